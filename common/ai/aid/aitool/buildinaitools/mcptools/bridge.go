@@ -0,0 +1,422 @@
+package mcptools
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/ai/aid/aitool"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/client"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	ServerTypeStdio = "stdio"
+	ServerTypeSSE   = "sse"
+)
+
+// ServerConfig 描述一个外部 MCP 服务器
+type ServerConfig struct {
+	// Name 服务器名称，注册的工具名为 mcp_{Name}_{tool}
+	Name string
+	// Type 为 stdio 或 sse
+	Type string
+
+	// stdio: 启动的命令
+	Command string
+	Args    []string
+	Env     []string
+
+	// sse: 服务器地址, 例如 http://127.0.0.1:8080/sse
+	URL string
+
+	// Disabled 服务器默认不启用，之后可以通过 Bridge.EnableServer 启用
+	Disabled bool
+	// ManualReview 该服务器的工具调用总是需要人工审核，不受 yolo / auto / ai 审核策略影响
+	ManualReview bool
+	// Timeout 连接与单次调用的超时，默认 30s
+	Timeout time.Duration
+}
+
+type serverSession struct {
+	config  *ServerConfig
+	client  client.MCPClient
+	tools   []*aitool.Tool
+	enabled bool
+}
+
+// Bridge 连接外部 MCP 服务器，把服务器上的工具转换为 aitool.Tool
+type Bridge struct {
+	// ctx 控制 sse 长连接的生命周期，Close 时取消
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.RWMutex
+	servers  map[string]*serverSession
+	order    []string
+	toolHost map[string]string
+}
+
+func NewBridge() *Bridge {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Bridge{
+		ctx:      ctx,
+		cancel:   cancel,
+		servers:  make(map[string]*serverSession),
+		toolHost: make(map[string]string),
+	}
+}
+
+// NewBridgeWithServers 创建 Bridge 并添加所有服务器，连接失败的服务器会被跳过
+func NewBridgeWithServers(ctx context.Context, configs ...*ServerConfig) *Bridge {
+	bridge := NewBridge()
+	for _, config := range configs {
+		if err := bridge.AddServer(ctx, config); err != nil {
+			log.Errorf("add mcp server[%v] failed: %v", config.Name, err)
+		}
+	}
+	return bridge
+}
+
+func ToolName(server, tool string) string {
+	return fmt.Sprintf("mcp_%s_%s", server, tool)
+}
+
+// AddServer 添加一个服务器，启用的服务器会立即连接并获取工具列表，连接成功后才会加入 Bridge
+func (b *Bridge) AddServer(ctx context.Context, config *ServerConfig) error {
+	if config == nil {
+		return utils.Error("mcp server config is nil")
+	}
+	if config.Name == "" {
+		return utils.Error("mcp server name is empty")
+	}
+
+	b.mu.RLock()
+	_, existed := b.servers[config.Name]
+	b.mu.RUnlock()
+	if existed {
+		return utils.Errorf("mcp server[%v] existed", config.Name)
+	}
+
+	session := &serverSession{config: config}
+	if !config.Disabled {
+		// 连接可能很慢，不持有锁，避免阻塞其他服务器
+		cli, tools, err := b.connect(ctx, config)
+		if err != nil {
+			return err
+		}
+		session.client = cli
+		session.tools = tools
+		session.enabled = true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.servers[config.Name]; ok || b.ctx.Err() != nil {
+		if session.client != nil {
+			session.client.Close()
+		}
+		if ok {
+			return utils.Errorf("mcp server[%v] existed", config.Name)
+		}
+		return utils.Error("mcp bridge is closed")
+	}
+	b.servers[config.Name] = session
+	b.order = append(b.order, config.Name)
+	for _, tool := range session.tools {
+		b.toolHost[tool.Name] = config.Name
+	}
+	return nil
+}
+
+// EnableServer 启用服务器，未连接的服务器会先连接
+func (b *Bridge) EnableServer(ctx context.Context, name string) error {
+	b.mu.Lock()
+	session, ok := b.servers[name]
+	if !ok {
+		b.mu.Unlock()
+		return utils.Errorf("mcp server[%v] not found", name)
+	}
+	if session.client != nil {
+		session.enabled = true
+		b.mu.Unlock()
+		return nil
+	}
+	config := session.config
+	b.mu.Unlock()
+
+	cli, tools, err := b.connect(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.ctx.Err() != nil {
+		cli.Close()
+		return utils.Error("mcp bridge is closed")
+	}
+	if session.client != nil {
+		// 并发启用时已经由其他调用连接
+		cli.Close()
+	} else {
+		session.client = cli
+		session.tools = tools
+		for _, tool := range tools {
+			b.toolHost[tool.Name] = config.Name
+		}
+	}
+	session.enabled = true
+	return nil
+}
+
+// DisableServer 禁用服务器，服务器的工具不再出现在工具列表中，连接会被保留
+func (b *Bridge) DisableServer(name string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	session, ok := b.servers[name]
+	if !ok {
+		return utils.Errorf("mcp server[%v] not found", name)
+	}
+	session.enabled = false
+	return nil
+}
+
+// Servers 返回所有服务器的名称与启用状态
+func (b *Bridge) Servers() map[string]bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	servers := make(map[string]bool, len(b.servers))
+	for name, session := range b.servers {
+		servers[name] = session.enabled
+	}
+	return servers
+}
+
+// Tools 返回所有启用服务器的工具
+func (b *Bridge) Tools() []*aitool.Tool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var tools []*aitool.Tool
+	for _, name := range b.order {
+		session := b.servers[name]
+		if session.enabled {
+			tools = append(tools, session.tools...)
+		}
+	}
+	return tools
+}
+
+// GetServerByToolName 返回工具所属的服务器配置
+func (b *Bridge) GetServerByToolName(toolName string) (*ServerConfig, bool) {
+	if b == nil {
+		return nil, false
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	name, ok := b.toolHost[toolName]
+	if !ok {
+		return nil, false
+	}
+	return b.servers[name].config, true
+}
+
+// RequireManualReview 工具是否来自需要人工审核的服务器
+func (b *Bridge) RequireManualReview(toolName string) bool {
+	config, ok := b.GetServerByToolName(toolName)
+	return ok && config.ManualReview
+}
+
+func (b *Bridge) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	defer b.cancel()
+
+	var errs []string
+	for _, session := range b.servers {
+		if session.client == nil {
+			continue
+		}
+		if err := session.client.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("%v: %v", session.config.Name, err))
+		}
+		session.client = nil
+		session.enabled = false
+	}
+	if len(errs) > 0 {
+		return utils.Errorf("close mcp servers failed: %v", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// connect 连接服务器并获取工具列表，不修改 Bridge 的状态
+func (b *Bridge) connect(ctx context.Context, config *ServerConfig) (_ client.MCPClient, _ []*aitool.Tool, fErr error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, config.timeout())
+	defer cancel()
+
+	var cli client.MCPClient
+	switch strings.ToLower(config.Type) {
+	case ServerTypeStdio:
+		stdioClient, err := client.NewStdioMCPClient(config.Command, config.Env, config.Args...)
+		if err != nil {
+			return nil, nil, utils.Errorf("start mcp server[%v] failed: %v", config.Name, err)
+		}
+		cli = stdioClient
+	case ServerTypeSSE:
+		sseClient, err := client.NewSSEMCPClient(config.URL)
+		if err != nil {
+			return nil, nil, utils.Errorf("create mcp client for server[%v] failed: %v", config.Name, err)
+		}
+		// the sse stream lives with the bridge, ctx only limits the waiting for endpoint
+		started := make(chan error, 1)
+		go func() {
+			started <- sseClient.Start(b.ctx)
+		}()
+		select {
+		case err := <-started:
+			if err != nil {
+				sseClient.Close()
+				return nil, nil, utils.Errorf("connect mcp server[%v] failed: %v", config.Name, err)
+			}
+		case <-ctx.Done():
+			sseClient.Close()
+			return nil, nil, utils.Errorf("connect mcp server[%v] timeout", config.Name)
+		}
+		cli = sseClient
+	default:
+		return nil, nil, utils.Errorf("mcp server[%v] type %#v is not supported, use stdio or sse", config.Name, config.Type)
+	}
+	defer func() {
+		if fErr != nil {
+			cli.Close()
+		}
+	}()
+
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{
+		Name:    "yaklang-aid",
+		Version: "1.0.0",
+	}
+	if _, err := cli.Initialize(ctx, initRequest); err != nil {
+		return nil, nil, utils.Errorf("initialize mcp server[%v] failed: %v", config.Name, err)
+	}
+	result, err := cli.ListTools(ctx, mcp.ListToolsRequest{})
+	if err != nil {
+		return nil, nil, utils.Errorf("list tools of mcp server[%v] failed: %v", config.Name, err)
+	}
+
+	var tools []*aitool.Tool
+	for _, remote := range result.Tools {
+		tool, err := newBridgeTool(config, cli, remote)
+		if err != nil {
+			log.Warnf("convert mcp tool of server[%v] failed: %v", config.Name, err)
+			continue
+		}
+		tools = append(tools, tool)
+	}
+	log.Infof("mcp server[%v] connected, %v tools found", config.Name, len(tools))
+	return cli, tools, nil
+}
+
+func (c *ServerConfig) timeout() time.Duration {
+	if c.Timeout <= 0 {
+		return 30 * time.Second
+	}
+	return c.Timeout
+}
+
+func newBridgeTool(config *ServerConfig, cli client.MCPClient, remote *mcp.Tool) (*aitool.Tool, error) {
+	if remote == nil || remote.Name == "" {
+		return nil, utils.Error("tool name is empty")
+	}
+	remoteName := remote.Name
+	local := *remote
+	local.Name = ToolName(config.Name, remoteName)
+	local.Description = fmt.Sprintf("[MCP:%s] %s", config.Name, remote.Description)
+	if local.InputSchema.Type == "" {
+		local.InputSchema.Type = "object"
+	}
+	if local.InputSchema.Properties == nil {
+		local.InputSchema.Properties = make(map[string]any)
+	}
+	// 外部工具不允许跳过审核
+	local.NoNeedUserReview = false
+	local.NoNeedTimelineRecorded = false
+
+	return aitool.NewFromMCPTool(
+		&local,
+		aitool.WithKeywords([]string{"mcp", config.Name, remoteName}),
+		aitool.WithNoRuntimeCallback(func(ctx context.Context, params aitool.InvokeParams, stdout io.Writer, stderr io.Writer) (any, error) {
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, cancel := context.WithTimeout(ctx, config.timeout())
+			defer cancel()
+
+			request := mcp.CallToolRequest{}
+			request.Params.Name = remoteName
+			request.Params.Arguments = params
+			result, err := cli.CallTool(ctx, request)
+			if err != nil {
+				return nil, utils.Errorf("call mcp tool[%v] of server[%v] failed: %v", remoteName, config.Name, err)
+			}
+			text := CallToolResultToString(result)
+			if result.IsError {
+				stderr.Write([]byte(text))
+				return nil, utils.Errorf("mcp tool[%v] of server[%v] returned error: %v", remoteName, config.Name, text)
+			}
+			return text, nil
+		}),
+	)
+}
+
+// CallToolResultToString 把 MCP 工具返回的内容转换为文本，图片与二进制资源只保留描述
+func CallToolResultToString(result *mcp.CallToolResult) string {
+	if result == nil {
+		return ""
+	}
+	var parts []string
+	for _, content := range result.Content {
+		switch ret := content.(type) {
+		case mcp.TextContent:
+			parts = append(parts, ret.Text)
+		case *mcp.TextContent:
+			parts = append(parts, ret.Text)
+		case map[string]any:
+			parts = append(parts, contentMapToString(ret))
+		default:
+			parts = append(parts, utils.InterfaceToString(content))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func contentMapToString(content map[string]any) string {
+	switch utils.MapGetString(content, "type") {
+	case "text":
+		return utils.MapGetString(content, "text")
+	case "image":
+		return fmt.Sprintf("[image %v, %v bytes base64]", utils.MapGetString(content, "mimeType"), len(utils.MapGetString(content, "data")))
+	case "resource":
+		resource := utils.MapGetMapRaw(content, "resource")
+		if text := utils.MapGetString(resource, "text"); text != "" {
+			return text
+		}
+		return fmt.Sprintf("[resource %v, %v bytes base64]", utils.MapGetString(resource, "uri"), len(utils.MapGetString(resource, "blob")))
+	default:
+		return string(utils.Jsonify(content))
+	}
+}
@@ -0,0 +1,135 @@
+package mcptools
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/ai/aid/aitool"
+	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/mcp"
+	"github.com/yaklang/yaklang/common/mcp/mcp-go/server"
+)
+
+func newTestMCPServer(t *testing.T) string {
+	mcpServer := server.NewMCPServer("ticket-server", "1.0.0")
+	mcpServer.AddTool(mcp.NewTool(
+		"query_ticket",
+		mcp.WithDescription("query ticket by id"),
+		mcp.WithString("id", mcp.Description("ticket id"), mcp.Required()),
+	), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		id, _ := request.Params.Arguments["id"].(string)
+		if id == "" {
+			return &mcp.CallToolResult{
+				Content: []any{mcp.TextContent{Type: "text", Text: "ticket not found"}},
+				IsError: true,
+			}, nil
+		}
+		return &mcp.CallToolResult{
+			Content: []any{
+				mcp.TextContent{Type: "text", Text: "ticket " + id + ": open"},
+				mcp.ImageContent{Type: "image", Data: "aGVsbG8=", MIMEType: "image/png"},
+			},
+		}, nil
+	})
+	testServer := server.NewTestServer(mcpServer)
+	t.Cleanup(testServer.Close)
+	return testServer.URL + "/sse"
+}
+
+func TestBridge_SSE(t *testing.T) {
+	url := newTestMCPServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	bridge := NewBridge()
+	defer bridge.Close()
+	require.NoError(t, bridge.AddServer(ctx, &ServerConfig{Name: "ticket", Type: ServerTypeSSE, URL: url, ManualReview: true}))
+	require.Error(t, bridge.AddServer(ctx, &ServerConfig{Name: "ticket", Type: ServerTypeSSE, URL: url}))
+
+	tools := bridge.Tools()
+	require.Len(t, tools, 1)
+	tool := tools[0]
+	require.Equal(t, ToolName("ticket", "query_ticket"), tool.Name)
+	require.Contains(t, tool.Description, "query ticket by id")
+	require.Contains(t, tool.InputSchema.Required, "id")
+	require.False(t, tool.NoNeedUserReview)
+	require.True(t, bridge.RequireManualReview(tool.Name))
+	require.False(t, bridge.RequireManualReview("now"))
+
+	result, err := tool.InvokeWithParams(map[string]any{"id": "T-1"})
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	data := result.Data.(*aitool.ToolExecutionResult)
+	require.Contains(t, data.Result, "ticket T-1: open")
+	require.Contains(t, data.Result, "[image image/png, 8 bytes base64]")
+
+	result, err = tool.InvokeWithParams(map[string]any{"id": ""})
+	require.Error(t, err)
+	require.False(t, result.Success)
+	require.Contains(t, result.Error, "ticket not found")
+
+	// disabled server is hidden from the tool manager
+	manager := buildinaitools.NewToolManagerByToolGetter(nil, buildinaitools.WithExtendToolsGetter(bridge.Tools))
+	_, err = manager.GetToolByName(tool.Name)
+	require.NoError(t, err)
+
+	require.NoError(t, bridge.DisableServer("ticket"))
+	require.Empty(t, bridge.Tools())
+	_, err = manager.GetToolByName(tool.Name)
+	require.Error(t, err)
+
+	require.NoError(t, bridge.EnableServer(ctx, "ticket"))
+	_, err = manager.GetToolByName(tool.Name)
+	require.NoError(t, err)
+}
+
+func TestBridge_DisabledServer(t *testing.T) {
+	bridge := NewBridge()
+	defer bridge.Close()
+
+	// disabled server is not connected until enabled
+	require.NoError(t, bridge.AddServer(context.Background(), &ServerConfig{Name: "inventory", Type: ServerTypeSSE, URL: "http://127.0.0.1:1/sse", Disabled: true}))
+	require.Equal(t, map[string]bool{"inventory": false}, bridge.Servers())
+	require.Empty(t, bridge.Tools())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	require.Error(t, bridge.EnableServer(ctx, "inventory"))
+	require.Error(t, bridge.AddServer(ctx, &ServerConfig{Name: "unknown", Type: "websocket"}))
+}
+
+func TestBridge_ConnectOutsideLock(t *testing.T) {
+	// the hanging server accepts the sse request but never sends the endpoint event
+	hanging := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer hanging.Close()
+
+	bridge := NewBridge()
+	defer bridge.Close()
+	require.NoError(t, bridge.AddServer(context.Background(), &ServerConfig{Name: "ticket", Type: ServerTypeSSE, URL: newTestMCPServer(t)}))
+
+	added := make(chan error, 1)
+	go func() {
+		added <- bridge.AddServer(context.Background(), &ServerConfig{Name: "hanging", Type: ServerTypeSSE, URL: hanging.URL, Timeout: 2 * time.Second})
+	}()
+
+	// other servers are still usable while the hanging server is connecting
+	time.Sleep(200 * time.Millisecond)
+	start := time.Now()
+	require.Len(t, bridge.Tools(), 1)
+	require.NoError(t, bridge.DisableServer("ticket"))
+	require.Equal(t, map[string]bool{"ticket": false}, bridge.Servers())
+	require.Less(t, time.Since(start), time.Second)
+
+	// the failed server is not published, so it can be added again
+	require.Error(t, <-added)
+	require.Equal(t, map[string]bool{"ticket": false}, bridge.Servers())
+	err := bridge.AddServer(context.Background(), &ServerConfig{Name: "hanging", Type: ServerTypeSSE, URL: "http://127.0.0.1:1/sse", Timeout: 2 * time.Second})
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "existed")
+}
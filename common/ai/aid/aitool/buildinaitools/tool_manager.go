@@ -2,6 +2,7 @@ package buildinaitools
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/samber/lo"
 
@@ -18,6 +19,9 @@ type AiToolManager struct {
 	searcher     searchtools.AISearcher[*aitool.Tool]
 	disableTools map[string]struct{} // 禁用的工具列表 优先级最高
 	searchTool   []*aitool.Tool
+
+	toolEnabledMu      sync.RWMutex
+	extendToolsGetters []func() []*aitool.Tool // 动态变化的扩展工具，默认开启
 }
 
 // ToolManagerOption 定义工具管理器的配置选项
@@ -71,6 +75,17 @@ func WithExtendTools(tools []*aitool.Tool, suggested ...bool) ToolManagerOption
 	}
 }
 
+// WithExtendToolsGetter 扩展动态变化的工具（例如外部 MCP 服务器的工具），每次获取工具列表时都会调用 getter
+// getter 返回的工具默认开启，除非已经通过 DisableTool 关闭
+func WithExtendToolsGetter(getter func() []*aitool.Tool) ToolManagerOption {
+	return func(m *AiToolManager) {
+		if getter == nil {
+			return
+		}
+		m.extendToolsGetters = append(m.extendToolsGetters, getter)
+	}
+}
+
 // WithEnabledTools 设置开启的工具列表
 func WithEnabledTools(toolNames []string) ToolManagerOption {
 	return func(m *AiToolManager) {
@@ -116,28 +131,61 @@ func NewToolManager(options ...ToolManagerOption) *AiToolManager {
 	return manager
 }
 
-func (m *AiToolManager) safeToolsGetter() []*aitool.Tool {
-	if m.toolsGetter == nil {
-		return []*aitool.Tool{}
+// getAllTools 获取工具列表，同时返回默认开启的扩展工具名
+func (m *AiToolManager) getAllTools() ([]*aitool.Tool, map[string]struct{}) {
+	var allTools []*aitool.Tool
+	if m.toolsGetter != nil {
+		allTools = m.toolsGetter()
+	}
+	extended := make(map[string]struct{})
+	if len(m.extendToolsGetters) > 0 {
+		existed := make(map[string]struct{}, len(allTools))
+		for _, tool := range allTools {
+			existed[tool.Name] = struct{}{}
+		}
+		for _, getter := range m.extendToolsGetters {
+			for _, tool := range getter() {
+				if _, ok := existed[tool.Name]; ok {
+					continue
+				}
+				existed[tool.Name] = struct{}{}
+				extended[tool.Name] = struct{}{}
+				allTools = append(allTools, tool)
+			}
+		}
 	}
-	allTools := m.toolsGetter()
 	if len(m.disableTools) > 0 {
 		allTools = lo.Filter(allTools, func(tool *aitool.Tool, _ int) bool {
 			_, ok := m.disableTools[tool.Name]
 			return !ok
 		})
 	}
+	return allTools, extended
+}
+
+func (m *AiToolManager) safeToolsGetter() []*aitool.Tool {
+	allTools, _ := m.getAllTools()
+	if allTools == nil {
+		return []*aitool.Tool{}
+	}
 	return allTools
 }
 
 // GetEnableTools 获取所有可用的工具
 func (m *AiToolManager) GetEnableTools() ([]*aitool.Tool, error) {
 	var enabledTools []*aitool.Tool
-	for _, tool := range m.safeToolsGetter() {
-		if m.toolEnabled[tool.Name] {
+	allTools, extended := m.getAllTools()
+	m.toolEnabledMu.RLock()
+	for _, tool := range allTools {
+		enabled, ok := m.toolEnabled[tool.Name]
+		if !ok {
+			_, enabled = extended[tool.Name]
+		}
+		if enabled {
 			enabledTools = append(enabledTools, tool)
 		}
 	}
+	m.toolEnabledMu.RUnlock()
 	if m.enableSearch {
 		if m.searcher == nil {
 			log.Errorf("searcher is not set")
@@ -202,10 +250,14 @@ func (m *AiToolManager) SearchTools(method string, query string) ([]*aitool.Tool
 
 // EnableTool 开启单个工具
 func (m *AiToolManager) EnableTool(name string) {
+	m.toolEnabledMu.Lock()
+	defer m.toolEnabledMu.Unlock()
 	m.toolEnabled[name] = true
 }
 
 // DisableTool 关闭单个工具
 func (m *AiToolManager) DisableTool(name string) {
+	m.toolEnabledMu.Lock()
+	defer m.toolEnabledMu.Unlock()
 	m.toolEnabled[name] = false
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/yaklang/yaklang/common/ai/aid/aitool"
 	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools"
 	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools/fstools"
+	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools/mcptools"
 	"github.com/yaklang/yaklang/common/ai/aid/aitool/buildinaitools/searchtools"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
@@ -97,6 +98,7 @@ type Config struct {
 	// tool manager
 	aiToolManager       *buildinaitools.AiToolManager
 	aiToolManagerOption []buildinaitools.ToolManagerOption
+	mcpBridge           *mcptools.Bridge
 
 	// memory
 	persistentMemory          []string
@@ -783,6 +785,20 @@ func WithTools(tool ...*aitool.Tool) Option {
 	}
 }
 
+// WithMCPBridge 使用外部 MCP 服务器的工具，服务器的启用状态变化会实时反映到工具列表中
+func WithMCPBridge(bridge *mcptools.Bridge) Option {
+	return func(config *Config) error {
+		config.m.Lock()
+		defer config.m.Unlock()
+		if bridge == nil {
+			return nil
+		}
+		config.mcpBridge = bridge
+		config.aiToolManagerOption = append(config.aiToolManagerOption, buildinaitools.WithExtendToolsGetter(bridge.Tools))
+		return nil
+	}
+}
+
 func WithDebugPrompt(i ...bool) Option {
 	return func(config *Config) error {
 		config.m.Lock()
@@ -148,7 +148,12 @@ func (t *aiTask) callTool(targetTool *aitool.Tool) (result *aitool.ToolResult, d
 		ep := t.config.epm.createEndpointWithEventType(schema.EVENT_TYPE_TOOL_USE_REVIEW_REQUIRE)
 		ep.SetDefaultSuggestionContinue()
 		t.config.EmitRequireReviewForToolUse(targetTool, callToolParams, ep.id)
-		t.config.doWaitAgree(nil, ep)
+		if t.config.mcpBridge.RequireManualReview(targetTool.Name) {
+			t.config.EmitInfo("tool[%v] is from mcp server which requires manual review", targetTool.Name)
+			t.config.doWaitAgreeWithPolicy(nil, AgreePolicyManual, ep)
+		} else {
+			t.config.doWaitAgree(nil, ep)
+		}
 		params := ep.GetParams()
 		t.config.ReleaseInteractiveEvent(ep.id, params)
 		if params == nil {
@@ -174,6 +174,7 @@ type ChatBaseContext struct {
 	StreamHandler       func(io.Reader)
 	ReasonStreamHandler func(reader io.Reader)
 	ErrHandler          func(err error)
+	UsageCallback       func(usage *ChatUsage)
 	ImageUrls           []*ImageDescription
 }
 
@@ -215,6 +216,12 @@ func WithChatBase_ErrHandler(b func(error)) ChatBaseOption {
 	}
 }
 
+func WithChatBase_UsageCallback(b func(usage *ChatUsage)) ChatBaseOption {
+	return func(c *ChatBaseContext) {
+		c.UsageCallback = b
+	}
+}
+
 func WithChatBase_PoCOptions(b func() ([]poc.PocConfigOption, error)) ChatBaseOption {
 	return func(c *ChatBaseContext) {
 		c.PoCOptionGenerator = b
@@ -267,6 +274,9 @@ func ChatBase(url string, model string, msg string, chatOpts ...ChatBaseOption)
 	handleStream := streamHandler != nil
 	if handleStream {
 		msgIns.Stream = true
+		if ctx.UsageCallback != nil {
+			msgIns.StreamOptions = &StreamOptions{IncludeUsage: true}
+		}
 	}
 
 	raw, err := json.Marshal(msgIns)
@@ -279,7 +289,7 @@ func ChatBase(url string, model string, msg string, chatOpts ...ChatBaseOption)
 
 	var pr, reasonPr io.Reader
 	var cancel context.CancelFunc
-	pr, reasonPr, opts, cancel = appendStreamHandlerPoCOptionEx(handleStream, opts)
+	pr, reasonPr, opts, cancel = appendStreamHandlerPoCOptionWithUsage(handleStream, opts, ctx.UsageCallback)
 	wg := new(sync.WaitGroup)
 
 	noMerge := false
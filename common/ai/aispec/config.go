@@ -41,6 +41,8 @@ type AIConfig struct {
 	FunctionCallRetryTimes int
 
 	HTTPErrorHandler func(error)
+	// UsageCallback 在服务端返回 token 用量时被调用
+	UsageCallback func(*ChatUsage)
 
 	Images []*ImageDescription
 }
@@ -283,3 +285,9 @@ func WithHTTPErrorHandler(h func(error)) AIConfigOption {
 		c.HTTPErrorHandler = h
 	}
 }
+
+func WithUsageCallback(h func(*ChatUsage)) AIConfigOption {
+	return func(c *AIConfig) {
+		c.UsageCallback = h
+	}
+}
@@ -12,10 +12,16 @@ import (
 )
 
 type ChatMessage struct {
-	Model          string       `json:"model"`
-	Messages       []ChatDetail `json:"messages"`
-	Stream         bool         `json:"stream"`
-	EnableThinking bool         `json:"enable_thinking"`
+	Model          string         `json:"model"`
+	Messages       []ChatDetail   `json:"messages"`
+	Stream         bool           `json:"stream"`
+	StreamOptions  *StreamOptions `json:"stream_options,omitempty"`
+	EnableThinking bool           `json:"enable_thinking"`
+}
+
+// StreamOptions 流式请求的额外选项，IncludeUsage 要求服务端在最后一个 chunk 中返回 usage
+type StreamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
 }
 
 type ChatDetail struct {
@@ -44,8 +44,33 @@ func appendStreamHandlerPoCOption(opts []poc.PocConfigOption) (io.Reader, []poc.
 	return pr, opts
 }
 
+// extractChatUsage 从响应 json 中提取 token 用量，兼容 prompt_tokens / input_tokens 两种字段
+func extractChatUsage(j string) *ChatUsage {
+	raw := jsonpath.Find(j, `$.usage`)
+	if utils.IsNil(raw) || !utils.IsMap(raw) {
+		return nil
+	}
+	m := utils.InterfaceToGeneralMap(raw)
+	usage := &ChatUsage{
+		PromptTokens:     utils.MapGetInt(m, "prompt_tokens"),
+		CompletionTokens: utils.MapGetInt(m, "completion_tokens"),
+		TotalTokens:      utils.MapGetInt(m, "total_tokens"),
+	}
+	if usage.PromptTokens == 0 && usage.CompletionTokens == 0 {
+		usage.PromptTokens = utils.MapGetInt(m, "input_tokens")
+		usage.CompletionTokens = utils.MapGetInt(m, "output_tokens")
+	}
+	if usage.TotalTokens == 0 {
+		usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+	}
+	if usage.TotalTokens == 0 {
+		return nil
+	}
+	return usage
+}
+
 // processStreamResponse 处理流式响应
-func processStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Writer, reasonWriter io.Writer) {
+func processStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Writer, reasonWriter io.Writer, onUsage func(*ChatUsage)) {
 	defer func() {
 		if w, ok := outWriter.(io.Closer); ok {
 			w.Close()
@@ -54,6 +79,13 @@ func processStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Writer,
 			w.Close()
 		}
 	}()
+	// usage 需要在关闭输出之前回调，读取方在输出结束后即可拿到 usage
+	var usage *ChatUsage
+	defer func() {
+		if usage != nil && onUsage != nil {
+			onUsage(usage)
+		}
+	}()
 
 	var chunked bool
 	if te := lowhttp.GetHTTPPacketHeader(r, "transfer-encoding"); utils.IContains(te, "chunked") {
@@ -102,6 +134,10 @@ func processStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Writer,
 		lineStr := string(line)
 		jsonIdentifiers := jsonextractor.ExtractStandardJSON(lineStr)
 		for _, j := range jsonIdentifiers {
+			if ret := extractChatUsage(j); ret != nil {
+				// usage 是累计值，以最后一次为准
+				usage = ret
+			}
 			var reasonDelta string
 			if !reasonFinished {
 				reasonContent := jsonpath.Find(j, `$..choices[*].delta.reasoning_content`)
@@ -158,7 +194,7 @@ func processStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Writer,
 }
 
 // processNonStreamResponse 处理非流式响应
-func processNonStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Writer, reasonWriter io.Writer) {
+func processNonStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Writer, reasonWriter io.Writer, onUsage func(*ChatUsage)) {
 	defer func() {
 		if w, ok := outWriter.(io.Closer); ok {
 			w.Close()
@@ -167,6 +203,13 @@ func processNonStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Write
 			w.Close()
 		}
 	}()
+	// usage 需要在关闭输出之前回调，读取方在输出结束后即可拿到 usage
+	var usage *ChatUsage
+	defer func() {
+		if usage != nil && onUsage != nil {
+			onUsage(usage)
+		}
+	}()
 
 	if lowhttp.GetStatusCodeFromResponse(r) > 299 {
 		log.Warnf("response status code: %v", lowhttp.GetStatusCodeFromResponse(r))
@@ -187,6 +230,10 @@ func processNonStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Write
 	// 解析 JSON 响应
 	jsonIdentifiers := jsonextractor.ExtractStandardJSON(string(bodyBytes))
 	for _, j := range jsonIdentifiers {
+		if ret := extractChatUsage(j); ret != nil {
+			usage = ret
+		}
+
 		// 处理 reasoning content
 		reasonContent := jsonpath.Find(j, `$..choices[*].message.reasoning_content`)
 		if reasonContent != nil {
@@ -224,6 +271,10 @@ func processNonStreamResponse(r []byte, closer io.ReadCloser, outWriter io.Write
 }
 
 func appendStreamHandlerPoCOptionEx(isStream bool, opts []poc.PocConfigOption) (io.Reader, io.Reader, []poc.PocConfigOption, func()) {
+	return appendStreamHandlerPoCOptionWithUsage(isStream, opts, nil)
+}
+
+func appendStreamHandlerPoCOptionWithUsage(isStream bool, opts []poc.PocConfigOption, onUsage func(*ChatUsage)) (io.Reader, io.Reader, []poc.PocConfigOption, func()) {
 	outReader, outWriter := utils.NewBufPipe(nil)
 	reasonReader, reasonWriter := utils.NewBufPipe(nil)
 
@@ -234,9 +285,9 @@ func appendStreamHandlerPoCOptionEx(isStream bool, opts []poc.PocConfigOption) (
 
 	opts = append(opts, poc.WithBodyStreamReaderHandler(func(r []byte, closer io.ReadCloser) {
 		if isStream {
-			processStreamResponse(r, closer, outWriter, reasonWriter)
+			processStreamResponse(r, closer, outWriter, reasonWriter, onUsage)
 		} else {
-			processNonStreamResponse(r, closer, outWriter, reasonWriter)
+			processNonStreamResponse(r, closer, outWriter, reasonWriter, onUsage)
 		}
 	}))
 
@@ -19,7 +19,7 @@ func TestProcessNonStreamResponse(t *testing.T) {
 	outBuffer := &bytes.Buffer{}
 	reasonBuffer := &bytes.Buffer{}
 
-	processNonStreamResponse(mockResponse, mockCloser, outBuffer, reasonBuffer)
+	processNonStreamResponse(mockResponse, mockCloser, outBuffer, reasonBuffer, nil)
 
 	expectedContent := "Hello World"
 	expectedReason := "This is my reasoning process"
@@ -49,7 +49,7 @@ data: [DONE]`
 	outBuffer := &bytes.Buffer{}
 	reasonBuffer := &bytes.Buffer{}
 
-	processStreamResponse(mockResponse, mockCloser, outBuffer, reasonBuffer)
+	processStreamResponse(mockResponse, mockCloser, outBuffer, reasonBuffer, nil)
 
 	t.Logf("流式内容输出: %s", outBuffer.String())
 	t.Logf("流式推理输出: %s", reasonBuffer.String())
@@ -80,3 +80,36 @@ func TestAppendStreamHandlerPoCOptionEx(t *testing.T) {
 		t.Error("非流式应该添加了处理选项")
 	}
 }
+
+func TestProcessResponseUsage(t *testing.T) {
+	streamData := `data: {"choices":[{"delta":{"content":"Hello"}}],"usage":null}
+data: {"choices":[{"delta":{"content":" World"}}]}
+data: {"choices":[],"usage":{"prompt_tokens":12,"completion_tokens":2,"total_tokens":14}}
+data: [DONE]`
+
+	var usage *ChatUsage
+	outBuffer := &bytes.Buffer{}
+	processStreamResponse(
+		[]byte("HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\n\r\n"),
+		io.NopCloser(strings.NewReader(streamData)),
+		outBuffer, &bytes.Buffer{},
+		func(u *ChatUsage) { usage = u },
+	)
+	if outBuffer.String() != "Hello World" {
+		t.Errorf("内容输出不匹配: %s", outBuffer.String())
+	}
+	if usage == nil || usage.PromptTokens != 12 || usage.CompletionTokens != 2 || usage.TotalTokens != 14 {
+		t.Fatalf("usage 解析失败: %#v", usage)
+	}
+
+	usage = nil
+	processNonStreamResponse(
+		[]byte("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"),
+		io.NopCloser(strings.NewReader(`{"choices":[{"message":{"content":"ok"}}],"usage":{"input_tokens":5,"output_tokens":1}}`)),
+		&bytes.Buffer{}, &bytes.Buffer{},
+		func(u *ChatUsage) { usage = u },
+	)
+	if usage == nil || usage.PromptTokens != 5 || usage.CompletionTokens != 1 || usage.TotalTokens != 6 {
+		t.Fatalf("usage 解析失败: %#v", usage)
+	}
+}
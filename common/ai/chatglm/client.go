@@ -130,6 +130,7 @@ func (g *GLMClient) Chat(s string, f ...any) (string, error) {
 		aispec.WithChatBase_StreamHandler(g.config.StreamHandler),
 		aispec.WithChatBase_ReasonStreamHandler(g.config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.config.HTTPErrorHandler),
+		aispec.WithChatBase_UsageCallback(g.config.UsageCallback),
 		aispec.WithChatBase_ImageRawInstance(g.config.Images...),
 	)
 }
@@ -46,6 +46,7 @@ func (g *GetawayClient) Chat(s string, function ...any) (string, error) {
 		aispec.WithChatBase_StreamHandler(g.config.StreamHandler),
 		aispec.WithChatBase_ReasonStreamHandler(g.config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.config.HTTPErrorHandler),
+		aispec.WithChatBase_UsageCallback(g.config.UsageCallback),
 		aispec.WithChatBase_ImageRawInstance(g.config.Images...),
 	)
 }
@@ -101,6 +101,7 @@ func (g *Gateway) Chat(s string, f ...any) (string, error) {
 		aispec.WithChatBase_StreamHandler(g.Config.StreamHandler),
 		aispec.WithChatBase_ReasonStreamHandler(g.Config.ReasonStreamHandler),
 		aispec.WithChatBase_ErrHandler(g.Config.HTTPErrorHandler),
+		aispec.WithChatBase_UsageCallback(g.Config.UsageCallback),
 		aispec.WithChatBase_ImageRawInstance(g.Config.Images...),
 	)
 }
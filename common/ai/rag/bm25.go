@@ -0,0 +1,187 @@
+package rag
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// BM25 参数
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Tokenize 将文本切分为用于关键词检索的词
+// 英文与数字按单词切分并转为小写，CVE-2021-44228、a.b.c 这类带连接符的词同时保留整体与各部分
+// 中文按单字与相邻两字切分
+func Tokenize(text string) []string {
+	var tokens []string
+	var word []rune
+	var han []rune
+
+	flushWord := func() {
+		if len(word) == 0 {
+			return
+		}
+		w := strings.Trim(string(word), "-.")
+		word = word[:0]
+		if w == "" {
+			return
+		}
+		tokens = append(tokens, w)
+		if strings.ContainsAny(w, "-.") {
+			for _, part := range strings.FieldsFunc(w, func(r rune) bool { return r == '-' || r == '.' }) {
+				tokens = append(tokens, part)
+			}
+		}
+	}
+	flushHan := func() {
+		for i, r := range han {
+			tokens = append(tokens, string(r))
+			if i+1 < len(han) {
+				tokens = append(tokens, string(han[i:i+2]))
+			}
+		}
+		han = han[:0]
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			han = append(han, r)
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'):
+			flushHan()
+			word = append(word, r)
+		case (r == '-' || r == '.') && len(word) > 0:
+			word = append(word, r)
+		default:
+			flushWord()
+			flushHan()
+		}
+	}
+	flushWord()
+	flushHan()
+	return tokens
+}
+
+// termFrequency 统计词频，返回词频表与总词数
+func termFrequency(text string) (map[string]int, int) {
+	tokens := Tokenize(text)
+	tf := make(map[string]int, len(tokens))
+	for _, token := range tokens {
+		tf[token]++
+	}
+	return tf, len(tokens)
+}
+
+// bm25TermScore 计算单个词对文档的 BM25 得分
+// tf: 词在文档中的次数, df: 包含该词的文档数, n: 文档总数, dl: 文档词数, avgdl: 平均文档词数
+func bm25TermScore(tf, df, n int, dl, avgdl float64) float64 {
+	if tf <= 0 || df <= 0 || n <= 0 {
+		return 0
+	}
+	idf := math.Log(1 + (float64(n)-float64(df)+0.5)/(float64(df)+0.5))
+	if avgdl <= 0 {
+		avgdl = 1
+	}
+	return idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + bm25K1*(1-bm25B+bm25B*dl/avgdl))
+}
+
+// BM25Index 是基于内存的倒排索引
+type BM25Index struct {
+	mu       sync.RWMutex
+	postings map[string]map[string]int // term -> document id -> tf
+	docLen   map[string]int            // document id -> 词数
+	totalLen int
+}
+
+func NewBM25Index() *BM25Index {
+	return &BM25Index{
+		postings: make(map[string]map[string]int),
+		docLen:   make(map[string]int),
+	}
+}
+
+// Add 索引文档，已存在的文档会被重新索引
+func (idx *BM25Index) Add(id string, content string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(id)
+	tf, length := termFrequency(content)
+	for term, count := range tf {
+		if idx.postings[term] == nil {
+			idx.postings[term] = make(map[string]int)
+		}
+		idx.postings[term][id] = count
+	}
+	idx.docLen[id] = length
+	idx.totalLen += length
+}
+
+// Remove 从索引中删除文档
+func (idx *BM25Index) Remove(ids ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, id := range ids {
+		idx.remove(id)
+	}
+}
+
+func (idx *BM25Index) remove(id string) {
+	length, ok := idx.docLen[id]
+	if !ok {
+		return
+	}
+	for term, docs := range idx.postings {
+		if _, ok := docs[id]; ok {
+			delete(docs, id)
+			if len(docs) == 0 {
+				delete(idx.postings, term)
+			}
+		}
+	}
+	delete(idx.docLen, id)
+	idx.totalLen -= length
+}
+
+// Scores 返回与查询匹配的文档及其 BM25 得分
+func (idx *BM25Index) Scores(query string) map[string]float64 {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	scores := make(map[string]float64)
+	n := len(idx.docLen)
+	if n == 0 {
+		return scores
+	}
+	avgdl := float64(idx.totalLen) / float64(n)
+	queryTerms, _ := termFrequency(query)
+	for term := range queryTerms {
+		docs := idx.postings[term]
+		for id, tf := range docs {
+			scores[id] += bm25TermScore(tf, len(docs), n, float64(idx.docLen[id]), avgdl)
+		}
+	}
+	return scores
+}
+
+// rankByScores 将得分转换为按得分降序排列的文档 ID
+func rankByScores(scores map[string]float64) []string {
+	ids := make([]string, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] == scores[ids[j]] {
+			return ids[i] < ids[j]
+		}
+		return scores[ids[i]] > scores[ids[j]]
+	})
+	return ids
+}
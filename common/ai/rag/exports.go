@@ -97,5 +97,7 @@ var Exports = map[string]interface{}{
 	"AddText":                 AddText,
 	"SearchAndGeneratePrompt": SearchAndGeneratePrompt,
 	"ChunkText":               ChunkText,
+	"ChunkTextBySentence":     ChunkTextBySentence,
+	"IngestText":              IngestText,
 	"FilterResults":           FilterResults,
 }
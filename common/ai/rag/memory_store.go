@@ -11,6 +11,7 @@ import (
 type MemoryVectorStore struct {
 	documents map[string]Document // 文档存储，以 ID 为键
 	embedder  EmbeddingClient     // 用于生成查询的嵌入向量
+	keywords  *BM25Index          // 关键词检索的倒排索引
 	mu        sync.RWMutex        // 用于并发安全的互斥锁
 }
 
@@ -19,6 +20,7 @@ func NewMemoryVectorStore(embedder EmbeddingClient) *MemoryVectorStore {
 	return &MemoryVectorStore{
 		documents: make(map[string]Document),
 		embedder:  embedder,
+		keywords:  NewBM25Index(),
 	}
 }
 
@@ -40,6 +42,7 @@ func (m *MemoryVectorStore) Add(docs ...Document) error {
 
 		// 存储文档
 		m.documents[doc.ID] = doc
+		m.keywords.Add(doc.ID, doc.Content)
 	}
 
 	return nil
@@ -47,6 +50,16 @@ func (m *MemoryVectorStore) Add(docs ...Document) error {
 
 // Search 根据查询文本检索相关文档
 func (m *MemoryVectorStore) Search(query string, page, limit int) ([]SearchResult, error) {
+	return m.SearchWithOptions(query, page, limit)
+}
+
+// SearchWithOptions 根据查询文本检索相关文档，可以指定检索模式与元数据过滤
+func (m *MemoryVectorStore) SearchWithOptions(query string, page, limit int, opts ...SearchOption) ([]SearchResult, error) {
+	config, err := NewSearchConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -56,43 +69,28 @@ func (m *MemoryVectorStore) Search(query string, page, limit int) ([]SearchResul
 	}
 
 	// 生成查询的嵌入向量
-	queryEmbedding, err := m.embedder.Embedding(query)
-	if err != nil {
-		return nil, utils.Errorf("failed to generate embedding for query: %v", err)
-	}
-
-	// 计算所有文档与查询的相似度
-	var results []SearchResult
-	for _, doc := range m.documents {
-		// 计算余弦相似度
-		similarity, err := utils.CosineSimilarity(queryEmbedding, doc.Embedding)
+	var queryEmbedding []float64
+	if config.needVector() {
+		queryEmbedding, err = m.embedder.Embedding(query)
 		if err != nil {
-			return nil, utils.Errorf("failed to calculate similarity: %v", err)
+			return nil, utils.Errorf("failed to generate embedding for query: %v", err)
 		}
-
-		// 添加到结果集
-		results = append(results, SearchResult{
-			Document: doc,
-			Score:    similarity,
-		})
 	}
-
-	// 按相似度降序排序
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Score > results[j].Score
-	})
-
-	// 计算分页
-	offset := (page - 1) * limit
-	if offset >= len(results) {
-		return []SearchResult{}, nil
-	}
-	if offset+limit > len(results) {
-		limit = len(results) - offset
+	var keywordScores map[string]float64
+	if config.needKeyword() {
+		keywordScores = m.keywords.Scores(query)
 	}
-	results = results[offset : offset+limit]
 
-	return results, nil
+	docs := make([]Document, 0, len(m.documents))
+	for _, doc := range m.documents {
+		docs = append(docs, doc)
+	}
+	// 保证得分相同时结果稳定
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].ID < docs[j].ID
+	})
+	results := rankDocuments(config, filterDocuments(config.Filter, docs), queryEmbedding, keywordScores)
+	return paginateResults(results, page, limit), nil
 }
 
 // Delete 根据 ID 删除文档
@@ -103,6 +101,7 @@ func (m *MemoryVectorStore) Delete(ids ...string) error {
 	for _, id := range ids {
 		delete(m.documents, id)
 	}
+	m.keywords.Remove(ids...)
 
 	return nil
 }
@@ -0,0 +1,405 @@
+package rag
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// MetadataFilter 是作用在 Document.Metadata 上的过滤表达式，例如：
+//
+//	source == "cve" && (year >= 2021 || tags contains "rce") && lang in ["go", "java"]
+//
+// 支持的比较符：== != > >= < <= contains in，逻辑运算：&& || ! 与括号，
+// 字段名可以使用 a.b 访问嵌套的 map，不存在的字段只会让比较结果为 false
+type MetadataFilter struct {
+	expr string
+	root filterNode
+}
+
+type filterNode interface {
+	match(metadata map[string]any) bool
+}
+
+type filterAnd struct{ left, right filterNode }
+type filterOr struct{ left, right filterNode }
+type filterNot struct{ node filterNode }
+type filterCompare struct {
+	field string
+	op    string
+	value any
+}
+
+func (f *filterAnd) match(m map[string]any) bool { return f.left.match(m) && f.right.match(m) }
+func (f *filterOr) match(m map[string]any) bool  { return f.left.match(m) || f.right.match(m) }
+func (f *filterNot) match(m map[string]any) bool { return !f.node.match(m) }
+
+// ParseMetadataFilter 解析过滤表达式，空表达式匹配所有文档
+func ParseMetadataFilter(expr string) (*MetadataFilter, error) {
+	filter := &MetadataFilter{expr: expr}
+	if strings.TrimSpace(expr) == "" {
+		return filter, nil
+	}
+	tokens, err := lexMetadataFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, utils.Errorf("parse metadata filter %#v failed: %v", expr, err)
+	}
+	if !p.eof() {
+		return nil, utils.Errorf("parse metadata filter %#v failed: unexpected %v", expr, p.peek().text)
+	}
+	filter.root = root
+	return filter, nil
+}
+
+// Match 判断元数据是否满足过滤表达式
+func (f *MetadataFilter) Match(metadata map[string]any) bool {
+	if f == nil || f.root == nil {
+		return true
+	}
+	return f.root.match(metadata)
+}
+
+func (f *MetadataFilter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.expr
+}
+
+func (f *filterCompare) match(metadata map[string]any) bool {
+	actual, ok := lookupMetadata(metadata, f.field)
+	if !ok {
+		return f.op == "!="
+	}
+	switch f.op {
+	case "==":
+		return filterValueEqual(actual, f.value)
+	case "!=":
+		return !filterValueEqual(actual, f.value)
+	case ">", ">=", "<", "<=":
+		a, aok := filterToFloat(actual)
+		b, bok := filterToFloat(f.value)
+		if !aok || !bok {
+			return false
+		}
+		switch f.op {
+		case ">":
+			return a > b
+		case ">=":
+			return a >= b
+		case "<":
+			return a < b
+		default:
+			return a <= b
+		}
+	case "contains":
+		if list, ok := filterToList(actual); ok {
+			for _, item := range list {
+				if filterValueEqual(item, f.value) {
+					return true
+				}
+			}
+			return false
+		}
+		return strings.Contains(utils.InterfaceToString(actual), utils.InterfaceToString(f.value))
+	case "in":
+		list, _ := filterToList(f.value)
+		for _, item := range list {
+			if filterValueEqual(actual, item) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+func lookupMetadata(metadata map[string]any, field string) (any, bool) {
+	if metadata == nil {
+		return nil, false
+	}
+	if v, ok := metadata[field]; ok {
+		return v, true
+	}
+	var current any = metadata
+	for _, key := range strings.Split(field, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func filterValueEqual(a, b any) bool {
+	if af, ok := filterToFloat(a); ok {
+		if bf, ok := filterToFloat(b); ok {
+			return af == bf
+		}
+	}
+	return utils.InterfaceToString(a) == utils.InterfaceToString(b)
+}
+
+func filterToFloat(v any) (float64, bool) {
+	switch ret := v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		f, err := strconv.ParseFloat(fmt.Sprint(ret), 64)
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(ret), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func filterToList(v any) ([]any, bool) {
+	switch ret := v.(type) {
+	case []any:
+		return ret, true
+	case []string:
+		list := make([]any, len(ret))
+		for i, item := range ret {
+			list[i] = item
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenIdent filterTokenKind = iota
+	filterTokenString
+	filterTokenNumber
+	filterTokenOp
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+}
+
+func lexMetadataFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			j := i + 1
+			var buf strings.Builder
+			for ; j < len(runes) && runes[j] != r; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				buf.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, utils.Errorf("unterminated string in metadata filter: %v", expr)
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenString, text: buf.String()})
+			i = j + 1
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenNumber, text: string(runes[i:j])})
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '.') {
+				j++
+			}
+			word := string(runes[i:j])
+			switch strings.ToLower(word) {
+			case "and":
+				tokens = append(tokens, filterToken{kind: filterTokenOp, text: "&&"})
+			case "or":
+				tokens = append(tokens, filterToken{kind: filterTokenOp, text: "||"})
+			case "not":
+				tokens = append(tokens, filterToken{kind: filterTokenOp, text: "!"})
+			case "contains", "in":
+				tokens = append(tokens, filterToken{kind: filterTokenOp, text: strings.ToLower(word)})
+			default:
+				tokens = append(tokens, filterToken{kind: filterTokenIdent, text: word})
+			}
+			i = j
+		default:
+			matched := false
+			for _, op := range []string{"&&", "||", "==", "!=", ">=", "<=", ">", "<", "!", "(", ")", "[", "]", ","} {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					tokens = append(tokens, filterToken{kind: filterTokenOp, text: op})
+					i += len([]rune(op))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, utils.Errorf("unexpected %q in metadata filter: %v", r, expr)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) eof() bool { return p.pos >= len(p.tokens) }
+
+func (p *filterParser) peek() filterToken {
+	if p.eof() {
+		return filterToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) acceptOp(op string) bool {
+	if !p.eof() && p.peek().kind == filterTokenOp && p.peek().text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptOp("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &filterOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptOp("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &filterAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	if p.acceptOp("!") {
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &filterNot{node: node}, nil
+	}
+	if p.acceptOp("(") {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptOp(")") {
+			return nil, utils.Error("missing )")
+		}
+		return node, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *filterParser) parseCompare() (filterNode, error) {
+	if p.eof() || p.peek().kind != filterTokenIdent {
+		return nil, utils.Errorf("expect field name, got %#v", p.peek().text)
+	}
+	field := p.tokens[p.pos].text
+	p.pos++
+
+	if p.eof() || p.peek().kind != filterTokenOp {
+		return nil, utils.Errorf("expect operator after %v", field)
+	}
+	op := p.tokens[p.pos].text
+	switch op {
+	case "==", "!=", ">", ">=", "<", "<=", "contains", "in":
+		p.pos++
+	default:
+		return nil, utils.Errorf("unsupported operator %v", op)
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	if _, isList := value.([]any); isList != (op == "in") {
+		return nil, utils.Errorf("operator %v with invalid value", op)
+	}
+	return &filterCompare{field: field, op: op, value: value}, nil
+}
+
+func (p *filterParser) parseValue() (any, error) {
+	if p.acceptOp("[") {
+		var list []any
+		for !p.acceptOp("]") {
+			if len(list) > 0 && !p.acceptOp(",") {
+				return nil, utils.Error("expect , in list")
+			}
+			item, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		if list == nil {
+			list = []any{}
+		}
+		return list, nil
+	}
+	if p.eof() {
+		return nil, utils.Error("expect value")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token.kind {
+	case filterTokenString:
+		return token.text, nil
+	case filterTokenNumber:
+		f, err := strconv.ParseFloat(token.text, 64)
+		if err != nil {
+			return nil, utils.Errorf("invalid number %v", token.text)
+		}
+		return f, nil
+	case filterTokenIdent:
+		switch strings.ToLower(token.text) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		// bare word is treated as string
+		return token.text, nil
+	}
+	return nil, utils.Errorf("unexpected %v", token.text)
+}
@@ -0,0 +1,47 @@
+package rag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadataFilter(t *testing.T) {
+	metadata := map[string]any{
+		"type":   "cve",
+		"year":   2021,
+		"score":  "9.8",
+		"tags":   []any{"rce", "java"},
+		"plugin": map[string]any{"name": "log4j-scan"},
+		"public": true,
+	}
+
+	for expr, expected := range map[string]bool{
+		``:                                      true,
+		`type == "cve"`:                         true,
+		`type == 'doc'`:                         false,
+		`type != "doc"`:                         true,
+		`year >= 2021 && year < 2022`:           true,
+		`score > 9`:                             true,
+		`tags contains "rce"`:                   true,
+		`tags contains "php"`:                   false,
+		`plugin.name contains "log4j"`:          true,
+		`type in ["cve", "doc"]`:                true,
+		`year in [2020, 2022]`:                  false,
+		`public == true`:                        true,
+		`missing == "x"`:                        false,
+		`missing != "x"`:                        true,
+		`!(type == "doc") and year > 2000`:      true,
+		`type == "doc" || tags contains "java"`: true,
+		`(type == "doc" or year < 2000) && public == true`: false,
+	} {
+		filter, err := ParseMetadataFilter(expr)
+		assert.NoError(t, err, expr)
+		assert.Equal(t, expected, filter.Match(metadata), expr)
+	}
+
+	for _, expr := range []string{`type ==`, `type = "cve"`, `(type == "cve"`, `type in "cve"`, `"cve" == type`, `type == "cve`, `type == "cve" && public`} {
+		_, err := ParseMetadataFilter(expr)
+		assert.Error(t, err, expr)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/jinzhu/gorm"
 	"github.com/stretchr/testify/assert"
 	"github.com/yaklang/yaklang/common/ai/rag"
 	"github.com/yaklang/yaklang/common/schema"
@@ -21,7 +22,7 @@ func TestMUSTPASS_ExportVectorData(t *testing.T) {
 	defer db.Close()
 
 	// 自动迁移数据库表结构
-	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{})
+	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{}, &schema.VectorStoreTerm{})
 
 	// 2. 创建向量存储并添加测试数据
 	store, err := rag.NewSQLiteVectorStore(db, PLUGIN_RAG_COLLECTION_NAME, "text-embedding-3-small", 1536, nil)
@@ -105,7 +106,7 @@ func TestMUSTPASS_ImportVectorData_ExistingCollection(t *testing.T) {
 	}
 	defer db.Close()
 
-	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{})
+	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{}, &schema.VectorStoreTerm{})
 
 	// 创建初始的Collection和Document
 	store, err := rag.NewSQLiteVectorStore(db, PLUGIN_RAG_COLLECTION_NAME, "text-embedding-3-small", 1536, nil)
@@ -167,7 +168,7 @@ func TestMUSTPASS_ImportVectorData_ExistingDocument(t *testing.T) {
 	}
 	defer db.Close()
 
-	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{})
+	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{}, &schema.VectorStoreTerm{})
 
 	// 创建初始的Collection和Document
 	store, err := rag.NewSQLiteVectorStore(db, PLUGIN_RAG_COLLECTION_NAME, "text-embedding-3-small", 1536, nil)
@@ -252,7 +253,7 @@ func TestMUSTPASS_ImportVectorDataFullUpdate(t *testing.T) {
 	}
 	defer db.Close()
 
-	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{})
+	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{}, &schema.VectorStoreTerm{})
 
 	store, err := rag.NewSQLiteVectorStore(db, PLUGIN_RAG_COLLECTION_NAME, "text-embedding-3-small", 1536, nil)
 	if err != nil {
@@ -300,3 +301,54 @@ func TestMUSTPASS_ImportVectorDataFullUpdate(t *testing.T) {
 	assert.Equal(t, 1, len(newDocs))
 	assert.Equal(t, "Yakit 权威使用指南 v1", newDocs[0].DocumentID)
 }
+
+// TestImportVectorData_KeywordSearch 测试导入的数据可以通过关键词检索
+func TestMUSTPASS_ImportVectorData_KeywordSearch(t *testing.T) {
+	db, err := utils.CreateTempTestDatabaseInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	db.AutoMigrate(&schema.VectorStoreCollection{}, &schema.VectorStoreDocument{}, &schema.VectorStoreTerm{})
+
+	store, err := rag.NewSQLiteVectorStore(db, PLUGIN_RAG_COLLECTION_NAME, "text-embedding-3-small", 1536, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.Add(rag.Document{
+		ID:        "log4j",
+		Content:   "Apache Log4j2 JNDI 远程代码执行漏洞",
+		Embedding: []float64{0.1, 0.2, 0.3},
+	}, rag.Document{
+		ID:        "yaklang",
+		Content:   "Yaklang 是一门网络安全领域的编程语言",
+		Embedding: []float64{0.3, 0.2, 0.1},
+	})
+	assert.NoError(t, err)
+
+	tmpFilePath := "/tmp/plugins_rag_keyword_search.zip"
+	err = rag.ExportVectorData(db, PLUGIN_RAG_COLLECTION_NAME, tmpFilePath)
+	assert.NoError(t, err)
+	defer os.Remove(tmpFilePath)
+
+	for _, importFunc := range []func(db *gorm.DB, filepath string) error{
+		rag.ImportVectorData,
+		rag.ImportVectorDataFullUpdate,
+	} {
+		db.Unscoped().Delete(&schema.VectorStoreTerm{})
+		db.Unscoped().Delete(&schema.VectorStoreDocument{})
+		db.Unscoped().Delete(&schema.VectorStoreCollection{})
+
+		err = importFunc(db, tmpFilePath)
+		assert.NoError(t, err)
+
+		store, err = rag.NewSQLiteVectorStore(db, PLUGIN_RAG_COLLECTION_NAME, "text-embedding-3-small", 1536, nil)
+		assert.NoError(t, err)
+		results, err := store.SearchWithOptions("JNDI", 1, 5, rag.WithSearchMode(rag.SearchModeKeyword))
+		assert.NoError(t, err)
+		if assert.Equal(t, 1, len(results)) {
+			assert.Equal(t, "log4j", results[0].Document.ID)
+		}
+	}
+}
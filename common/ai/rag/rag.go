@@ -15,7 +15,7 @@ type Document struct {
 // SearchResult 表示检索结果
 type SearchResult struct {
 	Document Document `json:"document"` // 检索到的文档
-	Score    float64  `json:"score"`    // 相似度得分 (-1 到 1 之间)
+	Score    float64  `json:"score"`    // 得分，向量检索为相似度 (-1 到 1 之间)，关键词检索为 BM25 得分，混合检索为 RRF 得分
 }
 
 // EmbeddingClient 接口定义了嵌入向量生成的操作
@@ -31,6 +31,9 @@ type VectorStore interface {
 	// Search 根据查询文本检索相关文档
 	Search(query string, page, limit int) ([]SearchResult, error)
 
+	// SearchWithOptions 根据查询文本检索相关文档，可以指定检索模式与元数据过滤
+	SearchWithOptions(query string, page, limit int, opts ...SearchOption) ([]SearchResult, error)
+
 	// Delete 根据 ID 删除文档
 	Delete(ids ...string) error
 
@@ -78,6 +81,11 @@ func (r *RAGSystem) Query(query string, page, limit int) ([]SearchResult, error)
 	return r.VectorStore.Search(query, page, limit)
 }
 
+// QueryWithOptions 根据查询文本检索相关文档，可以指定检索模式与元数据过滤
+func (r *RAGSystem) QueryWithOptions(query string, page, limit int, opts ...SearchOption) ([]SearchResult, error) {
+	return r.VectorStore.SearchWithOptions(query, page, limit, opts...)
+}
+
 // DeleteDocuments 删除文档
 func (r *RAGSystem) DeleteDocuments(ids ...string) error {
 	return r.VectorStore.Delete(ids...)
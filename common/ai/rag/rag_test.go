@@ -168,3 +168,146 @@ func TestFilterResults(t *testing.T) {
 	assert.Equal(t, 0.9, filtered[0].Score)
 	assert.Equal(t, 0.7, filtered[1].Score)
 }
+
+func newHybridTestStore(t *testing.T) *MemoryVectorStore {
+	store := NewMemoryVectorStore(&MockEmbedder{})
+	err := store.Add(
+		Document{
+			ID:        "log4j",
+			Content:   "Apache Log4j2 JNDI 远程代码执行漏洞 CVE-2021-44228",
+			Metadata:  map[string]any{"type": "cve", "year": 2021, "tags": []any{"rce", "java"}},
+			Embedding: []float64{0.0, 0.0, 1.0},
+		},
+		Document{
+			ID:        "spring4shell",
+			Content:   "Spring Framework 远程代码执行漏洞 CVE-2022-22965",
+			Metadata:  map[string]any{"type": "cve", "year": 2022, "tags": []any{"rce", "java"}},
+			Embedding: []float64{0.0, 0.1, 0.9},
+		},
+		Document{
+			ID:        "yaklang",
+			Content:   "Yaklang是一种安全研究编程语言",
+			Metadata:  map[string]any{"type": "doc", "year": 2023},
+			Embedding: []float64{1.0, 0.0, 0.0},
+		},
+	)
+	assert.NoError(t, err)
+	return store
+}
+
+// 测试关键词检索、混合检索与元数据过滤
+func TestMemoryVectorStore_HybridSearch(t *testing.T) {
+	store := newHybridTestStore(t)
+
+	// MockEmbedder 对未知查询返回零向量，纯向量检索无法区分 CVE 编号
+	// "cve" 也会命中其他 CVE 文档，但完整编号的得分更高，不含该词的文档不会出现
+	results, err := store.SearchWithOptions("CVE-2022-22965", 1, 5, WithSearchMode(SearchModeKeyword))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, "spring4shell", results[0].Document.ID)
+	assert.Greater(t, results[0].Score, results[1].Score)
+
+	results, err = store.SearchWithOptions("什么是Yaklang", 1, 5, WithSearchMode(SearchModeHybrid))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, "yaklang", results[0].Document.ID)
+
+	results, err = store.SearchWithOptions("远程代码执行", 1, 5, WithSearchMode(SearchModeKeyword), WithMetadataFilter(`type == "cve" && year >= 2022`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "spring4shell", results[0].Document.ID)
+
+	results, err = store.SearchWithOptions("什么是Yaklang", 1, 5, WithMetadataFilter(`tags contains "rce"`))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(results))
+
+	results, err = store.SearchWithOptions("什么是Yaklang", 2, 2, WithSearchMode(SearchModeHybrid))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+
+	// 删除后不再被关键词检索命中
+	assert.NoError(t, store.Delete("spring4shell"))
+	results, err = store.SearchWithOptions("CVE-2022-22965", 1, 5, WithSearchMode(SearchModeKeyword))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "log4j", results[0].Document.ID)
+
+	_, err = store.SearchWithOptions("x", 1, 5, WithMetadataFilter(`type ==`))
+	assert.Error(t, err)
+	_, err = store.SearchWithOptions("x", 1, 5, WithSearchMode("unknown"))
+	assert.Error(t, err)
+}
+
+// 测试倒数排名融合
+func TestFuseRRF(t *testing.T) {
+	a := []SearchResult{{Document: Document{ID: "1"}}, {Document: Document{ID: "2"}}, {Document: Document{ID: "3"}}}
+	b := []SearchResult{{Document: Document{ID: "3"}}, {Document: Document{ID: "2"}}}
+	results := fuseRRF(60, a, b)
+	assert.Equal(t, 3, len(results))
+	// 2 与 3 都出现在两个列表中，得分更高
+	assert.Equal(t, "3", results[0].Document.ID)
+	assert.Equal(t, "2", results[1].Document.ID)
+	assert.Equal(t, "1", results[2].Document.ID)
+	assert.InDelta(t, 1.0/61+1.0/63, results[0].Score, 1e-9)
+}
+
+// 测试分词
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize("Log4j CVE-2021-44228 远程执行 com.example.Foo_bar")
+	assert.Contains(t, tokens, "log4j")
+	assert.Contains(t, tokens, "cve-2021-44228")
+	assert.Contains(t, tokens, "44228")
+	assert.Contains(t, tokens, "远程")
+	assert.Contains(t, tokens, "执")
+	assert.Contains(t, tokens, "com.example.foo_bar")
+	assert.Contains(t, tokens, "foo_bar")
+}
+
+// 测试按句子分块
+func TestChunkTextBySentence(t *testing.T) {
+	text := "第一句话。第二句话比较长一些！第三句？Fourth sentence here. 第五句"
+	chunks := ChunkTextBySentence(text, 10, 0)
+	assert.True(t, len(chunks) > 1)
+	for _, chunk := range chunks {
+		assert.LessOrEqual(t, len([]rune(chunk)), 10)
+	}
+	assert.Equal(t, "第一句话。", chunks[0])
+
+	chunks = ChunkTextBySentence(text, 10, 3)
+	for i := 1; i < len(chunks); i++ {
+		prev := []rune(chunks[i-1])
+		assert.Contains(t, chunks[i], string(prev[len(prev)-1:]))
+	}
+
+	assert.Equal(t, []string{"short"}, ChunkTextBySentence(" short ", 10, 0))
+	assert.Empty(t, ChunkTextBySentence("  ", 10, 0))
+}
+
+// 测试长文本写入
+func TestIngestText(t *testing.T) {
+	mockEmbed := &MockEmbedder{}
+	ragSystem := NewRAGSystem(mockEmbed, NewMemoryVectorStore(mockEmbed))
+
+	text := "Log4j2 存在 JNDI 注入。攻击者可以远程执行代码。影响版本 2.0 到 2.14.1。"
+	ids, err := IngestText(ragSystem, "cve-2021-44228", text, 16, 0, map[string]any{"type": "cve"})
+	assert.NoError(t, err)
+	assert.True(t, len(ids) > 1)
+	assert.Equal(t, "cve-2021-44228#0", ids[0])
+
+	count, err := ragSystem.CountDocuments()
+	assert.NoError(t, err)
+	assert.Equal(t, len(ids), count)
+
+	results, err := ragSystem.QueryWithOptions("JNDI", 1, 5, WithSearchMode(SearchModeKeyword), WithMetadataFilter(`doc_id == "cve-2021-44228"`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "cve", results[0].Document.Metadata["type"])
+
+	// 重新写入会替换旧的分块
+	ids, err = IngestText(ragSystem, "cve-2021-44228", "Log4j2 JNDI 注入", 16, 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ids))
+	count, err = ragSystem.CountDocuments()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
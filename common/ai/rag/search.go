@@ -0,0 +1,173 @@
+package rag
+
+import (
+	"sort"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// SearchMode 检索模式
+type SearchMode string
+
+const (
+	// SearchModeVector 向量相似度检索，得分为余弦相似度
+	SearchModeVector SearchMode = "vector"
+	// SearchModeKeyword BM25 关键词检索，适合 CVE 编号、函数名、插件名等精确词
+	SearchModeKeyword SearchMode = "keyword"
+	// SearchModeHybrid 使用倒数排名融合 (RRF) 合并向量与关键词检索的结果
+	SearchModeHybrid SearchMode = "hybrid"
+)
+
+const defaultRRFK = 60
+
+// SearchConfig 检索配置
+type SearchConfig struct {
+	Mode   SearchMode
+	Filter *MetadataFilter
+	// RRFK 为倒数排名融合的平滑参数，得分为 sum(1 / (k + rank))
+	RRFK int
+
+	filterErr error
+}
+
+type SearchOption func(*SearchConfig)
+
+// WithSearchMode 设置检索模式，默认为向量检索
+func WithSearchMode(mode SearchMode) SearchOption {
+	return func(c *SearchConfig) {
+		c.Mode = mode
+	}
+}
+
+// WithMetadataFilter 设置元数据过滤表达式，见 ParseMetadataFilter
+func WithMetadataFilter(expr string) SearchOption {
+	return func(c *SearchConfig) {
+		c.Filter, c.filterErr = ParseMetadataFilter(expr)
+	}
+}
+
+// WithRRFK 设置倒数排名融合的平滑参数
+func WithRRFK(k int) SearchOption {
+	return func(c *SearchConfig) {
+		c.RRFK = k
+	}
+}
+
+func NewSearchConfig(opts ...SearchOption) (*SearchConfig, error) {
+	config := &SearchConfig{
+		Mode: SearchModeVector,
+		RRFK: defaultRRFK,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.filterErr != nil {
+		return nil, config.filterErr
+	}
+	switch config.Mode {
+	case "":
+		config.Mode = SearchModeVector
+	case SearchModeVector, SearchModeKeyword, SearchModeHybrid:
+	default:
+		return nil, utils.Errorf("unsupported search mode: %v", config.Mode)
+	}
+	if config.RRFK <= 0 {
+		config.RRFK = defaultRRFK
+	}
+	return config, nil
+}
+
+func (c *SearchConfig) needVector() bool {
+	return c.Mode == SearchModeVector || c.Mode == SearchModeHybrid
+}
+
+func (c *SearchConfig) needKeyword() bool {
+	return c.Mode == SearchModeKeyword || c.Mode == SearchModeHybrid
+}
+
+// rankDocuments 按检索模式对已经过滤的文档排序
+// queryEmbedding 仅在需要向量检索时使用，keywordScores 为 BM25 得分
+func rankDocuments(config *SearchConfig, docs []Document, queryEmbedding []float64, keywordScores map[string]float64) []SearchResult {
+	var vectorResults, keywordResults []SearchResult
+	if config.needVector() {
+		for _, doc := range docs {
+			similarity, err := utils.CosineSimilarity(queryEmbedding, doc.Embedding)
+			if err != nil {
+				log.Warnf("计算文档 %s 的相似度失败: %v", doc.ID, err)
+				continue
+			}
+			vectorResults = append(vectorResults, SearchResult{Document: doc, Score: similarity})
+		}
+		sort.SliceStable(vectorResults, func(i, j int) bool {
+			return vectorResults[i].Score > vectorResults[j].Score
+		})
+	}
+	if config.needKeyword() {
+		docMap := make(map[string]Document, len(docs))
+		for _, doc := range docs {
+			docMap[doc.ID] = doc
+		}
+		for _, id := range rankByScores(keywordScores) {
+			if doc, ok := docMap[id]; ok {
+				keywordResults = append(keywordResults, SearchResult{Document: doc, Score: keywordScores[id]})
+			}
+		}
+	}
+
+	switch config.Mode {
+	case SearchModeKeyword:
+		return keywordResults
+	case SearchModeHybrid:
+		return fuseRRF(config.RRFK, vectorResults, keywordResults)
+	default:
+		return vectorResults
+	}
+}
+
+// fuseRRF 使用倒数排名融合合并多个有序结果
+func fuseRRF(k int, lists ...[]SearchResult) []SearchResult {
+	scores := make(map[string]float64)
+	docs := make(map[string]Document)
+	for _, list := range lists {
+		for rank, result := range list {
+			scores[result.Document.ID] += 1 / float64(k+rank+1)
+			docs[result.Document.ID] = result.Document
+		}
+	}
+	var results []SearchResult
+	for _, id := range rankByScores(scores) {
+		results = append(results, SearchResult{Document: docs[id], Score: scores[id]})
+	}
+	return results
+}
+
+func filterDocuments(filter *MetadataFilter, docs []Document) []Document {
+	if filter == nil || filter.root == nil {
+		return docs
+	}
+	var filtered []Document
+	for _, doc := range docs {
+		if filter.Match(doc.Metadata) {
+			filtered = append(filtered, doc)
+		}
+	}
+	return filtered
+}
+
+func paginateResults(results []SearchResult, page, limit int) []SearchResult {
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		return results
+	}
+	offset := (page - 1) * limit
+	if offset >= len(results) {
+		return []SearchResult{}
+	}
+	if offset+limit > len(results) {
+		limit = len(results) - offset
+	}
+	return results[offset : offset+limit]
+}
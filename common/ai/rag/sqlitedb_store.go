@@ -1,12 +1,10 @@
 package rag
 
 import (
-	"sort"
 	"sync"
 
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
-	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
 )
@@ -67,6 +65,9 @@ func RemoveCollection(db *gorm.DB, collectionName string) error {
 		if err := tx.Model(&schema.VectorStoreDocument{}).Where("collection_id = ?", collection.ID).Unscoped().Delete(&schema.VectorStoreDocument{}).Error; err != nil {
 			return err
 		}
+		if err := tx.Model(&schema.VectorStoreTerm{}).Where("collection_id = ?", collection.ID).Unscoped().Delete(&schema.VectorStoreTerm{}).Error; err != nil {
+			return err
+		}
 		if err := tx.Model(&schema.VectorStoreCollection{}).Where("id = ?", collection.ID).Unscoped().Delete(&schema.VectorStoreCollection{}).Error; err != nil {
 			return err
 		}
@@ -81,6 +82,7 @@ func (s *SQLiteVectorStore) Remove() {
 func (s *SQLiteVectorStore) toDocument(doc *schema.VectorStoreDocument) Document {
 	return Document{
 		ID:        doc.DocumentID,
+		Content:   doc.Content,
 		Metadata:  map[string]any(doc.Metadata),
 		Embedding: []float64(doc.Embedding),
 	}
@@ -93,7 +95,105 @@ func (s *SQLiteVectorStore) toSchemaDocument(doc Document) *schema.VectorStoreDo
 		CollectionID: s.collectionID,
 		Metadata:     schema.MetadataMap(doc.Metadata),
 		Embedding:    schema.FloatArray(doc.Embedding),
+		Content:      doc.Content,
+	}
+}
+
+// saveTerms 重建文档的关键词倒排索引，返回文档的词数
+func (s *SQLiteVectorStore) saveTerms(tx *gorm.DB, docID string, content string) (int, error) {
+	return saveDocumentTerms(tx, s.collectionID, docID, content)
+}
+
+func saveDocumentTerms(tx *gorm.DB, collectionID uint, docID string, content string) (int, error) {
+	if err := tx.Unscoped().Where("collection_id = ? AND document_id = ?", collectionID, docID).Delete(&schema.VectorStoreTerm{}).Error; err != nil {
+		return 0, utils.Errorf("删除文档 %s 的索引失败: %v", docID, err)
+	}
+	tf, length := termFrequency(content)
+	for term, count := range tf {
+		if err := tx.Create(&schema.VectorStoreTerm{
+			CollectionID: collectionID,
+			DocumentID:   docID,
+			Term:         term,
+			Frequency:    count,
+		}).Error; err != nil {
+			return 0, utils.Errorf("创建文档 %s 的索引失败: %v", docID, err)
+		}
+	}
+	return length, nil
+}
+
+// keywordScores 使用倒排索引计算 BM25 得分，docs 为集合中的全部文档
+func (s *SQLiteVectorStore) keywordScores(query string, docs []schema.VectorStoreDocument) (map[string]float64, error) {
+	scores := make(map[string]float64)
+	if len(docs) == 0 {
+		return scores, nil
+	}
+	queryTerms, _ := termFrequency(query)
+	if len(queryTerms) == 0 {
+		return scores, nil
+	}
+	terms := make([]string, 0, len(queryTerms))
+	for term := range queryTerms {
+		terms = append(terms, term)
+	}
+
+	var postings []schema.VectorStoreTerm
+	if err := s.db.Where("collection_id = ? AND term IN (?)", s.collectionID, terms).Find(&postings).Error; err != nil {
+		return nil, utils.Errorf("查询关键词索引失败: %v", err)
+	}
+
+	docLen := make(map[string]int, len(docs))
+	totalLen := 0
+	for _, doc := range docs {
+		docLen[doc.DocumentID] = doc.TermCount
+		totalLen += doc.TermCount
+	}
+	avgdl := float64(totalLen) / float64(len(docs))
+	df := make(map[string]int)
+	for _, posting := range postings {
+		if _, ok := docLen[posting.DocumentID]; ok {
+			df[posting.Term]++
+		}
+	}
+	for _, posting := range postings {
+		dl, ok := docLen[posting.DocumentID]
+		if !ok {
+			continue
+		}
+		scores[posting.DocumentID] += bm25TermScore(posting.Frequency, df[posting.Term], len(docs), float64(dl), avgdl)
+	}
+	return scores, nil
+}
+
+// RebuildKeywordIndex 重建集合中全部文档的关键词索引，用于旧版本数据升级，导入数据时会自动重建
+func (s *SQLiteVectorStore) RebuildKeywordIndex() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return utils.GormTransaction(s.db, func(tx *gorm.DB) error {
+		return rebuildKeywordIndex(tx, s.collectionID)
+	})
+}
+
+func rebuildKeywordIndex(tx *gorm.DB, collectionID uint) error {
+	// 清理已经不存在的文档的索引
+	if err := tx.Unscoped().Where("collection_id = ?", collectionID).Delete(&schema.VectorStoreTerm{}).Error; err != nil {
+		return utils.Errorf("删除集合索引失败: %v", err)
+	}
+	var docs []schema.VectorStoreDocument
+	if err := tx.Where("collection_id = ?", collectionID).Find(&docs).Error; err != nil {
+		return utils.Errorf("查询文档失败: %v", err)
+	}
+	for _, doc := range docs {
+		length, err := saveDocumentTerms(tx, collectionID, doc.DocumentID, doc.Content)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&schema.VectorStoreDocument{}).Where("id = ?", doc.ID).Update("term_count", length).Error; err != nil {
+			return utils.Errorf("更新文档 %s 失败: %v", doc.DocumentID, err)
+		}
 	}
+	return nil
 }
 
 // Add 添加文档到向量存储
@@ -127,11 +227,19 @@ func (s *SQLiteVectorStore) Add(docs ...Document) error {
 		result := tx.Where("document_id = ?", doc.ID).First(&existingDoc)
 
 		schemaDoc := s.toSchemaDocument(doc)
+		termCount, err := s.saveTerms(tx, doc.ID, doc.Content)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		schemaDoc.TermCount = termCount
 
 		if result.Error == nil {
 			// 更新现有文档
 			existingDoc.Metadata = schemaDoc.Metadata
 			existingDoc.Embedding = schemaDoc.Embedding
+			existingDoc.Content = schemaDoc.Content
+			existingDoc.TermCount = schemaDoc.TermCount
 
 			if err := tx.Save(&existingDoc).Error; err != nil {
 				tx.Rollback()
@@ -156,13 +264,26 @@ func (s *SQLiteVectorStore) Add(docs ...Document) error {
 
 // Search 根据查询文本检索相关文档
 func (s *SQLiteVectorStore) Search(query string, page, limit int) ([]SearchResult, error) {
+	return s.SearchWithOptions(query, page, limit)
+}
+
+// SearchWithOptions 根据查询文本检索相关文档，可以指定检索模式与元数据过滤
+func (s *SQLiteVectorStore) SearchWithOptions(query string, page, limit int, opts ...SearchOption) ([]SearchResult, error) {
+	config, err := NewSearchConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	// 生成查询的嵌入向量
-	queryEmbedding, err := s.embedder.Embedding(query)
-	if err != nil {
-		return nil, utils.Errorf("为查询生成嵌入向量失败: %v", err)
+	var queryEmbedding []float64
+	if config.needVector() {
+		queryEmbedding, err = s.embedder.Embedding(query)
+		if err != nil {
+			return nil, utils.Errorf("为查询生成嵌入向量失败: %v", err)
+		}
 	}
 
 	// 获取所有文档
@@ -175,40 +296,19 @@ func (s *SQLiteVectorStore) Search(query string, page, limit int) ([]SearchResul
 		return []SearchResult{}, nil
 	}
 
-	// 计算相似度并排序
-	var results []SearchResult
-	for _, doc := range docs {
-		embedding := []float64(doc.Embedding)
-
-		// 计算余弦相似度
-		similarity, err := utils.CosineSimilarity(queryEmbedding, embedding)
+	var keywordScores map[string]float64
+	if config.needKeyword() {
+		keywordScores, err = s.keywordScores(query, docs)
 		if err != nil {
-			log.Warnf("计算文档 %s 的相似度失败: %v", doc.DocumentID, err)
-			continue
+			return nil, err
 		}
-
-		results = append(results, SearchResult{
-			Document: s.toDocument(&doc),
-			Score:    similarity,
-		})
 	}
 
-	// 按相似度降序排序
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Score > results[j].Score
-	})
-
-	// 计算分页
-	offset := (page - 1) * limit
-	if offset >= len(results) {
-		return []SearchResult{}, nil
-	}
-	if offset+limit > len(results) {
-		limit = len(results) - offset
+	results := make([]Document, 0, len(docs))
+	for i := range docs {
+		results = append(results, s.toDocument(&docs[i]))
 	}
-	results = results[offset : offset+limit]
-
-	return results, nil
+	return paginateResults(rankDocuments(config, filterDocuments(config.Filter, results), queryEmbedding, keywordScores), page, limit), nil
 }
 
 // Delete 根据 ID 删除文档
@@ -228,6 +328,10 @@ func (s *SQLiteVectorStore) Delete(ids ...string) error {
 			tx.Rollback()
 			return utils.Errorf("删除文档 %s 失败: %v", id, err)
 		}
+		if err := tx.Unscoped().Where("collection_id = ? AND document_id = ?", s.collectionID, id).Delete(&schema.VectorStoreTerm{}).Error; err != nil {
+			tx.Rollback()
+			return utils.Errorf("删除文档 %s 的索引失败: %v", id, err)
+		}
 	}
 
 	return tx.Commit().Error
@@ -70,3 +70,60 @@ func TestSQLiteVectorStore(t *testing.T) {
 	assert.Equal(t, 1, len(docs))
 	assert.Equal(t, "doc2", docs[0].ID)
 }
+
+// 测试 SQLiteVectorStore 的关键词检索与元数据过滤
+func TestSQLiteVectorStore_HybridSearch(t *testing.T) {
+	mockEmbed := &MockEmbedder{}
+
+	db := consts.GetGormProfileDatabase()
+	store, err := NewSQLiteVectorStore(db, "test_hybrid_collection", "Qwen3-Embedding-0.6B-Q8_0", 1024, mockEmbed)
+	assert.NoError(t, err)
+	defer store.Remove()
+
+	err = store.Add(
+		Document{
+			ID:        "log4j",
+			Content:   "Apache Log4j2 JNDI 远程代码执行漏洞 CVE-2021-44228",
+			Metadata:  map[string]any{"type": "cve", "year": 2021},
+			Embedding: []float64{0.0, 0.0, 1.0},
+		},
+		Document{
+			ID:        "yaklang",
+			Content:   "Yaklang是一种安全研究编程语言",
+			Metadata:  map[string]any{"type": "doc", "year": 2023},
+			Embedding: []float64{1.0, 0.0, 0.0},
+		},
+	)
+	assert.NoError(t, err)
+
+	doc, exists, err := store.Get("log4j")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "Apache Log4j2 JNDI 远程代码执行漏洞 CVE-2021-44228", doc.Content)
+
+	results, err := store.SearchWithOptions("cve-2021-44228", 1, 5, WithSearchMode(SearchModeKeyword))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "log4j", results[0].Document.ID)
+
+	results, err = store.SearchWithOptions("什么是Yaklang", 1, 5, WithSearchMode(SearchModeHybrid))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, "yaklang", results[0].Document.ID)
+
+	results, err = store.SearchWithOptions("什么是Yaklang", 1, 5, WithMetadataFilter(`type == "cve"`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, "log4j", results[0].Document.ID)
+
+	// 重建索引后结果不变
+	assert.NoError(t, store.RebuildKeywordIndex())
+	results, err = store.SearchWithOptions("JNDI", 1, 5, WithSearchMode(SearchModeKeyword))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+
+	assert.NoError(t, store.Delete("log4j"))
+	results, err = store.SearchWithOptions("JNDI", 1, 5, WithSearchMode(SearchModeKeyword))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(results))
+}
@@ -24,6 +24,8 @@ type ExportVectorStoreDocument struct {
 	DocumentID string                 `json:"document_id"`
 	Metadata   map[string]interface{} `json:"metadata"`
 	Embedding  []float64              `json:"embedding"`
+	Content    string                 `json:"content,omitempty"`
+	TermCount  int                    `json:"term_count,omitempty"`
 }
 
 func ImportVectorData(db *gorm.DB, filepath string) error {
@@ -38,11 +40,13 @@ func ImportVectorData(db *gorm.DB, filepath string) error {
 				DocumentID:   v.DocumentID,
 				Metadata:     v.Metadata,
 				Embedding:    v.Embedding,
+				Content:      v.Content,
+				TermCount:    v.TermCount,
 				CollectionID: collectionId,
 			}
 			return collection, nil
 		}
-		return bizhelper.ImportTableZipWithMarshalFunc(context.Background(), tx, filepath, unmarshalFunc, bizhelper.WithMetaDataHandler(func(metaData bizhelper.MetaData) error {
+		err := bizhelper.ImportTableZipWithMarshalFunc(context.Background(), tx, filepath, unmarshalFunc, bizhelper.WithMetaDataHandler(func(metaData bizhelper.MetaData) error {
 			collectionName := metaData["collection_name"].(string)
 			collectionDescription := metaData["collection_description"].(string)
 			collectionModelName := metaData["collection_model_name"].(string)
@@ -71,6 +75,11 @@ func ImportVectorData(db *gorm.DB, filepath string) error {
 			collectionId = cs[0].ID
 			return nil
 		}), bizhelper.WithImportUniqueIndexField("DocumentID"), bizhelper.WithImportAllowOverwrite(true))
+		if err != nil {
+			return err
+		}
+		// 导入的文档没有关键词索引，需要重建以支持关键词与混合检索
+		return rebuildKeywordIndex(tx, collectionId)
 	})
 
 }
@@ -101,6 +110,8 @@ func ExportVectorData(db *gorm.DB, collectionName string, filepath string) error
 			DocumentID: v.DocumentID,
 			Metadata:   v.Metadata,
 			Embedding:  v.Embedding,
+			Content:    v.Content,
+			TermCount:  v.TermCount,
 		})
 	}
 	return bizhelper.ExportTableZipWithMarshalFunc(context.Background(), exportDB, filepath, marshalFunc, opts...)
@@ -118,11 +129,13 @@ func ImportVectorDataFullUpdate(db *gorm.DB, filepath string) error {
 				DocumentID:   v.DocumentID,
 				Metadata:     v.Metadata,
 				Embedding:    v.Embedding,
+				Content:      v.Content,
+				TermCount:    v.TermCount,
 				CollectionID: collectionId,
 			}
 			return collection, nil
 		}
-		return bizhelper.ImportTableZipWithMarshalFunc(context.Background(), tx, filepath, unmarshalFunc, bizhelper.WithMetaDataHandler(func(metaData bizhelper.MetaData) error {
+		err := bizhelper.ImportTableZipWithMarshalFunc(context.Background(), tx, filepath, unmarshalFunc, bizhelper.WithMetaDataHandler(func(metaData bizhelper.MetaData) error {
 			collectionName := metaData["collection_name"].(string)
 			collectionDescription := metaData["collection_description"].(string)
 			collectionModelName := metaData["collection_model_name"].(string)
@@ -140,6 +153,10 @@ func ImportVectorDataFullUpdate(db *gorm.DB, filepath string) error {
 				if err != nil {
 					return err
 				}
+				err = tx.Unscoped().Model(&schema.VectorStoreTerm{}).Where("collection_id = ?", collectionId).Delete(&schema.VectorStoreTerm{}).Error
+				if err != nil {
+					return err
+				}
 				err = tx.Unscoped().Model(&schema.VectorStoreCollection{}).Where("id = ?", collectionId).Delete(&schema.VectorStoreCollection{}).Error
 				if err != nil {
 					return err
@@ -169,5 +186,10 @@ func ImportVectorDataFullUpdate(db *gorm.DB, filepath string) error {
 			collectionId = cs[0].ID
 			return nil
 		}), bizhelper.WithImportUniqueIndexField("DocumentID"), bizhelper.WithImportAllowOverwrite(true))
+		if err != nil {
+			return err
+		}
+		// 导入的文档没有关键词索引，需要重建以支持关键词与混合检索
+		return rebuildKeywordIndex(tx, collectionId)
 	})
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/yaklang/yaklang/common/utils"
 )
 
 // ChunkText 将长文本分割成多个小块，以便于处理和嵌入
@@ -76,6 +77,130 @@ func TextToDocuments(text string, maxChunkSize int, overlap int, metadata map[st
 	return docs
 }
 
+// ChunkTextBySentence 按段落与句子将长文本分割成不超过 maxChunkSize 个字符的块，适用于中文等不以空格分词的文本
+// 相邻块之间保留 overlap 个字符的重叠，超过块大小的句子会被直接截断
+func ChunkTextBySentence(text string, maxChunkSize int, overlap int) []string {
+	if maxChunkSize <= 0 {
+		maxChunkSize = 1000 // 默认块大小
+	}
+	if overlap < 0 {
+		overlap = 0
+	}
+	if overlap >= maxChunkSize {
+		overlap = maxChunkSize / 2
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+	if len([]rune(text)) <= maxChunkSize {
+		return []string{text}
+	}
+
+	// 先按句子切分，句子保留结尾的标点
+	var sentences []string
+	var current []rune
+	for _, r := range []rune(text) {
+		current = append(current, r)
+		switch r {
+		case '\n', '。', '！', '？', '；', '.', '!', '?', ';':
+			if sentence := strings.TrimSpace(string(current)); sentence != "" {
+				sentences = append(sentences, string(current))
+			}
+			current = current[:0]
+		}
+	}
+	if strings.TrimSpace(string(current)) != "" {
+		sentences = append(sentences, string(current))
+	}
+
+	var chunks []string
+	var chunk []rune
+	flush := func() {
+		if strings.TrimSpace(string(chunk)) == "" {
+			chunk = chunk[:0]
+			return
+		}
+		chunks = append(chunks, strings.TrimSpace(string(chunk)))
+		if overlap > 0 && len(chunk) > overlap {
+			chunk = append([]rune{}, chunk[len(chunk)-overlap:]...)
+		} else {
+			chunk = chunk[:0]
+		}
+	}
+	for _, sentence := range sentences {
+		runes := []rune(sentence)
+		for len(runes) > 0 {
+			space := maxChunkSize - len(chunk)
+			if len(runes) <= space {
+				chunk = append(chunk, runes...)
+				break
+			}
+			if len(chunk) > overlap {
+				// 当前块已经有内容，先结束当前块，句子放到下一块
+				flush()
+				continue
+			}
+			// 句子本身超过块大小，直接截断
+			chunk = append(chunk, runes[:space]...)
+			runes = runes[space:]
+			flush()
+		}
+	}
+	if len(chunk) > overlap || len(chunks) == 0 {
+		flush()
+	}
+	return chunks
+}
+
+// IngestText 将长文本分块后写入 RAG 系统，分块的 ID 为 {docID}#{chunk_index}
+// 重复写入同一个 docID 会先删除旧的分块，元数据中会记录 doc_id、chunk_index 与 total_chunks
+func IngestText(rag *RAGSystem, docID string, text string, maxChunkSize int, overlap int, metadata map[string]any) ([]string, error) {
+	if docID == "" {
+		return nil, utils.Error("document id is empty")
+	}
+
+	existed, err := rag.ListDocuments()
+	if err != nil {
+		return nil, utils.Errorf("list documents failed: %v", err)
+	}
+	var staleIDs []string
+	for _, doc := range existed {
+		if utils.InterfaceToString(doc.Metadata["doc_id"]) == docID {
+			staleIDs = append(staleIDs, doc.ID)
+		}
+	}
+	if len(staleIDs) > 0 {
+		if err := rag.DeleteDocuments(staleIDs...); err != nil {
+			return nil, utils.Errorf("delete stale chunks of %v failed: %v", docID, err)
+		}
+	}
+
+	chunks := ChunkTextBySentence(text, maxChunkSize, overlap)
+	docs := make([]Document, len(chunks))
+	ids := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		doc := Document{
+			ID:       fmt.Sprintf("%s#%d", docID, i),
+			Content:  chunk,
+			Metadata: make(map[string]any),
+		}
+		for k, v := range metadata {
+			doc.Metadata[k] = v
+		}
+		doc.Metadata["doc_id"] = docID
+		doc.Metadata["chunk_index"] = i
+		doc.Metadata["total_chunks"] = len(chunks)
+		docs[i] = doc
+		ids[i] = doc.ID
+	}
+	if err := rag.AddDocuments(docs...); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // FormatRagPrompt 格式化 RAG 提示，结合用户问题和检索到的文档
 func FormatRagPrompt(query string, results []SearchResult, promptTemplate string) string {
 	if promptTemplate == "" {
@@ -14,11 +14,17 @@ import (
 type ModelConfig struct {
 	Name      string            `yaml:"name" json:"name"`
 	Providers []*ConfigProvider `yaml:"providers" json:"providers"`
+	// Price per 1M tokens, used for cost accounting
+	InputPrice  float64 `yaml:"input_price,omitempty" json:"input_price,omitempty"`
+	OutputPrice float64 `yaml:"output_price,omitempty" json:"output_price,omitempty"`
 }
 
 type KeyConfig struct {
 	Key           string   `yaml:"key" json:"key"`
 	AllowedModels []string `yaml:"allowed_models" json:"allowed_models"`
+	// Quotas, 0 means unlimited
+	RPMLimit        int64 `yaml:"rpm_limit,omitempty" json:"rpm_limit,omitempty"`
+	DailyTokenLimit int64 `yaml:"daily_token_limit,omitempty" json:"daily_token_limit,omitempty"`
 }
 
 type Config struct {
@@ -69,8 +75,10 @@ func (c *YamlConfig) ToServerConfig() (*ServerConfig, error) {
 
 		// Set up API key
 		key := &Key{
-			Key:           keyConfig.Key,
-			AllowedModels: make(map[string]bool),
+			Key:             keyConfig.Key,
+			AllowedModels:   make(map[string]bool),
+			RPMLimit:        keyConfig.RPMLimit,
+			DailyTokenLimit: keyConfig.DailyTokenLimit,
 		}
 		for _, model := range keyConfig.AllowedModels {
 			key.AllowedModels[model] = true
@@ -85,6 +93,9 @@ func (c *YamlConfig) ToServerConfig() (*ServerConfig, error) {
 	// Process model configurations
 	for i, model := range c.Models {
 		log.Debugf("YamlConfig.ToServerConfig: Processing model %d: %s with %d providers", i, model.Name, len(model.Providers))
+		if model.InputPrice > 0 || model.OutputPrice > 0 {
+			config.ModelPrices[model.Name] = &ModelPrice{InputPrice: model.InputPrice, OutputPrice: model.OutputPrice}
+		}
 		// Get all providers for this model
 		var providers []*Provider
 
@@ -259,3 +259,67 @@ func UpdateAiApiKeyAllowedModels(id uint, allowedModels string) error {
 
 	return nil
 }
+
+// RecordAiApiKeyUsage 保存一次请求的消耗记录，并累加到 API Key 的 token 与费用统计中
+func RecordAiApiKeyUsage(usage *schema.AiApiKeyUsage) error {
+	if usage == nil {
+		return fmt.Errorf("usage is nil")
+	}
+	if err := GetDB().Create(usage).Error; err != nil {
+		return fmt.Errorf("Failed to save API key usage: %v", err)
+	}
+	return GetDB().Model(&schema.AiApiKeys{}).Where("api_key = ?", usage.APIKey).Updates(map[string]any{
+		"input_tokens":  gorm.Expr("input_tokens + ?", usage.InputTokens),
+		"output_tokens": gorm.Expr("output_tokens + ?", usage.OutputTokens),
+		"total_cost":    gorm.Expr("total_cost + ?", usage.Cost),
+	}).Error
+}
+
+// GetAiApiKeyTokensSince 统计 API Key 自 since 以来消耗的 token 数
+func GetAiApiKeyTokensSince(apiKey string, since time.Time) (int64, error) {
+	var result struct {
+		Total int64
+	}
+	err := GetDB().Model(&schema.AiApiKeyUsage{}).
+		Select("COALESCE(SUM(total_tokens), 0) AS total").
+		Where("api_key = ? AND created_at >= ?", apiKey, since).
+		Scan(&result).Error
+	if err != nil {
+		return 0, err
+	}
+	return result.Total, nil
+}
+
+// GetAiApiKeyUsageSince 获取 API Key 自 since 以来的消耗记录，按时间升序
+func GetAiApiKeyUsageSince(apiKey string, since time.Time) ([]*schema.AiApiKeyUsage, error) {
+	var usages []*schema.AiApiKeyUsage
+	if err := GetDB().Where("api_key = ? AND created_at >= ?", apiKey, since).
+		Order("created_at asc").Find(&usages).Error; err != nil {
+		return nil, err
+	}
+	return usages, nil
+}
+
+// GetAiApiKeyByID gets API key by ID
+func GetAiApiKeyByID(id uint) (*schema.AiApiKeys, error) {
+	var key schema.AiApiKeys
+	if err := GetDB().Where("id = ?", id).First(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// UpdateAiApiKeyLimits 更新 API Key 的配额，0 表示不限制
+func UpdateAiApiKeyLimits(id uint, rpmLimit, dailyTokenLimit int64) error {
+	result := GetDB().Model(&schema.AiApiKeys{}).Where("id = ?", id).Updates(map[string]any{
+		"rpm_limit":         rpmLimit,
+		"daily_token_limit": dailyTokenLimit,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
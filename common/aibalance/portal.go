@@ -72,6 +72,11 @@ type APIKeyData struct {
 	InputBytesFormatted  string
 	OutputBytesFormatted string
 	Active               bool
+	InputTokens          int64
+	OutputTokens         int64
+	TotalCost            string
+	RPMLimit             int64
+	DailyTokenLimit      int64
 }
 
 // PortalData contains all data for the management panel page
@@ -431,6 +436,11 @@ func (c *ServerConfig) servePortal(conn net.Conn) {
 				InputBytesFormatted:  inputBytesFormatted,
 				OutputBytesFormatted: outputBytesFormatted,
 				Active:               apiKey.Active,
+				InputTokens:          apiKey.InputTokens,
+				OutputTokens:         apiKey.OutputTokens,
+				TotalCost:            fmt.Sprintf("%.4f", apiKey.TotalCost),
+				RPMLimit:             apiKey.RPMLimit,
+				DailyTokenLimit:      apiKey.DailyTokenLimit,
 			}
 
 			// 设置最后使用时间
@@ -1008,6 +1018,10 @@ func (c *ServerConfig) HandlePortalRequest(conn net.Conn, request *http.Request,
 		c.handleBatchToggleAPIKeyStatus(conn, request, false)
 	} else if strings.HasPrefix(uriIns.Path, "/portal/update-api-key-allowed-models/") && request.Method == "POST" {
 		c.handleUpdateAPIKeyAllowedModels(conn, request, uriIns.Path)
+	} else if strings.HasPrefix(uriIns.Path, "/portal/update-api-key-limits/") && request.Method == "POST" {
+		c.handleUpdateAPIKeyLimits(conn, request, uriIns.Path)
+	} else if strings.HasPrefix(uriIns.Path, "/portal/api/key-usage/") {
+		c.serveAPIKeyUsageAPI(conn, request, uriIns)
 	} else {
 		// Default return home page
 		c.servePortalWithAuth(conn)
@@ -1953,3 +1967,175 @@ func (c *ServerConfig) handleUpdateAPIKeyAllowedModels(conn net.Conn, request *h
 		"message": "API key allowed models updated successfully",
 	})
 }
+
+// parseAPIKeyIDFromPath extracts the API key ID from the last segment of path
+func parseAPIKeyIDFromPath(path string) (uint, error) {
+	parts := strings.Split(strings.TrimRight(path, "/"), "/")
+	id, err := strconv.ParseUint(parts[len(parts)-1], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// handleUpdateAPIKeyLimits handles requests to update quotas of an API key
+// Example: POST /portal/update-api-key-limits/123 {"rpm_limit": 60, "daily_token_limit": 1000000}
+func (c *ServerConfig) handleUpdateAPIKeyLimits(conn net.Conn, request *http.Request, path string) {
+	c.logInfo("Processing update API key limits request: %s", path)
+
+	id, err := parseAPIKeyIDFromPath(path)
+	if err != nil {
+		c.logError("Invalid API key ID in path '%s': %v", path, err)
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid API key ID format",
+		})
+		return
+	}
+
+	var reqBody struct {
+		RPMLimit        int64 `json:"rpm_limit"`
+		DailyTokenLimit int64 `json:"daily_token_limit"`
+	}
+	bodyBytes, err := io.ReadAll(request.Body)
+	if err != nil {
+		c.logError("Failed to read request body for updating API key limits: %v", err)
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Failed to read request body",
+		})
+		return
+	}
+	defer request.Body.Close()
+	if err := json.Unmarshal(bodyBytes, &reqBody); err != nil || reqBody.RPMLimit < 0 || reqBody.DailyTokenLimit < 0 {
+		c.logError("Invalid request body for updating API key limits: %s", string(bodyBytes))
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid request body format",
+		})
+		return
+	}
+
+	if err := UpdateAiApiKeyLimits(id, reqBody.RPMLimit, reqBody.DailyTokenLimit); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.writeJSONResponse(conn, http.StatusNotFound, map[string]interface{}{
+				"success": false,
+				"message": "API key not found",
+			})
+		} else {
+			c.logError("Failed to update limits for API key (ID: %d): %v", id, err)
+			c.writeJSONResponse(conn, http.StatusInternalServerError, map[string]interface{}{
+				"success": false,
+				"message": "Failed to update API key limits",
+			})
+		}
+		return
+	}
+	c.logInfo("Successfully updated limits for API key (ID: %d): rpm=%d, daily tokens=%d", id, reqBody.RPMLimit, reqBody.DailyTokenLimit)
+
+	if err := c.LoadAPIKeysFromDB(); err != nil {
+		c.logError("Failed to reload API keys into memory after updating limits for key ID %d: %v", id, err)
+	}
+
+	c.writeJSONResponse(conn, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": "API key limits updated successfully",
+	})
+}
+
+// APIKeyDailyUsage is the consumption of an API key on one model in one day
+type APIKeyDailyUsage struct {
+	Date         string  `json:"date"`
+	ModelName    string  `json:"model_name"`
+	Requests     int64   `json:"requests"`
+	Failures     int64   `json:"failures"`
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	Cost         float64 `json:"cost"`
+	Estimated    bool    `json:"estimated"` // some of the tokens are estimated from bytes
+}
+
+// aggregateDailyUsage groups usage records by day and model, in time order
+func aggregateDailyUsage(usages []*schema.AiApiKeyUsage) []*APIKeyDailyUsage {
+	var result []*APIKeyDailyUsage
+	index := make(map[string]*APIKeyDailyUsage)
+	for _, u := range usages {
+		date := u.CreatedAt.Format("2006-01-02")
+		id := date + "|" + u.ModelName
+		item, ok := index[id]
+		if !ok {
+			item = &APIKeyDailyUsage{Date: date, ModelName: u.ModelName}
+			index[id] = item
+			result = append(result, item)
+		}
+		item.Requests++
+		if !u.Success {
+			item.Failures++
+		}
+		item.InputTokens += u.InputTokens
+		item.OutputTokens += u.OutputTokens
+		item.Cost += u.Cost
+		item.Estimated = item.Estimated || u.Estimated
+	}
+	return result
+}
+
+// serveAPIKeyUsageAPI returns the consumption history of an API key
+// Example: GET /portal/api/key-usage/123?days=7
+func (c *ServerConfig) serveAPIKeyUsageAPI(conn net.Conn, request *http.Request, uriIns *url.URL) {
+	c.logInfo("Handling API key usage request: %s", uriIns.Path)
+
+	id, err := parseAPIKeyIDFromPath(uriIns.Path)
+	if err != nil {
+		c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid API key ID format",
+		})
+		return
+	}
+	days := 7
+	if raw := uriIns.Query().Get("days"); raw != "" {
+		days, err = strconv.Atoi(raw)
+		if err != nil || days <= 0 || days > 366 {
+			c.writeJSONResponse(conn, http.StatusBadRequest, map[string]interface{}{
+				"success": false,
+				"message": "Invalid days, should be in [1, 366]",
+			})
+			return
+		}
+	}
+
+	apiKey, err := GetAiApiKeyByID(id)
+	if err != nil {
+		c.writeJSONResponse(conn, http.StatusNotFound, map[string]interface{}{
+			"success": false,
+			"message": "API key not found",
+		})
+		return
+	}
+
+	since := startOfDay(time.Now()).AddDate(0, 0, 1-days)
+	usages, err := GetAiApiKeyUsageSince(apiKey.APIKey, since)
+	if err != nil {
+		c.logError("Failed to get usage of API key (ID: %d): %v", id, err)
+		c.writeJSONResponse(conn, http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "Failed to get API key usage",
+		})
+		return
+	}
+
+	c.writeJSONResponse(conn, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"id":                apiKey.ID,
+			"rpm_limit":         apiKey.RPMLimit,
+			"daily_token_limit": apiKey.DailyTokenLimit,
+			"today_tokens":      c.Quota.DailyTokens(apiKey.APIKey),
+			"input_tokens":      apiKey.InputTokens,
+			"output_tokens":     apiKey.OutputTokens,
+			"total_cost":        apiKey.TotalCost,
+			"daily":             aggregateDailyUsage(usages),
+		},
+	})
+}
@@ -148,7 +148,8 @@ func (cp *ConfigProvider) GetAllKeys() []string {
 	return allKeys
 }
 
-func (p *Provider) GetAIClientWithImages(imageContents []*aispec.ChatContent, onStream, onReasonStream func(reader io.Reader)) (aispec.AIClient, error) {
+// GetAIClientWithImages gets the AI client with image contents, extra options are appended to the client config
+func (p *Provider) GetAIClientWithImages(imageContents []*aispec.ChatContent, onStream, onReasonStream func(reader io.Reader), extra ...aispec.AIConfigOption) (aispec.AIClient, error) {
 	log.Infof("GetAIClient: type: %s, domain: %s, key: %s, model: %s, no_https: %v", p.TypeName, p.DomainOrURL, utils.ShrinkString(p.APIKey, 8), p.ModelName, p.NoHTTPS)
 
 	var images []any
@@ -185,6 +186,8 @@ func (p *Provider) GetAIClientWithImages(imageContents []*aispec.ChatContent, on
 		}),
 	)
 
+	opts = append(opts, extra...)
+
 	if target := strings.TrimSpace(p.DomainOrURL); target != "" {
 		if utils.IsHttpOrHttpsUrl(target) {
 			opts = append(opts, aispec.WithBaseURL(target))
@@ -0,0 +1,174 @@
+package aibalance
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/ai/aispec"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// ModelPrice is the price of a model, in currency units per 1M tokens
+type ModelPrice struct {
+	InputPrice  float64 `yaml:"input_price" json:"input_price"`
+	OutputPrice float64 `yaml:"output_price" json:"output_price"`
+}
+
+// Cost calculates the cost of a request
+func (p *ModelPrice) Cost(inputTokens, outputTokens int64) float64 {
+	if p == nil {
+		return 0
+	}
+	return (float64(inputTokens)*p.InputPrice + float64(outputTokens)*p.OutputPrice) / 1e6
+}
+
+// QuotaExceededError describes which quota is exhausted and when the client can retry
+type QuotaExceededError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *QuotaExceededError) Error() string {
+	return e.Reason
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header, at least 1 second
+func (e *QuotaExceededError) RetryAfterSeconds() int64 {
+	seconds := int64(math.Ceil(e.RetryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+type keyQuotaState struct {
+	requests []time.Time // request timestamps within the last minute
+
+	day         string // the day that tokens are counted for, 2006-01-02
+	tokens      int64
+	tokensReady bool
+}
+
+// QuotaManager enforces requests/minute and tokens/day limits per API key
+type QuotaManager struct {
+	mu     sync.Mutex
+	states map[string]*keyQuotaState
+
+	now func() time.Time
+	// loadDailyTokens restores the tokens used today from database after restart
+	loadDailyTokens func(apiKey string, since time.Time) (int64, error)
+}
+
+// NewQuotaManager creates a quota manager backed by the usage records in database
+func NewQuotaManager() *QuotaManager {
+	return &QuotaManager{
+		states:          make(map[string]*keyQuotaState),
+		now:             time.Now,
+		loadDailyTokens: GetAiApiKeyTokensSince,
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// state must be called with lock held
+func (q *QuotaManager) state(apiKey string, now time.Time) *keyQuotaState {
+	s, ok := q.states[apiKey]
+	if !ok {
+		s = &keyQuotaState{}
+		q.states[apiKey] = s
+	}
+
+	day := now.Format("2006-01-02")
+	if s.day != day {
+		s.day = day
+		s.tokens = 0
+		s.tokensReady = false
+	}
+	if !s.tokensReady && q.loadDailyTokens != nil {
+		tokens, err := q.loadDailyTokens(apiKey, startOfDay(now))
+		if err != nil {
+			log.Warnf("failed to load daily token usage for key %s: %v", utils.ShrinkString(apiKey, 8), err)
+		} else {
+			s.tokens += tokens
+		}
+	}
+	s.tokensReady = true
+
+	// drop requests older than one minute
+	idx := 0
+	for idx < len(s.requests) && now.Sub(s.requests[idx]) >= time.Minute {
+		idx++
+	}
+	s.requests = s.requests[idx:]
+	return s
+}
+
+// Acquire checks the quotas of key and takes one request slot if allowed
+func (q *QuotaManager) Acquire(key *Key) *QuotaExceededError {
+	if key == nil || (key.RPMLimit <= 0 && key.DailyTokenLimit <= 0) {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	s := q.state(key.Key, now)
+
+	if key.DailyTokenLimit > 0 && s.tokens >= key.DailyTokenLimit {
+		return &QuotaExceededError{
+			Reason:     "daily token quota exhausted",
+			RetryAfter: startOfDay(now).AddDate(0, 0, 1).Sub(now),
+		}
+	}
+	if key.RPMLimit > 0 && int64(len(s.requests)) >= key.RPMLimit {
+		return &QuotaExceededError{
+			Reason:     "requests per minute limit exceeded",
+			RetryAfter: s.requests[0].Add(time.Minute).Sub(now),
+		}
+	}
+	s.requests = append(s.requests, now)
+	return nil
+}
+
+// AddTokens records tokens consumed by key for the daily quota
+func (q *QuotaManager) AddTokens(apiKey string, tokens int64) {
+	if tokens <= 0 {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	s := q.state(apiKey, q.now())
+	s.tokens += tokens
+}
+
+// DailyTokens returns tokens consumed by key today
+func (q *QuotaManager) DailyTokens(apiKey string) int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.state(apiKey, q.now()).tokens
+}
+
+// estimateTokens roughly converts bytes to tokens when upstream returns no usage
+func estimateTokens(bytes int64) int64 {
+	if bytes <= 0 {
+		return 0
+	}
+	return (bytes + 3) / 4
+}
+
+// usageTokens returns the input/output tokens of a request, the bool result is true if estimated
+func usageTokens(usage *aispec.ChatUsage, inputBytes, outputBytes int64) (int64, int64, bool) {
+	if usage != nil && (usage.PromptTokens > 0 || usage.CompletionTokens > 0) {
+		return int64(usage.PromptTokens), int64(usage.CompletionTokens), false
+	}
+	return estimateTokens(inputBytes), estimateTokens(outputBytes), true
+}
@@ -0,0 +1,150 @@
+package aibalance
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/ai/aispec"
+	"github.com/yaklang/yaklang/common/schema"
+)
+
+func newTestQuotaManager(now *time.Time, loaded int64) *QuotaManager {
+	q := NewQuotaManager()
+	q.now = func() time.Time { return *now }
+	q.loadDailyTokens = func(apiKey string, since time.Time) (int64, error) {
+		return loaded, nil
+	}
+	return q
+}
+
+func TestQuotaManager_RPM(t *testing.T) {
+	now := time.Date(2025, 5, 1, 10, 0, 0, 0, time.Local)
+	q := newTestQuotaManager(&now, 0)
+	key := &Key{Key: "rpm-key", RPMLimit: 2}
+
+	assert.Nil(t, q.Acquire(key))
+	now = now.Add(20 * time.Second)
+	assert.Nil(t, q.Acquire(key))
+
+	now = now.Add(10 * time.Second)
+	quotaErr := q.Acquire(key)
+	require.NotNil(t, quotaErr)
+	assert.Contains(t, quotaErr.Reason, "requests per minute")
+	// the first request leaves the window 30s later
+	assert.Equal(t, int64(30), quotaErr.RetryAfterSeconds())
+
+	now = now.Add(30 * time.Second)
+	assert.Nil(t, q.Acquire(key))
+
+	// keys without limits are never blocked
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, q.Acquire(&Key{Key: "free"}))
+	}
+}
+
+func TestQuotaManager_DailyTokens(t *testing.T) {
+	now := time.Date(2025, 5, 1, 23, 0, 0, 0, time.Local)
+	// 800 tokens used today before restart
+	q := newTestQuotaManager(&now, 800)
+	key := &Key{Key: "token-key", DailyTokenLimit: 1000}
+
+	assert.Nil(t, q.Acquire(key))
+	q.AddTokens(key.Key, 150)
+	assert.Equal(t, int64(950), q.DailyTokens(key.Key))
+	assert.Nil(t, q.Acquire(key))
+	q.AddTokens(key.Key, 100)
+
+	quotaErr := q.Acquire(key)
+	require.NotNil(t, quotaErr)
+	assert.Contains(t, quotaErr.Reason, "daily token")
+	assert.Equal(t, int64(3600), quotaErr.RetryAfterSeconds())
+
+	// the quota is reset on the next day
+	q.loadDailyTokens = nil
+	now = now.Add(time.Hour)
+	assert.Nil(t, q.Acquire(key))
+	assert.Equal(t, int64(0), q.DailyTokens(key.Key))
+}
+
+func TestUsageTokensAndCost(t *testing.T) {
+	input, output, estimated := usageTokens(&aispec.ChatUsage{PromptTokens: 10, CompletionTokens: 20}, 1000, 1000)
+	assert.Equal(t, int64(10), input)
+	assert.Equal(t, int64(20), output)
+	assert.False(t, estimated)
+
+	input, output, estimated = usageTokens(nil, 10, 0)
+	assert.Equal(t, int64(3), input)
+	assert.Equal(t, int64(0), output)
+	assert.True(t, estimated)
+
+	price := &ModelPrice{InputPrice: 2, OutputPrice: 8}
+	assert.InDelta(t, 0.01, price.Cost(1000, 1000), 1e-9)
+	var noPrice *ModelPrice
+	assert.Equal(t, float64(0), noPrice.Cost(1000, 1000))
+}
+
+func TestAggregateDailyUsage(t *testing.T) {
+	day1 := time.Date(2025, 5, 1, 10, 0, 0, 0, time.Local)
+	day2 := day1.AddDate(0, 0, 1)
+	newUsage := func(at time.Time, model string, tokens int64, success bool) *schema.AiApiKeyUsage {
+		u := &schema.AiApiKeyUsage{ModelName: model, InputTokens: tokens, OutputTokens: tokens, Cost: 0.5, Success: success}
+		u.CreatedAt = at
+		return u
+	}
+	daily := aggregateDailyUsage([]*schema.AiApiKeyUsage{
+		newUsage(day1, "gpt", 10, true),
+		newUsage(day1.Add(time.Hour), "gpt", 20, false),
+		newUsage(day1, "qwen", 5, true),
+		newUsage(day2, "gpt", 1, true),
+	})
+	require.Len(t, daily, 3)
+	assert.Equal(t, "2025-05-01", daily[0].Date)
+	assert.Equal(t, "gpt", daily[0].ModelName)
+	assert.Equal(t, int64(2), daily[0].Requests)
+	assert.Equal(t, int64(1), daily[0].Failures)
+	assert.Equal(t, int64(30), daily[0].InputTokens)
+	assert.InDelta(t, 1.0, daily[0].Cost, 1e-9)
+	assert.Equal(t, "qwen", daily[1].ModelName)
+	assert.Equal(t, "2025-05-02", daily[2].Date)
+}
+
+func TestServeChatCompletions_QuotaExceeded(t *testing.T) {
+	cfg := NewServerConfig()
+	cfg.Quota.loadDailyTokens = nil
+	key := &Key{
+		Key:           "quota-key",
+		AllowedModels: map[string]bool{"test-model": true},
+		RPMLimit:      1,
+	}
+	cfg.Keys.keys[key.Key] = key
+	cfg.KeyAllowedModels.allowedModels[key.Key] = key.AllowedModels
+
+	// take the only slot of this minute
+	require.Nil(t, cfg.Quota.Acquire(key))
+
+	client, server := net.Pipe()
+	defer client.Close()
+	go cfg.Serve(server)
+
+	jsonBody := `{"model":"test-model","messages":[{"role":"user","content":"test"}]}`
+	go client.Write([]byte("POST /v1/chat/completions HTTP/1.1\r\n" +
+		"Host: localhost\r\n" +
+		"Authorization: Bearer quota-key\r\n" +
+		"Content-Type: application/json\r\n" +
+		fmt.Sprintf("Content-Length: %d\r\n", len(jsonBody)) +
+		"\r\n" +
+		jsonBody))
+
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	raw, _ := io.ReadAll(client)
+	response := string(raw)
+	assert.True(t, strings.HasPrefix(response, "HTTP/1.1 429 Too Many Requests"), response)
+	assert.Contains(t, response, "Retry-After: ")
+	assert.Contains(t, response, "rate_limit_exceeded")
+}
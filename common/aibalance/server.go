@@ -26,6 +26,7 @@ import (
 	_ "github.com/yaklang/yaklang/common/ai"
 	"github.com/yaklang/yaklang/common/ai/aispec"
 	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 )
@@ -42,6 +43,9 @@ type LogLevel struct {
 type Key struct {
 	Key           string
 	AllowedModels map[string]bool
+	// Quotas, 0 means unlimited
+	RPMLimit        int64
+	DailyTokenLimit int64
 }
 
 // KeyManager manages API keys and their permissions
@@ -292,8 +296,10 @@ func (c *ServerConfig) LoadAPIKeysFromDB() error {
 
 		// 同时添加到 Keys 结构
 		c.Keys.keys[key.APIKey] = &Key{
-			Key:           key.APIKey,
-			AllowedModels: modelMap,
+			Key:             key.APIKey,
+			AllowedModels:   modelMap,
+			RPMLimit:        key.RPMLimit,
+			DailyTokenLimit: key.DailyTokenLimit,
 		}
 
 		log.Infof("Loaded API key: %s with allowed models: %v", utils.ShrinkString(key.APIKey, 8), modelMap)
@@ -312,6 +318,8 @@ type ServerConfig struct {
 	Logging          LogLevel
 	AdminPassword    string          // 添加管理员密码配置
 	SessionManager   *SessionManager // 会话管理器
+	Quota            *QuotaManager   // API Key 配额
+	ModelPrices      map[string]*ModelPrice
 	forwardRule      *omap.OrderedMap[string, *aiforwarder.Rule]
 }
 
@@ -330,6 +338,8 @@ func NewServerConfig() *ServerConfig {
 		},
 		AdminPassword:  "admin", // 默认密码
 		SessionManager: NewSessionManager(),
+		Quota:          NewQuotaManager(),
+		ModelPrices:    make(map[string]*ModelPrice),
 		forwardRule:    omap.NewOrderedMap[string, *aiforwarder.Rule](make(map[string]*aiforwarder.Rule)),
 	}
 }
@@ -461,6 +471,13 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 			conn.Write([]byte("HTTP/1.1 403 Forbidden\r\n\r\n"))
 			return
 		}
+
+		// Quota check
+		if quotaErr := c.Quota.Acquire(key); quotaErr != nil {
+			c.logWarn("Key[%v] quota exceeded: %v, retry after %ds", utils.ShrinkString(key.Key, 8), quotaErr.Reason, quotaErr.RetryAfterSeconds())
+			c.writeQuotaExceeded(conn, quotaErr)
+			return
+		}
 	}
 
 	var prompt bytes.Buffer
@@ -567,6 +584,9 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 		pr, pw := utils.NewBufPipe(nil)
 		rr, rw := utils.NewBufPipe(nil)
 
+		var usageMutex sync.Mutex
+		var usage *aispec.ChatUsage
+
 		writer := NewChatJSONChunkWriter(conn, apiKeyForStat, modelName)
 		client, err := provider.GetAIClientWithImages(
 			imageContent,
@@ -588,6 +608,11 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 				io.Copy(rw, reader)
 				utils.FlushWriter(writer.writerClose)
 			},
+			aispec.WithUsageCallback(func(u *aispec.ChatUsage) {
+				usageMutex.Lock()
+				defer usageMutex.Unlock()
+				usage = u
+			}),
 		)
 		if err != nil {
 			c.logError("Failed to get AI client from provider %s: %v", provider.TypeName, err)
@@ -724,15 +749,39 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 
 		// Update API Key statistics using actual success
 		if !isFreeModel {
+			inputBytes := int64(prompt.Len())
+			outputBytes := total
+			usageMutex.Lock()
+			inputTokens, outputTokens, estimated := usageTokens(usage, inputBytes, outputBytes)
+			usageMutex.Unlock()
+			// count tokens synchronously, so the next request sees the daily quota
+			c.Quota.AddTokens(key.Key, inputTokens+outputTokens)
+			usageRecord := &schema.AiApiKeyUsage{
+				APIKey:       key.Key,
+				ModelName:    modelName,
+				ProviderType: provider.TypeName,
+				InputTokens:  inputTokens,
+				OutputTokens: outputTokens,
+				TotalTokens:  inputTokens + outputTokens,
+				InputBytes:   inputBytes,
+				OutputBytes:  outputBytes,
+				Cost:         c.ModelPrices[modelName].Cost(inputTokens, outputTokens),
+				Success:      requestSucceeded,
+				Estimated:    estimated,
+			}
 			go func() {
-				inputBytes := int64(prompt.Len())
-				outputBytes := total
 				if err := UpdateAiApiKeyStats(key.Key, inputBytes, outputBytes, requestSucceeded); err != nil {
 					c.logError("Failed to update API key statistics: %v", err)
 				} else {
 					c.logInfo("API key statistics updated: key=%s, input=%d bytes, output=%d bytes, success=%v",
 						utils.ShrinkString(key.Key, 8), inputBytes, outputBytes, requestSucceeded)
 				}
+				if err := RecordAiApiKeyUsage(usageRecord); err != nil {
+					c.logError("Failed to record API key usage: %v", err)
+				} else {
+					c.logInfo("API key usage recorded: key=%s, input=%d tokens, output=%d tokens, cost=%.6f, estimated=%v",
+						utils.ShrinkString(key.Key, 8), inputTokens, outputTokens, usageRecord.Cost, estimated)
+				}
 			}()
 		}
 
@@ -761,6 +810,24 @@ func (c *ServerConfig) serveChatCompletions(conn net.Conn, rawPacket []byte) {
 	c.logInfo("Connection closed for %s", conn.RemoteAddr())
 }
 
+// writeQuotaExceeded responds 429 with Retry-After header in OpenAI error format
+func (c *ServerConfig) writeQuotaExceeded(conn net.Conn, quotaErr *QuotaExceededError) {
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message": quotaErr.Reason,
+			"type":    "rate_limit_exceeded",
+			"code":    http.StatusTooManyRequests,
+		},
+	})
+	header := fmt.Sprintf("HTTP/1.1 429 Too Many Requests\r\n"+
+		"Content-Type: application/json; charset=utf-8\r\n"+
+		"Retry-After: %d\r\n"+
+		"Content-Length: %d\r\n"+
+		"\r\n", quotaErr.RetryAfterSeconds(), len(body))
+	conn.Write([]byte(header))
+	conn.Write(body)
+}
+
 // 新增函数: 处理 /v1/models 请求，返回所有可用的 model 列表
 func (c *ServerConfig) serveModels(key *Key, conn net.Conn) {
 	c.logInfo("Serving models list")
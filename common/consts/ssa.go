@@ -27,11 +27,13 @@ const (
 	GO      Language = "golang"
 	C       Language = "c"
 	TS      Language = "ts"
+	PYTHON  Language = "python"
+	RUST    Language = "rust"
 	General Language = "general"
 )
 
 func GetAllSupportedLanguages() []Language {
-	return []Language{Yak, JS, PHP, JAVA, GO}
+	return []Language{Yak, JS, PHP, JAVA, GO, PYTHON, RUST}
 }
 
 func ValidateLanguage(language string) (Language, error) {
@@ -46,6 +48,10 @@ func ValidateLanguage(language string) (Language, error) {
 		return JS, nil
 	case "go", "golang":
 		return GO, nil
+	case "python", "py", "python3":
+		return PYTHON, nil
+	case "rust", "rs":
+		return RUST, nil
 	}
 	return "", errors.Errorf("unsupported language: %s", language)
 }
@@ -12,6 +12,7 @@ import (
 	"encoding/pem"
 	"fmt"
 	"github.com/yaklang/yaklang/common/gmsm/gmtls"
+	"io"
 	"io/ioutil"
 	"math/big"
 	"net"
@@ -358,6 +359,9 @@ type MITMServer struct {
 	// random JA3 fingerprint
 	randomJA3 bool
 
+	// 劫持的 TLS 连接的密钥以 NSS Key Log 格式写入
+	tlsKeyLogWriter io.Writer
+
 	tunMode bool
 }
 
@@ -434,6 +438,9 @@ func (m *MITMServer) initConfig() error {
 	m.proxy.SetFindProcessName(m.findProcessName)
 	m.proxy.SetDialer(m.dialer)
 
+	if m.tlsKeyLogWriter != nil {
+		m.mitmConfig.SetKeyLogWriter(m.tlsKeyLogWriter)
+	}
 	m.proxy.SetMITM(m.mitmConfig)
 	return nil
 }
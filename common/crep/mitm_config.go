@@ -7,9 +7,11 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"time"
 
 	"github.com/yaklang/yaklang/common/gmsm/gmtls"
@@ -77,6 +79,38 @@ func MITM_RandomJA3(b bool) MITMConfig {
 	}
 }
 
+// MITM_SetTLSKeyLogWriter 把劫持的 TLS 连接的密钥以 NSS Key Log 格式（SSLKEYLOGFILE）写入 w，
+// 同时抓取的流量可以使用 pcaputil.WithTLSKeyLog 解密
+func MITM_SetTLSKeyLogWriter(w io.Writer) MITMConfig {
+	return func(server *MITMServer) error {
+		server.tlsKeyLogWriter = w
+		return nil
+	}
+}
+
+// tlsKeyLogFile 每次写入时以追加方式打开文件，不需要管理文件句柄的生命周期
+type tlsKeyLogFile string
+
+func (f tlsKeyLogFile) Write(p []byte) (int, error) {
+	fp, err := os.OpenFile(string(f), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	defer fp.Close()
+	return fp.Write(p)
+}
+
+// MITM_SetTLSKeyLogFile 把劫持的 TLS 连接的密钥追加写入 SSLKEYLOGFILE 文件，见 MITM_SetTLSKeyLogWriter
+func MITM_SetTLSKeyLogFile(filename string) MITMConfig {
+	return func(server *MITMServer) error {
+		if _, err := tlsKeyLogFile(filename).Write(nil); err != nil {
+			return utils.Errorf("open tls key log file[%v] failed: %s", filename, err)
+		}
+		server.tlsKeyLogWriter = tlsKeyLogFile(filename)
+		return nil
+	}
+}
+
 func MITM_SetHijackedMaxContentLength(i int) MITMConfig {
 	return func(server *MITMServer) error {
 		server.hijackedMaxContentLength = i
@@ -0,0 +1,49 @@
+package crep
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/tlsutils"
+)
+
+func TestMITM_TLSKeyLogFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Write([]byte("keylog"))
+	}))
+	defer server.Close()
+
+	keyLogFile := filepath.Join(t.TempDir(), "sslkeylog.txt")
+	proxy, err := NewMITMServer(MITM_SetTLSKeyLogFile(keyLogFile))
+	require.NoError(t, err)
+	proxyPort := utils.GetRandomAvailableTCPPort()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		proxy.Serve(ctx, fmt.Sprintf("127.0.0.1:%v", proxyPort))
+	}()
+	require.NoError(t, utils.WaitConnect(utils.HostPort("127.0.0.1", proxyPort), 3))
+
+	client := utils.NewDefaultHTTPClient()
+	client.Transport.(*http.Transport).Proxy = func(request *http.Request) (*url.URL, error) {
+		return url.Parse(fmt.Sprintf("http://127.0.0.1:%v", proxyPort))
+	}
+	client.Timeout = 10 * time.Second
+	rsp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	raw, _ := utils.HttpDumpWithBody(rsp, true)
+	require.Contains(t, string(raw), "keylog")
+
+	content, err := os.ReadFile(keyLogFile)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, tlsutils.ParseKeyLog(content).Len(), 1, string(content))
+}
@@ -13,4 +13,6 @@ var FacadesExports = map[string]interface{}{
 	"ldapResourceAddr":  SetLdapResourceAddr,
 	"rmiResourceAddr":   SetRmiResourceAddr,
 	"evilClassResource": SetRmiResourceAddr,
+	"ntlmCapture":       SetNTLMCapture,
+	"ntlmChallenge":     SetNTLMChallenge,
 }
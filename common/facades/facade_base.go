@@ -19,6 +19,8 @@ type Notification struct {
 	Uuid         string `json:"uuid"`
 	ResponseInfo string `json:"response_info"`
 	ConnectHash  string `json:"connect_hash"`
+	// ntlm capture
+	NTLM *NTLMCapture `json:"ntlm,omitempty"`
 }
 
 func NewNotification(t string, remoteAddr string, raw []byte, token string) *Notification {
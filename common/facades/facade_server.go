@@ -26,6 +26,8 @@ const (
 	LDAPMsgFlag         = "ldap_flag"
 	RMIMsgFlag          = "rmi"
 	RMIHandshakeMsgFlag = "rmi-handshake"
+	SMBMsgFlag          = "smb"
+	NTLMMsgFlag         = "ntlm"
 )
 
 const (
@@ -116,6 +118,10 @@ type FacadeServer struct {
 	// resourceName               string
 	ldapEntry map[string]interface{}
 	httpMux   *sync.Mutex
+
+	// ntlm capture mode for http and ldap
+	ntlmCapture   bool
+	ntlmChallenge []byte
 }
 
 type ResourcesInfo struct {
@@ -349,6 +355,10 @@ func (f *FacadeServer) triggerNotificationEx(t string, conn net.Conn, token stri
 	notif.ConnectHash = codec.Md5(fmt.Sprintf("%p", conn))
 	// 响应内容
 	notif.ResponseInfo = responseInfo
+	f.handleNotification(notif)
+}
+
+func (f *FacadeServer) handleNotification(notif *Notification) {
 	if len(f.handlers) <= 0 {
 		// spew.Dump(notif)
 	}
@@ -477,6 +487,13 @@ WRAPPER:
 			return
 		}
 		f.triggerNotification(LDAPMsgFlag, conn, "", nil)
+		if f.ntlmCapture {
+			err := f.ldapNTLMBind(peekableConn)
+			if err != nil {
+				log.Errorf("ldap ntlm bind failed: %s", err)
+				return
+			}
+		}
 		server := ldapserver.NewServer()
 		routes := ldapserver.NewRouteMux()
 		routes.Bind(func(writer ldapserver.ResponseWriter, message *ldapserver.Message) {
@@ -515,6 +532,18 @@ WRAPPER:
 		}
 		cli.Serve()
 		peekableConn.Close()
+	case 0x00: // netbios session message
+		header, err := peekableConn.Peek(8)
+		if err == nil && len(header) == 8 && (bytes.Equal(header[4:8], smb1ProtocolId) || bytes.Equal(header[4:8], smb2ProtocolId)) {
+			log.Info("handle for smb")
+			f.triggerNotification(SMBMsgFlag, conn, "", nil)
+			err := f.smbServe(peekableConn)
+			if err != nil {
+				log.Errorf("serve smb failed: %s", err)
+			}
+			return
+		}
+		fallthrough
 	default:
 		log.Infof("start to fallback http handlers for: %s", conn.RemoteAddr())
 		err = f.getHTTPHandler(isTls.IsSet())(peekableConn)
@@ -3,11 +3,16 @@ package facades
 import (
 	"bufio"
 	"errors"
+	"fmt"
 	"github.com/h2non/filetype"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+	"io"
 	"net"
+	"net/http"
+	"strings"
 	"time"
 )
 
@@ -112,11 +117,19 @@ func (f *FacadeServer) getHTTPHandler(isHttps bool) FacadeConnectionHandler {
 		var c net.Conn = peekConn
 		c.SetDeadline(time.Now().Add(3 * time.Second))
 		log.Infof("start to read http request from %s", c.RemoteAddr())
-		req, err := utils.ReadHTTPRequestFromBufioReader(bufio.NewReader(c))
+		reader := bufio.NewReader(c)
+		req, err := utils.ReadHTTPRequestFromBufioReader(reader)
 		if err != nil {
 			log.Errorf("read http request from conn[%s] failed", c.RemoteAddr())
 			return err
 		}
+		if f.ntlmCapture {
+			req, err = f.httpNTLMCapture(peekConn, reader, req)
+			if err != nil {
+				log.Errorf("capture ntlm from conn[%s] failed: %s", c.RemoteAddr(), err)
+				return err
+			}
+		}
 
 		log.Infof("request is received from %s", c.RemoteAddr())
 		reqRaw, err := utils.HttpDumpWithBody(req, true)
@@ -202,3 +215,47 @@ func (f *FacadeServer) getHTTPHandler(isHttps bool) FacadeConnectionHandler {
 		return nil
 	}
 }
+
+const httpNTLMUnauthorized = "HTTP/1.1 401 Unauthorized\r\nWWW-Authenticate: NTLM\r\nWWW-Authenticate: Negotiate\r\nContent-Length: 0\r\n\r\n"
+
+// httpNTLMCapture asks the client to authenticate with ntlm in the same connection, and returns the
+// request with the authenticate message (or the request of a client giving up) to be served as usual.
+func (f *FacadeServer) httpNTLMCapture(conn *utils.BufferedPeekableConn, reader *bufio.Reader, req *http.Request) (*http.Request, error) {
+	handshake := f.newNTLMHandshake("http")
+	for i := 0; i < 3; i++ {
+		scheme, token := "", []byte(nil)
+		if fields := strings.Fields(req.Header.Get("Authorization")); len(fields) == 2 {
+			scheme = fields[0]
+			token, _ = codec.DecodeBase64(fields[1])
+		}
+
+		rsp := httpNTLMUnauthorized
+		if msg, _ := unwrapNTLMToken(token); msg != nil {
+			challenge, capture, err := handshake.next(token)
+			if err != nil {
+				return nil, err
+			}
+			if capture != nil {
+				f.triggerNTLMNotification(conn.GetOriginConn(), capture)
+				return req, nil
+			}
+			rsp = fmt.Sprintf("HTTP/1.1 401 Unauthorized\r\nWWW-Authenticate: %s %s\r\nContent-Length: 0\r\n\r\n", scheme, codec.EncodeBase64(challenge))
+		} else if i > 0 {
+			return req, nil
+		}
+
+		if req.Body != nil {
+			io.Copy(io.Discard, req.Body)
+		}
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+		if _, err := conn.Write([]byte(rsp)); err != nil {
+			return nil, err
+		}
+		next, err := utils.ReadHTTPRequestFromBufioReader(reader)
+		if err != nil {
+			return nil, err
+		}
+		req = next
+	}
+	return req, nil
+}
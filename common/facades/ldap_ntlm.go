@@ -0,0 +1,196 @@
+package facades
+
+import (
+	"io"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	ldapTagBindRequest  = 0x60
+	ldapTagBindResponse = 0x61
+
+	ldapAuthSasl             = 0xa3
+	ldapAuthSicilyDiscovery  = 0x89
+	ldapAuthSicilyNegotiate  = 0x8a
+	ldapAuthSicilyResponse   = 0x8b
+	ldapServerSaslCreds      = 0x87
+	ldapResultSuccess        = 0
+	ldapResultSaslInProgress = 14
+)
+
+// peekFull peeks n bytes, Peek only reads the conn once
+func peekFull(conn *utils.BufferedPeekableConn, n int) ([]byte, error) {
+	for {
+		buf, err := conn.Peek(n)
+		if len(buf) >= n {
+			return buf[:n], nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func peekLDAPMessage(conn *utils.BufferedPeekableConn) ([]byte, error) {
+	header, err := peekFull(conn, 2)
+	if err != nil {
+		return nil, err
+	}
+	if header[1]&0x80 != 0 {
+		header, err = peekFull(conn, 2+int(header[1]&0x7f))
+		if err != nil {
+			return nil, err
+		}
+	}
+	headerSize, length, err := berHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	if length > 1024*1024 {
+		return nil, utils.Errorf("ldap message is too large: %d", length)
+	}
+	return peekFull(conn, headerSize+length)
+}
+
+func berInteger(n int) []byte {
+	raw := []byte{byte(n)}
+	for n >>= 8; n > 0; n >>= 8 {
+		raw = append([]byte{byte(n)}, raw...)
+	}
+	if raw[0]&0x80 != 0 {
+		raw = append([]byte{0}, raw...)
+	}
+	return raw
+}
+
+func ldapBindResponse(messageId int, resultCode byte, matchedDN []byte, serverSaslCreds []byte) []byte {
+	op := [][]byte{
+		berTLV(0x0a, []byte{resultCode}),
+		berTLV(0x04, matchedDN),
+		berTLV(0x04, nil),
+	}
+	if serverSaslCreds != nil {
+		op = append(op, berTLV(ldapServerSaslCreds, serverSaslCreds))
+	}
+	return berTLV(0x30,
+		berTLV(0x02, berInteger(messageId)),
+		berTLV(ldapTagBindResponse, op...),
+	)
+}
+
+// ldapNTLMBind handles the ntlm binds (sicily and sasl GSS-SPNEGO / NTLM) before the ldap server,
+// it returns when the next message is not a ntlm bind and leaves the message in the conn.
+func (f *FacadeServer) ldapNTLMBind(conn *utils.BufferedPeekableConn) error {
+	handshake := f.newNTLMHandshake("ldap")
+	for {
+		conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+		raw, err := peekLDAPMessage(conn)
+		if err != nil {
+			return err
+		}
+		messageId, auth, ok := parseLDAPNTLMBind(raw)
+		if !ok {
+			conn.SetReadDeadline(time.Time{})
+			return nil
+		}
+		if _, err := io.ReadFull(conn, make([]byte, len(raw))); err != nil {
+			return err
+		}
+
+		tag, content, _, _ := berReadTLV(auth)
+		var rsp []byte
+		switch tag {
+		case ldapAuthSicilyDiscovery:
+			// the packages are returned in the matchedDN
+			rsp = ldapBindResponse(messageId, ldapResultSuccess, []byte("NTLM"), nil)
+		case ldapAuthSicilyNegotiate, ldapAuthSicilyResponse:
+			token, capture, err := handshake.next(content)
+			if err != nil {
+				return err
+			}
+			if capture != nil {
+				f.triggerNTLMNotification(conn.GetOriginConn(), capture)
+			}
+			// the challenge of sicily is returned in the matchedDN too
+			rsp = ldapBindResponse(messageId, ldapResultSuccess, token, nil)
+		case ldapAuthSasl:
+			// mechanism and credentials
+			_, _, rest, err := berReadTLV(content)
+			if err != nil {
+				return err
+			}
+			_, credentials, _, err := berReadTLV(rest)
+			if err != nil {
+				return err
+			}
+			token, capture, err := handshake.next(credentials)
+			if err != nil {
+				return err
+			}
+			if capture != nil {
+				f.triggerNTLMNotification(conn.GetOriginConn(), capture)
+				rsp = ldapBindResponse(messageId, ldapResultSuccess, nil, nil)
+			} else {
+				rsp = ldapBindResponse(messageId, ldapResultSaslInProgress, nil, token)
+			}
+		}
+		if _, err := conn.Write(rsp); err != nil {
+			return err
+		}
+	}
+}
+
+// parseLDAPNTLMBind returns the message id and the authentication choice of a ntlm bind request
+func parseLDAPNTLMBind(raw []byte) (int, []byte, bool) {
+	tag, message, _, err := berReadTLV(raw)
+	if err != nil || tag != 0x30 {
+		return 0, nil, false
+	}
+	tag, id, rest, err := berReadTLV(message)
+	if err != nil || tag != 0x02 || len(id) > 4 {
+		return 0, nil, false
+	}
+	messageId := 0
+	for _, b := range id {
+		messageId = messageId<<8 | int(b)
+	}
+	tag, op, _, err := berReadTLV(rest)
+	if err != nil || tag != ldapTagBindRequest {
+		return 0, nil, false
+	}
+	// version and name
+	_, _, rest, err = berReadTLV(op)
+	if err != nil {
+		return 0, nil, false
+	}
+	_, _, auth, err := berReadTLV(rest)
+	if err != nil || len(auth) == 0 {
+		return 0, nil, false
+	}
+	switch auth[0] {
+	case ldapAuthSicilyDiscovery, ldapAuthSicilyNegotiate, ldapAuthSicilyResponse:
+		return messageId, auth, true
+	case ldapAuthSasl:
+		_, content, _, err := berReadTLV(auth)
+		if err != nil {
+			return 0, nil, false
+		}
+		_, mechanism, rest, err := berReadTLV(content)
+		if err != nil || len(rest) == 0 {
+			return 0, nil, false
+		}
+		switch string(mechanism) {
+		case "GSS-SPNEGO", "NTLM":
+			_, credentials, _, err := berReadTLV(rest)
+			if err != nil {
+				return 0, nil, false
+			}
+			if msg, _ := unwrapNTLMToken(credentials); msg != nil {
+				return messageId, auth, true
+			}
+		}
+	}
+	return 0, nil, false
+}
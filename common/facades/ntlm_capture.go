@@ -0,0 +1,333 @@
+package facades
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	protocol_impl "github.com/yaklang/yaklang/common/bin-parser/protocol-impl"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+	"golang.org/x/text/encoding/unicode"
+)
+
+const (
+	ntlmNegotiateUnicode          = 0x00000001
+	ntlmRequestTarget             = 0x00000004
+	ntlmNegotiateNTLM             = 0x00000200
+	ntlmNegotiateAlwaysSign       = 0x00008000
+	ntlmTargetTypeDomain          = 0x00010000
+	ntlmNegotiateTargetInfo       = 0x00800000
+	ntlmNegotiateVersion          = 0x02000000
+	ntlmNegotiate128              = 0x20000000
+	ntlmNegotiateKeyExchange      = 0x40000000
+	ntlmNegotiate56               = 0x80000000
+	ntlmDefaultChallengeFlags     = ntlmNegotiateUnicode | ntlmRequestTarget | ntlmNegotiateNTLM | ntlmNegotiateAlwaysSign | ntlmTargetTypeDomain | ntlmNegotiateTargetInfo | ntlmNegotiateVersion | ntlmNegotiate128 | ntlmNegotiateKeyExchange | ntlmNegotiate56
+	ntlmDefaultTargetDomain       = "WORKGROUP"
+	ntlmDefaultTargetComputerName = "FACADE"
+)
+
+var ntlmSignature = []byte("NTLMSSP\x00")
+
+// NTLMCapture is a Net-NTLM challenge-response captured by the facade server;
+// it can be cracked directly by hashcat using HashcatMode.
+type NTLMCapture struct {
+	// http / smb / ldap
+	Protocol string `json:"protocol"`
+	// NTLMv1 / NTLMv2
+	Version         string `json:"version"`
+	User            string `json:"user"`
+	Domain          string `json:"domain"`
+	Workstation     string `json:"workstation"`
+	ServerChallenge string `json:"server_challenge"`
+	Hashcat         string `json:"hashcat"`
+	HashcatMode     int    `json:"hashcat_mode"`
+}
+
+func (c *NTLMCapture) Account() string {
+	if c.Domain == "" {
+		return c.User
+	}
+	return c.Domain + `\` + c.User
+}
+
+// SetNTLMCapture makes the http and ldap handlers negotiate ntlm authentication and capture
+// the Net-NTLM hash, the smb responder always captures the hash.
+func SetNTLMCapture(enable bool) FacadeServerConfig {
+	return func(f *FacadeServer) {
+		f.ntlmCapture = enable
+	}
+}
+
+// SetNTLMChallenge sets a fixed 8 bytes server challenge (e.g. 1122334455667788) for rainbow tables,
+// a random challenge is used for every handshake by default.
+func SetNTLMChallenge(challenge string) FacadeServerConfig {
+	return func(f *FacadeServer) {
+		raw, err := codec.DecodeHex(challenge)
+		if err != nil || len(raw) != 8 {
+			log.Errorf("invalid ntlm challenge %#v, need 8 bytes hex", challenge)
+			return
+		}
+		f.ntlmChallenge = raw
+	}
+}
+
+func ntlmMessageType(msg []byte) uint32 {
+	if len(msg) < 12 || !bytes.HasPrefix(msg, ntlmSignature) {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(msg[8:12])
+}
+
+func windowsFileTime(t time.Time) uint64 {
+	return uint64(t.UnixNano()/100) + 116444736000000000
+}
+
+func ntlmAVPair(buf *bytes.Buffer, id uint16, value []byte) {
+	_ = binary.Write(buf, binary.LittleEndian, id)
+	_ = binary.Write(buf, binary.LittleEndian, uint16(len(value)))
+	buf.Write(value)
+}
+
+// newNTLMChallenge builds a CHALLENGE_MESSAGE, returns the message and the server challenge in it.
+func (f *FacadeServer) newNTLMChallenge() ([]byte, []byte, error) {
+	serverChallenge := make([]byte, 8)
+	if len(f.ntlmChallenge) == 8 {
+		copy(serverChallenge, f.ntlmChallenge)
+	} else if _, err := rand.Read(serverChallenge); err != nil {
+		return nil, nil, err
+	}
+
+	domain := protocol_impl.UnicodeEncode(ntlmDefaultTargetDomain)
+	computer := protocol_impl.UnicodeEncode(ntlmDefaultTargetComputerName)
+	timestamp := make([]byte, 8)
+	binary.LittleEndian.PutUint64(timestamp, windowsFileTime(time.Now()))
+	var targetInfo bytes.Buffer
+	ntlmAVPair(&targetInfo, 2, domain)   // MsvAvNbDomainName
+	ntlmAVPair(&targetInfo, 1, computer) // MsvAvNbComputerName
+	ntlmAVPair(&targetInfo, 4, domain)   // MsvAvDnsDomainName
+	ntlmAVPair(&targetInfo, 3, computer) // MsvAvDnsComputerName
+	ntlmAVPair(&targetInfo, 7, timestamp)
+	ntlmAVPair(&targetInfo, 0, nil) // MsvAvEOL
+
+	msg := protocol_impl.NewChallengeMessage()
+	copy(msg.Signature[:], ntlmSignature)
+	msg.MessageType = 2
+	msg.NegotiateFlags = ntlmDefaultChallengeFlags
+	copy(msg.ServerChallenge[:], serverChallenge)
+	msg.TargetNameFields = msg.NewField(domain)
+	msg.TargetInfoFields = msg.NewField(targetInfo.Bytes())
+	msg.Version = protocol_impl.Version{
+		ProductMajorVersion: 10,
+		ProductBuild:        17763,
+		NTLMRevisionCurrent: 15,
+	}
+	raw, err := msg.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+	return raw, serverChallenge, nil
+}
+
+// parseNTLMAuthenticate parses an AUTHENTICATE_MESSAGE, the fixed header is read directly because
+// a client may omit the version and the mic, and then the payload starts before offset 88.
+func parseNTLMAuthenticate(msg []byte, serverChallenge []byte) (*NTLMCapture, error) {
+	if ntlmMessageType(msg) != 3 || len(msg) < 64 {
+		return nil, utils.Error("not a ntlm authenticate message")
+	}
+	field := func(offset int) ([]byte, error) {
+		length := int(binary.LittleEndian.Uint16(msg[offset:]))
+		start := int(binary.LittleEndian.Uint32(msg[offset+4:]))
+		if start+length > len(msg) || start < 0 {
+			return nil, utils.Errorf("invalid ntlm field at %d", offset)
+		}
+		return msg[start : start+length], nil
+	}
+	lm, err := field(12)
+	if err != nil {
+		return nil, err
+	}
+	nt, err := field(20)
+	if err != nil {
+		return nil, err
+	}
+	var names [3]string
+	isUnicode := binary.LittleEndian.Uint32(msg[60:])&ntlmNegotiateUnicode != 0
+	for i, offset := range []int{28, 36, 44} {
+		value, err := field(offset)
+		if err != nil {
+			return nil, err
+		}
+		if isUnicode {
+			value, err = unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder().Bytes(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		names[i] = string(value)
+	}
+
+	capture := &NTLMCapture{
+		Domain:          names[0],
+		User:            names[1],
+		Workstation:     names[2],
+		ServerChallenge: codec.EncodeToHex(serverChallenge),
+	}
+	switch {
+	case capture.User == "" && len(nt) == 0:
+		return nil, utils.Error("anonymous ntlm authentication")
+	case len(nt) == 24:
+		capture.Version = "NTLMv1"
+		capture.HashcatMode = 5500
+		capture.Hashcat = fmt.Sprintf("%s::%s:%s:%s:%s", capture.User, capture.Domain, codec.EncodeToHex(lm), codec.EncodeToHex(nt), capture.ServerChallenge)
+	case len(nt) > 24:
+		capture.Version = "NTLMv2"
+		capture.HashcatMode = 5600
+		capture.Hashcat = fmt.Sprintf("%s::%s:%s:%s:%s", capture.User, capture.Domain, capture.ServerChallenge, codec.EncodeToHex(nt[:16]), codec.EncodeToHex(nt[16:]))
+	default:
+		return nil, utils.Errorf("invalid ntlm response length: %d", len(nt))
+	}
+	return capture, nil
+}
+
+// ntlmHandshake keeps the server challenge between the negotiate and the authenticate message,
+// tokens are ntlmssp messages or spnego tokens wrapping them.
+type ntlmHandshake struct {
+	server          *FacadeServer
+	protocol        string
+	serverChallenge []byte
+}
+
+// next handles a token from the client, returns the token to response for a NEGOTIATE_MESSAGE
+// and the capture for an AUTHENTICATE_MESSAGE.
+func (h *ntlmHandshake) next(token []byte) ([]byte, *NTLMCapture, error) {
+	msg, isSpnego := unwrapNTLMToken(token)
+	switch ntlmMessageType(msg) {
+	case 1:
+		challenge, serverChallenge, err := h.server.newNTLMChallenge()
+		if err != nil {
+			return nil, nil, err
+		}
+		h.serverChallenge = serverChallenge
+		if isSpnego {
+			challenge = spnegoNegTokenResp(1, challenge)
+		}
+		return challenge, nil, nil
+	case 3:
+		if h.serverChallenge == nil {
+			return nil, nil, utils.Error("ntlm authenticate message without challenge")
+		}
+		capture, err := parseNTLMAuthenticate(msg, h.serverChallenge)
+		if err != nil {
+			return nil, nil, err
+		}
+		capture.Protocol = h.protocol
+		return nil, capture, nil
+	default:
+		return nil, nil, utils.Error("not a ntlm token")
+	}
+}
+
+func (f *FacadeServer) newNTLMHandshake(protocol string) *ntlmHandshake {
+	return &ntlmHandshake{server: f, protocol: protocol}
+}
+
+func (f *FacadeServer) triggerNTLMNotification(conn net.Conn, capture *NTLMCapture) {
+	remoteAddr := f.ConvertRemoteAddr(conn.RemoteAddr().String())
+	log.Infof("capture %v hash of %v via %v from %v", capture.Version, capture.Account(), capture.Protocol, remoteAddr)
+
+	notif := NewNotification(NTLMMsgFlag, remoteAddr, []byte(capture.Hashcat), capture.Account())
+	notif.ConnectHash = codec.Md5(fmt.Sprintf("%p", conn))
+	notif.ResponseInfo = fmt.Sprintf("%s %s", strings.ToUpper(capture.Protocol), capture.Version)
+	notif.NTLM = capture
+	f.handleNotification(notif)
+}
+
+// unwrapNTLMToken finds the ntlmssp message in a gss-api token, the second return value
+// reports whether the token is wrapped by spnego.
+func unwrapNTLMToken(token []byte) ([]byte, bool) {
+	if bytes.HasPrefix(token, ntlmSignature) {
+		return token, false
+	}
+	idx := bytes.Index(token, ntlmSignature)
+	if idx < 0 {
+		return nil, false
+	}
+	return token[idx:], true
+}
+
+var (
+	spnegoOID  = []byte{0x2b, 0x06, 0x01, 0x05, 0x05, 0x02}
+	ntlmsspOID = []byte{0x2b, 0x06, 0x01, 0x04, 0x01, 0x82, 0x37, 0x02, 0x02, 0x0a}
+)
+
+func berLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+	var raw []byte
+	for ; n > 0; n >>= 8 {
+		raw = append([]byte{byte(n)}, raw...)
+	}
+	return append([]byte{0x80 | byte(len(raw))}, raw...)
+}
+
+func berTLV(tag byte, contents ...[]byte) []byte {
+	content := bytes.Join(contents, nil)
+	return append(append([]byte{tag}, berLength(len(content))...), content...)
+}
+
+// spnegoNegTokenInit is the security blob of a server announcing ntlmssp only
+func spnegoNegTokenInit() []byte {
+	return berTLV(0x60,
+		berTLV(0x06, spnegoOID),
+		berTLV(0xa0, berTLV(0x30,
+			berTLV(0xa0, berTLV(0x30, berTLV(0x06, ntlmsspOID))),
+		)),
+	)
+}
+
+// spnegoNegTokenResp wraps a ntlmssp message, state 1 is accept-incomplete
+func spnegoNegTokenResp(state byte, token []byte) []byte {
+	return berTLV(0xa1, berTLV(0x30,
+		berTLV(0xa0, berTLV(0x0a, []byte{state})),
+		berTLV(0xa1, berTLV(0x06, ntlmsspOID)),
+		berTLV(0xa2, berTLV(0x04, token)),
+	))
+}
+
+// berReadTLV reads an element with a single byte tag, indefinite length is not supported.
+func berReadTLV(data []byte) (tag byte, content []byte, rest []byte, err error) {
+	header, length, err := berHeader(data)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if header+length > len(data) {
+		return 0, nil, nil, utils.Error("ber element is truncated")
+	}
+	return data[0], data[header : header+length], data[header+length:], nil
+}
+
+// berHeader returns the size of the tag and length octets, and the length of the content
+func berHeader(data []byte) (int, int, error) {
+	if len(data) < 2 {
+		return 0, 0, utils.Error("ber element is truncated")
+	}
+	if data[1]&0x80 == 0 {
+		return 2, int(data[1]), nil
+	}
+	n := int(data[1] & 0x7f)
+	if n == 0 || n > 4 || len(data) < 2+n {
+		return 0, 0, utils.Error("invalid ber length")
+	}
+	length := 0
+	for _, b := range data[2 : 2+n] {
+		length = length<<8 | int(b)
+	}
+	return 2 + n, length, nil
+}
@@ -0,0 +1,225 @@
+package facades
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	protocol_impl "github.com/yaklang/yaklang/common/bin-parser/protocol-impl"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+const testNTLMChallenge = "1122334455667788"
+
+func startNTLMCaptureServer(t *testing.T, configs ...FacadeServerConfig) (string, chan *Notification) {
+	port := utils.GetRandomAvailableTCPPort()
+	server := NewFacadeServer("127.0.0.1", port, append([]FacadeServerConfig{SetNTLMChallenge(testNTLMChallenge)}, configs...)...)
+	captured := make(chan *Notification, 1)
+	server.OnHandle(func(n *Notification) {
+		if n.Type == NTLMMsgFlag {
+			captured <- n
+		}
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go server.ServeWithContext(ctx)
+	addr := utils.HostPort("127.0.0.1", port)
+	require.NoError(t, utils.WaitConnect(addr, 3))
+	return addr, captured
+}
+
+func ntlmNegotiateMessage(t *testing.T) []byte {
+	msg := protocol_impl.NewNegotiateMessage()
+	copy(msg.Signature[:], ntlmSignature)
+	msg.MessageType = 1
+	msg.NegotiateFlags = ntlmNegotiateUnicode | ntlmNegotiateNTLM | ntlmRequestTarget
+	raw, err := msg.Marshal()
+	require.NoError(t, err)
+	return raw
+}
+
+// ntlmAuthenticateMessage answers the challenge with NTLMv2 and returns the expected hashcat line
+func ntlmAuthenticateMessage(t *testing.T, challenge []byte) ([]byte, string) {
+	require.Equal(t, uint32(2), ntlmMessageType(challenge))
+	serverChallenge := challenge[24:32]
+	require.Equal(t, testNTLMChallenge, codec.EncodeToHex(serverChallenge))
+
+	user, domain := "Administrator", "YAKLANG"
+	nt := protocol_impl.NTOWFv2("P@ssw0rd", user, domain)
+	clientChallenge := []byte("yakclien")
+	timestamp := make([]byte, 8)
+	binary.LittleEndian.PutUint64(timestamp, windowsFileTime(time.Now()))
+	netNt, netLm, _ := protocol_impl.NetNTLMv2(nt, nt, serverChallenge, clientChallenge, timestamp, nil)
+
+	msg := protocol_impl.NewAuthenticationMessage()
+	copy(msg.Signature[:], ntlmSignature)
+	msg.MessageType = 3
+	msg.LmChallengeResponseFields = msg.NewField(netLm)
+	msg.NtChallengeResponseFields = msg.NewField(netNt)
+	msg.DomainNameFields = msg.NewField(protocol_impl.UnicodeEncode(domain))
+	msg.UserNameFields = msg.NewField(protocol_impl.UnicodeEncode(user))
+	msg.WorkstationFields = msg.NewField(protocol_impl.UnicodeEncode("WIN10"))
+	msg.EncryptedRandomSessionKeyFields = msg.NewField(nil)
+	binary.LittleEndian.PutUint32(msg.NegotiateFlags[:], ntlmNegotiateUnicode|ntlmNegotiateNTLM)
+	raw, err := msg.Marshal()
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf("%s::%s:%s:%s:%s", user, domain, testNTLMChallenge, codec.EncodeToHex(netNt[:16]), codec.EncodeToHex(netNt[16:]))
+	return raw, expected
+}
+
+func requireNTLMCapture(t *testing.T, captured chan *Notification, protocol string, expected string) {
+	select {
+	case n := <-captured:
+		require.Equal(t, expected, string(n.Raw))
+		require.Equal(t, `YAKLANG\Administrator`, n.Token)
+		require.NotNil(t, n.NTLM)
+		require.Equal(t, protocol, n.NTLM.Protocol)
+		require.Equal(t, "NTLMv2", n.NTLM.Version)
+		require.Equal(t, 5600, n.NTLM.HashcatMode)
+		require.Equal(t, "WIN10", n.NTLM.Workstation)
+	case <-time.After(5 * time.Second):
+		t.Fatal("ntlm hash is not captured")
+	}
+}
+
+func TestNTLMCapture_HTTP(t *testing.T) {
+	addr, captured := startNTLMCaptureServer(t, SetNTLMCapture(true), SetHttpResource("secret", []byte("ok")))
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	request := func(authorization string) *http.Response {
+		packet := "GET /secret HTTP/1.1\r\nHost: " + addr + "\r\n"
+		if authorization != "" {
+			packet += "Authorization: " + authorization + "\r\n"
+		}
+		_, err := conn.Write([]byte(packet + "\r\n"))
+		require.NoError(t, err)
+		rsp, err := http.ReadResponse(reader, nil)
+		require.NoError(t, err)
+		io.Copy(io.Discard, rsp.Body)
+		return rsp
+	}
+
+	rsp := request("")
+	require.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+	require.Contains(t, rsp.Header.Values("WWW-Authenticate"), "NTLM")
+
+	rsp = request("NTLM " + codec.EncodeBase64(ntlmNegotiateMessage(t)))
+	require.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+	fields := strings.Fields(rsp.Header.Get("WWW-Authenticate"))
+	require.Len(t, fields, 2)
+	challenge, err := codec.DecodeBase64(fields[1])
+	require.NoError(t, err)
+
+	authenticate, expected := ntlmAuthenticateMessage(t, challenge)
+	rsp = request("NTLM " + codec.EncodeBase64(authenticate))
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	requireNTLMCapture(t, captured, "http", expected)
+}
+
+func TestNTLMCapture_SMB(t *testing.T) {
+	addr, captured := startNTLMCaptureServer(t)
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	smb2Request := func(command uint16, messageId uint64, body []byte) []byte {
+		header := make([]byte, smb2HeaderSize)
+		copy(header, smb2ProtocolId)
+		binary.LittleEndian.PutUint16(header[4:], smb2HeaderSize)
+		binary.LittleEndian.PutUint16(header[12:], command)
+		binary.LittleEndian.PutUint64(header[24:], messageId)
+		require.NoError(t, writeNetbiosMessage(conn, header, body))
+		rsp, err := readNetbiosMessage(conn)
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(rsp, smb2ProtocolId))
+		require.Equal(t, messageId, binary.LittleEndian.Uint64(rsp[24:]))
+		return rsp
+	}
+	sessionSetup := func(messageId uint64, token []byte) []byte {
+		body := make([]byte, 24)
+		binary.LittleEndian.PutUint16(body[0:], 25)
+		binary.LittleEndian.PutUint16(body[12:], smb2HeaderSize+24)
+		binary.LittleEndian.PutUint16(body[14:], uint16(len(token)))
+		return smb2Request(smb2CommandSessionSetup, messageId, append(body, token...))
+	}
+
+	negotiate := make([]byte, 36)
+	binary.LittleEndian.PutUint16(negotiate[0:], 36)
+	binary.LittleEndian.PutUint16(negotiate[2:], 2)
+	negotiate = append(negotiate, 0x02, 0x02, 0x10, 0x02)
+	rsp := smb2Request(smb2CommandNegotiate, 0, negotiate)
+	require.Equal(t, uint32(smbStatusSuccess), binary.LittleEndian.Uint32(rsp[8:]))
+	require.Equal(t, uint16(smb2Dialect210), binary.LittleEndian.Uint16(rsp[smb2HeaderSize+4:]))
+
+	rsp = sessionSetup(1, spnegoNegTokenResp(1, ntlmNegotiateMessage(t)))
+	require.Equal(t, uint32(smbStatusMoreProcessingRequired), binary.LittleEndian.Uint32(rsp[8:]))
+	challenge, isSpnego := unwrapNTLMToken(rsp[smb2SessionSetupSecurityBufOffset:])
+	require.True(t, isSpnego)
+
+	authenticate, expected := ntlmAuthenticateMessage(t, challenge)
+	rsp = sessionSetup(2, spnegoNegTokenResp(1, authenticate))
+	require.Equal(t, uint32(smbStatusAccessDenied), binary.LittleEndian.Uint32(rsp[8:]))
+	requireNTLMCapture(t, captured, "smb", expected)
+}
+
+func TestNTLMCapture_LDAPSicily(t *testing.T) {
+	addr, captured := startNTLMCaptureServer(t, SetNTLMCapture(true))
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	bind := func(messageId int, auth []byte) []byte {
+		_, err := conn.Write(berTLV(0x30,
+			berTLV(0x02, berInteger(messageId)),
+			berTLV(ldapTagBindRequest, berTLV(0x02, []byte{3}), berTLV(0x04, nil), auth),
+		))
+		require.NoError(t, err)
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		header := make([]byte, 2)
+		_, err = io.ReadFull(conn, header)
+		require.NoError(t, err)
+		if header[1]&0x80 != 0 {
+			header = append(header, make([]byte, header[1]&0x7f)...)
+			_, err = io.ReadFull(conn, header[2:])
+			require.NoError(t, err)
+		}
+		_, length, err := berHeader(header)
+		require.NoError(t, err)
+		rsp := make([]byte, length)
+		_, err = io.ReadFull(conn, rsp)
+		require.NoError(t, err)
+
+		// message id, bind response { result code, matched dn }
+		_, _, rest, err := berReadTLV(rsp)
+		require.NoError(t, err)
+		tag, op, _, err := berReadTLV(rest)
+		require.NoError(t, err)
+		require.Equal(t, byte(ldapTagBindResponse), tag)
+		_, code, rest, err := berReadTLV(op)
+		require.NoError(t, err)
+		require.Equal(t, []byte{ldapResultSuccess}, code)
+		_, matchedDN, _, err := berReadTLV(rest)
+		require.NoError(t, err)
+		return matchedDN
+	}
+
+	require.Equal(t, []byte("NTLM"), bind(1, berTLV(ldapAuthSicilyDiscovery, nil)))
+	challenge := bind(2, berTLV(ldapAuthSicilyNegotiate, ntlmNegotiateMessage(t)))
+	authenticate, expected := ntlmAuthenticateMessage(t, challenge)
+	bind(3, berTLV(ldapAuthSicilyResponse, authenticate))
+	requireNTLMCapture(t, captured, "ldap", expected)
+}
@@ -0,0 +1,200 @@
+package facades
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var (
+	smb1ProtocolId = []byte("\xffSMB")
+	smb2ProtocolId = []byte("\xfeSMB")
+)
+
+const (
+	smb1CommandNegotiate = 0x72
+
+	smb2CommandNegotiate    = 0x0000
+	smb2CommandSessionSetup = 0x0001
+
+	smb2HeaderSize = 64
+
+	smb2DialectWildcard = 0x02ff
+	smb2Dialect202      = 0x0202
+	smb2Dialect210      = 0x0210
+
+	smbStatusSuccess                  = 0x00000000
+	smbStatusMoreProcessingRequired   = 0xc0000016
+	smbStatusAccessDenied             = 0xc0000022
+	smb2FlagServerToRedir             = 0x00000001
+	smb2NegotiateSigningEnabled       = 0x0001
+	smb2GlobalCapDFS                  = 0x00000001
+	smb2MaxTransactSize               = 0x00800000
+	smbNetbiosSessionMessage          = 0x00
+	smbNetbiosMaxMessageSize          = 0x00ffffff
+	smb2SessionSetupSecurityBufOffset = smb2HeaderSize + 8
+	smb2NegotiateSecurityBufOffset    = smb2HeaderSize + 64
+)
+
+// smbServe is a SMB2 responder, it negotiates the ntlm authentication of a session setup and
+// captures the Net-NTLM hash, the session is always rejected with STATUS_ACCESS_DENIED.
+func (f *FacadeServer) smbServe(conn *utils.BufferedPeekableConn) error {
+	serverGuid := make([]byte, 16)
+	_, _ = rand.Read(serverGuid)
+	sessionId := make([]byte, 8)
+	_, _ = rand.Read(sessionId)
+	handshake := f.newNTLMHandshake("smb")
+
+	for {
+		conn.SetDeadline(time.Now().Add(10 * time.Second))
+		packet, err := readNetbiosMessage(conn)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case bytes.HasPrefix(packet, smb1ProtocolId):
+			// a client starts with a SMB1 negotiate, response the SMB2 wildcard dialect to upgrade
+			if len(packet) < 5 || packet[4] != smb1CommandNegotiate {
+				return utils.Error("unsupported smb1 command")
+			}
+			if !bytes.Contains(packet, []byte("SMB 2.")) {
+				return utils.Error("smb client does not support smb2")
+			}
+			header := smb2ResponseHeader(nil, smb2CommandNegotiate, smbStatusSuccess)
+			err = writeNetbiosMessage(conn, header, smb2NegotiateResponse(smb2DialectWildcard, serverGuid))
+		case bytes.HasPrefix(packet, smb2ProtocolId) && len(packet) >= smb2HeaderSize:
+			command := binary.LittleEndian.Uint16(packet[12:])
+			switch command {
+			case smb2CommandNegotiate:
+				header := smb2ResponseHeader(packet, smb2CommandNegotiate, smbStatusSuccess)
+				err = writeNetbiosMessage(conn, header, smb2NegotiateResponse(smb2SelectDialect(packet), serverGuid))
+			case smb2CommandSessionSetup:
+				if len(packet) < smb2HeaderSize+24 {
+					return utils.Error("invalid smb2 session setup request")
+				}
+				offset := int(binary.LittleEndian.Uint16(packet[smb2HeaderSize+12:]))
+				length := int(binary.LittleEndian.Uint16(packet[smb2HeaderSize+14:]))
+				if offset+length > len(packet) {
+					return utils.Error("invalid smb2 security buffer")
+				}
+				token, capture, err := handshake.next(packet[offset : offset+length])
+				if err != nil {
+					return err
+				}
+				if capture != nil {
+					f.triggerNTLMNotification(conn.GetOriginConn(), capture)
+					header := smb2ResponseHeader(packet, smb2CommandSessionSetup, smbStatusAccessDenied)
+					copy(header[40:48], sessionId)
+					// SMB2 ERROR Response
+					_ = writeNetbiosMessage(conn, header, []byte{0x09, 0, 0, 0, 0, 0, 0, 0, 0})
+					return nil
+				}
+				header := smb2ResponseHeader(packet, smb2CommandSessionSetup, smbStatusMoreProcessingRequired)
+				copy(header[40:48], sessionId)
+				err = writeNetbiosMessage(conn, header, smb2SessionSetupResponse(token))
+			default:
+				return utils.Errorf("unsupported smb2 command: %d", command)
+			}
+		default:
+			return utils.Error("invalid smb packet")
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func readNetbiosMessage(r io.Reader) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if header[0] != smbNetbiosSessionMessage {
+		return nil, utils.Errorf("unsupported netbios message type: %d", header[0])
+	}
+	length := int(binary.BigEndian.Uint32(header) & smbNetbiosMaxMessageSize)
+	packet := make([]byte, length)
+	if _, err := io.ReadFull(r, packet); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+func writeNetbiosMessage(w io.Writer, contents ...[]byte) error {
+	packet := bytes.Join(contents, nil)
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, uint32(len(packet))&smbNetbiosMaxMessageSize)
+	_, err := w.Write(append(header, packet...))
+	if err != nil {
+		log.Errorf("write smb response failed: %s", err)
+	}
+	return err
+}
+
+// smb2ResponseHeader builds the response header for request, request is nil for a SMB1 negotiate
+func smb2ResponseHeader(request []byte, command uint16, status uint32) []byte {
+	header := make([]byte, smb2HeaderSize)
+	copy(header, smb2ProtocolId)
+	binary.LittleEndian.PutUint16(header[4:], smb2HeaderSize)
+	binary.LittleEndian.PutUint32(header[8:], status)
+	binary.LittleEndian.PutUint16(header[12:], command)
+	binary.LittleEndian.PutUint16(header[14:], 1) // credits granted
+	binary.LittleEndian.PutUint32(header[16:], smb2FlagServerToRedir)
+	if request != nil {
+		copy(header[6:8], request[6:8])     // credit charge
+		copy(header[24:32], request[24:32]) // message id
+		copy(header[32:40], request[32:40]) // process id and tree id
+		copy(header[40:48], request[40:48]) // session id
+	}
+	return header
+}
+
+// smb2SelectDialect selects SMB 2.1 or SMB 2.0.2, dialects after 3.0 need negotiate contexts and signing
+func smb2SelectDialect(request []byte) uint16 {
+	body := request[smb2HeaderSize:]
+	if len(body) < 36 {
+		return smb2Dialect210
+	}
+	count := int(binary.LittleEndian.Uint16(body[2:]))
+	selected := uint16(smb2Dialect210)
+	for i := 0; i < count && 36+i*2+2 <= len(body); i++ {
+		switch dialect := binary.LittleEndian.Uint16(body[36+i*2:]); dialect {
+		case smb2Dialect210:
+			return smb2Dialect210
+		case smb2Dialect202:
+			selected = smb2Dialect202
+		}
+	}
+	return selected
+}
+
+func smb2NegotiateResponse(dialect uint16, serverGuid []byte) []byte {
+	securityBlob := spnegoNegTokenInit()
+	body := make([]byte, 64)
+	binary.LittleEndian.PutUint16(body[0:], 65) // structure size
+	binary.LittleEndian.PutUint16(body[2:], smb2NegotiateSigningEnabled)
+	binary.LittleEndian.PutUint16(body[4:], dialect)
+	copy(body[8:24], serverGuid)
+	binary.LittleEndian.PutUint32(body[24:], smb2GlobalCapDFS)
+	binary.LittleEndian.PutUint32(body[28:], smb2MaxTransactSize)
+	binary.LittleEndian.PutUint32(body[32:], smb2MaxTransactSize)
+	binary.LittleEndian.PutUint32(body[36:], smb2MaxTransactSize)
+	binary.LittleEndian.PutUint64(body[40:], windowsFileTime(time.Now()))
+	binary.LittleEndian.PutUint16(body[56:], smb2NegotiateSecurityBufOffset)
+	binary.LittleEndian.PutUint16(body[58:], uint16(len(securityBlob)))
+	return append(body, securityBlob...)
+}
+
+func smb2SessionSetupResponse(securityBlob []byte) []byte {
+	body := make([]byte, 8)
+	binary.LittleEndian.PutUint16(body[0:], 9) // structure size
+	binary.LittleEndian.PutUint16(body[4:], smb2SessionSetupSecurityBufOffset)
+	binary.LittleEndian.PutUint16(body[6:], uint16(len(securityBlob)))
+	return append(body, securityBlob...)
+}
@@ -48,10 +48,28 @@ type PageTimings struct {
 	OnLoad        float64 `json:"onLoad"`
 }
 type HAREntry struct {
+	Pageref         string            `json:"pageref,omitempty"`
+	StartedDateTime string            `json:"startedDateTime,omitempty"`
+	Time            float64           `json:"time,omitempty"`
 	Request         *HARRequest       `json:"request"`
 	Response        *HARResponse      `json:"response"`
+	Timings         *Timings          `json:"timings,omitempty"`
 	ServerIPAddress string            `json:"serverIPAddress"`
+	Connection      string            `json:"connection,omitempty"`
 	MetaData        *HTTPFlowMetaData `json:"metaData,omitempty"`
+
+	// chrome devtools extensions
+	ResourceType      string                 `json:"_resourceType,omitempty"`
+	WebSocketMessages []*HARWebSocketMessage `json:"_webSocketMessages,omitempty"`
+}
+
+// HARWebSocketMessage is a websocket frame recorded by devtools, type is send or receive,
+// time is the unix timestamp in seconds, opcode 1 is text and 2 is binary (base64 encoded data)
+type HARWebSocketMessage struct {
+	Type   string  `json:"type"`
+	Time   float64 `json:"time"`
+	Opcode int     `json:"opcode"`
+	Data   string  `json:"data"`
 }
 
 type HARKVPair struct {
@@ -132,6 +150,7 @@ type HTTPFlowMetaData struct {
 	Tags               string         `json:"tags,omitempty"` // 用来打标！
 	Payload            string         `json:"payload,omitempty"`
 	IsWebsocket        bool           `json:"is_websocket,omitempty"`
+	WebsocketHash      string         `json:"websocket_hash,omitempty"`
 	FromPlugin         string         `json:"from_plugin,omitempty"`
 	ProcessName        sql.NullString `json:"process_name,omitempty"`
 	UploadOnline       bool           `json:"upload_online,omitempty"`
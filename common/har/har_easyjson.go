@@ -530,6 +530,8 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar4(in *jlexer.Lexer, o
 			out.Payload = string(in.String())
 		case "is_websocket":
 			out.IsWebsocket = bool(in.Bool())
+		case "websocket_hash":
+			out.WebsocketHash = string(in.String())
 		case "from_plugin":
 			out.FromPlugin = string(in.String())
 		case "process_name":
@@ -615,6 +617,11 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar4(out *jwriter.Writer
 		out.RawString(prefix)
 		out.Bool(bool(in.IsWebsocket))
 	}
+	if in.WebsocketHash != "" {
+		const prefix string = ",\"websocket_hash\":"
+		out.RawString(prefix)
+		out.String(string(in.WebsocketHash))
+	}
 	if in.FromPlugin != "" {
 		const prefix string = ",\"from_plugin\":"
 		out.RawString(prefix)
@@ -783,7 +790,94 @@ func (v *HTTPArchive) UnmarshalJSON(data []byte) error {
 func (v *HTTPArchive) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar5(l, v)
 }
-func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar6(in *jlexer.Lexer, out *HARResponse) {
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar6(in *jlexer.Lexer, out *HARWebSocketMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "time":
+			out.Time = float64(in.Float64())
+		case "opcode":
+			out.Opcode = int(in.Int())
+		case "data":
+			out.Data = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar6(out *jwriter.Writer, in HARWebSocketMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Time))
+	}
+	{
+		const prefix string = ",\"opcode\":"
+		out.RawString(prefix)
+		out.Int(int(in.Opcode))
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		out.String(string(in.Data))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HARWebSocketMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HARWebSocketMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HARWebSocketMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HARWebSocketMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar6(l, v)
+}
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar7(in *jlexer.Lexer, out *HARResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -894,7 +988,7 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar6(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar6(out *jwriter.Writer, in HARResponse) {
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar7(out *jwriter.Writer, in HARResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -978,27 +1072,27 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar6(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v HARResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar6(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HARResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar6(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HARResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar6(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HARResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar6(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar7(l, v)
 }
-func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar7(in *jlexer.Lexer, out *HARRequest) {
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar8(in *jlexer.Lexer, out *HARRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1119,7 +1213,7 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar7(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar7(out *jwriter.Writer, in HARRequest) {
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar8(out *jwriter.Writer, in HARRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1208,27 +1302,27 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar7(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v HARRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar7(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HARRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar7(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HARRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar7(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HARRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar7(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar8(l, v)
 }
-func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar8(in *jlexer.Lexer, out *HARKVPair) {
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar9(in *jlexer.Lexer, out *HARKVPair) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1261,7 +1355,7 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar8(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar8(out *jwriter.Writer, in HARKVPair) {
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar9(out *jwriter.Writer, in HARKVPair) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1281,27 +1375,27 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar8(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v HARKVPair) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar8(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HARKVPair) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar8(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HARKVPair) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar8(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HARKVPair) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar8(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar9(l, v)
 }
-func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar9(in *jlexer.Lexer, out *HARHTTPPostData) {
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar10(in *jlexer.Lexer, out *HARHTTPPostData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1365,7 +1459,7 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar9(in *jlexer.Lexer, o
 		in.Consumed()
 	}
 }
-func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar9(out *jwriter.Writer, in HARHTTPPostData) {
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar10(out *jwriter.Writer, in HARHTTPPostData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1405,27 +1499,27 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar9(out *jwriter.Writer
 // MarshalJSON supports json.Marshaler interface
 func (v HARHTTPPostData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar9(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HARHTTPPostData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar9(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HARHTTPPostData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar9(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HARHTTPPostData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar9(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar10(l, v)
 }
-func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar10(in *jlexer.Lexer, out *HARHTTPParam) {
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar11(in *jlexer.Lexer, out *HARHTTPParam) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1462,7 +1556,7 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar10(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar10(out *jwriter.Writer, in HARHTTPParam) {
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar11(out *jwriter.Writer, in HARHTTPParam) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1492,27 +1586,27 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar10(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v HARHTTPParam) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar10(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HARHTTPParam) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar10(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HARHTTPParam) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar10(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HARHTTPParam) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar10(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar11(l, v)
 }
-func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar11(in *jlexer.Lexer, out *HARHTTPContent) {
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(in *jlexer.Lexer, out *HARHTTPContent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1549,7 +1643,7 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar11(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar11(out *jwriter.Writer, in HARHTTPContent) {
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar12(out *jwriter.Writer, in HARHTTPContent) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1579,27 +1673,27 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar11(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v HARHTTPContent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar11(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HARHTTPContent) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar11(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HARHTTPContent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar11(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HARHTTPContent) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar11(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(l, v)
 }
-func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(in *jlexer.Lexer, out *HAREntry) {
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar13(in *jlexer.Lexer, out *HAREntry) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1618,6 +1712,12 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
+		case "pageref":
+			out.Pageref = string(in.String())
+		case "startedDateTime":
+			out.StartedDateTime = string(in.String())
+		case "time":
+			out.Time = float64(in.Float64())
 		case "request":
 			if in.IsNull() {
 				in.Skip()
@@ -1638,8 +1738,20 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(in *jlexer.Lexer,
 				}
 				(*out.Response).UnmarshalEasyJSON(in)
 			}
+		case "timings":
+			if in.IsNull() {
+				in.Skip()
+				out.Timings = nil
+			} else {
+				if out.Timings == nil {
+					out.Timings = new(Timings)
+				}
+				(*out.Timings).UnmarshalEasyJSON(in)
+			}
 		case "serverIPAddress":
 			out.ServerIPAddress = string(in.String())
+		case "connection":
+			out.Connection = string(in.String())
 		case "metaData":
 			if in.IsNull() {
 				in.Skip()
@@ -1650,6 +1762,39 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(in *jlexer.Lexer,
 				}
 				(*out.MetaData).UnmarshalEasyJSON(in)
 			}
+		case "_resourceType":
+			out.ResourceType = string(in.String())
+		case "_webSocketMessages":
+			if in.IsNull() {
+				in.Skip()
+				out.WebSocketMessages = nil
+			} else {
+				in.Delim('[')
+				if out.WebSocketMessages == nil {
+					if !in.IsDelim(']') {
+						out.WebSocketMessages = make([]*HARWebSocketMessage, 0, 8)
+					} else {
+						out.WebSocketMessages = []*HARWebSocketMessage{}
+					}
+				} else {
+					out.WebSocketMessages = (out.WebSocketMessages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 *HARWebSocketMessage
+					if in.IsNull() {
+						in.Skip()
+						v19 = nil
+					} else {
+						if v19 == nil {
+							v19 = new(HARWebSocketMessage)
+						}
+						(*v19).UnmarshalEasyJSON(in)
+					}
+					out.WebSocketMessages = append(out.WebSocketMessages, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1660,13 +1805,44 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar12(out *jwriter.Writer, in HAREntry) {
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar13(out *jwriter.Writer, in HAREntry) {
 	out.RawByte('{')
 	first := true
 	_ = first
+	if in.Pageref != "" {
+		const prefix string = ",\"pageref\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Pageref))
+	}
+	if in.StartedDateTime != "" {
+		const prefix string = ",\"startedDateTime\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.StartedDateTime))
+	}
+	if in.Time != 0 {
+		const prefix string = ",\"time\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Time))
+	}
 	{
 		const prefix string = ",\"request\":"
-		out.RawString(prefix[1:])
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
 		if in.Request == nil {
 			out.RawString("null")
 		} else {
@@ -1682,67 +1858,99 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar12(out *jwriter.Write
 			(*in.Response).MarshalEasyJSON(out)
 		}
 	}
+	if in.Timings != nil {
+		const prefix string = ",\"timings\":"
+		out.RawString(prefix)
+		(*in.Timings).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"serverIPAddress\":"
 		out.RawString(prefix)
 		out.String(string(in.ServerIPAddress))
 	}
+	if in.Connection != "" {
+		const prefix string = ",\"connection\":"
+		out.RawString(prefix)
+		out.String(string(in.Connection))
+	}
 	if in.MetaData != nil {
 		const prefix string = ",\"metaData\":"
 		out.RawString(prefix)
 		(*in.MetaData).MarshalEasyJSON(out)
 	}
+	if in.ResourceType != "" {
+		const prefix string = ",\"_resourceType\":"
+		out.RawString(prefix)
+		out.String(string(in.ResourceType))
+	}
+	if len(in.WebSocketMessages) != 0 {
+		const prefix string = ",\"_webSocketMessages\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v20, v21 := range in.WebSocketMessages {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				if v21 == nil {
+					out.RawString("null")
+				} else {
+					(*v21).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v HAREntry) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar12(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v HAREntry) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar12(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *HAREntry) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *HAREntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar12(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar13(l, v)
 }
-
 // MarshalJSON supports json.Marshaler interface
 func (v Entries) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar13(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Entries) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar13(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Entries) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar13(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Entries) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar13(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar14(l, v)
 }
-func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar14(in *jlexer.Lexer, out *Creator) {
+func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar15(in *jlexer.Lexer, out *Creator) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1775,7 +1983,7 @@ func easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar14(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar14(out *jwriter.Writer, in Creator) {
+func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar15(out *jwriter.Writer, in Creator) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1795,23 +2003,23 @@ func easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar14(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v Creator) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar14(&w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Creator) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar14(w, v)
+	easyjson46e2e00bEncodeGithubComYaklangYaklangCommonHar15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Creator) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar14(&r, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Creator) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar14(l, v)
+	easyjson46e2e00bDecodeGithubComYaklangYaklangCommonHar15(l, v)
 }
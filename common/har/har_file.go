@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"github.com/mailru/easyjson"
+	"github.com/samber/lo"
 	"github.com/yaklang/yaklang/common/utils"
 )
 
@@ -14,29 +15,27 @@ func ImportHTTPArchive(r io.Reader) (*HTTPArchive, error) {
 }
 func CountHTTPArchiveEntries(r io.Reader) (int, error) {
 	count := 0
-	har := &HTTPArchive{
-		Log: &Log{
-			Entries: &Entries{
-				unmarshalEntryCallback: func(h *HAREntry) error {
-					count++
-					return nil
-				},
-			},
-		},
-	}
-	err := easyjson.UnmarshalFromReader(r, har)
+	err := ImportHTTPArchiveStream(r, func(h *HAREntry) error {
+		count++
+		return nil
+	})
 	return count, err
 }
 
+// ImportHTTPArchiveStream reads the entries one by one, it stops when callback returns error
 func ImportHTTPArchiveStream(r io.Reader, callback func(*HAREntry) error) error {
-	har := &HTTPArchive{
-		Log: &Log{
-			Entries: &Entries{
-				unmarshalEntryCallback: callback,
-			},
-		},
+	reader := NewHARStreamReader(r)
+	for {
+		entry, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := callback(entry); err != nil {
+			return err
+		}
 	}
-	return easyjson.UnmarshalFromReader(r, har)
 }
 
 func ExportHTTPArchiveStream(w io.Writer, har *HTTPArchive) error {
@@ -44,9 +43,25 @@ func ExportHTTPArchiveStream(w io.Writer, har *HTTPArchive) error {
 		return utils.Error("invalid HAR file, expect log field")
 	}
 	entries := har.Log.Entries
-	if len(entries.Entries) == 0 && entries.entriesChannel == nil {
+	if entries == nil || (len(entries.Entries) == 0 && entries.entriesChannel == nil) {
 		return utils.Error("invalid HAR file, expect log.entries should not empty")
 	}
-	_, err := easyjson.MarshalToWriter(har, w)
-	return err
+	ch := entries.entriesChannel
+	if ch == nil {
+		ch = lo.SliceToChannel(0, entries.Entries)
+	}
+
+	writer := NewHARStreamWriter(w, har.Log)
+	for entry := range ch {
+		if err := writer.WriteEntry(entry); err != nil {
+			// drain the channel to release the producer
+			for range ch {
+			}
+			return err
+		}
+		if entries.marshalEntryCallback != nil {
+			entries.marshalEntryCallback(entry)
+		}
+	}
+	return writer.Close()
 }
@@ -4,16 +4,20 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
 )
 
 func HTTPFlow2HarEntry(flow *schema.HTTPFlow) (*HAREntry, error) {
@@ -31,7 +35,7 @@ func HTTPFlow2HarEntry(flow *schema.HTTPFlow) (*HAREntry, error) {
 
 	// get http version
 	_, _, request.HTTPVersion = lowhttp.GetHTTPPacketFirstLine(reqByte)
-	isRequestHTTP2 := strings.Contains(strings.ToLower(request.HTTPVersion), "http/2")
+	isRequestHTTP2 := isMultiplexedHTTPVersion(request.HTTPVersion)
 
 	// get query string
 	var requestQueryString []*HARKVPair
@@ -138,7 +142,7 @@ func HTTPFlow2HarEntry(flow *schema.HTTPFlow) (*HAREntry, error) {
 
 	// get http version
 	response.HTTPVersion = resp.Proto
-	isResponseHTTP2 := strings.Contains(strings.ToLower(response.HTTPVersion), "http/2")
+	isResponseHTTP2 := isMultiplexedHTTPVersion(response.HTTPVersion)
 
 	// get headers
 	var (
@@ -177,6 +181,7 @@ func HTTPFlow2HarEntry(flow *schema.HTTPFlow) (*HAREntry, error) {
 
 	// clear and save httpflow metadata
 	entry := &HAREntry{
+		Time:            float64(flow.Duration) / float64(time.Millisecond),
 		Request:         request,
 		Response:        response,
 		ServerIPAddress: flow.RemoteAddr,
@@ -194,11 +199,18 @@ func HTTPFlow2HarEntry(flow *schema.HTTPFlow) (*HAREntry, error) {
 			Tags:               flow.Tags,
 			Payload:            flow.Payload,
 			IsWebsocket:        flow.IsWebsocket,
+			WebsocketHash:      flow.WebsocketHash,
 			FromPlugin:         flow.FromPlugin,
 			ProcessName:        flow.ProcessName,
 			UploadOnline:       flow.UploadOnline,
 		},
 	}
+	if !flow.CreatedAt.IsZero() {
+		entry.StartedDateTime = flow.CreatedAt.Format(time.RFC3339Nano)
+	}
+	if flow.IsWebsocket {
+		entry.ResourceType = "websocket"
+	}
 
 	return entry, nil
 }
@@ -212,26 +224,33 @@ func HarEntry2HTTPFlow(entry *HAREntry) (*schema.HTTPFlow, error) {
 	}
 
 	reqPacket := lowhttp.BasicRequest()
-	isRequestHTTP2 := strings.Contains(strings.ToLower(req.HTTPVersion), "http/2")
+	isRequestHTTP2 := isMultiplexedHTTPVersion(req.HTTPVersion)
 
 	// build request first line
-	reqPacket = lowhttp.ReplaceHTTPPacketFirstLine(reqPacket, fmt.Sprintf("%s %s %s", req.Method, urlIns.RequestURI(), strings.ToUpper(req.HTTPVersion)))
+	reqPacket = lowhttp.ReplaceHTTPPacketFirstLine(reqPacket, fmt.Sprintf("%s %s %s", req.Method, urlIns.RequestURI(), normalizeHTTPVersion(req.HTTPVersion)))
 
 	// build request headers
 	ReqHeaders := make(map[string]string)
 	lo.ForEach(req.Headers, func(kv *HARKVPair, _ int) {
 		name, value := kv.Name, kv.Value
-		if isRequestHTTP2 {
-			if strings.HasPrefix(kv.Name, ":") {
-				if strings.ToLower(kv.Name) == ":authority" {
-					ReqHeaders["Host"] = kv.Value
+		// pseudo-headers of http/2 and http/3
+		if strings.HasPrefix(name, ":") {
+			if strings.ToLower(name) == ":authority" {
+				if _, ok := ReqHeaders["Host"]; !ok {
+					ReqHeaders["Host"] = value
 				}
-				return
 			}
+			return
+		}
+		if isRequestHTTP2 {
 			name = http.CanonicalHeaderKey(name)
 		}
 		ReqHeaders[name] = value
 	})
+	// some tools do not record the host header
+	if _, ok := ReqHeaders["Host"]; !ok && urlIns.Host != "" {
+		ReqHeaders["Host"] = urlIns.Host
+	}
 	reqPacket = lowhttp.ReplaceAllHTTPPacketHeaders(reqPacket, ReqHeaders)
 
 	// build request query string
@@ -261,8 +280,8 @@ func HarEntry2HTTPFlow(entry *HAREntry) (*schema.HTTPFlow, error) {
 	respPacket := lowhttp.BasicResponse()
 
 	// build response first line
-	respPacket = lowhttp.ReplaceHTTPPacketFirstLine(respPacket, fmt.Sprintf("%s %d %s", strings.ToUpper(resp.HTTPVersion), resp.StatusCode, resp.StatusText))
-	isResponseHTTP2 := strings.Contains(strings.ToLower(resp.HTTPVersion), "http/2")
+	respPacket = lowhttp.ReplaceHTTPPacketFirstLine(respPacket, fmt.Sprintf("%s %d %s", normalizeHTTPVersion(resp.HTTPVersion), resp.StatusCode, resp.StatusText))
+	isResponseHTTP2 := isMultiplexedHTTPVersion(resp.HTTPVersion)
 
 	// build response headers
 	RespHeaders := make(map[string]string)
@@ -282,21 +301,34 @@ func HarEntry2HTTPFlow(entry *HAREntry) (*schema.HTTPFlow, error) {
 
 	metadata := entry.MetaData
 	flow := &schema.HTTPFlow{
-		Method:      req.Method,
-		Url:         req.URL,
-		StatusCode:  int64(resp.StatusCode),
-		Request:     strconv.Quote(string(reqPacket)),
-		Response:    strconv.Quote(string(respPacket)),
-		RemoteAddr:  entry.ServerIPAddress,
-		BodyLength:  int64(resp.Content.Size),
-		ContentType: resp.Content.MimeType,
+		Method:     req.Method,
+		Url:        req.URL,
+		StatusCode: int64(resp.StatusCode),
+		Request:    strconv.Quote(string(reqPacket)),
+		Response:   strconv.Quote(string(respPacket)),
+		RemoteAddr: entry.ServerIPAddress,
+		IsHTTPS:    urlIns.Scheme == "https" || urlIns.Scheme == "wss",
+		Duration:   int64(entry.Time * float64(time.Millisecond)),
+	}
+	if resp.Content != nil {
+		flow.BodyLength = int64(resp.Content.Size)
+		flow.ContentType = resp.Content.MimeType
+	}
+	if t, err := time.Parse(time.RFC3339Nano, entry.StartedDateTime); err == nil {
+		flow.CreatedAt = t
+	}
+	if isWebsocketEntry(entry) {
+		flow.IsWebsocket = true
+		flow.WebsocketHash = utils.CalcSha1(req.URL, entry.StartedDateTime, entry.Connection, entry.ServerIPAddress)
 	}
 	if metadata != nil {
 		flow.NoFixContentLength = metadata.NoFixContentLength
-		flow.IsHTTPS = metadata.IsHTTPS
+		flow.IsHTTPS = flow.IsHTTPS || metadata.IsHTTPS
 		flow.Path = metadata.Path
 		flow.SourceType = metadata.SourceType
-		flow.Duration = metadata.Duration
+		if metadata.Duration > 0 {
+			flow.Duration = metadata.Duration
+		}
 		flow.GetParamsTotal = metadata.GetParamsTotal
 		flow.PostParamsTotal = metadata.PostParamsTotal
 		flow.CookieParamsTotal = metadata.CookieParamsTotal
@@ -304,10 +336,116 @@ func HarEntry2HTTPFlow(entry *HAREntry) (*schema.HTTPFlow, error) {
 		flow.IPInteger = metadata.IPInteger
 		flow.Tags = metadata.Tags
 		flow.Payload = metadata.Payload
-		flow.IsWebsocket = metadata.IsWebsocket
+		flow.IsWebsocket = flow.IsWebsocket || metadata.IsWebsocket
+		if metadata.WebsocketHash != "" {
+			flow.WebsocketHash = metadata.WebsocketHash
+		}
 		flow.FromPlugin = metadata.FromPlugin
 		flow.ProcessName = metadata.ProcessName
 		flow.UploadOnline = metadata.UploadOnline
 	}
 	return flow, nil
 }
+
+// normalizeHTTPVersion converts the httpVersion in HAR (h2, h3, http/2.0, HTTP/3...) to the version in packet first line
+func normalizeHTTPVersion(version string) string {
+	lower := strings.ToLower(strings.TrimSpace(version))
+	switch lower {
+	case "":
+		return "HTTP/1.1"
+	case "h2", "h2c", "http/2", "http/2.0":
+		return "HTTP/2.0"
+	case "h3", "http/3", "http/3.0":
+		return "HTTP/3.0"
+	}
+	// draft versions of http/3, e.g. h3-29
+	if strings.HasPrefix(lower, "h3-") {
+		return "HTTP/3.0"
+	}
+	return strings.ToUpper(version)
+}
+
+// isMultiplexedHTTPVersion checks whether headers are lowercase with pseudo-headers, both http/2 and http/3 are
+func isMultiplexedHTTPVersion(version string) bool {
+	version = normalizeHTTPVersion(version)
+	return strings.HasPrefix(version, "HTTP/2") || strings.HasPrefix(version, "HTTP/3")
+}
+
+func isWebsocketEntry(entry *HAREntry) bool {
+	if len(entry.WebSocketMessages) > 0 || entry.ResourceType == "websocket" {
+		return true
+	}
+	if entry.Response == nil || entry.Response.StatusCode != http.StatusSwitchingProtocols {
+		return false
+	}
+	for _, kv := range entry.Response.Headers {
+		if strings.EqualFold(kv.Name, "upgrade") && strings.EqualFold(kv.Value, "websocket") {
+			return true
+		}
+	}
+	return false
+}
+
+// HarEntry2WebsocketFlows converts _webSocketMessages of the entry to websocket flows,
+// websocketHash should be the WebsocketHash of the http flow converted from the same entry
+func HarEntry2WebsocketFlows(entry *HAREntry, websocketHash string) []*schema.WebsocketFlow {
+	flows := make([]*schema.WebsocketFlow, 0, len(entry.WebSocketMessages))
+	for _, msg := range entry.WebSocketMessages {
+		if msg == nil {
+			continue
+		}
+		data, messageType := []byte(msg.Data), "text"
+		if msg.Opcode == 2 {
+			// binary frames are base64 encoded by devtools
+			messageType = "binary"
+			if decoded, err := codec.DecodeBase64(msg.Data); err == nil {
+				data = decoded
+			}
+		}
+		flow := &schema.WebsocketFlow{
+			WebsocketRequestHash: websocketHash,
+			FrameIndex:           len(flows) + 1,
+			FromServer:           msg.Type == "receive",
+			QuotedData:           strconv.Quote(string(data)),
+			MessageType:          messageType,
+		}
+		if msg.Time > 0 {
+			sec, frac := math.Modf(msg.Time)
+			flow.CreatedAt = time.Unix(int64(sec), int64(frac*float64(time.Second)))
+		}
+		flow.Hash = flow.CalcHash()
+		flows = append(flows, flow)
+	}
+	return flows
+}
+
+// WebsocketFlows2HarMessages converts websocket flows to _webSocketMessages ordered by frame index
+func WebsocketFlows2HarMessages(flows []*schema.WebsocketFlow) []*HARWebSocketMessage {
+	flows = lo.Filter(flows, func(flow *schema.WebsocketFlow, _ int) bool { return flow != nil })
+	sort.SliceStable(flows, func(i, j int) bool { return flows[i].FrameIndex < flows[j].FrameIndex })
+
+	messages := make([]*HARWebSocketMessage, 0, len(flows))
+	for _, flow := range flows {
+		data, err := strconv.Unquote(flow.QuotedData)
+		if err != nil {
+			data = flow.QuotedData
+		}
+		msg := &HARWebSocketMessage{
+			Type:   "send",
+			Opcode: 1,
+			Data:   data,
+		}
+		if flow.FromServer {
+			msg.Type = "receive"
+		}
+		if flow.MessageType == "binary" {
+			msg.Opcode = 2
+			msg.Data = codec.EncodeBase64(data)
+		}
+		if !flow.CreatedAt.IsZero() {
+			msg.Time = float64(flow.CreatedAt.UnixNano()) / float64(time.Second)
+		}
+		messages = append(messages, msg)
+	}
+	return messages
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
@@ -253,3 +254,119 @@ Content-Length: %d
 	require.Equal(t, sourceType, flow.SourceType)
 	require.Equal(t, randTag, flow.Tags)
 }
+
+func TestHAR2HTTPFlowHTTP3(t *testing.T) {
+	entry := &HAREntry{
+		StartedDateTime: "2024-01-01T00:00:00.5Z",
+		Time:            20,
+		Request: &HARRequest{
+			Method:      "GET",
+			URL:         "https://example.com/index?a=1",
+			HTTPVersion: "h3",
+			QueryString: []*HARKVPair{{Name: "a", Value: "1"}},
+			Headers: []*HARKVPair{
+				{Name: ":method", Value: "GET"},
+				{Name: ":authority", Value: "example.com"},
+				{Name: ":scheme", Value: "https"},
+				{Name: ":path", Value: "/index?a=1"},
+				{Name: "accept", Value: "*/*"},
+			},
+		},
+		Response: &HARResponse{
+			StatusCode:  200,
+			HTTPVersion: "h3",
+			Headers:     []*HARKVPair{{Name: "content-type", Value: "text/plain"}},
+			Content:     &HARHTTPContent{Size: 2, MimeType: "text/plain", Text: "ok"},
+		},
+	}
+	flow, err := HarEntry2HTTPFlow(entry)
+	require.NoError(t, err)
+	require.True(t, flow.IsHTTPS)
+	require.Equal(t, int64(20*time.Millisecond), flow.Duration)
+	require.Equal(t, 2024, flow.CreatedAt.Year())
+
+	req := []byte(flow.GetRequest())
+	_, _, version := lowhttp.GetHTTPPacketFirstLine(req)
+	require.Equal(t, "HTTP/3.0", version)
+	require.Equal(t, "example.com", lowhttp.GetHTTPPacketHeader(req, "Host"))
+	require.Equal(t, "*/*", lowhttp.GetHTTPPacketHeader(req, "Accept"))
+	require.NotContains(t, string(req), ":scheme")
+	rsp := []byte(flow.GetResponse())
+	rspVersion, _, _ := lowhttp.GetHTTPPacketFirstLine(rsp)
+	require.Equal(t, "HTTP/3.0", rspVersion)
+	require.Equal(t, "text/plain", lowhttp.GetHTTPPacketHeader(rsp, "Content-Type"))
+
+	// http/1.1 har without host header and content
+	entry = &HAREntry{
+		Request:  &HARRequest{Method: "GET", URL: "http://example.com/", HTTPVersion: "HTTP/1.1"},
+		Response: &HARResponse{StatusCode: 204, HTTPVersion: "HTTP/1.1"},
+	}
+	flow, err = HarEntry2HTTPFlow(entry)
+	require.NoError(t, err)
+	require.False(t, flow.IsHTTPS)
+	require.Equal(t, "example.com", lowhttp.GetHTTPPacketHeader([]byte(flow.GetRequest()), "Host"))
+}
+
+func TestHARWebsocketFlows(t *testing.T) {
+	entry := &HAREntry{
+		StartedDateTime: "2024-01-01T00:00:00Z",
+		ResourceType:    "websocket",
+		Request: &HARRequest{
+			Method:      "GET",
+			URL:         "wss://example.com/ws",
+			HTTPVersion: "HTTP/1.1",
+			Headers: []*HARKVPair{
+				{Name: "Upgrade", Value: "websocket"},
+				{Name: "Connection", Value: "Upgrade"},
+			},
+		},
+		Response: &HARResponse{
+			StatusCode:  101,
+			StatusText:  "Switching Protocols",
+			HTTPVersion: "HTTP/1.1",
+			Headers:     []*HARKVPair{{Name: "Upgrade", Value: "websocket"}},
+			Content:     &HARHTTPContent{},
+		},
+		WebSocketMessages: []*HARWebSocketMessage{
+			{Type: "send", Time: 1704067200.25, Opcode: 1, Data: "hello"},
+			{Type: "receive", Time: 1704067200.5, Opcode: 2, Data: "AAEC"},
+		},
+	}
+	flow, err := HarEntry2HTTPFlow(entry)
+	require.NoError(t, err)
+	require.True(t, flow.IsWebsocket)
+	require.True(t, flow.IsHTTPS)
+	require.NotEmpty(t, flow.WebsocketHash)
+
+	wsFlows := HarEntry2WebsocketFlows(entry, flow.WebsocketHash)
+	require.Len(t, wsFlows, 2)
+	require.Equal(t, 1, wsFlows[0].FrameIndex)
+	require.False(t, wsFlows[0].FromServer)
+	require.Equal(t, strconv.Quote("hello"), wsFlows[0].QuotedData)
+	require.Equal(t, int64(1704067200), wsFlows[0].CreatedAt.Unix())
+	require.True(t, wsFlows[1].FromServer)
+	require.Equal(t, "binary", wsFlows[1].MessageType)
+	require.Equal(t, strconv.Quote("\x00\x01\x02"), wsFlows[1].QuotedData)
+	for _, f := range wsFlows {
+		require.Equal(t, flow.WebsocketHash, f.WebsocketRequestHash)
+		require.Equal(t, f.CalcHash(), f.Hash)
+	}
+
+	// reversed order should be sorted by frame index
+	messages := WebsocketFlows2HarMessages([]*schema.WebsocketFlow{wsFlows[1], nil, wsFlows[0]})
+	require.Len(t, messages, 2)
+	for i, msg := range messages {
+		want := entry.WebSocketMessages[i]
+		require.Equal(t, want.Type, msg.Type)
+		require.Equal(t, want.Opcode, msg.Opcode)
+		require.Equal(t, want.Data, msg.Data)
+		require.InDelta(t, want.Time, msg.Time, 0.001)
+	}
+
+	// websocket metadata should be kept when exporting
+	exported, err := HTTPFlow2HarEntry(flow)
+	require.NoError(t, err)
+	require.Equal(t, "websocket", exported.ResourceType)
+	require.Equal(t, flow.WebsocketHash, exported.MetaData.WebsocketHash)
+	require.Equal(t, "2024-01-01T00:00:00Z", exported.StartedDateTime)
+}
@@ -0,0 +1,278 @@
+package har
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// HARStreamReader reads the entries of a HAR file one by one, only the current entry is kept in memory,
+// so it can handle multi-GB captures exported by browser devtools or other proxies
+type HARStreamReader struct {
+	dec *json.Decoder
+	log *Log
+
+	started   bool
+	inLog     bool
+	inEntries bool
+	err       error
+}
+
+func NewHARStreamReader(r io.Reader) *HARStreamReader {
+	return &HARStreamReader{
+		dec: json.NewDecoder(r),
+		log: &Log{},
+	}
+}
+
+// Log returns the metadata (version, creator, pages) of the HAR read so far,
+// fields after log.entries are only available after Next returns io.EOF
+func (s *HARStreamReader) Log() *Log {
+	return s.log
+}
+
+// Next returns the next entry, io.EOF is returned when all entries are read
+func (s *HARStreamReader) Next() (*HAREntry, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for {
+		if !s.inEntries {
+			if err := s.seekEntries(); err != nil {
+				s.err = err
+				return nil, err
+			}
+			continue
+		}
+		if !s.dec.More() {
+			if err := s.expectDelim(']'); err != nil {
+				s.err = err
+				return nil, err
+			}
+			s.inEntries = false
+			continue
+		}
+		var entry *HAREntry
+		if err := s.dec.Decode(&entry); err != nil {
+			s.err = utils.Wrap(err, "decode HAR entry failed")
+			return nil, s.err
+		}
+		if entry == nil {
+			continue
+		}
+		return entry, nil
+	}
+}
+
+// seekEntries moves the decoder into the next log.entries array, io.EOF means the end of HAR
+func (s *HARStreamReader) seekEntries() error {
+	if !s.started {
+		s.started = true
+		if err := s.expectDelim('{'); err != nil {
+			return err
+		}
+	}
+	for {
+		if !s.dec.More() {
+			// end of log or the root object
+			if err := s.expectDelim('}'); err != nil {
+				return err
+			}
+			if s.inLog {
+				s.inLog = false
+				continue
+			}
+			return io.EOF
+		}
+		key, err := s.readKey()
+		if err != nil {
+			return err
+		}
+
+		if !s.inLog {
+			if key != "log" {
+				if err := s.skipValue(); err != nil {
+					return err
+				}
+				continue
+			}
+			isNull, err := s.expectDelimOrNull('{')
+			if err != nil {
+				return err
+			}
+			s.inLog = !isNull
+			continue
+		}
+
+		switch key {
+		case "version":
+			err = s.dec.Decode(&s.log.Version)
+		case "creator":
+			err = s.dec.Decode(&s.log.Creator)
+		case "pages":
+			err = s.dec.Decode(&s.log.Pages)
+		case "entries":
+			var isNull bool
+			isNull, err = s.expectDelimOrNull('[')
+			if err == nil && !isNull {
+				s.inEntries = true
+				return nil
+			}
+		default:
+			err = s.skipValue()
+		}
+		if err != nil {
+			return utils.Wrapf(err, "decode HAR log.%s failed", key)
+		}
+	}
+}
+
+func (s *HARStreamReader) readKey() (string, error) {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return "", err
+	}
+	key, ok := tok.(string)
+	if !ok {
+		return "", utils.Errorf("invalid HAR file, expect object key but got %v", tok)
+	}
+	return key, nil
+}
+
+func (s *HARStreamReader) skipValue() error {
+	var raw json.RawMessage
+	return s.dec.Decode(&raw)
+}
+
+func (s *HARStreamReader) expectDelim(delim json.Delim) error {
+	isNull, err := s.expectDelimOrNull(delim)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		return utils.Errorf("invalid HAR file, expect %v but got null", delim)
+	}
+	return nil
+}
+
+func (s *HARStreamReader) expectDelimOrNull(delim json.Delim) (bool, error) {
+	tok, err := s.dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			return false, io.ErrUnexpectedEOF
+		}
+		return false, err
+	}
+	if tok == nil {
+		return true, nil
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return false, utils.Errorf("invalid HAR file, expect %v but got %v", delim, tok)
+	}
+	return false, nil
+}
+
+// HARStreamWriter writes the entries of a HAR file one by one, Close must be called to finish the file
+type HARStreamWriter struct {
+	w   *bufio.Writer
+	log *Log
+
+	headerWritten bool
+	closed        bool
+	count         int
+}
+
+// NewHARStreamWriter creates a writer, version / creator / pages of log are written before the entries
+func NewHARStreamWriter(w io.Writer, log *Log) *HARStreamWriter {
+	if log == nil {
+		log = &Log{Version: "1.2"}
+	}
+	return &HARStreamWriter{
+		w:   bufio.NewWriter(w),
+		log: log,
+	}
+}
+
+func (s *HARStreamWriter) writeHeader() error {
+	if s.headerWritten {
+		return nil
+	}
+	s.headerWritten = true
+
+	out := &jwriter.Writer{}
+	out.RawString(`{"log":{"version":`)
+	out.String(s.log.Version)
+	out.RawString(`,"creator":`)
+	if s.log.Creator == nil {
+		out.RawString("null")
+	} else {
+		s.log.Creator.MarshalEasyJSON(out)
+	}
+	out.RawString(`,"pages":`)
+	if s.log.Pages == nil {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for i, page := range s.log.Pages {
+			if i > 0 {
+				out.RawByte(',')
+			}
+			if page == nil {
+				out.RawString("null")
+			} else {
+				page.MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+	out.RawString(`,"entries":[`)
+	_, err := out.DumpTo(s.w)
+	return err
+}
+
+// WriteEntry marshals and writes a single entry, nil entry is ignored
+func (s *HARStreamWriter) WriteEntry(entry *HAREntry) error {
+	if s.closed {
+		return utils.Error("HAR stream writer is closed")
+	}
+	if err := s.writeHeader(); err != nil {
+		return err
+	}
+	if entry == nil {
+		return nil
+	}
+	if s.count > 0 {
+		if err := s.w.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	if _, err := easyjson.MarshalToWriter(entry, s.w); err != nil {
+		return err
+	}
+	s.count++
+	return nil
+}
+
+// Count returns the number of entries written
+func (s *HARStreamWriter) Count() int {
+	return s.count
+}
+
+// Close finishes the HAR file and flushes the buffer, the underlying writer is not closed
+func (s *HARStreamWriter) Close() error {
+	if s.closed {
+		return nil
+	}
+	if err := s.writeHeader(); err != nil {
+		return err
+	}
+	s.closed = true
+	if _, err := s.w.WriteString("]}}"); err != nil {
+		return err
+	}
+	return s.w.Flush()
+}
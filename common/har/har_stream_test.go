@@ -0,0 +1,159 @@
+package har
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestHARStreamReader(t *testing.T) {
+	raw := `{
+  "_comment": {"nested": [1, 2, {"a": "b"}]},
+  "log": {
+    "version": "1.2",
+    "creator": {"name": "WebInspector", "version": "537.36"},
+    "pages": [{"startedDateTime": "2024-01-01T00:00:00.000Z", "id": "page_1", "title": "example"}],
+    "entries": [
+      {
+        "_resourceType": "document",
+        "startedDateTime": "2024-01-01T00:00:00.123Z",
+        "time": 12.5,
+        "request": {"method": "GET", "url": "https://example.com/", "httpVersion": "h3", "headers": [{"name": ":authority", "value": "example.com"}]},
+        "response": {"status": 200, "statusText": "", "httpVersion": "h3", "headers": [], "content": {"size": 0, "mimeType": "text/html"}},
+        "timings": {"blocked": 1, "dns": -1, "ssl": -1, "connect": -1, "send": 0.5, "wait": 10, "receive": 1},
+        "connection": "443"
+      },
+      null,
+      {
+        "_resourceType": "websocket",
+        "request": {"method": "GET", "url": "wss://example.com/ws", "httpVersion": "HTTP/1.1", "headers": []},
+        "response": {"status": 101, "statusText": "Switching Protocols", "httpVersion": "HTTP/1.1", "headers": []},
+        "_webSocketMessages": [
+          {"type": "send", "time": 1704067200.5, "opcode": 1, "data": "ping"},
+          {"type": "receive", "time": 1704067200.6, "opcode": 1, "data": "pong"}
+        ]
+      }
+    ],
+    "comment": "after entries"
+  }
+}`
+	reader := NewHARStreamReader(strings.NewReader(raw))
+	var entries []*HAREntry
+	for {
+		entry, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 2)
+	// EOF is sticky
+	_, err := reader.Next()
+	require.Equal(t, io.EOF, err)
+
+	log := reader.Log()
+	require.Equal(t, "1.2", log.Version)
+	require.Equal(t, "WebInspector", log.Creator.Name)
+	require.Len(t, log.Pages, 1)
+
+	first := entries[0]
+	require.Equal(t, "h3", first.Request.HTTPVersion)
+	require.Equal(t, 12.5, first.Time)
+	require.Equal(t, "443", first.Connection)
+	require.Equal(t, "document", first.ResourceType)
+	require.NotNil(t, first.Timings)
+	require.Equal(t, float64(10), first.Timings.Wait)
+
+	ws := entries[1]
+	require.Equal(t, "websocket", ws.ResourceType)
+	require.Len(t, ws.WebSocketMessages, 2)
+	require.Equal(t, "pong", ws.WebSocketMessages[1].Data)
+	require.Equal(t, "receive", ws.WebSocketMessages[1].Type)
+}
+
+func TestHARStreamReaderInvalid(t *testing.T) {
+	for _, raw := range []string{
+		`[]`,
+		`{"log": {"entries": [{"request": }]}}`,
+		`{"log": {"entries": [`,
+	} {
+		err := ImportHTTPArchiveStream(strings.NewReader(raw), func(*HAREntry) error { return nil })
+		require.Error(t, err, raw)
+	}
+
+	// no entries is not an error
+	count, err := CountHTTPArchiveEntries(strings.NewReader(`{"log": {"version": "1.2", "entries": null}}`))
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestHARStreamReaderCallbackError(t *testing.T) {
+	raw := `{"log": {"entries": [{"request": {"url": "a"}}, {"request": {"url": "b"}}]}}`
+	count := 0
+	err := ImportHTTPArchiveStream(strings.NewReader(raw), func(*HAREntry) error {
+		count++
+		return fmt.Errorf("stop")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestHARStreamWriterRoundTrip(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	writer := NewHARStreamWriter(buf, &Log{
+		Version: "1.2",
+		Creator: &Creator{Name: "Yaklang", Version: "dev"},
+	})
+	total := 1000
+	for i := 0; i < total; i++ {
+		err := writer.WriteEntry(&HAREntry{
+			StartedDateTime: "2024-01-01T00:00:00Z",
+			Time:            1.5,
+			Request: &HARRequest{
+				Method:      "GET",
+				URL:         fmt.Sprintf("https://example.com/%d", i),
+				HTTPVersion: "h2",
+			},
+			Response: &HARResponse{StatusCode: 200, HTTPVersion: "h2"},
+			WebSocketMessages: []*HARWebSocketMessage{
+				{Type: "send", Opcode: 1, Data: "hello"},
+			},
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.WriteEntry(nil))
+	require.NoError(t, writer.Close())
+	require.Equal(t, total, writer.Count())
+	require.Error(t, writer.WriteEntry(&HAREntry{}))
+
+	result := gjson.ParseBytes(buf.Bytes())
+	require.Equal(t, "Yaklang", result.Get("log.creator.name").String())
+	require.Equal(t, int64(total), result.Get("log.entries.#").Int())
+
+	count := 0
+	err := ImportHTTPArchiveStream(bytes.NewReader(buf.Bytes()), func(entry *HAREntry) error {
+		require.Equal(t, fmt.Sprintf("https://example.com/%d", count), entry.Request.URL)
+		require.Equal(t, "h2", entry.Request.HTTPVersion)
+		require.Equal(t, 1.5, entry.Time)
+		require.Len(t, entry.WebSocketMessages, 1)
+		count++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, total, count)
+}
+
+func TestHARStreamWriterEmpty(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	writer := NewHARStreamWriter(buf, nil)
+	require.NoError(t, writer.Close())
+	result := gjson.ParseBytes(buf.Bytes())
+	require.Equal(t, "1.2", result.Get("log.version").String())
+	require.True(t, result.Get("log.entries").IsArray())
+}
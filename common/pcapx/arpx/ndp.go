@@ -0,0 +1,246 @@
+package arpx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/omap"
+)
+
+// SolicitedNodeMulticastIP 返回 IPv6 地址对应的 Solicited-Node 组播地址 ff02::1:ffXX:XXXX
+func SolicitedNodeMulticastIP(ip net.IP) net.IP {
+	ip = ip.To16()
+	if ip == nil {
+		return nil
+	}
+	return net.IP{0xff, 0x02, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01, 0xff, ip[13], ip[14], ip[15]}
+}
+
+// SolicitedNodeMulticastMac 返回 Solicited-Node 组播地址对应的以太网组播地址 33:33:ff:XX:XX:XX
+func SolicitedNodeMulticastMac(ip net.IP) net.HardwareAddr {
+	ip = ip.To16()
+	if ip == nil {
+		return nil
+	}
+	return net.HardwareAddr{0x33, 0x33, 0xff, ip[13], ip[14], ip[15]}
+}
+
+// ipv6SourceForTarget 从网卡地址中挑选发送 NDP 报文的源地址，优先同网段地址，其次链路本地地址
+func ipv6SourceForTarget(iface *net.Interface, target net.IP) (net.IP, error) {
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, utils.Errorf("fetch src ip failed: %s", err)
+	}
+	var linkLocal, global net.IP
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.To4() != nil || ipNet.IP.To16() == nil {
+			continue
+		}
+		if ipNet.Contains(target) {
+			return ipNet.IP, nil
+		}
+		if ipNet.IP.IsLinkLocalUnicast() {
+			if linkLocal == nil {
+				linkLocal = ipNet.IP
+			}
+		} else if global == nil {
+			global = ipNet.IP
+		}
+	}
+	if linkLocal != nil {
+		return linkLocal, nil
+	}
+	if global != nil {
+		return global, nil
+	}
+	return nil, utils.Errorf("iface[%v] 's ipv6 address cannot be found", iface.Name)
+}
+
+func newNeighborSolicitationPacket(iface *net.Interface, ip string) ([]byte, error) {
+	target := net.ParseIP(ip)
+	if target == nil || target.To4() != nil {
+		return nil, utils.Errorf("parse ipv6[%v] failed", ip)
+	}
+	src, err := ipv6SourceForTarget(iface, target)
+	if err != nil {
+		return nil, err
+	}
+
+	eth := &layers.Ethernet{
+		SrcMAC:       iface.HardwareAddr,
+		DstMAC:       SolicitedNodeMulticastMac(target),
+		EthernetType: layers.EthernetTypeIPv6,
+	}
+	ip6 := &layers.IPv6{
+		Version:    6,
+		HopLimit:   255,
+		NextHeader: layers.IPProtocolICMPv6,
+		SrcIP:      src,
+		DstIP:      SolicitedNodeMulticastIP(target),
+	}
+	icmp6 := &layers.ICMPv6{
+		TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborSolicitation, 0),
+	}
+	if err := icmp6.SetNetworkLayerForChecksum(ip6); err != nil {
+		return nil, err
+	}
+	ns := &layers.ICMPv6NeighborSolicitation{
+		TargetAddress: target,
+		Options: layers.ICMPv6Options{
+			{Type: layers.ICMPv6OptSourceAddress, Data: iface.HardwareAddr},
+		},
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{
+		FixLengths:       true,
+		ComputeChecksums: true,
+	}
+	err = gopacket.SerializeLayers(buf, opts, eth, ip6, icmp6, ns)
+	if err != nil {
+		return nil, utils.Errorf("serialize neighbor solicitation packet failed: %s", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// neighborAdvertisementHardware 从邻居通告报文中解析出 target 与其 MAC 地址
+func neighborAdvertisementHardware(packet gopacket.Packet) (net.IP, net.HardwareAddr) {
+	naLayer := packet.Layer(layers.LayerTypeICMPv6NeighborAdvertisement)
+	if naLayer == nil {
+		return nil, nil
+	}
+	na, ok := naLayer.(*layers.ICMPv6NeighborAdvertisement)
+	if !ok {
+		return nil, nil
+	}
+	for _, opt := range na.Options {
+		if opt.Type == layers.ICMPv6OptTargetAddress && len(opt.Data) >= 6 {
+			return na.TargetAddress, net.HardwareAddr(opt.Data[:6])
+		}
+	}
+	// 没有携带 Target Link-Layer Address 选项时，以链路层源地址为准
+	if ethLayer, ok := packet.LinkLayer().(*layers.Ethernet); ok {
+		return na.TargetAddress, ethLayer.SrcMAC
+	}
+	return nil, nil
+}
+
+func NdpWithPcapFirst(ctx context.Context, ifaceName string, target string) (net.HardwareAddr, error) {
+	result, err := NdpWithPcap(ctx, ifaceName, target)
+	if err != nil {
+		return nil, err
+	}
+	for _, hw := range result {
+		return hw, nil
+	}
+	return nil, errors.New("no result")
+}
+
+// NdpWithPcap 通过 IPv6 邻居发现协议（Neighbor Solicitation / Advertisement）获取目标的 MAC 地址
+func NdpWithPcap(ctx context.Context, ifaceName string, targets ...string) (map[string]net.HardwareAddr, error) {
+	ifaceIns, err := net.InterfaceByName(ifaceName)
+	if err != nil {
+		return nil, err
+	}
+	if ifaceIns.Flags&net.FlagLoopback != 0 {
+		return nil, errors.New("ndp on loopback interface is not supported")
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var cancel context.CancelFunc
+	if _, ok := ctx.Deadline(); ok {
+		ctx, cancel = context.WithCancel(ctx)
+	} else {
+		ctx, cancel = context.WithTimeout(ctx, 5*time.Second)
+	}
+	defer cancel()
+
+	var targetList []string
+	targetMap := make(map[string]struct{})
+	for _, t := range targets {
+		ip := net.ParseIP(utils.FixForParseIP(t))
+		if ip == nil || ip.To4() != nil {
+			continue
+		}
+		targetList = append(targetList, ip.String())
+		targetMap[ip.String()] = struct{}{}
+	}
+	if len(targetList) == 0 {
+		return nil, utils.Errorf("no valid ipv6 target in %v", targets)
+	}
+
+	results := omap.NewOrderedMap(map[string]net.HardwareAddr{})
+	resultsLock := new(sync.Mutex)
+	err = pcaputil.Start(
+		pcaputil.WithDevice(ifaceName),
+		pcaputil.WithEnableCache(true),
+		pcaputil.WithBPFFilter("icmp6"),
+		pcaputil.WithContext(ctx),
+		pcaputil.WithNetInterfaceCreated(func(handle *pcaputil.PcapHandleWrapper) {
+			go func() {
+				for _, target := range targetList {
+					buf, err := newNeighborSolicitationPacket(ifaceIns, target)
+					if err != nil {
+						log.Errorf("new neighbor solicitation packet failed: %s", err)
+						continue
+					}
+					for i := 0; i < 2; i++ {
+						if results.Have(target) {
+							break
+						}
+						select {
+						case <-ctx.Done():
+							return
+						default:
+						}
+						if err := handle.WritePacketData(buf); err != nil {
+							log.Errorf("write neighbor solicitation packet failed: %s", err)
+							break
+						}
+						time.Sleep(300 * time.Millisecond)
+					}
+				}
+			}()
+		}),
+		pcaputil.WithEveryPacket(func(packet gopacket.Packet) {
+			ip, hw := neighborAdvertisementHardware(packet)
+			if ip == nil || hw == nil || bytes.Equal(ifaceIns.HardwareAddr, hw) {
+				return
+			}
+			if _, ok := targetMap[ip.String()]; !ok {
+				return
+			}
+			log.Debugf("IPv6[%v] 's mac addr: %v", ip.String(), hw)
+
+			resultsLock.Lock()
+			defer resultsLock.Unlock()
+			results.Set(ip.String(), hw)
+			if results.Len() >= len(targetList) {
+				cancel()
+			}
+		}),
+	)
+	if results.Len() > 0 {
+		var ret = make(map[string]net.HardwareAddr)
+		results.ForEach(func(i string, v net.HardwareAddr) bool {
+			ret[i] = v
+			return true
+		})
+		return ret, nil
+	}
+	if err == nil {
+		err = utils.Errorf("cannot fetch (%v) %v 's mac address", ifaceName, targets)
+	}
+	return nil, err
+}
@@ -0,0 +1,45 @@
+package arpx
+
+import (
+	"net"
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+)
+
+func TestSolicitedNodeMulticast(t *testing.T) {
+	ip := net.ParseIP("2001:db8::aabb:ccdd")
+	if ret := SolicitedNodeMulticastIP(ip).String(); ret != "ff02::1:ffbb:ccdd" {
+		t.Fatalf("unexpected solicited-node multicast ip: %v", ret)
+	}
+	if ret := SolicitedNodeMulticastMac(ip).String(); ret != "33:33:ff:bb:cc:dd" {
+		t.Fatalf("unexpected solicited-node multicast mac: %v", ret)
+	}
+}
+
+func TestNeighborAdvertisementHardware(t *testing.T) {
+	target := net.ParseIP("fe80::2")
+	mac, _ := net.ParseMAC("66:77:88:99:aa:bb")
+	ip6 := &layers.IPv6{Version: 6, HopLimit: 255, NextHeader: layers.IPProtocolICMPv6, SrcIP: target, DstIP: net.ParseIP("fe80::1")}
+	icmp6 := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborAdvertisement, 0)}
+	icmp6.SetNetworkLayerForChecksum(ip6)
+	buf := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true},
+		&layers.Ethernet{SrcMAC: mac, DstMAC: net.HardwareAddr{0, 0x11, 0x22, 0x33, 0x44, 0x55}, EthernetType: layers.EthernetTypeIPv6},
+		ip6, icmp6,
+		&layers.ICMPv6NeighborAdvertisement{
+			Flags:         0x60,
+			TargetAddress: target,
+			Options:       layers.ICMPv6Options{{Type: layers.ICMPv6OptTargetAddress, Data: mac}},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packet := gopacket.NewPacket(buf.Bytes(), layers.LayerTypeEthernet, gopacket.Default)
+	ip, hw := neighborAdvertisementHardware(packet)
+	if !ip.Equal(target) || hw.String() != mac.String() {
+		t.Fatalf("unexpected result: %v %v", ip, hw)
+	}
+}
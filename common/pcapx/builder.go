@@ -3,7 +3,6 @@ package pcapx
 import (
 	"github.com/gopacket/gopacket"
 	"github.com/gopacket/gopacket/layers"
-	"github.com/yaklang/yaklang/common/go-funk"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
 	"net"
@@ -48,10 +47,12 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 		udpConfig   *layers.UDP
 		tcpConfig   *layers.TCP
 		icmp4Config *layers.ICMPv4
+		icmp6Config *ICMPv6Header
 
 		// link and network
 		arpConfig      *layers.ARP
 		ip4Config      *layers.IPv4
+		ip6Config      *IPv6Header
 		ethernetConfig *layers.Ethernet
 		loopbackConfig *layers.Loopback
 	)
@@ -83,6 +84,14 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			if err != nil {
 				return nil, utils.Errorf("set icmp4 config failed: %s", err)
 			}
+		case ICMPv6Option:
+			if icmp6Config == nil {
+				icmp6Config = NewDefaultICMPv6Layer()
+			}
+			err := optFunc(icmp6Config)
+			if err != nil {
+				return nil, utils.Errorf("set icmp6 config failed: %s", err)
+			}
 		case ArpConfig:
 			if arpConfig == nil {
 				arpConfig = &layers.ARP{
@@ -104,6 +113,14 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			if err != nil {
 				return nil, utils.Errorf("set ipv4 config failed: %s", err)
 			}
+		case IPv6Option:
+			if ip6Config == nil {
+				ip6Config = NewDefaultIPv6Layer()
+			}
+			err := optFunc(ip6Config)
+			if err != nil {
+				return nil, utils.Errorf("set ipv6 config failed: %s", err)
+			}
 		case EthernetOption:
 			if ethernetConfig == nil {
 				ethernetConfig = &layers.Ethernet{
@@ -133,6 +150,36 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 		}
 	}
 
+	/*
+		check network layer, only one of arp / ipv4 / ipv6 is allowed
+	*/
+	var networkLayerCount int
+	if arpConfig != nil {
+		networkLayerCount++
+	}
+	if ip4Config != nil {
+		networkLayerCount++
+	}
+	if ip6Config != nil {
+		networkLayerCount++
+	}
+	if networkLayerCount > 1 {
+		return nil, utils.Errorf("PacketBuilder: only one network layer is allowed, need ip / ipv6 / arp layer")
+	}
+	if networkLayerCount == 0 {
+		if icmp6Config == nil {
+			return nil, utils.Errorf("PacketBuilder: network layer is empty")
+		}
+		// icmpv6 without ipv6 layer is meaningless, use default ipv6 layer
+		ip6Config = NewDefaultIPv6Layer()
+	}
+	if ip6Config != nil && icmp4Config != nil {
+		return nil, utils.Errorf("PacketBuilder: icmpv4 layer cannot be used with ipv6 layer, use icmpv6 instead")
+	}
+	if ip4Config != nil && icmp6Config != nil {
+		return nil, utils.Errorf("PacketBuilder: icmpv6 layer cannot be used with ipv4 layer, use icmp instead")
+	}
+
 	/**
 	LinkLayer can be Ethernet(Default) or Loopback
 	*/
@@ -142,13 +189,20 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			loopbackConfig = &layers.Loopback{
 				Family: layers.ProtocolFamilyIPv4,
 			}
+			if ip6Config != nil {
+				loopbackConfig.Family = loopbackIPv6Family()
+			}
 		}
 		linkLayer = loopbackConfig
 	} else if ethernetConfig != nil {
 		linkLayer = ethernetConfig
 	} else {
 		var err error
-		linkLayer, err = GetPublicToServerLinkLayerIPv4()
+		if ip6Config != nil {
+			linkLayer, err = GetPublicToServerLinkLayerIPv6()
+		} else {
+			linkLayer, err = GetPublicToServerLinkLayerIPv4()
+		}
 		if err != nil {
 			log.Errorf("PacketBuilder: %v", err)
 			linkLayer = &layers.Ethernet{
@@ -158,84 +212,92 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			}
 		}
 	}
-
-	/*
-		check network layer?
-	*/
-	var fetched = false
-	var networkLayerRaw any
-	for _, l := range []any{
-		arpConfig, ip4Config,
-	} {
-		if funk.IsEmpty(l) {
-			if fetched {
-				return nil, utils.Errorf("PacketBuilder: only one network layer is allowed, need ip / arp layer")
-			} else {
-				fetched = true
-				networkLayerRaw = l
-			}
+	setEthernetType := func(t layers.EthernetType) {
+		if eth, ok := linkLayer.(*layers.Ethernet); ok {
+			eth.EthernetType = t
 		}
 	}
-	if networkLayerRaw == nil {
-		return nil, utils.Errorf("PacketBuilder: network layer is empty")
-	}
 
 	var networkLayer gopacket.SerializableLayer
 	var ipEnabled bool
-	if !funk.IsEmpty(ip4Config) {
+	if ip4Config != nil {
 		ipEnabled = true
 		networkLayer = ip4Config
 		if ip4Config.Version == 6 {
-			linkLayer.(*layers.Ethernet).EthernetType = layers.EthernetTypeIPv6
+			setEthernetType(layers.EthernetTypeIPv6)
 		}
-	} else if !funk.IsEmpty(arpConfig) {
+	} else if ip6Config != nil {
+		ipEnabled = true
+		setEthernetType(layers.EthernetTypeIPv6)
+	} else if arpConfig != nil {
 		networkLayer = arpConfig
-		linkLayer.(*layers.Ethernet).EthernetType = layers.EthernetTypeARP
+		setEthernetType(layers.EthernetTypeARP)
 	} else {
 		return nil, utils.Errorf("PacketBuilder: network layer is empty")
 	}
-	_ = networkLayer
 
 	var err error
 	if ipEnabled {
 		// TCP/IP Stack!
-		// TransportLayer can be TCP(Default) / ICMP / IGMP / UDP ...
-		var transportLayer gopacket.SerializableLayer
+		// TransportLayer can be TCP(Default) / ICMP / ICMPv6 / IGMP / UDP ...
+		var checksumLayer gopacket.NetworkLayer
+		var protocol layers.IPProtocol
+		if ip4Config != nil {
+			checksumLayer = ip4Config
+		} else {
+			checksumLayer = ip6Config.IPv6
+		}
+
+		var transportLayers []gopacket.SerializableLayer
 	TRANS:
 		if tcpConfig != nil {
-			tcpLayer := tcpConfig
-			ip4Layer := networkLayer.(*layers.IPv4)
-			err := tcpLayer.SetNetworkLayerForChecksum(ip4Layer)
+			err := tcpConfig.SetNetworkLayerForChecksum(checksumLayer)
 			if err != nil {
 				return nil, utils.Errorf("TCP checksum failed: %s", err)
 			}
-			ip4Layer.Protocol = layers.IPProtocolTCP
-			transportLayer = tcpLayer
+			protocol = layers.IPProtocolTCP
+			transportLayers = append(transportLayers, tcpConfig)
 		} else if icmp4Config != nil {
-			transportLayer = icmp4Config
-			ip4Layer := networkLayer.(*layers.IPv4)
-			ip4Layer.Protocol = layers.IPProtocolICMPv4
+			protocol = layers.IPProtocolICMPv4
+			transportLayers = append(transportLayers, icmp4Config)
+		} else if icmp6Config != nil {
+			err := icmp6Config.SetNetworkLayerForChecksum(checksumLayer)
+			if err != nil {
+				return nil, utils.Errorf("ICMPv6 checksum failed: %s", err)
+			}
+			protocol = layers.IPProtocolICMPv6
+			transportLayers = append(transportLayers, icmp6Config.serializableLayers()...)
+			if icmp6Config.isNDP() && !ip6Config.hopLimitSet {
+				// RFC 4861: NDP messages must be sent with hop limit 255
+				ip6Config.HopLimit = 255
+			}
 		} else if udpConfig != nil {
-			ip4Layer := networkLayer.(*layers.IPv4)
-			ip4Layer.Protocol = layers.IPProtocolUDP
-			err := udpConfig.SetNetworkLayerForChecksum(ip4Layer)
+			err := udpConfig.SetNetworkLayerForChecksum(checksumLayer)
 			if err != nil {
 				return nil, utils.Errorf("UDP checksum failed: %s", err)
 			}
-			transportLayer = udpConfig
+			protocol = layers.IPProtocolUDP
+			transportLayers = append(transportLayers, udpConfig)
 		} else {
 			log.Warn("PacketBuilder: tcp layer is empty, use default")
 			tcpConfig = NewDefaultTCPLayer()
 			goto TRANS
 		}
 
-		if err != nil {
-			return nil, utils.Errorf("TCP checksum failed: %s", err)
+		var packetLayers = []gopacket.SerializableLayer{linkLayer}
+		if ip4Config != nil {
+			ip4Config.Protocol = protocol
+			packetLayers = append(packetLayers, ip4Config)
+		} else {
+			packetLayers = append(packetLayers, ip6Config.serializableLayers(protocol)...)
 		}
+		packetLayers = append(packetLayers, transportLayers...)
+		packetLayers = append(packetLayers, gopacket.Payload(baseConfig.Payload))
+
 		var buf = gopacket.NewSerializeBuffer()
 		err = gopacket.SerializeLayers(
 			buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true},
-			linkLayer, networkLayer, transportLayer, gopacket.Payload(baseConfig.Payload),
+			packetLayers...,
 		)
 		if err != nil {
 			return nil, utils.Errorf(`gopacket.SerializeLayers failed: %s`, err)
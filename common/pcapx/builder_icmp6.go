@@ -0,0 +1,192 @@
+package pcapx
+
+import (
+	"net"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/yaklang/yaklang/common/go-funk"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var icmp6LayerExports = map[string]any{
+	"ICMPV6_TYPE_DEST_UNREACH":           layers.ICMPv6TypeDestinationUnreachable,
+	"ICMPV6_TYPE_PACKET_TOO_BIG":         layers.ICMPv6TypePacketTooBig,
+	"ICMPV6_TYPE_TIME_EXCEEDED":          layers.ICMPv6TypeTimeExceeded,
+	"ICMPV6_TYPE_PARAM_PROBLEM":          layers.ICMPv6TypeParameterProblem,
+	"ICMPV6_TYPE_ECHO_REQUEST":           layers.ICMPv6TypeEchoRequest,
+	"ICMPV6_TYPE_ECHO_REPLY":             layers.ICMPv6TypeEchoReply,
+	"ICMPV6_TYPE_ROUTER_SOLICITATION":    layers.ICMPv6TypeRouterSolicitation,
+	"ICMPV6_TYPE_ROUTER_ADVERTISEMENT":   layers.ICMPv6TypeRouterAdvertisement,
+	"ICMPV6_TYPE_NEIGHBOR_SOLICITATION":  layers.ICMPv6TypeNeighborSolicitation,
+	"ICMPV6_TYPE_NEIGHBOR_ADVERTISEMENT": layers.ICMPv6TypeNeighborAdvertisement,
+	"ICMPV6_TYPE_REDIRECT":               layers.ICMPv6TypeRedirect,
+
+	"ICMPV6_CODE_UNREACH_NO_ROUTE":        layers.ICMPv6CodeNoRouteToDst,
+	"ICMPV6_CODE_UNREACH_ADMIN":           layers.ICMPv6CodeAdminProhibited,
+	"ICMPV6_CODE_UNREACH_BEYOND_SCOPE":    layers.ICMPv6CodeBeyondScopeOfSrc,
+	"ICMPV6_CODE_UNREACH_ADDRESS":         layers.ICMPv6CodeAddressUnreachable,
+	"ICMPV6_CODE_UNREACH_PORT":            layers.ICMPv6CodePortUnreachable,
+	"ICMPV6_CODE_TIME_EXCEEDED_HOP_LIMIT": layers.ICMPv6CodeHopLimitExceeded,
+	"ICMPV6_CODE_TIME_EXCEEDED_FRAG":      layers.ICMPv6CodeFragmentReassemblyTimeExceeded,
+
+	"NDP_FLAG_ROUTER":    NDPFlagRouter,
+	"NDP_FLAG_SOLICITED": NDPFlagSolicited,
+	"NDP_FLAG_OVERRIDE":  NDPFlagOverride,
+
+	"icmpv6_type":                  WithICMPv6_Type,
+	"icmpv6_id":                    WithICMPv6_Id,
+	"icmpv6_seq":                   WithICMPv6_Sequence,
+	"icmpv6_payload":               WithICMPv6_Payload,
+	"icmpv6_neighborSolicitation":  WithICMPv6_NeighborSolicitation,
+	"icmpv6_neighborAdvertisement": WithICMPv6_NeighborAdvertisement,
+}
+
+func init() {
+	for k, v := range icmp6LayerExports {
+		Exports[k] = v
+	}
+}
+
+// Neighbor Advertisement 的标志位
+const (
+	NDPFlagRouter    = 0x80
+	NDPFlagSolicited = 0x40
+	NDPFlagOverride  = 0x20
+)
+
+// ICMPv6Header 是 ICMPv6 头部以及紧随其后的消息体（Echo / Neighbor Solicitation / Neighbor Advertisement）
+type ICMPv6Header struct {
+	*layers.ICMPv6
+	Message gopacket.SerializableLayer
+}
+
+type ICMPv6Option func(pv6 *ICMPv6Header) error
+
+func NewDefaultICMPv6Layer() *ICMPv6Header {
+	return &ICMPv6Header{
+		ICMPv6: &layers.ICMPv6{
+			TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeEchoRequest, 0),
+		},
+	}
+}
+
+// isNDP 判断是否为邻居发现报文，邻居发现报文的 HopLimit 必须为 255
+func (pv6 *ICMPv6Header) isNDP() bool {
+	switch pv6.TypeCode.Type() {
+	case layers.ICMPv6TypeRouterSolicitation, layers.ICMPv6TypeRouterAdvertisement,
+		layers.ICMPv6TypeNeighborSolicitation, layers.ICMPv6TypeNeighborAdvertisement,
+		layers.ICMPv6TypeRedirect:
+		return true
+	}
+	return false
+}
+
+func (pv6 *ICMPv6Header) echo() *layers.ICMPv6Echo {
+	if echo, ok := pv6.Message.(*layers.ICMPv6Echo); ok {
+		return echo
+	}
+	echo := &layers.ICMPv6Echo{}
+	pv6.Message = echo
+	return echo
+}
+
+func (pv6 *ICMPv6Header) serializableLayers() []gopacket.SerializableLayer {
+	t := pv6.TypeCode.Type()
+	if pv6.Message == nil && (t == layers.ICMPv6TypeEchoRequest || t == layers.ICMPv6TypeEchoReply) {
+		pv6.echo()
+	}
+	if pv6.Message == nil {
+		return []gopacket.SerializableLayer{pv6.ICMPv6}
+	}
+	return []gopacket.SerializableLayer{pv6.ICMPv6, pv6.Message}
+}
+
+func WithICMPv6_Type(icmpType any, icmpCode any) ICMPv6Option {
+	return func(pv6 *ICMPv6Header) error {
+		if funk.IsEmpty(icmpCode) {
+			icmpCode = 0
+		}
+		pv6.TypeCode = layers.CreateICMPv6TypeCode(uint8(utils.InterfaceToInt(icmpType)), uint8(utils.InterfaceToInt(icmpCode)))
+		return nil
+	}
+}
+
+func WithICMPv6_Id(id any) ICMPv6Option {
+	return func(pv6 *ICMPv6Header) error {
+		pv6.echo().Identifier = uint16(utils.InterfaceToInt(id))
+		return nil
+	}
+}
+
+func WithICMPv6_Sequence(sequence any) ICMPv6Option {
+	return func(pv6 *ICMPv6Header) error {
+		pv6.echo().SeqNumber = uint16(utils.InterfaceToInt(sequence))
+		return nil
+	}
+}
+
+// WithICMPv6_Payload 设置 ICMPv6 消息的原始内容，用于构造 Echo / NDP 以外的报文（例如 Destination Unreachable）
+func WithICMPv6_Payload(i []byte) ICMPv6Option {
+	return func(pv6 *ICMPv6Header) error {
+		pv6.Message = gopacket.Payload(i)
+		return nil
+	}
+}
+
+func parseMac(i any) (net.HardwareAddr, error) {
+	switch ret := i.(type) {
+	case net.HardwareAddr:
+		return ret, nil
+	case []byte:
+		return ret, nil
+	default:
+		return net.ParseMAC(utils.InterfaceToString(i))
+	}
+}
+
+// WithICMPv6_NeighborSolicitation 构造邻居请求报文，srcMac 不为空时附带 Source Link-Layer Address 选项
+// 通常目的地址应为 target 的 Solicited-Node 组播地址，见 arpx.SolicitedNodeMulticastIP
+func WithICMPv6_NeighborSolicitation(target any, srcMac any) ICMPv6Option {
+	return func(pv6 *ICMPv6Header) error {
+		targetIP := parseIPv6(target)
+		if targetIP == nil {
+			return utils.Errorf("WithICMPv6_NeighborSolicitation invalid target: %v", target)
+		}
+		ns := &layers.ICMPv6NeighborSolicitation{TargetAddress: targetIP}
+		if !funk.IsEmpty(srcMac) {
+			mac, err := parseMac(srcMac)
+			if err != nil {
+				return utils.Errorf("WithICMPv6_NeighborSolicitation invalid mac: %v", srcMac)
+			}
+			ns.Options = append(ns.Options, layers.ICMPv6Option{Type: layers.ICMPv6OptSourceAddress, Data: mac})
+		}
+		pv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborSolicitation, 0)
+		pv6.Message = ns
+		return nil
+	}
+}
+
+// WithICMPv6_NeighborAdvertisement 构造邻居通告报文，flags 为 NDP_FLAG_ROUTER / NDP_FLAG_SOLICITED / NDP_FLAG_OVERRIDE 的组合
+func WithICMPv6_NeighborAdvertisement(target any, targetMac any, flags any) ICMPv6Option {
+	return func(pv6 *ICMPv6Header) error {
+		targetIP := parseIPv6(target)
+		if targetIP == nil {
+			return utils.Errorf("WithICMPv6_NeighborAdvertisement invalid target: %v", target)
+		}
+		na := &layers.ICMPv6NeighborAdvertisement{
+			Flags:         uint8(utils.InterfaceToInt(flags)),
+			TargetAddress: targetIP,
+		}
+		if !funk.IsEmpty(targetMac) {
+			mac, err := parseMac(targetMac)
+			if err != nil {
+				return utils.Errorf("WithICMPv6_NeighborAdvertisement invalid mac: %v", targetMac)
+			}
+			na.Options = append(na.Options, layers.ICMPv6Option{Type: layers.ICMPv6OptTargetAddress, Data: mac})
+		}
+		pv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborAdvertisement, 0)
+		pv6.Message = na
+		return nil
+	}
+}
@@ -0,0 +1,122 @@
+package pcapx
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/yaklang/yaklang/common/pcapx/arpx"
+)
+
+func TestSmoking_ICMPv6_Echo(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithEthernet_DstMac("66:77:88:99:aa:bb"),
+		WithIPv6_SrcIP("2001:db8::1"),
+		WithIPv6_DstIP("2001:db8::2"),
+		WithICMPv6_Id(1234),
+		WithICMPv6_Sequence(1),
+		WithPayload([]byte("hello yakit pcapx world")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	if packet.ErrorLayer() != nil {
+		t.Fatal(packet.ErrorLayer().Error())
+	}
+	fmt.Println(packet.String())
+	icmp6, ok := packet.Layer(layers.LayerTypeICMPv6).(*layers.ICMPv6)
+	if !ok || icmp6.TypeCode.Type() != layers.ICMPv6TypeEchoRequest {
+		t.Fatal("expect icmpv6 echo request layer, not found")
+	}
+	echo, ok := packet.Layer(layers.LayerTypeICMPv6Echo).(*layers.ICMPv6Echo)
+	if !ok || echo.Identifier != 1234 || echo.SeqNumber != 1 {
+		t.Fatal("expect icmpv6 echo id/seq, not found")
+	}
+	// gopacket does not keep the payload of icmpv6 echo when decoding
+	if !bytes.HasSuffix(icmp6.Payload, []byte("hello yakit pcapx world")) {
+		t.Fatalf("unexpected icmpv6 echo payload: %q", icmp6.Payload)
+	}
+}
+
+func TestSmoking_ICMPv6_NeighborSolicitation(t *testing.T) {
+	target := net.ParseIP("fe80::1234:5678")
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithEthernet_DstMac(arpx.SolicitedNodeMulticastMac(target)),
+		WithIPv6_SrcIP("fe80::1"),
+		WithIPv6_DstIP(arpx.SolicitedNodeMulticastIP(target).String()),
+		WithICMPv6_NeighborSolicitation(target.String(), "00:11:22:33:44:55"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	if packet.ErrorLayer() != nil {
+		t.Fatal(packet.ErrorLayer().Error())
+	}
+	fmt.Println(packet.String())
+
+	eth := packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+	if eth.DstMAC.String() != "33:33:ff:34:56:78" {
+		t.Fatalf("unexpected multicast mac: %v", eth.DstMAC)
+	}
+	ip6 := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	if ip6.HopLimit != 255 || ip6.DstIP.String() != "ff02::1:ff34:5678" {
+		t.Fatalf("unexpected ipv6 header: %v", ip6)
+	}
+	ns, ok := packet.Layer(layers.LayerTypeICMPv6NeighborSolicitation).(*layers.ICMPv6NeighborSolicitation)
+	if !ok || !ns.TargetAddress.Equal(target) {
+		t.Fatal("expect neighbor solicitation layer, not found")
+	}
+	if len(ns.Options) != 1 || ns.Options[0].Type != layers.ICMPv6OptSourceAddress ||
+		net.HardwareAddr(ns.Options[0].Data).String() != "00:11:22:33:44:55" {
+		t.Fatalf("unexpected ndp options: %v", ns.Options)
+	}
+}
+
+func TestSmoking_ICMPv6_NeighborAdvertisement(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithEthernet_DstMac("66:77:88:99:aa:bb"),
+		WithIPv6_SrcIP("fe80::1"),
+		WithIPv6_DstIP("fe80::2"),
+		WithIPv6_HopLimit(64),
+		WithICMPv6_NeighborAdvertisement("fe80::1", "00:11:22:33:44:55", NDPFlagSolicited|NDPFlagOverride),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	if packet.ErrorLayer() != nil {
+		t.Fatal(packet.ErrorLayer().Error())
+	}
+	// hop limit set by user is kept
+	if ip6 := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6); ip6.HopLimit != 64 {
+		t.Fatalf("unexpected hop limit: %v", ip6.HopLimit)
+	}
+	na, ok := packet.Layer(layers.LayerTypeICMPv6NeighborAdvertisement).(*layers.ICMPv6NeighborAdvertisement)
+	if !ok {
+		t.Fatal("expect neighbor advertisement layer, not found")
+	}
+	if !na.Solicited() || !na.Override() || na.Router() {
+		t.Fatalf("unexpected flags: %x", na.Flags)
+	}
+	if len(na.Options) != 1 || na.Options[0].Type != layers.ICMPv6OptTargetAddress {
+		t.Fatalf("unexpected ndp options: %v", na.Options)
+	}
+}
+
+func TestPacketBuilder_ICMPv4WithIPv6(t *testing.T) {
+	_, err := PacketBuilder(
+		WithIPv6_SrcIP("2001:db8::1"),
+		WithICMP_Type(layers.ICMPv4TypeEchoRequest, nil),
+	)
+	if err == nil {
+		t.Fatal("expect error for icmpv4 over ipv6")
+	}
+}
@@ -0,0 +1,304 @@
+package pcapx
+
+import (
+	"net"
+	"runtime"
+	"strings"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var ipv6LayerExports = map[string]any{
+	"ipv6_trafficClass":      WithIPv6_TrafficClass,
+	"ipv6_flowLabel":         WithIPv6_FlowLabel,
+	"ipv6_nextLayerProtocol": WithIPv6_NextHeader,
+	"ipv6_hopLimit":          WithIPv6_HopLimit,
+	"ipv6_srcIp":             WithIPv6_SrcIP,
+	"ipv6_dstIp":             WithIPv6_DstIP,
+	"ipv6_hopByHopOption":    WithIPv6_HopByHopOption,
+	"ipv6_destinationOption": WithIPv6_DestinationOption,
+	"ipv6_fragment":          WithIPv6_Fragment,
+
+	// consts
+	"IPV6_PROTOCOL_HOP_BY_HOP":     int(layers.IPProtocolIPv6HopByHop),
+	"IPV6_PROTOCOL_TCP":            int(layers.IPProtocolTCP),
+	"IPV6_PROTOCOL_UDP":            int(layers.IPProtocolUDP),
+	"IPV6_PROTOCOL_ROUTING":        int(layers.IPProtocolIPv6Routing),
+	"IPV6_PROTOCOL_FRAGMENT":       int(layers.IPProtocolIPv6Fragment),
+	"IPV6_PROTOCOL_ICMPV6":         int(layers.IPProtocolICMPv6),
+	"IPV6_PROTOCOL_NO_NEXT_HEADER": int(layers.IPProtocolNoNextHeader),
+	"IPV6_PROTOCOL_DESTINATION":    int(layers.IPProtocolIPv6Destination),
+}
+
+func init() {
+	for k, v := range ipv6LayerExports {
+		Exports[k] = v
+	}
+}
+
+// IPv6Header 是 IPv6 基础头部以及可选的扩展头部
+// 扩展头部按照 Hop-by-Hop -> Destination -> Fragment 的顺序串联，
+// 各个头部的 NextHeader 在构造数据包时自动填充
+type IPv6Header struct {
+	*layers.IPv6
+	Destination *layers.IPv6Destination
+	Fragment    *layers.IPv6Fragment
+
+	hopLimitSet   bool
+	nextHeaderSet bool
+}
+
+type IPv6Option func(pv6 *IPv6Header) error
+
+func NewDefaultIPv6Layer() *IPv6Header {
+	return &IPv6Header{
+		IPv6: &layers.IPv6{
+			Version:    6,
+			HopLimit:   64,
+			NextHeader: layers.IPProtocolTCP,
+		},
+	}
+}
+
+/*
+// IPv6 is the layer for the IPv6 header.
+type IPv6 struct {
+	BaseLayer
+	Version      uint8
+	TrafficClass uint8
+	FlowLabel    uint32
+	Length       uint16
+	NextHeader   IPProtocol
+	HopLimit     uint8
+	SrcIP        net.IP
+	DstIP        net.IP
+	HopByHop     *IPv6HopByHop
+}
+
+一般来说，不需要操作的字段有：Version / Length
+*/
+
+func WithIPv6_TrafficClass(i any) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		pv6.TrafficClass = uint8(utils.InterfaceToInt(i))
+		return nil
+	}
+}
+
+func WithIPv6_FlowLabel(i any) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		pv6.FlowLabel = uint32(utils.InterfaceToInt(i)) & 0xfffff
+		return nil
+	}
+}
+
+func WithIPv6_HopLimit(i any) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		pv6.HopLimit = uint8(utils.InterfaceToInt(i))
+		pv6.hopLimitSet = true
+		return nil
+	}
+}
+
+func parseIPv6(i any) net.IP {
+	ip := net.ParseIP(utils.FixForParseIP(utils.InterfaceToString(i)))
+	if ip == nil || ip.To4() != nil {
+		return nil
+	}
+	return ip
+}
+
+func WithIPv6_SrcIP(i any) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		pv6.SrcIP = parseIPv6(i)
+		if pv6.SrcIP == nil {
+			return utils.Errorf("WithIPv6_SrcIP error: %v", i)
+		}
+		return nil
+	}
+}
+
+func WithIPv6_DstIP(i any) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		pv6.DstIP = parseIPv6(i)
+		if pv6.DstIP == nil {
+			return utils.Errorf("WithIPv6_DstIP error: %v", i)
+		}
+		return nil
+	}
+}
+
+// WithIPv6_NextHeader 指定上层协议，未指定时根据 TCP / UDP / ICMPv6 层自动设置
+func WithIPv6_NextHeader(i any) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		// the names are the same as ipv4 protocols
+		ip4 := &layers.IPv4{}
+		err := WithIPv4_NextProtocol(i)(ip4)
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(utils.InterfaceToString(i)) == "icmp" {
+			ip4.Protocol = layers.IPProtocolICMPv6
+		}
+		pv6.NextHeader = ip4.Protocol
+		pv6.nextHeaderSet = true
+		return nil
+	}
+}
+
+func newIPv6TLVOption(optType any, data []byte) (uint8, uint8, error) {
+	if len(data) > 255 {
+		return 0, 0, utils.Errorf("ipv6 extension option data length is too long, max length is 255, got %d", len(data))
+	}
+	return uint8(utils.InterfaceToInt(optType)), uint8(len(data)), nil
+}
+
+// WithIPv6_HopByHopOption 添加 Hop-by-Hop 扩展头部选项，optType 为 nil 时清空该扩展头部
+func WithIPv6_HopByHopOption(optType any, data []byte) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		if optType == nil {
+			pv6.HopByHop = nil
+			return nil
+		}
+		t, l, err := newIPv6TLVOption(optType, data)
+		if err != nil {
+			return err
+		}
+		if pv6.HopByHop == nil {
+			pv6.HopByHop = &layers.IPv6HopByHop{}
+		}
+		pv6.HopByHop.Options = append(pv6.HopByHop.Options, &layers.IPv6HopByHopOption{
+			OptionType:   t,
+			OptionLength: l,
+			OptionData:   data,
+		})
+		return nil
+	}
+}
+
+// WithIPv6_DestinationOption 添加 Destination Options 扩展头部选项，optType 为 nil 时清空该扩展头部
+func WithIPv6_DestinationOption(optType any, data []byte) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		if optType == nil {
+			pv6.Destination = nil
+			return nil
+		}
+		t, l, err := newIPv6TLVOption(optType, data)
+		if err != nil {
+			return err
+		}
+		if pv6.Destination == nil {
+			pv6.Destination = &layers.IPv6Destination{}
+		}
+		pv6.Destination.Options = append(pv6.Destination.Options, &layers.IPv6DestinationOption{
+			OptionType:   t,
+			OptionLength: l,
+			OptionData:   data,
+		})
+		return nil
+	}
+}
+
+// WithIPv6_Fragment 添加 Fragment 扩展头部，offset 以 8 字节为单位
+func WithIPv6_Fragment(id any, offset any, moreFragments bool) IPv6Option {
+	return func(pv6 *IPv6Header) error {
+		pv6.Fragment = &layers.IPv6Fragment{
+			Identification: uint32(utils.InterfaceToInt(id)),
+			FragmentOffset: uint16(utils.InterfaceToInt(offset)) & 0x1fff,
+			MoreFragments:  moreFragments,
+		}
+		return nil
+	}
+}
+
+// ipv6OptionsPadding 返回使扩展头部长度对齐到 8 字节所需的 PadN 选项
+func ipv6OptionsPadding(dataLengths ...int) *layers.IPv6HopByHopOption {
+	total := 2
+	for _, l := range dataLengths {
+		total += l + 2
+	}
+	if total%8 == 0 {
+		return nil
+	}
+	pad := 8 - total%8
+	if pad < 2 {
+		pad += 8
+	}
+	return &layers.IPv6HopByHopOption{
+		OptionType:   1, // PadN
+		OptionLength: uint8(pad - 2),
+		OptionData:   make([]byte, pad-2),
+	}
+}
+
+// serializableLayers 按顺序串联扩展头部，upper 为最终的上层协议
+func (pv6 *IPv6Header) serializableLayers(upper layers.IPProtocol) []gopacket.SerializableLayer {
+	if pv6.nextHeaderSet {
+		upper = pv6.NextHeader
+	}
+
+	if pv6.HopByHop != nil {
+		var lengths []int
+		for _, opt := range pv6.HopByHop.Options {
+			lengths = append(lengths, len(opt.OptionData))
+		}
+		if pad := ipv6OptionsPadding(lengths...); pad != nil {
+			pv6.HopByHop.Options = append(pv6.HopByHop.Options, pad)
+		}
+	}
+	if pv6.Destination != nil {
+		var lengths []int
+		for _, opt := range pv6.Destination.Options {
+			lengths = append(lengths, len(opt.OptionData))
+		}
+		if pad := ipv6OptionsPadding(lengths...); pad != nil {
+			pv6.Destination.Options = append(pv6.Destination.Options, (*layers.IPv6DestinationOption)(pad))
+		}
+	}
+
+	var exts []gopacket.SerializableLayer
+	var protocols []layers.IPProtocol
+	if pv6.Destination != nil {
+		exts = append(exts, pv6.Destination)
+		protocols = append(protocols, layers.IPProtocolIPv6Destination)
+	}
+	if pv6.Fragment != nil {
+		exts = append(exts, pv6.Fragment)
+		protocols = append(protocols, layers.IPProtocolIPv6Fragment)
+	}
+
+	next := upper
+	for i := len(exts) - 1; i >= 0; i-- {
+		switch ext := exts[i].(type) {
+		case *layers.IPv6Destination:
+			ext.NextHeader = next
+		case *layers.IPv6Fragment:
+			ext.NextHeader = next
+		}
+		next = protocols[i]
+	}
+	if pv6.HopByHop != nil {
+		// the hop-by-hop header is serialized by layers.IPv6 itself
+		pv6.HopByHop.NextHeader = next
+		pv6.NextHeader = layers.IPProtocolIPv6HopByHop
+	} else {
+		pv6.NextHeader = next
+	}
+	return append([]gopacket.SerializableLayer{pv6.IPv6}, exts...)
+}
+
+// loopbackIPv6Family 返回当前系统 loopback 链路层中 IPv6 的协议族
+func loopbackIPv6Family() layers.ProtocolFamily {
+	switch runtime.GOOS {
+	case "darwin", "ios":
+		return layers.ProtocolFamilyIPv6Darwin
+	case "freebsd", "dragonfly":
+		return layers.ProtocolFamilyIPv6FreeBSD
+	case "linux", "android":
+		return layers.ProtocolFamilyIPv6Linux
+	default:
+		return layers.ProtocolFamilyIPv6BSD
+	}
+}
@@ -67,11 +67,17 @@ func GetPublicToServerLinkLayerIPv4() (*layers.Ethernet, error) {
 
 func GetPublicToServerLinkLayerIPv6() (*layers.Ethernet, error) {
 	if ethIPv6ToServer != nil {
-		return ethIPv6ToServer, nil
+		ethernet := *ethIPv6ToServer
+		return &ethernet, nil
 	}
 	var err error
 	ethIPv6ToServer, err = GetPublicLinkLayer(layers.EthernetTypeIPv6, true)
-	return ethIPv6ToServer, err
+	if err != nil {
+		return nil, err
+	}
+
+	ethernet := *ethIPv6ToServer
+	return &ethernet, nil
 }
 
 func GetPublicToClientLinkLayerIPv4() (*layers.Ethernet, error) {
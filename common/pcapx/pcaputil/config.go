@@ -249,7 +249,8 @@ func WithHTTPFlow(h func(flow *TrafficFlow, req *http.Request, rsp *http.Respons
 				return
 			}
 
-			if !conn.IsMarkedAsHttpPacket() {
+			// TLS 流量交给 WithTLSKeyLog 解密，避免把密文当作 HTTP 报文解析
+			if !conn.IsMarkedAsHttpPacket() && !isTLSRecord(frame.Payload) {
 				if _, err := utils.ReadHTTPRequestFromBytes(frame.Payload); err == nil {
 					flow.httpflowWg.Add(2)
 					if flow.ClientConn == conn {
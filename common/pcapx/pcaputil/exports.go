@@ -16,6 +16,7 @@ var Exports = map[string]any{
 	"pcap_onTLSClientHello":             WithTLSClientHello,
 	"pcap_onHTTPRequest":                WithHTTPRequest,
 	"pcap_onHTTPFlow":                   WithHTTPFlow,
+	"pcap_tlsKeyLogFile":                WithTLSKeyLogFile,
 	"pcap_everyPacket":                  WithEveryPacket,
 	"pcap_debug":                        WithDebug,
 	"pcap_disableAssembly":              WithDisableAssembly,
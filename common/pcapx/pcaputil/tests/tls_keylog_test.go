@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
+	"github.com/yaklang/yaklang/common/utils/tlsutils"
+)
+
+type tlsSegment struct {
+	fromClient bool
+	data       []byte
+}
+
+type tlsRecordingConn struct {
+	net.Conn
+	fromClient bool
+	mu         *sync.Mutex
+	segments   *[]tlsSegment
+}
+
+func (c *tlsRecordingConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	*c.segments = append(*c.segments, tlsSegment{fromClient: c.fromClient, data: append([]byte{}, b...)})
+	c.mu.Unlock()
+	return c.Conn.Write(b)
+}
+
+// recordHTTPSExchange 完成一次 HTTPS 请求，返回双方发送的 TLS 数据以及 Key Log
+func recordHTTPSExchange(t *testing.T, maxVersion uint16) ([]tlsSegment, []byte) {
+	crt, key, err := tlsutils.GenerateSelfSignedCertKey("127.0.0.1", nil, nil)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(crt, key)
+	require.NoError(t, err)
+
+	var segments []tlsSegment
+	mu := new(sync.Mutex)
+	clientSide, serverSide := net.Pipe()
+	defer clientSide.Close()
+	defer serverSide.Close()
+
+	serverDone := make(chan error, 1)
+	go func() {
+		conn := tls.Server(&tlsRecordingConn{Conn: serverSide, mu: mu, segments: &segments}, &tls.Config{Certificates: []tls.Certificate{cert}})
+		if _, err := http.ReadRequest(bufio.NewReader(conn)); err != nil {
+			serverDone <- err
+			return
+		}
+		_, err := io.WriteString(conn, "HTTP/1.1 200 OK\r\nContent-Length: 12\r\n\r\nhello secret")
+		serverDone <- err
+	}()
+
+	var keyLogRaw bytes.Buffer
+	conn := tls.Client(&tlsRecordingConn{Conn: clientSide, fromClient: true, mu: mu, segments: &segments}, &tls.Config{
+		InsecureSkipVerify: true,
+		ServerName:         "www.example.com",
+		MaxVersion:         maxVersion,
+		KeyLogWriter:       &keyLogRaw,
+	})
+	_, err = io.WriteString(conn, "GET /tls-keylog HTTP/1.1\r\nHost: www.example.com\r\n\r\n")
+	require.NoError(t, err)
+	rsp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(rsp.Body)
+	require.Equal(t, "hello secret", string(body))
+	require.NoError(t, <-serverDone)
+	return segments, keyLogRaw.Bytes()
+}
+
+// writeTCPSessionPcap 把 TLS 数据按照一条完整的 TCP 连接写入 pcap 文件
+func writeTCPSessionPcap(t *testing.T, segments []tlsSegment) string {
+	filename := filepath.Join(t.TempDir(), "tls.pcap")
+	fp, err := os.Create(filename)
+	require.NoError(t, err)
+	defer fp.Close()
+	w := pcapgo.NewWriter(fp)
+	require.NoError(t, w.WriteFileHeader(65535, layers.LinkTypeEthernet))
+
+	clientMac, _ := net.ParseMAC("00:11:22:33:44:55")
+	serverMac, _ := net.ParseMAC("66:77:88:99:aa:bb")
+	clientIP, serverIP := net.ParseIP("192.168.1.2").To4(), net.ParseIP("192.168.1.1").To4()
+	clientSeq, serverSeq := uint32(1000), uint32(5000)
+	ts := time.Now()
+
+	write := func(fromClient bool, payload []byte, flags func(tcp *layers.TCP)) {
+		eth := &layers.Ethernet{SrcMAC: clientMac, DstMAC: serverMac, EthernetType: layers.EthernetTypeIPv4}
+		ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolTCP, SrcIP: clientIP, DstIP: serverIP}
+		tcp := &layers.TCP{SrcPort: 54321, DstPort: 443, Seq: clientSeq, Ack: serverSeq, Window: 65535}
+		if !fromClient {
+			eth.SrcMAC, eth.DstMAC = serverMac, clientMac
+			ip.SrcIP, ip.DstIP = serverIP, clientIP
+			tcp.SrcPort, tcp.DstPort, tcp.Seq, tcp.Ack = 443, 54321, serverSeq, clientSeq
+		}
+		flags(tcp)
+		require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+		buf := gopacket.NewSerializeBuffer()
+		require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, eth, ip, tcp, gopacket.Payload(payload)))
+		ts = ts.Add(time.Millisecond)
+		require.NoError(t, w.WritePacket(gopacket.CaptureInfo{Timestamp: ts, CaptureLength: len(buf.Bytes()), Length: len(buf.Bytes())}, buf.Bytes()))
+	}
+
+	write(true, nil, func(tcp *layers.TCP) { tcp.SYN, tcp.Ack = true, 0 })
+	clientSeq++
+	write(false, nil, func(tcp *layers.TCP) { tcp.SYN, tcp.ACK = true, true })
+	serverSeq++
+	write(true, nil, func(tcp *layers.TCP) { tcp.ACK = true })
+	for _, segment := range segments {
+		write(segment.fromClient, segment.data, func(tcp *layers.TCP) { tcp.PSH, tcp.ACK = true, true })
+		if segment.fromClient {
+			clientSeq += uint32(len(segment.data))
+		} else {
+			serverSeq += uint32(len(segment.data))
+		}
+	}
+	write(true, nil, func(tcp *layers.TCP) { tcp.FIN, tcp.ACK = true, true })
+	clientSeq++
+	write(false, nil, func(tcp *layers.TCP) { tcp.FIN, tcp.ACK = true, true })
+	serverSeq++
+	write(true, nil, func(tcp *layers.TCP) { tcp.ACK = true })
+	return filename
+}
+
+func TestTLSKeyLogDecryptHTTPFlow(t *testing.T) {
+	for _, version := range []uint16{tls.VersionTLS12, tls.VersionTLS13} {
+		segments, keyLogRaw := recordHTTPSExchange(t, version)
+		pcapFile := writeTCPSessionPcap(t, segments)
+		keyLogFile := filepath.Join(t.TempDir(), "sslkeylog.txt")
+		require.NoError(t, os.WriteFile(keyLogFile, keyLogRaw, 0o644))
+
+		count := 0
+		err := pcaputil.OpenPcapFile(
+			pcapFile,
+			pcaputil.WithTLSKeyLogFile(keyLogFile),
+			pcaputil.WithHTTPFlow(func(flow *pcaputil.TrafficFlow, req *http.Request, rsp *http.Response) {
+				require.NotNil(t, rsp)
+				require.NotNil(t, req.TLS)
+				require.Equal(t, version, req.TLS.Version)
+				require.Equal(t, "www.example.com", req.TLS.ServerName)
+				require.Equal(t, "/tls-keylog", req.URL.Path)
+				body, _ := io.ReadAll(rsp.Body)
+				require.Equal(t, "hello secret", string(body))
+				count++
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, count, "tls version: %x", version)
+	}
+}
+
+func TestTLSKeyLogFileNotFound(t *testing.T) {
+	err := pcaputil.OpenPcapFile("not-existed.pcap", pcaputil.WithTLSKeyLogFile(filepath.Join(t.TempDir(), "not-existed.txt")))
+	require.Error(t, err)
+}
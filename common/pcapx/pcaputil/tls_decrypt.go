@@ -0,0 +1,176 @@
+package pcaputil
+
+import (
+	"bufio"
+	"crypto/tls"
+	"net/http"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bufpipe"
+	"github.com/yaklang/yaklang/common/utils/omap"
+	"github.com/yaklang/yaklang/common/utils/tlsutils"
+)
+
+// tlsFlowDecryptor 解密一条 TCP 流中的 TLS 记录，并把两个方向的明文分别写入管道以便解析 HTTP
+type tlsFlowDecryptor struct {
+	flow       *TrafficFlow
+	clientConn *TrafficConnection
+	decryptor  *tlsutils.TLSStreamDecryptor
+
+	requestReader  *bufpipe.PipeReader
+	requestWriter  *bufpipe.PipeWriter
+	responseReader *bufpipe.PipeReader
+	responseWriter *bufpipe.PipeWriter
+
+	startOnce *sync.Once
+	failed    bool
+}
+
+// isTLSRecord 判断数据是否以 TLS 记录头开始（content type 20-23，版本 3.x）
+func isTLSRecord(payload []byte) bool {
+	return len(payload) >= 5 && payload[0] >= 0x14 && payload[0] <= 0x17 && payload[1] == 0x03
+}
+
+func isTLSClientHelloRecord(payload []byte) bool {
+	// handshake record (0x16) + client hello (0x01)
+	return isTLSRecord(payload) && len(payload) > 5 && payload[0] == 0x16 && payload[5] == 0x01
+}
+
+func newTLSFlowDecryptor(flow *TrafficFlow, clientConn *TrafficConnection, keyLog *tlsutils.KeyLog) *tlsFlowDecryptor {
+	d := &tlsFlowDecryptor{
+		flow:       flow,
+		clientConn: clientConn,
+		decryptor:  tlsutils.NewTLSStreamDecryptor(keyLog),
+		startOnce:  new(sync.Once),
+	}
+	d.requestReader, d.requestWriter = utils.NewBufPipe(make([]byte, 0))
+	d.responseReader, d.responseWriter = utils.NewBufPipe(make([]byte, 0))
+	return d
+}
+
+func (d *tlsFlowDecryptor) close() {
+	d.requestWriter.Close()
+	d.responseWriter.Close()
+}
+
+func (d *tlsFlowDecryptor) feed(conn *TrafficConnection, payload []byte, h func(*TrafficFlow, *http.Request, *http.Response)) {
+	if d.failed {
+		return
+	}
+	fromClient := conn == d.clientConn
+	plaintext, err := d.decryptor.Feed(fromClient, payload)
+	if len(plaintext) > 0 && h != nil {
+		d.startOnce.Do(func() {
+			d.startHTTPFlow(h)
+		})
+		if fromClient {
+			d.requestWriter.Write(plaintext)
+		} else {
+			d.responseWriter.Write(plaintext)
+		}
+	}
+	if err != nil {
+		log.Debugf("decrypt tls flow %v failed: %v", d.flow.String(), err)
+		d.failed = true
+		d.close()
+	}
+}
+
+func (d *tlsFlowDecryptor) connectionState() *tls.ConnectionState {
+	state := &tls.ConnectionState{
+		Version:           d.decryptor.Version(),
+		CipherSuite:       d.decryptor.CipherSuite(),
+		HandshakeComplete: true,
+	}
+	if hello := d.decryptor.ClientHello(); hello != nil {
+		state.ServerName = hello.SNI()
+	}
+	return state
+}
+
+// startHTTPFlow 与 WithHTTPFlow 一样从明文中解析请求与响应，请求的 TLS 字段用于标记解密得到的 https 流量
+func (d *tlsFlowDecryptor) startHTTPFlow(h func(*TrafficFlow, *http.Request, *http.Response)) {
+	flow := d.flow
+	state := d.connectionState()
+	flow.httpflowWg.Add(2)
+	go func() {
+		defer flow.httpflowWg.Done()
+		reader := bufio.NewReader(d.requestReader)
+		for {
+			req, err := utils.ReadHTTPRequestFromBufioReader(reader)
+			if err != nil {
+				return
+			}
+			req.TLS = state
+			flow.StashHTTPRequest(req)
+			flow.AutoTriggerHTTPFlow(h)
+		}
+	}()
+	go func() {
+		defer flow.httpflowWg.Done()
+		defer func() {
+			if err := recover(); err != nil {
+				log.Errorf("tls http flow panic with: %v", err)
+			}
+		}()
+		reader := bufio.NewReader(d.responseReader)
+		for {
+			rsp, err := utils.ReadHTTPResponseFromBufioReader(reader, nil)
+			if err != nil {
+				return
+			}
+			rsp.TLS = state
+			flow.StashHTTPResponse(rsp)
+			flow.AutoTriggerHTTPFlow(h)
+		}
+	}()
+}
+
+// WithTLSKeyLog 使用 NSS Key Log（SSLKEYLOGFILE）中的密钥解密 TLS 1.2 / 1.3 流量
+// 解密得到的 HTTP/1.x 请求与响应交给 WithHTTPFlow 设置的回调，此时 req.TLS 不为空
+func WithTLSKeyLog(keyLog *tlsutils.KeyLog) CaptureOption {
+	decryptors := omap.NewOrderedMap(make(map[string]*tlsFlowDecryptor))
+	return withPool(func(pool *TrafficPool) {
+		pool.onFlowFrameDataFrameArrived = append(pool.onFlowFrameDataFrameArrived, func(flow *TrafficFlow, conn *TrafficConnection, frame *TrafficFrame) {
+			if len(frame.Payload) <= 0 {
+				return
+			}
+
+			d, ok := decryptors.Get(flow.Hash)
+			if !ok {
+				// 只处理从 ClientHello 开始的 TLS 流，否则无法得到 Client Random
+				if isTLSClientHelloRecord(frame.Payload) {
+					d = newTLSFlowDecryptor(flow, conn, keyLog)
+				}
+				decryptors.Set(flow.Hash, d)
+				hash, clientCtx, serverCtx := flow.Hash, flow.ClientConn.ctx, flow.ServerConn.ctx
+				go func() {
+					<-clientCtx.Done()
+					<-serverCtx.Done()
+					if d != nil {
+						d.close()
+					}
+					decryptors.Delete(hash)
+				}()
+			}
+			if d == nil {
+				return
+			}
+			d.feed(conn, frame.Payload, pool._onHTTPFlow)
+		})
+	})
+}
+
+// WithTLSKeyLogFile 从 SSLKEYLOGFILE 文件中加载密钥解密 TLS 流量，见 WithTLSKeyLog
+// 查询不到密钥时会重新读取该文件，因此可以与正在写入 Key Log 的浏览器 / MITM 同时使用
+func WithTLSKeyLogFile(filename string) CaptureOption {
+	return func(c *CaptureConfig) error {
+		keyLog, err := tlsutils.NewKeyLogFromFile(filename)
+		if err != nil {
+			return err
+		}
+		return WithTLSKeyLog(keyLog)(c)
+	}
+}
@@ -27,6 +27,8 @@ type S5Config struct {
 	MITMTLSConfig       *mitm.Config
 	Debug               bool
 	DownstreamHTTPProxy string
+	// Dialer 自定义连接目标的方式（例如通过 webshell 隧道），为空时直接连接或者使用 DownstreamHTTPProxy
+	Dialer func(timeout time.Duration, addr string) (net.Conn, error)
 }
 
 func NewConfig() (*S5Config, error) {
@@ -228,7 +230,11 @@ func (c *S5Config) HandleS5Request(conn net.Conn) (net.Conn, error) {
 	var proxyConnectionTimeout = 30 * time.Second
 	var actConn net.Conn
 	var err error
-	actConn, err = netx.DialTCPTimeout(proxyConnectionTimeout, targetAddr, downstreamProxy)
+	if c.Dialer != nil {
+		actConn, err = c.Dialer(proxyConnectionTimeout, targetAddr)
+	} else {
+		actConn, err = netx.DialTCPTimeout(proxyConnectionTimeout, targetAddr, downstreamProxy)
+	}
 	if err != nil {
 		return nil, err
 	}
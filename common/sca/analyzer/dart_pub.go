@@ -0,0 +1,44 @@
+package analyzer
+
+import (
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/dart/pub"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+const (
+	TypDartPub TypAnalyzer = "pub-lang"
+
+	PubspecLock = "pubspec.lock"
+
+	statusPubspecLock int = 1
+)
+
+func init() {
+	RegisterAnalyzer(TypDartPub, NewDartPubAnalyzer())
+}
+
+type pubAnalyzer struct{}
+
+func NewDartPubAnalyzer() *pubAnalyzer {
+	return &pubAnalyzer{}
+}
+
+func (a pubAnalyzer) Analyze(afi AnalyzeFileInfo) ([]*dxtypes.Package, error) {
+	fi := afi.Self
+	switch fi.MatchStatus {
+	case statusPubspecLock:
+		pkgs, err := ParseLanguageConfiguration(fi, pub.NewParser())
+		if err != nil {
+			return nil, err
+		}
+		return pkgs, nil
+	}
+	return nil, nil
+}
+
+func (a pubAnalyzer) Match(info MatchInfo) int {
+	if info.FileInfo.Name() == PubspecLock {
+		return statusPubspecLock
+	}
+	return 0
+}
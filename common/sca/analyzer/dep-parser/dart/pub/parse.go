@@ -0,0 +1,64 @@
+package pub
+
+import (
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/types"
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/utils"
+	outils "github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+const (
+	transitiveDep = "transitive"
+	directDevDep  = "direct dev"
+	sdkSource     = "sdk"
+)
+
+type lock struct {
+	Packages map[string]Dep `yaml:"packages"`
+}
+
+type Dep struct {
+	Dependency string `yaml:"dependency"`
+	Source     string `yaml:"source"`
+	Version    string `yaml:"version"`
+}
+
+// Parser parses pubspec.lock of Dart / Flutter
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(fs fi.FileSystem, r types.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+	l := &lock{}
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, outils.Errorf("failed to read pubspec.lock: %v", err)
+	}
+	if err := yaml.Unmarshal(input, l); err != nil {
+		return nil, nil, outils.Errorf("failed to decode pubspec.lock: %v", err)
+	}
+
+	var libs []types.Library
+	for name, dep := range l.Packages {
+		// the packages from the sdk (flutter, flutter_test...) use the version of sdk
+		if dep.Source == sdkSource || dep.Version == "" {
+			continue
+		}
+		libs = append(libs, types.Library{
+			ID:       utils.PackageID(name, dep.Version),
+			Name:     name,
+			Version:  dep.Version,
+			Indirect: dep.Dependency == transitiveDep,
+			Dev:      dep.Dependency == directDevDep,
+		})
+	}
+	sort.Sort(types.Libraries(libs))
+	return libs, nil, nil
+}
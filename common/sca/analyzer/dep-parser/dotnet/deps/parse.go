@@ -0,0 +1,102 @@
+package deps
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/liamg/jfather"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/types"
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/utils"
+	outils "github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+type dotNetDependencies struct {
+	Libraries map[string]dotNetLibrary           `json:"libraries"`
+	Targets   map[string]map[string]dotNetTarget `json:"targets"`
+}
+
+type dotNetLibrary struct {
+	Type      string `json:"type"`
+	Sha512    string `json:"sha512"`
+	StartLine int
+	EndLine   int
+}
+
+type dotNetTarget struct {
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// Parser parses *.deps.json generated by dotnet build / publish
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(fs fi.FileSystem, r types.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+	var depsFile dotNetDependencies
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, outils.Errorf("failed to read .deps.json file: %v", err)
+	}
+	if err := jfather.Unmarshal(input, &depsFile); err != nil {
+		return nil, nil, outils.Errorf("failed to decode .deps.json file: %v", err)
+	}
+
+	var libs []types.Library
+	for nameVer, lib := range depsFile.Libraries {
+		// the project itself is "project", the runtime packs are "runtimepack"
+		if !strings.EqualFold(lib.Type, "package") {
+			continue
+		}
+		name, version, ok := strings.Cut(nameVer, "/")
+		if !ok {
+			log.Debugf("cannot parse .NET library %q", nameVer)
+			continue
+		}
+		libs = append(libs, types.Library{
+			ID:        utils.PackageID(name, version),
+			Name:      name,
+			Version:   version,
+			Locations: []types.Location{{StartLine: lib.StartLine, EndLine: lib.EndLine}},
+		})
+	}
+
+	deps := make(map[string][]string)
+	for _, target := range depsFile.Targets {
+		for nameVer, t := range target {
+			name, version, ok := strings.Cut(nameVer, "/")
+			if !ok || len(t.Dependencies) == 0 {
+				continue
+			}
+			id := utils.PackageID(name, version)
+			for depName, depVersion := range t.Dependencies {
+				deps[id] = append(deps[id], utils.PackageID(depName, depVersion))
+			}
+		}
+	}
+
+	var dependencies []types.Dependency
+	for id, dependsOn := range deps {
+		dependsOn = utils.UniqueStrings(dependsOn)
+		sort.Strings(dependsOn)
+		dependencies = append(dependencies, types.Dependency{ID: id, DependsOn: dependsOn})
+	}
+	sort.Sort(types.Dependencies(dependencies))
+	return utils.UniqueLibraries(libs), dependencies, nil
+}
+
+// UnmarshalJSONWithMetadata needed to detect start and end lines of deps
+func (t *dotNetLibrary) UnmarshalJSONWithMetadata(node jfather.Node) error {
+	if err := node.Decode(&t); err != nil {
+		return err
+	}
+	// Decode func will overwrite line numbers if we save them first
+	t.StartLine = node.Range().Start.Line
+	t.EndLine = node.Range().End.Line
+	return nil
+}
@@ -0,0 +1,96 @@
+package nuget
+
+import (
+	"io"
+	"sort"
+
+	"github.com/liamg/jfather"
+
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/types"
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/utils"
+	outils "github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+type LockFile struct {
+	Version int                     `json:"version"`
+	Targets map[string]Dependencies `json:"dependencies"`
+}
+
+type Dependencies map[string]Dependency
+
+type Dependency struct {
+	Type         string            `json:"type"`
+	Resolved     string            `json:"resolved"`
+	Dependencies map[string]string `json:"dependencies"`
+	StartLine    int
+	EndLine      int
+}
+
+// Parser parses packages.lock.json of NuGet
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(fs fi.FileSystem, r types.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+	var lockFile LockFile
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, outils.Errorf("failed to read packages.lock.json: %v", err)
+	}
+	if err := jfather.Unmarshal(input, &lockFile); err != nil {
+		return nil, nil, outils.Errorf("failed to decode packages.lock.json: %v", err)
+	}
+
+	var (
+		libs []types.Library
+		deps = make(map[string][]string)
+	)
+	// the same package may be resolved in every target framework
+	for _, targetContent := range lockFile.Targets {
+		for packageName, packageContent := range targetContent {
+			// project references are not packages
+			if packageContent.Type == "Project" || packageContent.Resolved == "" {
+				continue
+			}
+			lib := types.Library{
+				ID:        utils.PackageID(packageName, packageContent.Resolved),
+				Name:      packageName,
+				Version:   packageContent.Resolved,
+				Indirect:  packageContent.Type == "Transitive",
+				Locations: []types.Location{{StartLine: packageContent.StartLine, EndLine: packageContent.EndLine}},
+			}
+			libs = append(libs, lib)
+
+			for depName := range packageContent.Dependencies {
+				dep, ok := targetContent[depName]
+				if !ok || dep.Resolved == "" {
+					continue
+				}
+				deps[lib.ID] = append(deps[lib.ID], utils.PackageID(depName, dep.Resolved))
+			}
+		}
+	}
+
+	var dependencies []types.Dependency
+	for id, dependsOn := range deps {
+		dependsOn = utils.UniqueStrings(dependsOn)
+		sort.Strings(dependsOn)
+		dependencies = append(dependencies, types.Dependency{ID: id, DependsOn: dependsOn})
+	}
+	sort.Sort(types.Dependencies(dependencies))
+	return utils.UniqueLibraries(libs), dependencies, nil
+}
+
+// UnmarshalJSONWithMetadata needed to detect start and end lines of deps
+func (t *Dependency) UnmarshalJSONWithMetadata(node jfather.Node) error {
+	if err := node.Decode(&t); err != nil {
+		return err
+	}
+	// Decode func will overwrite line numbers if we save them first
+	t.StartLine = node.Range().Start.Line
+	t.EndLine = node.Range().End.Line
+	return nil
+}
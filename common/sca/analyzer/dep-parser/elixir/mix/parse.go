@@ -0,0 +1,83 @@
+package mix
+
+import (
+	"bufio"
+	"regexp"
+	"sort"
+
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/types"
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/utils"
+	outils "github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+var (
+	// "cowboy": {:hex, :cowboy, "2.9.0", "2c729f934b4e1aa149aff882f57c6372c15399a20d54f65c8d67bef583021bde", [:make, :rebar3], [...], "hexpm", "..."},
+	hexDepRegexp = regexp.MustCompile(`^\s*"([^"]+)":\s*\{:hex,\s*:"?([\w.-]+)"?,\s*"([^"]+)"`)
+	// {:cowlib, "2.11.0", [hex: :cowlib, repo: "hexpm", optional: false]}
+	hexChildRegexp = regexp.MustCompile(`\{:"?([\w.-]+)"?,\s*"[^"]*",\s*\[hex:\s*:"?([\w.-]+)"?`)
+)
+
+// Parser parses mix.lock of Elixir, only the packages from hex are reported,
+// git / path dependencies have no version
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(fs fi.FileSystem, r types.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+	var (
+		libs     = make(map[string]types.Library) // lock name => Library
+		children = make(map[string][]string)      // lock name => hex names of children
+		lineNum  int
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		m := hexDepRegexp.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		// the key in lock is the app name, the hex package name may differ
+		key, name, version := m[1], m[2], m[3]
+		libs[key] = types.Library{
+			ID:        utils.PackageID(name, version),
+			Name:      name,
+			Version:   version,
+			Locations: []types.Location{{StartLine: lineNum, EndLine: lineNum}},
+		}
+		for _, child := range hexChildRegexp.FindAllStringSubmatch(line[len(m[0]):], -1) {
+			children[key] = append(children[key], child[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, outils.Errorf("failed to scan mix.lock: %v", err)
+	}
+
+	var deps []types.Dependency
+	for key, childKeys := range children {
+		var dependsOn []string
+		for _, childKey := range childKeys {
+			if child, ok := libs[childKey]; ok {
+				dependsOn = append(dependsOn, child.ID)
+			}
+		}
+		if len(dependsOn) == 0 {
+			continue
+		}
+		sort.Strings(dependsOn)
+		deps = append(deps, types.Dependency{ID: libs[key].ID, DependsOn: dependsOn})
+	}
+	sort.Sort(types.Dependencies(deps))
+
+	ret := make([]types.Library, 0, len(libs))
+	for _, lib := range libs {
+		ret = append(ret, lib)
+	}
+	sort.Sort(types.Libraries(ret))
+	return ret, deps, nil
+}
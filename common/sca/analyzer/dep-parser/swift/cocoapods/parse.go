@@ -0,0 +1,114 @@
+package cocoapods
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/types"
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/utils"
+	outils "github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+type lockFile struct {
+	// the item is "Name (version)" or {"Name (version)": ["Dep (= version)", ...]}
+	Pods []any `yaml:"PODS"`
+}
+
+// Parser parses Podfile.lock of CocoaPods
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(fs fi.FileSystem, r types.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+	var lock lockFile
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, outils.Errorf("failed to read Podfile.lock: %v", err)
+	}
+	if err := yaml.Unmarshal(input, &lock); err != nil {
+		return nil, nil, outils.Errorf("failed to decode Podfile.lock: %v", err)
+	}
+
+	parsedDeps := make(map[string]types.Library) // dependency name => Library
+	directDeps := make(map[string][]string)      // dependency name => slice of child dependency names
+	for _, pod := range lock.Pods {
+		switch dep := pod.(type) {
+		case string:
+			lib, err := parseDep(dep)
+			if err != nil {
+				log.Debug(err)
+				continue
+			}
+			parsedDeps[lib.Name] = lib
+		case map[string]any:
+			for blockDep, blockDeps := range dep {
+				lib, err := parseDep(blockDep)
+				if err != nil {
+					log.Debug(err)
+					continue
+				}
+				parsedDeps[lib.Name] = lib
+
+				children, ok := blockDeps.([]any)
+				if !ok {
+					continue
+				}
+				for _, child := range children {
+					s, ok := child.(string)
+					if !ok {
+						continue
+					}
+					directDeps[lib.Name] = append(directDeps[lib.Name], strings.Fields(s)[0])
+				}
+			}
+		}
+	}
+
+	var deps []types.Dependency
+	for dep, childDeps := range directDeps {
+		var dependsOn []string
+		// find versions for child dependencies
+		for _, childDep := range childDeps {
+			if child, ok := parsedDeps[childDep]; ok {
+				dependsOn = append(dependsOn, child.ID)
+			}
+		}
+		if len(dependsOn) == 0 {
+			continue
+		}
+		sort.Strings(dependsOn)
+		deps = append(deps, types.Dependency{
+			ID:        parsedDeps[dep].ID,
+			DependsOn: dependsOn,
+		})
+	}
+	sort.Sort(types.Dependencies(deps))
+
+	libs := make([]types.Library, 0, len(parsedDeps))
+	for _, lib := range parsedDeps {
+		libs = append(libs, lib)
+	}
+	return utils.UniqueLibraries(libs), deps, nil
+}
+
+// parseDep parses "AFNetworking/NSURLSession (4.0.1)"
+func parseDep(dep string) (types.Library, error) {
+	ss := strings.Fields(dep)
+	if len(ss) != 2 {
+		return types.Library{}, outils.Errorf("unable to determine cocoapods dependency: %q", dep)
+	}
+	name := ss[0]
+	version := strings.Trim(strings.TrimSpace(ss[1]), "()")
+	return types.Library{
+		ID:      utils.PackageID(name, version),
+		Name:    name,
+		Version: version,
+	}, nil
+}
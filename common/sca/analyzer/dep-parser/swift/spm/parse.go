@@ -0,0 +1,110 @@
+package spm
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/liamg/jfather"
+
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/types"
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/utils"
+	outils "github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+// LockFile is Package.resolved, version 1 puts the pins in "object", version 2 and 3 put them in the root
+type LockFile struct {
+	Object  Object `json:"object"`
+	Pins    []Pin  `json:"pins"`
+	Version int    `json:"version"`
+}
+
+type Object struct {
+	Pins []Pin `json:"pins"`
+}
+
+type Pin struct {
+	Package       string `json:"package"`
+	RepositoryURL string `json:"repositoryURL"` // Package.resolved v1
+	Location      string `json:"location"`      // Package.resolved v2 / v3
+	State         State  `json:"state"`
+	StartLine     int
+	EndLine       int
+}
+
+type State struct {
+	Branch   string `json:"branch"`
+	Revision string `json:"revision"`
+	Version  string `json:"version"`
+}
+
+// Parser parses Package.resolved of Swift Package Manager
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(fs fi.FileSystem, r types.ReadSeekerAt) ([]types.Library, []types.Dependency, error) {
+	var lockFile LockFile
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, outils.Errorf("failed to read Package.resolved: %v", err)
+	}
+	if err := jfather.Unmarshal(input, &lockFile); err != nil {
+		return nil, nil, outils.Errorf("failed to decode Package.resolved: %v", err)
+	}
+
+	pins := lockFile.Object.Pins
+	if lockFile.Version > 1 {
+		pins = lockFile.Pins
+	}
+
+	var libs []types.Library
+	for _, pin := range pins {
+		name := libraryName(pin, lockFile.Version)
+		// skip the pins without a tagged version, e.g. a branch or a revision
+		if name == "" || pin.State.Version == "" {
+			continue
+		}
+		libs = append(libs, types.Library{
+			ID:        utils.PackageID(name, pin.State.Version),
+			Name:      name,
+			Version:   pin.State.Version,
+			Locations: []types.Location{{StartLine: pin.StartLine, EndLine: pin.EndLine}},
+		})
+	}
+	sort.Sort(types.Libraries(libs))
+	return libs, nil, nil
+}
+
+// libraryName uses the repository url without scheme and .git suffix as name,
+// e.g. https://github.com/Alamofire/Alamofire.git => github.com/Alamofire/Alamofire
+func libraryName(pin Pin, lockVersion int) string {
+	name := pin.RepositoryURL
+	if lockVersion > 1 {
+		name = pin.Location
+	}
+	if name == "" {
+		return pin.Package
+	}
+	if idx := strings.Index(name, "://"); idx >= 0 {
+		name = name[idx+3:]
+	} else if user, rest, ok := strings.Cut(name, "@"); ok && !strings.Contains(user, "/") {
+		// git@github.com:Alamofire/Alamofire.git
+		name = strings.Replace(rest, ":", "/", 1)
+	}
+	return strings.TrimSuffix(name, ".git")
+}
+
+// UnmarshalJSONWithMetadata needed to detect start and end lines of deps
+func (p *Pin) UnmarshalJSONWithMetadata(node jfather.Node) error {
+	if err := node.Decode(&p); err != nil {
+		return err
+	}
+	// Decode func will overwrite line numbers if we save them first
+	p.StartLine = node.Range().Start.Line
+	p.EndLine = node.Range().End.Line
+	return nil
+}
@@ -0,0 +1,47 @@
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/dotnet/deps"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+const (
+	TypDotNetDeps TypAnalyzer = "dotnet-deps-lang"
+
+	dotnetDepsSuffix = ".deps.json"
+
+	statusDotNetDeps int = 1
+)
+
+func init() {
+	RegisterAnalyzer(TypDotNetDeps, NewDotNetDepsAnalyzer())
+}
+
+type dotnetDepsAnalyzer struct{}
+
+func NewDotNetDepsAnalyzer() *dotnetDepsAnalyzer {
+	return &dotnetDepsAnalyzer{}
+}
+
+func (a dotnetDepsAnalyzer) Analyze(afi AnalyzeFileInfo) ([]*dxtypes.Package, error) {
+	fi := afi.Self
+	switch fi.MatchStatus {
+	case statusDotNetDeps:
+		pkgs, err := ParseLanguageConfiguration(fi, deps.NewParser())
+		if err != nil {
+			return nil, err
+		}
+		return pkgs, nil
+	}
+	return nil, nil
+}
+
+func (a dotnetDepsAnalyzer) Match(info MatchInfo) int {
+	// xxx.deps.json is generated by `dotnet build` / `dotnet publish`
+	if strings.HasSuffix(info.FileInfo.Name(), dotnetDepsSuffix) {
+		return statusDotNetDeps
+	}
+	return 0
+}
@@ -0,0 +1,44 @@
+package analyzer
+
+import (
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/dotnet/nuget"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+const (
+	TypDotNetNuget TypAnalyzer = "nuget-lang"
+
+	NugetLock = "packages.lock.json"
+
+	statusNugetLock int = 1
+)
+
+func init() {
+	RegisterAnalyzer(TypDotNetNuget, NewDotNetNugetAnalyzer())
+}
+
+type nugetAnalyzer struct{}
+
+func NewDotNetNugetAnalyzer() *nugetAnalyzer {
+	return &nugetAnalyzer{}
+}
+
+func (a nugetAnalyzer) Analyze(afi AnalyzeFileInfo) ([]*dxtypes.Package, error) {
+	fi := afi.Self
+	switch fi.MatchStatus {
+	case statusNugetLock:
+		pkgs, err := ParseLanguageConfiguration(fi, nuget.NewParser())
+		if err != nil {
+			return nil, err
+		}
+		return pkgs, nil
+	}
+	return nil, nil
+}
+
+func (a nugetAnalyzer) Match(info MatchInfo) int {
+	if info.FileInfo.Name() == NugetLock {
+		return statusNugetLock
+	}
+	return 0
+}
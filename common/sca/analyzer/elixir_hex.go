@@ -0,0 +1,44 @@
+package analyzer
+
+import (
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/elixir/mix"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+const (
+	TypElixirHex TypAnalyzer = "hex-lang"
+
+	MixLock = "mix.lock"
+
+	statusMixLock int = 1
+)
+
+func init() {
+	RegisterAnalyzer(TypElixirHex, NewElixirHexAnalyzer())
+}
+
+type hexAnalyzer struct{}
+
+func NewElixirHexAnalyzer() *hexAnalyzer {
+	return &hexAnalyzer{}
+}
+
+func (a hexAnalyzer) Analyze(afi AnalyzeFileInfo) ([]*dxtypes.Package, error) {
+	fi := afi.Self
+	switch fi.MatchStatus {
+	case statusMixLock:
+		pkgs, err := ParseLanguageConfiguration(fi, mix.NewParser())
+		if err != nil {
+			return nil, err
+		}
+		return pkgs, nil
+	}
+	return nil, nil
+}
+
+func (a hexAnalyzer) Match(info MatchInfo) int {
+	if info.FileInfo.Name() == MixLock {
+		return statusMixLock
+	}
+	return 0
+}
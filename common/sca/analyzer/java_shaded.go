@@ -0,0 +1,61 @@
+package analyzer
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+const (
+	TypJavaShaded TypAnalyzer = "jar-shaded-lang"
+
+	pomPropertiesFile = "pom.properties"
+	mavenMetaDir      = "META-INF/maven/"
+
+	statusPomProperties int = 1
+)
+
+func init() {
+	RegisterAnalyzer(TypJavaShaded, NewJavaShadedAnalyzer())
+}
+
+// shadedAnalyzer reads META-INF/maven/<groupId>/<artifactId>/pom.properties in the filesystem,
+// they are left by the exploded fat jars (e.g. spring boot layers, unpacked uber jars), the
+// shaded dependencies in a packed jar are reported by jar-lang
+type shadedAnalyzer struct{}
+
+func NewJavaShadedAnalyzer() *shadedAnalyzer {
+	return &shadedAnalyzer{}
+}
+
+func (a shadedAnalyzer) Analyze(afi AnalyzeFileInfo) ([]*dxtypes.Package, error) {
+	fi := afi.Self
+	switch fi.MatchStatus {
+	case statusPomProperties:
+		props, err := parsePomPropertiesFromReader(fi.LazyFile, fi.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !props.Valid() {
+			return nil, nil
+		}
+		lib := props.Library()
+		return []*dxtypes.Package{
+			{
+				Name:    lib.Name,
+				Version: lib.Version,
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+func (a shadedAnalyzer) Match(info MatchInfo) int {
+	p := filepath.ToSlash(info.Path)
+	if path.Base(p) == pomPropertiesFile && strings.Contains(p, mavenMetaDir) {
+		return statusPomProperties
+	}
+	return 0
+}
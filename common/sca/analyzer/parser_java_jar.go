@@ -36,8 +36,10 @@ type JarProperties struct {
 }
 
 func (p JarProperties) Library() types.Library {
+	name := fmt.Sprintf("%s:%s", p.GroupID, p.ArtifactID)
 	return types.Library{
-		Name:     fmt.Sprintf("%s:%s", p.GroupID, p.ArtifactID),
+		ID:       fmt.Sprintf("%s@%s", name, p.Version),
+		Name:     name,
 		Version:  p.Version,
 		FilePath: p.FilePath,
 	}
@@ -79,6 +81,10 @@ func (p *JarParser) parseArtifact(fs fi.FileSystem, filePath string, size int64,
 	var libs []types.Library
 	var m manifest
 	var foundPomProps bool
+	var rootLib types.Library
+	// the artifacts described by META-INF/maven/**/pom.properties in this jar,
+	// except the jar itself they are shaded into the fat jar
+	var pomLibs []types.Library
 
 	for _, fileInJar := range zr.File {
 		filename := filepath.Base(fileInJar.Name)
@@ -93,6 +99,9 @@ func (p *JarParser) parseArtifact(fs fi.FileSystem, filePath string, size int64,
 			// Check if the pom.properties is for the original JAR/WAR/EAR
 			if fileProps.ArtifactID == props.ArtifactID && fileProps.Version == props.Version {
 				foundPomProps = true
+				rootLib = props.Library()
+			} else {
+				pomLibs = append(pomLibs, props.Library())
 			}
 		case filename == "MANIFEST.MF":
 			m, err = parseManifest(fileInJar)
@@ -110,14 +119,30 @@ func (p *JarParser) parseArtifact(fs fi.FileSystem, filePath string, size int64,
 
 	// If pom.properties is found, it should be preferred than MANIFEST.MF.
 	if foundPomProps {
-		return libs, nil, nil
+		return libs, shadedDependencies(rootLib, pomLibs), nil
 	}
 
 	manifestProps := m.properties(filePath)
 	if !manifestProps.Valid() {
 		return libs, nil, nil
 	}
-	return append(libs, manifestProps.Library()), nil, nil
+	rootLib = manifestProps.Library()
+	return append(libs, rootLib), shadedDependencies(rootLib, pomLibs), nil
+}
+
+// shadedDependencies links the shaded artifacts to the fat jar
+func shadedDependencies(root types.Library, shaded []types.Library) []types.Dependency {
+	dependsOn := make([]string, 0, len(shaded))
+	for _, lib := range shaded {
+		if lib.ID == root.ID {
+			continue
+		}
+		dependsOn = append(dependsOn, lib.ID)
+	}
+	if root.ID == "" || len(dependsOn) == 0 {
+		return nil
+	}
+	return []types.Dependency{{ID: root.ID, DependsOn: lo.Uniq(dependsOn)}}
 }
 
 func (p *JarParser) parseInnerJar(fs fi.FileSystem, zf *zip.File, rootPath string) ([]types.Library, []types.Dependency, error) {
@@ -181,11 +206,14 @@ func parsePomProperties(f *zip.File, filePath string) (JarProperties, error) {
 		return JarProperties{}, utils.Errorf("unable to open pom.properties: %v", err)
 	}
 	defer file.Close()
+	return parsePomPropertiesFromReader(file, filePath)
+}
 
+func parsePomPropertiesFromReader(r io.Reader, filePath string) (JarProperties, error) {
 	p := JarProperties{
 		FilePath: filePath,
 	}
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 		switch {
@@ -198,7 +226,7 @@ func parsePomProperties(f *zip.File, filePath string) (JarProperties, error) {
 		}
 	}
 
-	if err = scanner.Err(); err != nil {
+	if err := scanner.Err(); err != nil {
 		return JarProperties{}, utils.Errorf("scan error: %v", err)
 	}
 	return p, nil
@@ -0,0 +1,44 @@
+package analyzer
+
+import (
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/swift/cocoapods"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+const (
+	TypSwiftCocoaPods TypAnalyzer = "cocoapods-lang"
+
+	PodfileLock = "Podfile.lock"
+
+	statusCocoaPods int = 1
+)
+
+func init() {
+	RegisterAnalyzer(TypSwiftCocoaPods, NewSwiftCocoaPodsAnalyzer())
+}
+
+type cocoaPodsAnalyzer struct{}
+
+func NewSwiftCocoaPodsAnalyzer() *cocoaPodsAnalyzer {
+	return &cocoaPodsAnalyzer{}
+}
+
+func (a cocoaPodsAnalyzer) Analyze(afi AnalyzeFileInfo) ([]*dxtypes.Package, error) {
+	fi := afi.Self
+	switch fi.MatchStatus {
+	case statusCocoaPods:
+		pkgs, err := ParseLanguageConfiguration(fi, cocoapods.NewParser())
+		if err != nil {
+			return nil, err
+		}
+		return pkgs, nil
+	}
+	return nil, nil
+}
+
+func (a cocoaPodsAnalyzer) Match(info MatchInfo) int {
+	if info.FileInfo.Name() == PodfileLock {
+		return statusCocoaPods
+	}
+	return 0
+}
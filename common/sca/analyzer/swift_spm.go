@@ -0,0 +1,44 @@
+package analyzer
+
+import (
+	"github.com/yaklang/yaklang/common/sca/analyzer/dep-parser/swift/spm"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+const (
+	TypSwiftPM TypAnalyzer = "swift-lang"
+
+	SwiftPackageResolved = "Package.resolved"
+
+	statusSwiftPM int = 1
+)
+
+func init() {
+	RegisterAnalyzer(TypSwiftPM, NewSwiftPMAnalyzer())
+}
+
+type swiftPMAnalyzer struct{}
+
+func NewSwiftPMAnalyzer() *swiftPMAnalyzer {
+	return &swiftPMAnalyzer{}
+}
+
+func (a swiftPMAnalyzer) Analyze(afi AnalyzeFileInfo) ([]*dxtypes.Package, error) {
+	fi := afi.Self
+	switch fi.MatchStatus {
+	case statusSwiftPM:
+		pkgs, err := ParseLanguageConfiguration(fi, spm.NewParser())
+		if err != nil {
+			return nil, err
+		}
+		return pkgs, nil
+	}
+	return nil, nil
+}
+
+func (a swiftPMAnalyzer) Match(info MatchInfo) int {
+	if info.FileInfo.Name() == SwiftPackageResolved {
+		return statusSwiftPM
+	}
+	return 0
+}
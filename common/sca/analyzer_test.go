@@ -950,6 +950,160 @@ func TestRustCargo(t *testing.T) {
 	})
 }
 
+func TestDotNet(t *testing.T) {
+	t.Run("nuget-lock", func(t *testing.T) {
+		tc := testcase{
+			name:        "nuget-lock",
+			filePath:    "./testdata/dotnet_nuget/packages.lock.json",
+			virtualPath: "/test/packages.lock.json",
+			t:           t,
+			a:           analyzer.NewDotNetNugetAnalyzer(),
+			matchType:   1,
+			wantPkgs:    DotNetNugetPkgs,
+		}
+		pkgs := Run(tc)
+		for _, pkg := range pkgs {
+			if pkg.Name == "Serilog.Sinks.Console" {
+				if len(pkg.UpStreamPackages) != 1 {
+					t.Fatalf("Serilog.Sinks.Console should depend on Serilog: %v", pkg.UpStreamPackages)
+				}
+			}
+		}
+	})
+	t.Run("deps-json", func(t *testing.T) {
+		tc := testcase{
+			name:        "deps-json",
+			filePath:    "./testdata/dotnet_deps/ExampleApp.deps.json",
+			virtualPath: "/app/ExampleApp.deps.json",
+			t:           t,
+			a:           analyzer.NewDotNetDepsAnalyzer(),
+			matchType:   1,
+			wantPkgs:    DotNetDepsPkgs,
+		}
+		Run(tc)
+	})
+	t.Run("negative", func(t *testing.T) {
+		tc := testcase{
+			name:        "negative",
+			filePath:    "./testdata/swift_cocoapods/Podfile.lock",
+			virtualPath: "/test/packages.lock.json",
+			t:           t,
+			a:           analyzer.NewDotNetNugetAnalyzer(),
+			matchType:   1,
+			wantPkgs:    []*dxtypes.Package{},
+			wantError:   true,
+		}
+		Run(tc)
+	})
+}
+
+func TestSwift(t *testing.T) {
+	t.Run("cocoapods", func(t *testing.T) {
+		tc := testcase{
+			name:        "cocoapods",
+			filePath:    "./testdata/swift_cocoapods/Podfile.lock",
+			virtualPath: "/test/Podfile.lock",
+			t:           t,
+			a:           analyzer.NewSwiftCocoaPodsAnalyzer(),
+			matchType:   1,
+			wantPkgs:    SwiftCocoaPodsPkgs,
+		}
+		Run(tc)
+	})
+	t.Run("swift-pm", func(t *testing.T) {
+		tc := testcase{
+			name:        "swift-pm",
+			filePath:    "./testdata/swift_pm/Package.resolved",
+			virtualPath: "/test/Package.resolved",
+			t:           t,
+			a:           analyzer.NewSwiftPMAnalyzer(),
+			matchType:   1,
+			wantPkgs:    SwiftPMPkgs,
+		}
+		Run(tc)
+	})
+	t.Run("swift-pm-v1", func(t *testing.T) {
+		tc := testcase{
+			name:        "swift-pm-v1",
+			filePath:    "./testdata/swift_pm/Package.resolved.v1",
+			virtualPath: "/test/Package.resolved",
+			t:           t,
+			a:           analyzer.NewSwiftPMAnalyzer(),
+			matchType:   1,
+			wantPkgs:    SwiftPMV1Pkgs,
+		}
+		Run(tc)
+	})
+}
+
+func TestDartPub(t *testing.T) {
+	tc := testcase{
+		name:        "positive",
+		filePath:    "./testdata/dart_pub/pubspec.lock",
+		virtualPath: "/test/pubspec.lock",
+		t:           t,
+		a:           analyzer.NewDartPubAnalyzer(),
+		matchType:   1,
+		wantPkgs:    DartPubPkgs,
+	}
+	Run(tc)
+}
+
+func TestElixirHex(t *testing.T) {
+	tc := testcase{
+		name:        "positive",
+		filePath:    "./testdata/elixir_hex/mix.lock",
+		virtualPath: "/test/mix.lock",
+		t:           t,
+		a:           analyzer.NewElixirHexAnalyzer(),
+		matchType:   1,
+		wantPkgs:    ElixirHexPkgs,
+	}
+	pkgs := Run(tc)
+	for _, pkg := range pkgs {
+		if pkg.Name == "cowboy" && len(pkg.UpStreamPackages) != 2 {
+			t.Fatalf("cowboy should depend on cowlib and ranch: %v", pkg.UpStreamPackages)
+		}
+	}
+}
+
+func TestJavaShaded(t *testing.T) {
+	t.Run("fat-jar", func(t *testing.T) {
+		tc := testcase{
+			name:        "fat-jar",
+			filePath:    "./testdata/java_jar/positive/fat-app-1.0.0.jar",
+			virtualPath: "/app/fat-app-1.0.0.jar",
+			t:           t,
+			a:           analyzer.NewJavaJarAnalyzer(),
+			matchType:   1,
+			wantPkgs:    JavaShadedJarPkgs,
+		}
+		pkgs := Run(tc)
+		for _, pkg := range pkgs {
+			if pkg.Name != "com.example:fat-app" {
+				continue
+			}
+			names := lo.MapToSlice(pkg.UpStreamPackages, func(_ string, p *dxtypes.Package) string { return p.Name })
+			sort.Strings(names)
+			if !reflect.DeepEqual(names, []string{"com.google.guava:guava", "org.yaml:snakeyaml"}) {
+				t.Fatalf("shaded dependencies error: %v", names)
+			}
+		}
+	})
+	t.Run("exploded-pom-properties", func(t *testing.T) {
+		tc := testcase{
+			name:        "exploded-pom-properties",
+			filePath:    "./testdata/java_shaded/pom.properties",
+			virtualPath: "/app/META-INF/maven/com.google.guava/guava/pom.properties",
+			t:           t,
+			a:           analyzer.NewJavaShadedAnalyzer(),
+			matchType:   1,
+			wantPkgs:    JavaShadedPomPropertiesPkgs,
+		}
+		Run(tc)
+	})
+}
+
 func TestCustomAnalyzer(t *testing.T) {
 	tc := testcase{
 		name:        "positive",
@@ -1045,6 +1199,13 @@ func TestFilterAnalyzer(t *testing.T) {
 		getName(analyzer.NewRubyBundlerAnalyzer()),
 		getName(analyzer.NewRubyGemSpecAnalyzer()),
 		getName(analyzer.NewRustCargoAnalyzer()),
+		getName(analyzer.NewDotNetNugetAnalyzer()),
+		getName(analyzer.NewDotNetDepsAnalyzer()),
+		getName(analyzer.NewSwiftCocoaPodsAnalyzer()),
+		getName(analyzer.NewSwiftPMAnalyzer()),
+		getName(analyzer.NewDartPubAnalyzer()),
+		getName(analyzer.NewElixirHexAnalyzer()),
+		getName(analyzer.NewJavaShadedAnalyzer()),
 	}
 
 	t.Run("filter-by-mode", func(t *testing.T) {
@@ -3321,6 +3321,137 @@ var RustCargoPkgs = []*dxtypes.Package{
 	},
 }
 
+var DotNetNugetPkgs = []*dxtypes.Package{
+	{
+		Name:    "Newtonsoft.Json",
+		Version: "13.0.1",
+	},
+	{
+		Name:    "Serilog",
+		Version: "2.10.0",
+	},
+	{
+		Name:    "Serilog.Sinks.Console",
+		Version: "4.1.0",
+	},
+}
+
+var DotNetDepsPkgs = []*dxtypes.Package{
+	{
+		Name:    "Microsoft.Data.SqlClient",
+		Version: "5.0.1",
+	},
+	{
+		Name:    "Newtonsoft.Json",
+		Version: "13.0.1",
+	},
+	{
+		Name:    "System.Text.Encodings.Web",
+		Version: "4.7.2",
+	},
+}
+
+var SwiftCocoaPodsPkgs = []*dxtypes.Package{
+	{
+		Name:    "AFNetworking",
+		Version: "4.0.1",
+	},
+	{
+		Name:    "AFNetworking/NSURLSession",
+		Version: "4.0.1",
+	},
+	{
+		Name:    "AFNetworking/Reachability",
+		Version: "4.0.1",
+	},
+	{
+		Name:    "SDWebImage",
+		Version: "5.15.5",
+	},
+	{
+		Name:    "SDWebImage/Core",
+		Version: "5.15.5",
+	},
+}
+
+var SwiftPMPkgs = []*dxtypes.Package{
+	{
+		Name:    "github.com/Alamofire/Alamofire",
+		Version: "5.7.1",
+	},
+	{
+		Name:    "github.com/apple/swift-nio",
+		Version: "2.54.0",
+	},
+}
+
+var SwiftPMV1Pkgs = []*dxtypes.Package{
+	{
+		Name:    "github.com/onevcat/Kingfisher",
+		Version: "7.8.1",
+	},
+}
+
+var DartPubPkgs = []*dxtypes.Package{
+	{
+		Name:    "async",
+		Version: "2.11.0",
+	},
+	{
+		Name:    "http",
+		Version: "1.1.0",
+	},
+	{
+		Name:    "lints",
+		Version: "2.1.1",
+	},
+}
+
+var ElixirHexPkgs = []*dxtypes.Package{
+	{
+		Name:    "cowboy",
+		Version: "2.9.0",
+	},
+	{
+		Name:    "cowlib",
+		Version: "2.11.0",
+	},
+	{
+		Name:    "phoenix",
+		Version: "1.7.2",
+	},
+	{
+		Name:    "plug",
+		Version: "1.14.2",
+	},
+	{
+		Name:    "ranch",
+		Version: "1.8.0",
+	},
+}
+
+var JavaShadedJarPkgs = []*dxtypes.Package{
+	{
+		Name:    "com.example:fat-app",
+		Version: "1.0.0",
+	},
+	{
+		Name:    "com.google.guava:guava",
+		Version: "31.1-jre",
+	},
+	{
+		Name:    "org.yaml:snakeyaml",
+		Version: "1.33",
+	},
+}
+
+var JavaShadedPomPropertiesPkgs = []*dxtypes.Package{
+	{
+		Name:    "com.google.guava:guava",
+		Version: "31.1-jre",
+	},
+}
+
 func check(t *testing.T, tag string, target []*dxtypes.Package) {
 	seen := make(map[string]*dxtypes.Package, len(target))
 
@@ -3369,4 +3500,13 @@ func TestData(t *testing.T) {
 	check(t, "ruby-bundler", RubyBundlerPkgs)
 	check(t, "ruby-gemspec", RubyGemspecPkgs)
 	check(t, "rust-cargo", RustCargoPkgs)
+	check(t, "dotnet-nuget", DotNetNugetPkgs)
+	check(t, "dotnet-deps", DotNetDepsPkgs)
+	check(t, "swift-cocoapods", SwiftCocoaPodsPkgs)
+	check(t, "swift-pm", SwiftPMPkgs)
+	check(t, "swift-pm-v1", SwiftPMV1Pkgs)
+	check(t, "dart-pub", DartPubPkgs)
+	check(t, "elixir-hex", ElixirHexPkgs)
+	check(t, "java-shaded-jar", JavaShadedJarPkgs)
+	check(t, "java-shaded-pom-properties", JavaShadedPomPropertiesPkgs)
 }
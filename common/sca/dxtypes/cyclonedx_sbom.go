@@ -3,9 +3,12 @@ package dxtypes
 import (
 	"bytes"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
 	"github.com/yaklang/yaklang/common/filter"
 	"github.com/yaklang/yaklang/common/go-funk"
 )
@@ -40,6 +43,35 @@ func normalCyloneDXHashType(i string) (cdx.HashAlgorithm, bool) {
 	return "", false
 }
 
+const (
+	sbomPropertyAnalyzer  = "yaklang:sca:analyzer"
+	sbomPropertyFile      = "yaklang:sca:file"
+	sbomPropertyPotential = "yaklang:sca:potential"
+	sbomPropertyCVE       = "yaklang:sca:cve"
+)
+
+// PackageBOMRef is the bom-ref of the package in the CycloneDX BOM and the VEX document
+func PackageBOMRef(pkg *Package) string {
+	return fmt.Sprintf("%v@%v", pkg.Name, pkg.Version)
+}
+
+func dxPackageToCycloneDXProperties(pkg *Package) []cdx.Property {
+	var props []cdx.Property
+	for _, analyzer := range pkg.FromAnalyzer {
+		props = append(props, cdx.Property{Name: sbomPropertyAnalyzer, Value: analyzer})
+	}
+	for _, file := range pkg.FromFile {
+		props = append(props, cdx.Property{Name: sbomPropertyFile, Value: file})
+	}
+	for _, cve := range pkg.AssociatedCVE {
+		props = append(props, cdx.Property{Name: sbomPropertyCVE, Value: cve})
+	}
+	if pkg.Potential {
+		props = append(props, cdx.Property{Name: sbomPropertyPotential, Value: strconv.FormatBool(pkg.Potential)})
+	}
+	return props
+}
+
 func dxPackagesToCycloneDXComponent(pkgFilter filter.Filterable, pkgs []*Package) []cdx.Component {
 	ret := make([]cdx.Component, 0, len(pkgs))
 	for _, pkg := range pkgs {
@@ -87,24 +119,109 @@ func dxPackagesToCycloneDXComponent(pkgFilter filter.Filterable, pkgs []*Package
 				}
 			}
 		}
-		ret = append(ret, cdx.Component{
+		component := cdx.Component{
+			BOMRef:     PackageBOMRef(pkg),
+			Type:       cdx.ComponentTypeLibrary,
 			Name:       pkg.Name,
 			Version:    pkg.Version,
 			Hashes:     &hashes, // pkg.Verification
 			Licenses:   &lis,
 			CPE:        cpe,
 			Components: &sub,
-		})
+		}
+		if props := dxPackageToCycloneDXProperties(pkg); len(props) > 0 {
+			component.Properties = &props
+		}
+		ret = append(ret, component)
+	}
+	return ret
+}
+
+// dxPackagesToCycloneDXDependencies records the UpStreamPackages of every package as dependsOn,
+// the packages which are not in the components are skipped.
+func dxPackagesToCycloneDXDependencies(pkgs []*Package, components []cdx.Component) []cdx.Dependency {
+	refs := make(map[string]struct{})
+	var walk func([]cdx.Component)
+	walk = func(components []cdx.Component) {
+		for _, c := range components {
+			refs[c.BOMRef] = struct{}{}
+			if c.Components != nil {
+				walk(*c.Components)
+			}
+		}
+	}
+	walk(components)
+
+	deps := make(map[string]map[string]struct{})
+	for _, pkg := range walkPackages(pkgs) {
+		ref := PackageBOMRef(pkg)
+		if _, ok := refs[ref]; !ok {
+			continue
+		}
+		if _, ok := deps[ref]; !ok {
+			deps[ref] = make(map[string]struct{})
+		}
+		for _, up := range pkg.UpStreamPackages {
+			if upRef := PackageBOMRef(up); upRef != ref {
+				if _, ok := refs[upRef]; ok {
+					deps[ref][upRef] = struct{}{}
+				}
+			}
+		}
+	}
+
+	ret := make([]cdx.Dependency, 0, len(deps))
+	for ref, dependsOn := range deps {
+		dep := cdx.Dependency{Ref: ref}
+		if len(dependsOn) > 0 {
+			list := make([]string, 0, len(dependsOn))
+			for upRef := range dependsOn {
+				list = append(list, upRef)
+			}
+			sort.Strings(list)
+			dep.Dependencies = &list
+		}
+		ret = append(ret, dep)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Ref < ret[j].Ref
+	})
+	return ret
+}
+
+// walkPackages returns pkgs and all the packages linked by them, every package is returned once.
+func walkPackages(pkgs []*Package) []*Package {
+	visited := make(map[*Package]struct{})
+	ret := make([]*Package, 0, len(pkgs))
+	var walk func(pkg *Package)
+	walk = func(pkg *Package) {
+		if _, ok := visited[pkg]; ok {
+			return
+		}
+		visited[pkg] = struct{}{}
+		ret = append(ret, pkg)
+		for _, up := range pkg.UpStreamPackages {
+			walk(up)
+		}
+		for _, down := range pkg.DownStreamPackages {
+			walk(down)
+		}
+	}
+	for _, pkg := range pkgs {
+		walk(pkg)
 	}
 	return ret
 }
 
 func CreateCycloneDXSBOMByDXPackages(pkgs []*Package) *cdx.BOM {
 	bom := cdx.NewBOM()
+	bom.SerialNumber = "urn:uuid:" + uuid.NewString()
 	f := filter.NewFilter()
 	defer f.Close()
 	ret := dxPackagesToCycloneDXComponent(f, pkgs)
 	bom.Components = &ret
+	deps := dxPackagesToCycloneDXDependencies(pkgs, ret)
+	bom.Dependencies = &deps
 	return bom
 }
 
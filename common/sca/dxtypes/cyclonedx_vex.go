@@ -0,0 +1,170 @@
+package dxtypes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+)
+
+// VEXStatement is a triage decision of a vulnerability on a component
+type VEXStatement struct {
+	// CVE-2021-44228 / GHSA-jfh8-c2jp-5v3q ...
+	VulnerabilityID string
+
+	// Package is the affected component, PackageRef is used when Package is nil,
+	// the ref can be a bom-ref in the SBOM or a BOM-Link (urn:cdx:serial/version#bom-ref)
+	Package    *Package
+	PackageRef string
+
+	State         cdx.ImpactAnalysisState
+	Justification cdx.ImpactAnalysisJustification
+	Responses     []cdx.ImpactAnalysisResponse
+	Detail        string
+}
+
+func (s *VEXStatement) ref() string {
+	if s.Package != nil {
+		return PackageBOMRef(s.Package)
+	}
+	return s.PackageRef
+}
+
+func vulnerabilitySource(id string) *cdx.Source {
+	switch {
+	case strings.HasPrefix(id, "CVE-"):
+		return &cdx.Source{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/" + id}
+	case strings.HasPrefix(id, "GHSA-"):
+		return &cdx.Source{Name: "GitHub", URL: "https://github.com/advisories/" + id}
+	}
+	return nil
+}
+
+// CreateCycloneDXVEX creates a CycloneDX VEX document of the statements, the statements of a
+// vulnerability with the same analysis are merged to one vulnerability with multiple affects.
+// When sbom has a serial number, the affects refer to the components in it by BOM-Link.
+func CreateCycloneDXVEX(sbom *cdx.BOM, statements []*VEXStatement) *cdx.BOM {
+	vex := cdx.NewBOM()
+	vex.SerialNumber = "urn:uuid:" + uuid.NewString()
+	vex.Metadata = &cdx.Metadata{Timestamp: time.Now().UTC().Format(time.RFC3339)}
+
+	type analysisKey struct {
+		id, state, justification, responses, detail string
+	}
+	var (
+		keys  []analysisKey
+		vulns = make(map[analysisKey]*cdx.Vulnerability)
+	)
+	for _, s := range statements {
+		if s == nil || s.VulnerabilityID == "" {
+			continue
+		}
+		responses := make([]string, 0, len(s.Responses))
+		for _, r := range s.Responses {
+			responses = append(responses, string(r))
+		}
+		key := analysisKey{s.VulnerabilityID, string(s.State), string(s.Justification), strings.Join(responses, ","), s.Detail}
+		vuln, ok := vulns[key]
+		if !ok {
+			vuln = &cdx.Vulnerability{
+				BOMRef: s.VulnerabilityID,
+				ID:     s.VulnerabilityID,
+				Source: vulnerabilitySource(s.VulnerabilityID),
+				Analysis: &cdx.VulnerabilityAnalysis{
+					State:         s.State,
+					Justification: s.Justification,
+					Detail:        s.Detail,
+				},
+				Affects: &[]cdx.Affects{},
+			}
+			if len(s.Responses) > 0 {
+				resp := append([]cdx.ImpactAnalysisResponse(nil), s.Responses...)
+				vuln.Analysis.Response = &resp
+			}
+			vulns[key] = vuln
+			keys = append(keys, key)
+		}
+
+		ref := s.ref()
+		if ref == "" {
+			continue
+		}
+		if sbom != nil && sbom.SerialNumber != "" && !cdx.IsBOMLink(ref) {
+			if link, err := cdx.NewBOMLink(sbom.SerialNumber, sbom.Version, cdx.Component{BOMRef: ref}); err == nil {
+				ref = link.String()
+			}
+		}
+		affects := cdx.Affects{Ref: ref}
+		if s.Package != nil && s.Package.Version != "" {
+			status := cdx.VulnerabilityStatusAffected
+			switch s.State {
+			case cdx.IASNotAffected, cdx.IASFalsePositive, cdx.IASResolved, cdx.IASResolvedWithPedigree:
+				status = cdx.VulnerabilityStatusNotAffected
+			case cdx.IASInTriage:
+				status = cdx.VulnerabilityStatusUnknown
+			}
+			affects.Range = &[]cdx.AffectedVersions{{Version: s.Package.Version, Status: status}}
+		}
+		*vuln.Affects = append(*vuln.Affects, affects)
+	}
+
+	ret := make([]cdx.Vulnerability, 0, len(keys))
+	seen := make(map[string]int)
+	for _, key := range keys {
+		vuln := vulns[key]
+		// bom-ref must be unique in the document
+		if n := seen[vuln.ID]; n > 0 {
+			vuln.BOMRef = fmt.Sprintf("%s-%d", vuln.ID, n)
+		}
+		seen[vuln.ID]++
+		sort.Slice(*vuln.Affects, func(i, j int) bool {
+			return (*vuln.Affects)[i].Ref < (*vuln.Affects)[j].Ref
+		})
+		ret = append(ret, *vuln)
+	}
+	vex.Vulnerabilities = &ret
+	return vex
+}
+
+// CycloneDXVEXToStatements reads the triage decisions from a CycloneDX VEX document (or a BOM with
+// vulnerabilities), the package of a statement is parsed from the bom-ref created by PackageBOMRef.
+func CycloneDXVEXToStatements(vex *cdx.BOM) []*VEXStatement {
+	if vex == nil || vex.Vulnerabilities == nil {
+		return nil
+	}
+	var ret []*VEXStatement
+	for _, vuln := range *vex.Vulnerabilities {
+		newStatement := func(ref string) *VEXStatement {
+			s := &VEXStatement{VulnerabilityID: vuln.ID, PackageRef: ref}
+			if vuln.Analysis != nil {
+				s.State = vuln.Analysis.State
+				s.Justification = vuln.Analysis.Justification
+				s.Detail = vuln.Analysis.Detail
+				if vuln.Analysis.Response != nil {
+					s.Responses = append(s.Responses, *vuln.Analysis.Response...)
+				}
+			}
+			if ref == "" {
+				return s
+			}
+			if link, err := cdx.ParseBOMLink(ref); err == nil && link.Reference() != "" {
+				ref = link.Reference()
+			}
+			if idx := strings.LastIndex(ref, "@"); idx > 0 {
+				s.Package = &Package{Name: ref[:idx], Version: ref[idx+1:]}
+			}
+			return s
+		}
+		if vuln.Affects == nil || len(*vuln.Affects) == 0 {
+			ret = append(ret, newStatement(""))
+			continue
+		}
+		for _, affects := range *vuln.Affects {
+			ret = append(ret, newStatement(affects.Ref))
+		}
+	}
+	return ret
+}
@@ -0,0 +1,381 @@
+package dxtypes
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var spdxLicenseExprSplit = regexp.MustCompile(`\s+(?:AND|OR|WITH|and|or|with)\s+`)
+
+// ParseSBOM reads a CycloneDX (json / xml) or SPDX (json / tag-value) SBOM to packages,
+// the dependency graph of the SBOM is restored by LinkDepend.
+func ParseSBOM(raw []byte) ([]*Package, error) {
+	raw = bytes.TrimSpace(bytes.TrimPrefix(raw, []byte("\xef\xbb\xbf")))
+	if len(raw) == 0 {
+		return nil, utils.Error("empty sbom")
+	}
+	switch raw[0] {
+	case '<':
+		bom, err := UnmarshalCycloneDXBom(raw)
+		if err != nil {
+			return nil, err
+		}
+		return CycloneDXBomToDXPackages(bom), nil
+	case '{':
+		var header struct {
+			BOMFormat   string `json:"bomFormat"`
+			SPDXVersion string `json:"spdxVersion"`
+		}
+		if err := json.Unmarshal(raw, &header); err != nil {
+			return nil, utils.Wrap(err, "invalid json sbom")
+		}
+		switch {
+		case header.BOMFormat == cdx.BOMFormat:
+			bom, err := UnmarshalCycloneDXBom(raw)
+			if err != nil {
+				return nil, err
+			}
+			return CycloneDXBomToDXPackages(bom), nil
+		case header.SPDXVersion != "":
+			doc, err := UnmarshalSPDXDocument(raw)
+			if err != nil {
+				return nil, err
+			}
+			return SPDXDocumentToDXPackages(doc), nil
+		}
+	default:
+		if bytes.Contains(raw, []byte("SPDXVersion:")) {
+			doc, err := UnmarshalSPDXDocument(raw)
+			if err != nil {
+				return nil, err
+			}
+			return SPDXDocumentToDXPackages(doc), nil
+		}
+	}
+	return nil, utils.Error("unknown sbom format, only CycloneDX and SPDX are supported")
+}
+
+// UnmarshalCycloneDXBom reads a CycloneDX BOM in json or xml format
+func UnmarshalCycloneDXBom(raw []byte) (*cdx.BOM, error) {
+	raw = bytes.TrimSpace(raw)
+	format := cdx.BOMFileFormatJSON
+	if bytes.HasPrefix(raw, []byte("<")) {
+		format = cdx.BOMFileFormatXML
+	}
+	bom := new(cdx.BOM)
+	if err := cdx.NewBOMDecoder(bytes.NewReader(raw), format).Decode(bom); err != nil {
+		return nil, utils.Wrap(err, "decode cyclonedx bom failed")
+	}
+	return bom, nil
+}
+
+func cycloneDXHashToVerification(hash cdx.Hash) string {
+	algorithm := strings.ToLower(string(hash.Algorithm))
+	if strings.HasPrefix(algorithm, "sha-") {
+		algorithm = strings.Replace(algorithm, "-", "", 1)
+	}
+	return algorithm + ":" + hash.Value
+}
+
+func cycloneDXComponentToDXPackage(c *cdx.Component) *Package {
+	pkg := &Package{
+		Name:    c.Name,
+		Version: c.Version,
+	}
+	if c.Group != "" {
+		pkg.Name = c.Group + ":" + c.Name
+	}
+	if c.Hashes != nil && len(*c.Hashes) > 0 {
+		pkg.Verification = cycloneDXHashToVerification((*c.Hashes)[0])
+	}
+	if c.Licenses != nil {
+		for _, choice := range *c.Licenses {
+			switch {
+			case choice.License != nil && choice.License.ID != "":
+				pkg.License = append(pkg.License, choice.License.ID)
+			case choice.License != nil && choice.License.Name != "":
+				pkg.License = append(pkg.License, choice.License.Name)
+			case choice.Expression != "":
+				pkg.License = append(pkg.License, splitSPDXLicenseExpression(choice.Expression)...)
+			}
+		}
+	}
+	if c.CPE != "" {
+		pkg.AmendedCPE = append(pkg.AmendedCPE, c.CPE)
+	}
+	if c.Properties != nil {
+		for _, prop := range *c.Properties {
+			switch prop.Name {
+			case sbomPropertyAnalyzer:
+				pkg.FromAnalyzer = append(pkg.FromAnalyzer, prop.Value)
+			case sbomPropertyFile:
+				pkg.FromFile = append(pkg.FromFile, prop.Value)
+			case sbomPropertyCVE:
+				pkg.AssociatedCVE = append(pkg.AssociatedCVE, prop.Value)
+			case sbomPropertyPotential:
+				pkg.Potential, _ = strconv.ParseBool(prop.Value)
+			}
+		}
+	}
+	pkg.IsVersionRange = pkg.HasVersionRange()
+	return pkg
+}
+
+// CycloneDXBomToDXPackages converts the components (include the nested components) of the BOM to packages,
+// the dependencies of the BOM are linked as UpStreamPackages.
+func CycloneDXBomToDXPackages(bom *cdx.BOM) []*Package {
+	var pkgs []*Package
+	refs := make(map[string]*Package)
+	var walk func(components []cdx.Component)
+	walk = func(components []cdx.Component) {
+		for i := range components {
+			c := &components[i]
+			pkg := cycloneDXComponentToDXPackage(c)
+			ref := c.BOMRef
+			if ref == "" {
+				ref = PackageBOMRef(pkg)
+			}
+			if _, ok := refs[ref]; !ok {
+				refs[ref] = pkg
+				pkgs = append(pkgs, pkg)
+			}
+			if c.Components != nil {
+				walk(*c.Components)
+			}
+		}
+	}
+	if bom.Components != nil {
+		walk(*bom.Components)
+	}
+
+	if bom.Dependencies != nil {
+		for _, dep := range *bom.Dependencies {
+			pkg, ok := refs[dep.Ref]
+			if !ok || dep.Dependencies == nil {
+				continue
+			}
+			for _, upRef := range *dep.Dependencies {
+				if up, ok := refs[upRef]; ok && up != pkg {
+					pkg.LinkDepend(up)
+				}
+			}
+		}
+	}
+	return pkgs
+}
+
+// UnmarshalSPDXDocument reads a SPDX document in json or tag-value format
+func UnmarshalSPDXDocument(raw []byte) (*SPDXDocument, error) {
+	raw = bytes.TrimSpace(raw)
+	if bytes.HasPrefix(raw, []byte("{")) {
+		doc := new(SPDXDocument)
+		if err := json.Unmarshal(raw, doc); err != nil {
+			return nil, utils.Wrap(err, "decode spdx json failed")
+		}
+		return doc, nil
+	}
+	return unmarshalSPDXTagValue(raw)
+}
+
+func unmarshalSPDXTagValue(raw []byte) (*SPDXDocument, error) {
+	doc := new(SPDXDocument)
+	var (
+		pkg *SPDXPackage
+		lic *SPDXExtractedLicense
+		// the SPDXID of files and snippets should not be set to the document
+		inFile bool
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tag, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(value, "<text>") {
+			// multi-line text ends with </text>
+			text := strings.TrimPrefix(value, "<text>")
+			for !strings.Contains(text, "</text>") && scanner.Scan() {
+				text += "\n" + scanner.Text()
+			}
+			value, _, _ = strings.Cut(text, "</text>")
+		}
+
+		switch tag {
+		case "SPDXVersion":
+			doc.SPDXVersion = value
+		case "DataLicense":
+			doc.DataLicense = value
+		case "DocumentName":
+			doc.Name = value
+		case "DocumentNamespace":
+			doc.DocumentNamespace = value
+		case "Creator":
+			doc.CreationInfo.Creators = append(doc.CreationInfo.Creators, value)
+		case "Created":
+			doc.CreationInfo.Created = value
+		case "SPDXID":
+			if pkg != nil {
+				pkg.SPDXID = value
+			} else if !inFile {
+				doc.SPDXID = value
+			}
+		case "PackageName":
+			pkg, inFile = &SPDXPackage{Name: value}, false
+			doc.Packages = append(doc.Packages, pkg)
+		case "FileName", "SnippetSPDXID":
+			// files and snippets are not packages
+			pkg, inFile = nil, true
+		case "Relationship":
+			fields := strings.Fields(value)
+			if len(fields) >= 3 {
+				doc.Relationships = append(doc.Relationships, &SPDXRelationship{
+					SPDXElementID:      fields[0],
+					RelationshipType:   fields[1],
+					RelatedSPDXElement: fields[2],
+				})
+			}
+		case "LicenseID":
+			lic = &SPDXExtractedLicense{LicenseID: value}
+			doc.HasExtractedLicensingInfos = append(doc.HasExtractedLicensingInfos, lic)
+		case "ExtractedText":
+			if lic != nil {
+				lic.ExtractedText = value
+			}
+		case "LicenseName":
+			if lic != nil {
+				lic.Name = value
+			}
+		}
+
+		if pkg == nil {
+			continue
+		}
+		switch tag {
+		case "PackageVersion":
+			pkg.VersionInfo = value
+		case "PackageDownloadLocation":
+			pkg.DownloadLocation = value
+		case "FilesAnalyzed":
+			pkg.FilesAnalyzed, _ = strconv.ParseBool(value)
+		case "PackageChecksum":
+			algorithm, checksum, ok := strings.Cut(value, ":")
+			if ok {
+				pkg.Checksums = append(pkg.Checksums, SPDXChecksum{
+					Algorithm:     strings.TrimSpace(algorithm),
+					ChecksumValue: strings.TrimSpace(checksum),
+				})
+			}
+		case "PackageLicenseConcluded":
+			pkg.LicenseConcluded = value
+		case "PackageLicenseDeclared":
+			pkg.LicenseDeclared = value
+		case "PackageCopyrightText":
+			pkg.CopyrightText = value
+		case "PackageSourceInfo":
+			pkg.SourceInfo = value
+		case "ExternalRef":
+			fields := strings.Fields(value)
+			if len(fields) >= 3 {
+				pkg.ExternalRefs = append(pkg.ExternalRefs, SPDXExternalRef{
+					ReferenceCategory: fields[0],
+					ReferenceType:     fields[1],
+					ReferenceLocator:  fields[2],
+				})
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, utils.Wrap(err, "read spdx tag-value failed")
+	}
+	if doc.SPDXVersion == "" {
+		return nil, utils.Error("invalid spdx tag-value document: SPDXVersion not found")
+	}
+	return doc, nil
+}
+
+func splitSPDXLicenseExpression(expr string) []string {
+	var ret []string
+	for _, lic := range spdxLicenseExprSplit.Split(expr, -1) {
+		lic = strings.Trim(lic, "() ")
+		if lic == "" || lic == SPDXNoAssertion || lic == SPDXNone {
+			continue
+		}
+		ret = append(ret, lic)
+	}
+	return ret
+}
+
+// SPDXDocumentToDXPackages converts the packages of the document to packages, DEPENDS_ON and
+// DEPENDENCY_OF relationships are linked as UpStreamPackages.
+func SPDXDocumentToDXPackages(doc *SPDXDocument) []*Package {
+	extracted := make(map[string]string)
+	for _, lic := range doc.HasExtractedLicensingInfos {
+		name := lic.Name
+		if name == "" || name == SPDXNoAssertion {
+			name = lic.ExtractedText
+		}
+		extracted[lic.LicenseID] = name
+	}
+
+	pkgs := make([]*Package, 0, len(doc.Packages))
+	ids := make(map[string]*Package)
+	for _, spdxPkg := range doc.Packages {
+		pkg := &Package{
+			Name:    spdxPkg.Name,
+			Version: spdxPkg.VersionInfo,
+		}
+		expr := spdxPkg.LicenseDeclared
+		if expr == "" || expr == SPDXNoAssertion || expr == SPDXNone {
+			expr = spdxPkg.LicenseConcluded
+		}
+		for _, lic := range splitSPDXLicenseExpression(expr) {
+			if name, ok := extracted[lic]; ok {
+				lic = name
+			}
+			pkg.License = append(pkg.License, lic)
+		}
+		if len(spdxPkg.Checksums) > 0 {
+			checksum := spdxPkg.Checksums[0]
+			pkg.Verification = strings.ToLower(checksum.Algorithm) + ":" + checksum.ChecksumValue
+		}
+		for _, ref := range spdxPkg.ExternalRefs {
+			switch ref.ReferenceType {
+			case SPDXRefTypeCPE23, SPDXRefTypeCPE22:
+				pkg.AmendedCPE = append(pkg.AmendedCPE, ref.ReferenceLocator)
+			}
+		}
+		pkg.IsVersionRange = pkg.HasVersionRange()
+		if _, ok := ids[spdxPkg.SPDXID]; !ok {
+			ids[spdxPkg.SPDXID] = pkg
+			pkgs = append(pkgs, pkg)
+		}
+	}
+
+	for _, rel := range doc.Relationships {
+		element, ok1 := ids[rel.SPDXElementID]
+		related, ok2 := ids[rel.RelatedSPDXElement]
+		if !ok1 || !ok2 || element == related {
+			continue
+		}
+		switch rel.RelationshipType {
+		case SPDXRelDependsOn:
+			element.LinkDepend(related)
+		case SPDXRelDependencyOf:
+			related.LinkDepend(element)
+		}
+	}
+	return pkgs
+}
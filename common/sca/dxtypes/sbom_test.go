@@ -0,0 +1,163 @@
+package dxtypes
+
+import (
+	_ "embed"
+	"strings"
+	"testing"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed testdata/supplier.spdx
+var supplierSPDX []byte
+
+//go:embed testdata/supplier.cdx.xml
+var supplierCycloneDX []byte
+
+func createSBOMTestPackages() []*Package {
+	app := &Package{Name: "yak-app", Version: "1.0.0", License: []string{"MIT"}, FromAnalyzer: []string{"node-npm"}, FromFile: []string{"package-lock.json"}}
+	express := &Package{Name: "express", Version: "4.17.1", License: []string{"MIT"}, Verification: "sha1:0a5e17b2b9ab63b93b7b0d0c4e88fd1b1e9fbcab", FromAnalyzer: []string{"node-npm"}}
+	qs := &Package{Name: "qs", Version: "6.7.0", License: []string{"BSD 3-Clause License"}, AmendedCPE: []string{"cpe:2.3:a:qs_project:qs:6.7.0:*:*:*:*:node.js:*:*"}, AssociatedCVE: []string{"CVE-2022-24999"}}
+	app.LinkDepend(express)
+	express.LinkDepend(qs)
+	return []*Package{app, express, qs}
+}
+
+func sbomPackageMap(pkgs []*Package) map[string]*Package {
+	ret := make(map[string]*Package)
+	for _, pkg := range pkgs {
+		ret[pkg.Name] = pkg
+	}
+	return ret
+}
+
+func upstreamNames(p *Package) []string {
+	var names []string
+	for _, up := range p.UpStreamPackages {
+		names = append(names, up.Name)
+	}
+	return names
+}
+
+func TestCycloneDXRoundTrip(t *testing.T) {
+	bom := CreateCycloneDXSBOMByDXPackages(createSBOMTestPackages())
+	raw, err := MarshalCycloneDXBomToJSON(bom)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"dependsOn"`)
+
+	pkgs, err := ParseSBOM(raw)
+	require.NoError(t, err)
+	m := sbomPackageMap(pkgs)
+	require.Len(t, m, 3)
+
+	require.Equal(t, "1.0.0", m["yak-app"].Version)
+	require.Equal(t, []string{"node-npm"}, m["yak-app"].FromAnalyzer)
+	require.Equal(t, []string{"package-lock.json"}, m["yak-app"].FromFile)
+	require.Equal(t, "sha1:0a5e17b2b9ab63b93b7b0d0c4e88fd1b1e9fbcab", m["express"].Verification)
+	require.Equal(t, []string{"CVE-2022-24999"}, m["qs"].AssociatedCVE)
+	require.Equal(t, []string{"BSD 3-Clause License"}, m["qs"].License)
+
+	require.Equal(t, []string{"express"}, upstreamNames(m["yak-app"]))
+	require.Equal(t, []string{"qs"}, upstreamNames(m["express"]))
+	require.Empty(t, m["qs"].UpStreamPackages)
+	require.Len(t, m["qs"].DownStreamPackages, 1)
+}
+
+func TestSPDXRoundTrip(t *testing.T) {
+	doc := CreateSPDXSBOMByDXPackages("yak-app", createSBOMTestPackages())
+	require.Len(t, doc.HasExtractedLicensingInfos, 1)
+
+	check := func(t *testing.T, raw []byte) {
+		pkgs, err := ParseSBOM(raw)
+		require.NoError(t, err)
+		m := sbomPackageMap(pkgs)
+		require.Len(t, m, 3)
+		require.Equal(t, "4.17.1", m["express"].Version)
+		require.Equal(t, "sha1:0a5e17b2b9ab63b93b7b0d0c4e88fd1b1e9fbcab", m["express"].Verification)
+		require.Equal(t, []string{"BSD 3-Clause License"}, m["qs"].License)
+		require.Equal(t, []string{"cpe:2.3:a:qs_project:qs:6.7.0:*:*:*:*:node.js:*:*"}, m["qs"].AmendedCPE)
+		require.Equal(t, []string{"express"}, upstreamNames(m["yak-app"]))
+		require.Equal(t, []string{"qs"}, upstreamNames(m["express"]))
+	}
+
+	t.Run("json", func(t *testing.T) {
+		raw, err := MarshalSPDXDocumentToJSON(doc)
+		require.NoError(t, err)
+		require.Contains(t, string(raw), `"spdxVersion": "SPDX-2.3"`)
+		check(t, raw)
+	})
+	t.Run("tag-value", func(t *testing.T) {
+		raw, err := MarshalSPDXDocumentToTagValue(doc)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(raw), "SPDXVersion: SPDX-2.3\n"))
+		require.Contains(t, string(raw), "Relationship: SPDXRef-DOCUMENT DESCRIBES "+PackageSPDXID(sbomPackageMap(createSBOMTestPackages())["yak-app"]))
+		check(t, raw)
+	})
+}
+
+func TestParseSBOM_SupplierSPDX(t *testing.T) {
+	pkgs, err := ParseSBOM(supplierSPDX)
+	require.NoError(t, err)
+	m := sbomPackageMap(pkgs)
+	require.Len(t, m, 3)
+
+	app := m["supplier-app"]
+	require.Equal(t, []string{"MIT", "Apache-2.0"}, app.License)
+	require.Equal(t, []string{"org.apache.logging.log4j:log4j-core"}, upstreamNames(app))
+
+	log4j := m["org.apache.logging.log4j:log4j-core"]
+	require.Equal(t, "2.14.1", log4j.Version)
+	require.Equal(t, "sha1:9141212b8507ab50a45525b545b39d224614528b", log4j.Verification)
+	require.Equal(t, []string{"Custom Apache License"}, log4j.License)
+	require.Equal(t, []string{"cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"}, log4j.AmendedCPE)
+	require.Equal(t, []string{"org.apache.logging.log4j:log4j-api"}, upstreamNames(log4j))
+}
+
+func TestParseSBOM_SupplierCycloneDXXML(t *testing.T) {
+	pkgs, err := ParseSBOM(supplierCycloneDX)
+	require.NoError(t, err)
+	m := sbomPackageMap(pkgs)
+	require.Len(t, m, 3)
+
+	lodash := m["lodash"]
+	require.Equal(t, "sha256:6f8b2e4c2a51ac1cbbdb3ba6b0b7b2cf0b3a6f7a9e3d0e6d5d1d4d2b4e8c9f01", lodash.Verification)
+	require.Equal(t, []string{"MIT", "CC0-1.0"}, lodash.License)
+	require.Contains(t, m, "com.fasterxml.jackson.core:jackson-databind")
+	require.ElementsMatch(t, []string{"lodash", "com.fasterxml.jackson.core:jackson-databind"}, upstreamNames(m["supplier-web"]))
+}
+
+func TestCycloneDXVEX(t *testing.T) {
+	pkgs := createSBOMTestPackages()
+	qs, express := pkgs[2], pkgs[1]
+	sbom := CreateCycloneDXSBOMByDXPackages(pkgs)
+
+	vex := CreateCycloneDXVEX(sbom, []*VEXStatement{
+		{VulnerabilityID: "CVE-2022-24999", Package: qs, State: cdx.IASNotAffected, Justification: cdx.IAJCodeNotReachable, Detail: "qs.parse is not called with user input"},
+		{VulnerabilityID: "CVE-2024-29041", Package: express, State: cdx.IASExploitable, Responses: []cdx.ImpactAnalysisResponse{cdx.IARUpdate}},
+		{VulnerabilityID: "CVE-2024-29041", Package: qs, State: cdx.IASInTriage},
+	})
+	raw, err := MarshalCycloneDXBomToJSON(vex)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"state":"not_affected"`)
+
+	parsed, err := UnmarshalCycloneDXBom(raw)
+	require.NoError(t, err)
+	require.Len(t, *parsed.Vulnerabilities, 3)
+	for _, vuln := range *parsed.Vulnerabilities {
+		for _, affects := range *vuln.Affects {
+			require.True(t, cdx.IsBOMLink(affects.Ref), affects.Ref)
+		}
+	}
+
+	statements := CycloneDXVEXToStatements(parsed)
+	require.Len(t, statements, 3)
+	require.Equal(t, "CVE-2022-24999", statements[0].VulnerabilityID)
+	require.Equal(t, cdx.IASNotAffected, statements[0].State)
+	require.Equal(t, cdx.IAJCodeNotReachable, statements[0].Justification)
+	require.Equal(t, "qs", statements[0].Package.Name)
+	require.Equal(t, "6.7.0", statements[0].Package.Version)
+	require.Equal(t, []cdx.ImpactAnalysisResponse{cdx.IARUpdate}, statements[1].Responses)
+	require.Equal(t, "express", statements[1].Package.Name)
+	require.Equal(t, cdx.IASInTriage, statements[2].State)
+}
@@ -0,0 +1,351 @@
+package dxtypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yaklang/yaklang/common/filter"
+	licenses "github.com/yaklang/yaklang/common/sca/license"
+)
+
+const (
+	SPDXVersion           = "SPDX-2.3"
+	SPDXDataLicense       = "CC0-1.0"
+	SPDXDocumentID        = "SPDXRef-DOCUMENT"
+	SPDXNoAssertion       = "NOASSERTION"
+	SPDXNone              = "NONE"
+	SPDXRelDescribes      = "DESCRIBES"
+	SPDXRelDependsOn      = "DEPENDS_ON"
+	SPDXRelDependencyOf   = "DEPENDENCY_OF"
+	SPDXRefCategorySecure = "SECURITY"
+	SPDXRefTypeCPE23      = "cpe23Type"
+	SPDXRefTypeCPE22      = "cpe22Type"
+
+	spdxDocumentNamespacePrefix = "https://yaklang.io/spdxdocs/"
+	spdxCreator                 = "Tool: yaklang-sca"
+)
+
+var (
+	spdxIDInvalidChar   = regexp.MustCompile(`[^a-zA-Z0-9.\-]+`)
+	spdxLicenseIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9.\-]+\+?$`)
+)
+
+// SPDXDocument is a SPDX 2.3 document, it can be marshaled to the json and tag-value formats.
+type SPDXDocument struct {
+	SPDXVersion                string                  `json:"spdxVersion"`
+	DataLicense                string                  `json:"dataLicense"`
+	SPDXID                     string                  `json:"SPDXID"`
+	Name                       string                  `json:"name"`
+	DocumentNamespace          string                  `json:"documentNamespace"`
+	CreationInfo               SPDXCreationInfo        `json:"creationInfo"`
+	Packages                   []*SPDXPackage          `json:"packages,omitempty"`
+	Relationships              []*SPDXRelationship     `json:"relationships,omitempty"`
+	HasExtractedLicensingInfos []*SPDXExtractedLicense `json:"hasExtractedLicensingInfos,omitempty"`
+}
+
+type SPDXCreationInfo struct {
+	Creators []string `json:"creators"`
+	Created  string   `json:"created"`
+}
+
+type SPDXPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	Checksums        []SPDXChecksum    `json:"checksums,omitempty"`
+	LicenseConcluded string            `json:"licenseConcluded,omitempty"`
+	LicenseDeclared  string            `json:"licenseDeclared,omitempty"`
+	CopyrightText    string            `json:"copyrightText,omitempty"`
+	SourceInfo       string            `json:"sourceInfo,omitempty"`
+	ExternalRefs     []SPDXExternalRef `json:"externalRefs,omitempty"`
+}
+
+type SPDXChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type SPDXExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type SPDXRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+type SPDXExtractedLicense struct {
+	LicenseID     string `json:"licenseId"`
+	ExtractedText string `json:"extractedText"`
+	Name          string `json:"name,omitempty"`
+}
+
+func normalSPDXChecksumAlgorithm(i string) (string, bool) {
+	switch strings.ToLower(i) {
+	case "md5":
+		return "MD5", true
+	case "sha1", "sha-1":
+		return "SHA1", true
+	case "sha224", "sha-224":
+		return "SHA224", true
+	case "sha256", "sha-256":
+		return "SHA256", true
+	case "sha384", "sha-384":
+		return "SHA384", true
+	case "sha512", "sha-512":
+		return "SHA512", true
+	case "sha3-256", "sha3_256":
+		return "SHA3-256", true
+	case "sha3-384", "sha3_384":
+		return "SHA3-384", true
+	case "sha3-512", "sha3_512":
+		return "SHA3-512", true
+	case "blake2b-256", "blake2b_256":
+		return "BLAKE2b-256", true
+	case "blake2b-384", "blake2b_384":
+		return "BLAKE2b-384", true
+	case "blake2b-512", "blake2b_512":
+		return "BLAKE2b-512", true
+	case "blake3":
+		return "BLAKE3", true
+	}
+	return "", false
+}
+
+// PackageSPDXID is the SPDXID of the package in the SPDX document
+func PackageSPDXID(pkg *Package) string {
+	name := strings.Trim(spdxIDInvalidChar.ReplaceAllString(pkg.Name, "-"), "-")
+	return fmt.Sprintf("SPDXRef-Package-%s-%s", name, pkg.Identifier()[:8])
+}
+
+type spdxLicenseBuilder struct {
+	extracted map[string]*SPDXExtractedLicense
+}
+
+// expression joins the licenses of a package to a SPDX license expression, the licenses which are not
+// SPDX license identifiers are recorded as LicenseRef
+func (b *spdxLicenseBuilder) expression(lics []string) string {
+	var ids []string
+	for _, lic := range lics {
+		lic = strings.TrimSpace(lic)
+		if lic == "" {
+			continue
+		}
+		lic = licenses.Normalize(lic)
+		if spdxLicenseIDRegexp.MatchString(lic) {
+			ids = append(ids, lic)
+			continue
+		}
+		id := "LicenseRef-" + strings.Trim(spdxIDInvalidChar.ReplaceAllString(lic, "-"), "-")
+		if _, ok := b.extracted[id]; !ok {
+			b.extracted[id] = &SPDXExtractedLicense{LicenseID: id, ExtractedText: lic, Name: lic}
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return SPDXNoAssertion
+	}
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return "(" + strings.Join(ids, " AND ") + ")"
+}
+
+func (b *spdxLicenseBuilder) extractedLicenses() []*SPDXExtractedLicense {
+	ret := make([]*SPDXExtractedLicense, 0, len(b.extracted))
+	for _, lic := range b.extracted {
+		ret = append(ret, lic)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].LicenseID < ret[j].LicenseID
+	})
+	return ret
+}
+
+func dxPackageToSPDXPackage(pkg *Package, lb *spdxLicenseBuilder) *SPDXPackage {
+	spdxPkg := &SPDXPackage{
+		Name:             pkg.Name,
+		SPDXID:           PackageSPDXID(pkg),
+		VersionInfo:      pkg.Version,
+		DownloadLocation: SPDXNoAssertion,
+		LicenseConcluded: SPDXNoAssertion,
+		LicenseDeclared:  lb.expression(pkg.License),
+		CopyrightText:    SPDXNoAssertion,
+	}
+	if pkg.Verification != "" {
+		schema, code, _ := strings.Cut(pkg.Verification, ":")
+		if algorithm, ok := normalSPDXChecksumAlgorithm(schema); ok {
+			spdxPkg.Checksums = []SPDXChecksum{{Algorithm: algorithm, ChecksumValue: code}}
+		}
+	}
+	for _, cpe := range pkg.AmendedCPE {
+		refType := SPDXRefTypeCPE23
+		if strings.HasPrefix(cpe, "cpe:/") {
+			refType = SPDXRefTypeCPE22
+		}
+		spdxPkg.ExternalRefs = append(spdxPkg.ExternalRefs, SPDXExternalRef{
+			ReferenceCategory: SPDXRefCategorySecure,
+			ReferenceType:     refType,
+			ReferenceLocator:  cpe,
+		})
+	}
+	if len(pkg.FromFile) > 0 {
+		spdxPkg.SourceInfo = "acquired package info from: " + strings.Join(pkg.FromFile, ", ")
+	}
+	return spdxPkg
+}
+
+// CreateSPDXSBOMByDXPackages creates a SPDX 2.3 document of the packages, the UpStreamPackages are recorded
+// as DEPENDS_ON relationships and the document DESCRIBES the packages which no package depends on.
+func CreateSPDXSBOMByDXPackages(name string, pkgs []*Package) *SPDXDocument {
+	if name == "" {
+		name = "yaklang-sca"
+	}
+	doc := &SPDXDocument{
+		SPDXVersion:       SPDXVersion,
+		DataLicense:       SPDXDataLicense,
+		SPDXID:            SPDXDocumentID,
+		Name:              name,
+		DocumentNamespace: spdxDocumentNamespacePrefix + spdxIDInvalidChar.ReplaceAllString(name, "-") + "-" + uuid.NewString(),
+		CreationInfo: SPDXCreationInfo{
+			Creators: []string{spdxCreator},
+			Created:  time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+
+	f := filter.NewFilter()
+	defer f.Close()
+	lb := &spdxLicenseBuilder{extracted: make(map[string]*SPDXExtractedLicense)}
+	all := walkPackages(pkgs)
+	ids := make(map[string]struct{})
+	for _, pkg := range all {
+		id := PackageSPDXID(pkg)
+		if f.Exist(id) {
+			continue
+		}
+		f.Insert(id)
+		ids[id] = struct{}{}
+		doc.Packages = append(doc.Packages, dxPackageToSPDXPackage(pkg, lb))
+	}
+	doc.HasExtractedLicensingInfos = lb.extractedLicenses()
+
+	relFilter := filter.NewFilter()
+	defer relFilter.Close()
+	var describes, depends []*SPDXRelationship
+	for _, pkg := range all {
+		id := PackageSPDXID(pkg)
+		isRoot := true
+		for _, down := range pkg.DownStreamPackages {
+			if _, ok := ids[PackageSPDXID(down)]; ok && PackageSPDXID(down) != id {
+				isRoot = false
+				break
+			}
+		}
+		if isRoot && !relFilter.Exist(SPDXDocumentID+id) {
+			relFilter.Insert(SPDXDocumentID + id)
+			describes = append(describes, &SPDXRelationship{
+				SPDXElementID:      SPDXDocumentID,
+				RelationshipType:   SPDXRelDescribes,
+				RelatedSPDXElement: id,
+			})
+		}
+		for _, up := range pkg.UpStreamPackages {
+			upID := PackageSPDXID(up)
+			if upID == id || relFilter.Exist(id+upID) {
+				continue
+			}
+			relFilter.Insert(id + upID)
+			depends = append(depends, &SPDXRelationship{
+				SPDXElementID:      id,
+				RelationshipType:   SPDXRelDependsOn,
+				RelatedSPDXElement: upID,
+			})
+		}
+	}
+	sortRel := func(rels []*SPDXRelationship) {
+		sort.Slice(rels, func(i, j int) bool {
+			if rels[i].SPDXElementID != rels[j].SPDXElementID {
+				return rels[i].SPDXElementID < rels[j].SPDXElementID
+			}
+			return rels[i].RelatedSPDXElement < rels[j].RelatedSPDXElement
+		})
+	}
+	sortRel(describes)
+	sortRel(depends)
+	doc.Relationships = append(describes, depends...)
+	return doc
+}
+
+func MarshalSPDXDocumentToJSON(doc *SPDXDocument) ([]byte, error) {
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func MarshalSPDXDocumentToTagValue(doc *SPDXDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	writeTag := func(tag, value string) {
+		if value == "" {
+			return
+		}
+		if strings.Contains(value, "\n") {
+			value = "<text>" + value + "</text>"
+		}
+		buf.WriteString(tag + ": " + value + "\n")
+	}
+
+	writeTag("SPDXVersion", doc.SPDXVersion)
+	writeTag("DataLicense", doc.DataLicense)
+	writeTag("SPDXID", doc.SPDXID)
+	writeTag("DocumentName", doc.Name)
+	writeTag("DocumentNamespace", doc.DocumentNamespace)
+	for _, creator := range doc.CreationInfo.Creators {
+		writeTag("Creator", creator)
+	}
+	writeTag("Created", doc.CreationInfo.Created)
+
+	for _, pkg := range doc.Packages {
+		buf.WriteString("\n##### Package: " + pkg.Name + "\n\n")
+		writeTag("PackageName", pkg.Name)
+		writeTag("SPDXID", pkg.SPDXID)
+		writeTag("PackageVersion", pkg.VersionInfo)
+		writeTag("PackageDownloadLocation", pkg.DownloadLocation)
+		writeTag("FilesAnalyzed", fmt.Sprint(pkg.FilesAnalyzed))
+		for _, checksum := range pkg.Checksums {
+			writeTag("PackageChecksum", checksum.Algorithm+": "+checksum.ChecksumValue)
+		}
+		writeTag("PackageSourceInfo", pkg.SourceInfo)
+		writeTag("PackageLicenseConcluded", pkg.LicenseConcluded)
+		writeTag("PackageLicenseDeclared", pkg.LicenseDeclared)
+		writeTag("PackageCopyrightText", pkg.CopyrightText)
+		for _, ref := range pkg.ExternalRefs {
+			writeTag("ExternalRef", strings.Join([]string{ref.ReferenceCategory, ref.ReferenceType, ref.ReferenceLocator}, " "))
+		}
+	}
+
+	if len(doc.Relationships) > 0 {
+		buf.WriteString("\n##### Relationships\n\n")
+	}
+	for _, rel := range doc.Relationships {
+		writeTag("Relationship", strings.Join([]string{rel.SPDXElementID, rel.RelationshipType, rel.RelatedSPDXElement}, " "))
+	}
+
+	if len(doc.HasExtractedLicensingInfos) > 0 {
+		buf.WriteString("\n##### Other Licenses\n\n")
+	}
+	for _, lic := range doc.HasExtractedLicensingInfos {
+		writeTag("LicenseID", lic.LicenseID)
+		buf.WriteString("ExtractedText: <text>" + lic.ExtractedText + "</text>\n")
+		writeTag("LicenseName", lic.Name)
+	}
+	return buf.Bytes(), nil
+}
@@ -10,6 +10,7 @@ var Exports = map[string]interface{}{
 	"ScanLocalFilesystem":      ScanLocalFilesystem,
 	"ScanFilesystem":           ScanFilesystem,
 	"NewAnalyzerResult":        analyzer.NewAnalyzerResult,
+	"MatchVulnerabilities":     MatchVulnerabilities,
 
 	// options
 	"endpoint":       _withEndPoint,
@@ -18,6 +19,12 @@ var Exports = map[string]interface{}{
 	"analyzers":      _withAnalayzers,
 	"customAnalyzer": _withCustomAnalyzer,
 
+	// vulnerability matching options
+	"cveDatabase": WithVulnMatchCVEDatabase,
+	"saveRisk":    WithVulnMatchSaveRisk,
+	"runtimeID":   WithVulnMatchRuntimeID,
+	"context":     WithVulnMatchContext,
+
 	// use prefix + type name as key
 	// e.g. "ANALYZER_TYPE_DPKG"
 	// keep friendly for completion
@@ -45,4 +52,11 @@ var Exports = map[string]interface{}{
 	"ANALYZER_TYPE_GO_MOD":           analyzer.TypGoMod,
 	"ANALYZER_TYPE_GO_BINARY":        analyzer.TypGoBinary,
 	"ANALYZER_TYPE_CLANG_CONAN":      analyzer.TypClangConan,
+	"ANALYZER_TYPE_DOTNET_NUGET":     analyzer.TypDotNetNuget,
+	"ANALYZER_TYPE_DOTNET_DEPS":      analyzer.TypDotNetDeps,
+	"ANALYZER_TYPE_SWIFT_COCOAPODS":  analyzer.TypSwiftCocoaPods,
+	"ANALYZER_TYPE_SWIFT_PM":         analyzer.TypSwiftPM,
+	"ANALYZER_TYPE_DART_PUB":         analyzer.TypDartPub,
+	"ANALYZER_TYPE_ELIXIR_HEX":       analyzer.TypElixirHex,
+	"ANALYZER_TYPE_JAVA_SHADED":      analyzer.TypJavaShaded,
 }
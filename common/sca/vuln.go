@@ -0,0 +1,147 @@
+package sca
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+	"github.com/yaklang/yaklang/common/sca/vulnmatch"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+type VulnMatchConfig struct {
+	ctx       context.Context
+	db        *gorm.DB
+	dbPath    string
+	saveRisk  bool
+	runtimeID string
+}
+
+type VulnMatchOption func(*VulnMatchConfig)
+
+func WithVulnMatchContext(ctx context.Context) VulnMatchOption {
+	return func(c *VulnMatchConfig) {
+		c.ctx = ctx
+	}
+}
+
+// WithVulnMatchCVEDatabase uses the cve database file instead of the default one,
+// the file is opened when matching starts and closed when matching finishes
+func WithVulnMatchCVEDatabase(path string) VulnMatchOption {
+	return func(c *VulnMatchConfig) {
+		c.dbPath = path
+	}
+}
+
+func WithVulnMatchDatabase(db *gorm.DB) VulnMatchOption {
+	return func(c *VulnMatchConfig) {
+		c.db = db
+	}
+}
+
+func WithVulnMatchSaveRisk(b bool) VulnMatchOption {
+	return func(c *VulnMatchConfig) {
+		c.saveRisk = b
+	}
+}
+
+func WithVulnMatchRuntimeID(id string) VulnMatchOption {
+	return func(c *VulnMatchConfig) {
+		c.runtimeID = id
+	}
+}
+
+// MatchVulnerabilities correlates the packages with the local CVE database offline,
+// every vulnerable package and CVE pair becomes a risk, the risks are saved when saveRisk is set
+func MatchVulnerabilities(pkgs []*dxtypes.Package, opts ...VulnMatchOption) ([]*schema.Risk, error) {
+	config := &VulnMatchConfig{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.dbPath != "" {
+		db, err := gorm.Open(consts.SQLite, config.dbPath)
+		if err != nil {
+			return nil, utils.Errorf("open cve database %v failed: %v", config.dbPath, err)
+		}
+		defer db.Close()
+		config.db = db
+	}
+	if config.db == nil {
+		config.db = consts.GetGormCVEDatabase()
+	}
+	if config.db == nil {
+		return nil, utils.Error("cve database is not available, please update the cve database first")
+	}
+
+	matches, err := vulnmatch.NewMatcher(config.ctx, config.db).MatchPackages(pkgs)
+	risks := make([]*schema.Risk, 0, len(matches))
+	for _, m := range matches {
+		r := matchToRisk(m, config)
+		if config.saveRisk {
+			if err := yakit.SaveRisk(r); err != nil {
+				log.Warnf("save risk %v failed: %v", r.Title, err)
+			}
+		}
+		risks = append(risks, r)
+	}
+	return risks, err
+}
+
+func matchToRisk(m *vulnmatch.Match, config *VulnMatchConfig) *schema.Risk {
+	pkg, cve := m.Package, m.CVE
+	pkgName := fmt.Sprintf("%s@%s", pkg.Name, pkg.Version)
+
+	description := cve.DescriptionMainZh
+	if description == "" {
+		description = cve.DescriptionMain
+	}
+	solution := cve.Solution
+	if fixed := m.FixedVersion(); fixed != "" {
+		solution = fmt.Sprintf("升级 %s 到 %s 及以上版本", pkg.Name, fixed)
+		if cve.Solution != "" {
+			solution += "\n" + cve.Solution
+		}
+	}
+	titleVerbose := fmt.Sprintf("%s: %s 存在漏洞", cve.CVE, pkgName)
+	if cve.TitleZh != "" {
+		titleVerbose = fmt.Sprintf("%s: %s (%s)", cve.CVE, cve.TitleZh, pkgName)
+	}
+
+	details := map[string]any{
+		"package":        pkg.Name,
+		"version":        pkg.Version,
+		"ecosystem":      string(m.Ecosystem),
+		"from_file":      strings.Join(pkg.FromFile, ","),
+		"from_analyzer":  strings.Join(pkg.FromAnalyzer, ","),
+		"affected_range": m.AffectedRange(),
+		"fixed_version":  m.FixedVersion(),
+		"cpe":            m.CPE,
+		"cvss_version":   cve.CVSSVersion,
+		"cvss_vector":    cve.CVSSVectorString,
+		"cvss_score":     cve.BaseCVSSv2Score,
+	}
+
+	opts := []yakit.RiskParamsOpt{
+		yakit.WithRiskParam_Title(fmt.Sprintf("%s: %s", cve.CVE, pkgName)),
+		yakit.WithRiskParam_TitleVerbose(titleVerbose),
+		yakit.WithRiskParam_Description(description),
+		yakit.WithRiskParam_Solution(solution),
+		yakit.WithRiskParam_RiskType("sca"),
+		yakit.WithRiskParam_Severity(cve.Severity),
+		yakit.WithRiskParam_CVE(cve.CVE),
+		yakit.WithRiskParam_Details(details),
+		yakit.WithRiskParam_Tags(strings.Join([]string{"sca", string(m.Ecosystem)}, "|")),
+		yakit.WithRiskParam_Potential(pkg.Potential),
+	}
+	if config.runtimeID != "" {
+		opts = append(opts, yakit.WithRiskParam_RuntimeId(config.runtimeID))
+	}
+	// the target of sca risk is not a network address, avoid resolving it
+	return yakit.CreateRisk("", opts...)
+}
@@ -0,0 +1,26 @@
+package sca
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+func TestMatchVulnerabilities_CVEDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cve.db")
+	db, err := consts.CreateCVEDatabase(path)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	pkgs := []*dxtypes.Package{{Name: "lodash", Version: "4.17.20"}}
+	risks, err := MatchVulnerabilities(pkgs, WithVulnMatchCVEDatabase(path))
+	require.NoError(t, err)
+	require.Empty(t, risks)
+
+	// the open error is returned instead of falling back to the default database
+	_, err = MatchVulnerabilities(pkgs, WithVulnMatchCVEDatabase(filepath.Join(t.TempDir(), "not-exist", "cve.db")))
+	require.Error(t, err)
+}
@@ -0,0 +1,132 @@
+package vulnmatch
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Ecosystem decides how the versions of a package are ordered
+type Ecosystem string
+
+const (
+	EcosystemGeneric   Ecosystem = "generic"
+	EcosystemNpm       Ecosystem = "npm"
+	EcosystemPyPI      Ecosystem = "pypi"
+	EcosystemMaven     Ecosystem = "maven"
+	EcosystemGo        Ecosystem = "go"
+	EcosystemCargo     Ecosystem = "cargo"
+	EcosystemRubyGems  Ecosystem = "rubygems"
+	EcosystemComposer  Ecosystem = "composer"
+	EcosystemConan     Ecosystem = "conan"
+	EcosystemNuGet     Ecosystem = "nuget"
+	EcosystemCocoaPods Ecosystem = "cocoapods"
+	EcosystemSwift     Ecosystem = "swift"
+	EcosystemPub       Ecosystem = "pub"
+	EcosystemHex       Ecosystem = "hex"
+	EcosystemDebian    Ecosystem = "debian"
+	EcosystemRPM       Ecosystem = "rpm"
+	EcosystemAlpine    Ecosystem = "alpine"
+)
+
+// analyzer type (see sca/analyzer TypAnalyzer) -> ecosystem
+var analyzerEcosystems = map[string]Ecosystem{
+	"dpkg-pkg":              EcosystemDebian,
+	"rpm-pkg":               EcosystemRPM,
+	"apk-pkg":               EcosystemAlpine,
+	"npm-lang":              EcosystemNpm,
+	"npmp-lang":             EcosystemNpm,
+	"yarm-lang":             EcosystemNpm,
+	"python-packaging-lang": EcosystemPyPI,
+	"python-pip-lang":       EcosystemPyPI,
+	"python-pipenv-lang":    EcosystemPyPI,
+	"python-poetry-lang":    EcosystemPyPI,
+	"pom-lang":              EcosystemMaven,
+	"gradle-lang":           EcosystemMaven,
+	"jar-lang":              EcosystemMaven,
+	"jar-shaded-lang":       EcosystemMaven,
+	"go-mod-lang":           EcosystemGo,
+	"go-binary-lang":        EcosystemGo,
+	"cargo-lang":            EcosystemCargo,
+	"ruby-bundler-lang":     EcosystemRubyGems,
+	"ruby-gemspec-lang":     EcosystemRubyGems,
+	"composer-lang":         EcosystemComposer,
+	"conan-lang":            EcosystemConan,
+	"nuget-lang":            EcosystemNuGet,
+	"dotnet-deps-lang":      EcosystemNuGet,
+	"cocoapods-lang":        EcosystemCocoaPods,
+	"swift-lang":            EcosystemSwift,
+	"pub-lang":              EcosystemPub,
+	"hex-lang":              EcosystemHex,
+}
+
+// RegisterAnalyzerEcosystem sets the ecosystem of the packages found by the analyzer
+func RegisterAnalyzerEcosystem(analyzer string, ecosystem Ecosystem) {
+	analyzerEcosystems[analyzer] = ecosystem
+}
+
+func EcosystemFromAnalyzer(analyzer string) Ecosystem {
+	if eco, ok := analyzerEcosystems[analyzer]; ok {
+		return eco
+	}
+	return EcosystemGeneric
+}
+
+// PackageEcosystem returns the ecosystem of the first analyzer which found the package
+func PackageEcosystem(pkg *dxtypes.Package) Ecosystem {
+	for _, analyzer := range pkg.FromAnalyzer {
+		if eco := EcosystemFromAnalyzer(analyzer); eco != EcosystemGeneric {
+			return eco
+		}
+	}
+	if strings.Count(pkg.Name, ":") == 1 {
+		// groupId:artifactId
+		return EcosystemMaven
+	}
+	return EcosystemGeneric
+}
+
+// CompareVersion compares two versions by the rule of the ecosystem, the result is
+// -1 (v1 < v2), 0 (v1 == v2) or 1 (v1 > v2)
+func CompareVersion(eco Ecosystem, v1, v2 string) (int, error) {
+	v1, v2 = strings.TrimSpace(v1), strings.TrimSpace(v2)
+	if v1 == "" || v2 == "" {
+		return 0, utils.Errorf("empty version: %#v %#v", v1, v2)
+	}
+	if v1 == v2 {
+		return 0, nil
+	}
+	switch eco {
+	case EcosystemNpm, EcosystemGo, EcosystemCargo, EcosystemComposer, EcosystemConan,
+		EcosystemNuGet, EcosystemCocoaPods, EcosystemSwift, EcosystemPub, EcosystemHex:
+		return compareSemver(v1, v2), nil
+	case EcosystemPyPI:
+		return comparePEP440(v1, v2)
+	case EcosystemMaven:
+		return compareMaven(v1, v2), nil
+	case EcosystemRubyGems:
+		return compareRubyGems(v1, v2), nil
+	case EcosystemDebian:
+		return compareDebian(v1, v2)
+	case EcosystemRPM:
+		return compareRPM(v1, v2), nil
+	case EcosystemAlpine:
+		return compareAlpine(v1, v2), nil
+	}
+	return utils.VersionCompare(v1, v2)
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func cmpString(a, b string) int {
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,305 @@
+package vulnmatch
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/cve/cveresources"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Match is a vulnerability of a package
+type Match struct {
+	Package   *dxtypes.Package
+	Ecosystem Ecosystem
+	CVE       *cveresources.CVE
+
+	// CPE and Range are the vulnerable cpe_match in the CVE configurations,
+	// they are empty when the CVE comes from Package.AssociatedCVE
+	CPE   string
+	Range *VersionRange
+}
+
+// FixedVersion is the first fixed version known by the CVE, empty if unknown
+func (m *Match) FixedVersion() string {
+	if m.Range == nil {
+		return ""
+	}
+	return m.Range.FixedVersion()
+}
+
+// AffectedRange formats the affected versions, empty if unknown
+func (m *Match) AffectedRange() string {
+	if m.Range == nil {
+		return ""
+	}
+	return m.Range.String()
+}
+
+// productCandidate is a product name in cpe to search, vendors limit the vendor of the
+// cpe when the product name is too generic
+type productCandidate struct {
+	product string
+	vendors []string
+}
+
+func (c *productCandidate) matchCPE(cpe *cveresources.CPE) bool {
+	if !strings.EqualFold(unescapeCPE(cpe.Product), c.product) {
+		return false
+	}
+	if len(c.vendors) == 0 {
+		return true
+	}
+	vendor := strings.ToLower(unescapeCPE(cpe.Vendor))
+	for _, v := range c.vendors {
+		if vendor == v {
+			return true
+		}
+	}
+	return false
+}
+
+func appendCandidate(ret []*productCandidate, product string, vendors ...string) []*productCandidate {
+	product = strings.ToLower(strings.TrimSpace(product))
+	if product == "" {
+		return ret
+	}
+	for _, c := range ret {
+		if c.product == product && len(c.vendors) == 0 {
+			return ret
+		}
+	}
+	return append(ret, &productCandidate{product: product, vendors: vendors})
+}
+
+// productCandidates guesses the cpe products of the package
+func productCandidates(pkg *dxtypes.Package, eco Ecosystem) []*productCandidate {
+	var ret []*productCandidate
+	for _, raw := range pkg.AmendedCPE {
+		cpe, err := parseCPE(raw)
+		if err != nil {
+			continue
+		}
+		ret = appendCandidate(ret, unescapeCPE(cpe.Product), strings.ToLower(unescapeCPE(cpe.Vendor)))
+	}
+
+	name := pkg.Name
+	switch eco {
+	case EcosystemMaven:
+		group, artifact, ok := strings.Cut(name, ":")
+		if !ok {
+			ret = appendCandidate(ret, name)
+			break
+		}
+		// org.apache.logging.log4j:log4j-core -> apache:log4j
+		var vendors []string
+		for _, seg := range strings.Split(strings.ToLower(group), ".") {
+			switch seg {
+			case "org", "com", "net", "io", "cn":
+				continue
+			}
+			vendors = append(vendors, seg)
+		}
+		ret = appendCandidate(ret, artifact)
+		if idx := strings.IndexByte(artifact, '-'); idx > 0 {
+			ret = appendCandidate(ret, artifact[:idx], vendors...)
+		}
+		if len(vendors) > 0 {
+			ret = appendCandidate(ret, vendors[len(vendors)-1], vendors...)
+		}
+	case EcosystemNpm:
+		// @babel/traverse -> traverse of babel
+		if strings.HasPrefix(name, "@") {
+			if scope, pkgName, ok := strings.Cut(name[1:], "/"); ok {
+				ret = appendCandidate(ret, pkgName, strings.ToLower(scope))
+				break
+			}
+		}
+		ret = appendCandidate(ret, name)
+	case EcosystemPyPI:
+		ret = appendCandidate(ret, name)
+		ret = appendCandidate(ret, strings.ReplaceAll(name, "-", "_"))
+		ret = appendCandidate(ret, strings.ReplaceAll(name, "_", "-"))
+	case EcosystemGo, EcosystemSwift:
+		// github.com/gin-gonic/gin -> gin of gin-gonic
+		segs := strings.Split(strings.TrimSuffix(name, "/"), "/")
+		if len(segs) >= 3 {
+			ret = appendCandidate(ret, segs[2], strings.ToLower(segs[1]))
+		} else {
+			ret = appendCandidate(ret, segs[len(segs)-1])
+		}
+	default:
+		ret = appendCandidate(ret, name)
+	}
+	return ret
+}
+
+func parseCPE(raw string) (cpe *cveresources.CPE, err error) {
+	if !strings.HasPrefix(raw, "cpe:") {
+		return nil, utils.Errorf("invalid cpe: %v", raw)
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			err = utils.Errorf("invalid cpe: %v", raw)
+		}
+	}()
+	return cveresources.ParseToCPE(raw)
+}
+
+// UpstreamVersion strips the parts which are not in the cpe of NVD (epoch, debian revision,
+// rpm release and apk -r#) from the version of os packages
+func UpstreamVersion(eco Ecosystem, version string) string {
+	switch eco {
+	case EcosystemDebian, EcosystemRPM:
+		if _, rest, err := splitEpoch(version); err == nil {
+			version = rest
+		}
+		version, _ = splitRevision(version)
+	case EcosystemAlpine:
+		if idx := strings.LastIndex(version, "-r"); idx >= 0 && isNumeric(version[idx+2:]) {
+			version = version[:idx]
+		}
+	}
+	return version
+}
+
+func walkCpeMatches(nodes []cveresources.Nodes, handler func(m cveresources.CpeMatch)) {
+	for _, node := range nodes {
+		for _, m := range node.CpeMatch {
+			handler(m)
+		}
+		walkCpeMatches(node.Children, handler)
+	}
+}
+
+// Matcher correlates packages with the CVEs in the CVE database
+type Matcher struct {
+	db  *gorm.DB
+	ctx context.Context
+
+	// product -> cves
+	cache map[string][]*cveresources.CVE
+}
+
+func NewMatcher(ctx context.Context, db *gorm.DB) *Matcher {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &Matcher{
+		db:    db,
+		ctx:   ctx,
+		cache: make(map[string][]*cveresources.CVE),
+	}
+}
+
+func (m *Matcher) queryProduct(product string) ([]*cveresources.CVE, error) {
+	if cves, ok := m.cache[product]; ok {
+		return cves, nil
+	}
+	var cves []*cveresources.CVE
+	// Product is joined by comma, filter by the configurations later
+	if db := m.db.Model(&cveresources.CVE{}).Where("product LIKE ?", "%"+product+"%").Find(&cves); db.Error != nil {
+		return nil, utils.Errorf("query cve by product %v failed: %s", product, db.Error)
+	}
+	m.cache[product] = cves
+	return cves, nil
+}
+
+// MatchPackage returns the CVEs affecting the package, packages with version range are skipped
+func (m *Matcher) MatchPackage(pkg *dxtypes.Package) ([]*Match, error) {
+	if pkg == nil || pkg.Name == "" {
+		return nil, nil
+	}
+	eco := PackageEcosystem(pkg)
+	var (
+		ret  []*Match
+		seen = make(map[string]struct{})
+	)
+	for _, id := range pkg.AssociatedCVE {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		cve, err := cveresources.GetCVE(m.db, id)
+		if err != nil {
+			log.Debugf("associated cve %v of %v not found: %v", id, pkg.Name, err)
+			continue
+		}
+		seen[id] = struct{}{}
+		ret = append(ret, &Match{Package: pkg, Ecosystem: eco, CVE: cve})
+	}
+
+	if pkg.Version == "" || pkg.IsVersionRange || pkg.HasVersionRange() {
+		return ret, nil
+	}
+	version := UpstreamVersion(eco, pkg.Version)
+
+	for _, candidate := range productCandidates(pkg, eco) {
+		cves, err := m.queryProduct(candidate.product)
+		if err != nil {
+			return ret, err
+		}
+		for _, cve := range cves {
+			select {
+			case <-m.ctx.Done():
+				return ret, m.ctx.Err()
+			default:
+			}
+			if _, ok := seen[cve.CVE]; ok {
+				continue
+			}
+			if match := matchCVE(cve, candidate, eco, version); match != nil {
+				match.Package = pkg
+				seen[cve.CVE] = struct{}{}
+				ret = append(ret, match)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func matchCVE(cve *cveresources.CVE, candidate *productCandidate, eco Ecosystem, version string) *Match {
+	var config cveresources.Configurations
+	if err := json.Unmarshal(cve.CPEConfigurations, &config); err != nil {
+		return nil
+	}
+	var ret *Match
+	walkCpeMatches(config.Nodes, func(cpeMatch cveresources.CpeMatch) {
+		if ret != nil || !cpeMatch.Vulnerable {
+			return
+		}
+		cpe, err := parseCPE(cpeMatch.Cpe23URI)
+		if err != nil || !candidate.matchCPE(cpe) {
+			return
+		}
+		r := NewVersionRangeFromCpeMatch(cpeMatch, cpe)
+		in, err := r.Contains(eco, version)
+		if err != nil {
+			log.Debugf("compare %v with %v failed: %v", version, r, err)
+			return
+		}
+		if in {
+			ret = &Match{Ecosystem: eco, CVE: cve, CPE: cpeMatch.Cpe23URI, Range: r}
+		}
+	})
+	return ret
+}
+
+// MatchPackages matches all packages, the errors of single package are logged and skipped
+func (m *Matcher) MatchPackages(pkgs []*dxtypes.Package) ([]*Match, error) {
+	var ret []*Match
+	for _, pkg := range pkgs {
+		matches, err := m.MatchPackage(pkg)
+		ret = append(ret, matches...)
+		if err != nil {
+			if m.ctx.Err() != nil {
+				return ret, err
+			}
+			log.Warnf("match vulnerabilities of %v@%v failed: %v", pkg.Name, pkg.Version, err)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,109 @@
+package vulnmatch
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/cve/cveresources"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+)
+
+func createTestCVEDatabase(t *testing.T) *gorm.DB {
+	db, err := consts.CreateCVEDatabase(filepath.Join(t.TempDir(), "cve.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	save := func(id, vendor, product, severity string, nodes ...cveresources.Nodes) {
+		config, err := json.Marshal(cveresources.Configurations{Nodes: nodes})
+		require.NoError(t, err)
+		require.NoError(t, db.Save(&cveresources.CVE{
+			CVE:               id,
+			Vendor:            vendor,
+			Product:           product,
+			Severity:          severity,
+			CPEConfigurations: config,
+		}).Error)
+	}
+	save("CVE-2021-44228", "apache", "log4j", "CRITICAL", cveresources.Nodes{
+		Operator: "OR",
+		CpeMatch: []cveresources.CpeMatch{
+			{Vulnerable: true, Cpe23URI: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", VersionStartIncluding: "2.0.1", VersionEndExcluding: "2.3.1"},
+			{Vulnerable: true, Cpe23URI: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", VersionStartIncluding: "2.4.0", VersionEndExcluding: "2.12.2"},
+			{Vulnerable: true, Cpe23URI: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", VersionStartIncluding: "2.13.0", VersionEndExcluding: "2.15.0"},
+		},
+	})
+	save("CVE-2022-24999", "qs_project", "qs", "HIGH", cveresources.Nodes{
+		Operator: "OR",
+		CpeMatch: []cveresources.CpeMatch{
+			{Vulnerable: true, Cpe23URI: "cpe:2.3:a:qs_project:qs:6.7.0:*:*:*:*:node.js:*:*"},
+			{Vulnerable: true, Cpe23URI: "cpe:2.3:a:qs_project:qs:*:*:*:*:*:node.js:*:*", VersionStartIncluding: "6.8.0", VersionEndExcluding: "6.8.3"},
+		},
+	})
+	save("CVE-2022-0778", "openssl,debian", "openssl,debian_linux", "HIGH", cveresources.Nodes{
+		Operator: "OR",
+		Children: []cveresources.Nodes{{
+			Operator: "OR",
+			CpeMatch: []cveresources.CpeMatch{
+				{Vulnerable: true, Cpe23URI: "cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*", VersionStartIncluding: "1.1.1", VersionEndExcluding: "1.1.1n"},
+				{Vulnerable: false, Cpe23URI: "cpe:2.3:o:debian:debian_linux:11.0:*:*:*:*:*:*:*"},
+			},
+		}},
+	})
+	// a product named like a substring of the others should not be matched
+	save("CVE-2099-0001", "example", "qs-extra", "LOW", cveresources.Nodes{
+		CpeMatch: []cveresources.CpeMatch{
+			{Vulnerable: true, Cpe23URI: "cpe:2.3:a:example:qs-extra:*:*:*:*:*:*:*:*", VersionEndExcluding: "99.0"},
+		},
+	})
+	return db
+}
+
+func TestMatcher(t *testing.T) {
+	db := createTestCVEDatabase(t)
+
+	pkgs := []*dxtypes.Package{
+		{Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1", FromAnalyzer: []string{"pom-lang"}},
+		{Name: "org.apache.logging.log4j:log4j-api", Version: "2.17.1", FromAnalyzer: []string{"pom-lang"}},
+		{Name: "qs", Version: "6.7.0", FromAnalyzer: []string{"npm-lang"}},
+		{Name: "qs", Version: "6.8.1", FromAnalyzer: []string{"yarm-lang"}},
+		{Name: "qs", Version: "6.8.3", FromAnalyzer: []string{"npm-lang"}},
+		{Name: "openssl", Version: "1.1.1k-1+deb11u1", FromAnalyzer: []string{"dpkg-pkg"}},
+		{Name: "openssl", Version: "1.1.1n-0+deb11u3", FromAnalyzer: []string{"dpkg-pkg"}},
+		{Name: "qs", Version: "^6.7.0", IsVersionRange: true, FromAnalyzer: []string{"npm-lang"}},
+		{Name: "custom-lib", Version: "1.0.0", AssociatedCVE: []string{"CVE-2021-44228", "CVE-1999-0000"}},
+	}
+	matches, err := NewMatcher(context.Background(), db).MatchPackages(pkgs)
+	require.NoError(t, err)
+
+	got := make(map[string][]string)
+	for _, m := range matches {
+		key := m.Package.Name + "@" + m.Package.Version
+		got[key] = append(got[key], m.CVE.CVE)
+	}
+	require.Equal(t, map[string][]string{
+		"org.apache.logging.log4j:log4j-core@2.14.1": {"CVE-2021-44228"},
+		"qs@6.7.0":                 {"CVE-2022-24999"},
+		"qs@6.8.1":                 {"CVE-2022-24999"},
+		"openssl@1.1.1k-1+deb11u1": {"CVE-2022-0778"},
+		"custom-lib@1.0.0":         {"CVE-2021-44228"},
+	}, got)
+
+	for _, m := range matches {
+		switch m.Package.Name + "@" + m.Package.Version {
+		case "org.apache.logging.log4j:log4j-core@2.14.1":
+			require.Equal(t, EcosystemMaven, m.Ecosystem)
+			require.Equal(t, ">=2.13.0, <2.15.0", m.AffectedRange())
+			require.Equal(t, "2.15.0", m.FixedVersion())
+		case "qs@6.7.0":
+			require.Equal(t, "=6.7.0", m.AffectedRange())
+			require.Empty(t, m.FixedVersion())
+		case "custom-lib@1.0.0":
+			require.Nil(t, m.Range)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package vulnmatch
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/cve/cveresources"
+)
+
+// VersionRange is the affected versions of a cpe match in NVD configurations
+type VersionRange struct {
+	// Exact is the version in the cpe uri, empty / * / - means no exact version
+	Exact string
+
+	StartIncluding string
+	StartExcluding string
+	EndIncluding   string
+	EndExcluding   string
+}
+
+func NewVersionRangeFromCpeMatch(m cveresources.CpeMatch, cpe *cveresources.CPE) *VersionRange {
+	r := &VersionRange{
+		StartIncluding: m.VersionStartIncluding,
+		StartExcluding: m.VersionStartExcluding,
+		EndIncluding:   m.VersionEndIncluding,
+		EndExcluding:   m.VersionEndExcluding,
+	}
+	if cpe != nil && !isAnyVersion(cpe.Version) {
+		r.Exact = unescapeCPE(cpe.Version)
+	}
+	return r
+}
+
+func isAnyVersion(v string) bool {
+	return v == "" || v == "*" || v == "-"
+}
+
+// unescapeCPE removes the escape of cpe 2.3 formatted string, 1\.0 -> 1.0
+func unescapeCPE(s string) string {
+	return strings.ReplaceAll(s, `\`, "")
+}
+
+// IsUnbounded reports whether the range matches all versions
+func (r *VersionRange) IsUnbounded() bool {
+	return r.Exact == "" && r.StartIncluding == "" && r.StartExcluding == "" && r.EndIncluding == "" && r.EndExcluding == ""
+}
+
+// Contains reports whether the version is in the range, an unbounded range contains nothing
+// because it usually means the whole product is vulnerable and can't be checked offline.
+func (r *VersionRange) Contains(eco Ecosystem, version string) (bool, error) {
+	if r.IsUnbounded() {
+		return false, nil
+	}
+	if r.Exact != "" {
+		c, err := CompareVersion(eco, version, r.Exact)
+		if err != nil {
+			return false, err
+		}
+		if c != 0 {
+			return false, nil
+		}
+	}
+	check := func(bound string, ok func(int) bool) (bool, error) {
+		if bound == "" {
+			return true, nil
+		}
+		c, err := CompareVersion(eco, version, bound)
+		if err != nil {
+			return false, err
+		}
+		return ok(c), nil
+	}
+	for _, b := range []struct {
+		bound string
+		ok    func(int) bool
+	}{
+		{r.StartIncluding, func(c int) bool { return c >= 0 }},
+		{r.StartExcluding, func(c int) bool { return c > 0 }},
+		{r.EndIncluding, func(c int) bool { return c <= 0 }},
+		{r.EndExcluding, func(c int) bool { return c < 0 }},
+	} {
+		in, err := check(b.bound, b.ok)
+		if err != nil || !in {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// FixedVersion is the first version out of the range, only EndExcluding is known to be fixed
+func (r *VersionRange) FixedVersion() string {
+	return r.EndExcluding
+}
+
+// String formats the range like ">=2.0.0, <2.15.0"
+func (r *VersionRange) String() string {
+	if r.Exact != "" {
+		return "=" + r.Exact
+	}
+	var parts []string
+	if r.StartIncluding != "" {
+		parts = append(parts, ">="+r.StartIncluding)
+	}
+	if r.StartExcluding != "" {
+		parts = append(parts, ">"+r.StartExcluding)
+	}
+	if r.EndIncluding != "" {
+		parts = append(parts, "<="+r.EndIncluding)
+	}
+	if r.EndExcluding != "" {
+		parts = append(parts, "<"+r.EndExcluding)
+	}
+	if len(parts) == 0 {
+		return "*"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,427 @@
+package vulnmatch
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareNumeric compares two digit strings of any length
+func compareNumeric(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if r := cmpInt(len(a), len(b)); r != 0 {
+		return r
+	}
+	return cmpString(a, b)
+}
+
+// splitAlnum splits "1.0rc1-beta" to ["1", "0", "rc", "1", "beta"]
+func splitAlnum(s string) []string {
+	var (
+		ret []string
+		buf strings.Builder
+	)
+	flush := func() {
+		if buf.Len() > 0 {
+			ret = append(ret, buf.String())
+			buf.Reset()
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isDigit(c) && !isLetter(c) {
+			flush()
+			continue
+		}
+		if buf.Len() > 0 && isDigit(c) != isDigit(s[i-1]) {
+			flush()
+		}
+		buf.WriteByte(c)
+	}
+	flush()
+	return ret
+}
+
+/*
+semver: npm / go / cargo / composer / conan
+*/
+
+func compareSemver(v1, v2 string) int {
+	core1, pre1 := splitSemver(v1)
+	core2, pre2 := splitSemver(v2)
+
+	p1, p2 := strings.Split(core1, "."), strings.Split(core2, ".")
+	for i := 0; i < len(p1) || i < len(p2); i++ {
+		a, b := "0", "0"
+		if i < len(p1) {
+			a = p1[i]
+		}
+		if i < len(p2) {
+			b = p2[i]
+		}
+		var r int
+		if isNumeric(a) && isNumeric(b) {
+			r = compareNumeric(a, b)
+		} else {
+			r = compareLoose(a, b)
+		}
+		if r != 0 {
+			return r
+		}
+	}
+
+	// a version without prerelease has higher precedence
+	switch {
+	case pre1 == "" && pre2 == "":
+		return 0
+	case pre1 == "":
+		return 1
+	case pre2 == "":
+		return -1
+	}
+	ids1, ids2 := strings.Split(pre1, "."), strings.Split(pre2, ".")
+	for i := 0; i < len(ids1) && i < len(ids2); i++ {
+		a, b := ids1[i], ids2[i]
+		an, bn := isNumeric(a), isNumeric(b)
+		var r int
+		switch {
+		case an && bn:
+			r = compareNumeric(a, b)
+		case an:
+			r = -1
+		case bn:
+			r = 1
+		default:
+			r = cmpString(a, b)
+		}
+		if r != 0 {
+			return r
+		}
+	}
+	return cmpInt(len(ids1), len(ids2))
+}
+
+func splitSemver(v string) (core string, prerelease string) {
+	v = strings.TrimPrefix(strings.TrimPrefix(v, "v"), "=")
+	if idx := strings.IndexByte(v, '+'); idx >= 0 {
+		v = v[:idx]
+	}
+	if idx := strings.IndexByte(v, '-'); idx >= 0 {
+		return v[:idx], v[idx+1:]
+	}
+	return v, ""
+}
+
+// compareLoose compares the alnum parts one by one, numbers are bigger than words
+func compareLoose(a, b string) int {
+	p1, p2 := splitAlnum(a), splitAlnum(b)
+	for i := 0; i < len(p1) && i < len(p2); i++ {
+		an, bn := isNumeric(p1[i]), isNumeric(p2[i])
+		var r int
+		switch {
+		case an && bn:
+			r = compareNumeric(p1[i], p2[i])
+		case an:
+			r = 1
+		case bn:
+			r = -1
+		default:
+			r = cmpString(strings.ToLower(p1[i]), strings.ToLower(p2[i]))
+		}
+		if r != 0 {
+			return r
+		}
+	}
+	return cmpInt(len(p1), len(p2))
+}
+
+/*
+PEP 440: [N!]N(.N)*[{a|b|rc}N][.postN][.devN][+local]
+*/
+
+var pep440Regexp = regexp.MustCompile(`^v?(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(a|alpha|b|beta|c|rc|pre|preview)[-_.]?(\d*))?` +
+	`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d*))?` +
+	`(?:[-_.]?(dev)[-_.]?(\d*))?` +
+	`(?:\+([a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`)
+
+type pep440Version struct {
+	epoch   int
+	release []string
+	// phase: -1 dev release, 0 alpha, 1 beta, 2 rc, 3 final
+	prePhase int
+	preNum   int
+	post     int
+	dev      int
+	local    []string
+}
+
+func atoiDefault(s string, d int) int {
+	if s == "" {
+		return d
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return d
+	}
+	return i
+}
+
+func parsePEP440(v string) (*pep440Version, error) {
+	m := pep440Regexp.FindStringSubmatch(strings.ToLower(strings.TrimSpace(v)))
+	if m == nil {
+		return nil, utils.Errorf("invalid PEP 440 version: %v", v)
+	}
+	ret := &pep440Version{
+		epoch:    atoiDefault(m[1], 0),
+		release:  strings.Split(m[2], "."),
+		prePhase: 3,
+		post:     -1,
+		dev:      math.MaxInt,
+	}
+	// 1.0.0 == 1.0
+	for len(ret.release) > 1 && strings.Trim(ret.release[len(ret.release)-1], "0") == "" {
+		ret.release = ret.release[:len(ret.release)-1]
+	}
+	switch m[3] {
+	case "a", "alpha":
+		ret.prePhase = 0
+	case "b", "beta":
+		ret.prePhase = 1
+	case "c", "rc", "pre", "preview":
+		ret.prePhase = 2
+	}
+	ret.preNum = atoiDefault(m[4], 0)
+	if m[5] != "" {
+		ret.post = atoiDefault(m[5], 0)
+	} else if m[6] != "" {
+		ret.post = atoiDefault(m[7], 0)
+	}
+	if m[8] != "" {
+		ret.dev = atoiDefault(m[9], 0)
+		// 1.0.dev1 < 1.0a1, but 1.0.post1.dev1 > 1.0
+		if m[3] == "" && ret.post < 0 {
+			ret.prePhase = -1
+		}
+	}
+	if m[10] != "" {
+		ret.local = strings.FieldsFunc(m[10], func(r rune) bool {
+			return r == '.' || r == '-' || r == '_'
+		})
+	}
+	return ret, nil
+}
+
+func comparePEP440(v1, v2 string) (int, error) {
+	a, err := parsePEP440(v1)
+	if err != nil {
+		return utils.VersionCompare(v1, v2)
+	}
+	b, err := parsePEP440(v2)
+	if err != nil {
+		return utils.VersionCompare(v1, v2)
+	}
+	if r := cmpInt(a.epoch, b.epoch); r != 0 {
+		return r, nil
+	}
+	for i := 0; i < len(a.release) || i < len(b.release); i++ {
+		x, y := "0", "0"
+		if i < len(a.release) {
+			x = a.release[i]
+		}
+		if i < len(b.release) {
+			y = b.release[i]
+		}
+		if r := compareNumeric(x, y); r != 0 {
+			return r, nil
+		}
+	}
+	for _, r := range []int{
+		cmpInt(a.prePhase, b.prePhase),
+		cmpInt(a.preNum, b.preNum),
+		cmpInt(a.post, b.post),
+		cmpInt(a.dev, b.dev),
+	} {
+		if r != 0 {
+			return r, nil
+		}
+	}
+	// local segments: numbers are bigger than words, a longer local version is bigger
+	for i := 0; i < len(a.local) && i < len(b.local); i++ {
+		x, y := a.local[i], b.local[i]
+		xn, yn := isNumeric(x), isNumeric(y)
+		var r int
+		switch {
+		case xn && yn:
+			r = compareNumeric(x, y)
+		case xn:
+			r = 1
+		case yn:
+			r = -1
+		default:
+			r = cmpString(x, y)
+		}
+		if r != 0 {
+			return r, nil
+		}
+	}
+	return cmpInt(len(a.local), len(b.local)), nil
+}
+
+/*
+maven: a subset of org.apache.maven.artifact.versioning.ComparableVersion
+*/
+
+var mavenQualifiers = map[string]string{
+	"alpha":     "0",
+	"beta":      "1",
+	"milestone": "2",
+	"rc":        "3",
+	"cr":        "3",
+	"snapshot":  "4",
+	"":          "5",
+	"ga":        "5",
+	"final":     "5",
+	"release":   "5",
+	"sp":        "6",
+}
+
+func mavenQualifierKey(q string) string {
+	if k, ok := mavenQualifiers[q]; ok {
+		return k
+	}
+	// unknown qualifiers are after all known ones, in lexical order
+	return "7-" + q
+}
+
+type mavenItem struct {
+	number    string
+	qualifier string
+	isNumber  bool
+}
+
+func parseMavenVersion(v string) []*mavenItem {
+	parts := splitAlnum(strings.ToLower(v))
+	ret := make([]*mavenItem, 0, len(parts))
+	for i, p := range parts {
+		if isNumeric(p) {
+			ret = append(ret, &mavenItem{number: p, isNumber: true})
+			continue
+		}
+		// 1.0a1 means 1.0-alpha-1
+		if len(p) == 1 && i+1 < len(parts) && isNumeric(parts[i+1]) {
+			switch p {
+			case "a":
+				p = "alpha"
+			case "b":
+				p = "beta"
+			case "m":
+				p = "milestone"
+			}
+		}
+		// ga / final / release are the same as no qualifier
+		if mavenQualifierKey(p) == mavenQualifierKey("") {
+			continue
+		}
+		ret = append(ret, &mavenItem{qualifier: p})
+	}
+	return ret
+}
+
+func compareMavenItem(a, b *mavenItem) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -compareMavenItem(b, nil)
+	case b == nil:
+		if a.isNumber {
+			if strings.Trim(a.number, "0") == "" {
+				return 0
+			}
+			return 1
+		}
+		return cmpString(mavenQualifierKey(a.qualifier), mavenQualifierKey(""))
+	case a.isNumber && b.isNumber:
+		return compareNumeric(a.number, b.number)
+	case a.isNumber:
+		return 1
+	case b.isNumber:
+		return -1
+	}
+	return cmpString(mavenQualifierKey(a.qualifier), mavenQualifierKey(b.qualifier))
+}
+
+func compareMaven(v1, v2 string) int {
+	a, b := parseMavenVersion(v1), parseMavenVersion(v2)
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y *mavenItem
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if r := compareMavenItem(x, y); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+/*
+rubygems: Gem::Version, segments with letters are prerelease
+*/
+
+func compareRubyGems(v1, v2 string) int {
+	a, b := splitAlnum(v1), splitAlnum(v2)
+	for i := 0; i < len(a) || i < len(b); i++ {
+		x, y := "0", "0"
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		xn, yn := isNumeric(x), isNumeric(y)
+		var r int
+		switch {
+		case xn && yn:
+			r = compareNumeric(x, y)
+		case xn:
+			r = 1
+		case yn:
+			r = -1
+		default:
+			r = cmpString(x, y)
+		}
+		if r != 0 {
+			return r
+		}
+	}
+	return 0
+}
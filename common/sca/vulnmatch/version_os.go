@@ -0,0 +1,328 @@
+package vulnmatch
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func byteAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return 0
+}
+
+/*
+debian: [epoch:]upstream_version[-debian_revision], see dpkg lib/dpkg/version.c
+*/
+
+func splitEpoch(v string) (int, string, error) {
+	idx := strings.IndexByte(v, ':')
+	if idx < 0 {
+		return 0, v, nil
+	}
+	epoch, err := strconv.Atoi(v[:idx])
+	if err != nil {
+		return 0, "", utils.Errorf("invalid epoch in version %#v", v)
+	}
+	return epoch, v[idx+1:], nil
+}
+
+func debianOrder(c byte) int {
+	switch {
+	case c == 0, isDigit(c):
+		return 0
+	case isLetter(c):
+		return int(c)
+	case c == '~':
+		return -1
+	}
+	return int(c) + 256
+}
+
+// verrevcmp is the comparison of dpkg, '~' sorts before everything, even the end of the part
+func verrevcmp(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		firstDiff := 0
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			ac, bc := debianOrder(byteAt(a, i)), debianOrder(byteAt(b, j))
+			if ac != bc {
+				return cmpInt(ac, bc)
+			}
+			i++
+			j++
+		}
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = cmpInt(int(a[i]), int(b[j]))
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return firstDiff
+		}
+	}
+	return 0
+}
+
+func splitRevision(v string) (string, string) {
+	if idx := strings.LastIndexByte(v, '-'); idx >= 0 {
+		return v[:idx], v[idx+1:]
+	}
+	return v, ""
+}
+
+func compareDebian(v1, v2 string) (int, error) {
+	e1, rest1, err := splitEpoch(v1)
+	if err != nil {
+		return 0, err
+	}
+	e2, rest2, err := splitEpoch(v2)
+	if err != nil {
+		return 0, err
+	}
+	if r := cmpInt(e1, e2); r != 0 {
+		return r, nil
+	}
+	up1, rev1 := splitRevision(rest1)
+	up2, rev2 := splitRevision(rest2)
+	if r := verrevcmp(up1, up2); r != 0 {
+		return r, nil
+	}
+	return verrevcmp(rev1, rev2), nil
+}
+
+/*
+rpm: [epoch:]version[-release], see rpm rpmio/rpmvercmp.c
+*/
+
+func isAlnum(c byte) bool {
+	return isDigit(c) || isLetter(c)
+}
+
+func rpmvercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isAlnum(a[i]) && a[i] != '~' && a[i] != '^' {
+			i++
+		}
+		for j < len(b) && !isAlnum(b[j]) && b[j] != '~' && b[j] != '^' {
+			j++
+		}
+
+		// tilde sorts before everything else
+		if byteAt(a, i) == '~' || byteAt(b, j) == '~' {
+			if byteAt(a, i) != '~' {
+				return 1
+			}
+			if byteAt(b, j) != '~' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+		// caret sorts after the end of the version but before everything else
+		if byteAt(a, i) == '^' || byteAt(b, j) == '^' {
+			if i >= len(a) {
+				return -1
+			}
+			if j >= len(b) {
+				return 1
+			}
+			if a[i] != '^' {
+				return 1
+			}
+			if b[j] != '^' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+
+		si, sj := i, j
+		isNum := isDigit(a[i])
+		if isNum {
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+		} else {
+			for i < len(a) && isLetter(a[i]) {
+				i++
+			}
+			for j < len(b) && isLetter(b[j]) {
+				j++
+			}
+		}
+		seg1, seg2 := a[si:i], b[sj:j]
+		if seg2 == "" {
+			// numeric segments are newer than alpha segments
+			if isNum {
+				return 1
+			}
+			return -1
+		}
+		var r int
+		if isNum {
+			r = compareNumeric(seg1, seg2)
+		} else {
+			r = cmpString(seg1, seg2)
+		}
+		if r != 0 {
+			return r
+		}
+	}
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i < len(a):
+		return 1
+	}
+	return -1
+}
+
+func compareRPM(v1, v2 string) int {
+	e1, rest1, err1 := splitEpoch(v1)
+	e2, rest2, err2 := splitEpoch(v2)
+	if err1 != nil || err2 != nil {
+		return rpmvercmp(v1, v2)
+	}
+	if r := cmpInt(e1, e2); r != 0 {
+		return r
+	}
+	ver1, rel1 := splitRevision(rest1)
+	ver2, rel2 := splitRevision(rest2)
+	if r := rpmvercmp(ver1, ver2); r != 0 {
+		return r
+	}
+	// a version without release matches all releases
+	if rel1 == "" || rel2 == "" {
+		return 0
+	}
+	return rpmvercmp(rel1, rel2)
+}
+
+/*
+alpine: number{.number}...{letter}{_suffix{number}}...{-r#}, see apk-tools src/version.c
+*/
+
+var alpineSuffixes = map[string]int{
+	"alpha": 0,
+	"beta":  1,
+	"pre":   2,
+	"rc":    3,
+	// no suffix: 4
+	"cvs": 5,
+	"svn": 6,
+	"git": 7,
+	"hg":  8,
+	"p":   9,
+}
+
+type alpineVersion struct {
+	numbers  []string
+	letter   byte
+	suffixes [][2]string
+	revision string
+}
+
+func parseAlpineVersion(v string) (*alpineVersion, bool) {
+	ret := &alpineVersion{revision: "0"}
+	if idx := strings.LastIndex(v, "-r"); idx >= 0 && isNumeric(v[idx+2:]) {
+		ret.revision = v[idx+2:]
+		v = v[:idx]
+	}
+	parts := strings.Split(v, "_")
+	main := parts[0]
+	if main != "" && isLetter(main[len(main)-1]) {
+		ret.letter = main[len(main)-1]
+		main = main[:len(main)-1]
+	}
+	ret.numbers = strings.Split(main, ".")
+	for _, n := range ret.numbers {
+		if !isNumeric(n) {
+			return nil, false
+		}
+	}
+	for _, s := range parts[1:] {
+		name := strings.TrimRightFunc(s, func(r rune) bool {
+			return r >= '0' && r <= '9'
+		})
+		if _, ok := alpineSuffixes[name]; !ok {
+			return nil, false
+		}
+		ret.suffixes = append(ret.suffixes, [2]string{name, s[len(name):]})
+	}
+	return ret, true
+}
+
+func compareAlpine(v1, v2 string) int {
+	a, ok1 := parseAlpineVersion(v1)
+	b, ok2 := parseAlpineVersion(v2)
+	if !ok1 || !ok2 {
+		return verrevcmp(v1, v2)
+	}
+	for i := 0; i < len(a.numbers) || i < len(b.numbers); i++ {
+		x, y := "0", "0"
+		if i < len(a.numbers) {
+			x = a.numbers[i]
+		}
+		if i < len(b.numbers) {
+			y = b.numbers[i]
+		}
+		if r := compareNumeric(x, y); r != 0 {
+			return r
+		}
+	}
+	if r := cmpInt(int(a.letter), int(b.letter)); r != 0 {
+		return r
+	}
+	for i := 0; i < len(a.suffixes) || i < len(b.suffixes); i++ {
+		x, y := [2]string{"", "0"}, [2]string{"", "0"}
+		if i < len(a.suffixes) {
+			x = a.suffixes[i]
+		}
+		if i < len(b.suffixes) {
+			y = b.suffixes[i]
+		}
+		rank := func(s string) int {
+			if r, ok := alpineSuffixes[s]; ok {
+				return r
+			}
+			return 4
+		}
+		if r := cmpInt(rank(x[0]), rank(y[0])); r != 0 {
+			return r
+		}
+		if r := compareNumeric(x[1], y[1]); r != 0 {
+			return r
+		}
+	}
+	return compareNumeric(a.revision, b.revision)
+}
@@ -0,0 +1,114 @@
+package vulnmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareVersion(t *testing.T) {
+	for _, c := range []struct {
+		eco    Ecosystem
+		v1, v2 string
+		want   int
+	}{
+		// semver
+		{EcosystemNpm, "1.2.3", "1.2.10", -1},
+		{EcosystemNpm, "1.0.0-alpha", "1.0.0", -1},
+		{EcosystemNpm, "1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{EcosystemNpm, "1.0.0-beta.11", "1.0.0-beta.2", 1},
+		{EcosystemNpm, "1.0.0-rc.1", "1.0.0-beta.11", 1},
+		{EcosystemNpm, "1.0.0+build.1", "1.0.0", 0},
+		{EcosystemGo, "v0.0.0-20210101000000-abcdef", "v0.0.1", -1},
+		{EcosystemGo, "v1.9.1", "1.10.0", -1},
+
+		// PEP 440
+		{EcosystemPyPI, "1.0.dev1", "1.0a1", -1},
+		{EcosystemPyPI, "1.0a1", "1.0b1", -1},
+		{EcosystemPyPI, "1.0rc1", "1.0", -1},
+		{EcosystemPyPI, "1.0", "1.0.post1", -1},
+		{EcosystemPyPI, "1.0.post1.dev1", "1.0.post1", -1},
+		{EcosystemPyPI, "1.0.post1.dev1", "1.0", 1},
+		{EcosystemPyPI, "1.0", "1.0.0", 0},
+		{EcosystemPyPI, "1!0.1", "2.0", 1},
+		{EcosystemPyPI, "1.0+local.1", "1.0", 1},
+		{EcosystemPyPI, "2.0.0-rc.1", "2.0.0", -1},
+
+		// maven
+		{EcosystemMaven, "2.14.1", "2.15.0", -1},
+		{EcosystemMaven, "1.0-alpha-1", "1.0-beta-1", -1},
+		{EcosystemMaven, "1.0-rc1", "1.0", -1},
+		{EcosystemMaven, "1.0-SNAPSHOT", "1.0", -1},
+		{EcosystemMaven, "1.0.RELEASE", "1.0", 0},
+		{EcosystemMaven, "1.0-final", "1.0.0", 0},
+		{EcosystemMaven, "1.0-sp1", "1.0", 1},
+		{EcosystemMaven, "1.0a1", "1.0-alpha-1", 0},
+		{EcosystemMaven, "5.3.18", "5.3.9", 1},
+
+		// rubygems
+		{EcosystemRubyGems, "1.0.0.pre", "1.0.0", -1},
+		{EcosystemRubyGems, "1.0.a", "1.0.b", -1},
+		{EcosystemRubyGems, "1.10", "1.9", 1},
+
+		// dpkg
+		{EcosystemDebian, "1:1.0", "2.0", 1},
+		{EcosystemDebian, "1.0~rc1", "1.0", -1},
+		{EcosystemDebian, "1.0", "1.0+b1", -1},
+		{EcosystemDebian, "1.1.1n-0+deb11u3", "1.1.1n-0+deb11u4", -1},
+		{EcosystemDebian, "2.31-13+deb11u5", "2.31-9", 1},
+		{EcosystemDebian, "1.0a", "1.0-", 1},
+
+		// rpm
+		{EcosystemRPM, "1.0", "1.0.1", -1},
+		{EcosystemRPM, "1:1.0", "2.0", 1},
+		{EcosystemRPM, "1.0~rc1", "1.0", -1},
+		{EcosystemRPM, "1.0^20230101", "1.0", 1},
+		{EcosystemRPM, "1.0^20230101", "1.0.1", -1},
+		{EcosystemRPM, "2.28-225.el8", "2.28-211.el8", 1},
+		{EcosystemRPM, "2.28", "2.28-211.el8", 0},
+		{EcosystemRPM, "1.0a", "1.0.1", -1},
+
+		// apk
+		{EcosystemAlpine, "1.2.3-r0", "1.2.3-r1", -1},
+		{EcosystemAlpine, "1.2.3_rc1", "1.2.3", -1},
+		{EcosystemAlpine, "1.2.3_p1", "1.2.3", 1},
+		{EcosystemAlpine, "1.1.1t-r0", "1.1.1s-r5", 1},
+		{EcosystemAlpine, "3.0.8-r3", "3.0.10-r0", -1},
+	} {
+		got, err := CompareVersion(c.eco, c.v1, c.v2)
+		require.NoError(t, err, "%s %s %s", c.eco, c.v1, c.v2)
+		require.Equal(t, c.want, got, "%s: %s vs %s", c.eco, c.v1, c.v2)
+		got, err = CompareVersion(c.eco, c.v2, c.v1)
+		require.NoError(t, err)
+		require.Equal(t, -c.want, got, "%s: %s vs %s", c.eco, c.v2, c.v1)
+	}
+}
+
+func TestVersionRange(t *testing.T) {
+	r := &VersionRange{StartIncluding: "2.0-beta9", EndExcluding: "2.15.0"}
+	require.Equal(t, ">=2.0-beta9, <2.15.0", r.String())
+	for v, want := range map[string]bool{
+		"2.0-beta8": false,
+		"2.0-beta9": true,
+		"2.0":       true,
+		"2.14.1":    true,
+		"2.15.0":    false,
+		"2.17.1":    false,
+	} {
+		in, err := r.Contains(EcosystemMaven, v)
+		require.NoError(t, err)
+		require.Equal(t, want, in, v)
+	}
+
+	in, err := (&VersionRange{Exact: "6.7.0"}).Contains(EcosystemNpm, "6.7.0")
+	require.NoError(t, err)
+	require.True(t, in)
+
+	in, err = (&VersionRange{}).Contains(EcosystemNpm, "6.7.0")
+	require.NoError(t, err)
+	require.False(t, in, "unbounded range should not match")
+
+	require.Equal(t, "1.1.1n", UpstreamVersion(EcosystemDebian, "1.1.1n-0+deb11u3"))
+	require.Equal(t, "2.28", UpstreamVersion(EcosystemRPM, "1:2.28-225.el8"))
+	require.Equal(t, "3.0.8", UpstreamVersion(EcosystemAlpine, "3.0.8-r3"))
+}
@@ -120,6 +120,32 @@ type AiApiKeys struct {
 	FailureCount  int64     `json:"failure_count"`              // 失败请求数
 	LastUsedTime  time.Time `json:"last_used_time"`             // 上次使用时间
 	Active        bool      `json:"active" gorm:"default:true"` // 新增：API Key 激活状态
+
+	// 配额，0 表示不限制
+	RPMLimit        int64 `json:"rpm_limit"`         // 每分钟请求数上限
+	DailyTokenLimit int64 `json:"daily_token_limit"` // 每日 token 上限
+
+	// token 与费用统计
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	TotalCost    float64 `json:"total_cost"`
+}
+
+// AiApiKeyUsage 记录每一次请求的消耗，用于配额计算与消耗历史查询
+type AiApiKeyUsage struct {
+	gorm.Model
+	APIKey       string  `json:"api_key" gorm:"index"`
+	ModelName    string  `json:"model_name" gorm:"index"`
+	ProviderType string  `json:"provider_type"`
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	TotalTokens  int64   `json:"total_tokens"`
+	InputBytes   int64   `json:"input_bytes"`
+	OutputBytes  int64   `json:"output_bytes"`
+	Cost         float64 `json:"cost"`
+	Success      bool    `json:"success"`
+	// Estimated 上游没有返回 usage 字段，token 数由字节数估算
+	Estimated bool `json:"estimated"`
 }
 
 type LoginSession struct {
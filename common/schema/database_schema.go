@@ -63,9 +63,10 @@ var ProfileTables = []interface{}{
 	&HotPatchTemplate{},
 	&AIForge{},
 
-	&AiProvider{},   // for aibalance
-	&AiApiKeys{},    // for aibalance
-	&LoginSession{}, // for aibalance
+	&AiProvider{},    // for aibalance
+	&AiApiKeys{},     // for aibalance
+	&AiApiKeyUsage{}, // for aibalance
+	&LoginSession{},  // for aibalance
 	&AIYakTool{},
 }
 
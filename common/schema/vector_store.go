@@ -100,9 +100,25 @@ type VectorStoreDocument struct {
 
 	// 文档的嵌入向量，以JSON格式存储
 	Embedding FloatArray `gorm:"type:text" json:"embedding"`
+
+	// 文档内容，用于关键词检索
+	Content string `gorm:"type:text" json:"content"`
+
+	// 文档分词后的词数，用于计算 BM25
+	TermCount int `json:"term_count"`
+}
+
+// VectorStoreTerm 是关键词检索的倒排索引，记录词在文档中出现的次数
+type VectorStoreTerm struct {
+	gorm.Model
+
+	CollectionID uint   `json:"collection_id" gorm:"index"`
+	DocumentID   string `json:"document_id" gorm:"index"`
+	Term         string `json:"term" gorm:"index"`
+	Frequency    int    `json:"frequency"`
 }
 
 func init() {
 	// 注册到数据库模式中
-	RegisterDatabaseSchema(KEY_SCHEMA_PROFILE_DATABASE, &VectorStoreCollection{}, &VectorStoreDocument{})
+	RegisterDatabaseSchema(KEY_SCHEMA_PROFILE_DATABASE, &VectorStoreCollection{}, &VectorStoreDocument{}, &VectorStoreTerm{})
 }
@@ -0,0 +1,13 @@
+import sqlite3
+from flask import Flask, request
+
+app = Flask(__name__)
+
+
+@app.route("/user")
+def user():
+    uid = request.args.get("id")
+    conn = sqlite3.connect("app.db")
+    cursor = conn.cursor()
+    cursor.execute("select * from users where id = " + uid)
+    return str(cursor.fetchall())
@@ -0,0 +1,10 @@
+import requests
+from flask import Flask, request
+
+app = Flask(__name__)
+
+
+@app.route("/fetch")
+def fetch():
+    url = request.args.get("url")
+    return requests.get(url, timeout=3).text
@@ -7,6 +7,38 @@ import (
 	"time"
 )
 
+// getGatewayMacByTarget 根据目标地址的类型获取 IPv4 或 IPv6 网关的 MAC 地址
+func (s *Scannerx) getGatewayMacByTarget(host string) (net.HardwareAddr, error) {
+	if utils.IsIPv6(host) {
+		return s.getIPv6GatewayMac()
+	}
+	return s.getGatewayMac()
+}
+
+func (s *Scannerx) getIPv6GatewayMac() (net.HardwareAddr, error) {
+	if s.config.GatewayIPv6 == nil {
+		return nil, utils.Errorf("cannot fetch ipv6 gateway for %v", s.config.Iface.Name)
+	}
+	gateway := s.config.GatewayIPv6.String()
+	for retry := 0; retry <= 2; retry++ {
+		if dstHw, ok := s.macCacheTable.Load(gateway); ok {
+			if hw, ok := dstHw.(net.HardwareAddr); ok {
+				return hw, nil
+			}
+		}
+		// 通过 NDP 协议获取网关的 MAC 地址
+		s.arp(gateway)
+		time.Sleep(time.Millisecond * 50)
+	}
+	if dstHw, ok := s.macCacheTable.Load(gateway); ok {
+		if hw, ok := dstHw.(net.HardwareAddr); ok {
+			log.Debugf("use ndp proto to fetch ipv6 gateway's hw address: %s", hw)
+			return hw, nil
+		}
+	}
+	return nil, utils.Errorf("cannot fetch hw addr for ipv6 gateway %v[%v]", gateway, s.config.Iface.Name)
+}
+
 func (s *Scannerx) getGatewayMac() (net.HardwareAddr, error) {
 	if s.config.GatewayIP != nil {
 		gateway := s.config.GatewayIP.String()
@@ -34,7 +66,7 @@ func (s *Scannerx) onArp(ip net.IP, hw net.HardwareAddr) {
 	if s.MacHandlers != nil {
 		s.MacHandlers(ip, hw)
 	}
-	if s.config.SourceIP.Equal(ip) || s.config.GatewayIP.Equal(ip) {
+	if s.config.SourceIP.Equal(ip) || s.config.GatewayIP.Equal(ip) || s.config.GatewayIPv6.Equal(ip) {
 		s.macCacheTable.Store(ip.String(), hw)
 		return
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/yaklang/yaklang/common/fp"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/pcapx"
+	"github.com/yaklang/yaklang/common/pcapx/arpx"
 	"github.com/yaklang/yaklang/common/utils"
 	"math/rand"
 	"net"
@@ -36,7 +37,7 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 		// 外网扫描
 		if !isLoopback && !s.isInternalAddress(host) {
 			// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
-			dstMac, err = s.getGatewayMac()
+			dstMac, err = s.getGatewayMacByTarget(host)
 			if err != nil {
 				return nil, utils.Errorf("get gateway mac failed: %s", err)
 			}
@@ -73,7 +74,7 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 					case <-timeout:
 						log.Debugf("%s timeout waiting for ARP response", host)
 						s.macCacheTable.Store(host, fmt.Sprintf("%s timeout", host))
-						return nil, utils.Errorf("timeout waiting for %s ARP response", host)
+						return nil, utils.Errorf("timeout waiting for %s ARP/NDP response", host)
 					}
 				}
 			}
@@ -88,25 +89,14 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 		)
 	}
 
-	var ipSrc string
-	if isLoopback {
-		ipSrc = net.ParseIP("127.0.0.1").String()
-		host = ipSrc
-	} else {
-		ipSrc = s.config.SourceIP.String()
+	ipOpts, err := s.networkLayerOptions(host, isLoopback, layers.IPProtocolTCP)
+	if err != nil {
+		return nil, err
 	}
+	opts = append(opts, ipOpts...)
 	srcPort := rand.Intn(65534) + 1
 	// wireshark filter port
 	//srcPort := 52873
-	// IPv4
-	opts = append(opts, pcapx.WithIPv4_Flags(layers.IPv4DontFragment))
-	opts = append(opts, pcapx.WithIPv4_Version(4))
-	opts = append(opts, pcapx.WithIPv4_NextProtocol(layers.IPProtocolTCP))
-	opts = append(opts, pcapx.WithIPv4_TTL(64))
-	opts = append(opts, pcapx.WithIPv4_ID(40000+rand.Intn(10000)))
-	opts = append(opts, pcapx.WithIPv4_SrcIP(ipSrc))
-	opts = append(opts, pcapx.WithIPv4_DstIP(host))
-	opts = append(opts, pcapx.WithIPv4_Option(nil, nil))
 
 	// TCP
 	opts = append(opts,
@@ -126,6 +116,47 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 	return packetBytes, nil
 }
 
+// networkLayerOptions 根据目标地址类型构造 IPv4 或 IPv6 网络层
+func (s *Scannerx) networkLayerOptions(host string, isLoopback bool, protocol layers.IPProtocol) ([]any, error) {
+	var opts []any
+	if utils.IsIPv6(host) {
+		var ipSrc string
+		if isLoopback {
+			ipSrc = net.IPv6loopback.String()
+			host = ipSrc
+		} else {
+			srcIP, err := s.ipv6Source()
+			if err != nil {
+				return nil, err
+			}
+			ipSrc = srcIP.String()
+		}
+		// IPv6, next header is filled by the transport layer
+		opts = append(opts, pcapx.WithIPv6_HopLimit(64))
+		opts = append(opts, pcapx.WithIPv6_SrcIP(ipSrc))
+		opts = append(opts, pcapx.WithIPv6_DstIP(host))
+		return opts, nil
+	}
+
+	var ipSrc string
+	if isLoopback {
+		ipSrc = net.ParseIP("127.0.0.1").String()
+		host = ipSrc
+	} else {
+		ipSrc = s.config.SourceIP.String()
+	}
+	// IPv4
+	opts = append(opts, pcapx.WithIPv4_Flags(layers.IPv4DontFragment))
+	opts = append(opts, pcapx.WithIPv4_Version(4))
+	opts = append(opts, pcapx.WithIPv4_NextProtocol(protocol))
+	opts = append(opts, pcapx.WithIPv4_TTL(64))
+	opts = append(opts, pcapx.WithIPv4_ID(40000+rand.Intn(10000)))
+	opts = append(opts, pcapx.WithIPv4_SrcIP(ipSrc))
+	opts = append(opts, pcapx.WithIPv4_DstIP(host))
+	opts = append(opts, pcapx.WithIPv4_Option(nil, nil))
+	return opts, nil
+}
+
 func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 	isLoopback := utils.IsLoopback(host)
 
@@ -149,7 +180,7 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 		// 外网扫描
 		if !isLoopback && !s.isInternalAddress(host) {
 			// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
-			dstMac, err = s.getGatewayMac()
+			dstMac, err = s.getGatewayMacByTarget(host)
 			if err != nil {
 				return nil, utils.Errorf("get gateway mac failed: %s", err)
 			}
@@ -201,27 +232,15 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 		)
 	}
 
-	var ipSrc string
-	if isLoopback {
-		ipSrc = net.ParseIP("127.0.0.1").String()
-		host = ipSrc
-	} else {
-		ipSrc = s.config.SourceIP.String()
+	ipOpts, err := s.networkLayerOptions(host, isLoopback, layers.IPProtocolUDP)
+	if err != nil {
+		return nil, err
 	}
+	opts = append(opts, ipOpts...)
 	srcPort := rand.Intn(65534) + 1
 	// wireshark filter port
 	//srcPort := 52873
 
-	// IPv4
-	opts = append(opts, pcapx.WithIPv4_Flags(layers.IPv4DontFragment))
-	opts = append(opts, pcapx.WithIPv4_Version(4))
-	opts = append(opts, pcapx.WithIPv4_NextProtocol(layers.IPProtocolUDP))
-	opts = append(opts, pcapx.WithIPv4_TTL(64))
-	opts = append(opts, pcapx.WithIPv4_ID(40000+rand.Intn(10000)))
-	opts = append(opts, pcapx.WithIPv4_SrcIP(ipSrc))
-	opts = append(opts, pcapx.WithIPv4_DstIP(host))
-	opts = append(opts, pcapx.WithIPv4_Option(nil, nil))
-
 	// UDP
 	opts = append(opts, pcapx.WithUDP_SrcPort(srcPort))
 	opts = append(opts, pcapx.WithUDP_DstPort(port))
@@ -247,6 +266,9 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 }
 
 func (s *Scannerx) assembleArpPacket(host string) ([]byte, error) {
+	if utils.IsIPv6(host) {
+		return s.assembleNeighborSolicitationPacket(host)
+	}
 	var opts []any
 	srcMac := s.config.SourceMac.String()
 	srcIP := s.config.SourceIP.String()
@@ -262,3 +284,28 @@ func (s *Scannerx) assembleArpPacket(host string) ([]byte, error) {
 	}
 	return packetBytes, nil
 }
+
+// assembleNeighborSolicitationPacket 构造 IPv6 邻居请求报文，作用等同于 IPv4 的 ARP 请求
+func (s *Scannerx) assembleNeighborSolicitationPacket(host string) ([]byte, error) {
+	target := net.ParseIP(host)
+	if target == nil {
+		return nil, utils.Errorf("invalid ipv6 target: %s", host)
+	}
+	srcIP, err := s.ipv6Source()
+	if err != nil {
+		return nil, err
+	}
+	srcMac := s.config.SourceMac.String()
+	var opts []any
+	opts = append(opts, pcapx.WithEthernet_SrcMac(srcMac))
+	opts = append(opts, pcapx.WithEthernet_DstMac(arpx.SolicitedNodeMulticastMac(target)))
+	opts = append(opts, pcapx.WithIPv6_SrcIP(srcIP.String()))
+	opts = append(opts, pcapx.WithIPv6_DstIP(arpx.SolicitedNodeMulticastIP(target).String()))
+	opts = append(opts, pcapx.WithICMPv6_NeighborSolicitation(host, srcMac))
+
+	packetBytes, err := pcapx.PacketBuilder(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return packetBytes, nil
+}
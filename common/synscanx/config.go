@@ -39,6 +39,9 @@ type SynxConfig struct {
 	Iface     *net.Interface
 	GatewayIP net.IP
 	SourceIP  net.IP
+	// IPv6 目标使用的网关与源地址，在提交扫描目标时通过路由获取
+	GatewayIPv6 net.IP
+	SourceIPv6  net.IP
 	// 内网扫描时，目标机器的 MAC 地址来自 ARP
 	// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
 	SourceMac, RemoteMac net.HardwareAddr
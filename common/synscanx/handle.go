@@ -41,6 +41,10 @@ func handleError(err error) error {
 	}
 }
 
+// ipv6SynAckBPF 匹配 IPv6 的 SYN-ACK 以及邻居通告报文，libpcap 的 tcp[tcpflags] 只支持 IPv4，
+// 这里按照没有扩展头部的情况直接取 TCP flags 与 ICMPv6 type
+const ipv6SynAckBPF = "(ip6 && tcp && ip6[53] & 0x12 == 0x12) || (icmp6 && ip6[40] == 136)"
+
 func (s *Scannerx) initHandle() error {
 	if s.config.Iface == nil {
 		return utils.Errorf("iface is nil")
@@ -59,14 +63,14 @@ func (s *Scannerx) initHandle() error {
 	if s.config.Iface.Flags&net.FlagLoopback == 0 {
 		// Interface is not loopback, set the filter.
 		if s.config.Iface.HardwareAddr == nil || !strings.Contains(s.config.Iface.HardwareAddr.String(), ":") {
-			bpf = "arp || udp || tcp[tcpflags] == tcp-syn|tcp-ack"
+			bpf = "arp || udp || tcp[tcpflags] == tcp-syn|tcp-ack || " + ipv6SynAckBPF
 		} else {
-			bpf = fmt.Sprintf("ether dst %s && (arp || udp || tcp[tcpflags] == tcp-syn|tcp-ack)", s.config.Iface.HardwareAddr.String())
+			bpf = fmt.Sprintf("ether dst %s && (arp || udp || tcp[tcpflags] == tcp-syn|tcp-ack || %s)", s.config.Iface.HardwareAddr.String(), ipv6SynAckBPF)
 		}
 	} else {
 		// Interface is loopback, set a different filter.
 		// Replace the following line with the appropriate filter for your use case.
-		bpf = "udp || tcp[tcpflags] == tcp-syn|tcp-ack"
+		bpf = "udp || tcp[tcpflags] == tcp-syn|tcp-ack || " + ipv6SynAckBPF
 	}
 	err = handle.SetBPFFilter(bpf)
 	if err != nil {
@@ -98,7 +102,7 @@ func (s *Scannerx) initHandlerStart(ctx context.Context) error {
 		if s.config.Iface != nil {
 			adapters = append(adapters, &pcaputil.DeviceAdapter{
 				DeviceName: s.config.Iface.Name,
-				BPF:        fmt.Sprintf("ether dst %s && (arp || udp  || tcp[tcpflags] == tcp-syn|tcp-ack)", s.config.Iface.HardwareAddr.String()),
+				BPF:        fmt.Sprintf("ether dst %s && (arp || udp  || tcp[tcpflags] == tcp-syn|tcp-ack || %s)", s.config.Iface.HardwareAddr.String(), ipv6SynAckBPF),
 				Snaplen:    128,
 				Promisc:    false,
 				Timeout:    pcap.BlockForever,
@@ -109,7 +113,7 @@ func (s *Scannerx) initHandlerStart(ctx context.Context) error {
 		if err == nil {
 			adapters = append(adapters, &pcaputil.DeviceAdapter{
 				DeviceName: loop.Name,
-				BPF:        "udp || tcp[tcpflags] == tcp-syn|tcp-ack",
+				BPF:        "udp || tcp[tcpflags] == tcp-syn|tcp-ack || " + ipv6SynAckBPF,
 				Snaplen:    128,
 				Promisc:    false,
 				Timeout:    pcap.BlockForever,
@@ -256,6 +260,23 @@ func (s *Scannerx) handlePacket(packet gopacket.Packet) {
 		}
 	}
 
+	if naLayer := packet.Layer(layers.LayerTypeICMPv6NeighborAdvertisement); naLayer != nil {
+		na, ok := naLayer.(*layers.ICMPv6NeighborAdvertisement)
+		if !ok {
+			return
+		}
+		for _, opt := range na.Options {
+			if opt.Type == layers.ICMPv6OptTargetAddress && len(opt.Data) >= 6 {
+				s.onArp(na.TargetAddress, net.HardwareAddr(opt.Data[:6]))
+				return
+			}
+		}
+		if eth, ok := packet.LinkLayer().(*layers.Ethernet); ok {
+			s.onArp(na.TargetAddress, eth.SrcMAC)
+		}
+		return
+	}
+
 	//if icmpLayer := packet.Layer(layers.LayerTypeICMPv4); icmpLayer != nil {
 	//	icmp := icmpLayer.(*layers.ICMPv4)
 	//
@@ -51,6 +51,9 @@ type Scannerx struct {
 	ifaceIPNetV6 *net.IPNet
 	ifaceUpdated bool
 
+	ipv6InitOnce sync.Once
+	ipv6InitErr  error
+
 	Handle    *pcap.Handle
 	limiter   *rate.Limiter
 	startTime time.Time
@@ -154,11 +157,87 @@ func (s *Scannerx) initEssentialInfo() error {
 	s.config.SourceMac = iface.HardwareAddr
 	s.config.GatewayIP = gatewayIP
 
+	// 以 IPv6 地址作为取样地址时，路由得到的就是 IPv6 的源地址与网关
+	if srcIP.To4() == nil {
+		s.config.SourceIPv6 = srcIP
+		if gatewayIP != nil && gatewayIP.To4() == nil {
+			s.config.GatewayIPv6 = gatewayIP
+		}
+	}
+
 	// 不确定扫描目标中是否存在回环地址，所以这里先初始化一个回环地址的映射表
 	s.loopbackMap["127.0.0.1"] = s.config.SourceIP.String()
+	if s.config.SourceIPv6 != nil {
+		s.loopbackMap["::1"] = s.config.SourceIPv6.String()
+	}
 	return nil
 }
 
+// defaultIPv6RouteProbe 在无法预知 IPv6 目标时，用于查询 IPv6 默认路由的地址
+const defaultIPv6RouteProbe = "2001:4860:4860::8888"
+
+// initIPv6Info 获取扫描 IPv6 目标所需的源地址与网关，只执行一次，失败时不影响 IPv4 目标的扫描
+// 它会写入 config 与 loopbackMap，必须在发包与收包协程启动之前调用
+func (s *Scannerx) initIPv6Info(target string) error {
+	s.ipv6InitOnce.Do(func() {
+		if s.config.SourceIPv6 != nil {
+			return
+		}
+		_, gatewayIP, srcIP, err := netutil.Route(time.Second*2, target)
+		if err != nil {
+			log.Debugf("get ipv6 route for %s failed: %s", target, err)
+		}
+		if gatewayIP != nil && gatewayIP.To4() == nil {
+			s.config.GatewayIPv6 = gatewayIP
+		}
+		if srcIP != nil && srcIP.To4() == nil {
+			s.config.SourceIPv6 = srcIP
+		} else {
+			s.config.SourceIPv6 = s.ifaceIPv6Address(net.ParseIP(target))
+		}
+		if s.config.SourceIPv6 == nil {
+			s.ipv6InitErr = utils.Errorf("iface: %s has no ipv6 addrs", s.config.Iface.Name)
+			return
+		}
+		s.loopbackMap["::1"] = s.config.SourceIPv6.String()
+		log.Infof("ipv6 scan use src: %v gateway: %v", s.config.SourceIPv6, s.config.GatewayIPv6)
+	})
+	return s.ipv6InitErr
+}
+
+// ipv6Source 返回 initIPv6Info 得到的 IPv6 源地址
+func (s *Scannerx) ipv6Source() (net.IP, error) {
+	if s.ipv6InitErr != nil {
+		return nil, s.ipv6InitErr
+	}
+	if s.config.SourceIPv6 == nil {
+		return nil, utils.Errorf("ipv6 source address of %s is not initialized", s.config.Iface.Name)
+	}
+	return s.config.SourceIPv6, nil
+}
+
+// ifaceIPv6Address 挑选网卡上的 IPv6 地址，优先与目标同网段的地址，其次全局地址
+func (s *Scannerx) ifaceIPv6Address(target net.IP) net.IP {
+	addrs, err := s.config.Iface.Addrs()
+	if err != nil {
+		return nil
+	}
+	var ret net.IP
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.To4() != nil || ipNet.IP.To16() == nil {
+			continue
+		}
+		if target != nil && ipNet.Contains(target) {
+			return ipNet.IP
+		}
+		if ret == nil || (ret.IsLinkLocalUnicast() && !ipNet.IP.IsLinkLocalUnicast()) {
+			ret = ipNet.IP
+		}
+	}
+	return ret
+}
+
 func (s *Scannerx) rateLimit() {
 	s.limiter.Wait(s.ctx)
 }
@@ -183,6 +262,14 @@ func (s *Scannerx) SubmitTarget(targets, ports string) (<-chan *SynxTarget, erro
 		s.cancel()
 		return nil, errors.New("targets or ports is empty")
 	}
+	for _, host := range nonExcludedHosts {
+		if utils.IsIPv6(host) {
+			if err := s.initIPv6Info(host); err != nil {
+				log.Warnf("ipv6 targets will be skipped: %s", err)
+			}
+			break
+		}
+	}
 	s.OnSubmitTask(func(h string, p int) {
 		s.config.callSubmitTaskCallback(utils.HostPort(h, p))
 	})
@@ -229,6 +316,14 @@ func (s *Scannerx) SubmitTarget(targets, ports string) (<-chan *SynxTarget, erro
 func (s *Scannerx) SubmitTargetFromPing(res chan string, ports string) <-chan *SynxTarget {
 	tgCh := make(chan *SynxTarget)
 	nonExcludedPorts := s.GetNonExcludedPorts(ports)
+	// 存活主机是逐个到达的，无法预知是否存在 IPv6 目标，这里先按默认路由准备好 IPv6 的源地址与网关
+	probe := s.sampleIP
+	if !utils.IsIPv6(probe) {
+		probe = defaultIPv6RouteProbe
+	}
+	if err := s.initIPv6Info(probe); err != nil {
+		log.Debugf("ipv6 targets will be skipped: %s", err)
+	}
 
 	s.OnSubmitTask(func(h string, p int) {
 		s.config.callSubmitTaskCallback(utils.HostPort(h, p))
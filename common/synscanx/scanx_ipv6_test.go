@@ -0,0 +1,101 @@
+package synscanx
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/pcapx/arpx"
+)
+
+var (
+	testSourceMac  = net.HardwareAddr{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+	testGatewayMac = net.HardwareAddr{0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb}
+)
+
+func newIPv6TestScanner(t *testing.T) *Scannerx {
+	s := &Scannerx{
+		ctx: context.Background(),
+		config: &SynxConfig{
+			Iface:       &net.Interface{Name: "test0"},
+			SourceMac:   testSourceMac,
+			RemoteMac:   testGatewayMac,
+			SourceIPv6:  net.ParseIP("2001:db8::1"),
+			GatewayIPv6: net.ParseIP("2001:db8::fffe"),
+		},
+		macCacheTable: new(sync.Map),
+		loopbackMap:   make(map[string]string),
+	}
+	require.NoError(t, s.initIPv6Info("2001:db8::2"))
+	return s
+}
+
+func decodeIPv6Packet(t *testing.T, raw []byte, src, dst string) gopacket.Packet {
+	packet := gopacket.NewPacket(raw, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	ip6, ok := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	require.True(t, ok, "expect ipv6 layer")
+	require.Equal(t, src, ip6.SrcIP.String())
+	require.Equal(t, dst, ip6.DstIP.String())
+	return packet
+}
+
+func TestScannerx_IPv6Syn(t *testing.T) {
+	s := newIPv6TestScanner(t)
+	raw, err := s.assemblePacket("2001:db8::2", 443, TCP)
+	require.NoError(t, err)
+
+	packet := decodeIPv6Packet(t, raw, "2001:db8::1", "2001:db8::2")
+	eth := packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+	require.Equal(t, testSourceMac, eth.SrcMAC)
+	require.Equal(t, testGatewayMac, eth.DstMAC)
+	tcp, ok := packet.Layer(layers.LayerTypeTCP).(*layers.TCP)
+	require.True(t, ok, "expect tcp layer")
+	require.Equal(t, layers.TCPPort(443), tcp.DstPort)
+	require.True(t, tcp.SYN)
+	require.False(t, tcp.ACK)
+}
+
+func TestScannerx_IPv6Udp(t *testing.T) {
+	s := newIPv6TestScanner(t)
+	raw, err := s.assemblePacket("2001:db8::2", 53, UDP)
+	require.NoError(t, err)
+
+	packet := decodeIPv6Packet(t, raw, "2001:db8::1", "2001:db8::2")
+	udp, ok := packet.Layer(layers.LayerTypeUDP).(*layers.UDP)
+	require.True(t, ok, "expect udp layer")
+	require.Equal(t, layers.UDPPort(53), udp.DstPort)
+}
+
+func TestScannerx_IPv6NeighborSolicitation(t *testing.T) {
+	s := newIPv6TestScanner(t)
+	target := net.ParseIP("2001:db8::2")
+	raw, err := s.assemblePacket(target.String(), 0, ARP)
+	require.NoError(t, err)
+
+	packet := decodeIPv6Packet(t, raw, "2001:db8::1", arpx.SolicitedNodeMulticastIP(target).String())
+	eth := packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+	require.Equal(t, arpx.SolicitedNodeMulticastMac(target), eth.DstMAC)
+	ns, ok := packet.Layer(layers.LayerTypeICMPv6NeighborSolicitation).(*layers.ICMPv6NeighborSolicitation)
+	require.True(t, ok, "expect icmpv6 neighbor solicitation layer")
+	require.Equal(t, target.String(), ns.TargetAddress.String())
+}
+
+func TestScannerx_IPv6InfoNotResolvedBySender(t *testing.T) {
+	// 发包协程不再惰性获取 IPv6 信息，未初始化时直接报错，避免与收包协程并发读写 loopbackMap
+	s := &Scannerx{
+		ctx:           context.Background(),
+		config:        &SynxConfig{Iface: &net.Interface{Name: "test0"}, SourceMac: testSourceMac, RemoteMac: testGatewayMac},
+		macCacheTable: new(sync.Map),
+		loopbackMap:   make(map[string]string),
+	}
+	_, err := s.assemblePacket("2001:db8::2", 443, TCP)
+	require.Error(t, err)
+	_, err = s.assemblePacket("2001:db8::2", 0, ARP)
+	require.Error(t, err)
+	require.Empty(t, s.loopbackMap)
+}
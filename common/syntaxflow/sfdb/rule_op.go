@@ -233,6 +233,10 @@ func CheckSyntaxFlowLanguage(languageRaw string) (consts.Language, error) {
 		return consts.JS, nil
 	case "golang", "go":
 		return consts.GO, nil
+	case "python", "py", "python3":
+		return consts.PYTHON, nil
+	case "rust", "rs":
+		return consts.RUST, nil
 	case "general":
 		return consts.General, nil
 	}
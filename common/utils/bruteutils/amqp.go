@@ -0,0 +1,86 @@
+package bruteutils
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// amqpAnonymousAuth is the ANONYMOUS sasl mechanism of rabbitmq
+type amqpAnonymousAuth struct{}
+
+func (*amqpAnonymousAuth) Mechanism() string { return "ANONYMOUS" }
+func (*amqpAnonymousAuth) Response() string  { return "" }
+
+func AMQPAuth(target string, auth amqp.Authentication) (bool, error) {
+	config := amqp.Config{
+		SASL:  []amqp.Authentication{auth},
+		Vhost: "/",
+		Dial: func(network, addr string) (net.Conn, error) {
+			conn, err := defaultDialer.DialContext(utils.TimeoutContext(defaultTimeout), network, addr)
+			if err != nil {
+				return nil, err
+			}
+			_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+			return conn, nil
+		},
+	}
+	conn, err := amqp.DialConfig(fmt.Sprintf("amqp://%s/", target), config)
+	if err != nil {
+		var amqpErr *amqp.Error
+		if errors.As(err, &amqpErr) {
+			// credentials refused, mechanism not supported or vhost not allowed
+			return false, nil
+		}
+		return false, err
+	}
+	_ = conn.Close()
+	return true, nil
+}
+
+var amqpAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "amqp",
+	DefaultPorts:     "5672",
+	DefaultUsernames: append([]string{"guest", "admin", "rabbitmq", "mq"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"guest", "rabbitmq", "admin"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 5672)
+		result := i.Result()
+
+		ok, err := AMQPAuth(i.Target, &amqpAnonymousAuth{})
+		if err != nil {
+			log.Errorf("amqp unauth verify failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if ok {
+			result.Ok = true
+			result.Username = ""
+			result.Password = ""
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 5672)
+		result := i.Result()
+
+		ok, err := AMQPAuth(i.Target, &amqp.PlainAuth{
+			Username: i.Username,
+			Password: i.Password,
+		})
+		if err != nil {
+			log.Errorf("amqp brute failed: %s", err)
+			if _, isNetErr := err.(net.Error); isNetErr {
+				result.Finished = true
+			}
+			return result
+		}
+		result.Ok = ok
+		return result
+	},
+}
@@ -68,6 +68,13 @@ var AuthFunctionMap = []struct {
 	{Name: "socks_proxy/v4a", Data: "socks4a_proxy"},
 	{Name: "pptp", Data: "pptp"},
 	{Name: "ldap", Data: "ldap"},
+	{Name: "mqtt", Data: "mqtt"},
+	{Name: "amqp", Data: "amqp"},
+	{Name: "kafka", Data: "kafka"},
+	{Name: "elasticsearch", Data: "elasticsearch"},
+	{Name: "couchdb", Data: "couchdb"},
+	{Name: "influxdb", Data: "influxdb"},
+	{Name: "clickhouse", Data: "clickhouse"},
 }
 
 // rdp https://palm/common/utils/bruteutils/grdp
@@ -103,6 +110,13 @@ var authFunc = map[string]*DefaultServiceAuthInfo{
 	"socks4a_proxy":  SocksProxyBruteAuthFactory("socks4a"),
 	"pptp":           pptp_Auth,
 	"ldap":           ldapAuth,
+	"mqtt":           mqttAuth,
+	"amqp":           amqpAuth,
+	"kafka":          kafkaAuth,
+	"elasticsearch":  elasticsearchAuth,
+	"couchdb":        couchdbAuth,
+	"influxdb":       influxdbAuth,
+	"clickhouse":     clickhouseAuth,
 }
 
 func GetUsernameListFromBruteType(t string) []string {
@@ -0,0 +1,60 @@
+package bruteutils
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/yaklang/yaklang/common/log"
+)
+
+const clickhouseProbeQuery = "/?query=SELECT+1"
+
+var clickhouseAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "clickhouse",
+	DefaultPorts:     "8123",
+	DefaultUsernames: append([]string{"default", "clickhouse"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"clickhouse"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 8123)
+		result := i.Result()
+
+		// without credentials the http interface uses the `default` user
+		rsp, err := httpServiceRequest(i.Target, http.MethodGet, clickhouseProbeQuery, "", "", nil, nil)
+		if err != nil {
+			log.Errorf("clickhouse unauth verify failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if isClickhouseProbeResult(rsp) {
+			result.Ok = true
+			return result
+		}
+		if rsp.Header("X-ClickHouse-Exception-Code") == "" {
+			// not a clickhouse service
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 8123)
+		result := i.Result()
+
+		rsp, err := httpServiceRequest(i.Target, http.MethodGet, clickhouseProbeQuery, "", "", map[string]string{
+			"X-ClickHouse-User": i.Username,
+			"X-ClickHouse-Key":  i.Password,
+		}, nil)
+		if err != nil {
+			log.Errorf("clickhouse brute failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if isClickhouseProbeResult(rsp) {
+			result.Ok = true
+		}
+		return result
+	},
+}
+
+func isClickhouseProbeResult(rsp *httpServiceResponse) bool {
+	return rsp.StatusCode == http.StatusOK && bytes.Equal(bytes.TrimSpace(rsp.Body()), []byte("1"))
+}
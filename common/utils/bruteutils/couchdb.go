@@ -0,0 +1,55 @@
+package bruteutils
+
+import (
+	"net/http"
+
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/log"
+)
+
+var couchdbAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "couchdb",
+	DefaultPorts:     "5984",
+	DefaultUsernames: append([]string{"admin", "couchdb"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"couchdb", "password"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 5984)
+		result := i.Result()
+
+		// "admin party": every request is handled as the server admin before 3.0
+		rsp, err := httpServiceRequest(i.Target, http.MethodGet, "/_all_dbs", "", "", nil, nil)
+		if err != nil {
+			log.Errorf("couchdb unauth verify failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		body := rsp.Body()
+		if rsp.StatusCode == http.StatusOK && gjson.ValidBytes(body) && gjson.ParseBytes(body).IsArray() {
+			result.Ok = true
+			return result
+		}
+		if rsp.StatusCode != http.StatusUnauthorized {
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 5984)
+		result := i.Result()
+
+		rsp, err := httpServiceRequest(i.Target, http.MethodGet, "/_session", i.Username, i.Password, nil, nil)
+		if err != nil {
+			log.Errorf("couchdb brute failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if rsp.StatusCode != http.StatusOK {
+			return result
+		}
+		// the session of an anonymous user has a null name
+		if gjson.GetBytes(rsp.Body(), "userCtx.name").String() == i.Username {
+			result.Ok = true
+		}
+		return result
+	},
+}
@@ -0,0 +1,55 @@
+package bruteutils
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/yaklang/yaklang/common/log"
+)
+
+var elasticsearchAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "elasticsearch",
+	DefaultPorts:     "9200",
+	DefaultUsernames: append([]string{"elastic", "admin", "kibana", "kibana_system", "logstash_system"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"changeme", "elastic", "admin"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 9200)
+		result := i.Result()
+
+		rsp, err := httpServiceRequest(i.Target, http.MethodGet, "/", "", "", nil, nil)
+		if err != nil {
+			log.Errorf("elasticsearch unauth verify failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if rsp.StatusCode == http.StatusOK && isElasticsearchBanner(rsp.Body()) {
+			result.Ok = true
+			return result
+		}
+		if rsp.StatusCode != http.StatusUnauthorized && rsp.StatusCode != http.StatusForbidden {
+			// not an elasticsearch / opensearch service
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 9200)
+		result := i.Result()
+
+		rsp, err := httpServiceRequest(i.Target, http.MethodGet, "/", i.Username, i.Password, nil, nil)
+		if err != nil {
+			log.Errorf("elasticsearch brute failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if rsp.StatusCode == http.StatusOK && isElasticsearchBanner(rsp.Body()) {
+			result.Ok = true
+		}
+		return result
+	},
+}
+
+// isElasticsearchBanner checks the banner of `GET /`, opensearch keeps the same format with elasticsearch
+func isElasticsearchBanner(body []byte) bool {
+	return bytes.Contains(body, []byte(`"cluster_name"`)) && bytes.Contains(body, []byte(`"version"`))
+}
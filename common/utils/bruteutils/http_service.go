@@ -0,0 +1,68 @@
+package bruteutils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+var httpServiceTlsTTLCache = utils.NewTTLCache[bool](30 * time.Minute)
+
+// httpServiceResponse is the response of a http based service (elasticsearch, couchdb, influxdb, clickhouse)
+type httpServiceResponse struct {
+	StatusCode int
+	Raw        []byte
+}
+
+func (r *httpServiceResponse) Header(key string) string {
+	return lowhttp.GetHTTPPacketHeader(r.Raw, key)
+}
+
+func (r *httpServiceResponse) Body() []byte {
+	return lowhttp.GetHTTPPacketBody(r.Raw)
+}
+
+// httpServiceRequest sends a request to a http based service, the username and password are
+// sent with basic auth when username is not empty. the tls check of a target is cached.
+func httpServiceRequest(target string, method string, path string, username, password string, headers map[string]string, body []byte) (*httpServiceResponse, error) {
+	host, port, err := utils.ParseStringToHostPort(target)
+	if err != nil {
+		return nil, err
+	}
+	addr := utils.HostPort(host, port)
+	isTls, ok := httpServiceTlsTTLCache.Get(addr)
+	if !ok {
+		isTls = netx.IsTLSService(addr)
+		httpServiceTlsTTLCache.Set(addr, isTls)
+	}
+
+	packet := []byte(fmt.Sprintf("%s %s HTTP/1.1\r\nHost: %s\r\nUser-Agent: %s\r\nAccept: */*\r\n\r\n", method, path, addr, consts.DefaultUserAgent))
+	if username != "" {
+		packet = lowhttp.ReplaceHTTPPacketBasicAuth(packet, username, password)
+	}
+	for k, v := range headers {
+		packet = lowhttp.ReplaceHTTPPacketHeader(packet, k, v)
+	}
+	if len(body) > 0 {
+		packet = lowhttp.ReplaceHTTPPacketBody(packet, body, false)
+	}
+
+	rsp, err := lowhttp.HTTP(
+		lowhttp.WithHttps(isTls),
+		lowhttp.WithHost(host),
+		lowhttp.WithPort(port),
+		lowhttp.WithTimeout(defaultTimeout),
+		lowhttp.WithRequest(packet),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &httpServiceResponse{
+		StatusCode: lowhttp.GetStatusCodeFromResponse(rsp.RawPacket),
+		Raw:        rsp.RawPacket,
+	}, nil
+}
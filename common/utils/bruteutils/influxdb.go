@@ -0,0 +1,74 @@
+package bruteutils
+
+import (
+	"net/http"
+
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/log"
+)
+
+const influxdbShowDatabases = "/query?q=SHOW+DATABASES"
+
+var influxdbAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "influxdb",
+	DefaultPorts:     "8086",
+	DefaultUsernames: append([]string{"admin", "influx", "influxdb"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"influxdb", "admin", "password"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 8086)
+		result := i.Result()
+
+		rsp, err := httpServiceRequest(i.Target, http.MethodGet, influxdbShowDatabases, "", "", nil, nil)
+		if err != nil {
+			log.Errorf("influxdb unauth verify failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if rsp.Header("X-Influxdb-Version") == "" {
+			// not an influxdb service
+			result.Finished = true
+			return result
+		}
+		if isInfluxdbQueryResult(rsp) {
+			result.Ok = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 8086)
+		result := i.Result()
+
+		rsp, err := httpServiceRequest(i.Target, http.MethodGet, influxdbShowDatabases, i.Username, i.Password, nil, nil)
+		if err != nil {
+			log.Errorf("influxdb brute failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if isInfluxdbQueryResult(rsp) {
+			result.Ok = true
+			return result
+		}
+		if rsp.StatusCode != http.StatusNotFound {
+			return result
+		}
+
+		// influxdb 2.x has no v1 query api without dbrp mapping, sign in with the v2 api instead
+		rsp, err = httpServiceRequest(i.Target, http.MethodPost, "/api/v2/signin", i.Username, i.Password, nil, nil)
+		if err != nil {
+			log.Errorf("influxdb v2 brute failed: %s", err)
+			return result
+		}
+		if rsp.StatusCode == http.StatusNoContent {
+			result.Ok = true
+		}
+		return result
+	},
+}
+
+func isInfluxdbQueryResult(rsp *httpServiceResponse) bool {
+	if rsp.StatusCode != http.StatusOK {
+		return false
+	}
+	body := rsp.Body()
+	return gjson.GetBytes(body, "results").Exists() && !gjson.GetBytes(body, "results.0.error").Exists()
+}
@@ -0,0 +1,288 @@
+package bruteutils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	"github.com/xdg-go/scram"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	kafkaApiMetadata         = 3
+	kafkaApiSaslHandshake    = 17
+	kafkaApiSaslAuthenticate = 36
+
+	kafkaErrUnsupportedSaslMechanism = 33
+)
+
+// kafkaConn is a minimal kafka wire protocol client, it only knows the requests used by brute
+type kafkaConn struct {
+	net.Conn
+	correlationId int32
+}
+
+func dialKafka(target string) (*kafkaConn, error) {
+	conn, err := defaultDialer.DialContext(utils.TimeoutContext(defaultTimeout), "tcp", target)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+	return &kafkaConn{Conn: conn}, nil
+}
+
+func kafkaWriteString(buf *bytes.Buffer, s string) {
+	_ = binary.Write(buf, binary.BigEndian, int16(len(s)))
+	buf.WriteString(s)
+}
+
+func kafkaWriteBytes(buf *bytes.Buffer, b []byte) {
+	_ = binary.Write(buf, binary.BigEndian, int32(len(b)))
+	buf.Write(b)
+}
+
+// request sends a request with the v1 request header and returns the response body
+func (c *kafkaConn) request(apiKey, apiVersion int16, body []byte) (*bytes.Reader, error) {
+	c.correlationId++
+	var header bytes.Buffer
+	_ = binary.Write(&header, binary.BigEndian, apiKey)
+	_ = binary.Write(&header, binary.BigEndian, apiVersion)
+	_ = binary.Write(&header, binary.BigEndian, c.correlationId)
+	kafkaWriteString(&header, "yak")
+	header.Write(body)
+
+	var packet bytes.Buffer
+	_ = binary.Write(&packet, binary.BigEndian, int32(header.Len()))
+	packet.Write(header.Bytes())
+	if _, err := c.Write(packet.Bytes()); err != nil {
+		return nil, err
+	}
+
+	var size int32
+	if err := binary.Read(c, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+	if size < 4 || size > 16*1024*1024 {
+		return nil, utils.Errorf("invalid kafka response size: %d", size)
+	}
+	rsp := make([]byte, size)
+	if _, err := io.ReadFull(c, rsp); err != nil {
+		return nil, err
+	}
+	if id := int32(binary.BigEndian.Uint32(rsp[:4])); id != c.correlationId {
+		return nil, utils.Errorf("kafka correlation id mismatch: %d != %d", id, c.correlationId)
+	}
+	return bytes.NewReader(rsp[4:]), nil
+}
+
+func kafkaReadString(r *bytes.Reader) (string, error) {
+	var length int16
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return "", err
+	}
+	if length < 0 {
+		return "", nil
+	}
+	s := make([]byte, length)
+	if _, err := io.ReadFull(r, s); err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
+
+func kafkaReadBytes(r *bytes.Reader) ([]byte, error) {
+	var length int32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return nil, nil
+	}
+	if int(length) > r.Len() {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := make([]byte, length)
+	_, err := io.ReadFull(r, b)
+	return b, err
+}
+
+// metadata sends a Metadata v0 request for all topics, a broker requiring sasl closes the connection
+func (c *kafkaConn) metadata() error {
+	_, err := c.request(kafkaApiMetadata, 0, []byte{0, 0, 0, 0})
+	return err
+}
+
+// saslHandshake returns the error code and the mechanisms enabled by the broker
+func (c *kafkaConn) saslHandshake(mechanism string) (int16, []string, error) {
+	var body bytes.Buffer
+	kafkaWriteString(&body, mechanism)
+	rsp, err := c.request(kafkaApiSaslHandshake, 1, body.Bytes())
+	if err != nil {
+		return 0, nil, err
+	}
+	var code int16
+	var count int32
+	if err := binary.Read(rsp, binary.BigEndian, &code); err != nil {
+		return 0, nil, err
+	}
+	if err := binary.Read(rsp, binary.BigEndian, &count); err != nil {
+		return 0, nil, err
+	}
+	var mechanisms []string
+	for i := int32(0); i < count; i++ {
+		m, err := kafkaReadString(rsp)
+		if err != nil {
+			return 0, nil, err
+		}
+		mechanisms = append(mechanisms, m)
+	}
+	return code, mechanisms, nil
+}
+
+// saslAuthenticate returns the error code and the auth bytes of the broker
+func (c *kafkaConn) saslAuthenticate(authBytes []byte) (int16, []byte, error) {
+	var body bytes.Buffer
+	kafkaWriteBytes(&body, authBytes)
+	rsp, err := c.request(kafkaApiSaslAuthenticate, 0, body.Bytes())
+	if err != nil {
+		return 0, nil, err
+	}
+	var code int16
+	if err := binary.Read(rsp, binary.BigEndian, &code); err != nil {
+		return 0, nil, err
+	}
+	if _, err := kafkaReadString(rsp); err != nil {
+		return 0, nil, err
+	}
+	serverBytes, err := kafkaReadBytes(rsp)
+	if err != nil {
+		return 0, nil, err
+	}
+	return code, serverBytes, nil
+}
+
+func (c *kafkaConn) scramAuth(hash scram.HashGeneratorFcn, username, password string) (bool, error) {
+	client, err := hash.NewClient(username, password, "")
+	if err != nil {
+		return false, err
+	}
+	conv := client.NewConversation()
+	msg, err := conv.Step("")
+	for err == nil && !conv.Done() {
+		code, serverBytes, authErr := c.saslAuthenticate([]byte(msg))
+		if authErr != nil {
+			return false, authErr
+		}
+		if code != 0 {
+			return false, nil
+		}
+		msg, err = conv.Step(string(serverBytes))
+	}
+	if err != nil {
+		// the server signature is invalid
+		return false, nil
+	}
+	return conv.Valid(), nil
+}
+
+// KafkaAuth authenticates with SASL/PLAIN, and SCRAM-SHA-256/512 when PLAIN is not enabled
+func KafkaAuth(target, username, password string) (bool, error) {
+	conn, err := dialKafka(target)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	code, mechanisms, err := conn.saslHandshake("PLAIN")
+	if err != nil {
+		return false, err
+	}
+	switch code {
+	case 0:
+		code, _, err := conn.saslAuthenticate([]byte("\x00" + username + "\x00" + password))
+		if err != nil {
+			return false, err
+		}
+		return code == 0, nil
+	case kafkaErrUnsupportedSaslMechanism:
+	default:
+		return false, utils.Errorf("kafka sasl handshake failed with error code: %d", code)
+	}
+
+	for _, mechanism := range mechanisms {
+		var hash scram.HashGeneratorFcn
+		switch mechanism {
+		case "SCRAM-SHA-256":
+			hash = scram.SHA256
+		case "SCRAM-SHA-512":
+			hash = scram.SHA512
+		default:
+			continue
+		}
+		// the handshake can only be done once in a connection
+		scramConn, err := dialKafka(target)
+		if err != nil {
+			return false, err
+		}
+		ok, err := func() (bool, error) {
+			defer scramConn.Close()
+			code, _, err := scramConn.saslHandshake(mechanism)
+			if err != nil {
+				return false, err
+			}
+			if code != 0 {
+				return false, nil
+			}
+			return scramConn.scramAuth(hash, username, password)
+		}()
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+var kafkaAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "kafka",
+	DefaultPorts:     "9092",
+	DefaultUsernames: append([]string{"admin", "kafka", "client", "user"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"admin-secret", "kafka", "client-secret", "password"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 9092)
+		result := i.Result()
+
+		conn, err := dialKafka(i.Target)
+		if err != nil {
+			log.Errorf("kafka unauth verify failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		defer conn.Close()
+
+		if err := conn.metadata(); err != nil {
+			log.Debugf("kafka metadata request failed: %s", err)
+			return result
+		}
+		result.Ok = true
+		result.Username = ""
+		result.Password = ""
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 9092)
+		result := i.Result()
+
+		ok, err := KafkaAuth(i.Target, i.Username, i.Password)
+		if err != nil {
+			log.Errorf("kafka brute failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		result.Ok = ok
+		return result
+	},
+}
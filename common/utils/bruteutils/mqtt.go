@@ -0,0 +1,135 @@
+package bruteutils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	mqttConnAckAccepted          = 0x00
+	mqttConnAckBadUsernameOrPass = 0x04
+	mqttConnAckNotAuthorized     = 0x05
+)
+
+func mqttString(buf *bytes.Buffer, s string) {
+	_ = binary.Write(buf, binary.BigEndian, uint16(len(s)))
+	buf.WriteString(s)
+}
+
+// mqttConnectPacket builds a MQTT 3.1.1 CONNECT packet with a clean session
+func mqttConnectPacket(clientId, username, password string, withAuth bool) []byte {
+	var payload bytes.Buffer
+	mqttString(&payload, "MQTT")
+	payload.WriteByte(0x04) // protocol level 3.1.1
+
+	var flags byte = 0x02 // clean session
+	if withAuth {
+		flags |= 0x80
+		if password != "" {
+			flags |= 0x40
+		}
+	}
+	payload.WriteByte(flags)
+	_ = binary.Write(&payload, binary.BigEndian, uint16(30)) // keep alive
+	mqttString(&payload, clientId)
+	if withAuth {
+		mqttString(&payload, username)
+		if password != "" {
+			mqttString(&payload, password)
+		}
+	}
+
+	var packet bytes.Buffer
+	packet.WriteByte(0x10)
+	// remaining length, variable byte integer
+	length := payload.Len()
+	for {
+		b := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			b |= 0x80
+		}
+		packet.WriteByte(b)
+		if length == 0 {
+			break
+		}
+	}
+	packet.Write(payload.Bytes())
+	return packet.Bytes()
+}
+
+// MQTTAuth sends a CONNECT packet and returns the return code of CONNACK
+func MQTTAuth(target, username, password string, withAuth bool) (byte, error) {
+	conn, err := defaultDialer.DialContext(utils.TimeoutContext(defaultTimeout), "tcp", target)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	clientId := "yak" + utils.RandStringBytes(8)
+	if _, err := conn.Write(mqttConnectPacket(clientId, username, password, withAuth)); err != nil {
+		return 0, err
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(defaultTimeout))
+	connAck := make([]byte, 4)
+	if _, err := io.ReadFull(conn, connAck); err != nil {
+		return 0, err
+	}
+	if connAck[0] != 0x20 || connAck[1] != 0x02 {
+		return 0, utils.Errorf("invalid mqtt connack: %x", connAck)
+	}
+	return connAck[3], nil
+}
+
+var mqttAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "mqtt",
+	DefaultPorts:     "1883",
+	DefaultUsernames: append([]string{"admin", "mqtt", "guest", "user", "emqx"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"public", "mqtt", "guest", "password", "emqx"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 1883)
+		result := i.Result()
+
+		code, err := MQTTAuth(i.Target, "", "", false)
+		if err != nil {
+			log.Errorf("mqtt unauth verify failed: %s", err)
+			result.Finished = true
+			return result
+		}
+		if code == mqttConnAckAccepted {
+			result.Ok = true
+			result.Username = ""
+			result.Password = ""
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		i.Target = appendDefaultPort(i.Target, 1883)
+		result := i.Result()
+
+		code, err := MQTTAuth(i.Target, i.Username, i.Password, true)
+		if err != nil {
+			log.Errorf("mqtt brute failed: %s", err)
+			if _, ok := err.(net.Error); ok {
+				result.Finished = true
+			}
+			return result
+		}
+		switch code {
+		case mqttConnAckAccepted:
+			result.Ok = true
+		case mqttConnAckBadUsernameOrPass, mqttConnAckNotAuthorized:
+		default:
+			// unacceptable protocol version, identifier rejected or server unavailable
+			result.Finished = true
+		}
+		return result
+	},
+}
@@ -0,0 +1,276 @@
+package bruteutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func bruteMockItem(r *DefaultServiceAuthInfo, host string, port int, username, password string) *BruteItem {
+	return &BruteItem{
+		Type:     r.ServiceName,
+		Target:   utils.HostPort(host, port),
+		Username: username,
+		Password: password,
+	}
+}
+
+func TestBrute_MQTT(t *testing.T) {
+	host, port := utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		header := make([]byte, 2)
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return
+		}
+		body := make([]byte, header[1])
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return
+		}
+		code := byte(mqttConnAckBadUsernameOrPass)
+		if bytes.HasSuffix(body, []byte("\x00\x05admin\x00\x06public")) {
+			code = mqttConnAckAccepted
+		}
+		conn.Write([]byte{0x20, 0x02, 0x00, code})
+	})
+
+	require.False(t, mqttAuth.UnAuthVerify(bruteMockItem(mqttAuth, host, port, "", "")).Ok)
+	require.False(t, mqttAuth.BrutePass(bruteMockItem(mqttAuth, host, port, "admin", "admin")).Ok)
+	require.True(t, mqttAuth.BrutePass(bruteMockItem(mqttAuth, host, port, "admin", "public")).Ok)
+}
+
+func TestBrute_Kafka(t *testing.T) {
+	readRequest := func(conn net.Conn) (int16, int32, []byte, error) {
+		var size int32
+		if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+			return 0, 0, nil, err
+		}
+		req := make([]byte, size)
+		if _, err := io.ReadFull(conn, req); err != nil {
+			return 0, 0, nil, err
+		}
+		apiKey := int16(binary.BigEndian.Uint16(req[0:2]))
+		correlationId := int32(binary.BigEndian.Uint32(req[4:8]))
+		clientIdLen := int(binary.BigEndian.Uint16(req[8:10]))
+		return apiKey, correlationId, req[10+clientIdLen:], nil
+	}
+	writeResponse := func(conn net.Conn, correlationId int32, body []byte) {
+		var buf bytes.Buffer
+		binary.Write(&buf, binary.BigEndian, int32(len(body)+4))
+		binary.Write(&buf, binary.BigEndian, correlationId)
+		buf.Write(body)
+		conn.Write(buf.Bytes())
+	}
+
+	host, port := utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		for {
+			apiKey, correlationId, body, err := readRequest(conn)
+			if err != nil {
+				return
+			}
+			switch apiKey {
+			case kafkaApiSaslHandshake:
+				var rsp bytes.Buffer
+				binary.Write(&rsp, binary.BigEndian, int16(0))
+				binary.Write(&rsp, binary.BigEndian, int32(1))
+				kafkaWriteString(&rsp, "PLAIN")
+				writeResponse(conn, correlationId, rsp.Bytes())
+			case kafkaApiSaslAuthenticate:
+				var code int16 = 58
+				if bytes.Equal(body[4:], []byte("\x00admin\x00admin-secret")) {
+					code = 0
+				}
+				var rsp bytes.Buffer
+				binary.Write(&rsp, binary.BigEndian, code)
+				binary.Write(&rsp, binary.BigEndian, int16(-1))
+				binary.Write(&rsp, binary.BigEndian, int32(0))
+				writeResponse(conn, correlationId, rsp.Bytes())
+			default:
+				// sasl is required before any other request
+				return
+			}
+		}
+	})
+
+	require.False(t, kafkaAuth.UnAuthVerify(bruteMockItem(kafkaAuth, host, port, "", "")).Ok)
+	require.False(t, kafkaAuth.BrutePass(bruteMockItem(kafkaAuth, host, port, "admin", "kafka")).Ok)
+	require.True(t, kafkaAuth.BrutePass(bruteMockItem(kafkaAuth, host, port, "admin", "admin-secret")).Ok)
+}
+
+func TestBrute_AMQP(t *testing.T) {
+	readMethod := func(conn net.Conn) (uint16, uint16, []byte, error) {
+		header := make([]byte, 7)
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return 0, 0, nil, err
+		}
+		// payload and frame-end octet
+		payload := make([]byte, binary.BigEndian.Uint32(header[3:7])+1)
+		if _, err := io.ReadFull(conn, payload); err != nil {
+			return 0, 0, nil, err
+		}
+		if header[0] != 1 || len(payload) < 5 {
+			return 0, 0, nil, nil
+		}
+		return binary.BigEndian.Uint16(payload[0:2]), binary.BigEndian.Uint16(payload[2:4]), payload[4 : len(payload)-1], nil
+	}
+	writeMethod := func(conn net.Conn, classId, methodId uint16, args []byte) {
+		var payload bytes.Buffer
+		binary.Write(&payload, binary.BigEndian, classId)
+		binary.Write(&payload, binary.BigEndian, methodId)
+		payload.Write(args)
+
+		var frame bytes.Buffer
+		frame.WriteByte(1)
+		binary.Write(&frame, binary.BigEndian, uint16(0))
+		binary.Write(&frame, binary.BigEndian, uint32(payload.Len()))
+		frame.Write(payload.Bytes())
+		frame.WriteByte(0xCE)
+		conn.Write(frame.Bytes())
+	}
+
+	host, port := utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		protocolHeader := make([]byte, 8)
+		if _, err := io.ReadFull(conn, protocolHeader); err != nil {
+			return
+		}
+
+		// connection.start, only PLAIN is offered
+		var start bytes.Buffer
+		start.Write([]byte{0, 9})
+		binary.Write(&start, binary.BigEndian, uint32(0))
+		binary.Write(&start, binary.BigEndian, uint32(len("PLAIN")))
+		start.WriteString("PLAIN")
+		binary.Write(&start, binary.BigEndian, uint32(len("en_US")))
+		start.WriteString("en_US")
+		writeMethod(conn, 10, 10, start.Bytes())
+
+		// connection.start-ok: client-properties, mechanism, response, locale
+		_, _, args, err := readMethod(conn)
+		if err != nil || len(args) < 4 {
+			return
+		}
+		args = args[4+binary.BigEndian.Uint32(args[0:4]):]
+		args = args[1+int(args[0]):]
+		response := args[4 : 4+binary.BigEndian.Uint32(args[0:4])]
+		if !bytes.Equal(response, []byte("\x00admin\x00rabbit-secret")) {
+			// rabbitmq closes the socket directly when the credentials are refused
+			return
+		}
+
+		// connection.tune
+		var tune bytes.Buffer
+		binary.Write(&tune, binary.BigEndian, uint16(0))
+		binary.Write(&tune, binary.BigEndian, uint32(131072))
+		binary.Write(&tune, binary.BigEndian, uint16(0))
+		writeMethod(conn, 10, 30, tune.Bytes())
+		for {
+			classId, methodId, _, err := readMethod(conn)
+			if err != nil {
+				return
+			}
+			switch {
+			case classId == 10 && methodId == 40:
+				// connection.open -> connection.open-ok
+				writeMethod(conn, 10, 41, []byte{0})
+			case classId == 10 && methodId == 50:
+				// connection.close -> connection.close-ok
+				writeMethod(conn, 10, 51, nil)
+				return
+			}
+		}
+	})
+
+	require.False(t, amqpAuth.UnAuthVerify(bruteMockItem(amqpAuth, host, port, "", "")).Ok)
+	require.False(t, amqpAuth.BrutePass(bruteMockItem(amqpAuth, host, port, "guest", "guest")).Ok)
+	require.True(t, amqpAuth.BrutePass(bruteMockItem(amqpAuth, host, port, "admin", "rabbit-secret")).Ok)
+}
+
+func TestBrute_Elasticsearch(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "elastic" || password != "changeme" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="security"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"name":"node-1","cluster_name":"elasticsearch","version":{"number":"7.17.0"},"tagline":"You Know, for Search"}`))
+	})
+
+	require.False(t, elasticsearchAuth.UnAuthVerify(bruteMockItem(elasticsearchAuth, host, port, "", "")).Ok)
+	require.False(t, elasticsearchAuth.BrutePass(bruteMockItem(elasticsearchAuth, host, port, "elastic", "elastic")).Ok)
+	require.True(t, elasticsearchAuth.BrutePass(bruteMockItem(elasticsearchAuth, host, port, "elastic", "changeme")).Ok)
+}
+
+func TestBrute_CouchDB(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		authed := ok && username == "admin" && password == "couchdb"
+		switch r.URL.Path {
+		case "/_all_dbs":
+			if !authed {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":"unauthorized"}`))
+				return
+			}
+			w.Write([]byte(`["_users"]`))
+		case "/_session":
+			if ok && !authed {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":"unauthorized","reason":"Name or password is incorrect."}`))
+				return
+			}
+			if !authed {
+				w.Write([]byte(`{"ok":true,"userCtx":{"name":null,"roles":[]}}`))
+				return
+			}
+			w.Write([]byte(`{"ok":true,"userCtx":{"name":"admin","roles":["_admin"]}}`))
+		}
+	})
+
+	require.False(t, couchdbAuth.UnAuthVerify(bruteMockItem(couchdbAuth, host, port, "", "")).Ok)
+	require.False(t, couchdbAuth.BrutePass(bruteMockItem(couchdbAuth, host, port, "admin", "admin")).Ok)
+	require.True(t, couchdbAuth.BrutePass(bruteMockItem(couchdbAuth, host, port, "admin", "couchdb")).Ok)
+}
+
+func TestBrute_InfluxDB(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Influxdb-Version", "1.8.10")
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "influxdb" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"authorization failed"}`))
+			return
+		}
+		w.Write([]byte(`{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["_internal"]]}]}]}`))
+	})
+
+	require.False(t, influxdbAuth.UnAuthVerify(bruteMockItem(influxdbAuth, host, port, "", "")).Ok)
+	require.False(t, influxdbAuth.BrutePass(bruteMockItem(influxdbAuth, host, port, "admin", "admin")).Ok)
+	require.True(t, influxdbAuth.BrutePass(bruteMockItem(influxdbAuth, host, port, "admin", "influxdb")).Ok)
+}
+
+func TestBrute_ClickHouse(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-ClickHouse-User") != "default" || r.Header.Get("X-ClickHouse-Key") != "clickhouse" {
+			w.Header().Set("X-ClickHouse-Exception-Code", "516")
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("Code: 516. DB::Exception: default: Authentication failed"))
+			return
+		}
+		w.Write([]byte("1\n"))
+	})
+
+	result := clickhouseAuth.UnAuthVerify(bruteMockItem(clickhouseAuth, host, port, "", ""))
+	require.False(t, result.Ok)
+	require.False(t, result.Finished)
+	require.False(t, clickhouseAuth.BrutePass(bruteMockItem(clickhouseAuth, host, port, "default", "default")).Ok)
+	require.True(t, clickhouseAuth.BrutePass(bruteMockItem(clickhouseAuth, host, port, "default", "clickhouse")).Ok)
+}
@@ -0,0 +1,379 @@
+package filesys
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ulikunitz/xz"
+	"github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/utils/memfile"
+)
+
+const archiveMaxSymlinkHops = 40
+
+// archiveFile is a file or directory loaded from an archive, it is the fs.FileInfo of itself.
+type archiveFile struct {
+	name    string
+	mode    fs.FileMode
+	modTime time.Time
+	content []byte
+	// target of a symlink
+	link string
+
+	// load reads the content of a file indexed lazily, size is its length
+	load func() ([]byte, error)
+	size int64
+}
+
+func (a *archiveFile) Name() string { return a.name }
+func (a *archiveFile) Size() int64 {
+	if a.load != nil {
+		return a.size
+	}
+	return int64(len(a.content))
+}
+func (a *archiveFile) Mode() fs.FileMode  { return a.mode }
+func (a *archiveFile) ModTime() time.Time { return a.modTime }
+func (a *archiveFile) IsDir() bool        { return a.mode.IsDir() }
+func (a *archiveFile) Sys() any           { return nil }
+
+func (a *archiveFile) readContent() ([]byte, error) {
+	if a.load != nil {
+		return a.load()
+	}
+	return a.content, nil
+}
+
+type archiveDir struct {
+	info *archiveFile
+}
+
+func (d *archiveDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *archiveDir) Read([]byte) (int, error)   { return 0, utils.Error("archive dir cannot be read") }
+func (d *archiveDir) Close() error               { return nil }
+
+// archiveFS is a readonly filesystem of the files loaded from archives, the paths are cleaned
+// to be relative to the root ("usr/bin/env"), and the root is ".".
+type archiveFS struct {
+	kind     string
+	files    map[string]*archiveFile
+	children map[string]map[string]struct{}
+}
+
+func newArchiveFS(kind string) *archiveFS {
+	a := &archiveFS{
+		kind:     kind,
+		files:    make(map[string]*archiveFile),
+		children: make(map[string]map[string]struct{}),
+	}
+	a.files["."] = &archiveFile{name: ".", mode: fs.ModeDir | 0o755}
+	return a
+}
+
+func archivePathClean(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		return "."
+	}
+	return name
+}
+
+// mkdirAll creates the parent directories of name which are not in the archive
+func (a *archiveFS) mkdirAll(name string) {
+	for name != "." {
+		parent := path.Dir(name)
+		if parent == "" {
+			parent = "."
+		}
+		if _, ok := a.children[parent]; !ok {
+			a.children[parent] = make(map[string]struct{})
+		}
+		a.children[parent][path.Base(name)] = struct{}{}
+		if _, ok := a.files[parent]; ok {
+			return
+		}
+		a.files[parent] = &archiveFile{name: path.Base(parent), mode: fs.ModeDir | 0o755}
+		name = parent
+	}
+}
+
+func (a *archiveFS) add(name string, file *archiveFile) {
+	name = archivePathClean(name)
+	if name == "." {
+		return
+	}
+	if old, ok := a.files[name]; ok && old.IsDir() && file.IsDir() {
+		// keep the children of the directory
+		old.mode, old.modTime = file.mode, file.modTime
+		return
+	}
+	a.remove(name)
+	file.name = path.Base(name)
+	a.files[name] = file
+	a.mkdirAll(name)
+}
+
+// remove deletes name and all the files below it
+func (a *archiveFS) remove(name string) {
+	name = archivePathClean(name)
+	if _, ok := a.files[name]; !ok || name == "." {
+		return
+	}
+	a.removeChildren(name)
+	delete(a.files, name)
+	if children, ok := a.children[path.Dir(name)]; ok {
+		delete(children, path.Base(name))
+	}
+}
+
+func (a *archiveFS) removeChildren(name string) {
+	for child := range a.children[name] {
+		childPath := path.Join(name, child)
+		if name == "." {
+			childPath = child
+		}
+		a.removeChildren(childPath)
+		delete(a.files, childPath)
+	}
+	delete(a.children, name)
+}
+
+// archiveLazyLoader returns the loader of the file content at offset of a tar stream
+type archiveLazyLoader func(offset, size int64) func() ([]byte, error)
+
+type archiveCountingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *archiveCountingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// loadTar adds the entries of a tar stream, handle can rewrite or skip (return false) an entry.
+// The content of regular files is read into memory, or only indexed by lazy if it is not nil.
+func (a *archiveFS) loadTar(r io.Reader, handle func(header *tar.Header) bool, lazy archiveLazyLoader) error {
+	counter := &archiveCountingReader{r: r}
+	reader := tar.NewReader(counter)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return utils.Wrapf(err, "read %s entry failed", a.kind)
+		}
+		if handle != nil && !handle(header) {
+			continue
+		}
+
+		info := header.FileInfo()
+		file := &archiveFile{mode: info.Mode(), modTime: header.ModTime}
+		switch header.Typeflag {
+		case tar.TypeDir:
+		case tar.TypeSymlink:
+			file.link = header.Linkname
+		case tar.TypeLink:
+			target, ok := a.files[archivePathClean(header.Linkname)]
+			if !ok {
+				continue
+			}
+			file.mode, file.content, file.load, file.size = target.mode, target.content, target.load, target.size
+		case tar.TypeReg, tar.TypeRegA:
+			if lazy != nil {
+				// the data of the entry starts right after the header
+				file.load, file.size = lazy(counter.n, header.Size), header.Size
+				break
+			}
+			file.content, err = io.ReadAll(reader)
+			if err != nil {
+				return utils.Wrapf(err, "read %s file %s failed", a.kind, header.Name)
+			}
+		default:
+			// devices, fifos and pax headers
+			continue
+		}
+		a.add(header.Name, file)
+	}
+}
+
+// resolve follows the symlinks in the path of name, the symlinks are resolved inside the archive
+func (a *archiveFS) resolve(name string) (string, *archiveFile, error) {
+	parts := strings.Split(archivePathClean(name), "/")
+	current := "."
+	for hops := 0; len(parts) > 0; {
+		part := parts[0]
+		parts = parts[1:]
+		if part == "." || part == "" {
+			continue
+		}
+		next := part
+		if current != "." {
+			next = current + "/" + part
+		}
+		file, ok := a.files[next]
+		if !ok {
+			return "", nil, utils.Wrapf(os.ErrNotExist, "%s not exist", name)
+		}
+		if file.mode&fs.ModeSymlink == 0 {
+			current = next
+			continue
+		}
+		if hops++; hops > archiveMaxSymlinkHops {
+			return "", nil, utils.Errorf("too many levels of symbolic links: %s", name)
+		}
+		target := file.link
+		if !strings.HasPrefix(target, "/") {
+			target = path.Join(current, target)
+		}
+		parts = append(strings.Split(archivePathClean(target), "/"), parts...)
+		current = "."
+	}
+	return current, a.files[current], nil
+}
+
+func (a *archiveFS) Open(name string) (fs.File, error) {
+	_, file, err := a.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	if file.IsDir() {
+		return &archiveDir{info: file}, nil
+	}
+	content, err := file.readContent()
+	if err != nil {
+		return nil, err
+	}
+	return memfile.NewWithName(file.name, content), nil
+}
+
+func (a *archiveFS) OpenFile(name string, flag int, perm os.FileMode) (fs.File, error) {
+	return a.Open(name)
+}
+
+func (a *archiveFS) Stat(name string) (fs.FileInfo, error) {
+	_, file, err := a.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (a *archiveFS) ReadFile(name string) ([]byte, error) {
+	_, file, err := a.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	if file.IsDir() {
+		return nil, utils.Wrapf(os.ErrNotExist, "%v is dir", name)
+	}
+	return file.readContent()
+}
+
+func (a *archiveFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	dir, file, err := a.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	if !file.IsDir() {
+		return nil, utils.Errorf("%v is not a dir", name)
+	}
+	var entries []fs.DirEntry
+	for child := range a.children[dir] {
+		childPath := child
+		if dir != "." {
+			childPath = dir + "/" + child
+		}
+		if info, ok := a.files[childPath]; ok {
+			entries = append(entries, fs.FileInfoToDirEntry(info))
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+func (a *archiveFS) Exists(name string) (bool, error) {
+	_, err := a.Stat(name)
+	return err == nil, nil
+}
+
+func (a *archiveFS) Rel(base string, target string) (string, error) {
+	base, target = archivePathClean(base), archivePathClean(target)
+	if base == "." {
+		return target, nil
+	}
+	if target == base {
+		return ".", nil
+	}
+	if strings.HasPrefix(target, base+"/") {
+		return strings.TrimPrefix(target, base+"/"), nil
+	}
+	return "", utils.Errorf("%v is not under %v", target, base)
+}
+
+func (a *archiveFS) ExtraInfo(string) map[string]any { return nil }
+func (a *archiveFS) GetSeparators() rune             { return '/' }
+func (a *archiveFS) Join(name ...string) string      { return path.Join(name...) }
+func (a *archiveFS) Base(p string) string            { return path.Base(p) }
+func (a *archiveFS) PathSplit(s string) (string, string) {
+	return splitWithSeparator(s, a.GetSeparators())
+}
+func (a *archiveFS) Ext(s string) string    { return getExtension(s) }
+func (a *archiveFS) IsAbs(s string) bool    { return false }
+func (a *archiveFS) Getwd() (string, error) { return ".", nil }
+func (a *archiveFS) Rename(string, string) error {
+	return utils.Errorf("unsupported on readonly %s", a.kind)
+}
+func (a *archiveFS) WriteFile(string, []byte, os.FileMode) error {
+	return utils.Errorf("unsupported on readonly %s", a.kind)
+}
+func (a *archiveFS) Delete(string) error { return utils.Errorf("unsupported on readonly %s", a.kind) }
+func (a *archiveFS) MkdirAll(string, os.FileMode) error {
+	return utils.Errorf("unsupported on readonly %s", a.kind)
+}
+
+var _ fi.FileSystem = (*archiveFS)(nil)
+
+// compressionOf returns "gzip", "bzip2" or "xz" by the magic bytes, "" for other streams
+func compressionOf(magic []byte) string {
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		return "gzip"
+	case bytes.HasPrefix(magic, []byte("BZh")):
+		return "bzip2"
+	case bytes.HasPrefix(magic, []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}):
+		return "xz"
+	}
+	return ""
+}
+
+// decompressReader detects gzip, bzip2 and xz by the magic bytes, other streams are returned as is.
+func decompressReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(6)
+	switch compressionOf(magic) {
+	case "gzip":
+		return gzip.NewReader(br)
+	case "bzip2":
+		return bzip2.NewReader(br), nil
+	case "xz":
+		return xz.NewReader(br)
+	}
+	return br, nil
+}
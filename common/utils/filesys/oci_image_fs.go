@@ -0,0 +1,328 @@
+package filesys
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+const (
+	ociWhiteoutPrefix     = ".wh."
+	ociWhiteoutOpaqueName = ".wh..wh..opq"
+
+	ociImageRefNameAnnotation = "org.opencontainers.image.ref.name"
+)
+
+// OCIImageFS is a readonly filesystem of the rootfs of an image saved by `docker save` (or an OCI image
+// layout tarball), the layers are merged like overlayfs and the whiteout files are applied.
+// Only the paths are kept in memory, the content of a file is read from the image tarball when it is opened,
+// so the tarball must be kept until Close.
+type OCIImageFS struct {
+	*archiveFS
+
+	RepoTags []string
+	// Layers are the paths of the layers in the archive, from the base layer to the top layer.
+	Layers []string
+
+	// archive is the uncompressed image tarball, entries are the positions of its regular files
+	archive io.ReaderAt
+	entries map[string]ociArchiveEntry
+	closer  io.Closer
+}
+
+var _ fi.FileSystem = (*OCIImageFS)(nil)
+
+type ociArchiveEntry struct {
+	offset int64
+	size   int64
+}
+
+type dockerSaveManifest struct {
+	Config   string   `json:"Config"`
+	RepoTags []string `json:"RepoTags"`
+	Layers   []string `json:"Layers"`
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type ociIndex struct {
+	Manifests []ociDescriptor `json:"manifests"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// NewOCIImageFS loads an image tarball. A seekable uncompressed tarball (*os.File, *bytes.Reader) is read in place
+// and must not be closed before the filesystem, other streams are copied to a temporary file removed by Close.
+func NewOCIImageFS(r io.Reader) (*OCIImageFS, error) {
+	archive, size, closer, err := ociSeekableArchive(r)
+	if err != nil {
+		return nil, err
+	}
+	image, err := newOCIImageFS(archive, size)
+	if err != nil {
+		if closer != nil {
+			closer.Close()
+		}
+		return nil, err
+	}
+	image.closer = closer
+	return image, nil
+}
+
+// NewOCIImageFSFromLocal loads the image tarball of the path, the file is kept open until Close
+func NewOCIImageFSFromLocal(i string) (*OCIImageFS, error) {
+	f, err := os.Open(i)
+	if err != nil {
+		return nil, err
+	}
+	image, err := NewOCIImageFS(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if image.closer == nil {
+		image.closer = f
+	} else {
+		f.Close()
+	}
+	return image, nil
+}
+
+func newOCIImageFS(archive io.ReaderAt, size int64) (*OCIImageFS, error) {
+	image := &OCIImageFS{archiveFS: newArchiveFS("ociimagefs"), archive: archive}
+	if err := image.indexArchive(size); err != nil {
+		return nil, err
+	}
+
+	if raw, err := image.readArchiveFile("manifest.json"); err == nil {
+		var manifests []dockerSaveManifest
+		if err := json.Unmarshal(raw, &manifests); err != nil {
+			return nil, utils.Wrap(err, "invalid manifest.json")
+		}
+		if len(manifests) == 0 {
+			return nil, utils.Error("no image in manifest.json")
+		}
+		image.RepoTags = manifests[0].RepoTags
+		image.Layers = manifests[0].Layers
+	} else if raw, err := image.readArchiveFile("index.json"); err == nil {
+		if err := image.loadOCILayout(raw); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, utils.Error("manifest.json or index.json not found, not an image archive")
+	}
+
+	for _, layer := range image.Layers {
+		entry, ok := image.entries[archivePathClean(layer)]
+		if !ok {
+			return nil, utils.Wrapf(os.ErrNotExist, "layer %s not found", layer)
+		}
+		if err := image.applyLayer(io.NewSectionReader(archive, entry.offset, entry.size)); err != nil {
+			return nil, utils.Wrapf(err, "apply layer %s failed", layer)
+		}
+	}
+	return image, nil
+}
+
+// Close releases the image tarball, the content of the files cannot be read after Close
+func (o *OCIImageFS) Close() error {
+	if o.closer == nil {
+		return nil
+	}
+	return o.closer.Close()
+}
+
+// ociSeekableArchive returns the uncompressed image tarball as an io.ReaderAt, compressed or unseekable
+// streams are copied to a temporary file.
+func ociSeekableArchive(r io.Reader) (io.ReaderAt, int64, io.Closer, error) {
+	if rs, ok := r.(interface {
+		io.ReaderAt
+		io.Seeker
+	}); ok {
+		start, err := rs.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		end, err := rs.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		archive := io.NewSectionReader(rs, start, end-start)
+		magic := make([]byte, 6)
+		n, _ := archive.ReadAt(magic, 0)
+		if compressionOf(magic[:n]) == "" {
+			return archive, end - start, nil, nil
+		}
+		r = archive
+	}
+
+	reader, err := decompressReader(r)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	f, err := os.CreateTemp("", "yak-oci-image-*.tar")
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	temp := &ociTempArchive{File: f}
+	size, err := io.Copy(f, reader)
+	if err != nil {
+		temp.Close()
+		return nil, 0, nil, utils.Wrap(err, "copy image archive failed")
+	}
+	return f, size, temp, nil
+}
+
+type ociTempArchive struct {
+	*os.File
+}
+
+func (t *ociTempArchive) Close() error {
+	err := t.File.Close()
+	os.Remove(t.File.Name())
+	return err
+}
+
+// indexArchive records the positions of the regular files in the image tarball without reading them
+func (o *OCIImageFS) indexArchive(size int64) error {
+	o.entries = make(map[string]ociArchiveEntry)
+	section := io.NewSectionReader(o.archive, 0, size)
+	reader := tar.NewReader(section)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return utils.Wrapf(err, "read %s entry failed", o.kind)
+		}
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+		// tar.Reader seeks over the data, so the position is the start of the data of the entry
+		offset, err := section.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		o.entries[archivePathClean(header.Name)] = ociArchiveEntry{offset: offset, size: header.Size}
+	}
+}
+
+func (o *OCIImageFS) readArchiveFile(name string) ([]byte, error) {
+	entry, ok := o.entries[archivePathClean(name)]
+	if !ok {
+		return nil, utils.Wrapf(os.ErrNotExist, "%s not exist", name)
+	}
+	return io.ReadAll(io.NewSectionReader(o.archive, entry.offset, entry.size))
+}
+
+func ociBlobPath(digest string) string {
+	return path.Join("blobs", strings.Replace(digest, ":", "/", 1))
+}
+
+// loadOCILayout finds the layers of the first image in an OCI image layout
+func (o *OCIImageFS) loadOCILayout(raw []byte) error {
+	for depth := 0; depth < 4; depth++ {
+		var index ociIndex
+		if err := json.Unmarshal(raw, &index); err != nil {
+			return utils.Wrap(err, "invalid oci index")
+		}
+		if len(index.Layers) > 0 {
+			for _, layer := range index.Layers {
+				o.Layers = append(o.Layers, ociBlobPath(layer.Digest))
+			}
+			return nil
+		}
+		if len(index.Manifests) == 0 {
+			return utils.Error("no manifest in oci index")
+		}
+		manifest := index.Manifests[0]
+		if name := manifest.Annotations[ociImageRefNameAnnotation]; name != "" && len(o.RepoTags) == 0 {
+			o.RepoTags = append(o.RepoTags, name)
+		}
+		var err error
+		raw, err = o.readArchiveFile(ociBlobPath(manifest.Digest))
+		if err != nil {
+			return utils.Wrapf(err, "read manifest %s failed", manifest.Digest)
+		}
+	}
+	return utils.Error("oci index is nested too deep")
+}
+
+// applyLayer merges a layer into the rootfs, `.wh.<name>` deletes the file from the lower layers
+// and `.wh..wh..opq` makes the directory opaque. The files are indexed by their offsets in the layer.
+func (o *OCIImageFS) applyLayer(layer *io.SectionReader) error {
+	reader, err := decompressReader(io.NewSectionReader(layer, 0, layer.Size()))
+	if err != nil {
+		return err
+	}
+	magic := make([]byte, 6)
+	n, _ := layer.ReadAt(magic, 0)
+	compressed := compressionOf(magic[:n]) != ""
+
+	current := make(map[string]struct{})
+	return o.loadTar(reader, func(header *tar.Header) bool {
+		name := archivePathClean(header.Name)
+		dir, base := path.Dir(name), path.Base(name)
+		switch {
+		case base == ociWhiteoutOpaqueName:
+			o.removeLower(dir, current)
+			return false
+		case strings.HasPrefix(base, ociWhiteoutPrefix):
+			o.remove(path.Join(dir, strings.TrimPrefix(base, ociWhiteoutPrefix)))
+			return false
+		}
+		current[name] = struct{}{}
+		return true
+	}, func(offset, size int64) func() ([]byte, error) {
+		return func() ([]byte, error) {
+			return readLayerFile(layer, compressed, offset, size)
+		}
+	})
+}
+
+// readLayerFile reads the file at offset of the uncompressed layer, a compressed layer is decompressed
+// from the beginning as it cannot be seeked.
+func readLayerFile(layer *io.SectionReader, compressed bool, offset, size int64) ([]byte, error) {
+	if !compressed {
+		return io.ReadAll(io.NewSectionReader(layer, offset, size))
+	}
+	reader, err := decompressReader(io.NewSectionReader(layer, 0, layer.Size()))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(io.Discard, reader, offset); err != nil {
+		return nil, utils.Wrap(err, "seek layer failed")
+	}
+	content := make([]byte, size)
+	if _, err := io.ReadFull(reader, content); err != nil {
+		return nil, utils.Wrap(err, "read layer file failed")
+	}
+	return content, nil
+}
+
+// removeLower removes the files below dir which are not added by the current layer
+func (o *OCIImageFS) removeLower(dir string, current map[string]struct{}) {
+	for child := range o.children[dir] {
+		childPath := path.Join(dir, child)
+		if dir == "." {
+			childPath = child
+		}
+		if _, ok := current[childPath]; !ok {
+			o.remove(childPath)
+			continue
+		}
+		if file := o.files[childPath]; file != nil && file.IsDir() {
+			o.removeLower(childPath, current)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package filesys
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+// TarFS is a readonly filesystem of a tarball, gzip / bzip2 / xz compressed tarballs
+// are detected automatically. symlinks are resolved inside the tarball.
+type TarFS struct {
+	*archiveFS
+}
+
+var _ fi.FileSystem = (*TarFS)(nil)
+
+func NewTarFS(r io.Reader) (*TarFS, error) {
+	reader, err := decompressReader(r)
+	if err != nil {
+		return nil, err
+	}
+	tfs := &TarFS{archiveFS: newArchiveFS("tarfs")}
+	if err := tfs.loadTar(reader, nil, nil); err != nil {
+		return nil, err
+	}
+	return tfs, nil
+}
+
+func NewTarFSFromString(i string) (*TarFS, error) {
+	return NewTarFS(bytes.NewReader([]byte(i)))
+}
+
+func NewTarFSFromLocal(i string) (*TarFS, error) {
+	f, err := os.Open(i)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return NewTarFS(f)
+}
@@ -0,0 +1,206 @@
+package filesys
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+)
+
+type tarTestEntry struct {
+	name     string
+	content  string
+	typeflag byte
+	link     string
+}
+
+func buildTestTar(t *testing.T, entries ...tarTestEntry) []byte {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Typeflag: entry.typeflag, Linkname: entry.link, Mode: 0o644}
+		switch entry.typeflag {
+		case tar.TypeDir:
+			header.Mode = 0o755
+		case tar.TypeReg:
+			header.Size = int64(len(entry.content))
+		}
+		require.NoError(t, w.WriteHeader(header))
+		if entry.typeflag == tar.TypeReg {
+			_, err := w.Write([]byte(entry.content))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func compressTestTar(t *testing.T, kind string, raw []byte) []byte {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	var err error
+	switch kind {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "xz":
+		w, err = xz.NewWriter(&buf)
+		require.NoError(t, err)
+	default:
+		return raw
+	}
+	_, err = w.Write(raw)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestTarFS(t *testing.T) {
+	raw := buildTestTar(t,
+		tarTestEntry{name: "./src/", typeflag: tar.TypeDir},
+		tarTestEntry{name: "./src/main.go", content: "package main", typeflag: tar.TypeReg},
+		tarTestEntry{name: "./src/lib/util.go", content: "package lib", typeflag: tar.TypeReg},
+		tarTestEntry{name: "./current", typeflag: tar.TypeSymlink, link: "src"},
+		tarTestEntry{name: "./util.go", typeflag: tar.TypeSymlink, link: "/src/lib/util.go"},
+		tarTestEntry{name: "./main.go", typeflag: tar.TypeLink, link: "src/main.go"},
+	)
+
+	for _, kind := range []string{"tar", "gzip", "xz"} {
+		t.Run(kind, func(t *testing.T) {
+			tfs, err := NewTarFSFromString(string(compressTestTar(t, kind, raw)))
+			require.NoError(t, err)
+
+			content, err := tfs.ReadFile("src/main.go")
+			require.NoError(t, err)
+			require.Equal(t, "package main", string(content))
+			content, err = tfs.ReadFile("/current/lib/util.go")
+			require.NoError(t, err)
+			require.Equal(t, "package lib", string(content))
+			content, err = tfs.ReadFile("util.go")
+			require.NoError(t, err)
+			require.Equal(t, "package lib", string(content))
+			content, err = tfs.ReadFile("main.go")
+			require.NoError(t, err)
+			require.Equal(t, "package main", string(content))
+
+			entries, err := tfs.ReadDir(".")
+			require.NoError(t, err)
+			var names []string
+			for _, entry := range entries {
+				names = append(names, entry.Name())
+			}
+			require.Equal(t, []string{"current", "main.go", "src", "util.go"}, names)
+
+			entries, err = tfs.ReadDir("current")
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+
+			info, err := tfs.Stat("src/lib")
+			require.NoError(t, err)
+			require.True(t, info.IsDir())
+			exists, _ := tfs.Exists("src/none.go")
+			require.False(t, exists)
+			require.Error(t, tfs.WriteFile("a.txt", nil, 0o644))
+		})
+	}
+}
+
+func buildTestDockerSave(t *testing.T) []byte {
+	base := buildTestTar(t,
+		tarTestEntry{name: "etc/", typeflag: tar.TypeDir},
+		tarTestEntry{name: "etc/os-release", content: "ID=alpine", typeflag: tar.TypeReg},
+		tarTestEntry{name: "etc/passwd", content: "root:x:0:0", typeflag: tar.TypeReg},
+		tarTestEntry{name: "app/", typeflag: tar.TypeDir},
+		tarTestEntry{name: "app/old.jar", content: "old", typeflag: tar.TypeReg},
+		tarTestEntry{name: "tmp/cache", content: "cache", typeflag: tar.TypeReg},
+	)
+	top := buildTestTar(t,
+		tarTestEntry{name: "etc/.wh.passwd", typeflag: tar.TypeReg},
+		tarTestEntry{name: "app/.wh..wh..opq", typeflag: tar.TypeReg},
+		tarTestEntry{name: "app/new.jar", content: "new", typeflag: tar.TypeReg},
+		tarTestEntry{name: ".wh.tmp", typeflag: tar.TypeReg},
+	)
+	manifest, err := json.Marshal([]dockerSaveManifest{{
+		Config:   "config.json",
+		RepoTags: []string{"yaklang/test:latest"},
+		Layers:   []string{"base/layer.tar", "top/layer.tar"},
+	}})
+	require.NoError(t, err)
+	return buildTestTar(t,
+		tarTestEntry{name: "manifest.json", content: string(manifest), typeflag: tar.TypeReg},
+		tarTestEntry{name: "config.json", content: "{}", typeflag: tar.TypeReg},
+		tarTestEntry{name: "base/layer.tar", content: string(base), typeflag: tar.TypeReg},
+		tarTestEntry{name: "top/layer.tar", content: string(compressTestTar(t, "gzip", top)), typeflag: tar.TypeReg},
+	)
+}
+
+func TestOCIImageFS_DockerSave(t *testing.T) {
+	ofs, err := NewOCIImageFS(bytes.NewReader(buildTestDockerSave(t)))
+	require.NoError(t, err)
+	testOCIImageFSRootfs(t, ofs)
+}
+
+func testOCIImageFSRootfs(t *testing.T, ofs *OCIImageFS) {
+	require.Equal(t, []string{"yaklang/test:latest"}, ofs.RepoTags)
+
+	content, err := ofs.ReadFile("etc/os-release")
+	require.NoError(t, err)
+	require.Equal(t, "ID=alpine", string(content))
+	content, err = ofs.ReadFile("app/new.jar")
+	require.NoError(t, err)
+	require.Equal(t, "new", string(content))
+
+	for _, removed := range []string{"etc/passwd", "app/old.jar", "tmp", "tmp/cache", "etc/.wh.passwd"} {
+		exists, _ := ofs.Exists(removed)
+		require.False(t, exists, removed)
+	}
+
+	count := 0
+	require.NoError(t, Recursive(".", WithFileSystem(ofs), WithFileStat(func(string, os.FileInfo) error {
+		count++
+		return nil
+	})))
+	require.Equal(t, 2, count)
+}
+
+func TestOCIImageFS_LazyContent(t *testing.T) {
+	image := buildTestDockerSave(t)
+
+	// the content of the files is not kept in memory
+	ofs, err := NewOCIImageFS(bytes.NewReader(image))
+	require.NoError(t, err)
+	for name, file := range ofs.files {
+		require.Nil(t, file.content, name)
+	}
+	info, err := ofs.Stat("app/new.jar")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), info.Size())
+	f, err := ofs.Open("etc/os-release")
+	require.NoError(t, err)
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.Equal(t, "ID=alpine", string(content))
+
+	// a compressed image archive is copied to a temporary file
+	ofs, err = NewOCIImageFS(bytes.NewReader(compressTestTar(t, "gzip", image)))
+	require.NoError(t, err)
+	temp := ofs.closer.(*ociTempArchive).Name()
+	testOCIImageFSRootfs(t, ofs)
+	require.NoError(t, ofs.Close())
+	require.NoFileExists(t, temp)
+
+	local := filepath.Join(t.TempDir(), "image.tar")
+	require.NoError(t, os.WriteFile(local, image, 0o644))
+	ofs, err = NewOCIImageFSFromLocal(local)
+	require.NoError(t, err)
+	testOCIImageFSRootfs(t, ofs)
+	require.NoError(t, ofs.Close())
+	_, err = ofs.ReadFile("etc/os-release")
+	require.Error(t, err)
+}
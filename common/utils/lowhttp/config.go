@@ -114,6 +114,10 @@ type LowhttpExecConfig struct {
 	MaxChunkDelay       time.Duration
 	ChunkedHandler      ChunkedResultHandler
 	chunkedSender       *RandomChunkedSender
+
+	// HostPolicy overrides the policy matched in the host policy registry
+	HostPolicy        *HostPolicy
+	DisableHostPolicy bool
 }
 
 type LowhttpResponse struct {
@@ -657,6 +661,19 @@ func WithContext(ctx context.Context) LowhttpOpt {
 	}
 }
 
+// WithHostPolicy limits the request by the policy instead of the registered host policy
+func WithHostPolicy(policy *HostPolicy) LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.HostPolicy = policy
+	}
+}
+
+func WithDisableHostPolicy(b bool) LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.DisableHostPolicy = b
+	}
+}
+
 func WithProxy(proxy ...string) LowhttpOpt {
 	return func(o *LowhttpExecConfig) {
 		o.Proxy = utils.StringArrayFilterEmpty(proxy)
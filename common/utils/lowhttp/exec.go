@@ -175,7 +175,7 @@ func addDebugCounter(prompt string) {
 }
 
 // HTTPWithoutRedirect SendHttpRequestWithRawPacketWithOpt
-func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
+func HTTPWithoutRedirect(opts ...LowhttpOpt) (_ *LowhttpResponse, retErr error) {
 	option := NewLowhttpOption()
 	for _, opt := range opts {
 		opt(option)
@@ -287,6 +287,20 @@ func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 		return nil
 	}
 
+	// host policy slot is held across retries, released when the request is finished
+	var hostGuard *hostPolicyGuard
+	defer func() {
+		if hostGuard == nil {
+			return
+		}
+		rspRaw := response.RawPacket
+		if len(rspRaw) == 0 {
+			rspRaw = response.BareResponse
+		}
+		hostGuard.report(rspRaw, retErr)
+		hostGuard.release()
+	}()
+
 RETRY:
 	connectTimeout = 2 * time.Second
 	if reqIns == nil {
@@ -550,6 +564,20 @@ RETRY:
 	}
 	originAddr := utils.HostPort(host, port)
 
+	/*
+		host policy: concurrency, rps, back-off and circuit breaker
+	*/
+	if hostGuard == nil {
+		if policy := option.getHostPolicy(ctx, host, port); policy != nil {
+			hostGuard, err = policy.acquire(ctx, originAddr)
+			if err != nil {
+				return response, err
+			}
+		}
+	} else if err := hostGuard.wait(ctx); err != nil {
+		return response, err
+	}
+
 	if timeout <= 0 {
 		timeout = 10 * time.Second
 	}
@@ -804,6 +832,9 @@ RECONNECT:
 			// status code retry
 			if retry(response, responsePacket, retryTimes) && (retryTimes < maxRetryTimes || retryHandler != nil) {
 				retryTimes += 1
+				if hostGuard != nil {
+					hostGuard.report(responsePacket, nil)
+				}
 				time.Sleep(utils.JitterBackoff(retryWaitTime, retryMaxWaitTime, retryTimes))
 				log.Infof("retry reconnect because [%d / %d]", retryTimes, maxRetryTimes)
 				goto RETRY
@@ -1106,6 +1137,9 @@ RECONNECT:
 	// status code retry
 	if retry(response, rawBytes, retryTimes) && (retryTimes < maxRetryTimes || retryHandler != nil) {
 		retryTimes += 1
+		if hostGuard != nil {
+			hostGuard.report(rawBytes, nil)
+		}
 		time.Sleep(utils.JitterBackoff(retryWaitTime, retryMaxWaitTime, retryTimes))
 		log.Infof("retry reconnect because [%d / %d]", retryTimes, maxRetryTimes)
 		goto RETRY
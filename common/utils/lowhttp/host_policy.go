@@ -0,0 +1,456 @@
+package lowhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	defaultHostPolicyMinBackoff      = time.Second
+	defaultHostPolicyMaxBackoff      = time.Minute
+	defaultHostPolicyBreakerCooldown = 30 * time.Second
+)
+
+var (
+	defaultHostPolicyBackoffStatusCodes = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+
+	// ErrHostCircuitOpen means too many failures of the host, requests are rejected until the cooldown ends
+	ErrHostCircuitOpen = errors.New("host circuit breaker is open")
+)
+
+// HostPolicy limits the requests sent to every host (host:port), zero value fields mean unlimited.
+// The state is kept in the policy, so all requests using the same policy share the limits.
+type HostPolicy struct {
+	// MaxConcurrent is the max number of requests in flight for a host
+	MaxConcurrent int
+	// RPS and Burst configure the token bucket of a host, Burst defaults to ceil(RPS)
+	RPS   float64
+	Burst int
+
+	// AdaptiveBackoff pauses the host when the response status is in BackoffStatusCodes (default 429 and 503),
+	// the pause honors Retry-After, or doubles from MinBackoff to MaxBackoff
+	AdaptiveBackoff    bool
+	BackoffStatusCodes []int
+	MinBackoff         time.Duration
+	MaxBackoff         time.Duration
+
+	// BreakerThreshold is the consecutive failures (connection errors and back-off responses) to open the
+	// circuit breaker, requests fail fast with ErrHostCircuitOpen in BreakerCooldown, then one request is
+	// allowed to probe the host
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+
+	mu     sync.Mutex
+	states map[string]*hostPolicyState
+}
+
+type hostPolicyState struct {
+	sem     chan struct{}
+	limiter *rate.Limiter
+
+	mu           sync.Mutex
+	backoff      time.Duration
+	backoffUntil time.Time
+	failures     int
+	openUntil    time.Time
+	probing      bool
+}
+
+// HostPolicyStatus is a snapshot of the state of a host
+type HostPolicyStatus struct {
+	InFlight     int
+	BackoffUntil time.Time
+	Failures     int
+	CircuitOpen  bool
+}
+
+func (p *HostPolicy) getState(addr string) *hostPolicyState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.states == nil {
+		p.states = make(map[string]*hostPolicyState)
+	}
+	if st, ok := p.states[addr]; ok {
+		return st
+	}
+	st := &hostPolicyState{}
+	if p.MaxConcurrent > 0 {
+		st.sem = make(chan struct{}, p.MaxConcurrent)
+	}
+	if p.RPS > 0 {
+		burst := p.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(p.RPS))
+		}
+		st.limiter = rate.NewLimiter(rate.Limit(p.RPS), burst)
+	}
+	p.states[addr] = st
+	return st
+}
+
+// Status returns the current state of the host (host:port)
+func (p *HostPolicy) Status(addr string) HostPolicyStatus {
+	st := p.getState(addr)
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return HostPolicyStatus{
+		InFlight:     len(st.sem),
+		BackoffUntil: st.backoffUntil,
+		Failures:     st.failures,
+		CircuitOpen:  time.Now().Before(st.openUntil),
+	}
+}
+
+func (p *HostPolicy) isBackoffStatusCode(code int) bool {
+	codes := p.BackoffStatusCodes
+	if len(codes) == 0 {
+		codes = defaultHostPolicyBackoffStatusCodes
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *HostPolicy) backoffRange() (time.Duration, time.Duration) {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultHostPolicyMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultHostPolicyMaxBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+	return minBackoff, maxBackoff
+}
+
+// acquire takes a concurrency slot of the host and waits for the rate limit,
+// the guard must be released after the request is finished
+func (p *HostPolicy) acquire(ctx context.Context, addr string) (*hostPolicyGuard, error) {
+	st := p.getState(addr)
+	guard := &hostPolicyGuard{policy: p, state: st, addr: addr}
+
+	if p.BreakerThreshold > 0 {
+		st.mu.Lock()
+		if !st.openUntil.IsZero() {
+			if time.Now().Before(st.openUntil) || st.probing {
+				st.mu.Unlock()
+				return nil, utils.Wrapf(ErrHostCircuitOpen, "%v", addr)
+			}
+			// half-open, only one request can probe the host
+			st.probing = true
+			guard.probing = true
+		}
+		st.mu.Unlock()
+	}
+
+	if st.sem != nil {
+		select {
+		case st.sem <- struct{}{}:
+			guard.holdSlot = true
+		case <-ctx.Done():
+			guard.release()
+			return nil, ctx.Err()
+		}
+	}
+	if err := guard.wait(ctx); err != nil {
+		guard.release()
+		return nil, err
+	}
+	return guard, nil
+}
+
+type hostPolicyGuard struct {
+	policy *HostPolicy
+	state  *hostPolicyState
+	addr   string
+
+	holdSlot bool
+	probing  bool
+	released bool
+}
+
+// wait blocks until the back-off of the host ends and a token of rps is available
+func (g *hostPolicyGuard) wait(ctx context.Context) error {
+	st := g.state
+	st.mu.Lock()
+	until := st.backoffUntil
+	st.mu.Unlock()
+	if d := time.Until(until); d > 0 {
+		timer := time.NewTimer(d)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+	if st.limiter != nil {
+		return st.limiter.Wait(ctx)
+	}
+	return nil
+}
+
+// report updates back-off and circuit breaker of the host by the result of a request
+func (g *hostPolicyGuard) report(rsp []byte, err error) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	p, st := g.policy, g.state
+	now := time.Now()
+	statusCode := 0
+	if len(rsp) > 0 {
+		statusCode = GetStatusCodeFromResponse(rsp)
+	}
+	failed := err != nil && statusCode == 0
+	backoffHit := p.AdaptiveBackoff && statusCode > 0 && p.isBackoffStatusCode(statusCode)
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if backoffHit {
+		minBackoff, maxBackoff := p.backoffRange()
+		d := parseRetryAfter(GetHTTPPacketHeader(rsp, "Retry-After"), now)
+		if d <= 0 {
+			d = st.backoff * 2
+		}
+		if d < minBackoff {
+			d = minBackoff
+		}
+		if d > maxBackoff {
+			d = maxBackoff
+		}
+		st.backoff = d
+		st.backoffUntil = now.Add(d)
+	} else if statusCode > 0 {
+		st.backoff = 0
+	}
+
+	if p.BreakerThreshold <= 0 {
+		return
+	}
+	if failed || backoffHit {
+		st.failures++
+		if g.probing || st.failures >= p.BreakerThreshold {
+			cooldown := p.BreakerCooldown
+			if cooldown <= 0 {
+				cooldown = defaultHostPolicyBreakerCooldown
+			}
+			st.openUntil = now.Add(cooldown)
+		}
+	} else if statusCode > 0 {
+		st.failures = 0
+		st.openUntil = time.Time{}
+	}
+}
+
+func (g *hostPolicyGuard) release() {
+	if g.released {
+		return
+	}
+	g.released = true
+	if g.probing {
+		g.state.mu.Lock()
+		g.state.probing = false
+		g.state.mu.Unlock()
+	}
+	if g.holdSlot {
+		<-g.state.sem
+	}
+}
+
+// parseRetryAfter parses delay-seconds or HTTP-date of Retry-After
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// HostPolicyRegistry maps host patterns to policies, a pattern can be host:port, host,
+// *.example.com (subdomains) or * (all hosts)
+type HostPolicyRegistry struct {
+	mu       sync.RWMutex
+	policies map[string]*HostPolicy
+}
+
+func NewHostPolicyRegistry() *HostPolicyRegistry {
+	return &HostPolicyRegistry{policies: make(map[string]*HostPolicy)}
+}
+
+// DefaultHostPolicyRegistry is consulted by all lowhttp requests without a matched task policy
+var DefaultHostPolicyRegistry = NewHostPolicyRegistry()
+
+// Set registers the policy of the pattern, nil policy removes the pattern
+func (r *HostPolicyRegistry) Set(pattern string, policy *HostPolicy) {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if policy == nil {
+		delete(r.policies, pattern)
+		return
+	}
+	r.policies[pattern] = policy
+}
+
+func (r *HostPolicyRegistry) Remove(pattern string) {
+	r.Set(pattern, nil)
+}
+
+func (r *HostPolicyRegistry) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.policies = make(map[string]*HostPolicy)
+}
+
+// Policies returns a copy of the registered patterns and policies
+func (r *HostPolicyRegistry) Policies() map[string]*HostPolicy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	policies := make(map[string]*HostPolicy, len(r.policies))
+	for pattern, p := range r.policies {
+		policies[pattern] = p
+	}
+	return policies
+}
+
+// Match returns the most specific policy of the host, nil if not found
+func (r *HostPolicyRegistry) Match(host string, port int) *HostPolicy {
+	if r == nil {
+		return nil
+	}
+	host = strings.ToLower(strings.Trim(host, "[]"))
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.policies) == 0 {
+		return nil
+	}
+	if p, ok := r.policies[utils.HostPort(host, port)]; ok {
+		return p
+	}
+	if p, ok := r.policies[host]; ok {
+		return p
+	}
+	for domain := host; ; {
+		idx := strings.IndexByte(domain, '.')
+		if idx < 0 {
+			break
+		}
+		domain = domain[idx+1:]
+		if p, ok := r.policies["*."+domain]; ok {
+			return p
+		}
+	}
+	return r.policies["*"]
+}
+
+// SetHostPolicy registers the policy of the pattern in DefaultHostPolicyRegistry
+func SetHostPolicy(pattern string, policy *HostPolicy) {
+	DefaultHostPolicyRegistry.Set(pattern, policy)
+}
+
+func RemoveHostPolicy(pattern string) {
+	DefaultHostPolicyRegistry.Remove(pattern)
+}
+
+func ResetHostPolicy() {
+	DefaultHostPolicyRegistry.Reset()
+}
+
+type hostPolicyRegistryContextKey struct{}
+
+// ContextWithHostPolicyRegistry binds a task-scoped registry to ctx, the requests using ctx consult it first,
+// DefaultHostPolicyRegistry is only used for the hosts not matched by the task registry
+func ContextWithHostPolicyRegistry(ctx context.Context, r *HostPolicyRegistry) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, hostPolicyRegistryContextKey{}, r)
+}
+
+func HostPolicyRegistryFromContext(ctx context.Context) *HostPolicyRegistry {
+	if ctx == nil {
+		return nil
+	}
+	r, _ := ctx.Value(hostPolicyRegistryContextKey{}).(*HostPolicyRegistry)
+	return r
+}
+
+// HostPolicyConfig is the json form of HostPolicy, durations are in seconds
+type HostPolicyConfig struct {
+	MaxConcurrent          int     `json:"max_concurrent,omitempty"`
+	RPS                    float64 `json:"rps,omitempty"`
+	Burst                  int     `json:"burst,omitempty"`
+	AdaptiveBackoff        bool    `json:"adaptive_backoff,omitempty"`
+	BackoffStatusCodes     []int   `json:"backoff_status_codes,omitempty"`
+	MinBackoffSeconds      float64 `json:"min_backoff_seconds,omitempty"`
+	MaxBackoffSeconds      float64 `json:"max_backoff_seconds,omitempty"`
+	BreakerThreshold       int     `json:"breaker_threshold,omitempty"`
+	BreakerCooldownSeconds float64 `json:"breaker_cooldown_seconds,omitempty"`
+}
+
+func (c *HostPolicyConfig) ToHostPolicy() *HostPolicy {
+	return &HostPolicy{
+		MaxConcurrent:      c.MaxConcurrent,
+		RPS:                c.RPS,
+		Burst:              c.Burst,
+		AdaptiveBackoff:    c.AdaptiveBackoff,
+		BackoffStatusCodes: c.BackoffStatusCodes,
+		MinBackoff:         utils.FloatSecondDuration(c.MinBackoffSeconds),
+		MaxBackoff:         utils.FloatSecondDuration(c.MaxBackoffSeconds),
+		BreakerThreshold:   c.BreakerThreshold,
+		BreakerCooldown:    utils.FloatSecondDuration(c.BreakerCooldownSeconds),
+	}
+}
+
+// NewHostPolicyRegistryFromJSON builds a registry from {"pattern": HostPolicyConfig}, e.g.
+// {"*": {"max_concurrent": 4, "adaptive_backoff": true}, "*.example.com": {"rps": 5}}
+func NewHostPolicyRegistryFromJSON(raw []byte) (*HostPolicyRegistry, error) {
+	var configs map[string]*HostPolicyConfig
+	if err := json.Unmarshal(raw, &configs); err != nil {
+		return nil, utils.Wrap(err, "parse host policy config failed")
+	}
+	r := NewHostPolicyRegistry()
+	for pattern, config := range configs {
+		if config == nil {
+			continue
+		}
+		r.Set(pattern, config.ToHostPolicy())
+	}
+	return r, nil
+}
+
+// getHostPolicy returns the policy of the task registry, DefaultHostPolicyRegistry is the default
+func (o *LowhttpExecConfig) getHostPolicy(ctx context.Context, host string, port int) *HostPolicy {
+	if o.DisableHostPolicy {
+		return nil
+	}
+	if o.HostPolicy != nil {
+		return o.HostPolicy
+	}
+	if p := HostPolicyRegistryFromContext(ctx).Match(host, port); p != nil {
+		return p
+	}
+	return DefaultHostPolicyRegistry.Match(host, port)
+}
@@ -0,0 +1,247 @@
+package lowhttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func hostPolicyTestPacket(host string, port int) []byte {
+	return []byte("GET / HTTP/1.1\r\nHost: " + utils.HostPort(host, port) + "\r\n\r\n")
+}
+
+func TestHostPolicy_MaxConcurrent(t *testing.T) {
+	var inFlight, maxInFlight int64
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		n := atomic.AddInt64(&inFlight, 1)
+		defer atomic.AddInt64(&inFlight, -1)
+		for {
+			old := atomic.LoadInt64(&maxInFlight)
+			if n <= old || atomic.CompareAndSwapInt64(&maxInFlight, old, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		writer.Write([]byte("ok"))
+	})
+
+	policy := &HostPolicy{MaxConcurrent: 2}
+	wg := new(sync.WaitGroup)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := HTTP(WithPacketBytes(hostPolicyTestPacket(host, port)), WithHostPolicy(policy), WithTimeout(5*time.Second))
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	require.LessOrEqual(t, atomic.LoadInt64(&maxInFlight), int64(2))
+	require.Equal(t, 0, policy.Status(utils.HostPort(host, port)).InFlight)
+}
+
+func TestHostPolicy_RPS(t *testing.T) {
+	host, port := utils.DebugMockHTTP([]byte("HTTP/1.1 200 OK\r\nContent-Length: 2\r\n\r\nok"))
+
+	policy := &HostPolicy{RPS: 10, Burst: 1}
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		_, err := HTTP(WithPacketBytes(hostPolicyTestPacket(host, port)), WithHostPolicy(policy))
+		require.NoError(t, err)
+	}
+	// the first token is available at once, the other 5 requests wait 100ms each
+	require.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond)
+}
+
+func TestHostPolicy_RetryAfter(t *testing.T) {
+	var count int64
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt64(&count, 1) == 1 {
+			writer.Header().Set("Retry-After", "1")
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writer.Write([]byte("ok"))
+	})
+
+	policy := &HostPolicy{AdaptiveBackoff: true, MinBackoff: 100 * time.Millisecond, MaxBackoff: 5 * time.Second}
+	rsp, err := HTTP(WithPacketBytes(hostPolicyTestPacket(host, port)), WithHostPolicy(policy))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, GetStatusCodeFromResponse(rsp.RawPacket))
+	require.True(t, policy.Status(utils.HostPort(host, port)).BackoffUntil.After(time.Now()))
+
+	start := time.Now()
+	rsp, err = HTTP(WithPacketBytes(hostPolicyTestPacket(host, port)), WithHostPolicy(policy))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, GetStatusCodeFromResponse(rsp.RawPacket))
+	require.GreaterOrEqual(t, time.Since(start), 800*time.Millisecond)
+}
+
+func TestHostPolicy_RetryInStatusCodeBackoff(t *testing.T) {
+	var count int64
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt64(&count, 1) <= 2 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.Write([]byte("ok"))
+	})
+
+	policy := &HostPolicy{AdaptiveBackoff: true, MinBackoff: 200 * time.Millisecond, MaxBackoff: time.Second}
+	start := time.Now()
+	rsp, err := HTTP(
+		WithPacketBytes(hostPolicyTestPacket(host, port)), WithHostPolicy(policy),
+		WithRetryTimes(3), WithRetryInStatusCode([]int{503}),
+		WithRetryWaitTime(time.Millisecond), WithRetryMaxWaitTime(time.Millisecond),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, GetStatusCodeFromResponse(rsp.RawPacket))
+	// back-off of retries: 200ms then 400ms
+	require.GreaterOrEqual(t, time.Since(start), 550*time.Millisecond)
+}
+
+func TestHostPolicy_CircuitBreaker(t *testing.T) {
+	var count int64
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt64(&count, 1) <= 2 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.Write([]byte("ok"))
+	})
+
+	policy := &HostPolicy{
+		AdaptiveBackoff:  true,
+		MinBackoff:       time.Millisecond,
+		MaxBackoff:       time.Millisecond,
+		BreakerThreshold: 2,
+		BreakerCooldown:  300 * time.Millisecond,
+	}
+	send := func() (*LowhttpResponse, error) {
+		return HTTP(WithPacketBytes(hostPolicyTestPacket(host, port)), WithHostPolicy(policy))
+	}
+	for i := 0; i < 2; i++ {
+		_, err := send()
+		require.NoError(t, err)
+	}
+	require.True(t, policy.Status(utils.HostPort(host, port)).CircuitOpen)
+
+	_, err := send()
+	require.True(t, errors.Is(err, ErrHostCircuitOpen), err)
+	require.Equal(t, int64(2), atomic.LoadInt64(&count))
+
+	// half-open after cooldown, a success closes the breaker
+	time.Sleep(350 * time.Millisecond)
+	rsp, err := send()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, GetStatusCodeFromResponse(rsp.RawPacket))
+	require.False(t, policy.Status(utils.HostPort(host, port)).CircuitOpen)
+	_, err = send()
+	require.NoError(t, err)
+}
+
+func TestHostPolicy_Registry(t *testing.T) {
+	r := NewHostPolicyRegistry()
+	all := &HostPolicy{MaxConcurrent: 1}
+	sub := &HostPolicy{MaxConcurrent: 2}
+	exact := &HostPolicy{MaxConcurrent: 3}
+	withPort := &HostPolicy{MaxConcurrent: 4}
+	r.Set("*", all)
+	r.Set("*.example.com", sub)
+	r.Set("www.Example.com", exact)
+	r.Set("www.example.com:8080", withPort)
+
+	require.Equal(t, withPort, r.Match("www.example.com", 8080))
+	require.Equal(t, exact, r.Match("www.example.com", 443))
+	require.Equal(t, sub, r.Match("a.b.example.com", 80))
+	require.Equal(t, all, r.Match("example.com", 80))
+	require.Equal(t, all, r.Match("127.0.0.1", 80))
+
+	r.Remove("*")
+	require.Nil(t, r.Match("127.0.0.1", 80))
+	r.Reset()
+	require.Nil(t, r.Match("www.example.com", 8080))
+
+	r, err := NewHostPolicyRegistryFromJSON([]byte(`{"*": {"max_concurrent": 4, "adaptive_backoff": true, "min_backoff_seconds": 0.5}, "*.example.com": {"rps": 5}}`))
+	require.NoError(t, err)
+	p := r.Match("127.0.0.1", 80)
+	require.NotNil(t, p)
+	require.Equal(t, 4, p.MaxConcurrent)
+	require.Equal(t, 500*time.Millisecond, p.MinBackoff)
+	require.Equal(t, float64(5), r.Match("www.example.com", 80).RPS)
+
+	_, err = NewHostPolicyRegistryFromJSON([]byte(`[]`))
+	require.Error(t, err)
+}
+
+func TestHostPolicy_ContextRegistry(t *testing.T) {
+	var count int64
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt64(&count, 1)
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	r := NewHostPolicyRegistry()
+	r.Set(host, &HostPolicy{BreakerThreshold: 1, AdaptiveBackoff: true, MinBackoff: time.Millisecond, BreakerCooldown: time.Minute})
+	ctx := ContextWithHostPolicyRegistry(context.Background(), r)
+	send := func(opts ...LowhttpOpt) error {
+		_, err := HTTP(append([]LowhttpOpt{WithPacketBytes(hostPolicyTestPacket(host, port))}, opts...)...)
+		return err
+	}
+	require.NoError(t, send(WithContext(ctx)))
+	require.ErrorIs(t, send(WithContext(ctx)), ErrHostCircuitOpen)
+	// the task registry does not affect other requests
+	require.NoError(t, send())
+	require.NoError(t, send(WithContext(ctx), WithDisableHostPolicy(true)))
+	require.Equal(t, int64(3), atomic.LoadInt64(&count))
+}
+
+func TestHostPolicy_ContextRegistryDefault(t *testing.T) {
+	var count int64
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt64(&count, 1)
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	SetHostPolicy(host, &HostPolicy{BreakerThreshold: 1, AdaptiveBackoff: true, MinBackoff: time.Millisecond, BreakerCooldown: time.Minute})
+	t.Cleanup(func() {
+		RemoveHostPolicy(host)
+	})
+	send := func(opts ...LowhttpOpt) error {
+		_, err := HTTP(append([]LowhttpOpt{WithPacketBytes(hostPolicyTestPacket(host, port))}, opts...)...)
+		return err
+	}
+
+	// the task policy of the host replaces the global one
+	r := NewHostPolicyRegistry()
+	r.Set(host, &HostPolicy{MaxConcurrent: 1})
+	ctx := ContextWithHostPolicyRegistry(context.Background(), r)
+	require.NoError(t, send(WithContext(ctx)))
+	require.NoError(t, send(WithContext(ctx)))
+	require.Equal(t, 0, r.Match(host, port).Status(utils.HostPort(host, port)).InFlight)
+
+	// the global policy is the default of the hosts not matched by the task registry
+	other := NewHostPolicyRegistry()
+	other.Set("other.host-policy.test", &HostPolicy{MaxConcurrent: 1})
+	otherCtx := ContextWithHostPolicyRegistry(context.Background(), other)
+	require.NoError(t, send(WithContext(otherCtx)))
+	require.ErrorIs(t, send(WithContext(otherCtx)), ErrHostCircuitOpen)
+	require.ErrorIs(t, send(), ErrHostCircuitOpen)
+	require.NoError(t, send(WithContext(ctx)))
+	require.Equal(t, int64(4), atomic.LoadInt64(&count))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, 3*time.Second, parseRetryAfter("3", now))
+	require.Equal(t, 10*time.Second, parseRetryAfter("Mon, 01 Jan 2024 00:00:10 GMT", now))
+	require.Equal(t, time.Duration(0), parseRetryAfter("abc", now))
+	require.Equal(t, time.Duration(0), parseRetryAfter("", now))
+}
@@ -35,6 +35,8 @@ var (
 	PosCookieBase64Json    HttpParamPositionType = "cookie-base64-json"
 	PosPathAppend          HttpParamPositionType = "path-append"
 	PosPathBlock           HttpParamPositionType = "path-block"
+	PosGraphQLVariable     HttpParamPositionType = "graphql-variable"
+	PosGraphQLArgument     HttpParamPositionType = "graphql-argument"
 )
 
 func ForceStringToUrl(i string) *url.URL {
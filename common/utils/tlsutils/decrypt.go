@@ -0,0 +1,584 @@
+package tlsutils
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"encoding/binary"
+	"hash"
+	"io"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	tlsRecordTypeChangeCipherSpec = 20
+	tlsRecordTypeAlert            = 21
+	tlsRecordTypeHandshake        = 22
+	tlsRecordTypeApplicationData  = 23
+
+	tlsHandshakeTypeClientHello = 1
+	tlsHandshakeTypeServerHello = 2
+	tlsHandshakeTypeFinished    = 20
+	tlsHandshakeTypeKeyUpdate   = 24
+
+	tlsExtensionEncryptThenMac    = 22
+	tlsExtensionSupportedVersions = 43
+
+	tlsRecordHeaderLength = 5
+	// 密文记录最大为 2^14 + 2048
+	tlsMaxRecordLength = 16384 + 2048
+)
+
+// helloRetryRequestRandom 是 HelloRetryRequest 中固定的 ServerHello.random
+var helloRetryRequestRandom = []byte{
+	0xCF, 0x21, 0xAD, 0x74, 0xE5, 0x9A, 0x61, 0x11,
+	0xBE, 0x1D, 0x8C, 0x02, 0x1E, 0x65, 0xB8, 0x91,
+	0xC2, 0xA2, 0x11, 0x16, 0x7A, 0xBB, 0x8C, 0x5E,
+	0x07, 0x9E, 0x09, 0xE2, 0xC8, 0xA8, 0x33, 0x9C,
+}
+
+type tlsCipherSuiteSpec struct {
+	keyLen int
+	// TLS 1.2 key block 中的 IV 长度，GCM 为 4 字节的 implicit nonce
+	ivLen int
+	// CBC 模式下的 MAC 长度，AEAD 为 0
+	macLen int
+	// PRF / HKDF 使用的哈希
+	hash func() hash.Hash
+	aead func(key []byte) (cipher.AEAD, error)
+}
+
+func aeadAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func aeadChaCha20Poly1305(key []byte) (cipher.AEAD, error) {
+	return chacha20poly1305.New(key)
+}
+
+var (
+	suiteAES128GCMSHA256        = &tlsCipherSuiteSpec{keyLen: 16, ivLen: 4, hash: sha256.New, aead: aeadAESGCM}
+	suiteAES256GCMSHA384        = &tlsCipherSuiteSpec{keyLen: 32, ivLen: 4, hash: sha512.New384, aead: aeadAESGCM}
+	suiteChaCha20Poly1305SHA256 = &tlsCipherSuiteSpec{keyLen: 32, ivLen: 12, hash: sha256.New, aead: aeadChaCha20Poly1305}
+	suiteAES128CBCSHA           = &tlsCipherSuiteSpec{keyLen: 16, ivLen: 16, macLen: sha1.Size, hash: sha256.New}
+	suiteAES256CBCSHA           = &tlsCipherSuiteSpec{keyLen: 32, ivLen: 16, macLen: sha1.Size, hash: sha256.New}
+	suiteAES128CBCSHA256        = &tlsCipherSuiteSpec{keyLen: 16, ivLen: 16, macLen: sha256.Size, hash: sha256.New}
+
+	suiteTLS13AES128GCMSHA256        = &tlsCipherSuiteSpec{keyLen: 16, ivLen: 12, hash: sha256.New, aead: aeadAESGCM}
+	suiteTLS13AES256GCMSHA384        = &tlsCipherSuiteSpec{keyLen: 32, ivLen: 12, hash: sha512.New384, aead: aeadAESGCM}
+	suiteTLS13ChaCha20Poly1305SHA256 = &tlsCipherSuiteSpec{keyLen: 32, ivLen: 12, hash: sha256.New, aead: aeadChaCha20Poly1305}
+)
+
+// tlsDecryptableCipherSuites 是目前支持解密的密码套件，RSA / ECDHE 密钥交换对解密没有影响
+var tlsDecryptableCipherSuites = map[uint16]*tlsCipherSuiteSpec{
+	tls.TLS_RSA_WITH_AES_128_GCM_SHA256:               suiteAES128GCMSHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256:         suiteAES128GCMSHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256:       suiteAES128GCMSHA256,
+	tls.TLS_RSA_WITH_AES_256_GCM_SHA384:               suiteAES256GCMSHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384:         suiteAES256GCMSHA384,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384:       suiteAES256GCMSHA384,
+	tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256:   suiteChaCha20Poly1305SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256: suiteChaCha20Poly1305SHA256,
+	tls.TLS_RSA_WITH_AES_128_CBC_SHA:                  suiteAES128CBCSHA,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA:            suiteAES128CBCSHA,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA:          suiteAES128CBCSHA,
+	tls.TLS_RSA_WITH_AES_256_CBC_SHA:                  suiteAES256CBCSHA,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA:            suiteAES256CBCSHA,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA:          suiteAES256CBCSHA,
+	tls.TLS_RSA_WITH_AES_128_CBC_SHA256:               suiteAES128CBCSHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256:         suiteAES128CBCSHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256:       suiteAES128CBCSHA256,
+
+	tls.TLS_AES_128_GCM_SHA256:       suiteTLS13AES128GCMSHA256,
+	tls.TLS_AES_256_GCM_SHA384:       suiteTLS13AES256GCMSHA384,
+	tls.TLS_CHACHA20_POLY1305_SHA256: suiteTLS13ChaCha20Poly1305SHA256,
+}
+
+type tlsRecordDecrypter interface {
+	// decrypt 解密一条记录，返回真实的 content type 与明文
+	decrypt(header, payload []byte, seq uint64) (uint8, []byte, error)
+}
+
+func tlsXorNonce(iv []byte, seq uint64) []byte {
+	nonce := make([]byte, len(iv))
+	copy(nonce, iv)
+	for i := 0; i < 8; i++ {
+		nonce[len(nonce)-1-i] ^= byte(seq >> (8 * i))
+	}
+	return nonce
+}
+
+// tls12AEADDecrypter: GCM 使用 4 字节 implicit nonce + 记录中 8 字节 explicit nonce，ChaCha20-Poly1305 与序列号异或（RFC 7905）
+type tls12AEADDecrypter struct {
+	aead          cipher.AEAD
+	iv            []byte
+	explicitNonce bool
+}
+
+func (c *tls12AEADDecrypter) decrypt(header, payload []byte, seq uint64) (uint8, []byte, error) {
+	var nonce []byte
+	if c.explicitNonce {
+		if len(payload) < 8 {
+			return 0, nil, utils.Error("tls record too short for explicit nonce")
+		}
+		nonce = append(append([]byte{}, c.iv...), payload[:8]...)
+		payload = payload[8:]
+	} else {
+		nonce = tlsXorNonce(c.iv, seq)
+	}
+	if len(payload) < c.aead.Overhead() {
+		return 0, nil, utils.Error("tls record too short")
+	}
+
+	additionalData := make([]byte, 13)
+	binary.BigEndian.PutUint64(additionalData, seq)
+	copy(additionalData[8:11], header[:3])
+	binary.BigEndian.PutUint16(additionalData[11:], uint16(len(payload)-c.aead.Overhead()))
+	plaintext, err := c.aead.Open(nil, nonce, payload, additionalData)
+	if err != nil {
+		return 0, nil, err
+	}
+	return header[0], plaintext, nil
+}
+
+// tls12CBCDecrypter 解密 CBC 模式的记录，只去除 MAC 而不校验
+type tls12CBCDecrypter struct {
+	block          cipher.Block
+	macLen         int
+	encryptThenMac bool
+}
+
+func (c *tls12CBCDecrypter) decrypt(header, payload []byte, seq uint64) (uint8, []byte, error) {
+	if c.encryptThenMac {
+		if len(payload) < c.macLen {
+			return 0, nil, utils.Error("tls record too short for mac")
+		}
+		payload = payload[:len(payload)-c.macLen]
+	}
+	blockSize := c.block.BlockSize()
+	if len(payload) < 2*blockSize || len(payload)%blockSize != 0 {
+		return 0, nil, utils.Errorf("invalid tls cbc record length: %d", len(payload))
+	}
+	plaintext := make([]byte, len(payload)-blockSize)
+	cipher.NewCBCDecrypter(c.block, payload[:blockSize]).CryptBlocks(plaintext, payload[blockSize:])
+
+	paddingLen := int(plaintext[len(plaintext)-1]) + 1
+	if paddingLen > len(plaintext) {
+		return 0, nil, utils.Error("invalid tls cbc padding")
+	}
+	plaintext = plaintext[:len(plaintext)-paddingLen]
+	if !c.encryptThenMac {
+		if len(plaintext) < c.macLen {
+			return 0, nil, utils.Error("tls record too short for mac")
+		}
+		plaintext = plaintext[:len(plaintext)-c.macLen]
+	}
+	return header[0], plaintext, nil
+}
+
+// tls13Decrypter 的附加数据为记录头，真实的 content type 在明文末尾（去除 0 填充之后）
+type tls13Decrypter struct {
+	aead cipher.AEAD
+	iv   []byte
+}
+
+func (c *tls13Decrypter) decrypt(header, payload []byte, seq uint64) (uint8, []byte, error) {
+	plaintext, err := c.aead.Open(nil, tlsXorNonce(c.iv, seq), payload, header)
+	if err != nil {
+		return 0, nil, err
+	}
+	i := len(plaintext) - 1
+	for i >= 0 && plaintext[i] == 0 {
+		i--
+	}
+	if i < 0 {
+		return 0, nil, utils.Error("tls 1.3 inner plaintext without content type")
+	}
+	return plaintext[i], plaintext[:i], nil
+}
+
+// tls12PRF 是 RFC 5246 中定义的 P_hash
+func tls12PRF(h func() hash.Hash, secret []byte, label string, seed []byte, length int) []byte {
+	labelAndSeed := append([]byte(label), seed...)
+	mac := hmac.New(h, secret)
+	mac.Write(labelAndSeed)
+	a := mac.Sum(nil)
+
+	result := make([]byte, 0, length+mac.Size())
+	for len(result) < length {
+		mac.Reset()
+		mac.Write(a)
+		mac.Write(labelAndSeed)
+		result = mac.Sum(result)
+
+		mac.Reset()
+		mac.Write(a)
+		a = mac.Sum(nil)
+	}
+	return result[:length]
+}
+
+// tls13ExpandLabel 是 RFC 8446 中定义的 HKDF-Expand-Label
+func tls13ExpandLabel(h func() hash.Hash, secret []byte, label string, context []byte, length int) []byte {
+	label = "tls13 " + label
+	info := make([]byte, 0, 4+len(label)+len(context))
+	info = append(info, byte(length>>8), byte(length))
+	info = append(info, byte(len(label)))
+	info = append(info, label...)
+	info = append(info, byte(len(context)))
+	info = append(info, context...)
+
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.Expand(h, secret, info), out); err != nil {
+		// hkdf 只有在输出过长时才会失败
+		panic(err)
+	}
+	return out
+}
+
+type tlsHalfStream struct {
+	isClient bool
+	// 尚未组成完整记录的数据
+	pending []byte
+	// 尚未组成完整握手消息的数据
+	handshake []byte
+
+	decrypter tlsRecordDecrypter
+	seq       uint64
+	// TLS 1.3 当前使用的 traffic secret，用于处理 KeyUpdate
+	trafficSecret   []byte
+	applicationKeys bool
+}
+
+// TLSStreamDecryptor 使用 Key Log 中的密钥解密一条 TCP 连接中的 TLS 记录
+// 两个方向的数据需要按照到达顺序通过 Feed 输入，输出为解密后的应用层数据
+// 支持 TLS 1.2 (AES-GCM / ChaCha20-Poly1305 / AES-CBC) 与 TLS 1.3
+type TLSStreamDecryptor struct {
+	keyLog *KeyLog
+
+	clientHello    *HandshakeClientHello
+	serverRandom   []byte
+	version        uint16
+	cipherSuite    uint16
+	encryptThenMac bool
+
+	client *tlsHalfStream
+	server *tlsHalfStream
+}
+
+func NewTLSStreamDecryptor(keyLog *KeyLog) *TLSStreamDecryptor {
+	return &TLSStreamDecryptor{
+		keyLog: keyLog,
+		client: &tlsHalfStream{isClient: true},
+		server: &tlsHalfStream{},
+	}
+}
+
+// Version 返回协商的 TLS 版本，ServerHello 之前为 0
+func (d *TLSStreamDecryptor) Version() uint16 {
+	return d.version
+}
+
+// CipherSuite 返回协商的密码套件，ServerHello 之前为 0
+func (d *TLSStreamDecryptor) CipherSuite() uint16 {
+	return d.cipherSuite
+}
+
+// ClientHello 返回客户端的 ClientHello，可以用来获取 SNI / ALPN
+func (d *TLSStreamDecryptor) ClientHello() *HandshakeClientHello {
+	return d.clientHello
+}
+
+// Feed 输入一个方向的原始 TCP 数据，返回其中已经解密的应用层数据
+// 记录可以跨越多次 Feed，不完整的部分会被缓存
+func (d *TLSStreamDecryptor) Feed(fromClient bool, data []byte) ([]byte, error) {
+	half := d.server
+	if fromClient {
+		half = d.client
+	}
+	half.pending = append(half.pending, data...)
+
+	var output []byte
+	for len(half.pending) >= tlsRecordHeaderLength {
+		header := half.pending[:tlsRecordHeaderLength]
+		length := int(binary.BigEndian.Uint16(header[3:5]))
+		if header[0] < tlsRecordTypeChangeCipherSpec || header[0] > tlsRecordTypeApplicationData || header[1] != 3 || length > tlsMaxRecordLength {
+			return output, utils.Errorf("invalid tls record header: %x", header)
+		}
+		if len(half.pending) < tlsRecordHeaderLength+length {
+			break
+		}
+		payload := half.pending[tlsRecordHeaderLength : tlsRecordHeaderLength+length]
+		half.pending = half.pending[tlsRecordHeaderLength+length:]
+
+		plaintext, err := d.handleRecord(half, header, payload)
+		if err != nil {
+			return output, err
+		}
+		output = append(output, plaintext...)
+	}
+	if len(half.pending) == 0 {
+		half.pending = nil
+	}
+	return output, nil
+}
+
+func (d *TLSStreamDecryptor) handleRecord(half *tlsHalfStream, header, payload []byte) ([]byte, error) {
+	contentType := header[0]
+	if contentType == tlsRecordTypeChangeCipherSpec {
+		// TLS 1.3 中的 ChangeCipherSpec 只用于兼容中间设备
+		if d.version == tls.VersionTLS13 {
+			return nil, nil
+		}
+		return nil, d.installTLS12Keys(half)
+	}
+
+	if half.decrypter == nil {
+		if contentType != tlsRecordTypeApplicationData || d.version != tls.VersionTLS13 {
+			if contentType == tlsRecordTypeHandshake {
+				return nil, d.handlePlainHandshake(half, payload)
+			}
+			return nil, nil
+		}
+		// TLS 1.3 中 ServerHello 之后的记录均使用握手阶段的密钥加密
+		if err := d.installTLS13Keys(half); err != nil {
+			return nil, err
+		}
+	}
+
+	contentType, plaintext, err := half.decrypter.decrypt(header, payload, half.seq)
+	if err != nil {
+		if d.version == tls.VersionTLS13 && half.isClient && !half.applicationKeys && half.seq == 0 {
+			// 0-RTT early data 使用的是 early traffic secret，直接忽略
+			return nil, nil
+		}
+		return nil, utils.Errorf("decrypt tls record failed: %v", err)
+	}
+	half.seq++
+
+	switch contentType {
+	case tlsRecordTypeApplicationData:
+		return plaintext, nil
+	case tlsRecordTypeHandshake:
+		if d.version == tls.VersionTLS13 {
+			return nil, d.handleTLS13Handshake(half, plaintext)
+		}
+	}
+	return nil, nil
+}
+
+// nextHandshakeMessage 从缓存中取出一条完整的握手消息
+func (half *tlsHalfStream) nextHandshakeMessage() (uint8, []byte, bool) {
+	if len(half.handshake) < 4 {
+		return 0, nil, false
+	}
+	length := int(half.handshake[1])<<16 | int(half.handshake[2])<<8 | int(half.handshake[3])
+	if len(half.handshake) < 4+length {
+		return 0, nil, false
+	}
+	msg := half.handshake[:4+length]
+	half.handshake = half.handshake[4+length:]
+	return msg[0], msg, true
+}
+
+func (d *TLSStreamDecryptor) handlePlainHandshake(half *tlsHalfStream, payload []byte) error {
+	half.handshake = append(half.handshake, payload...)
+	for {
+		msgType, msg, ok := half.nextHandshakeMessage()
+		if !ok {
+			return nil
+		}
+		switch {
+		case msgType == tlsHandshakeTypeClientHello && half.isClient:
+			hello, err := ParseClientHello(msg)
+			if err != nil {
+				return err
+			}
+			d.clientHello = hello
+		case msgType == tlsHandshakeTypeServerHello && !half.isClient:
+			if err := d.parseServerHello(msg[4:]); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (d *TLSStreamDecryptor) parseServerHello(body []byte) error {
+	if len(body) < 35 {
+		return utils.Error("tls server hello too short")
+	}
+	version := binary.BigEndian.Uint16(body[0:2])
+	random := body[2:34]
+	offset := 35 + int(body[34])
+	if len(body) < offset+3 {
+		return utils.Error("tls server hello too short")
+	}
+	cipherSuite := binary.BigEndian.Uint16(body[offset : offset+2])
+	offset += 3
+
+	encryptThenMac := false
+	if len(body) >= offset+2 {
+		extensions := body[offset+2:]
+		if l := int(binary.BigEndian.Uint16(body[offset : offset+2])); l < len(extensions) {
+			extensions = extensions[:l]
+		}
+		for len(extensions) >= 4 {
+			extType := binary.BigEndian.Uint16(extensions[0:2])
+			extLen := int(binary.BigEndian.Uint16(extensions[2:4]))
+			if len(extensions) < 4+extLen {
+				break
+			}
+			data := extensions[4 : 4+extLen]
+			extensions = extensions[4+extLen:]
+			switch extType {
+			case tlsExtensionSupportedVersions:
+				if len(data) == 2 {
+					version = binary.BigEndian.Uint16(data)
+				}
+			case tlsExtensionEncryptThenMac:
+				encryptThenMac = true
+			}
+		}
+	}
+
+	d.version = version
+	d.cipherSuite = cipherSuite
+	if bytes.Equal(random, helloRetryRequestRandom) {
+		// HelloRetryRequest 之后还会有真正的 ServerHello
+		return nil
+	}
+	d.serverRandom = append([]byte{}, random...)
+	d.encryptThenMac = encryptThenMac
+	return nil
+}
+
+func (d *TLSStreamDecryptor) cipherSuiteSpec() (*tlsCipherSuiteSpec, error) {
+	if d.clientHello == nil || d.serverRandom == nil {
+		return nil, utils.Error("tls client hello or server hello is missing")
+	}
+	spec, ok := tlsDecryptableCipherSuites[d.cipherSuite]
+	if !ok {
+		return nil, utils.Errorf("unsupported tls cipher suite: %v", tls.CipherSuiteName(d.cipherSuite))
+	}
+	return spec, nil
+}
+
+func (d *TLSStreamDecryptor) installTLS12Keys(half *tlsHalfStream) error {
+	spec, err := d.cipherSuiteSpec()
+	if err != nil {
+		return err
+	}
+	masterSecret := d.keyLog.lookupSecret(d.clientHello.Random, func(s *KeyLogSecrets) []byte {
+		return s.MasterSecret
+	})
+	if len(masterSecret) == 0 {
+		return utils.Errorf("master secret for client random %x not found", d.clientHello.Random)
+	}
+
+	seed := append(append([]byte{}, d.serverRandom...), d.clientHello.Random...)
+	keyBlock := tls12PRF(spec.hash, masterSecret, "key expansion", seed, 2*(spec.macLen+spec.keyLen+spec.ivLen))
+	// client_write_MAC_key / server_write_MAC_key / client_write_key / server_write_key / client_write_IV / server_write_IV
+	keyBlock = keyBlock[2*spec.macLen:]
+	clientKey, serverKey := keyBlock[:spec.keyLen], keyBlock[spec.keyLen:2*spec.keyLen]
+	keyBlock = keyBlock[2*spec.keyLen:]
+	clientIV, serverIV := keyBlock[:spec.ivLen], keyBlock[spec.ivLen:2*spec.ivLen]
+
+	key, iv := serverKey, serverIV
+	if half.isClient {
+		key, iv = clientKey, clientIV
+	}
+	if spec.aead != nil {
+		aead, err := spec.aead(key)
+		if err != nil {
+			return err
+		}
+		half.decrypter = &tls12AEADDecrypter{aead: aead, iv: iv, explicitNonce: spec.ivLen == 4}
+	} else {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return err
+		}
+		half.decrypter = &tls12CBCDecrypter{block: block, macLen: spec.macLen, encryptThenMac: d.encryptThenMac}
+	}
+	half.seq = 0
+	return nil
+}
+
+func (d *TLSStreamDecryptor) installTLS13Keys(half *tlsHalfStream) error {
+	if _, err := d.cipherSuiteSpec(); err != nil {
+		return err
+	}
+	secret := d.keyLog.lookupSecret(d.clientHello.Random, func(s *KeyLogSecrets) []byte {
+		switch {
+		case half.isClient && half.applicationKeys:
+			return s.ClientTrafficSecret
+		case half.isClient:
+			return s.ClientHandshakeTrafficSecret
+		case half.applicationKeys:
+			return s.ServerTrafficSecret
+		default:
+			return s.ServerHandshakeTrafficSecret
+		}
+	})
+	if len(secret) == 0 {
+		return utils.Errorf("tls 1.3 traffic secret for client random %x not found", d.clientHello.Random)
+	}
+	return d.setTLS13TrafficSecret(half, secret)
+}
+
+func (d *TLSStreamDecryptor) setTLS13TrafficSecret(half *tlsHalfStream, secret []byte) error {
+	spec, err := d.cipherSuiteSpec()
+	if err != nil {
+		return err
+	}
+	aead, err := spec.aead(tls13ExpandLabel(spec.hash, secret, "key", nil, spec.keyLen))
+	if err != nil {
+		return err
+	}
+	half.decrypter = &tls13Decrypter{aead: aead, iv: tls13ExpandLabel(spec.hash, secret, "iv", nil, spec.ivLen)}
+	half.trafficSecret = secret
+	half.seq = 0
+	return nil
+}
+
+func (d *TLSStreamDecryptor) handleTLS13Handshake(half *tlsHalfStream, plaintext []byte) error {
+	half.handshake = append(half.handshake, plaintext...)
+	for {
+		msgType, _, ok := half.nextHandshakeMessage()
+		if !ok {
+			return nil
+		}
+		switch msgType {
+		case tlsHandshakeTypeFinished:
+			if half.applicationKeys {
+				continue
+			}
+			// Finished 之后切换为应用数据阶段的密钥
+			half.applicationKeys = true
+			if err := d.installTLS13Keys(half); err != nil {
+				return err
+			}
+		case tlsHandshakeTypeKeyUpdate:
+			spec, err := d.cipherSuiteSpec()
+			if err != nil {
+				return err
+			}
+			next := tls13ExpandLabel(spec.hash, half.trafficSecret, "traffic upd", nil, spec.hash().Size())
+			if err := d.setTLS13TrafficSecret(half, next); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package tlsutils
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type tlsRecordedChunk struct {
+	fromClient bool
+	data       []byte
+}
+
+type tlsRecordingConn struct {
+	net.Conn
+	fromClient bool
+	mu         *sync.Mutex
+	chunks     *[]tlsRecordedChunk
+}
+
+func (c *tlsRecordingConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	*c.chunks = append(*c.chunks, tlsRecordedChunk{fromClient: c.fromClient, data: append([]byte{}, b...)})
+	c.mu.Unlock()
+	return c.Conn.Write(b)
+}
+
+// recordTLSExchange 完成一次 HTTPS 请求，返回按发送顺序记录的原始 TLS 数据
+func recordTLSExchange(t *testing.T, clientConfig *tls.Config) []tlsRecordedChunk {
+	crt, key, err := GenerateSelfSignedCertKey("127.0.0.1", nil, nil)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(crt, key)
+	require.NoError(t, err)
+
+	var chunks []tlsRecordedChunk
+	mu := new(sync.Mutex)
+	clientSide, serverSide := net.Pipe()
+	clientConn := &tlsRecordingConn{Conn: clientSide, fromClient: true, mu: mu, chunks: &chunks}
+	serverConn := &tlsRecordingConn{Conn: serverSide, mu: mu, chunks: &chunks}
+
+	serverDone := make(chan error, 1)
+	go func() {
+		conn := tls.Server(serverConn, &tls.Config{Certificates: []tls.Certificate{cert}})
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			serverDone <- err
+			return
+		}
+		_, err = io.WriteString(conn, "HTTP/1.1 200 OK\r\nContent-Length: 19\r\n\r\nhello "+req.URL.Path[1:])
+		serverDone <- err
+	}()
+
+	conn := tls.Client(clientConn, clientConfig)
+	_, err = io.WriteString(conn, "GET /decrypted-tls HTTP/1.1\r\nHost: example.com\r\n\r\n")
+	require.NoError(t, err)
+	rsp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(rsp.Body)
+	require.Equal(t, "hello decrypted-tls", string(body))
+	require.NoError(t, <-serverDone)
+	// net.Pipe 没有缓冲，直接关闭底层连接以免 close_notify 阻塞
+	clientSide.Close()
+	serverSide.Close()
+	return chunks
+}
+
+func decryptRecordedTLS(t *testing.T, keyLog *KeyLog, chunks []tlsRecordedChunk) (string, string, *TLSStreamDecryptor) {
+	decryptor := NewTLSStreamDecryptor(keyLog)
+	var clientData, serverData bytes.Buffer
+	for _, chunk := range chunks {
+		plaintext, err := decryptor.Feed(chunk.fromClient, chunk.data)
+		require.NoError(t, err)
+		if chunk.fromClient {
+			clientData.Write(plaintext)
+		} else {
+			serverData.Write(plaintext)
+		}
+	}
+	return clientData.String(), serverData.String(), decryptor
+}
+
+func TestTLSStreamDecryptor(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		version     uint16
+		cipherSuite uint16
+	}{
+		{"tls12-aes128-gcm", tls.VersionTLS12, tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+		{"tls12-aes256-gcm", tls.VersionTLS12, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384},
+		{"tls12-chacha20", tls.VersionTLS12, tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256},
+		{"tls12-aes128-cbc", tls.VersionTLS12, tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA},
+		{"tls13", tls.VersionTLS13, 0},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			keyLog := NewKeyLog()
+			config := &tls.Config{
+				InsecureSkipVerify: true,
+				MaxVersion:         c.version,
+				KeyLogWriter:       keyLog,
+			}
+			if c.cipherSuite > 0 {
+				config.CipherSuites = []uint16{c.cipherSuite}
+			}
+			chunks := recordTLSExchange(t, config)
+
+			clientData, serverData, decryptor := decryptRecordedTLS(t, keyLog, chunks)
+			require.Equal(t, c.version, decryptor.Version())
+			if c.cipherSuite > 0 {
+				require.Equal(t, c.cipherSuite, decryptor.CipherSuite())
+			}
+			require.True(t, strings.HasPrefix(clientData, "GET /decrypted-tls HTTP/1.1\r\n"), clientData)
+			require.True(t, strings.HasSuffix(serverData, "hello decrypted-tls"), serverData)
+		})
+	}
+}
+
+func TestTLSStreamDecryptor_KeyLogFile(t *testing.T) {
+	var buf bytes.Buffer
+	chunks := recordTLSExchange(t, &tls.Config{InsecureSkipVerify: true, KeyLogWriter: &buf})
+	require.Contains(t, buf.String(), KeyLogLabelClientTraffic)
+
+	keyLog := ParseKeyLog([]byte("# comment\n\n" + buf.String()))
+	require.Equal(t, 1, keyLog.Len())
+	clientData, serverData, _ := decryptRecordedTLS(t, keyLog, chunks)
+	require.Contains(t, clientData, "Host: example.com")
+	require.Contains(t, serverData, "HTTP/1.1 200 OK")
+}
+
+func TestTLSStreamDecryptor_KeyNotFound(t *testing.T) {
+	chunks := recordTLSExchange(t, &tls.Config{InsecureSkipVerify: true, ServerName: "example.com"})
+
+	decryptor := NewTLSStreamDecryptor(NewKeyLog())
+	var err error
+	for _, chunk := range chunks {
+		if _, err = decryptor.Feed(chunk.fromClient, chunk.data); err != nil {
+			break
+		}
+	}
+	require.Error(t, err)
+	require.Equal(t, "example.com", decryptor.ClientHello().SNI())
+}
@@ -0,0 +1,220 @@
+package tlsutils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// NSS Key Log Format 中使用的标签
+// https://developer.mozilla.org/en-US/docs/Mozilla/Projects/NSS/Key_Log_Format
+const (
+	KeyLogLabelTLS12           = "CLIENT_RANDOM"
+	KeyLogLabelClientHandshake = "CLIENT_HANDSHAKE_TRAFFIC_SECRET"
+	KeyLogLabelServerHandshake = "SERVER_HANDSHAKE_TRAFFIC_SECRET"
+	KeyLogLabelClientTraffic   = "CLIENT_TRAFFIC_SECRET_0"
+	KeyLogLabelServerTraffic   = "SERVER_TRAFFIC_SECRET_0"
+)
+
+// KeyLogSecrets 是同一个 Client Random 对应的所有密钥
+// TLS 1.2 只有 MasterSecret，TLS 1.3 使用握手与应用数据阶段的 Traffic Secret
+type KeyLogSecrets struct {
+	MasterSecret                 []byte
+	ClientHandshakeTrafficSecret []byte
+	ServerHandshakeTrafficSecret []byte
+	ClientTrafficSecret          []byte
+	ServerTrafficSecret          []byte
+}
+
+// KeyLog 保存 SSLKEYLOGFILE 中的密钥，以 Client Random 为索引
+// KeyLog 实现了 io.Writer，可以直接作为 tls.Config.KeyLogWriter 使用
+type KeyLog struct {
+	mu      sync.RWMutex
+	secrets map[string]*KeyLogSecrets
+	pending []byte
+
+	filename    string
+	fileSize    int64
+	fileModTime time.Time
+}
+
+func NewKeyLog() *KeyLog {
+	return &KeyLog{secrets: make(map[string]*KeyLogSecrets)}
+}
+
+// ParseKeyLog 解析 NSS Key Log 格式的内容，无法识别的行会被忽略
+func ParseKeyLog(raw []byte) *KeyLog {
+	k := NewKeyLog()
+	k.load(raw)
+	return k
+}
+
+// NewKeyLogFromFile 从 SSLKEYLOGFILE 加载密钥
+// 查询不到密钥时，如果文件发生了变化会重新加载，因此可以配合正在写入该文件的程序（浏览器 / MITM）实时解密
+func NewKeyLogFromFile(filename string) (*KeyLog, error) {
+	k := NewKeyLog()
+	k.filename = filename
+	if err := k.reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *KeyLog) load(raw []byte) {
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 4096), 1024*1024)
+	for scanner.Scan() {
+		_ = k.AddLine(scanner.Text())
+	}
+}
+
+func (k *KeyLog) reload() error {
+	if k.filename == "" {
+		return nil
+	}
+	info, err := os.Stat(k.filename)
+	if err != nil {
+		return utils.Errorf("stat key log file[%v] failed: %v", k.filename, err)
+	}
+
+	k.mu.RLock()
+	unchanged := info.Size() == k.fileSize && info.ModTime().Equal(k.fileModTime)
+	k.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	raw, err := os.ReadFile(k.filename)
+	if err != nil {
+		return utils.Errorf("read key log file[%v] failed: %v", k.filename, err)
+	}
+	k.load(raw)
+
+	k.mu.Lock()
+	k.fileSize, k.fileModTime = info.Size(), info.ModTime()
+	k.mu.Unlock()
+	return nil
+}
+
+// AddLine 添加一行 "<LABEL> <ClientRandom> <Secret>"，注释与空行会被忽略
+func (k *KeyLog) AddLine(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return utils.Errorf("invalid key log line: %v", line)
+	}
+	clientRandom, err := hex.DecodeString(fields[1])
+	if err != nil || len(clientRandom) != 32 {
+		return utils.Errorf("invalid client random in key log line: %v", line)
+	}
+	secret, err := hex.DecodeString(fields[2])
+	if err != nil || len(secret) == 0 {
+		return utils.Errorf("invalid secret in key log line: %v", line)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	key := strings.ToLower(fields[1])
+	// 已经返回给调用者的 KeyLogSecrets 不会再被修改
+	s := &KeyLogSecrets{}
+	if old, ok := k.secrets[key]; ok {
+		*s = *old
+	}
+	switch fields[0] {
+	case KeyLogLabelTLS12:
+		s.MasterSecret = secret
+	case KeyLogLabelClientHandshake:
+		s.ClientHandshakeTrafficSecret = secret
+	case KeyLogLabelServerHandshake:
+		s.ServerHandshakeTrafficSecret = secret
+	case KeyLogLabelClientTraffic:
+		s.ClientTrafficSecret = secret
+	case KeyLogLabelServerTraffic:
+		s.ServerTrafficSecret = secret
+	default:
+		// CLIENT_EARLY_TRAFFIC_SECRET / EXPORTER_SECRET 等暂不需要
+		return nil
+	}
+	k.secrets[key] = s
+	return nil
+}
+
+// Write 按行写入 Key Log，用于 tls.Config.KeyLogWriter
+func (k *KeyLog) Write(p []byte) (int, error) {
+	k.mu.Lock()
+	k.pending = append(k.pending, p...)
+	var lines []string
+	for {
+		idx := bytes.IndexByte(k.pending, '\n')
+		if idx < 0 {
+			break
+		}
+		lines = append(lines, string(k.pending[:idx]))
+		k.pending = k.pending[idx+1:]
+	}
+	k.mu.Unlock()
+
+	for _, line := range lines {
+		if err := k.AddLine(line); err != nil {
+			log.Debugf("skip key log line: %v", err)
+		}
+	}
+	return len(p), nil
+}
+
+// Lookup 根据 Client Random 查找密钥
+func (k *KeyLog) Lookup(clientRandom []byte) (*KeyLogSecrets, bool) {
+	if k == nil {
+		return nil, false
+	}
+	key := hex.EncodeToString(clientRandom)
+	k.mu.RLock()
+	s, ok := k.secrets[key]
+	k.mu.RUnlock()
+	if ok || k.filename == "" {
+		return s, ok
+	}
+
+	if err := k.reload(); err != nil {
+		log.Debugf("reload key log failed: %v", err)
+		return nil, false
+	}
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	s, ok = k.secrets[key]
+	return s, ok
+}
+
+// lookupSecret 查找指定的密钥，TLS 1.3 的各个密钥是陆续写入的，缺失时会尝试重新加载文件
+func (k *KeyLog) lookupSecret(clientRandom []byte, pick func(s *KeyLogSecrets) []byte) []byte {
+	if s, ok := k.Lookup(clientRandom); ok && len(pick(s)) > 0 {
+		return pick(s)
+	}
+	if k == nil || k.filename == "" {
+		return nil
+	}
+	if err := k.reload(); err != nil {
+		return nil
+	}
+	if s, ok := k.Lookup(clientRandom); ok {
+		return pick(s)
+	}
+	return nil
+}
+
+// Len 返回 Key Log 中 Client Random 的数量
+func (k *KeyLog) Len() int {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return len(k.secrets)
+}
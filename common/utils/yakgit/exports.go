@@ -24,6 +24,7 @@ var Exports = map[string]any{
 	"FileSystemFromCommit":      FromCommit,
 	"FileSystemFromCommits":     FromCommits,
 	"FileSystemFromCommitRange": FromCommitRange,
+	"FileSystemFromRevision":    FromRevision,
 
 	"auth":           WithUsernamePassword,
 	"context":        WithContext,
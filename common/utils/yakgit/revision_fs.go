@@ -0,0 +1,236 @@
+package yakgit
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/utils/memfile"
+)
+
+// GitRevisionFS is a readonly filesystem of the tree of a commit, the files are read from the
+// object storage of the repository lazily, so the revision does not need to be checked out.
+type GitRevisionFS struct {
+	commit *object.Commit
+	tree   *object.Tree
+}
+
+var _ fi.FileSystem = (*GitRevisionFS)(nil)
+
+// NewGitRevisionFS opens the repository and returns the filesystem of revision
+// revision can be a full hash, a short hash, HEAD, HEAD~n or a branch / tag name
+func NewGitRevisionFS(repos string, revision string) (*GitRevisionFS, error) {
+	repo, err := git.PlainOpen(repos)
+	if err != nil {
+		return nil, utils.Errorf("open: %v failed: %v", repos, err)
+	}
+	return NewGitRevisionFSFromRepository(repo, revision)
+}
+
+func NewGitRevisionFSFromRepository(repo *git.Repository, revision string) (*GitRevisionFS, error) {
+	commit, err := GetCommitHashEx(repo, revision)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, utils.Errorf("get tree of commit %v failed: %v", commit.Hash.String(), err)
+	}
+	return &GitRevisionFS{commit: commit, tree: tree}, nil
+}
+
+// Commit returns the hash of the commit of the filesystem
+func (g *GitRevisionFS) Commit() string {
+	return g.commit.Hash.String()
+}
+
+func revisionPathClean(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		return "."
+	}
+	return name
+}
+
+type revisionFileInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (r *revisionFileInfo) Name() string       { return r.name }
+func (r *revisionFileInfo) Size() int64        { return r.size }
+func (r *revisionFileInfo) Mode() fs.FileMode  { return r.mode }
+func (r *revisionFileInfo) ModTime() time.Time { return r.modTime }
+func (r *revisionFileInfo) IsDir() bool        { return r.mode.IsDir() }
+func (r *revisionFileInfo) Sys() any           { return nil }
+
+type revisionDir struct {
+	info *revisionFileInfo
+}
+
+func (d *revisionDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *revisionDir) Read([]byte) (int, error) {
+	return 0, utils.Error("git revision dir cannot be read")
+}
+func (d *revisionDir) Close() error { return nil }
+
+func (g *GitRevisionFS) entryInfo(name string, entry *object.TreeEntry) (*revisionFileInfo, error) {
+	info := &revisionFileInfo{name: path.Base(name), modTime: g.commit.Committer.When}
+	if entry.Mode == filemode.Dir {
+		info.mode = fs.ModeDir | 0o755
+		return info, nil
+	}
+	mode, err := entry.Mode.ToOSFileMode()
+	if err != nil {
+		return nil, err
+	}
+	info.mode = mode
+	size, err := g.tree.Size(name)
+	if err != nil {
+		return nil, err
+	}
+	info.size = size
+	return info, nil
+}
+
+func (g *GitRevisionFS) Stat(name string) (fs.FileInfo, error) {
+	name = revisionPathClean(name)
+	if name == "." {
+		return &revisionFileInfo{name: ".", mode: fs.ModeDir | 0o755, modTime: g.commit.Committer.When}, nil
+	}
+	entry, err := g.tree.FindEntry(name)
+	if err != nil {
+		return nil, utils.Wrapf(os.ErrNotExist, "%s not exist in %s", name, g.Commit())
+	}
+	return g.entryInfo(name, entry)
+}
+
+func (g *GitRevisionFS) ReadFile(name string) ([]byte, error) {
+	name = revisionPathClean(name)
+	file, err := g.tree.File(name)
+	if err != nil {
+		return nil, utils.Wrapf(os.ErrNotExist, "%s not exist in %s", name, g.Commit())
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, utils.Errorf("read %s failed: %v", name, err)
+	}
+	return []byte(content), nil
+}
+
+func (g *GitRevisionFS) Open(name string) (fs.File, error) {
+	info, err := g.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &revisionDir{info: info.(*revisionFileInfo)}, nil
+	}
+	content, err := g.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return memfile.NewWithName(info.Name(), content), nil
+}
+
+func (g *GitRevisionFS) OpenFile(name string, flag int, perm os.FileMode) (fs.File, error) {
+	return g.Open(name)
+}
+
+func (g *GitRevisionFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	name = revisionPathClean(name)
+	tree := g.tree
+	if name != "." {
+		var err error
+		tree, err = g.tree.Tree(name)
+		if err != nil {
+			return nil, utils.Errorf("%v is not a dir in %s", name, g.Commit())
+		}
+	}
+	entries := make([]fs.DirEntry, 0, len(tree.Entries))
+	for i := range tree.Entries {
+		entry := &tree.Entries[i]
+		if entry.Mode == filemode.Submodule {
+			continue
+		}
+		entryPath := entry.Name
+		if name != "." {
+			entryPath = name + "/" + entry.Name
+		}
+		info, err := g.entryInfo(entryPath, entry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	return entries, nil
+}
+
+func (g *GitRevisionFS) Exists(name string) (bool, error) {
+	_, err := g.Stat(name)
+	return err == nil, nil
+}
+
+func (g *GitRevisionFS) Rel(base string, target string) (string, error) {
+	base, target = revisionPathClean(base), revisionPathClean(target)
+	if base == "." {
+		return target, nil
+	}
+	if target == base {
+		return ".", nil
+	}
+	if strings.HasPrefix(target, base+"/") {
+		return strings.TrimPrefix(target, base+"/"), nil
+	}
+	return "", utils.Errorf("%v is not under %v", target, base)
+}
+
+func (g *GitRevisionFS) ExtraInfo(string) map[string]any {
+	return map[string]any{"commit": g.Commit()}
+}
+func (g *GitRevisionFS) GetSeparators() rune        { return '/' }
+func (g *GitRevisionFS) Join(name ...string) string { return path.Join(name...) }
+func (g *GitRevisionFS) Base(p string) string       { return path.Base(p) }
+func (g *GitRevisionFS) PathSplit(s string) (string, string) {
+	idx := strings.LastIndex(s, "/")
+	if idx == -1 {
+		return "", s
+	}
+	return s[:idx], s[idx+1:]
+}
+func (g *GitRevisionFS) Ext(s string) string    { return path.Ext(s) }
+func (g *GitRevisionFS) IsAbs(s string) bool    { return false }
+func (g *GitRevisionFS) Getwd() (string, error) { return ".", nil }
+func (g *GitRevisionFS) Rename(string, string) error {
+	return utils.Error("unsupported on readonly git revision fs")
+}
+func (g *GitRevisionFS) WriteFile(string, []byte, os.FileMode) error {
+	return utils.Error("unsupported on readonly git revision fs")
+}
+func (g *GitRevisionFS) Delete(string) error {
+	return utils.Error("unsupported on readonly git revision fs")
+}
+func (g *GitRevisionFS) MkdirAll(string, os.FileMode) error {
+	return utils.Error("unsupported on readonly git revision fs")
+}
+
+// FileSystemFromRevision 从指定的版本中获取完整的只读文件系统，不需要检出该版本
+//
+// Example:
+// ```
+// fs := git.FileSystemFromRevision("path/to/repo", "HEAD~1")
+// fs, err := git.FileSystemFromRevision("path/to/repo", "2871a988b2ed7ec10a1fd45eca248a96a99a8560")
+// ```
+func FromRevision(repos string, revision string) (fi.FileSystem, error) {
+	return NewGitRevisionFS(repos, revision)
+}
@@ -197,3 +197,25 @@ func TestFSConverter_Short(t *testing.T) {
 	raw, _ = f.ReadFile("file2.txt")
 	assert.Empty(t, raw)
 }
+
+func TestGitRevisionFS(t *testing.T) {
+	name := getTestGitRepo(t)
+	rfs, err := NewGitRevisionFS(name, "HEAD^")
+	require.NoError(t, err)
+	require.Equal(t, "745f35e4fd4c1d8cfbc12495f04b989abf9f3437", rfs.Commit())
+
+	count := 0
+	err = filesys.Recursive(".", filesys.WithFileSystem(rfs), filesys.WithFileStat(func(s string, info fs.FileInfo) error {
+		raw, err := rfs.ReadFile(s)
+		require.NoError(t, err)
+		require.Equal(t, info.Size(), int64(len(raw)))
+		count++
+		return nil
+	}))
+	require.NoError(t, err)
+	require.Greater(t, count, 0)
+
+	_, err = rfs.Stat("not-exist-file")
+	require.Error(t, err)
+	require.Error(t, rfs.WriteFile("a.txt", nil, 0o644))
+}
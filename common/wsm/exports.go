@@ -22,4 +22,25 @@ var WebShellExports = map[string]interface{}{
 
 	// 设置参数
 	"cmdPath": behinder.SetCommandPath,
+
+	// 流量分析
+	"DetectTraffic":             DetectWebShellTraffic,
+	"DetectTrafficFromPcap":     DetectWebShellTrafficFromPcap,
+	"DetectTrafficFromHTTPFlow": DetectWebShellTrafficFromHTTPFlow,
+	"trafficKeys":               WithTrafficKeys,
+	"trafficSaveRisk":           WithTrafficSaveRisk,
+	"trafficRuntimeID":          WithTrafficRuntimeID,
+	"trafficCallback":           WithTrafficCallback,
+	"trafficContext":            WithTrafficContext,
+
+	// webshell 隧道
+	"GenerateTunnelScript": GenerateTunnelScript,
+	"DeployTunnel":         DeployTunnel,
+	"NewTunnel":            NewWebShellTunnel,
+	"ServeTunnelSocks5":    ServeTunnelSocks5,
+	"tunnelProxy":          WithTunnelProxy,
+	"tunnelHeaders":        WithTunnelHeaders,
+	"tunnelTimeout":        WithTunnelTimeout,
+	"tunnelPollInterval":   WithTunnelPollInterval,
+	"tunnelContext":        WithTunnelContext,
 }
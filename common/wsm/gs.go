@@ -444,28 +444,25 @@ func (g *Godzilla) getFile(filePath string) ([]byte, error) {
 //
 //	return result, nil
 //}
-//
-//func (g *Godzilla) uploadFile(fileName string, data []byte) (bool, error) {
-//	parameter := newParameter()
-//
-//	parameter.AddString("fileName", fileName)
-//
-//	parameter.AddBytes("fileValue", data)
-//	result, err := g.EvalFunc("", "uploadFile", parameter)
-//	if err != nil {
-//		return false, err
-//	}
-//	decode, err := g.encoding.CharsetDecode(result)
-//	if err != nil {
-//		return false, err
-//	}
-//	if "ok" == decode {
-//		return true, nil
-//	} else {
-//		return false, errors.New(decode)
-//	}
-//}
-//
+
+func (g *Godzilla) uploadFile(fileName string, data []byte) (bool, error) {
+	err := g.InjectPayloadIfNoCookie()
+	if err != nil {
+		return false, err
+	}
+	parameter := newParameter()
+	parameter.AddString("fileName", fileName)
+	parameter.AddBytes("fileValue", data)
+	result, err := g.EvalFunc("", "uploadFile", parameter)
+	if err != nil {
+		return false, err
+	}
+	if strings.TrimSpace(string(result)) == "ok" {
+		return true, nil
+	}
+	return false, utils.Error(string(result))
+}
+
 //func (g *Godzilla) copyFile(fileName, newFile string) (bool, error) {
 //	parameter := newParameter()
 //	enfileName, err := g.encoding.CharsetEncode(fileName)
@@ -22,6 +22,11 @@ var YakEncrypt embed.FS
 //go:embed godzilla/static/payload_test.dll
 var CshrapPayload []byte
 
+// TunnelPayloads 部署在目标服务器上的 TCP 隧道脚本
+//
+//go:embed tunnel/*
+var TunnelPayloads embed.FS
+
 type Payload string
 
 func (p Payload) String() string {
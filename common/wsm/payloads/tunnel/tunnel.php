@@ -0,0 +1,139 @@
+<?php
+$key = "{{key}}";
+if ($_SERVER['REQUEST_METHOD'] !== 'POST' || !isset($_SERVER['HTTP_X_TUNNEL_KEY']) || $_SERVER['HTTP_X_TUNNEL_KEY'] !== $key) {
+    header('HTTP/1.1 404 Not Found');
+    exit;
+}
+
+function tunnel_result($status, $error = '', $data = '')
+{
+    header('Content-Type: application/octet-stream');
+    header('X-Tunnel-Status: ' . $status);
+    if ($error !== '') {
+        header('X-Tunnel-Error: ' . str_replace(array("\r", "\n"), ' ', $error));
+    }
+    echo $data;
+    exit;
+}
+
+// php 无法在请求之间保存 socket，由 CONNECT 请求维持连接，通过临时文件与其他请求交换数据
+function tunnel_take($file)
+{
+    $fp = @fopen($file, 'r+');
+    if (!$fp) {
+        return '';
+    }
+    flock($fp, LOCK_EX);
+    $data = stream_get_contents($fp);
+    ftruncate($fp, 0);
+    flock($fp, LOCK_UN);
+    fclose($fp);
+    return $data === false ? '' : $data;
+}
+
+function tunnel_put($file, $data)
+{
+    $fp = @fopen($file, 'a');
+    if (!$fp) {
+        return false;
+    }
+    flock($fp, LOCK_EX);
+    fwrite($fp, $data);
+    fflush($fp);
+    flock($fp, LOCK_UN);
+    fclose($fp);
+    return true;
+}
+
+$cmd = isset($_SERVER['HTTP_X_TUNNEL_CMD']) ? $_SERVER['HTTP_X_TUNNEL_CMD'] : '';
+$id = isset($_SERVER['HTTP_X_TUNNEL_ID']) ? preg_replace('/[^a-zA-Z0-9]/', '', $_SERVER['HTTP_X_TUNNEL_ID']) : '';
+$base = sys_get_temp_dir() . DIRECTORY_SEPARATOR . 'yak_tunnel_' . $id;
+
+switch ($cmd) {
+    case 'PING':
+        tunnel_result('OK');
+        break;
+    case 'CONNECT':
+        $target = $_SERVER['HTTP_X_TUNNEL_TARGET'];
+        $idx = strrpos($target, ':');
+        $host = trim(substr($target, 0, $idx), '[]');
+        $port = (int)substr($target, $idx + 1);
+        if (strpos($host, ':') !== false) {
+            $host = '[' . $host . ']';
+        }
+        $sock = @stream_socket_client('tcp://' . $host . ':' . $port, $errno, $errstr, 10);
+        if (!$sock) {
+            tunnel_result('FAIL', 'connect failed: ' . $errstr);
+        }
+        stream_set_blocking($sock, false);
+        if (@file_put_contents($base . '.r', '') === false || @file_put_contents($base . '.w', '') === false
+            || @file_put_contents($base . '.run', '1') === false) {
+            fclose($sock);
+            tunnel_result('FAIL', 'temp dir is not writable');
+        }
+        header('Content-Type: application/octet-stream');
+        header('X-Tunnel-Status: OK');
+        header('Content-Length: 0');
+        header('Connection: close');
+        ignore_user_abort(true);
+        set_time_limit(0);
+        if (function_exists('fastcgi_finish_request')) {
+            fastcgi_finish_request();
+        } else {
+            while (ob_get_level() > 0) {
+                ob_end_flush();
+            }
+            flush();
+        }
+
+        $remoteClosed = false;
+        while (file_exists($base . '.run')) {
+            $data = tunnel_take($base . '.w');
+            if ($data !== '') {
+                stream_set_blocking($sock, true);
+                fwrite($sock, $data);
+                stream_set_blocking($sock, false);
+            }
+            $r = array($sock);
+            $w = null;
+            $e = null;
+            if (@stream_select($r, $w, $e, 0, $data !== '' ? 0 : 50000) > 0) {
+                $buf = fread($sock, 65536);
+                if ($buf === false || ($buf === '' && feof($sock))) {
+                    $remoteClosed = true;
+                    break;
+                }
+                tunnel_put($base . '.r', $buf);
+            }
+        }
+        fclose($sock);
+        @unlink($base . '.run');
+        if (!$remoteClosed) {
+            @unlink($base . '.r');
+            @unlink($base . '.w');
+        }
+        exit;
+    case 'READ':
+        $alive = file_exists($base . '.run');
+        $data = tunnel_take($base . '.r');
+        if ($data === '' && !$alive) {
+            @unlink($base . '.r');
+            @unlink($base . '.w');
+            tunnel_result('FAIL', 'connection closed');
+        }
+        tunnel_result('OK', '', $data);
+        break;
+    case 'FORWARD':
+        if (!file_exists($base . '.run')) {
+            tunnel_result('FAIL', 'connection closed');
+        }
+        tunnel_put($base . '.w', file_get_contents('php://input'));
+        tunnel_result('OK');
+        break;
+    case 'DISCONNECT':
+        @unlink($base . '.run');
+        tunnel_result('OK');
+        break;
+    default:
+        tunnel_result('FAIL', 'unknown command');
+}
@@ -0,0 +1,398 @@
+package wsm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+const (
+	TrafficToolBehinder = "behinder"
+	TrafficToolGodzilla = "godzilla"
+	TrafficToolYakShell = "yakshell"
+)
+
+// 冰蝎、哥斯拉默认的连接密钥
+var defaultTrafficKeys = []string{"rebeyond", "key"}
+
+// WebShellTraffic 从一组 HTTP 请求/响应中识别并解密出的 webshell 通信
+type WebShellTraffic struct {
+	// Index 在分析的流量中的顺序，用于生成时间线
+	Index     int
+	Timestamp int64
+	Url       string
+	// RemoteIP webshell 所在服务器的地址
+	RemoteIP string
+
+	// Tool webshell 管理工具: behinder / godzilla / yakshell
+	Tool string
+	// Script shell 类型: JSP / PHP / ASPX / ASP
+	Script string
+	// EncMode 哥斯拉、yakshell 的加密模式
+	EncMode string
+	// Key 解密成功的密钥，Pass 哥斯拉、yakshell 的连接参数
+	Key  string
+	Pass string
+
+	// Payload 请求执行的 payload 或者方法，例如 CmdGo、execCommand
+	Payload string
+	Params  map[string]string
+	// Command 可读的操作描述
+	Command string
+	// Result 可读的执行结果，Response 解密失败时为空
+	Result string
+
+	Request     []byte
+	Response    []byte
+	RawRequest  []byte
+	RawResponse []byte
+}
+
+func (t *WebShellTraffic) String() string {
+	return fmt.Sprintf("[%s/%s] %s: %s", t.Tool, t.Script, t.Url, t.Command)
+}
+
+type trafficConfig struct {
+	ctx         context.Context
+	keys        []string
+	saveRisk    bool
+	runtimeID   string
+	callback    func(*WebShellTraffic)
+	pcapOptions []pcaputil.CaptureOption
+}
+
+type TrafficOption func(*trafficConfig)
+
+// WithTrafficKeys 设置尝试解密的密钥（冰蝎、哥斯拉的 key），支持原始口令以及 md5 截取后的 16 位密钥
+func WithTrafficKeys(keys ...string) TrafficOption {
+	return func(c *trafficConfig) {
+		c.keys = append(c.keys, keys...)
+	}
+}
+
+func WithTrafficContext(ctx context.Context) TrafficOption {
+	return func(c *trafficConfig) {
+		c.ctx = ctx
+	}
+}
+
+// WithTrafficSaveRisk 识别到 webshell 通信后保存风险，每个 webshell 一条，包含解密后的时间线
+func WithTrafficSaveRisk(b bool) TrafficOption {
+	return func(c *trafficConfig) {
+		c.saveRisk = b
+	}
+}
+
+func WithTrafficRuntimeID(id string) TrafficOption {
+	return func(c *trafficConfig) {
+		c.runtimeID = id
+	}
+}
+
+// WithTrafficCallback 每识别到一次 webshell 通信就调用一次
+func WithTrafficCallback(h func(*WebShellTraffic)) TrafficOption {
+	return func(c *trafficConfig) {
+		c.callback = h
+	}
+}
+
+// WithTrafficPcapOptions 分析 pcap 时额外的抓包配置，例如 TLS key log
+func WithTrafficPcapOptions(opts ...pcaputil.CaptureOption) TrafficOption {
+	return func(c *trafficConfig) {
+		c.pcapOptions = append(c.pcapOptions, opts...)
+	}
+}
+
+type trafficKey struct {
+	name string
+	key  []byte
+}
+
+// WebShellTrafficAnalyzer 识别 HTTP 流量中冰蝎、哥斯拉、yakshell 的通信，并尝试解密请求与响应
+type WebShellTrafficAnalyzer struct {
+	config *trafficConfig
+	keys   []*trafficKey
+
+	mu      sync.Mutex
+	index   int
+	results []*WebShellTraffic
+}
+
+func NewWebShellTrafficAnalyzer(opts ...TrafficOption) *WebShellTrafficAnalyzer {
+	config := &trafficConfig{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(config)
+	}
+	a := &WebShellTrafficAnalyzer{config: config}
+	seen := make(map[string]struct{})
+	addKey := func(name string, key []byte) {
+		if _, ok := seen[string(key)]; ok {
+			return
+		}
+		seen[string(key)] = struct{}{}
+		a.keys = append(a.keys, &trafficKey{name: name, key: key})
+	}
+	for _, k := range append(append([]string{}, config.keys...), defaultTrafficKeys...) {
+		if k == "" {
+			continue
+		}
+		addKey(k, secretKey(k))
+		if len(k) == 16 {
+			addKey(k, []byte(k))
+		}
+	}
+	return a
+}
+
+// Analyze 分析一组 HTTP 请求与响应，识别成功时返回解密后的通信
+func (a *WebShellTrafficAnalyzer) Analyze(req, rsp []byte) (*WebShellTraffic, bool) {
+	return a.analyze(req, rsp, false, "", 0)
+}
+
+func (a *WebShellTrafficAnalyzer) analyze(req, rsp []byte, https bool, remoteIP string, timestamp int64) (*WebShellTraffic, bool) {
+	if len(req) == 0 || lowhttp.GetHTTPRequestMethod(req) != http.MethodPost {
+		return nil, false
+	}
+	body := lowhttp.GetHTTPPacketBody(req)
+	if len(body) == 0 {
+		return nil, false
+	}
+	var rspBody []byte
+	if len(rsp) > 0 {
+		_, rspBody, _ = lowhttp.FixHTTPResponse(rsp)
+	}
+
+	packet := &trafficPacket{
+		body:    body,
+		params:  lowhttp.GetAllHTTPRequestPostParams(req),
+		rspBody: rspBody,
+	}
+	var traffic *WebShellTraffic
+	for _, detect := range []func(*trafficPacket) *WebShellTraffic{
+		a.detectBehinder,
+		a.detectGodzilla,
+		a.detectYakShell,
+	} {
+		if traffic = detect(packet); traffic != nil {
+			break
+		}
+	}
+	if traffic == nil {
+		return nil, false
+	}
+
+	if u, err := lowhttp.ExtractURLFromHTTPRequestRaw(req, https); err == nil {
+		traffic.Url = u.String()
+	}
+	if timestamp == 0 {
+		timestamp = time.Now().Unix()
+	}
+	traffic.Timestamp = timestamp
+	traffic.RemoteIP = remoteIP
+	traffic.RawRequest = req
+	traffic.RawResponse = rsp
+
+	a.mu.Lock()
+	a.index++
+	traffic.Index = a.index
+	a.results = append(a.results, traffic)
+	a.mu.Unlock()
+
+	log.Infof("webshell traffic detected: %s", traffic)
+	if a.config.callback != nil {
+		a.config.callback(traffic)
+	}
+	return traffic, true
+}
+
+// AnalyzeHTTPFlow 分析数据库中保存的 HTTPFlow
+func (a *WebShellTrafficAnalyzer) AnalyzeHTTPFlow(flow *schema.HTTPFlow) (*WebShellTraffic, bool) {
+	if flow == nil || flow.IsWebsocket {
+		return nil, false
+	}
+	var remoteIP string
+	if flow.RemoteAddr != "" {
+		remoteIP, _, _ = utils.ParseStringToHostPort(flow.RemoteAddr)
+	}
+	return a.analyze([]byte(flow.GetRequest()), []byte(flow.GetResponse()), flow.IsHTTPS, remoteIP, flow.CreatedAt.Unix())
+}
+
+// AnalyzeDatabase 分析数据库中符合条件的 HTTPFlow，filter 为空时分析全部
+func (a *WebShellTrafficAnalyzer) AnalyzeDatabase(db *gorm.DB, filter *ypb.QueryHTTPFlowRequest) ([]*WebShellTraffic, error) {
+	if db == nil {
+		return nil, utils.Error("no database connection")
+	}
+	if filter == nil {
+		filter = &ypb.QueryHTTPFlowRequest{}
+	}
+	if filter.Methods == "" {
+		filter.Methods = http.MethodPost
+	}
+	db = yakit.FilterHTTPFlow(db.Model(&schema.HTTPFlow{}), filter).Order("created_at asc, id asc")
+	for flow := range yakit.YieldHTTPFlows(db, a.config.ctx) {
+		a.AnalyzeHTTPFlow(flow)
+	}
+	return a.Finish()
+}
+
+// AnalyzePcapFile 分析 pcap 文件中的 HTTP 流量
+func (a *WebShellTrafficAnalyzer) AnalyzePcapFile(filename string) ([]*WebShellTraffic, error) {
+	opts := append([]pcaputil.CaptureOption{
+		pcaputil.WithContext(a.config.ctx),
+		pcaputil.WithHTTPFlow(func(flow *pcaputil.TrafficFlow, req *http.Request, rsp *http.Response) {
+			if req == nil {
+				return
+			}
+			reqBytes, err := utils.DumpHTTPRequest(req, true)
+			if err != nil {
+				return
+			}
+			var rspBytes []byte
+			if rsp != nil {
+				rspBytes, _ = utils.DumpHTTPResponse(rsp, true)
+			}
+			a.analyze(reqBytes, rspBytes, req.TLS != nil, "", 0)
+		}),
+	}, a.config.pcapOptions...)
+	if err := pcaputil.OpenPcapFile(filename, opts...); err != nil {
+		return nil, utils.Wrapf(err, "open pcap file %s failed", filename)
+	}
+	return a.Finish()
+}
+
+// Timeline 按照时间顺序返回所有识别到的通信
+func (a *WebShellTrafficAnalyzer) Timeline() []*WebShellTraffic {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ret := make([]*WebShellTraffic, len(a.results))
+	copy(ret, a.results)
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Timestamp != ret[j].Timestamp {
+			return ret[i].Timestamp < ret[j].Timestamp
+		}
+		return ret[i].Index < ret[j].Index
+	})
+	return ret
+}
+
+// Finish 返回时间线，开启 WithTrafficSaveRisk 时保存风险
+func (a *WebShellTrafficAnalyzer) Finish() ([]*WebShellTraffic, error) {
+	timeline := a.Timeline()
+	if a.config.saveRisk {
+		if err := SaveWebShellTrafficRisk(timeline, a.config.runtimeID); err != nil {
+			return timeline, err
+		}
+	}
+	return timeline, nil
+}
+
+// SaveWebShellTrafficRisk 按照 webshell 地址与工具保存风险，风险详情中包含解密后的时间线
+func SaveWebShellTrafficRisk(traffics []*WebShellTraffic, runtimeID string) error {
+	type group struct {
+		url, tool string
+		items     []*WebShellTraffic
+	}
+	var groups []*group
+	index := make(map[string]*group)
+	for _, t := range traffics {
+		key := t.Url + "|" + t.Tool
+		g, ok := index[key]
+		if !ok {
+			g = &group{url: t.Url, tool: t.Tool}
+			index[key] = g
+			groups = append(groups, g)
+		}
+		g.items = append(g.items, t)
+	}
+
+	var errs []string
+	for _, g := range groups {
+		first := g.items[0]
+		timeline := make([]map[string]any, 0, len(g.items))
+		var verbose strings.Builder
+		for _, t := range g.items {
+			timeline = append(timeline, map[string]any{
+				"index":     t.Index,
+				"timestamp": t.Timestamp,
+				"payload":   t.Payload,
+				"params":    t.Params,
+				"command":   t.Command,
+				"result":    t.Result,
+			})
+			verbose.WriteString(fmt.Sprintf("[%s] %s\n", time.Unix(t.Timestamp, 0).Format("2006-01-02 15:04:05"), t.Command))
+			if t.Result != "" {
+				verbose.WriteString(utils.ShrinkString(t.Result, 512) + "\n")
+			}
+		}
+		opts := []yakit.RiskParamsOpt{
+			yakit.WithRiskParam_Title(fmt.Sprintf("WebShell Traffic Detected: %s(%s) %s", g.tool, first.Script, g.url)),
+			yakit.WithRiskParam_TitleVerbose(fmt.Sprintf("检测到 WebShell 通信: %s(%s) %s", g.tool, first.Script, g.url)),
+			yakit.WithRiskParam_RiskType("webshell"),
+			yakit.WithRiskParam_Severity("critical"),
+			yakit.WithRiskParam_Description(fmt.Sprintf("在流量中识别到 %s webshell 通信 %d 次，已使用密钥 %s 解密", g.tool, len(g.items), first.Key)),
+			yakit.WithRiskParam_Solution("排查并删除服务器上的 webshell，结合解密后的时间线确认攻击者执行的操作"),
+			yakit.WithRiskParam_Payload(verbose.String()),
+			yakit.WithRiskParam_Request(first.RawRequest),
+			yakit.WithRiskParam_Response(first.RawResponse),
+			yakit.WithRiskParam_Details(map[string]any{
+				"tool":     g.tool,
+				"script":   first.Script,
+				"key":      first.Key,
+				"pass":     first.Pass,
+				"enc_mode": first.EncMode,
+				"timeline": timeline,
+			}),
+			yakit.WithRiskParam_Tags("webshell-traffic," + g.tool),
+		}
+		if first.RemoteIP != "" {
+			opts = append(opts, yakit.WithRiskParam_IP(first.RemoteIP))
+		}
+		if runtimeID != "" {
+			opts = append(opts, yakit.WithRiskParam_RuntimeId(runtimeID))
+		}
+		if _, err := yakit.NewRisk(g.url, opts...); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return utils.Errorf("save webshell traffic risk failed: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// DetectWebShellTraffic 分析一组 HTTP 请求与响应
+func DetectWebShellTraffic(req, rsp any, opts ...TrafficOption) (*WebShellTraffic, bool) {
+	a := NewWebShellTrafficAnalyzer(opts...)
+	traffic, ok := a.Analyze(utils.InterfaceToBytes(req), utils.InterfaceToBytes(rsp))
+	if ok {
+		a.Finish()
+	}
+	return traffic, ok
+}
+
+// DetectWebShellTrafficFromPcap 分析 pcap 文件，返回解密后的时间线
+func DetectWebShellTrafficFromPcap(filename string, opts ...TrafficOption) ([]*WebShellTraffic, error) {
+	return NewWebShellTrafficAnalyzer(opts...).AnalyzePcapFile(filename)
+}
+
+// DetectWebShellTrafficFromHTTPFlow 分析当前项目数据库中的 HTTPFlow，runtimeID 为空时分析全部
+func DetectWebShellTrafficFromHTTPFlow(runtimeID string, opts ...TrafficOption) ([]*WebShellTraffic, error) {
+	return NewWebShellTrafficAnalyzer(opts...).AnalyzeDatabase(
+		consts.GetGormProjectDatabase(),
+		&ypb.QueryHTTPFlowRequest{RuntimeId: runtimeID},
+	)
+}
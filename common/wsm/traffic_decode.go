@@ -0,0 +1,687 @@
+package wsm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/javaclassparser"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/wsm/payloads"
+	"github.com/yaklang/yaklang/common/wsm/payloads/behinder"
+	"github.com/yaklang/yaklang/common/wsm/payloads/godzilla"
+	"github.com/yaklang/yaklang/common/wsm/payloads/yakshell"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+type trafficPacket struct {
+	body    []byte
+	params  map[string]string
+	rspBody []byte
+}
+
+var (
+	javaClassMagic = []byte{0xCA, 0xFE, 0xBA, 0xBE}
+	assemblyMagic  = []byte("MZ")
+
+	// 冰蝎 php payload 外层的包装 assert|eval(base64_decode('...'));
+	behinderPhpWrapRegexp = regexp.MustCompile(`^\s*assert\|eval\(base64_decode\('([A-Za-z0-9+/=]*)'\)\);?\s*$`)
+	// behinder.GetRawPHP 生成的参数 $cmd="d2hvYW1p";$cmd=base64_decode($cmd);
+	phpParamRegexp = regexp.MustCompile(`\$([A-Za-z_]\w*)="([A-Za-z0-9+/=]*)";\$[A-Za-z_]\w*=base64_decode\(\$[A-Za-z_]\w*\);`)
+	phpCallRegexp  = regexp.MustCompile(`\r\n([A-Za-z_]\w*)\(([$\w,\s]*)\);\s*$`)
+	// behinder.GetRawASP 生成的参数 main Array(chrw(119)&chrw(104),chrw(47))
+	aspParamsRegexp    = regexp.MustCompile(`\r\nmain\s+Array\((.*)\)\s*$`)
+	aspCharRegexp      = regexp.MustCompile(`chrw\((\d+)\)`)
+	yakShellParamRegex = regexp.MustCompile(`^[\w-]+~~[A-Za-z0-9+/=]*(,[\w-]+~~[A-Za-z0-9+/=]*)*$`)
+
+	// 哥斯拉常用的参数，用于生成可读的操作描述
+	godzillaCommandKeys = []string{
+		"cmdLine", "executableFile", "executableArgs", "dirName", "fileName", "srcFileName", "destFileName",
+		"newFileName", "evalClassName", "codeName", "dbType", "execSql",
+	}
+	trafficScripts = []string{
+		ypb.ShellScript_JSP.String(),
+		ypb.ShellScript_PHP.String(),
+		ypb.ShellScript_ASPX.String(),
+		ypb.ShellScript_ASP.String(),
+	}
+	yakShellModes = []string{
+		ypb.EncMode_AesBase64.String(),
+		ypb.EncMode_AesRaw.String(),
+		ypb.EncMode_Base64.String(),
+		ypb.EncMode_Raw.String(),
+	}
+)
+
+// classTemplate 冰蝎、yakshell 的 java payload，参数以 {{name}} 的形式保存在常量池中
+type classTemplate struct {
+	name         string
+	poolSize     int
+	consts       map[int]string
+	placeholders map[int]string
+}
+
+var (
+	trafficTemplateOnce sync.Once
+	classTemplates      []*classTemplate
+	// script => payload name and code prefix
+	codeTemplates     = make(map[string][][2]string)
+	assemblyTemplates = make(map[string][]byte)
+)
+
+func loadTrafficTemplates() {
+	trafficTemplateOnce.Do(func() {
+		addClass := func(name, hexCode string) {
+			raw, err := hex.DecodeString(hexCode)
+			if err != nil {
+				return
+			}
+			obj, err := parseJavaClass(raw)
+			if err != nil {
+				log.Debugf("parse payload class %s failed: %v", name, err)
+				return
+			}
+			tpl := &classTemplate{
+				name:         name,
+				poolSize:     len(obj.ConstantPool),
+				consts:       make(map[int]string),
+				placeholders: make(map[int]string),
+			}
+			for i, c := range obj.ConstantPool {
+				utf8Info, ok := c.(*javaclassparser.ConstantUtf8Info)
+				if !ok {
+					continue
+				}
+				if strings.HasPrefix(utf8Info.Value, "{{") && strings.HasSuffix(utf8Info.Value, "}}") {
+					tpl.placeholders[i] = strings.TrimSuffix(strings.TrimPrefix(utf8Info.Value, "{{"), "}}")
+				} else {
+					tpl.consts[i] = utf8Info.Value
+				}
+			}
+			classTemplates = append(classTemplates, tpl)
+		}
+		addCode := func(script, name, hexCode string) {
+			raw, err := hex.DecodeString(hexCode)
+			if err != nil {
+				return
+			}
+			code := strings.Replace(string(raw), "<?", "", 1)
+			if index := strings.Index(code, "__Encrypt__"); index >= 0 {
+				code = code[:index]
+			}
+			if len(code) > 512 {
+				code = code[:512]
+			}
+			if strings.TrimSpace(code) == "" {
+				return
+			}
+			// 冰蝎与 yakshell 存在同名的 payload
+			codeTemplates[script] = append(codeTemplates[script], [2]string{name, code})
+		}
+		add := func(script, name, hexCode string) {
+			switch script {
+			case ypb.ShellScript_JSP.String():
+				addClass(name, hexCode)
+			case ypb.ShellScript_ASPX.String():
+				if raw, err := hex.DecodeString(hexCode); err == nil {
+					assemblyTemplates[name] = raw
+				}
+			default:
+				addCode(script, name, hexCode)
+			}
+		}
+
+		for script, items := range payloads.HexPayload {
+			for name, hexCode := range items {
+				add(script, name.String(), hexCode)
+			}
+		}
+		entries, err := payloads.YakPayloads.ReadDir("yakshell/static")
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
+			// AllPayloadGo.class.txt
+			items := strings.Split(entry.Name(), ".")
+			if len(items) != 3 {
+				continue
+			}
+			var script string
+			switch items[1] {
+			case "class":
+				script = ypb.ShellScript_JSP.String()
+			case "php":
+				script = ypb.ShellScript_PHP.String()
+			case "dll":
+				script = ypb.ShellScript_ASPX.String()
+			default:
+				continue
+			}
+			hexCode, err := payloads.GetHexYakPayload(items[0] + "." + script)
+			if err != nil {
+				continue
+			}
+			add(script, items[0], hexCode)
+		}
+	})
+}
+
+// safeDecode 错误的密钥或者数据可能会导致解密、解析时 panic
+func safeDecode(f func() ([]byte, error)) (ret []byte) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+		}
+	}()
+	result, err := f()
+	if err != nil {
+		return nil
+	}
+	return result
+}
+
+func parseJavaClass(raw []byte) (obj *javaclassparser.ClassObject, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			obj, err = nil, utils.Errorf("parse java class failed: %v", e)
+		}
+	}()
+	return javaclassparser.Parse(raw)
+}
+
+func copyBytes(raw []byte) []byte {
+	ret := make([]byte, len(raw))
+	copy(ret, raw)
+	return ret
+}
+
+// isTrafficText 判断解密后的内容是否为可读文本
+func isTrafficText(raw []byte) bool {
+	if len(raw) == 0 || !utf8.Valid(raw) {
+		return false
+	}
+	for _, r := range string(raw) {
+		if r < 0x20 && r != '\r' && r != '\n' && r != '\t' {
+			return false
+		}
+	}
+	return true
+}
+
+func trafficParamValue(raw []byte) string {
+	if len(raw) == 0 || isTrafficText(raw) {
+		return string(raw)
+	}
+	return fmt.Sprintf("[binary %d bytes]", len(raw))
+}
+
+// trafficCommand 生成可读的操作描述，keys 为空时使用全部参数
+func trafficCommand(payload string, params map[string]string, keys ...string) string {
+	if len(keys) == 0 {
+		for k := range params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+	}
+	items := []string{payload}
+	for _, k := range keys {
+		if v, ok := params[k]; ok && v != "" {
+			items = append(items, k+"="+utils.ShrinkString(v, 256))
+		}
+	}
+	return strings.Join(items, " ")
+}
+
+// decodeJavaClassPayload 根据常量池匹配 payload 模板，还原被替换的参数
+func decodeJavaClassPayload(raw []byte) (string, map[string]string, bool) {
+	obj, err := parseJavaClass(raw)
+	if err != nil {
+		return "", nil, false
+	}
+	loadTrafficTemplates()
+	for _, tpl := range classTemplates {
+		if tpl.poolSize != len(obj.ConstantPool) {
+			continue
+		}
+		// 类名与源文件名会被随机替换
+		diff := 0
+		for i, v := range tpl.consts {
+			utf8Info, ok := obj.ConstantPool[i].(*javaclassparser.ConstantUtf8Info)
+			if !ok {
+				diff = len(tpl.consts)
+				break
+			}
+			if utf8Info.Value != v {
+				diff++
+			}
+		}
+		if diff > 2 {
+			continue
+		}
+		params := make(map[string]string)
+		for i, name := range tpl.placeholders {
+			utf8Info, ok := obj.ConstantPool[i].(*javaclassparser.ConstantUtf8Info)
+			if ok && utf8Info.Value != "{{"+name+"}}" {
+				params[name] = utf8Info.Value
+			}
+		}
+		return tpl.name, params, true
+	}
+	name := obj.ThisClassVerbose
+	if name == "" {
+		name = "JavaClass"
+	}
+	return name, map[string]string{}, true
+}
+
+// decodeAssemblyPayload 解析 behinder.GetRawAssembly 生成的 payload
+func decodeAssemblyPayload(raw []byte) (string, map[string]string, bool) {
+	code, paramsStr, _ := bytes.Cut(raw, []byte("~~~~~~"))
+	params := make(map[string]string)
+	for _, item := range strings.Split(string(paramsStr), ",") {
+		k, v, ok := strings.Cut(item, ":")
+		if !ok {
+			continue
+		}
+		value, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			continue
+		}
+		params[k] = trafficParamValue(value)
+	}
+	loadTrafficTemplates()
+	for name, tpl := range assemblyTemplates {
+		if bytes.Equal(tpl, code) {
+			return name, params, true
+		}
+	}
+	return "Assembly", params, true
+}
+
+func matchCodeTemplate(script, code string) string {
+	loadTrafficTemplates()
+	var ret, prefix string
+	for _, tpl := range codeTemplates[script] {
+		if strings.HasPrefix(code, tpl[1]) && len(tpl[1]) > len(prefix) {
+			ret, prefix = tpl[0], tpl[1]
+		}
+	}
+	return ret
+}
+
+// decodePhpPayload 解析 behinder.GetRawPHP 生成的代码，strict 时要求能够匹配 payload 模板或参数
+func decodePhpPayload(raw []byte, strict bool) (string, map[string]string, bool) {
+	if !isTrafficText(raw) {
+		return "", nil, false
+	}
+	code := string(raw)
+	params := make(map[string]string)
+	for _, match := range phpParamRegexp.FindAllStringSubmatch(code, -1) {
+		value, err := base64.StdEncoding.DecodeString(match[2])
+		if err != nil {
+			continue
+		}
+		params[match[1]] = trafficParamValue(value)
+	}
+	name := matchCodeTemplate(ypb.ShellScript_PHP.String(), code)
+	call := phpCallRegexp.FindStringSubmatch(code)
+	if strict && name == "" && (len(call) == 0 || len(params) == 0) {
+		return "", nil, false
+	}
+	if name == "" && len(call) > 0 {
+		name = call[1]
+	}
+	if name == "" {
+		name = "PhpCode"
+	}
+	return name, params, true
+}
+
+// decodeAspPayload 解析 behinder.GetRawASP 生成的代码，参数只保留了值
+func decodeAspPayload(raw []byte) (string, map[string]string, bool) {
+	if !isTrafficText(raw) {
+		return "", nil, false
+	}
+	code := string(raw)
+	name := matchCodeTemplate(ypb.ShellScript_ASP.String(), code)
+	match := aspParamsRegexp.FindStringSubmatch(code)
+	if name == "" && len(match) == 0 && !strings.Contains(code, "Function ") {
+		return "", nil, false
+	}
+	if name == "" {
+		name = "AspCode"
+	}
+	params := make(map[string]string)
+	if len(match) > 0 {
+		for i, item := range strings.Split(match[1], ",") {
+			var value strings.Builder
+			for _, c := range aspCharRegexp.FindAllStringSubmatch(item, -1) {
+				if r, err := strconv.Atoi(c[1]); err == nil {
+					value.WriteRune(rune(r))
+				}
+			}
+			params[fmt.Sprintf("arg%d", i)] = value.String()
+		}
+	}
+	return name, params, true
+}
+
+// decodeBehinderResult 冰蝎的响应为 {"status":"base64","msg":"base64"}
+func decodeBehinderResult(raw []byte) string {
+	var data any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		if isTrafficText(raw) {
+			return string(raw)
+		}
+		return ""
+	}
+	decoded, err := decodeBase64Values(data)
+	if err != nil {
+		return string(raw)
+	}
+	if m, ok := decoded.(map[string]any); ok {
+		if msg, ok := m["msg"].(string); ok {
+			return msg
+		}
+		if msg, ok := m["msg"]; ok {
+			decoded = msg
+		}
+	}
+	ret, err := json.Marshal(decoded)
+	if err != nil {
+		return string(raw)
+	}
+	return string(ret)
+}
+
+// decodeYakShellResult yakshell 的响应为 {"status":"ok","msg":"base64"}
+func decodeYakShellResult(raw []byte) (string, bool) {
+	var data map[string]any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return "", false
+	}
+	if _, ok := data["status"]; !ok {
+		return "", false
+	}
+	msg, _ := data["msg"].(string)
+	if value, err := base64.StdEncoding.DecodeString(msg); err == nil {
+		return string(value), true
+	}
+	return msg, true
+}
+
+func behinderDecrypt(body, key []byte, script string, xor bool) []byte {
+	return safeDecode(func() ([]byte, error) {
+		if xor {
+			raw, err := base64.StdEncoding.DecodeString(string(body))
+			if err != nil {
+				return nil, err
+			}
+			return payloads.Xor(raw, key), nil
+		}
+		return behinder.Decryption(copyBytes(body), key, script)
+	})
+}
+
+func (a *WebShellTrafficAnalyzer) detectBehinder(p *trafficPacket) *WebShellTraffic {
+	body := bytes.TrimSpace(p.body)
+	type mode struct {
+		script string
+		xor    bool
+	}
+	modes := []mode{
+		{script: ypb.ShellScript_JSP.String()},
+		{script: ypb.ShellScript_PHP.String()},
+		// 服务端没有 openssl 扩展时，冰蝎 php 使用 xor 加密
+		{script: ypb.ShellScript_PHP.String(), xor: true},
+		{script: ypb.ShellScript_ASPX.String()},
+		{script: ypb.ShellScript_ASP.String()},
+	}
+	for _, key := range a.keys {
+		for _, m := range modes {
+			raw := behinderDecrypt(body, key.key, m.script, m.xor)
+			if len(raw) == 0 {
+				continue
+			}
+			var (
+				payload string
+				params  map[string]string
+				ok      bool
+			)
+			switch m.script {
+			case ypb.ShellScript_JSP.String():
+				if bytes.HasPrefix(raw, javaClassMagic) {
+					payload, params, ok = decodeJavaClassPayload(raw)
+				}
+			case ypb.ShellScript_PHP.String():
+				if match := behinderPhpWrapRegexp.FindSubmatch(raw); len(match) > 0 {
+					if code, err := base64.StdEncoding.DecodeString(string(match[1])); err == nil {
+						payload, params, ok = decodePhpPayload(code, false)
+					}
+				}
+			case ypb.ShellScript_ASPX.String():
+				if bytes.HasPrefix(raw, assemblyMagic) {
+					payload, params, ok = decodeAssemblyPayload(raw)
+				}
+			case ypb.ShellScript_ASP.String():
+				payload, params, ok = decodeAspPayload(raw)
+			}
+			if !ok {
+				continue
+			}
+
+			traffic := &WebShellTraffic{
+				Tool:    TrafficToolBehinder,
+				Script:  m.script,
+				Key:     key.name,
+				Payload: payload,
+				Params:  params,
+				Command: trafficCommand(payload, params),
+				Request: raw,
+			}
+			if m.xor {
+				traffic.EncMode = "Xor"
+			}
+			rspBody := bytes.TrimSpace(p.rspBody)
+			if len(rspBody) > 0 {
+				if rsp := behinderDecrypt(rspBody, key.key, m.script, m.xor); len(rsp) > 0 {
+					traffic.Response = rsp
+					traffic.Result = decodeBehinderResult(rsp)
+				}
+			}
+			return traffic
+		}
+	}
+	return nil
+}
+
+// decodeGodzillaPayload 解析哥斯拉的请求，第一次请求为加载 payload，之后为序列化后的参数
+func decodeGodzillaPayload(script string, raw []byte) (string, map[string]string, bool) {
+	if parameter, err := godzilla.UnSerialize(raw); err == nil {
+		if method := parameter.GetString("methodName"); method != "" && isTrafficText([]byte(method)) {
+			params := make(map[string]string)
+			for k, v := range parameter.HashMap {
+				if value, ok := v.([]byte); ok {
+					params[k] = trafficParamValue(value)
+				}
+			}
+			return method, params, true
+		}
+	}
+	switch {
+	case bytes.HasPrefix(raw, javaClassMagic):
+		return "LoadPayload(JavaClass)", map[string]string{}, true
+	case bytes.HasPrefix(raw, assemblyMagic):
+		return "LoadPayload(Assembly)", map[string]string{}, true
+	case isTrafficText(raw) && script != ypb.ShellScript_JSP.String() && script != ypb.ShellScript_ASPX.String():
+		if script == ypb.ShellScript_ASP.String() && !strings.Contains(string(raw), "Function ") {
+			return "", nil, false
+		}
+		return "LoadPayload(Code)", map[string]string{}, true
+	}
+	return "", nil, false
+}
+
+func (a *WebShellTrafficAnalyzer) detectGodzilla(p *trafficPacket) *WebShellTraffic {
+	type candidate struct {
+		pass    string
+		encMode string
+		data    []byte
+	}
+	var candidates []*candidate
+	names := make([]string, 0, len(p.params))
+	for name := range p.params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		data, err := base64.StdEncoding.DecodeString(p.params[name])
+		if err != nil || len(data) == 0 {
+			continue
+		}
+		candidates = append(candidates, &candidate{pass: name, encMode: ypb.EncMode_Base64.String(), data: data})
+	}
+	candidates = append(candidates, &candidate{encMode: ypb.EncMode_Raw.String(), data: p.body})
+
+	for _, c := range candidates {
+		for _, key := range a.keys {
+			for _, script := range trafficScripts {
+				raw := safeDecode(func() ([]byte, error) {
+					return godzilla.Decryption(copyBytes(c.data), key.key, c.pass, ypb.EncMode_Raw.String(), script)
+				})
+				if len(raw) == 0 {
+					continue
+				}
+				payload, params, ok := decodeGodzillaPayload(script, raw)
+				if !ok {
+					continue
+				}
+				keys := make([]string, 0, len(godzillaCommandKeys))
+				for _, k := range godzillaCommandKeys {
+					if _, ok := params[k]; ok {
+						keys = append(keys, k)
+					}
+				}
+				traffic := &WebShellTraffic{
+					Tool:    TrafficToolGodzilla,
+					Script:  script,
+					EncMode: c.encMode,
+					Key:     key.name,
+					Pass:    c.pass,
+					Payload: payload,
+					Params:  params,
+					Command: payload,
+					Request: raw,
+				}
+				if len(keys) > 0 {
+					traffic.Command = trafficCommand(payload, params, keys...)
+				}
+				if len(p.rspBody) > 0 {
+					rsp := safeDecode(func() ([]byte, error) {
+						return godzilla.Decryption(copyBytes(p.rspBody), key.key, c.pass, c.encMode, script)
+					})
+					if len(rsp) > 0 {
+						traffic.Response = rsp
+						traffic.Result = trafficParamValue(rsp)
+					}
+				}
+				return traffic
+			}
+		}
+	}
+	return nil
+}
+
+// decodeYakShellPayload 解析 yakshell 的请求，session 模式下只发送序列化后的参数
+func decodeYakShellPayload(raw []byte) (string, map[string]string, bool) {
+	switch {
+	case bytes.HasPrefix(raw, javaClassMagic):
+		return decodeJavaClassPayload(raw)
+	case bytes.HasPrefix(raw, assemblyMagic):
+		return decodeAssemblyPayload(raw)
+	case yakShellParamRegex.Match(raw):
+		params := make(map[string]string)
+		for _, item := range strings.Split(string(raw), ",") {
+			k, v, _ := strings.Cut(item, "~~")
+			if value, err := base64.StdEncoding.DecodeString(v); err == nil {
+				params[k] = trafficParamValue(value)
+			}
+		}
+		return "SessionParams", params, true
+	}
+	return decodePhpPayload(raw, true)
+}
+
+func (a *WebShellTrafficAnalyzer) detectYakShell(p *trafficPacket) *WebShellTraffic {
+	names := make([]string, 0, len(p.params))
+	for name := range p.params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := []byte(p.params[name])
+		if name == "" || len(value) == 0 {
+			continue
+		}
+		// yakshell 使用连接参数作为密钥
+		key := []byte(name)
+		for _, mode := range yakShellModes {
+			raw := safeDecode(func() ([]byte, error) {
+				return yakshell.Decryption(copyBytes(value), key, mode)
+			})
+			if len(raw) == 0 {
+				continue
+			}
+			payload, params, ok := decodeYakShellPayload(raw)
+			if !ok {
+				continue
+			}
+			traffic := &WebShellTraffic{
+				Tool:    TrafficToolYakShell,
+				Script:  yakShellScript(raw),
+				EncMode: mode,
+				Key:     name,
+				Pass:    name,
+				Payload: payload,
+				Params:  params,
+				Command: trafficCommand(payload, params),
+				Request: raw,
+			}
+			if len(p.rspBody) > 0 {
+				for _, rspMode := range yakShellModes {
+					rsp := safeDecode(func() ([]byte, error) {
+						return yakshell.Decryption(copyBytes(p.rspBody), key, rspMode)
+					})
+					if result, ok := decodeYakShellResult(rsp); ok {
+						traffic.Response = rsp
+						traffic.Result = result
+						break
+					}
+				}
+			}
+			return traffic
+		}
+	}
+	return nil
+}
+
+func yakShellScript(raw []byte) string {
+	switch {
+	case bytes.HasPrefix(raw, javaClassMagic):
+		return ypb.ShellScript_JSP.String()
+	case bytes.HasPrefix(raw, assemblyMagic):
+		return ypb.ShellScript_ASPX.String()
+	case yakShellParamRegex.Match(raw):
+		return ""
+	}
+	return ypb.ShellScript_PHP.String()
+}
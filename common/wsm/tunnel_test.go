@@ -0,0 +1,207 @@
+package wsm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+// mockTunnelServer 与 payloads/tunnel 中的脚本实现相同的协议
+func mockTunnelServer(t *testing.T, key string) string {
+	var conns sync.Map
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("X-Tunnel-Key") != key {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fail := func(err string) {
+			w.Header().Set("X-Tunnel-Status", "FAIL")
+			w.Header().Set("X-Tunnel-Error", err)
+		}
+		id := r.Header.Get("X-Tunnel-Id")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		switch r.Header.Get("X-Tunnel-Cmd") {
+		case tunnelCmdPing:
+		case tunnelCmdConnect:
+			conn, err := net.DialTimeout("tcp", r.Header.Get("X-Tunnel-Target"), 5*time.Second)
+			if err != nil {
+				fail(err.Error())
+				return
+			}
+			conns.Store(id, conn)
+		case tunnelCmdRead:
+			v, ok := conns.Load(id)
+			if !ok {
+				fail("connection closed")
+				return
+			}
+			conn := v.(net.Conn)
+			buf := make([]byte, 65536)
+			conn.SetReadDeadline(time.Now().Add(20 * time.Millisecond))
+			n, err := conn.Read(buf)
+			if n == 0 && err != nil {
+				if ne, ok := err.(net.Error); !ok || !ne.Timeout() {
+					conns.Delete(id)
+					conn.Close()
+					fail("connection closed")
+					return
+				}
+			}
+			w.Header().Set("X-Tunnel-Status", "OK")
+			w.Write(buf[:n])
+			return
+		case tunnelCmdForward:
+			v, ok := conns.Load(id)
+			if !ok {
+				fail("connection closed")
+				return
+			}
+			data, _ := io.ReadAll(r.Body)
+			if _, err := v.(net.Conn).Write(data); err != nil {
+				fail(err.Error())
+				return
+			}
+		case tunnelCmdDisconnect:
+			if v, ok := conns.LoadAndDelete(id); ok {
+				v.(net.Conn).Close()
+			}
+		default:
+			fail("unknown command")
+			return
+		}
+		w.Header().Set("X-Tunnel-Status", "OK")
+	}))
+	t.Cleanup(server.Close)
+	return server.URL + "/tunnel.jsp"
+}
+
+func mockEchoServer(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return lis.Addr().String()
+}
+
+func TestGenerateTunnelScript(t *testing.T) {
+	for script, flag := range map[string]string{
+		ypb.ShellScript_JSP.String():  "SocketChannel",
+		ypb.ShellScript_PHP.String():  "stream_socket_client",
+		ypb.ShellScript_ASPX.String(): "System.Net.Sockets",
+	} {
+		content, err := GenerateTunnelScript(script, "yak-tunnel")
+		require.NoError(t, err)
+		require.Contains(t, content, flag)
+		require.Contains(t, content, `"yak-tunnel"`)
+		require.NotContains(t, content, "{{key}}")
+	}
+	_, err := GenerateTunnelScript(ypb.ShellScript_ASP.String(), "yak-tunnel")
+	require.Error(t, err)
+	_, err = GenerateTunnelScript(ypb.ShellScript_PHP.String(), "")
+	require.Error(t, err)
+}
+
+func TestWebShellTunnel_Dial(t *testing.T) {
+	tunnelUrl := mockTunnelServer(t, "yak-tunnel")
+	echo := mockEchoServer(t)
+
+	ok, err := NewWebShellTunnel(tunnelUrl, "wrong-key").Ping()
+	require.False(t, ok)
+	require.Error(t, err)
+
+	tunnel := NewWebShellTunnel(tunnelUrl, "yak-tunnel", WithTunnelPollInterval(0.01))
+	ok, err = tunnel.Ping()
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = tunnel.Dial(fmt.Sprintf("127.0.0.1:%d", utils.GetRandomAvailableTCPPort()))
+	require.Error(t, err)
+
+	conn, err := tunnel.Dial(echo)
+	require.NoError(t, err)
+	defer conn.Close()
+	data := bytes.Repeat([]byte{0x00, 0xff, 'y', 'a', 'k', '\r', '\n'}, 20000)
+	_, err = conn.Write(data)
+	require.NoError(t, err)
+	buf := make([]byte, len(data))
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.Equal(t, data, buf)
+}
+
+func TestWebShellTunnel_ReadDeadline(t *testing.T) {
+	tunnelUrl := mockTunnelServer(t, "yak-tunnel")
+	echo := mockEchoServer(t)
+
+	tunnel := NewWebShellTunnel(tunnelUrl, "yak-tunnel", WithTunnelPollInterval(0.01))
+	conn, err := tunnel.Dial(echo)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the echo server is silent until something is written
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(300*time.Millisecond)))
+	start := time.Now()
+	_, err = conn.Read(make([]byte, 16))
+	require.Error(t, err)
+	var netErr net.Error
+	require.ErrorAs(t, err, &netErr)
+	require.True(t, netErr.Timeout())
+	require.Less(t, time.Since(start), 3*time.Second)
+
+	require.NoError(t, conn.SetReadDeadline(time.Time{}))
+	_, err = conn.Write([]byte("after deadline"))
+	require.NoError(t, err)
+	buf := make([]byte, len("after deadline"))
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.Equal(t, "after deadline", string(buf))
+}
+
+func TestWebShellTunnel_Socks5(t *testing.T) {
+	tunnelUrl := mockTunnelServer(t, "yak-tunnel")
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("internal service " + r.URL.Path))
+	}))
+	defer target.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tunnel := NewWebShellTunnel(tunnelUrl, "yak-tunnel", WithTunnelContext(ctx), WithTunnelPollInterval(0.01))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go tunnel.serveSocks5(lis)
+
+	for i := 0; i < 3; i++ {
+		rsp, err := lowhttp.HTTP(
+			lowhttp.WithPacketBytes([]byte(fmt.Sprintf("GET /admin/%d HTTP/1.1\r\nHost: %s\r\n\r\n", i, strings.TrimPrefix(target.URL, "http://")))),
+			lowhttp.WithProxy("socks5://"+lis.Addr().String()),
+			lowhttp.WithTimeout(10*time.Second),
+		)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("internal service /admin/%d", i), string(lowhttp.GetHTTPPacketBody(rsp.RawPacket)))
+	}
+}
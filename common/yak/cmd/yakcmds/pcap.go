@@ -14,7 +14,6 @@ import (
 	"github.com/yaklang/yaklang/common/suricata/match"
 	"github.com/yaklang/yaklang/common/suricata/rule"
 	"github.com/yaklang/yaklang/common/utils"
-	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/utils/tlsutils"
 	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
 )
@@ -51,6 +50,10 @@ var pcapCommand = cli.Command{
 			Name:  "suricata-rule-keyword,k",
 			Usage: `suricata规则关键字，可选多个，使用逗号分隔`,
 		},
+		cli.StringFlag{
+			Name:  "tls-keylog",
+			Usage: "TLS Key Log 文件路径（SSLKEYLOGFILE 格式），用于解密 HTTPS 流量",
+		},
 	},
 	Action: func(c *cli.Context) error {
 		if c.Bool("list-devices") {
@@ -80,6 +83,9 @@ var pcapCommand = cli.Command{
 		if output := c.String("output"); output != "" {
 			opts = append(opts, pcaputil.WithOutput(output))
 		}
+		if keyLog := c.String("tls-keylog"); keyLog != "" {
+			opts = append(opts, pcaputil.WithTLSKeyLogFile(keyLog))
+		}
 		if suricata := c.String("suricata"); suricata != "" {
 			//opts = append(opts, pcaputil.WithSuricataFilter(suricata))
 		}
@@ -151,12 +157,9 @@ var pcapCommand = cli.Command{
 					fmt.Println(string(rspBytes))
 					fmt.Println("-----------------------------------------")
 
-					var urlStr string
-					urlIns, _ := lowhttp.ExtractURLFromHTTPRequestRaw(reqBytes, false)
-					if urlIns != nil {
-						urlStr = urlIns.String()
+					if err := mng.CreateHTTPFlow(flow, req, rsp); err != nil {
+						log.Errorf("save http flow failed: %s", err)
 					}
-					yakit.SaveFromHTTPFromRaw(consts.GetGormProjectDatabase(), false, reqBytes, rspBytes, "pcap", urlStr, "")
 					return
 				}
 				reqBytes, _ := utils.DumpHTTPRequest(req, true)
@@ -91,6 +91,7 @@ die(nuclei.UpdateDatabase())`, "main")
 	},
 	&synscanCommand,
 	&servicescanCommand,
+	&scaScanCommand,
 	hybridScanCommand,
 	&crawlerxCommand,
 }
@@ -0,0 +1,123 @@
+package yakcmds
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/urfave/cli"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/sca"
+	"github.com/yaklang/yaklang/common/sca/dxtypes"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var scaScanCommand = cli.Command{
+	Name:  "scan-sca",
+	Usage: "Scan dependencies of a directory / git repo / docker image file / SBOM, and match them with local CVE database offline",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "path,target,t",
+			Usage: "扫描的本地目录",
+		},
+		cli.StringFlag{
+			Name:  "git",
+			Usage: "扫描的本地 git 仓库（HEAD）",
+		},
+		cli.StringFlag{
+			Name:  "image",
+			Usage: "扫描的 docker 镜像文件（docker save 导出的 tar）",
+		},
+		cli.StringFlag{
+			Name:  "sbom",
+			Usage: "导入 CycloneDX / SPDX SBOM 文件进行漏洞匹配",
+		},
+		cli.StringFlag{
+			Name:  "cve-db",
+			Usage: "CVE 数据库路径，默认使用 yakit 的 CVE 数据库",
+		},
+		cli.BoolFlag{
+			Name:  "save",
+			Usage: "保存漏洞到 yakit 数据库",
+		},
+		cli.StringFlag{
+			Name:  "json,o",
+			Usage: "详细结果输出 json 到文件",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var (
+			pkgs []*dxtypes.Package
+			err  error
+		)
+		switch {
+		case c.String("path") != "":
+			pkgs, err = sca.ScanLocalFilesystem(c.String("path"))
+		case c.String("git") != "":
+			pkgs, err = sca.ScanGitRepo(c.String("git"))
+		case c.String("image") != "":
+			pkgs, err = sca.ScanDockerImageFromFile(c.String("image"))
+		case c.String("sbom") != "":
+			var raw []byte
+			raw, err = os.ReadFile(c.String("sbom"))
+			if err == nil {
+				pkgs, err = dxtypes.ParseSBOM(raw)
+			}
+		default:
+			return utils.Error("one of --path / --git / --image / --sbom is required")
+		}
+		if err != nil {
+			return utils.Errorf("scan dependencies failed: %s", err)
+		}
+		log.Infof("found %d packages", len(pkgs))
+
+		var opts []sca.VulnMatchOption
+		if db := c.String("cve-db"); db != "" {
+			if !utils.FileExists(db) {
+				return utils.Errorf("cve database %v not found", db)
+			}
+			opts = append(opts, sca.WithVulnMatchCVEDatabase(db))
+		}
+		opts = append(opts, sca.WithVulnMatchSaveRisk(c.Bool("save")))
+		risks, err := sca.MatchVulnerabilities(pkgs, opts...)
+		if err != nil {
+			log.Errorf("match vulnerabilities failed: %s", err)
+		}
+
+		showSCARisks(risks)
+		if output := c.String("json"); output != "" {
+			raw, err := json.MarshalIndent(risks, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(output, raw, 0o644); err != nil {
+				return err
+			}
+			log.Infof("save results to %v", output)
+		}
+		return nil
+	},
+}
+
+func showSCARisks(risks []*schema.Risk) {
+	if len(risks) == 0 {
+		log.Info("no vulnerable dependency found")
+		return
+	}
+	for _, r := range risks {
+		raw, err := strconv.Unquote(r.Details)
+		if err != nil {
+			raw = r.Details
+		}
+		var details map[string]any
+		_ = json.Unmarshal([]byte(raw), &details)
+		fmt.Printf("[%-8s] %-16s %s@%s affected: %v fixed: %v\n",
+			r.Severity, r.CVE,
+			utils.MapGetString(details, "package"), utils.MapGetString(details, "version"),
+			utils.MapGetString(details, "affected_range"), utils.MapGetString(details, "fixed_version"),
+		)
+	}
+	log.Infof("found %d vulnerabilities", len(risks))
+}
@@ -99,6 +99,25 @@ type Config struct {
 	OnTemplateLoaded  func(*YakTemplate) bool
 	BeforeSendPackage func(data []byte, isHttps bool) []byte
 	defaultFilter     filter.Filterable
+
+	// WorkflowTemplateLoader resolves the templates of workflows, default from the local database
+	WorkflowTemplateLoader WorkflowTemplateLoader
+
+	// headless templates launch local chrome by default, or connect to the browser by ws address
+	BrowserExePath   string
+	BrowserWsAddress string
+}
+
+func WithBrowserExePath(p string) ConfigOption {
+	return func(config *Config) {
+		config.BrowserExePath = p
+	}
+}
+
+func WithBrowserWsAddress(addr string) ConfigOption {
+	return func(config *Config) {
+		config.BrowserWsAddress = addr
+	}
 }
 
 func WithCustomVulnFilter(f filter.Filterable) ConfigOption {
@@ -359,9 +359,9 @@ func processVulnerability(target any, filterVul filter.Filterable, vCh chan *too
 			)
 			details := make(map[string]interface{}, 2)
 			runtimeId := utils.MapGetString(i, "runtimeId")
-			if len(tpl.HTTPRequestSequences) > 0 {
-				resp := i["responses"].([]*lowhttp.LowhttpResponse)
-				reqBulk := i["requests"].(*YakRequestBulkConfig)
+			// workflows report the http responses of the matched templates
+			if resp, ok := i["responses"].([]*lowhttp.LowhttpResponse); ok && len(resp) > 0 {
+				reqBulk, _ := i["requests"].(*YakRequestBulkConfig)
 				if runtimeId != "" {
 					runtimeId = resp[0].RuntimeId
 				}
@@ -381,14 +381,15 @@ func processVulnerability(target any, filterVul filter.Filterable, vCh chan *too
 					}
 				}
 				currTarget = strings.Join(urls, ",")
-				payloads, err = httpPayloadsToString(reqBulk.Payloads)
-				if err != nil {
-					log.Errorf("httpPayloadsToString failed: %v", err)
+				if reqBulk != nil && reqBulk.Payloads != nil {
+					payloads, err = httpPayloadsToString(reqBulk.Payloads)
+					if err != nil {
+						log.Errorf("httpPayloadsToString failed: %v", err)
+					}
 				}
 			}
 
-			if len(tpl.TCPRequestSequences) > 0 {
-				resp := i["responses"].([]*NucleiTcpResponse)
+			if resp, ok := i["responses"].([]*NucleiTcpResponse); ok && len(resp) > 0 {
 				calcSha1 = utils.CalcSha1(tpl.Name, resp[0].RawRequest, target)
 
 				currTarget = resp[0].RemoteAddr
@@ -403,6 +404,10 @@ func processVulnerability(target any, filterVul filter.Filterable, vCh chan *too
 				}
 			}
 
+			if calcSha1 == "" {
+				calcSha1 = utils.CalcSha1(tpl.Name, target)
+			}
+
 			pv := &tools.PocVul{
 				Source:        "nuclei",
 				Target:        currTarget,
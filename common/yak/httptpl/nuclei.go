@@ -171,10 +171,39 @@ func CreateYakTemplateFromNucleiTemplateRaw(tplRaw string) (*YakTemplate, error)
 			}
 
 			return yakTemp, nil
-		} else if nodeGetFirstRaw(rootNode, "workflows") != nil {
-			return nil, utils.Error("yakit nuclei cannot support workflows now~")
-		} else if nodeGetFirstRaw(rootNode, "headless") != nil {
-			return nil, utils.Errorf("nuclei template `headless(crawler)` is not supported (*)")
+		} else if workflowsNode := nodeGetFirstRaw(rootNode, "workflows"); workflowsNode != nil {
+			yakTemp.Workflows, err = generateYakWorkflows(workflowsNode)
+			if err != nil {
+				return nil, utils.Errorf("parse nuclei workflows failed: %v", err)
+			}
+			return yakTemp, nil
+		} else if headlessNode := nodeGetFirstRaw(rootNode, "headless"); headlessNode != nil {
+			if headlessNode.Kind != yaml.SequenceNode {
+				return nil, utils.Error("nuclei template headless is not slice")
+			}
+			yakTemp.HeadlessRequestSequences, err = parseHeadlessBulk(headlessNode.Content)
+			if err != nil {
+				return nil, utils.Errorf("parse headless bulk failed: %v", err)
+			}
+			return yakTemp, nil
+		} else if dnsNode := nodeGetRaw(rootNode, "dns"); dnsNode != nil {
+			if dnsNode.Kind != yaml.SequenceNode {
+				return nil, utils.Error("nuclei template dns is not slice")
+			}
+			yakTemp.DNSRequestSequences, err = parseDNSBulk(dnsNode.Content)
+			if err != nil {
+				return nil, utils.Errorf("parse dns bulk failed: %v", err)
+			}
+			return yakTemp, nil
+		} else if sslNode := nodeGetRaw(rootNode, "ssl"); sslNode != nil {
+			if sslNode.Kind != yaml.SequenceNode {
+				return nil, utils.Error("nuclei template ssl is not slice")
+			}
+			yakTemp.SSLRequestSequences, err = parseSSLBulk(sslNode.Content)
+			if err != nil {
+				return nil, utils.Errorf("parse ssl bulk failed: %v", err)
+			}
+			return yakTemp, nil
 		} else {
 			// log.Warnf("-----------------NUCLEI FORMATTER CANNOT FIX--------------------")
 			// fmt.Println(tplRaw)
@@ -427,11 +456,12 @@ func generateYakMatcher(rootNode *yaml.Node) (*YakMatcher, error) {
 			Condition:   "",
 			Group:       nil,
 		}
+		match.Name = nodeGetString(node, "name")
 		match.Negative = nodeGetBool(node, "negative")
 		match.Condition = nodeGetString(node, "condition")
 		match.Id = int(nodeGetFloat64(node, "id"))
 
-		switch nodeGetString(node, "part") {
+		switch part := nodeGetString(node, "part"); part {
 		case "body":
 			match.Scope = "body"
 		case "header":
@@ -442,6 +472,9 @@ func generateYakMatcher(rootNode *yaml.Node) (*YakMatcher, error) {
 			match.Scope = "raw"
 		case "interactsh_protocol", "oob_protocol":
 			match.Scope = "interactsh_protocol"
+		default:
+			// output of headless steps, e.g. `part: alerts`
+			match.Scope = part
 		}
 		typ := nodeGetString(node, "type")
 		switch typ {
@@ -0,0 +1,76 @@
+package httptpl
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// https://docs.projectdiscovery.io/templates/protocols/dns
+//
+// dns:
+//   - name: "{{FQDN}}"
+//     type: CNAME
+//     class: inet
+//     recursion: true
+//     retries: 3
+//     matchers:
+//       - type: word
+//         words:
+//           - ".azurewebsites.net"
+
+func parseDNSBulk(ret []*yaml.Node) ([]*YakDNSBulkConfig, error) {
+	var confs []*YakDNSBulkConfig
+	for _, node := range ret {
+		conf := &YakDNSBulkConfig{
+			Name:      nodeGetString(node, "name"),
+			Type:      strings.ToUpper(strings.TrimSpace(nodeGetString(node, "type"))),
+			Class:     strings.ToLower(strings.TrimSpace(nodeGetString(node, "class"))),
+			Recursion: true,
+			Retries:   int(nodeGetInt64(node, "retries")),
+		}
+		if recursion := nodeGetRaw(node, "recursion"); recursion != nil {
+			conf.Recursion = nodeToBool(recursion)
+		}
+		if conf.Name == "" {
+			conf.Name = "{{FQDN}}"
+		}
+		if conf.Type == "" {
+			conf.Type = "A"
+		}
+		if _, ok := dns.StringToType[conf.Type]; !ok {
+			log.Warnf("dns record type %v is not supported", conf.Type)
+			continue
+		}
+		if _, ok := dnsClasses[conf.Class]; !ok {
+			log.Warnf("dns class %v is not supported", conf.Class)
+			continue
+		}
+
+		if nodeGetRaw(node, "matchers") != nil {
+			matcher, err := generateYakMatcher(node)
+			if err != nil {
+				log.Warnf("build matcher failed: %s", err)
+				continue
+			}
+			conf.Matcher = matcher
+		}
+		extractors, err := generateYakExtractors(node)
+		if err != nil {
+			log.Warnf("build extractor failed: %s", err)
+		}
+		conf.Extractor = extractors
+		if len(conf.Extractor) <= 0 && conf.Matcher == nil {
+			log.Warn("no matcher and extractor found")
+			continue
+		}
+		confs = append(confs, conf)
+	}
+	if len(confs) <= 0 {
+		return nil, utils.Error("empty dns bulk config")
+	}
+	return confs, nil
+}
@@ -0,0 +1,134 @@
+package httptpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+var dnsTakeoverDemo = `id: azure-takeover-detection
+info:
+  name: Microsoft Azure Takeover Detection
+  author: pdteam
+  severity: high
+
+dns:
+  - name: "{{FQDN}}"
+    type: CNAME
+    retries: 2
+    matchers-condition: and
+    matchers:
+      - type: word
+        words:
+          - "azurewebsites.net"
+      - type: word
+        part: rcode
+        words:
+          - "NOERROR"
+    extractors:
+      - type: regex
+        group: 1
+        regex:
+          - "IN\tCNAME\t(.+)"
+`
+
+func mockDNSServer(t *testing.T, handler dns.HandlerFunc) string {
+	addr := utils.HostPort("127.0.0.1", utils.GetRandomAvailableUDPPort())
+	server := &dns.Server{Addr: addr, Net: "udp", Handler: handler}
+	go func() {
+		if err := server.ListenAndServe(); err != nil {
+			t.Logf("serve dns server failed: %s", err)
+		}
+	}()
+	t.Cleanup(func() {
+		server.Shutdown()
+	})
+	time.Sleep(500 * time.Millisecond)
+	return addr
+}
+
+func TestCreateYakTemplateFromNucleiTemplateRaw_DNS(t *testing.T) {
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(dnsTakeoverDemo)
+	require.NoError(t, err)
+	require.Len(t, tpl.DNSRequestSequences, 1)
+
+	bulk := tpl.DNSRequestSequences[0]
+	require.Equal(t, "{{FQDN}}", bulk.Name)
+	require.Equal(t, "CNAME", bulk.Type)
+	require.True(t, bulk.Recursion)
+	require.Equal(t, 2, bulk.Retries)
+	require.Len(t, bulk.Matcher.SubMatchers, 2)
+	require.Len(t, bulk.Extractor, 1)
+
+	_, err = CreateYakTemplateFromNucleiTemplateRaw(`id: bad-dns
+info:
+  name: bad dns
+dns:
+  - type: NOTYPE
+    matchers:
+      - type: word
+        words: [a]
+`)
+	require.Error(t, err)
+}
+
+func TestDNSDomainVars(t *testing.T) {
+	vars := dnsDomainVars("www.example.co.uk")
+	require.Equal(t, "www.example.co.uk", vars["FQDN"])
+	require.Equal(t, "example.co.uk", vars["RDN"])
+	require.Equal(t, "example", vars["DN"])
+	require.Equal(t, "co.uk", vars["TLD"])
+	require.Equal(t, "www", vars["SD"])
+}
+
+func TestMockTest_DNS(t *testing.T) {
+	var recursion bool
+	server := mockDNSServer(t, func(w dns.ResponseWriter, req *dns.Msg) {
+		msg := new(dns.Msg)
+		msg.SetReply(req)
+		recursion = req.RecursionDesired
+		q := req.Question[0]
+		if q.Qtype == dns.TypeCNAME && q.Name == "takeover.yaklang.io." {
+			msg.Answer = append(msg.Answer, &dns.CNAME{
+				Hdr:    dns.RR_Header{Name: q.Name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60},
+				Target: "yakit-demo.azurewebsites.net.",
+			})
+		} else {
+			msg.Rcode = dns.RcodeNameError
+		}
+		w.WriteMsg(msg)
+	})
+
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(dnsTakeoverDemo)
+	require.NoError(t, err)
+
+	check := func(target string) (bool, map[string]any, []*NucleiTcpResponse) {
+		var (
+			matched   bool
+			extracted map[string]any
+			responses []*NucleiTcpResponse
+		)
+		config := NewConfig(WithTCPResultCallback(func(y *YakTemplate, bulk *YakNetworkBulkConfig, rsp []*NucleiTcpResponse, result bool, extractor map[string]interface{}) {
+			matched = result
+			extracted = extractor
+			responses = rsp
+		}))
+		n, err := tpl.ExecWithUrl(target, config, lowhttp.WithDNSServers([]string{server}), lowhttp.WithTimeoutFloat(3))
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		return matched, extracted, responses
+	}
+
+	matched, extracted, responses := check("http://takeover.yaklang.io")
+	require.True(t, matched)
+	require.True(t, recursion)
+	require.Equal(t, server, responses[0].RemoteAddr)
+	require.Equal(t, "yakit-demo.azurewebsites.net.", toString(extracted["data"]))
+
+	matched, _, _ = check("http://safe.yaklang.io")
+	require.False(t, matched)
+}
@@ -32,7 +32,10 @@ import (
 	"github.com/yaklang/yaklang/common/yak/yaklang"
 	"github.com/yaklang/yaklang/common/yak/yaklang/lib/builtin"
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+	"github.com/yaklang/yaklang/common/yserx/dubbo"
+	"github.com/yaklang/yaklang/common/yserx/hessian"
 	"github.com/yaklang/yaklang/common/yso"
+	"github.com/yaklang/yaklang/common/yso/php"
 )
 
 var (
@@ -539,6 +542,38 @@ var nucleiDSLFunctions = map[string]interface{}{
 		ret = gadgetEncodingHelper(buf, encoding)
 		return
 	},
+	"generate_php_gadget": func(gadget, function, parameter, encoding string) string {
+		buf, err := php.GenerateGadgetBytes(gadget, function, parameter)
+		if err != nil {
+			log.Error(err)
+			return ""
+		}
+		return gadgetEncodingHelper(buf, encoding)
+	},
+	"generate_phar": func(gadget, function, parameter, encoding string) string {
+		buf, err := php.GeneratePharGadget(gadget, []string{function, parameter})
+		if err != nil {
+			log.Error(err)
+			return ""
+		}
+		return gadgetEncodingHelper(buf, encoding)
+	},
+	"generate_hessian_gadget": func(gadget, args, encoding string) string {
+		buf, err := hessian.GenerateGadgetBytes(gadget, strings.Split(args, "|")...)
+		if err != nil {
+			log.Error(err)
+			return ""
+		}
+		return gadgetEncodingHelper(buf, encoding)
+	},
+	"generate_dubbo_gadget": func(path, method, gadget, args, encoding string) string {
+		buf, err := dubbo.GenerateGadgetRequest(path, method, gadget, strings.Split(args, "|")...)
+		if err != nil {
+			log.Error(err)
+			return ""
+		}
+		return gadgetEncodingHelper(buf, encoding)
+	},
 	"unix_time": func(offset ...int64) int64 {
 		var offsetInt int64 = 0
 		if len(offset) > 0 {
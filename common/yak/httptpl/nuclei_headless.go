@@ -0,0 +1,84 @@
+package httptpl
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// https://docs.projectdiscovery.io/templates/protocols/headless
+//
+// headless:
+//   - steps:
+//       - action: navigate
+//         args:
+//           url: "{{BaseURL}}"
+//       - action: waitload
+//       - action: script
+//         name: title
+//         args:
+//           code: document.title
+//     matchers:
+//       - type: word
+//         part: title
+//         words:
+//           - "admin"
+
+func parseHeadlessBulk(ret []*yaml.Node) ([]*YakHeadlessBulkConfig, error) {
+	var confs []*YakHeadlessBulkConfig
+	for _, node := range ret {
+		stepsNode := nodeGetRaw(node, "steps")
+		if stepsNode == nil || stepsNode.Kind != yaml.SequenceNode {
+			log.Warn("headless steps is not slice")
+			continue
+		}
+		headless := &YakHeadlessBulkConfig{}
+		err := sequenceNodeForEach(stepsNode, func(stepNode *yaml.Node) error {
+			action := &YakHeadlessAction{
+				Name:   nodeGetString(stepNode, "name"),
+				Action: strings.ToLower(strings.TrimSpace(nodeGetString(stepNode, "action"))),
+				Args:   make(map[string]string),
+			}
+			if action.Action == "" {
+				return utils.Error("headless step action is empty")
+			}
+			if argsNode := nodeGetRaw(stepNode, "args"); argsNode != nil {
+				mappingNodeForEach(argsNode, func(key string, value *yaml.Node) error {
+					action.Args[strings.ToLower(key)] = value.Value
+					return nil
+				})
+			}
+			headless.Steps = append(headless.Steps, action)
+			return nil
+		})
+		if err != nil {
+			log.Warnf("parse headless steps failed: %s", err)
+			continue
+		}
+
+		if nodeGetRaw(node, "matchers") != nil {
+			matcher, err := generateYakMatcher(node)
+			if err != nil {
+				log.Warnf("build matcher failed: %s", err)
+				continue
+			}
+			headless.Matcher = matcher
+		}
+		extractors, err := generateYakExtractors(node)
+		if err != nil {
+			log.Warnf("build extractor failed: %s", err)
+		}
+		headless.Extractor = extractors
+		if len(headless.Extractor) <= 0 && headless.Matcher == nil {
+			log.Warn("no matcher and extractor found")
+			continue
+		}
+		confs = append(confs, headless)
+	}
+	if len(confs) <= 0 {
+		return nil, utils.Error("empty headless bulk config")
+	}
+	return confs, nil
+}
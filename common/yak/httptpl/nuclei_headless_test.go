@@ -0,0 +1,125 @@
+package httptpl
+
+import (
+	"testing"
+
+	"github.com/go-rod/rod/lib/launcher"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+var headlessDomXSSDemo = `id: headless-dom-xss
+info:
+  name: Headless DOM XSS
+  author: v1ll4n
+  severity: high
+
+headless:
+  - steps:
+      - action: script
+        args:
+          hook: true
+          code: |
+            (function() { window.alerts = []; window.alert = function(msg) { window.alerts.push(msg) } })()
+      - action: navigate
+        args:
+          url: "{{BaseURL}}/#<img src=x onerror=alert('yakit-xss')>"
+      - action: waitload
+      - action: script
+        name: alerts
+        args:
+          code: window.alerts.join(",")
+      - action: extract
+        name: title
+        args:
+          by: x
+          xpath: /html/head/title
+    matchers-condition: and
+    matchers:
+      - type: word
+        part: alerts
+        words:
+          - "yakit-xss"
+      - type: word
+        part: body
+        words:
+          - "<img"
+    extractors:
+      - type: regex
+        name: page_title
+        part: title
+        regex:
+          - "[a-z]+"
+`
+
+func TestCreateYakTemplateFromNucleiTemplateRaw_Headless(t *testing.T) {
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(headlessDomXSSDemo)
+	require.NoError(t, err)
+	require.Len(t, tpl.HeadlessRequestSequences, 1)
+
+	bulk := tpl.HeadlessRequestSequences[0]
+	require.Len(t, bulk.Steps, 5)
+	require.Equal(t, "script", bulk.Steps[0].Action)
+	require.Equal(t, "true", bulk.Steps[0].Args["hook"])
+	require.Equal(t, "{{BaseURL}}/#<img src=x onerror=alert('yakit-xss')>", bulk.Steps[1].Args["url"])
+	require.Equal(t, "alerts", bulk.Steps[3].Name)
+	require.Equal(t, "/html/head/title", bulk.Steps[4].Args["xpath"])
+	require.Len(t, bulk.Matcher.SubMatchers, 2)
+	require.Equal(t, "alerts", bulk.Matcher.SubMatchers[0].Scope)
+	require.Len(t, bulk.Extractor, 1)
+}
+
+func TestYakMatcher_PartFromVars(t *testing.T) {
+	matcher := &YakMatcher{
+		MatcherType: "word",
+		Scope:       "alerts",
+		Group:       []string{"yakit-xss"},
+	}
+	packet := []byte("HTTP/1.1 200 OK\r\n\r\nnothing")
+	ok, err := matcher.ExecuteRaw(packet, map[string]any{"alerts": "yakit-xss"})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = matcher.ExecuteRaw(packet, map[string]any{"alerts": ""})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// fallback to raw packet without the variable
+	ok, err = (&YakMatcher{MatcherType: "word", Scope: "alerts", Group: []string{"nothing"}}).ExecuteRaw(packet, nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestMockTest_Headless(t *testing.T) {
+	path, found := launcher.LookPath()
+	if !found || utils.InGithubActions() {
+		t.Skip("no local browser found")
+	}
+
+	server, port := utils.DebugMockHTTP([]byte("HTTP/1.1 200 OK\r\nContent-Type: text/html\r\n\r\n" +
+		`<html><head><title>yakit</title></head><body><div id="x"></div>` +
+		`<script>document.getElementById("x").innerHTML = decodeURIComponent(location.hash.slice(1))</script></body></html>`))
+
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(headlessDomXSSDemo)
+	require.NoError(t, err)
+
+	var (
+		matched   bool
+		responses []*lowhttp.LowhttpResponse
+		extracted map[string]any
+	)
+	config := NewConfig(
+		WithBrowserExePath(path),
+		WithResultCallback(func(y *YakTemplate, reqBulk *YakRequestBulkConfig, rsp []*lowhttp.LowhttpResponse, result bool, extractor map[string]interface{}) {
+			matched = result
+			responses = rsp
+			extracted = extractor
+		}),
+	)
+	_, err = tpl.ExecWithUrl("http://"+utils.HostPort(server, port), config)
+	require.NoError(t, err)
+	require.True(t, matched)
+	require.NotEmpty(t, responses)
+	require.Contains(t, toString(extracted["page_title"]), "yakit")
+}
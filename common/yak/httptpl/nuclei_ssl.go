@@ -0,0 +1,66 @@
+package httptpl
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// https://docs.projectdiscovery.io/templates/protocols/ssl
+//
+// ssl:
+//   - address: "{{Host}}:{{Port}}"
+//     min_version: tls10
+//     max_version: tls11
+//     matchers:
+//       - type: dsl
+//         dsl:
+//           - "expired == true"
+
+func parseSSLBulk(ret []*yaml.Node) ([]*YakSSLBulkConfig, error) {
+	var confs []*YakSSLBulkConfig
+	for _, node := range ret {
+		conf := &YakSSLBulkConfig{
+			Address:      nodeGetString(node, "address"),
+			MinVersion:   strings.ToLower(strings.TrimSpace(nodeGetFirstString(node, "min_version", "min-version"))),
+			MaxVersion:   strings.ToLower(strings.TrimSpace(nodeGetFirstString(node, "max_version", "max-version"))),
+			CipherSuites: nodeToStringSlice(nodeGetFirstRaw(node, "cipher_suites", "cipher-suites")),
+		}
+		if conf.Address == "" {
+			conf.Address = "{{Host}}:{{Port}}"
+		}
+		if _, ok := sslVersions[conf.MinVersion]; !ok {
+			log.Warnf("ssl min version %v is not supported", conf.MinVersion)
+			continue
+		}
+		if _, ok := sslVersions[conf.MaxVersion]; !ok {
+			log.Warnf("ssl max version %v is not supported", conf.MaxVersion)
+			continue
+		}
+
+		if nodeGetRaw(node, "matchers") != nil {
+			matcher, err := generateYakMatcher(node)
+			if err != nil {
+				log.Warnf("build matcher failed: %s", err)
+				continue
+			}
+			conf.Matcher = matcher
+		}
+		extractors, err := generateYakExtractors(node)
+		if err != nil {
+			log.Warnf("build extractor failed: %s", err)
+		}
+		conf.Extractor = extractors
+		if len(conf.Extractor) <= 0 && conf.Matcher == nil {
+			log.Warn("no matcher and extractor found")
+			continue
+		}
+		confs = append(confs, conf)
+	}
+	if len(confs) <= 0 {
+		return nil, utils.Error("empty ssl bulk config")
+	}
+	return confs, nil
+}
@@ -0,0 +1,152 @@
+package httptpl
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var sslExpiredDemo = `id: expired-ssl
+info:
+  name: Expired SSL Certificate
+  author: pdteam
+  severity: low
+
+ssl:
+  - address: "{{Host}}:{{Port}}"
+    max_version: tls12
+    matchers-condition: and
+    matchers:
+      - type: dsl
+        dsl:
+          - "expired == true"
+          - "self_signed == true"
+          - "tls_version == 'tls12'"
+        condition: and
+      - type: word
+        part: subject_cn
+        words:
+          - "expired.yaklang.io"
+    extractors:
+      - type: json
+        name: cipher
+        json:
+          - ".cipher"
+`
+
+var sslWeakCipherDemo = `id: weak-cipher-suites
+info:
+  name: Weak Cipher Suites
+  author: pussycat0x
+  severity: low
+
+ssl:
+  - address: "{{Host}}:{{Port}}"
+    min_version: tls10
+    max_version: tls12
+    cipher_suites:
+      - TLS_RSA_WITH_RC4_128_SHA
+    matchers:
+      - type: dsl
+        dsl:
+          - "probe_status == true"
+`
+
+// mockTLSServer serves an expired self-signed certificate, only tls12 with aes gcm is accepted
+func mockTLSServer(t *testing.T) string {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(0x1337),
+		Subject:               pkix.Name{CommonName: "expired.yaklang.io", Organization: []string{"yaklang"}},
+		NotBefore:             time.Now().Add(-48 * time.Hour),
+		NotAfter:              time.Now().Add(-24 * time.Hour),
+		DNSNames:              []string{"expired.yaklang.io", "*.yaklang.io"},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &priv.PublicKey, priv)
+	require.NoError(t, err)
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: priv}},
+		MinVersion:   tls.VersionTLS12,
+		MaxVersion:   tls.VersionTLS12,
+		CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		lis.Close()
+	})
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				conn.(*tls.Conn).Handshake()
+			}(conn)
+		}
+	}()
+	return lis.Addr().String()
+}
+
+func TestCreateYakTemplateFromNucleiTemplateRaw_SSL(t *testing.T) {
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(sslWeakCipherDemo)
+	require.NoError(t, err)
+	require.Len(t, tpl.SSLRequestSequences, 1)
+
+	bulk := tpl.SSLRequestSequences[0]
+	require.Equal(t, "tls10", bulk.MinVersion)
+	require.Equal(t, "tls12", bulk.MaxVersion)
+	require.Equal(t, []uint16{tls.TLS_RSA_WITH_RC4_128_SHA}, sslCipherSuiteIDs(bulk.CipherSuites))
+}
+
+func TestMockTest_SSL(t *testing.T) {
+	addr := mockTLSServer(t)
+	target := "https://" + addr
+
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(sslExpiredDemo)
+	require.NoError(t, err)
+	var (
+		matched   bool
+		extracted map[string]any
+		responses []*NucleiTcpResponse
+	)
+	config := NewConfig(WithTCPResultCallback(func(y *YakTemplate, bulk *YakNetworkBulkConfig, rsp []*NucleiTcpResponse, result bool, extractor map[string]interface{}) {
+		matched = result
+		extracted = extractor
+		responses = rsp
+	}))
+	n, err := tpl.ExecWithUrl(target, config)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.True(t, matched)
+	require.Equal(t, addr, responses[0].RemoteAddr)
+	require.Contains(t, utils.InterfaceToString(extracted["cipher"]), "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256")
+
+	// the server refuses rc4, handshake failed means no result
+	tpl, err = CreateYakTemplateFromNucleiTemplateRaw(sslWeakCipherDemo)
+	require.NoError(t, err)
+	called := false
+	config = NewConfig(WithTCPResultCallback(func(y *YakTemplate, bulk *YakNetworkBulkConfig, rsp []*NucleiTcpResponse, result bool, extractor map[string]interface{}) {
+		called = true
+	}))
+	n, err = tpl.ExecWithUrl(target, config)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	require.False(t, called)
+}
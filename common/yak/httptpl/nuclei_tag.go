@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/yaklang/yaklang/common/fuzztagx/parser"
+	"github.com/yaklang/yaklang/common/log"
 )
 
 type NucleiTag struct {
@@ -63,6 +64,19 @@ func QuickFuzzNucleiTag(raw string, vars map[string]any) (result string, err err
 	return string(res.GetData()), nil
 }
 
+// renderNucleiString 渲染 headless / dns / ssl 等参数中的变量，失败时返回原字符串
+func renderNucleiString(raw string, vars map[string]any) string {
+	if !strings.Contains(raw, "{{") {
+		return raw
+	}
+	results, err := FuzzNucleiTag(raw, vars, nil, "")
+	if err != nil || len(results) == 0 {
+		log.Warnf("render nuclei string %v failed: %v", raw, err)
+		return raw
+	}
+	return string(results[0])
+}
+
 // FuzzNucleiTag 使用payload对包含tag的字符串进行fuzz
 func FuzzNucleiTag(raw string, vars map[string]any, payload map[string][]string, mode string) (result [][]byte, err error) {
 	defer func() {
@@ -0,0 +1,381 @@
+package httptpl
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bizhelper"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"gopkg.in/yaml.v3"
+)
+
+// https://docs.projectdiscovery.io/templates/workflows/overview
+//
+// workflows:
+//   - template: http/technologies/tech-detect.yaml
+//     matchers:
+//       - name: wordpress
+//         subtemplates:
+//           - tags: wordpress
+//   - template: http/technologies/jira-detect.yaml
+//     subtemplates:
+//       - tags: jira
+
+// YakWorkflow is a step of nuclei workflows, the templates of the step are referenced
+// by path or tags, the subtemplates run when the templates matched
+type YakWorkflow struct {
+	Template     string
+	Tags         []string
+	Matchers     []*YakWorkflowMatcher
+	Subtemplates []*YakWorkflow
+}
+
+// YakWorkflowMatcher runs the subtemplates when the named matchers of the step template matched
+type YakWorkflowMatcher struct {
+	Name []string
+	// or
+	// and
+	Condition    string
+	Subtemplates []*YakWorkflow
+}
+
+// WorkflowTemplateLoader resolves the templates referenced by a workflow step
+type WorkflowTemplateLoader func(template string, tags []string) ([]*YakTemplate, error)
+
+func WithWorkflowTemplateLoader(loader WorkflowTemplateLoader) ConfigOption {
+	return func(config *Config) {
+		config.WorkflowTemplateLoader = loader
+	}
+}
+
+func generateYakWorkflows(node *yaml.Node) ([]*YakWorkflow, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.Kind != yaml.SequenceNode {
+		return nil, utils.Error("nuclei template workflows is not slice")
+	}
+	var workflows []*YakWorkflow
+	err := sequenceNodeForEach(node, func(stepNode *yaml.Node) error {
+		step := &YakWorkflow{
+			Template: nodeGetString(stepNode, "template"),
+			Tags:     utils.PrettifyListFromStringSplitEx(strings.Join(nodeGetStringSliceFallback(stepNode, "tags"), ","), ","),
+		}
+		if step.Template == "" && len(step.Tags) == 0 {
+			return utils.Error("nuclei workflow step need template or tags")
+		}
+
+		var err error
+		step.Subtemplates, err = generateYakWorkflows(nodeGetRaw(stepNode, "subtemplates"))
+		if err != nil {
+			return err
+		}
+
+		matchersNode := nodeGetRaw(stepNode, "matchers")
+		if matchersNode == nil {
+			workflows = append(workflows, step)
+			return nil
+		}
+		err = sequenceNodeForEach(matchersNode, func(matcherNode *yaml.Node) error {
+			matcher := &YakWorkflowMatcher{
+				Name:      nodeGetStringSliceFallback(matcherNode, "name"),
+				Condition: nodeGetString(matcherNode, "condition"),
+			}
+			if len(matcher.Name) == 0 {
+				return utils.Error("nuclei workflow matcher need name")
+			}
+			var err error
+			matcher.Subtemplates, err = generateYakWorkflows(nodeGetRaw(matcherNode, "subtemplates"))
+			if err != nil {
+				return err
+			}
+			step.Matchers = append(step.Matchers, matcher)
+			return nil
+		})
+		if err != nil {
+			return utils.Errorf("parse nuclei workflow matchers failed: %v", err)
+		}
+		workflows = append(workflows, step)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(workflows) == 0 {
+		return nil, utils.Error("nuclei template workflows is empty")
+	}
+	return workflows, nil
+}
+
+// LoadWorkflowTemplatesFromDatabase resolves the templates of workflow step from the local nuclei templates,
+// the template path is matched with the local path or the template id, the tags are matched with the template tags
+func LoadWorkflowTemplatesFromDatabase(template string, tags []string) ([]*YakTemplate, error) {
+	db := consts.GetGormProfileDatabase()
+	if db == nil {
+		return nil, utils.Error("cannot load gorm database: empty database")
+	}
+	db = db.Model(&schema.YakScript{}).Where("`type` = 'nuclei'")
+
+	var scripts []*schema.YakScript
+	if template != "" {
+		var script schema.YakScript
+		if ret := db.Where("local_path LIKE ?", "%"+strings.TrimPrefix(path.Clean("/"+template), "/")).First(&script); ret.Error == nil {
+			scripts = append(scripts, &script)
+		} else {
+			id := strings.TrimSuffix(path.Base(template), path.Ext(template))
+			script, err := yakit.GetNucleiYakScriptByName(db, id)
+			if err != nil {
+				return nil, utils.Errorf("cannot find workflow template %v: %v", template, err)
+			}
+			scripts = append(scripts, script)
+		}
+	}
+	if len(tags) > 0 {
+		for script := range yakit.YieldYakScripts(
+			bizhelper.FuzzSearchWithStringArrayOrEx(db, []string{"tags"}, tags, false),
+			context.Background(),
+		) {
+			scripts = append(scripts, script)
+		}
+	}
+
+	var templates []*YakTemplate
+	for _, script := range scripts {
+		tpl, err := CreateYakTemplateFromYakScript(script)
+		if err != nil {
+			log.Warnf("create workflow template %v failed: %v", script.ScriptName, err)
+			continue
+		}
+		if template == "" && !workflowTagsMatched(tpl.Tags, tags) {
+			// fuzz search matched a part of tag
+			continue
+		}
+		templates = append(templates, tpl)
+	}
+	return templates, nil
+}
+
+func workflowTagsMatched(tplTags []string, tags []string) bool {
+	for _, tag := range tags {
+		for _, tplTag := range tplTags {
+			if strings.EqualFold(strings.TrimSpace(tplTag), tag) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type workflowTemplateResult struct {
+	matched bool
+	bulk    *YakRequestBulkConfig
+	// responses are all responses of the template, named matchers are executed on them
+	responses []*lowhttp.LowhttpResponse
+	// matchedResponses are the responses of the matched request bulks
+	matchedResponses []*lowhttp.LowhttpResponse
+	extracted        map[string]any
+}
+
+type workflowExecutor struct {
+	target string
+	config *Config
+	opts   []lowhttp.LowhttpOpt
+	loader WorkflowTemplateLoader
+	count  int64
+
+	// the combined result of all matched chains
+	matched   bool
+	leaf      *YakTemplate
+	bulk      *YakRequestBulkConfig
+	responses []*lowhttp.LowhttpResponse
+	extracted map[string]any
+}
+
+// execWorkflows runs the steps of workflows one by one, the variables extracted by a template
+// are shared with its subtemplates, all matched chains are reported as a single workflow result
+func (y *YakTemplate) execWorkflows(u string, config *Config, opts ...lowhttp.LowhttpOpt) (int, error) {
+	w := &workflowExecutor{
+		target:    u,
+		config:    config,
+		opts:      opts,
+		loader:    config.WorkflowTemplateLoader,
+		extracted: make(map[string]any),
+	}
+	if w.loader == nil {
+		w.loader = LoadWorkflowTemplatesFromDatabase
+	}
+
+	vars := make(map[string]any)
+	if y.Variables != nil {
+		for k, v := range y.Variables.ToMap() {
+			vars[k] = v
+		}
+	}
+	for _, step := range y.Workflows {
+		w.runStep(step, vars, nil)
+	}
+
+	result := *y
+	if w.leaf != nil {
+		if result.Severity == "" {
+			result.Severity = w.leaf.Severity
+		}
+		if result.CVE == "" {
+			result.CVE = w.leaf.CVE
+		}
+	}
+	if w.matched {
+		log.Infof("[%v]-[%v] workflow matched", y.Name, y.Id)
+	}
+	config.ExecuteResultCallback(&result, w.bulk, w.responses, w.matched, w.extracted)
+	return int(w.count), nil
+}
+
+func (w *workflowExecutor) runStep(step *YakWorkflow, vars map[string]any, chain []*lowhttp.LowhttpResponse) {
+	if w.config.Ctx != nil && w.config.Ctx.Err() != nil {
+		return
+	}
+	templates, err := w.loader(step.Template, step.Tags)
+	if err != nil {
+		log.Errorf("load workflow templates failed: %v", err)
+		return
+	}
+	for _, tpl := range templates {
+		if tpl == nil {
+			continue
+		}
+		if len(tpl.Workflows) > 0 {
+			log.Warnf("nested workflow %v is not supported in workflow step", tpl.Name)
+			continue
+		}
+		ret := w.execTemplate(tpl, vars)
+
+		stepVars := make(map[string]any, len(vars)+len(ret.extracted))
+		for k, v := range vars {
+			stepVars[k] = v
+		}
+		for k, v := range ret.extracted {
+			stepVars[k] = v
+		}
+
+		if len(step.Matchers) > 0 {
+			for _, matcher := range step.Matchers {
+				if !ret.matchNames(w.config, tpl, matcher, stepVars) {
+					continue
+				}
+				w.runNext(tpl, ret, matcher.Subtemplates, stepVars, chain)
+			}
+			continue
+		}
+
+		if !ret.matched {
+			continue
+		}
+		w.runNext(tpl, ret, step.Subtemplates, stepVars, chain)
+	}
+}
+
+func (w *workflowExecutor) runNext(tpl *YakTemplate, ret *workflowTemplateResult, subtemplates []*YakWorkflow, vars map[string]any, chain []*lowhttp.LowhttpResponse) {
+	next := make([]*lowhttp.LowhttpResponse, 0, len(chain)+len(ret.matchedResponses))
+	next = append(next, chain...)
+	next = append(next, ret.matchedResponses...)
+	if len(subtemplates) == 0 {
+		// the end of chain
+		w.matched = true
+		if w.leaf == nil {
+			w.leaf = tpl
+		}
+		if w.bulk == nil {
+			w.bulk = ret.bulk
+		}
+		for _, rsp := range next {
+			if !lo.Contains(w.responses, rsp) {
+				w.responses = append(w.responses, rsp)
+			}
+		}
+		for k, v := range vars {
+			w.extracted[k] = v
+		}
+		return
+	}
+	for _, sub := range subtemplates {
+		w.runStep(sub, vars, next)
+	}
+}
+
+func (w *workflowExecutor) execTemplate(tpl *YakTemplate, vars map[string]any) *workflowTemplateResult {
+	if tpl.Variables == nil {
+		tpl.Variables = NewVars()
+	}
+	for k, v := range vars {
+		tpl.Variables.Set(k, v)
+	}
+
+	ret := &workflowTemplateResult{extracted: make(map[string]any)}
+	var mutex sync.Mutex
+	config := *w.config
+	config.Callback = func(y *YakTemplate, reqBulk any, rsp any, result bool, extractor map[string]interface{}) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		rsps, _ := rsp.([]*lowhttp.LowhttpResponse)
+		ret.responses = append(ret.responses, rsps...)
+		for k, v := range extractor {
+			ret.extracted[k] = v
+		}
+		if !result {
+			return
+		}
+		ret.matched = true
+		ret.matchedResponses = append(ret.matchedResponses, rsps...)
+		if bulk, ok := reqBulk.(*YakRequestBulkConfig); ok && ret.bulk == nil {
+			ret.bulk = bulk
+		}
+	}
+	count, err := tpl.ExecWithUrl(w.target, &config, w.opts...)
+	if err != nil {
+		log.Errorf("execute workflow template %v failed: %v", tpl.Name, err)
+	}
+	atomic.AddInt64(&w.count, int64(count))
+	return ret
+}
+
+// matchNames executes the named matchers of template on the responses
+func (r *workflowTemplateResult) matchNames(config *Config, tpl *YakTemplate, matcher *YakWorkflowMatcher, vars map[string]any) bool {
+	matchName := func(name string) bool {
+		for _, seq := range tpl.HTTPRequestSequences {
+			for _, m := range seq.Matcher.namedMatchers(name) {
+				for _, rsp := range r.responses {
+					if ok, _ := m.ExecuteRawWithConfig(config, rsp.RawPacket, vars); ok {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+
+	if strings.TrimSpace(strings.ToLower(matcher.Condition)) == "and" {
+		for _, name := range matcher.Name {
+			if !matchName(name) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, name := range matcher.Name {
+		if matchName(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package httptpl
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+func TestNucleiWorkflow(t *testing.T) {
+	var (
+		mutex sync.Mutex
+		paths []string
+	)
+	server, port := utils.DebugMockHTTPEx(func(req []byte) []byte {
+		path := lowhttp.GetHTTPRequestPath(req)
+		mutex.Lock()
+		paths = append(paths, path)
+		mutex.Unlock()
+		switch path {
+		case "/":
+			return []byte("HTTP/1.1 200 OK\r\nContent-Length: 33\r\n\r\nPowered by WordPress version 5.8")
+		case "/wp-admin/secret?v=5.8":
+			return []byte("HTTP/1.1 200 OK\r\nContent-Length: 4\r\n\r\nVULN")
+		}
+		return []byte("HTTP/1.1 404 Not Found\r\nContent-Length: 0\r\n\r\n")
+	})
+
+	templates := map[string]string{
+		"http/technologies/cms-detect.yaml": `id: cms-detect
+info:
+  name: CMS Detect
+  author: v1ll4n
+  severity: info
+  tags: tech
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/"
+    matchers-condition: or
+    matchers:
+      - type: word
+        name: wordpress
+        words:
+          - "WordPress"
+      - type: word
+        name: joomla
+        words:
+          - "Joomla"
+    extractors:
+      - type: regex
+        name: version
+        group: 1
+        regex:
+          - "version ([0-9.]+)"
+`,
+		"wordpress": `id: wordpress-secret
+info:
+  name: WordPress Secret
+  author: v1ll4n
+  severity: high
+  tags: wordpress
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/wp-admin/secret?v={{version}}"
+    matchers:
+      - type: word
+        words:
+          - "VULN"
+`,
+		"joomla": `id: joomla-secret
+info:
+  name: Joomla Secret
+  author: v1ll4n
+  severity: high
+  tags: joomla
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/joomla"
+    matchers:
+      - type: status
+        status:
+          - 404
+`,
+	}
+	loader := func(template string, tags []string) ([]*YakTemplate, error) {
+		key := template
+		if key == "" {
+			key = tags[0]
+		}
+		tpl, err := CreateYakTemplateFromNucleiTemplateRaw(templates[key])
+		if err != nil {
+			return nil, err
+		}
+		return []*YakTemplate{tpl}, nil
+	}
+
+	workflow, err := CreateYakTemplateFromNucleiTemplateRaw(`id: cms-workflow
+info:
+  name: CMS Workflow
+  author: v1ll4n
+
+workflows:
+  - template: http/technologies/cms-detect.yaml
+    matchers:
+      - name: wordpress
+        subtemplates:
+          - tags: wordpress
+      - name: joomla
+        subtemplates:
+          - tags: joomla
+`)
+	require.NoError(t, err)
+	require.Len(t, workflow.Workflows, 1)
+	require.Len(t, workflow.Workflows[0].Matchers, 2)
+
+	var (
+		callbackCount int
+		matched       bool
+		responses     []*lowhttp.LowhttpResponse
+		result        *YakTemplate
+		extracted     map[string]any
+	)
+	config := NewConfig(
+		WithWorkflowTemplateLoader(loader),
+		WithResultCallback(func(y *YakTemplate, reqBulk *YakRequestBulkConfig, rsp []*lowhttp.LowhttpResponse, r bool, extractor map[string]interface{}) {
+			callbackCount++
+			result = y
+			matched = r
+			responses = rsp
+			extracted = extractor
+		}),
+	)
+	_, err = workflow.ExecWithUrl("http://"+utils.HostPort(server, port), config)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, callbackCount, "workflow should be reported as single result")
+	require.True(t, matched)
+	require.Equal(t, "CMS Workflow", result.Name)
+	require.Equal(t, "high", result.Severity)
+	require.Equal(t, "5.8", extracted["version"])
+	require.Len(t, responses, 2)
+	require.True(t, bytes.Contains(responses[1].RawPacket, []byte("VULN")))
+	require.NotContains(t, paths, "/joomla", "joomla subtemplates should not run")
+}
+
+func TestNucleiWorkflow_Subtemplates_NotMatched(t *testing.T) {
+	server, port := utils.DebugMockHTTP([]byte("HTTP/1.1 200 OK\r\nContent-Length: 5\r\n\r\nhello"))
+
+	templates := map[string]string{
+		"detect.yaml": `id: detect
+info:
+  name: Detect
+  author: v1ll4n
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/"
+    matchers:
+      - type: word
+        words:
+          - "hello"
+`,
+		"vuln.yaml": `id: vuln
+info:
+  name: Vuln
+  author: v1ll4n
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/vuln"
+    matchers:
+      - type: word
+        words:
+          - "VULN"
+`,
+	}
+	executed := make(map[string]bool)
+	loader := func(template string, tags []string) ([]*YakTemplate, error) {
+		executed[template] = true
+		tpl, err := CreateYakTemplateFromNucleiTemplateRaw(templates[template])
+		if err != nil {
+			return nil, err
+		}
+		return []*YakTemplate{tpl}, nil
+	}
+
+	workflow, err := CreateYakTemplateFromNucleiTemplateRaw(`id: detect-workflow
+info:
+  name: Detect Workflow
+  author: v1ll4n
+
+workflows:
+  - template: detect.yaml
+    subtemplates:
+      - template: vuln.yaml
+`)
+	require.NoError(t, err)
+
+	matched := true
+	config := NewConfig(
+		WithWorkflowTemplateLoader(loader),
+		WithResultCallback(func(y *YakTemplate, reqBulk *YakRequestBulkConfig, rsp []*lowhttp.LowhttpResponse, r bool, extractor map[string]interface{}) {
+			matched = r
+		}),
+	)
+	_, err = workflow.ExecWithUrl("http://"+utils.HostPort(server, port), config)
+	require.NoError(t, err)
+	require.True(t, executed["vuln.yaml"], "subtemplates should run after gating template matched")
+	require.False(t, matched)
+}
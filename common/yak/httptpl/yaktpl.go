@@ -63,6 +63,13 @@ type YakTemplate struct {
 
 	TCPRequestSequences  []*YakNetworkBulkConfig
 	HTTPRequestSequences []*YakRequestBulkConfig
+	// HeadlessRequestSequences drive the browser
+	HeadlessRequestSequences []*YakHeadlessBulkConfig
+	// DNSRequestSequences / SSLRequestSequences query dns and handshake tls
+	DNSRequestSequences []*YakDNSBulkConfig
+	SSLRequestSequences []*YakSSLBulkConfig
+	// Workflows run the referenced templates instead of requests
+	Workflows []*YakWorkflow
 
 	// placeHolderMap
 	PlaceHolderMap map[string]string
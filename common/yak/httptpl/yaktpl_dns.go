@@ -0,0 +1,196 @@
+package httptpl
+
+import (
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	utils2 "github.com/yaklang/yaklang/common/yak/httptpl/utils"
+	"golang.org/x/net/publicsuffix"
+)
+
+type YakDNSBulkConfig struct {
+	// Name is the domain to query, default {{FQDN}}
+	Name string
+	// A / AAAA / CNAME / NS / TXT / MX / PTR / SOA / SRV / CAA ...
+	Type string
+	// inet / csnet / chaos / hesiod / none / any
+	Class     string
+	Recursion bool
+	Retries   int
+
+	Matcher   *YakMatcher
+	Extractor []*YakExtractor
+}
+
+var dnsClasses = map[string]uint16{
+	"":       dns.ClassINET,
+	"inet":   dns.ClassINET,
+	"csnet":  dns.ClassCSNET,
+	"chaos":  dns.ClassCHAOS,
+	"hesiod": dns.ClassHESIOD,
+	"none":   dns.ClassNONE,
+	"any":    dns.ClassANY,
+}
+
+func (y *YakTemplate) execDNS(u string, config *Config, opts ...lowhttp.LowhttpOpt) (int, error) {
+	lowhttpConfig := lowhttp.NewLowhttpOption()
+	for _, opt := range opts {
+		opt(lowhttpConfig)
+	}
+
+	count := 0
+	for _, bulk := range y.DNSRequestSequences {
+		urlVars := utils2.ExtractorVarsFromUrl(u)
+		vars := utils.InterfaceToMapInterface(urlVars)
+		utils.MergeToMap(vars, dnsDomainVars(urlVars["Host"]))
+		utils.MergeToMap(vars, y.Variables.ToMap())
+		err := bulk.Execute(config, vars, lowhttpConfig, func(rsp []*NucleiTcpResponse, matched bool, extractorResults map[string]any) {
+			count += len(rsp)
+			if matched {
+				log.Infof("[%v]-[%v] matched", y.Name, y.Id)
+			}
+			config.ExecuteTCPResultCallback(y, nil, rsp, matched, extractorResults)
+		})
+		if err != nil {
+			log.Errorf("[%s] dns execute failed: %s", y.Name, err)
+		}
+	}
+	return count, nil
+}
+
+// dnsDomainVars are the domain variables of dns templates, e.g. for `www.example.co.uk`:
+// FQDN: www.example.co.uk, RDN: example.co.uk, DN: example, TLD: co.uk, SD: www
+func dnsDomainVars(host string) map[string]any {
+	host = strings.TrimSuffix(host, ".")
+	vars := map[string]any{
+		"FQDN": host,
+		"RDN":  host,
+		"DN":   "",
+		"TLD":  "",
+		"SD":   "",
+	}
+	if net.ParseIP(host) != nil {
+		return vars
+	}
+	rdn, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return vars
+	}
+	tld, _ := publicsuffix.PublicSuffix(host)
+	vars["RDN"] = rdn
+	vars["TLD"] = tld
+	vars["DN"] = strings.TrimSuffix(rdn, "."+tld)
+	vars["SD"] = strings.TrimSuffix(strings.TrimSuffix(host, rdn), ".")
+	return vars
+}
+
+// dnsResponseVars are the parts of dns response, matchers and extractors use them by `part`
+func dnsResponseVars(msg *dns.Msg) map[string]any {
+	join := func(rrs []dns.RR) string {
+		var lines []string
+		for _, rr := range rrs {
+			lines = append(lines, rr.String())
+		}
+		return strings.Join(lines, "\n")
+	}
+	var questions []string
+	for _, q := range msg.Question {
+		questions = append(questions, q.String())
+	}
+	return map[string]any{
+		"rcode":    dns.RcodeToString[msg.Rcode],
+		"question": strings.Join(questions, "\n"),
+		"answer":   join(msg.Answer),
+		"ns":       join(msg.Ns),
+		"extra":    join(msg.Extra),
+		"raw":      msg.String(),
+	}
+}
+
+func (y *YakDNSBulkConfig) Execute(
+	config *Config, vars map[string]any, lowhttpConfig *lowhttp.LowhttpExecConfig,
+	callback func(rsp []*NucleiTcpResponse, matched bool, extractorResults map[string]any),
+) (fErr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			fErr = utils.Error(err)
+			utils.PrintCurrentGoroutineRuntimeStack()
+		}
+	}()
+
+	domain := strings.TrimSpace(renderNucleiString(y.Name, vars))
+	qtype := dns.StringToType[y.Type]
+	if qtype == dns.TypePTR && net.ParseIP(domain) != nil {
+		reverse, err := dns.ReverseAddr(domain)
+		if err != nil {
+			return utils.Errorf("build ptr domain for %v failed: %v", domain, err)
+		}
+		domain = reverse
+	}
+
+	retries := y.Retries
+	if retries <= 0 {
+		retries = 1
+	}
+	opts := []netx.DNSOption{
+		netx.WithDNSRetryTimes(retries),
+		netx.WithDNSFallbackTCP(true),
+	}
+	if len(lowhttpConfig.DNSServers) > 0 {
+		opts = append(opts, netx.WithDNSServers(lowhttpConfig.DNSServers...))
+	}
+	if lowhttpConfig.Timeout > 0 {
+		opts = append(opts, netx.WithTimeout(lowhttpConfig.Timeout))
+	}
+	if config.Ctx != nil {
+		opts = append(opts, netx.WithDNSContext(config.Ctx))
+	}
+	msg, server, err := netx.DNSQuery(domain, qtype, dnsClasses[y.Class], y.Recursion, opts...)
+	if err != nil {
+		return err
+	}
+
+	var question []string
+	for _, q := range msg.Question {
+		question = append(question, q.String())
+	}
+	response := &NucleiTcpResponse{
+		RawRequest: []byte(strings.Join(question, "\n")),
+		RawPacket:  []byte(msg.String()),
+		RemoteAddr: server,
+		RuntimeId:  config.RuntimeId,
+	}
+	if config.Debug || config.DebugResponse {
+		log.Infof("dns response from %v:\n%s", server, response.RawPacket)
+	}
+
+	vars = utils.MergeGeneralMap(vars, dnsResponseVars(msg))
+	extractorResults := make(map[string]any)
+	for _, extractor := range y.Extractor {
+		extractorVars, err := extractor.Execute(response.RawPacket, vars)
+		if err != nil {
+			log.Warnf("YakDNSBulkConfig extractor.Execute failed: %s", err)
+		}
+		vars = utils.MergeGeneralMap(vars, extractorVars)
+		for k, v := range extractorVars {
+			if v != nil {
+				extractorResults[k] = v
+			}
+		}
+	}
+
+	matched := false
+	if y.Matcher != nil {
+		matched, err = y.Matcher.ExecuteRawWithConfig(config, response.RawPacket, vars)
+		if err != nil {
+			log.Errorf("YakDNSBulkConfig matcher.ExecuteRaw failed: %s", err)
+		}
+	}
+	callback([]*NucleiTcpResponse{response}, matched, extractorResults)
+	return nil
+}
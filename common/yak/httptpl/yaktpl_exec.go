@@ -134,6 +134,10 @@ func (y *YakTemplate) ExecWithUrl(u string, config *Config, opts ...lowhttp.Lowh
 		config = NewConfig()
 	}
 
+	if len(y.Workflows) > 0 {
+		return y.execWorkflows(u, config, opts...)
+	}
+
 	var count int64 = 0
 	if y.ReverseConnectionNeed {
 		var err error
@@ -267,6 +271,12 @@ func (y *YakTemplate) ExecWithUrl(u string, config *Config, opts ...lowhttp.Lowh
 		}
 		swg.Wait()
 		return int(count), nil
+	} else if len(y.HeadlessRequestSequences) > 0 {
+		return y.execHeadless(u, config, opts...)
+	} else if len(y.DNSRequestSequences) > 0 {
+		return y.execDNS(u, config, opts...)
+	} else if len(y.SSLRequestSequences) > 0 {
+		return y.execSSL(u, config, opts...)
 	} else {
 		return 0, utils.Errorf("[%s] tcp/http is all empty!", y.Name)
 	}
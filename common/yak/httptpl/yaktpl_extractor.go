@@ -76,7 +76,11 @@ func (y *YakExtractor) Execute(rsp []byte, previous ...map[string]any) (map[stri
 		header, _ := lowhttp.SplitHTTPHeadersAndBodyFromPacket(rsp)
 		material = header
 	default:
-		material = string(rsp)
+		if m, ok := partMaterialFromVars(y.Scope, previous...); ok {
+			material = m
+		} else {
+			material = string(rsp)
+		}
 	}
 
 	t := strings.TrimSpace(strings.ToLower(y.Type))
@@ -0,0 +1,341 @@
+package httptpl
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/cdp"
+	"github.com/go-rod/rod/lib/launcher"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/yaklang/yaklang/common/crawlerx"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	utils2 "github.com/yaklang/yaklang/common/yak/httptpl/utils"
+)
+
+type YakHeadlessBulkConfig struct {
+	Steps []*YakHeadlessAction
+
+	Matcher   *YakMatcher
+	Extractor []*YakExtractor
+}
+
+type YakHeadlessAction struct {
+	// the output of step is saved as variable by name, matchers and extractors use it as part
+	Name string
+	// navigate
+	// waitload
+	// click
+	// text
+	// script
+	// screenshot
+	// extract
+	// sleep
+	Action string
+	Args   map[string]string
+}
+
+func newHeadlessBrowser(config *Config) (*rod.Browser, error) {
+	browser := rod.New()
+	if config.BrowserWsAddress != "" {
+		launch, err := launcher.NewManaged(config.BrowserWsAddress)
+		if err != nil {
+			return nil, utils.Errorf("new managed launcher %s error: %s", config.BrowserWsAddress, err)
+		}
+		launch = launch.Set("disable-features", "HttpsUpgrades").NoSandbox(true).Headless(true)
+		serviceURL, header := launch.ClientHeader()
+		client, err := cdp.StartWithURL(context.Background(), serviceURL, header)
+		if err != nil {
+			return nil, utils.Errorf("start cdp client %s error: %s", serviceURL, err)
+		}
+		browser = browser.Client(client)
+	} else {
+		launch := launcher.New()
+		if config.BrowserExePath != "" {
+			launch = launch.Bin(config.BrowserExePath)
+		}
+		launch = launch.Set("disable-features", "HttpsUpgrades").NoSandbox(true).Headless(true).Leakless(false)
+		controlUrl, err := launch.Launch()
+		if err != nil {
+			return nil, utils.Errorf("new launcher launch error: %s", err)
+		}
+		browser = browser.ControlURL(controlUrl)
+	}
+	if err := browser.Connect(); err != nil {
+		return nil, utils.Errorf("browser connect error: %s", err)
+	}
+	_ = browser.IgnoreCertErrors(true)
+	return browser, nil
+}
+
+func (y *YakTemplate) execHeadless(u string, config *Config, opts ...lowhttp.LowhttpOpt) (int, error) {
+	browser, err := newHeadlessBrowser(config)
+	if err != nil {
+		return 0, utils.Errorf("[%s] start headless browser failed: %v", y.Name, err)
+	}
+	defer browser.Close()
+
+	lowhttpConfig := lowhttp.NewLowhttpOption()
+	for _, opt := range opts {
+		opt(lowhttpConfig)
+	}
+	packetOpts := make([]lowhttp.LowhttpOpt, 0, len(opts)+1)
+	packetOpts = append(packetOpts, opts...)
+	packetOpts = append(packetOpts, lowhttp.WithSource(y.Name))
+
+	count := 0
+	for _, bulk := range y.HeadlessRequestSequences {
+		vars := utils.InterfaceToMapInterface(utils2.ExtractorVarsFromUrl(u))
+		utils.MergeToMap(vars, y.Variables.ToMap())
+		err := bulk.Execute(config, browser, vars, lowhttpConfig.Timeout, packetOpts, func(rsp []*lowhttp.LowhttpResponse, matched bool, extractorResults map[string]any) {
+			count += len(rsp)
+			if matched {
+				log.Infof("[%v]-[%v] matched", y.Name, y.Id)
+			}
+			config.ExecuteResultCallback(y, nil, rsp, matched, extractorResults)
+		})
+		if err != nil {
+			log.Errorf("[%s] headless execute failed: %s", y.Name, err)
+		}
+	}
+	return count, nil
+}
+
+// headlessSession records the traffic of page, the browser requests are sent by lowhttp
+type headlessSession struct {
+	page    *rod.Page
+	timeout time.Duration
+
+	mutex    sync.Mutex
+	history  []*lowhttp.LowhttpResponse
+	document *lowhttp.LowhttpResponse
+}
+
+func (y *YakHeadlessBulkConfig) Execute(
+	config *Config, browser *rod.Browser,
+	vars map[string]any, timeout time.Duration, opts []lowhttp.LowhttpOpt,
+	callback func(rsp []*lowhttp.LowhttpResponse, matched bool, extractorResults map[string]any),
+) (fErr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			fErr = utils.Error(err)
+			utils.PrintCurrentGoroutineRuntimeStack()
+		}
+	}()
+
+	page, err := browser.Page(proto.TargetCreateTarget{URL: "about:blank"})
+	if err != nil {
+		return utils.Errorf("create page error: %v", err)
+	}
+	defer page.Close()
+	if config.Ctx != nil {
+		page = page.Context(config.Ctx)
+	}
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	session := &headlessSession{page: page, timeout: timeout}
+	router := crawlerx.NewPageHijackRequests(page)
+	err = router.Add("*", "", session.hijack(opts))
+	if err != nil {
+		return utils.Errorf("create hijack error: %v", err)
+	}
+	go router.Run()
+	defer router.Stop()
+
+	for _, step := range y.Steps {
+		args := make(map[string]string, len(step.Args))
+		for k, v := range step.Args {
+			args[k] = renderNucleiString(v, vars)
+		}
+		output, err := session.do(step, args)
+		if err != nil {
+			return utils.Errorf("headless action %v failed: %v", step.Action, err)
+		}
+		if step.Name != "" {
+			vars[step.Name] = output
+		}
+	}
+
+	html, err := page.HTML()
+	if err != nil {
+		return utils.Errorf("get page html error: %v", err)
+	}
+	pageResponse, history := session.responses(html)
+	var historyRaw bytes.Buffer
+	for _, rsp := range history {
+		historyRaw.Write(rsp.RawRequest)
+		historyRaw.WriteString("\r\n")
+		historyRaw.Write(rsp.RawPacket)
+		historyRaw.WriteString("\r\n")
+	}
+	vars["history"] = historyRaw.String()
+
+	extractorResults := make(map[string]any)
+	for _, extractor := range y.Extractor {
+		extractorVars, err := extractor.Execute(pageResponse.RawPacket, vars)
+		if err != nil {
+			log.Warnf("YakHeadlessBulkConfig extractor.Execute failed: %s", err)
+		}
+		vars = utils.MergeGeneralMap(vars, extractorVars)
+		for k, v := range extractorVars {
+			if v != nil {
+				extractorResults[k] = v
+			}
+		}
+	}
+
+	matched := false
+	if y.Matcher != nil {
+		matched, err = y.Matcher.ExecuteRawWithConfig(config, pageResponse.RawPacket, vars)
+		if err != nil {
+			log.Errorf("YakHeadlessBulkConfig matcher.ExecuteRaw failed: %s", err)
+		}
+	}
+	callback(append([]*lowhttp.LowhttpResponse{pageResponse}, history...), matched, extractorResults)
+	return nil
+}
+
+func (s *headlessSession) hijack(opts []lowhttp.LowhttpOpt) func(*crawlerx.CrawlerHijack) {
+	return func(hijack *crawlerx.CrawlerHijack) {
+		if strings.Contains(hijack.Request.Header("Content-Type"), "multipart/form-data") {
+			hijack.ContinueRequest(&proto.FetchContinueRequest{})
+			return
+		}
+		reqOpts := make([]lowhttp.LowhttpOpt, 0, len(opts)+1)
+		reqOpts = append(reqOpts, opts...)
+		if u := hijack.Request.URL().String(); strings.HasPrefix(u, "https://") || strings.HasPrefix(u, "wss://") {
+			reqOpts = append(reqOpts, lowhttp.WithHttps(true))
+		}
+		rsp, err := hijack.LoadLowhttpResponse(reqOpts, true)
+		if err != nil {
+			if !strings.Contains(err.Error(), "context canceled") {
+				log.Debugf("headless load response error: %s", err)
+			}
+			hijack.Response.SetHeader()
+			hijack.Response.SetBody("")
+			return
+		}
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.history = append(s.history, rsp)
+		if hijack.Request.IsNavigation() {
+			s.document = rsp
+		}
+	}
+}
+
+// responses returns the document response with the rendered dom as body, and the traffic of page
+func (s *headlessSession) responses(html string) (*lowhttp.LowhttpResponse, []*lowhttp.LowhttpResponse) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	history := make([]*lowhttp.LowhttpResponse, len(s.history))
+	copy(history, s.history)
+	if s.document == nil {
+		return &lowhttp.LowhttpResponse{
+			RawPacket: lowhttp.ReplaceHTTPPacketBody([]byte("HTTP/1.1 200 OK\r\nContent-Type: text/html\r\n\r\n"), []byte(html), false),
+		}, history
+	}
+	rsp := *s.document
+	rsp.RawPacket = lowhttp.ReplaceHTTPPacketBody(rsp.RawPacket, []byte(html), false)
+	return &rsp, history
+}
+
+func (s *headlessSession) do(step *YakHeadlessAction, args map[string]string) (string, error) {
+	page := s.page.Timeout(s.timeout)
+	defer page.CancelTimeout()
+
+	switch step.Action {
+	case "navigate":
+		return "", page.Navigate(args["url"])
+	case "waitload":
+		return "", page.WaitLoad()
+	case "click":
+		element, err := headlessElement(page, args)
+		if err != nil {
+			return "", err
+		}
+		return "", element.Click(proto.InputMouseButtonLeft, 1)
+	case "text":
+		element, err := headlessElement(page, args)
+		if err != nil {
+			return "", err
+		}
+		return "", element.Input(args["value"])
+	case "script":
+		if utils.InterfaceToBoolean(args["hook"]) {
+			// hook scripts run before the scripts of page, e.g. override window.alert
+			_, err := page.EvalOnNewDocument(args["code"])
+			return "", err
+		}
+		result, err := page.Eval(`(code) => eval(code)`, args["code"])
+		if err != nil {
+			return "", err
+		}
+		return headlessRemoteObjectString(result), nil
+	case "screenshot":
+		// the screenshot is saved as variable instead of the `to` file
+		bin, err := page.Screenshot(utils.InterfaceToBoolean(args["fullpage"]), nil)
+		if err != nil {
+			return "", err
+		}
+		return "data:image/png;base64," + base64.StdEncoding.EncodeToString(bin), nil
+	case "extract":
+		element, err := headlessElement(page, args)
+		if err != nil {
+			return "", err
+		}
+		if strings.ToLower(args["target"]) == "attribute" {
+			attr, err := element.Attribute(args["attribute"])
+			if err != nil || attr == nil {
+				return "", err
+			}
+			return *attr, nil
+		}
+		return element.Text()
+	case "sleep":
+		select {
+		case <-time.After(time.Duration(atof(args["duration"]) * float64(time.Second))):
+		case <-page.GetContext().Done():
+		}
+		return "", nil
+	default:
+		log.Warnf("headless action %v is not supported, skip it", step.Action)
+		return "", nil
+	}
+}
+
+// headlessElement finds element by xpath(`by: x`) or css selector
+func headlessElement(page *rod.Page, args map[string]string) (*rod.Element, error) {
+	switch by := strings.ToLower(args["by"]); {
+	case args["xpath"] != "" && (by == "" || by == "x" || by == "xpath"):
+		return page.ElementX(args["xpath"])
+	case args["selector"] != "":
+		return page.Element(args["selector"])
+	default:
+		return nil, utils.Errorf("headless element selector is empty: %v", args)
+	}
+}
+
+func headlessRemoteObjectString(obj *proto.RuntimeRemoteObject) string {
+	if obj == nil {
+		return ""
+	}
+	switch obj.Type {
+	case proto.RuntimeRemoteObjectTypeUndefined:
+		return ""
+	case proto.RuntimeRemoteObjectTypeString:
+		return obj.Value.Str()
+	default:
+		return obj.Value.JSON("", "")
+	}
+}
@@ -109,6 +109,40 @@ type YakMatcher struct {
 
 	// record poc name / script name or some verbose
 	TemplateName string
+
+	// matcher name, workflows run subtemplates by it
+	Name string
+}
+
+// namedMatchers returns the matchers with the name, the sub matchers are included
+func (y *YakMatcher) namedMatchers(name string) []*YakMatcher {
+	if y == nil {
+		return nil
+	}
+	var ret []*YakMatcher
+	if y.Name != "" && strings.EqualFold(y.Name, name) {
+		ret = append(ret, y)
+	}
+	for _, sub := range y.SubMatchers {
+		ret = append(ret, sub.namedMatchers(name)...)
+	}
+	return ret
+}
+
+// partMaterialFromVars returns the variable named by scope, the outputs of headless steps
+// are matched by their names
+func partMaterialFromVars(scope string, vars ...map[string]any) (string, bool) {
+	switch strings.ToLower(scope) {
+	case "", SCOPE_STATUS_CODE, "status", SCOPE_HEADER, "all_headers", SCOPE_BODY, SCOPE_RAW,
+		SCOPE_INTERACTSH_PROTOCOL, "oob_protocol", SCOPE_INTERACTSH_REQUEST:
+		return "", false
+	}
+	for i := len(vars) - 1; i >= 0; i-- {
+		if v, ok := vars[i][scope]; ok {
+			return toString(v), true
+		}
+	}
+	return "", false
 }
 
 var matcherResponseCache = utils.NewTTLCache[string](1 * time.Minute)
@@ -202,6 +236,9 @@ func (y *YakMatcher) executeRaw(name string, config *Config, packet []byte, dura
 		if isExpr {
 			return string(packet)
 		}
+		if material, ok := partMaterialFromVars(y.Scope, vars); ok {
+			return material
+		}
 		var material string
 		scope := strings.ToLower(y.Scope)
 		scopeHash := cacheHash(packet, scope)
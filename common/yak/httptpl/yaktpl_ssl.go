@@ -0,0 +1,234 @@
+package httptpl
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	utils2 "github.com/yaklang/yaklang/common/yak/httptpl/utils"
+)
+
+type YakSSLBulkConfig struct {
+	// Address is the target to handshake, default {{Host}}:{{Port}}
+	Address string
+	// sslv3 / tls10 / tls11 / tls12 / tls13
+	MinVersion   string
+	MaxVersion   string
+	CipherSuites []string
+
+	Matcher   *YakMatcher
+	Extractor []*YakExtractor
+}
+
+var sslVersions = map[string]uint16{
+	"":      0,
+	"sslv3": tls.VersionSSL30, // nolint[:staticcheck]
+	"tls10": tls.VersionTLS10,
+	"tls11": tls.VersionTLS11,
+	"tls12": tls.VersionTLS12,
+	"tls13": tls.VersionTLS13,
+}
+
+func sslVersionToString(version uint16) string {
+	for name, v := range sslVersions {
+		if name != "" && v == version {
+			return name
+		}
+	}
+	return ""
+}
+
+func sslCipherSuiteIDs(names []string) []uint16 {
+	var ids []uint16
+	for _, suites := range [][]*tls.CipherSuite{tls.CipherSuites(), tls.InsecureCipherSuites()} {
+		for _, suite := range suites {
+			for _, name := range names {
+				if strings.EqualFold(strings.TrimSpace(name), suite.Name) {
+					ids = append(ids, suite.ID)
+				}
+			}
+		}
+	}
+	return ids
+}
+
+func (y *YakTemplate) execSSL(u string, config *Config, opts ...lowhttp.LowhttpOpt) (int, error) {
+	lowhttpConfig := lowhttp.NewLowhttpOption()
+	for _, opt := range opts {
+		opt(lowhttpConfig)
+	}
+
+	count := 0
+	for _, bulk := range y.SSLRequestSequences {
+		vars := utils.InterfaceToMapInterface(utils2.ExtractorVarsFromUrl(u))
+		utils.MergeToMap(vars, y.Variables.ToMap())
+		err := bulk.Execute(config, vars, lowhttpConfig, func(rsp []*NucleiTcpResponse, matched bool, extractorResults map[string]any) {
+			count += len(rsp)
+			if matched {
+				log.Infof("[%v]-[%v] matched", y.Name, y.Id)
+			}
+			config.ExecuteTCPResultCallback(y, nil, rsp, matched, extractorResults)
+		})
+		if err != nil {
+			log.Errorf("[%s] ssl execute failed: %s", y.Name, err)
+		}
+	}
+	return count, nil
+}
+
+// sslResponseVars are the fields of the handshake, the same as the json response of nuclei ssl protocol
+func sslResponseVars(host, port string, result *netx.TLSInspectResult, cert *x509.Certificate) map[string]any {
+	fingerprint := func(sum []byte) string {
+		return hex.EncodeToString(sum)
+	}
+	md5Sum := md5.Sum(cert.Raw)
+	sha1Sum := sha1.Sum(cert.Raw)
+	sha256Sum := sha256.Sum256(cert.Raw)
+
+	selfSigned := bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
+	wildcard := strings.HasPrefix(cert.Subject.CommonName, "*.")
+	for _, name := range cert.DNSNames {
+		if strings.HasPrefix(name, "*.") {
+			wildcard = true
+		}
+	}
+	mismatched := false
+	if net.ParseIP(host) == nil {
+		mismatched = cert.VerifyHostname(host) != nil
+	}
+	now := time.Now()
+
+	return map[string]any{
+		"host":                 host,
+		"port":                 port,
+		"probe_status":         true,
+		"tls_connection":       "ctls",
+		"tls_version":          sslVersionToString(result.Version),
+		"cipher":               tls.CipherSuiteName(result.CipherSuite),
+		"not_before":           cert.NotBefore.UTC().Format(time.RFC3339),
+		"not_after":            cert.NotAfter.UTC().Format(time.RFC3339),
+		"expired":              now.After(cert.NotAfter),
+		"self_signed":          selfSigned,
+		"mismatched":           mismatched,
+		"wildcard_certificate": wildcard,
+		"subject_cn":           cert.Subject.CommonName,
+		"subject_dn":           cert.Subject.String(),
+		"subject_org":          cert.Subject.Organization,
+		"subject_an":           cert.DNSNames,
+		"issuer_cn":            cert.Issuer.CommonName,
+		"issuer_dn":            cert.Issuer.String(),
+		"issuer_org":           cert.Issuer.Organization,
+		"serial":               strings.ToUpper(cert.SerialNumber.Text(16)),
+		"fingerprint_hash": map[string]any{
+			"md5":    fingerprint(md5Sum[:]),
+			"sha1":   fingerprint(sha1Sum[:]),
+			"sha256": fingerprint(sha256Sum[:]),
+		},
+	}
+}
+
+func (y *YakSSLBulkConfig) Execute(
+	config *Config, vars map[string]any, lowhttpConfig *lowhttp.LowhttpExecConfig,
+	callback func(rsp []*NucleiTcpResponse, matched bool, extractorResults map[string]any),
+) (fErr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			fErr = utils.Error(err)
+			utils.PrintCurrentGoroutineRuntimeStack()
+		}
+	}()
+
+	address := strings.TrimSpace(renderNucleiString(y.Address, vars))
+	host, port, err := utils.ParseStringToHostPort(address)
+	if err != nil {
+		return utils.Errorf("parse ssl address %v failed: %v", address, err)
+	}
+	address = utils.HostPort(host, port)
+
+	timeout := lowhttpConfig.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	ctx := config.Ctx
+	if ctx == nil {
+		ctx = utils.TimeoutContext(timeout)
+	}
+	minVersion, maxVersion := sslVersions[y.MinVersion], sslVersions[y.MaxVersion]
+	cipherSuites := sslCipherSuiteIDs(y.CipherSuites)
+	results, err := netx.TLSInspectContextWithConfig(ctx, address, func(tlsConfig *tls.Config) {
+		if minVersion > 0 {
+			tlsConfig.MinVersion = minVersion
+		}
+		if maxVersion > 0 {
+			tlsConfig.MaxVersion = maxVersion
+		}
+		if len(cipherSuites) > 0 {
+			tlsConfig.CipherSuites = cipherSuites
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if len(results) <= 0 {
+		// handshake failed, e.g. the versions or cipher suites are not supported by server
+		log.Debugf("ssl handshake with %v failed", address)
+		return nil
+	}
+	cert, err := x509.ParseCertificate(results[0].Raw)
+	if err != nil {
+		return utils.Errorf("parse certificate from %v failed: %v", address, err)
+	}
+
+	sslVars := sslResponseVars(host, utils.InterfaceToString(port), results[0], cert)
+	packet, err := json.Marshal(sslVars)
+	if err != nil {
+		return err
+	}
+	response := &NucleiTcpResponse{
+		RawRequest: []byte(address),
+		RawPacket:  packet,
+		RemoteAddr: address,
+		RuntimeId:  config.RuntimeId,
+	}
+	if config.Debug || config.DebugResponse {
+		log.Infof("ssl response from %v:\n%s", address, response.RawPacket)
+	}
+
+	vars = utils.MergeGeneralMap(vars, sslVars)
+	vars["response"] = string(packet)
+	extractorResults := make(map[string]any)
+	for _, extractor := range y.Extractor {
+		extractorVars, err := extractor.Execute(response.RawPacket, vars)
+		if err != nil {
+			log.Warnf("YakSSLBulkConfig extractor.Execute failed: %s", err)
+		}
+		vars = utils.MergeGeneralMap(vars, extractorVars)
+		for k, v := range extractorVars {
+			if v != nil {
+				extractorResults[k] = v
+			}
+		}
+	}
+
+	matched := false
+	if y.Matcher != nil {
+		matched, err = y.Matcher.ExecuteRawWithConfig(config, response.RawPacket, vars)
+		if err != nil {
+			log.Errorf("YakSSLBulkConfig matcher.ExecuteRaw failed: %s", err)
+		}
+	}
+	callback([]*NucleiTcpResponse{response}, matched, extractorResults)
+	return nil
+}
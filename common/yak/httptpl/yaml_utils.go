@@ -32,6 +32,13 @@ func nodeGetFirstRaw(node *yaml.Node, keys ...string) *yaml.Node {
 	return nil
 }
 
+func nodeGetFirstString(node *yaml.Node, keys ...string) string {
+	if ret := nodeGetFirstRaw(node, keys...); ret != nil {
+		return ret.Value
+	}
+	return ""
+}
+
 func sequenceNodeForEach(node *yaml.Node, fn func(value *yaml.Node) error) error {
 	if node == nil {
 		return utils.Error("node is nil")
@@ -0,0 +1,168 @@
+/*
+ * Python 3 lexer.
+ *
+ * NEWLINE tokens carry the indentation of the following line, PythonLexerBase
+ * (parser/base.go) turns them into NEWLINE / INDENT / DEDENT tokens and drops the
+ * newlines of blank lines and of lines inside brackets.
+ */
+
+lexer grammar PythonLexer;
+
+options {
+    superClass = PythonLexerBase;
+}
+
+tokens {
+    INDENT,
+    DEDENT
+}
+
+// keywords
+FALSE    : 'False';
+NONE     : 'None';
+TRUE     : 'True';
+AND      : 'and';
+AS       : 'as';
+ASSERT   : 'assert';
+ASYNC    : 'async';
+AWAIT    : 'await';
+BREAK    : 'break';
+CLASS    : 'class';
+CONTINUE : 'continue';
+DEF      : 'def';
+DEL      : 'del';
+ELIF     : 'elif';
+ELSE     : 'else';
+EXCEPT   : 'except';
+FINALLY  : 'finally';
+FOR      : 'for';
+FROM     : 'from';
+GLOBAL   : 'global';
+IF       : 'if';
+IMPORT   : 'import';
+IN       : 'in';
+IS       : 'is';
+LAMBDA   : 'lambda';
+NONLOCAL : 'nonlocal';
+NOT      : 'not';
+OR       : 'or';
+PASS     : 'pass';
+RAISE    : 'raise';
+RETURN   : 'return';
+TRY      : 'try';
+WHILE    : 'while';
+WITH     : 'with';
+YIELD    : 'yield';
+
+// soft keywords, they are names everywhere else
+MATCH : 'match';
+CASE  : 'case';
+TYPE  : 'type';
+
+NEWLINE: ('\r'? '\n' | '\r') [ \t\f]*;
+
+NAME: ID_START ID_CONTINUE*;
+
+STRING: STRING_PREFIX? (LONG_STRING | SHORT_STRING);
+
+NUMBER: INTEGER | FLOAT_NUMBER | IMAG_NUMBER;
+
+ELLIPSIS          : '...';
+DOT               : '.';
+STAR              : '*';
+OPEN_PAREN        : '(';
+CLOSE_PAREN       : ')';
+COMMA             : ',';
+COLON             : ':';
+SEMI_COLON        : ';';
+POWER             : '**';
+ASSIGN            : '=';
+OPEN_BRACK        : '[';
+CLOSE_BRACK       : ']';
+OR_OP             : '|';
+XOR               : '^';
+AND_OP            : '&';
+LEFT_SHIFT        : '<<';
+RIGHT_SHIFT       : '>>';
+ADD               : '+';
+MINUS             : '-';
+DIV               : '/';
+MOD               : '%';
+IDIV              : '//';
+NOT_OP            : '~';
+OPEN_BRACE        : '{';
+CLOSE_BRACE       : '}';
+LESS_THAN         : '<';
+GREATER_THAN      : '>';
+EQUALS            : '==';
+GT_EQ             : '>=';
+LT_EQ             : '<=';
+NOT_EQ            : '!=';
+AT                : '@';
+ARROW             : '->';
+WALRUS            : ':=';
+ADD_ASSIGN        : '+=';
+SUB_ASSIGN        : '-=';
+MULT_ASSIGN       : '*=';
+AT_ASSIGN         : '@=';
+DIV_ASSIGN        : '/=';
+MOD_ASSIGN        : '%=';
+AND_ASSIGN        : '&=';
+OR_ASSIGN         : '|=';
+XOR_ASSIGN        : '^=';
+LEFT_SHIFT_ASSIGN : '<<=';
+RIGHT_SHIFT_ASSIGN: '>>=';
+POWER_ASSIGN      : '**=';
+IDIV_ASSIGN       : '//=';
+
+// dropped by PythonLexerBase, a lexer action would make every character of a comment a new DFA state
+SKIP_: SPACES | COMMENT | LINE_JOINING;
+
+UNKNOWN_CHAR: .;
+
+fragment STRING_PREFIX: [rRuUbBfF] | [rR] [bBfF] | [bBfF] [rR];
+
+fragment SHORT_STRING
+    : '\'' (STRING_ESCAPE_SEQ | ~[\\\r\n'])* '\''
+    | '"' (STRING_ESCAPE_SEQ | ~[\\\r\n"])* '"'
+    ;
+
+fragment LONG_STRING: '\'\'\'' LONG_STRING_ITEM*? '\'\'\'' | '"""' LONG_STRING_ITEM*? '"""';
+
+fragment LONG_STRING_ITEM: ~'\\' | STRING_ESCAPE_SEQ;
+
+fragment STRING_ESCAPE_SEQ: '\\' . | '\\' ('\r'? '\n' | '\r');
+
+fragment INTEGER: DEC_INTEGER | BIN_INTEGER | OCT_INTEGER | HEX_INTEGER;
+
+fragment DEC_INTEGER: [1-9] ('_'? DIGIT)* | '0'+ ('_'? '0')*;
+
+fragment BIN_INTEGER: '0' [bB] ('_'? [01])+;
+
+fragment OCT_INTEGER: '0' [oO] ('_'? [0-7])+;
+
+fragment HEX_INTEGER: '0' [xX] ('_'? [0-9a-fA-F])+;
+
+fragment FLOAT_NUMBER: POINT_FLOAT | EXPONENT_FLOAT;
+
+fragment POINT_FLOAT: DIGIT_PART? '.' DIGIT_PART | DIGIT_PART '.';
+
+fragment EXPONENT_FLOAT: (DIGIT_PART | POINT_FLOAT) EXPONENT;
+
+fragment DIGIT_PART: DIGIT ('_'? DIGIT)*;
+
+fragment EXPONENT: [eE] [+-]? DIGIT_PART;
+
+fragment IMAG_NUMBER: (FLOAT_NUMBER | DIGIT_PART) [jJ];
+
+fragment DIGIT: [0-9];
+
+fragment SPACES: [ \t\f]+;
+
+fragment COMMENT: '#' ~[\r\n]*;
+
+fragment LINE_JOINING: '\\' [ \t\f]* ('\r'? '\n' | '\r');
+
+fragment ID_START: [\p{L}\p{Nl}_];
+
+fragment ID_CONTINUE: [\p{L}\p{Nl}\p{Mn}\p{Mc}\p{Nd}\p{Pc}];
@@ -0,0 +1,332 @@
+/*
+ * Python 3 parser, following the structure of the grammar in the Python language
+ * reference (3.12). Assignment targets are separate rules so that `1 = a` or
+ * `f() = a` are syntax errors, like they are for CPython.
+ */
+
+parser grammar PythonParser;
+
+options {
+    tokenVocab = PythonLexer;
+}
+
+file_input: (NEWLINE | stmt)* EOF;
+
+// the expression of an f-string replacement field, wrapped in parentheses
+fstring_expression: '(' (yield_expr | star_expressions) ')' NEWLINE* EOF;
+
+// ============================== statements ==============================
+
+stmt: compound_stmt | simple_stmts;
+
+simple_stmts: simple_stmt (';' simple_stmt)* ';'? NEWLINE;
+
+simple_stmt
+    : assignment
+    | star_expressions
+    | return_stmt
+    | import_stmt
+    | raise_stmt
+    | pass_stmt
+    | del_stmt
+    | yield_stmt
+    | assert_stmt
+    | break_stmt
+    | continue_stmt
+    | global_stmt
+    | nonlocal_stmt
+    | type_alias
+    ;
+
+compound_stmt
+    : function_def
+    | if_stmt
+    | class_def
+    | with_stmt
+    | for_stmt
+    | try_stmt
+    | while_stmt
+    | match_stmt
+    ;
+
+assignment
+    : single_target ':' expression ('=' annotated_rhs)? # annAssign
+    | (star_targets '=')+ annotated_rhs                 # assign
+    | single_target augassign annotated_rhs             # augAssign
+    ;
+
+annotated_rhs: yield_expr | star_expressions;
+
+augassign
+    : '+='
+    | '-='
+    | '*='
+    | '@='
+    | '/='
+    | '%='
+    | '&='
+    | '|='
+    | '^='
+    | '<<='
+    | '>>='
+    | '**='
+    | '//='
+    ;
+
+return_stmt: 'return' star_expressions?;
+
+raise_stmt: 'raise' (expression ('from' expression)?)?;
+
+pass_stmt: 'pass';
+
+break_stmt: 'break';
+
+continue_stmt: 'continue';
+
+global_stmt: 'global' name (',' name)*;
+
+nonlocal_stmt: 'nonlocal' name (',' name)*;
+
+del_stmt: 'del' del_targets;
+
+del_targets: del_target (',' del_target)* ','?;
+
+del_target
+    : t_primary '.' name
+    | t_primary '[' slices ']'
+    | name
+    | '(' del_targets? ')'
+    | '[' del_targets? ']'
+    ;
+
+yield_stmt: yield_expr;
+
+assert_stmt: 'assert' expression (',' expression)?;
+
+type_alias: TYPE name type_params? '=' expression;
+
+import_stmt: import_name | import_from;
+
+import_name: 'import' dotted_as_names;
+
+import_from
+    : 'from' ('.' | '...')* dotted_name 'import' import_from_targets
+    | 'from' ('.' | '...')+ 'import' import_from_targets
+    ;
+
+import_from_targets
+    : '(' import_from_as_names ','? ')'
+    | import_from_as_names
+    | '*'
+    ;
+
+import_from_as_names: import_from_as_name (',' import_from_as_name)*;
+
+import_from_as_name: name ('as' name)?;
+
+dotted_as_names: dotted_as_name (',' dotted_as_name)*;
+
+dotted_as_name: dotted_name ('as' name)?;
+
+dotted_name: name ('.' name)*;
+
+block: NEWLINE INDENT stmt+ DEDENT | simple_stmts;
+
+decorators: ('@' named_expression NEWLINE)+;
+
+class_def: decorators? 'class' name type_params? ('(' arguments? ')')? ':' block;
+
+function_def
+    : decorators? ASYNC? 'def' name type_params? '(' params? ')' ('->' expression)? ':' block
+    ;
+
+params: param_item (',' param_item)* ','?;
+
+param_item
+    : name annotation? default_assignment?
+    | '/'
+    | '*' (name star_annotation?)?
+    | '**' name annotation?
+    ;
+
+annotation: ':' expression;
+
+star_annotation: ':' star_expression;
+
+default_assignment: '=' expression;
+
+if_stmt: 'if' named_expression ':' block elif_stmt* else_block?;
+
+elif_stmt: 'elif' named_expression ':' block;
+
+else_block: 'else' ':' block;
+
+while_stmt: 'while' named_expression ':' block else_block?;
+
+for_stmt: ASYNC? 'for' star_targets 'in' star_expressions ':' block else_block?;
+
+with_stmt
+    : ASYNC? 'with' '(' with_item (',' with_item)* ','? ')' ':' block
+    | ASYNC? 'with' with_item (',' with_item)* ':' block
+    ;
+
+with_item: expression ('as' star_target)?;
+
+try_stmt: 'try' ':' block (except_block+ else_block? finally_block? | finally_block);
+
+except_block: 'except' '*'? (expression ('as' name)?)? ':' block;
+
+finally_block: 'finally' ':' block;
+
+// ============================== match ==============================
+
+match_stmt: MATCH subject_expr ':' NEWLINE INDENT case_block+ DEDENT;
+
+subject_expr: star_named_expression ',' star_named_expressions? | named_expression;
+
+case_block: CASE patterns guard? ':' block;
+
+guard: 'if' named_expression;
+
+patterns: pattern | pattern ',' (pattern (',' pattern)* ','?)?;
+
+pattern: or_pattern ('as' name)?;
+
+or_pattern: closed_pattern ('|' closed_pattern)*;
+
+closed_pattern
+    : literal_pattern                                # literalPattern
+    | name_or_attr '(' pattern_arguments? ')'        # classPattern
+    | name_or_attr                                   # capturePattern
+    | '(' pattern ')'                                # groupPattern
+    | '(' patterns? ')'                              # tuplePattern
+    | '[' patterns? ']'                              # listPattern
+    | '{' (mapping_item (',' mapping_item)* ','?)? '}' # mappingPattern
+    | '*' name                                       # starPattern
+    ;
+
+literal_pattern: '-'? NUMBER (('+' | '-') NUMBER)? | strings | 'None' | 'True' | 'False';
+
+name_or_attr: name ('.' name)*;
+
+mapping_item: (literal_pattern | name_or_attr) ':' pattern | '**' name;
+
+pattern_arguments: pattern_argument (',' pattern_argument)* ','?;
+
+pattern_argument: name '=' pattern | pattern;
+
+// ============================== targets ==============================
+
+star_targets: star_target (',' star_target)* ','?;
+
+star_target: '*'? target_with_star_atom;
+
+target_with_star_atom: t_primary '.' name | t_primary '[' slices ']' | star_atom;
+
+star_atom
+    : name
+    | '(' target_with_star_atom ')'
+    | '(' (star_target (',' star_target)* ','?)? ')'
+    | '[' (star_target (',' star_target)* ','?)? ']'
+    ;
+
+single_target: t_primary '.' name | t_primary '[' slices ']' | name | '(' single_target ')';
+
+// t_primary is the object of an attribute or subscript target
+t_primary: atom trailer*;
+
+// ============================== expressions ==============================
+
+star_expressions: star_expression (',' star_expression)* ','?;
+
+star_expression: '*' bitwise_or | expression;
+
+star_named_expressions: star_named_expression (',' star_named_expression)* ','?;
+
+star_named_expression: '*' bitwise_or | named_expression;
+
+named_expression: name ':=' expression | expression;
+
+expression: disjunction ('if' disjunction 'else' expression)? | lambdef;
+
+yield_expr: 'yield' ('from' expression | star_expressions?);
+
+disjunction: conjunction ('or' conjunction)*;
+
+conjunction: inversion ('and' inversion)*;
+
+inversion: 'not' inversion | comparison;
+
+comparison: bitwise_or (comp_op bitwise_or)*;
+
+comp_op: '==' | '!=' | '<=' | '<' | '>=' | '>' | 'not' 'in' | 'in' | 'is' 'not' | 'is';
+
+bitwise_or
+    : primary                                                 # primaryExpr
+    | <assoc = right> bitwise_or op = '**' bitwise_or         # binaryExpr
+    | op = ('+' | '-' | '~') bitwise_or                       # unaryExpr
+    | bitwise_or op = ('*' | '@' | '/' | '//' | '%') bitwise_or # binaryExpr
+    | bitwise_or op = ('+' | '-') bitwise_or                  # binaryExpr
+    | bitwise_or op = ('<<' | '>>') bitwise_or                # binaryExpr
+    | bitwise_or op = '&' bitwise_or                          # binaryExpr
+    | bitwise_or op = '^' bitwise_or                          # binaryExpr
+    | bitwise_or op = '|' bitwise_or                          # binaryExpr
+    ;
+
+primary: AWAIT? atom trailer*;
+
+trailer: '(' arguments? ')' | '[' slices ']' | '.' name;
+
+slices: slice (',' slice)* ','?;
+
+slice: expression? ':' expression? (':' expression?)? | star_named_expression;
+
+atom
+    : name                                          # nameAtom
+    | 'True'                                        # trueAtom
+    | 'False'                                       # falseAtom
+    | 'None'                                        # noneAtom
+    | strings                                       # stringAtom
+    | NUMBER                                        # numberAtom
+    | '...'                                         # ellipsisAtom
+    | '(' yield_expr ')'                            # yieldAtom
+    | '(' named_expression ')'                      # groupAtom
+    | '(' named_expression comprehension ')'        # generatorAtom
+    | '(' (star_named_expression ',' star_named_expressions?)? ')' # tupleAtom
+    | '[' star_named_expressions? ']'               # listAtom
+    | '[' named_expression comprehension ']'        # listCompAtom
+    | '{' star_named_expressions '}'                # setAtom
+    | '{' named_expression comprehension '}'        # setCompAtom
+    | '{' (dict_item (',' dict_item)* ','?)? '}'    # dictAtom
+    | '{' expression ':' expression comprehension '}' # dictCompAtom
+    ;
+
+strings: STRING+;
+
+dict_item: expression ':' expression | '**' bitwise_or;
+
+comprehension: for_if_clause+;
+
+for_if_clause: ASYNC? 'for' star_targets 'in' disjunction ('if' disjunction)*;
+
+arguments: argument (',' argument)* ','?;
+
+argument
+    : name '=' expression
+    | '*' expression
+    | '**' expression
+    | named_expression comprehension
+    | named_expression
+    ;
+
+lambdef: 'lambda' lambda_params? ':' expression;
+
+lambda_params: lambda_param (',' lambda_param)* ','?;
+
+lambda_param: name default_assignment? | '/' | '*' name? | '**' name;
+
+type_params: '[' type_param (',' type_param)* ','? ']';
+
+type_param: name (':' expression)? | '*' name | '**' name;
+
+name: NAME | MATCH | CASE | TYPE;
@@ -0,0 +1,154 @@
+package pythonparser
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+)
+
+const tabSize = 8
+
+// PythonLexerBase turns the NEWLINE tokens of the lexer into the NEWLINE, INDENT and DEDENT
+// tokens of the python grammar. The newlines of blank lines and of lines inside brackets are
+// dropped, the indentation of a logical line is the whitespace after its NEWLINE token.
+type PythonLexerBase struct {
+	*antlr.BaseLexer
+
+	pending []antlr.Token
+	indents []int
+	opened  int
+	last    antlr.Token
+
+	// partial is set for a lexer over a part of a line, the first token is not indented
+	partial bool
+}
+
+func (l *PythonLexerBase) NextToken() antlr.Token {
+	for len(l.pending) == 0 {
+		l.scan()
+	}
+	token := l.pending[0]
+	l.pending = l.pending[1:]
+	if token.GetChannel() == antlr.TokenDefaultChannel {
+		l.last = token
+	}
+	return token
+}
+
+// SetStartPosition makes the tokens of a lexer over a part of a file report the line and
+// column of that part inside the file.
+func (l *PythonLexerBase) SetStartPosition(line, column int) {
+	if interpreter, ok := l.Interpreter.(*antlr.LexerATNSimulator); ok {
+		interpreter.Line = line
+		interpreter.CharPositionInLine = column
+	}
+	l.partial = column > 0
+}
+
+func (l *PythonLexerBase) scan() {
+	token := l.BaseLexer.NextToken()
+	switch token.GetTokenType() {
+	case PythonLexerSKIP_:
+		return
+	case PythonLexerOPEN_PAREN, PythonLexerOPEN_BRACK, PythonLexerOPEN_BRACE:
+		l.opened++
+	case PythonLexerCLOSE_PAREN, PythonLexerCLOSE_BRACK, PythonLexerCLOSE_BRACE:
+		if l.opened > 0 {
+			l.opened--
+		}
+	case PythonLexerNEWLINE:
+		l.onNewLine(token)
+		return
+	case antlr.TokenEOF:
+		l.onEOF(token)
+		return
+	}
+	if l.last == nil && len(l.pending) == 0 && token.GetColumn() > 0 && !l.partial {
+		l.syntaxError(token, "unexpected indent")
+	}
+	l.pending = append(l.pending, token)
+}
+
+func (l *PythonLexerBase) onNewLine(token antlr.Token) {
+	if l.opened > 0 {
+		// implicit line joining
+		return
+	}
+	switch l.GetInputStream().LA(1) {
+	case '\r', '\n', '#', antlr.TokenEOF:
+		// a blank line or a line with only a comment
+		return
+	}
+	if l.last == nil || l.last.GetTokenType() == PythonLexerNEWLINE {
+		// blank lines at the start of the file
+		return
+	}
+
+	text := token.GetText()
+	newline := strings.TrimRight(text, " \t\f")
+	l.pending = append(l.pending, l.newToken(PythonLexerNEWLINE, newline, token))
+
+	indent := indentation(text[len(newline):])
+	current := l.currentIndent()
+	switch {
+	case indent > current:
+		l.indents = append(l.indents, indent)
+		l.pending = append(l.pending, l.newToken(PythonLexerINDENT, "", token))
+	case indent < current:
+		for len(l.indents) > 0 && l.currentIndent() > indent {
+			l.indents = l.indents[:len(l.indents)-1]
+			l.pending = append(l.pending, l.newToken(PythonLexerDEDENT, "", token))
+		}
+		if l.currentIndent() != indent {
+			l.syntaxError(token, "unindent does not match any outer indentation level")
+		}
+	}
+}
+
+func (l *PythonLexerBase) onEOF(token antlr.Token) {
+	if l.last != nil && l.last.GetTokenType() != PythonLexerNEWLINE {
+		l.pending = append(l.pending, l.newToken(PythonLexerNEWLINE, "", token))
+	}
+	for range l.indents {
+		l.pending = append(l.pending, l.newToken(PythonLexerDEDENT, "", token))
+	}
+	l.indents = nil
+	l.pending = append(l.pending, token)
+}
+
+func (l *PythonLexerBase) currentIndent() int {
+	if len(l.indents) == 0 {
+		return 0
+	}
+	return l.indents[len(l.indents)-1]
+}
+
+// newToken creates a token at the position of the token it is derived from, INDENT and
+// DEDENT tokens are empty so that a block ends at the end of its last line.
+func (l *PythonLexerBase) newToken(ttype int, text string, from antlr.Token) antlr.Token {
+	return l.GetTokenFactory().Create(
+		l.GetTokenSourceCharStreamPair(), ttype, text, antlr.TokenDefaultChannel,
+		from.GetStart(), from.GetStart()+len(text)-1, from.GetLine(), from.GetColumn(),
+	)
+}
+
+func (l *PythonLexerBase) syntaxError(token antlr.Token, msg string) {
+	l.GetErrorListenerDispatch().SyntaxError(l, token, token.GetLine(), token.GetColumn(), msg, nil)
+}
+
+// indentation is the column of the first character after the whitespace, tabs move to the
+// next multiple of eight.
+func indentation(spaces string) int {
+	count := 0
+	for _, c := range spaces {
+		switch c {
+		case '\t':
+			count += tabSize - count%tabSize
+		case '\f':
+			count = 0
+		default:
+			count++
+		}
+	}
+	return count
+}
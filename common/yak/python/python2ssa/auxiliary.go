@@ -0,0 +1,355 @@
+package python2ssa
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+var binOpTbl = map[string]ssa.BinaryOpcode{
+	"+":  ssa.OpAdd,
+	"-":  ssa.OpSub,
+	"*":  ssa.OpMul,
+	"/":  ssa.OpDiv,
+	"//": ssa.OpDiv,
+	"%":  ssa.OpMod,
+	"**": ssa.OpPow,
+	"@":  ssa.OpMul,
+	"&":  ssa.OpAnd,
+	"|":  ssa.OpOr,
+	"^":  ssa.OpXor,
+	"<<": ssa.OpShl,
+	">>": ssa.OpShr,
+}
+
+var compareOpTbl = map[string]ssa.BinaryOpcode{
+	"==":     ssa.OpEq,
+	"!=":     ssa.OpNotEq,
+	"<":      ssa.OpLt,
+	"<=":     ssa.OpLtEq,
+	">":      ssa.OpGt,
+	">=":     ssa.OpGtEq,
+	"is":     ssa.OpEq,
+	"is not": ssa.OpNotEq,
+	"in":     ssa.OpIn,
+}
+
+var unaryOpTbl = map[string]ssa.UnaryOpcode{
+	"not": ssa.OpNot,
+	"-":   ssa.OpNeg,
+	"+":   ssa.OpPlus,
+	"~":   ssa.OpBitwiseNot,
+}
+
+// tokenRange is the source range from the start of one rule to the end of another,
+// `a.b(c)[d]` is built one trailer at a time.
+type tokenRange struct {
+	start, stop antlr.ParserRuleContext
+}
+
+func (r *tokenRange) GetStart() antlr.Token {
+	return r.start.GetStart()
+}
+
+func (r *tokenRange) GetStop() antlr.Token {
+	return r.stop.GetStop()
+}
+
+func (r *tokenRange) GetText() string {
+	return ""
+}
+
+func lastDot(name string) int {
+	return strings.LastIndex(name, ".")
+}
+
+// statements flattens stmt rules into the statements of a python body: compound statements
+// and the simple statements of each line.
+func statements(stmts []pythonparser.IStmtContext) []antlr.ParserRuleContext {
+	var result []antlr.ParserRuleContext
+	for _, raw := range stmts {
+		stmt, _ := raw.(*pythonparser.StmtContext)
+		if stmt == nil {
+			continue
+		}
+		if compound, ok := stmt.Compound_stmt().(*pythonparser.Compound_stmtContext); ok {
+			if inner, ok := compound.GetChild(0).(antlr.ParserRuleContext); ok {
+				result = append(result, inner)
+			}
+			continue
+		}
+		result = append(result, simpleStatements(stmt.Simple_stmts())...)
+	}
+	return result
+}
+
+func simpleStatements(raw pythonparser.ISimple_stmtsContext) []antlr.ParserRuleContext {
+	stmts, _ := raw.(*pythonparser.Simple_stmtsContext)
+	if stmts == nil {
+		return nil
+	}
+	var result []antlr.ParserRuleContext
+	for _, simple := range stmts.AllSimple_stmt() {
+		if inner, ok := simple.GetChild(0).(antlr.ParserRuleContext); ok {
+			result = append(result, inner)
+		}
+	}
+	return result
+}
+
+func blockStatements(raw pythonparser.IBlockContext) []antlr.ParserRuleContext {
+	block, _ := raw.(*pythonparser.BlockContext)
+	if block == nil {
+		return nil
+	}
+	if block.Simple_stmts() != nil {
+		return simpleStatements(block.Simple_stmts())
+	}
+	return statements(block.AllStmt())
+}
+
+func elseStatements(raw pythonparser.IElse_blockContext) []antlr.ParserRuleContext {
+	if raw == nil {
+		return nil
+	}
+	return blockStatements(raw.(*pythonparser.Else_blockContext).Block())
+}
+
+func finallyStatements(raw pythonparser.IFinally_blockContext) []antlr.ParserRuleContext {
+	if raw == nil {
+		return nil
+	}
+	return blockStatements(raw.(*pythonparser.Finally_blockContext).Block())
+}
+
+// targetName returns the name of an assignment target which is a plain name.
+func targetName(tree antlr.Tree) string {
+	switch t := tree.(type) {
+	case *pythonparser.Star_targetsContext:
+		if len(t.AllStar_target()) == 1 && len(t.AllCOMMA()) == 0 {
+			return targetName(t.Star_target(0))
+		}
+	case *pythonparser.Star_targetContext:
+		if t.STAR() == nil {
+			return targetName(t.Target_with_star_atom())
+		}
+	case *pythonparser.Target_with_star_atomContext:
+		return targetName(t.Star_atom())
+	case *pythonparser.Star_atomContext:
+		if t.Name() != nil {
+			return t.Name().GetText()
+		}
+		return targetName(t.Target_with_star_atom())
+	case *pythonparser.Single_targetContext:
+		if t.T_primary() == nil && t.Name() != nil {
+			return t.Name().GetText()
+		}
+		return targetName(t.Single_target())
+	}
+	return ""
+}
+
+// primaryOf unwraps an expression which is a single primary, like `a.b(c)`.
+func primaryOf(tree antlr.Tree) *pythonparser.PrimaryContext {
+	for {
+		switch t := tree.(type) {
+		case *pythonparser.PrimaryContext:
+			return t
+		case antlr.ParserRuleContext:
+			if t.GetChildCount() != 1 {
+				return nil
+			}
+			tree = t.GetChild(0)
+		default:
+			return nil
+		}
+	}
+}
+
+// referenceName is the name a decorator or a base class refers to, the last name of `a.b.c`,
+// calls and subscripts after it are skipped: `@app.route("/")` is `route`, `Generic[T]` is `Generic`.
+func referenceName(tree antlr.Tree) string {
+	primary := primaryOf(tree)
+	if primary == nil {
+		return ""
+	}
+	trailers := primary.AllTrailer()
+	for len(trailers) > 0 {
+		last := trailers[len(trailers)-1].(*pythonparser.TrailerContext)
+		if last.DOT() != nil {
+			return last.Name().GetText()
+		}
+		trailers = trailers[:len(trailers)-1]
+	}
+	if atom, ok := primary.Atom().(*pythonparser.NameAtomContext); ok {
+		return atom.Name().GetText()
+	}
+	return ""
+}
+
+// functionScope keeps the python scope rules of the function being built: a name that is
+// assigned anywhere in a function body is local to the whole function unless it is declared
+// global or nonlocal.
+type functionScope struct {
+	isModule  bool
+	locals    []string
+	globals   map[string]struct{}
+	nonlocals map[string]struct{}
+}
+
+func newModuleScope() *functionScope {
+	return &functionScope{isModule: true}
+}
+
+func newFunctionScope(params []*parameter, body []antlr.ParserRuleContext) *functionScope {
+	scope := &functionScope{
+		globals:   make(map[string]struct{}),
+		nonlocals: make(map[string]struct{}),
+	}
+	excluded := make(map[string]struct{})
+	for _, param := range params {
+		excluded[param.name] = struct{}{}
+	}
+	collector := &bindingCollector{seen: excluded}
+	collector.visitBody(body)
+	for _, name := range collector.globals {
+		scope.globals[name] = struct{}{}
+	}
+	for _, name := range collector.nonlocals {
+		scope.nonlocals[name] = struct{}{}
+	}
+	for _, name := range collector.names {
+		if _, ok := scope.globals[name]; ok {
+			continue
+		}
+		if _, ok := scope.nonlocals[name]; ok {
+			continue
+		}
+		scope.locals = append(scope.locals, name)
+	}
+	return scope
+}
+
+// bindingCollector finds the names bound by the statements of one scope,
+// nested function and class bodies are scopes of their own and are skipped.
+type bindingCollector struct {
+	seen      map[string]struct{}
+	names     []string
+	globals   []string
+	nonlocals []string
+}
+
+func (c *bindingCollector) add(name string) {
+	if name == "" {
+		return
+	}
+	if _, ok := c.seen[name]; ok {
+		return
+	}
+	c.seen[name] = struct{}{}
+	c.names = append(c.names, name)
+}
+
+func (c *bindingCollector) addTarget(target antlr.Tree) {
+	switch t := target.(type) {
+	case *pythonparser.Star_targetsContext:
+		for _, item := range t.AllStar_target() {
+			c.addTarget(item)
+		}
+	case *pythonparser.Star_targetContext:
+		c.addTarget(t.Target_with_star_atom())
+	case *pythonparser.Target_with_star_atomContext:
+		c.addTarget(t.Star_atom())
+	case *pythonparser.Star_atomContext:
+		if t.Name() != nil {
+			c.add(t.Name().GetText())
+			return
+		}
+		c.addTarget(t.Target_with_star_atom())
+		for _, item := range t.AllStar_target() {
+			c.addTarget(item)
+		}
+	case *pythonparser.Single_targetContext:
+		if t.T_primary() == nil && t.Name() != nil {
+			c.add(t.Name().GetText())
+			return
+		}
+		c.addTarget(t.Single_target())
+	}
+}
+
+func (c *bindingCollector) visitBody(body []antlr.ParserRuleContext) {
+	for _, stmt := range body {
+		c.visitStmt(stmt)
+	}
+}
+
+func (c *bindingCollector) visitStmt(stmt antlr.ParserRuleContext) {
+	switch s := stmt.(type) {
+	case *pythonparser.Function_defContext:
+		c.add(s.Name().GetText())
+	case *pythonparser.Class_defContext:
+		c.add(s.Name().GetText())
+	case *pythonparser.AssignContext:
+		for _, target := range s.AllStar_targets() {
+			c.addTarget(target)
+		}
+	case *pythonparser.AugAssignContext:
+		c.addTarget(s.Single_target())
+	case *pythonparser.AnnAssignContext:
+		c.addTarget(s.Single_target())
+	case *pythonparser.For_stmtContext:
+		c.addTarget(s.Star_targets())
+		c.visitBody(blockStatements(s.Block()))
+		c.visitBody(elseStatements(s.Else_block()))
+	case *pythonparser.While_stmtContext:
+		c.visitBody(blockStatements(s.Block()))
+		c.visitBody(elseStatements(s.Else_block()))
+	case *pythonparser.If_stmtContext:
+		c.visitBody(blockStatements(s.Block()))
+		for _, elif := range s.AllElif_stmt() {
+			c.visitBody(blockStatements(elif.(*pythonparser.Elif_stmtContext).Block()))
+		}
+		c.visitBody(elseStatements(s.Else_block()))
+	case *pythonparser.With_stmtContext:
+		for _, raw := range s.AllWith_item() {
+			if item := raw.(*pythonparser.With_itemContext); item.Star_target() != nil {
+				c.addTarget(item.Star_target())
+			}
+		}
+		c.visitBody(blockStatements(s.Block()))
+	case *pythonparser.Match_stmtContext:
+		for _, raw := range s.AllCase_block() {
+			caseBlock := raw.(*pythonparser.Case_blockContext)
+			c.add(caseCapture(caseBlock))
+			c.visitBody(blockStatements(caseBlock.Block()))
+		}
+	case *pythonparser.Try_stmtContext:
+		c.visitBody(blockStatements(s.Block()))
+		for _, raw := range s.AllExcept_block() {
+			handler := raw.(*pythonparser.Except_blockContext)
+			if handler.Name() != nil {
+				c.add(handler.Name().GetText())
+			}
+			c.visitBody(blockStatements(handler.Block()))
+		}
+		c.visitBody(elseStatements(s.Else_block()))
+		c.visitBody(finallyStatements(s.Finally_block()))
+	case *pythonparser.Import_stmtContext:
+		for _, alias := range importAliases(s) {
+			if alias.name != "*" {
+				c.add(alias.boundName())
+			}
+		}
+	case *pythonparser.Global_stmtContext:
+		for _, name := range s.AllName() {
+			c.globals = append(c.globals, name.GetText())
+		}
+	case *pythonparser.Nonlocal_stmtContext:
+		for _, name := range s.AllName() {
+			c.nonlocals = append(c.nonlocals, name.GetText())
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package python2ssa
+
+import (
+	"path/filepath"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/antlr4util"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+type SSABuilder struct {
+	*ssa.PreHandlerInit
+}
+
+// moduleInfo is a python file of the project, the pre-handler records the module names
+// in the LibraryFile of the application, a module is built once in its own library.
+type moduleInfo struct {
+	name      string
+	path      string
+	isPackage bool
+}
+
+// packageName is the package used to resolve relative imports.
+func (m *moduleInfo) packageName() string {
+	if m.isPackage {
+		return m.name
+	}
+	if idx := lastDot(m.name); idx >= 0 {
+		return m.name[:idx]
+	}
+	return ""
+}
+
+type builder struct {
+	*ssa.FunctionBuilder
+	module *moduleInfo
+
+	// scope is the python scope of the function being built
+	scope *functionScope
+}
+
+var Builder ssa.Builder = &SSABuilder{}
+
+func (s *SSABuilder) Build(src string, force bool, b *ssa.FunctionBuilder) error {
+	module, err := Frontend(src, force)
+	if err != nil {
+		return err
+	}
+	b.SupportClosure = true
+	build := &builder{
+		FunctionBuilder: b,
+		scope:           newModuleScope(),
+	}
+	build.VisitFileInput(module)
+	return nil
+}
+
+func (*SSABuilder) FilterFile(path string) bool {
+	return filepath.Ext(path) == ".py"
+}
+
+func (*SSABuilder) GetLanguage() consts.Language {
+	return consts.PYTHON
+}
+
+func Frontend(src string, force bool) (pythonparser.IFile_inputContext, error) {
+	errListener := antlr4util.NewErrorListener()
+	lexer := pythonparser.NewPythonLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	tokenStream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := pythonparser.NewPythonParser(tokenStream)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errListener)
+	parser.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+	ast := parser.File_input()
+	// the replacement fields of f-strings are parsed on their own, check them here so that
+	// a broken field is a syntax error of the file
+	for _, token := range tokenStream.GetAllTokens() {
+		if token.GetTokenType() == pythonparser.PythonLexerSTRING {
+			splitString(token, errListener)
+		}
+	}
+	if force || len(errListener.GetErrors()) == 0 {
+		return ast, nil
+	}
+	return nil, utils.Errorf("parse AST FrontEnd error: %v", errListener.GetErrorString())
+}
+
+func newModuleInfo(name, path string) *moduleInfo {
+	_, filename := filepath.Split(path)
+	return &moduleInfo{
+		name:      name,
+		path:      path,
+		isPackage: filename == "__init__.py",
+	}
+}
+
+func registerModule(app *ssa.Program, name, path string) {
+	if lo.Contains(app.LibraryFile[name], path) {
+		return
+	}
+	app.LibraryFile[name] = append(app.LibraryFile[name], path)
+}
+
+func getModuleByName(app *ssa.Program, name string) *moduleInfo {
+	if name == "" {
+		return nil
+	}
+	files, ok := app.LibraryFile[name]
+	if !ok || len(files) == 0 {
+		return nil
+	}
+	return newModuleInfo(name, files[0])
+}
+
+func getModuleByPath(app *ssa.Program, path string) *moduleInfo {
+	if path == "" {
+		return nil
+	}
+	for name, files := range app.LibraryFile {
+		if len(files) > 0 && files[0] == path {
+			return newModuleInfo(name, path)
+		}
+	}
+	return nil
+}
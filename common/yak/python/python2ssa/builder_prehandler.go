@@ -0,0 +1,80 @@
+package python2ssa
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (s *SSABuilder) Create() ssa.Builder {
+	return &SSABuilder{
+		PreHandlerInit: ssa.NewPreHandlerInit().WithLanguageConfigOpts(
+			ssa.WithLanguageConfigBind(true),
+			ssa.WithLanguageConfigSupportClass(true),
+			ssa.WithLanguageConfigIsSupportClassStaticModifier(true),
+			ssa.WithLanguageConfigVirtualImport(true),
+			ssa.WithLanguageBuilder(s),
+			ssa.WithLanguageConfigTryBuildValue(true),
+		),
+	}
+}
+
+func (*SSABuilder) FilterPreHandlerFile(path string) bool {
+	return filepath.Ext(path) == ".py"
+}
+
+func (*SSABuilder) PreHandlerFile(editor *memedit.MemEditor, builder *ssa.FunctionBuilder) {
+	builder.GetProgram().GetApplication().Build("", editor, builder)
+}
+
+// PreHandlerProject records the module name of every python file, the module name is the
+// dotted path below the top-most directory that is not a package (has no __init__.py).
+func (s *SSABuilder) PreHandlerProject(fileSystem fi.FileSystem, fb *ssa.FunctionBuilder, path string) error {
+	prog := fb.GetProgram()
+	if prog == nil {
+		log.Errorf("program is nil")
+		return nil
+	}
+	file, err := fileSystem.ReadFile(path)
+	if err != nil {
+		log.Errorf("read file %s error: %v", path, err)
+		return nil
+	}
+
+	dir, filename := fileSystem.PathSplit(path)
+	name := strings.TrimSuffix(filename, fileSystem.Ext(filename))
+	var parts []string
+	if name != "__init__" {
+		parts = append(parts, name)
+	}
+	// the __init__.py of the enclosing packages, innermost first
+	var packages []string
+	for dir != "" {
+		initFile := fileSystem.Join(dir, "__init__.py")
+		if exist, _ := fileSystem.Exists(initFile); !exist {
+			break
+		}
+		var pkg string
+		dir, pkg = fileSystem.PathSplit(dir)
+		if pkg == "" {
+			break
+		}
+		parts = append([]string{pkg}, parts...)
+		packages = append(packages, initFile)
+	}
+	// a __init__.py outside of any package can not be imported
+	if len(parts) > 0 {
+		registerModule(prog.GetApplication(), strings.Join(parts, "."), path)
+	}
+	// empty files are not compiled, so an empty __init__.py is registered by the modules of its package
+	for i, initFile := range packages {
+		registerModule(prog.GetApplication(), strings.Join(parts[:len(packages)-i], "."), initFile)
+	}
+
+	prog.Build(path, memedit.NewMemEditor(string(file)), fb)
+	return nil
+}
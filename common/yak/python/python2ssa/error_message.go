@@ -0,0 +1,45 @@
+package python2ssa
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+const TAG ssa.ErrorTag = "Python"
+
+func UnexpectedBinaryOP(op string) string {
+	return fmt.Sprintf("unexpected binary operator: %s", op)
+}
+
+func UnexpectedUnaryOP(op string) string {
+	return fmt.Sprintf("unexpected unary operator: %s", op)
+}
+
+func UnexpectedCompareOP(op string) string {
+	return fmt.Sprintf("unexpected compare operator: %s", op)
+}
+
+func UnexpectedBreakStmt() string {
+	return "'break' outside loop"
+}
+
+func UnexpectedContinueStmt() string {
+	return "'continue' not properly in loop"
+}
+
+func InvalidAssignTarget() string {
+	return "cannot assign to expression"
+}
+
+func InvalidFunctionCallee() string {
+	return "invalid function callee"
+}
+
+func RelativeImportBeyondTopLevel(module string) string {
+	return fmt.Sprintf("attempted relative import beyond top-level package: %s", module)
+}
+
+func NonlocalAtModuleLevel() string {
+	return "nonlocal declaration not allowed at module level"
+}
@@ -0,0 +1,724 @@
+package python2ssa
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/google/uuid"
+	"github.com/yaklang/yaklang/common/utils"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *builder) VisitAnnotatedRhs(raw pythonparser.IAnnotated_rhsContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.Annotated_rhsContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.Yield_expr() != nil {
+		return b.VisitYieldExpr(i.Yield_expr())
+	}
+	return b.VisitStarExpressions(i.Star_expressions())
+}
+
+// VisitStarExpressions builds `a, *b`, more than one item (or a trailing comma) is a tuple.
+func (b *builder) VisitStarExpressions(raw pythonparser.IStar_expressionsContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.Star_expressionsContext)
+	if i == nil {
+		return nil
+	}
+
+	items := i.AllStar_expression()
+	if len(items) == 1 && len(i.AllCOMMA()) == 0 {
+		return b.VisitStarExpression(items[0])
+	}
+	recoverRange := b.SetRange(i)
+	defer recoverRange()
+	values := make([]ssa.Value, 0, len(items))
+	for _, item := range items {
+		if value := b.VisitStarExpression(item); value != nil {
+			values = append(values, value)
+		}
+	}
+	return b.CreateObjectWithSlice(values)
+}
+
+func (b *builder) VisitStarExpression(raw pythonparser.IStar_expressionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.Star_expressionContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.Bitwise_or() != nil {
+		return b.VisitBitwiseOr(i.Bitwise_or())
+	}
+	return b.VisitExpression(i.Expression())
+}
+
+func (b *builder) visitStarNamedExpressions(raw pythonparser.IStar_named_expressionsContext) []ssa.Value {
+	values := make([]ssa.Value, 0)
+	i, _ := raw.(*pythonparser.Star_named_expressionsContext)
+	if i == nil {
+		return values
+	}
+	for _, item := range i.AllStar_named_expression() {
+		if value := b.VisitStarNamedExpression(item); value != nil {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func (b *builder) VisitStarNamedExpression(raw pythonparser.IStar_named_expressionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.Star_named_expressionContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.Bitwise_or() != nil {
+		return b.VisitBitwiseOr(i.Bitwise_or())
+	}
+	return b.VisitNamedExpression(i.Named_expression())
+}
+
+func (b *builder) VisitNamedExpression(raw pythonparser.INamed_expressionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.Named_expressionContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.WALRUS() == nil {
+		return b.VisitExpression(i.Expression())
+	}
+	recoverRange := b.SetRange(i)
+	defer recoverRange()
+	value := b.VisitExpression(i.Expression())
+	b.assignName(i.Name().GetText(), value)
+	return value
+}
+
+func (b *builder) VisitExpression(raw pythonparser.IExpressionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.ExpressionContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.Lambdef() != nil {
+		return b.VisitLambdef(i.Lambdef())
+	}
+	if i.IF() == nil {
+		return b.VisitDisjunction(i.Disjunction(0))
+	}
+	recoverRange := b.SetRange(i)
+	defer recoverRange()
+	return b.visitTernary(i.Disjunction(1), i.Disjunction(0), i.Expression())
+}
+
+// visitTernary merges both branches of `body if test else orElse` into a phi.
+func (b *builder) visitTernary(test, body pythonparser.IDisjunctionContext, orElse pythonparser.IExpressionContext) ssa.Value {
+	id := "ternary_" + uuid.NewString()
+	variable := b.CreateLocalVariable(id)
+	b.AssignVariable(variable, b.EmitValueOnlyDeclare(id))
+
+	ifBuilder := b.CreateIfBuilder()
+	ifBuilder.AppendItem(
+		func() ssa.Value {
+			return b.VisitDisjunction(test)
+		},
+		func() {
+			b.AssignVariable(b.CreateVariable(id), b.VisitDisjunction(body))
+		},
+	)
+	ifBuilder.SetElse(func() {
+		b.AssignVariable(b.CreateVariable(id), b.VisitExpression(orElse))
+	})
+	ifBuilder.Build()
+	return b.ReadValue(id)
+}
+
+func (b *builder) VisitYieldExpr(raw pythonparser.IYield_exprContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*pythonparser.Yield_exprContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.Expression() != nil {
+		b.VisitExpression(i.Expression())
+	}
+	if i.Star_expressions() != nil {
+		b.VisitStarExpressions(i.Star_expressions())
+	}
+	return b.EmitUndefined("yield")
+}
+
+func (b *builder) VisitDisjunction(raw pythonparser.IDisjunctionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.DisjunctionContext)
+	if i == nil {
+		return nil
+	}
+
+	items := i.AllConjunction()
+	if len(items) == 1 {
+		return b.VisitConjunction(items[0])
+	}
+	recoverRange := b.SetRange(i)
+	defer recoverRange()
+	result := b.VisitConjunction(items[0])
+	for _, item := range items[1:] {
+		result = b.EmitBinOp(ssa.OpLogicOr, result, b.VisitConjunction(item))
+	}
+	return result
+}
+
+func (b *builder) VisitConjunction(raw pythonparser.IConjunctionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.ConjunctionContext)
+	if i == nil {
+		return nil
+	}
+
+	items := i.AllInversion()
+	if len(items) == 1 {
+		return b.VisitInversion(items[0])
+	}
+	recoverRange := b.SetRange(i)
+	defer recoverRange()
+	result := b.VisitInversion(items[0])
+	for _, item := range items[1:] {
+		result = b.EmitBinOp(ssa.OpLogicAnd, result, b.VisitInversion(item))
+	}
+	return result
+}
+
+func (b *builder) VisitInversion(raw pythonparser.IInversionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.InversionContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.NOT() == nil {
+		return b.VisitComparison(i.Comparison())
+	}
+	recoverRange := b.SetRange(i)
+	defer recoverRange()
+	return b.EmitUnOp(unaryOpTbl["not"], b.VisitInversion(i.Inversion()))
+}
+
+func compareOp(raw pythonparser.IComp_opContext) string {
+	op := raw.(*pythonparser.Comp_opContext)
+	switch {
+	case op.NOT() != nil && op.IN() != nil:
+		return "not in"
+	case op.IS() != nil && op.NOT() != nil:
+		return "is not"
+	}
+	return op.GetText()
+}
+
+func (b *builder) VisitComparison(raw pythonparser.IComparisonContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.ComparisonContext)
+	if i == nil {
+		return nil
+	}
+
+	operands := i.AllBitwise_or()
+	if len(operands) == 1 {
+		return b.VisitBitwiseOr(operands[0])
+	}
+	recoverRange := b.SetRange(i)
+	defer recoverRange()
+
+	left := b.VisitBitwiseOr(operands[0])
+	var result ssa.Value
+	for index, compOp := range i.AllComp_op() {
+		right := b.VisitBitwiseOr(operands[index+1])
+		var value ssa.Value
+		switch opText := compareOp(compOp); opText {
+		case "not in":
+			value = b.EmitUnOp(ssa.OpNot, b.EmitBinOp(ssa.OpIn, left, right))
+		default:
+			op, ok := compareOpTbl[opText]
+			if !ok {
+				b.NewError(ssa.Error, TAG, UnexpectedCompareOP(opText))
+				return b.EmitUndefined("")
+			}
+			value = b.EmitBinOp(op, left, right)
+		}
+		if result == nil {
+			result = value
+		} else {
+			result = b.EmitBinOp(ssa.OpLogicAnd, result, value)
+		}
+		left = right
+	}
+	return result
+}
+
+func (b *builder) VisitBitwiseOr(raw pythonparser.IBitwise_orContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+
+	switch i := raw.(type) {
+	case *pythonparser.PrimaryExprContext:
+		return b.VisitPrimary(i.Primary())
+	case *pythonparser.BinaryExprContext:
+		recoverRange := b.SetRange(i)
+		defer recoverRange()
+		opText := i.GetOp().GetText()
+		op, ok := binOpTbl[opText]
+		if !ok {
+			b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(opText))
+			return b.EmitUndefined("")
+		}
+		return b.EmitBinOp(op, b.VisitBitwiseOr(i.Bitwise_or(0)), b.VisitBitwiseOr(i.Bitwise_or(1)))
+	case *pythonparser.UnaryExprContext:
+		recoverRange := b.SetRange(i)
+		defer recoverRange()
+		opText := i.GetOp().GetText()
+		op, ok := unaryOpTbl[opText]
+		if !ok {
+			b.NewError(ssa.Error, TAG, UnexpectedUnaryOP(opText))
+			return b.EmitUndefined("")
+		}
+		return b.EmitUnOp(op, b.VisitBitwiseOr(i.Bitwise_or()))
+	}
+	return b.EmitUndefined("")
+}
+
+// VisitPrimary builds an atom followed by attribute reads, subscripts and calls,
+// the value of `await x` is the value of x.
+func (b *builder) VisitPrimary(raw pythonparser.IPrimaryContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.PrimaryContext)
+	if i == nil {
+		return nil
+	}
+	return b.visitTrailers(i.Atom(), i.AllTrailer())
+}
+
+// VisitTPrimary builds the object of an attribute or subscript target.
+func (b *builder) VisitTPrimary(raw pythonparser.IT_primaryContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.T_primaryContext)
+	if i == nil {
+		return nil
+	}
+	return b.visitTrailers(i.Atom(), i.AllTrailer())
+}
+
+func (b *builder) visitTrailers(atom pythonparser.IAtomContext, trailers []pythonparser.ITrailerContext) ssa.Value {
+	value := b.VisitAtom(atom)
+	// self is the object a method is called on, set when `.name` is followed by a call
+	var self ssa.Value
+	for index, raw := range trailers {
+		if b.IsStop() {
+			return value
+		}
+		trailer := raw.(*pythonparser.TrailerContext)
+		recoverRange := b.SetRange(&tokenRange{start: atom, stop: trailer})
+		switch {
+		case trailer.DOT() != nil:
+			name := trailer.Name().GetText()
+			if index+1 < len(trailers) && trailers[index+1].(*pythonparser.TrailerContext).OPEN_PAREN() != nil {
+				self, value = b.readCallee(value, name)
+			} else {
+				value = b.ReadMemberCallMethodOrValue(value, b.EmitConstInstPlaceholder(name))
+			}
+		case trailer.OPEN_BRACK() != nil:
+			value = b.visitSubscript(value, trailer.Slices())
+		default:
+			value = b.visitCall(self, value, trailer.Arguments())
+			self = nil
+		}
+		recoverRange()
+	}
+	return value
+}
+
+// visitSubscript reads `obj[slices]`, a single `a:b:c` slice makes a slice of obj.
+func (b *builder) visitSubscript(obj ssa.Value, raw pythonparser.ISlicesContext) ssa.Value {
+	slices, _ := raw.(*pythonparser.SlicesContext)
+	if slices == nil {
+		return nil
+	}
+	items := slices.AllSlice()
+	if len(items) == 1 && len(slices.AllCOMMA()) == 0 {
+		if slice := items[0].(*pythonparser.SliceContext); slice.Star_named_expression() == nil {
+			var values [3]ssa.Value
+			index := 0
+			for _, child := range slice.GetChildren() {
+				switch c := child.(type) {
+				case antlr.TerminalNode:
+					index++
+				case pythonparser.IExpressionContext:
+					values[index] = b.VisitExpression(c)
+				}
+			}
+			return b.EmitMakeSlice(obj, values[0], values[1], values[2])
+		}
+	}
+	return b.ReadMemberCallValue(obj, b.visitSliceKey(raw))
+}
+
+// visitSliceKey is the key of a subscript, `a[1, 2]` is keyed by a tuple.
+func (b *builder) visitSliceKey(raw pythonparser.ISlicesContext) ssa.Value {
+	slices, _ := raw.(*pythonparser.SlicesContext)
+	if slices == nil {
+		return nil
+	}
+	key := func(raw pythonparser.ISliceContext) ssa.Value {
+		slice := raw.(*pythonparser.SliceContext)
+		if slice.Star_named_expression() == nil {
+			return b.EmitUndefined("slice")
+		}
+		return b.VisitStarNamedExpression(slice.Star_named_expression())
+	}
+	items := slices.AllSlice()
+	if len(items) == 1 && len(slices.AllCOMMA()) == 0 {
+		return key(items[0])
+	}
+	values := make([]ssa.Value, 0, len(items))
+	for _, item := range items {
+		if value := key(item); value != nil {
+			values = append(values, value)
+		}
+	}
+	return b.CreateObjectWithSlice(values)
+}
+
+// VisitArgument builds the value of a call argument, `*args` and `**kwargs` are the value of
+// the unpacked expression.
+func (b *builder) VisitArgument(raw pythonparser.IArgumentContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*pythonparser.ArgumentContext)
+	if i == nil {
+		return nil
+	}
+
+	switch {
+	case i.Expression() != nil:
+		return b.VisitExpression(i.Expression())
+	case i.Comprehension() != nil:
+		return b.visitComprehension(i.Comprehension(), func(container, key ssa.Value) {
+			b.appendItem(container, key, b.VisitNamedExpression(i.Named_expression()))
+		})
+	default:
+		return b.VisitNamedExpression(i.Named_expression())
+	}
+}
+
+// visitCall builds a call, keyword arguments are passed after the positional ones.
+// Calling a class runs its constructor with the class as the first argument, like `__new__(cls)`.
+// A method read from an instance is bound: `obj.method(x)` calls `A.method(obj, x)`.
+func (b *builder) visitCall(self, callee ssa.Value, raw pythonparser.IArgumentsContext) ssa.Value {
+	if callee == nil {
+		b.NewError(ssa.Error, TAG, InvalidFunctionCallee())
+		return b.EmitUndefined("")
+	}
+
+	var args, keywords []ssa.Value
+	if self != nil {
+		args = append(args, self)
+	}
+	if arguments, ok := raw.(*pythonparser.ArgumentsContext); ok {
+		for _, item := range arguments.AllArgument() {
+			argument := item.(*pythonparser.ArgumentContext)
+			value := b.VisitArgument(argument)
+			if argument.ASSIGN() == nil && argument.POWER() == nil {
+				if value == nil {
+					value = b.EmitUndefined("")
+				}
+				args = append(args, value)
+				continue
+			}
+			if value == nil {
+				name := ""
+				if argument.Name() != nil {
+					name = argument.Name().GetText()
+				}
+				value = b.EmitUndefined(name)
+			}
+			keywords = append(keywords, value)
+		}
+	}
+	args = append(args, keywords...)
+
+	if blueprint, ok := ssa.ToClassBluePrintType(callee.GetType()); ok && blueprint.Container() == callee {
+		instance := b.ClassConstructorWithoutDeferDestructor(blueprint, append([]ssa.Value{callee}, args...))
+		instance.SetType(blueprint)
+		return instance
+	}
+	if method, ok := ssa.ToFunction(callee); ok && method.IsMethod() {
+		return b.emitMethodCall(method, args)
+	}
+	return b.EmitCall(b.NewCall(callee, args))
+}
+
+// readCallee reads the callee of `obj.name(...)`. A method of a class is returned as the
+// function itself, with obj as self when obj is an instance of the class. Other attributes
+// are read as members of obj and self is nil.
+func (b *builder) readCallee(obj ssa.Value, name string) (self, callee ssa.Value) {
+	if obj == nil {
+		return nil, nil
+	}
+	if blueprint, ok := ssa.ToClassBluePrintType(obj.GetType()); ok {
+		if method, ok := ssa.ToFunction(blueprint.GetNormalMethod(name)); ok {
+			if blueprint.Container() == obj {
+				return nil, method
+			}
+			return obj, method
+		}
+	}
+	return nil, b.ReadMemberCallMethodOrValue(obj, b.EmitConstInstPlaceholder(name))
+}
+
+// emitMethodCall calls a method with self already in args. The ssa call would otherwise
+// insert the class holding the method as the receiver.
+func (b *builder) emitMethodCall(method *ssa.Function, args []ssa.Value) ssa.Value {
+	objectType := method.Type.ObjectType
+	method.SetMethod(false, nil)
+	defer method.SetMethod(true, objectType)
+	return b.EmitCall(b.NewCall(method, args))
+}
+
+func (b *builder) VisitAtom(raw pythonparser.IAtomContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	switch i := raw.(type) {
+	case *pythonparser.NameAtomContext:
+		return b.ReadValue(i.Name().GetText())
+	case *pythonparser.TrueAtomContext:
+		return b.EmitConstInst(true)
+	case *pythonparser.FalseAtomContext:
+		return b.EmitConstInst(false)
+	case *pythonparser.NoneAtomContext:
+		return b.EmitConstInstNil()
+	case *pythonparser.StringAtomContext:
+		return b.VisitStrings(i.Strings())
+	case *pythonparser.NumberAtomContext:
+		return b.visitNumber(i.NUMBER().GetText())
+	case *pythonparser.EllipsisAtomContext:
+		return b.EmitUndefined("...")
+	case *pythonparser.YieldAtomContext:
+		return b.VisitYieldExpr(i.Yield_expr())
+	case *pythonparser.GroupAtomContext:
+		return b.VisitNamedExpression(i.Named_expression())
+	case *pythonparser.GeneratorAtomContext:
+		return b.visitComprehension(i.Comprehension(), func(container, key ssa.Value) {
+			b.appendItem(container, key, b.VisitNamedExpression(i.Named_expression()))
+		})
+	case *pythonparser.ListCompAtomContext:
+		return b.visitComprehension(i.Comprehension(), func(container, key ssa.Value) {
+			b.appendItem(container, key, b.VisitNamedExpression(i.Named_expression()))
+		})
+	case *pythonparser.SetCompAtomContext:
+		return b.visitComprehension(i.Comprehension(), func(container, key ssa.Value) {
+			b.appendItem(container, key, b.VisitNamedExpression(i.Named_expression()))
+		})
+	case *pythonparser.DictCompAtomContext:
+		return b.visitComprehension(i.Comprehension(), func(container, _ ssa.Value) {
+			key := b.VisitExpression(i.Expression(0))
+			b.appendItem(container, key, b.VisitExpression(i.Expression(1)))
+		})
+	case *pythonparser.TupleAtomContext:
+		values := make([]ssa.Value, 0)
+		if i.Star_named_expression() != nil {
+			if value := b.VisitStarNamedExpression(i.Star_named_expression()); value != nil {
+				values = append(values, value)
+			}
+			values = append(values, b.visitStarNamedExpressions(i.Star_named_expressions())...)
+		}
+		return b.CreateObjectWithSlice(values)
+	case *pythonparser.ListAtomContext:
+		return b.CreateObjectWithSlice(b.visitStarNamedExpressions(i.Star_named_expressions()))
+	case *pythonparser.SetAtomContext:
+		return b.CreateObjectWithSlice(b.visitStarNamedExpressions(i.Star_named_expressions()))
+	case *pythonparser.DictAtomContext:
+		return b.VisitDict(i)
+	}
+	return b.EmitUndefined("")
+}
+
+// visitNumber parses a NUMBER token, integers out of the int64 range keep their decimal text
+// and complex numbers keep the source text.
+func (b *builder) visitNumber(text string) ssa.Value {
+	lower := strings.ToLower(strings.ReplaceAll(text, "_", ""))
+	switch {
+	case strings.HasSuffix(lower, "j"):
+		return b.EmitConstInst(text)
+	case !strings.HasPrefix(lower, "0x") && strings.ContainsAny(lower, ".e"):
+		if v, err := strconv.ParseFloat(lower, 64); err == nil {
+			return b.EmitConstInst(v)
+		}
+		return b.EmitConstInst(text)
+	}
+	if v, err := strconv.ParseInt(lower, 0, 64); err == nil {
+		return b.EmitConstInst(v)
+	}
+	if v, ok := new(big.Int).SetString(lower, 0); ok {
+		return b.EmitConstInst(v.String())
+	}
+	return b.EmitConstInst(text)
+}
+
+func (b *builder) VisitDict(raw pythonparser.IAtomContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.DictAtomContext)
+	if i == nil {
+		return nil
+	}
+
+	var keys, values []ssa.Value
+	var unpacked []ssa.Value
+	for _, rawItem := range i.AllDict_item() {
+		item := rawItem.(*pythonparser.Dict_itemContext)
+		if item.POWER() != nil {
+			// `{**other}`
+			unpacked = append(unpacked, b.VisitBitwiseOr(item.Bitwise_or()))
+			continue
+		}
+		keys = append(keys, b.VisitExpression(item.Expression(0)))
+		values = append(values, b.VisitExpression(item.Expression(1)))
+	}
+	obj := b.CreateObjectWithMap(keys, values)
+	for index, value := range unpacked {
+		member := b.CreateMemberCallVariable(obj, b.EmitConstInstPlaceholder("**"+strconv.Itoa(index)))
+		b.AssignVariable(member, value)
+	}
+	return obj
+}
+
+func (b *builder) appendItem(container, key, value ssa.Value) {
+	if utils.IsNil(key) {
+		key = b.EmitConstInstPlaceholder(0)
+	}
+	member := b.CreateMemberCallVariable(container, key)
+	b.AssignVariable(member, value)
+}
+
+// visitComprehension builds the nested loops of a comprehension, elt is called in the
+// innermost loop with the result container and the key of the outermost iteration.
+func (b *builder) visitComprehension(raw pythonparser.IComprehensionContext, elt func(container, key ssa.Value)) ssa.Value {
+	container := b.EmitEmptyContainer()
+	comprehension, _ := raw.(*pythonparser.ComprehensionContext)
+	if comprehension == nil {
+		return container
+	}
+	generators := comprehension.AllFor_if_clause()
+	var outerKey ssa.Value
+
+	var build func(index int)
+	build = func(index int) {
+		if index >= len(generators) {
+			elt(container, outerKey)
+			return
+		}
+		generator := generators[index].(*pythonparser.For_if_clauseContext)
+		tests := generator.AllDisjunction()
+		loop := b.CreateLoopBuilder()
+		var iter ssa.Value
+		loop.SetFirst(func() []ssa.Value {
+			iter = b.VisitDisjunction(tests[0])
+			return []ssa.Value{iter}
+		})
+		loop.SetCondition(func() ssa.Value {
+			key, value, ok := b.EmitNext(iter, true)
+			if ok == nil {
+				return b.EmitConstInst(false)
+			}
+			if index == 0 {
+				outerKey = key
+			}
+			b.assignComprehensionTarget(generator.Star_targets(), value)
+			return ok
+		})
+		loop.SetBody(func() {
+			if len(tests) == 1 {
+				build(index + 1)
+				return
+			}
+			ifBuilder := b.CreateIfBuilder()
+			ifBuilder.AppendItem(
+				func() ssa.Value {
+					var condition ssa.Value
+					for _, test := range tests[1:] {
+						value := b.VisitDisjunction(test)
+						if condition == nil {
+							condition = value
+						} else {
+							condition = b.EmitBinOp(ssa.OpLogicAnd, condition, value)
+						}
+					}
+					return condition
+				},
+				func() {
+					build(index + 1)
+				},
+			)
+			ifBuilder.Build()
+		})
+		loop.Finish()
+	}
+	build(0)
+	return container
+}
+
+// assignComprehensionTarget binds the loop variables of a comprehension, they do not leak
+// into the enclosing scope.
+func (b *builder) assignComprehensionTarget(target pythonparser.IStar_targetsContext, value ssa.Value) {
+	b.bindTarget(target, value, func(name string, value ssa.Value) {
+		b.AssignVariable(b.CreateLocalVariable(name), value)
+	})
+}
@@ -0,0 +1,373 @@
+package python2ssa
+
+import (
+	"fmt"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/google/uuid"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// parameter is a parameter of a function or a lambda, `/` and a bare `*` are not parameters.
+type parameter struct {
+	name         string
+	isVarArgs    bool
+	defaultValue pythonparser.IExpressionContext
+	node         antlr.ParserRuleContext
+}
+
+func functionParams(raw pythonparser.IParamsContext) []*parameter {
+	params, _ := raw.(*pythonparser.ParamsContext)
+	if params == nil {
+		return nil
+	}
+	var result []*parameter
+	for _, rawItem := range params.AllParam_item() {
+		item := rawItem.(*pythonparser.Param_itemContext)
+		if item.Name() == nil {
+			continue
+		}
+		param := &parameter{name: item.Name().GetText(), isVarArgs: item.STAR() != nil, node: item}
+		if item.Default_assignment() != nil {
+			param.defaultValue = item.Default_assignment().(*pythonparser.Default_assignmentContext).Expression()
+		}
+		result = append(result, param)
+	}
+	return result
+}
+
+func lambdaParams(raw pythonparser.ILambda_paramsContext) []*parameter {
+	params, _ := raw.(*pythonparser.Lambda_paramsContext)
+	if params == nil {
+		return nil
+	}
+	var result []*parameter
+	for _, rawItem := range params.AllLambda_param() {
+		item := rawItem.(*pythonparser.Lambda_paramContext)
+		if item.Name() == nil {
+			continue
+		}
+		param := &parameter{name: item.Name().GetText(), isVarArgs: item.STAR() != nil, node: item}
+		if item.Default_assignment() != nil {
+			param.defaultValue = item.Default_assignment().(*pythonparser.Default_assignmentContext).Expression()
+		}
+		result = append(result, param)
+	}
+	return result
+}
+
+func decoratorsOf(raw pythonparser.IDecoratorsContext) []pythonparser.INamed_expressionContext {
+	decorators, _ := raw.(*pythonparser.DecoratorsContext)
+	if decorators == nil {
+		return nil
+	}
+	return decorators.AllNamed_expression()
+}
+
+// functionDefinition describes how the body of a python function is built.
+type functionDefinition struct {
+	params []*parameter
+	body   func()
+	scope  *functionScope
+
+	// enter runs before the parameters are declared, methods use it for `self`
+	enter func(params []*parameter) []*parameter
+	// exit runs after the body, constructors return the new object
+	exit func()
+}
+
+// buildFunction builds fn eagerly, default values are evaluated in the enclosing scope
+// like python does when the `def` statement runs.
+func (b *builder) buildFunction(fn *ssa.Function, def *functionDefinition) {
+	defaults := make([]ssa.Value, len(def.params))
+	for i, param := range def.params {
+		if param.defaultValue != nil {
+			defaults[i] = b.VisitExpression(param.defaultValue)
+		}
+	}
+
+	currentScope := b.scope
+	b.FunctionBuilder = b.PushFunction(fn)
+	b.scope = def.scope
+	{
+		params := def.params
+		offset := 0
+		if def.enter != nil {
+			params = def.enter(params)
+			offset = len(def.params) - len(params)
+		}
+		for i, param := range params {
+			recoverRange := b.SetRange(param.node)
+			p := b.NewParam(param.name)
+			if value := defaults[i+offset]; value != nil {
+				p.SetDefault(value)
+			}
+			if param.isVarArgs && i == len(params)-1 {
+				b.HandlerEllipsis()
+			}
+			recoverRange()
+		}
+		b.declareLocals()
+		def.body()
+		if def.exit != nil {
+			def.exit()
+		}
+		b.Finish()
+	}
+	b.scope = currentScope
+	b.FunctionBuilder = b.PopFunction()
+}
+
+// declareLocals declares every name assigned in the function as a local variable, so that an
+// assignment never writes to a variable with the same name in the enclosing scope.
+func (b *builder) declareLocals() {
+	if b.scope == nil || b.scope.isModule {
+		return
+	}
+	for _, name := range b.scope.locals {
+		variable := b.CreateLocalVariable(name)
+		b.AssignVariable(variable, b.EmitValueOnlyDeclare(name))
+	}
+}
+
+// applyDecorators calls the decorators from the innermost to the outermost one.
+func (b *builder) applyDecorators(decorators []pythonparser.INamed_expressionContext, value ssa.Value) ssa.Value {
+	callees := make([]ssa.Value, len(decorators))
+	for i, decorator := range decorators {
+		callees[i] = b.VisitNamedExpression(decorator)
+	}
+	for i := len(callees) - 1; i >= 0; i-- {
+		if callees[i] == nil {
+			continue
+		}
+		value = b.EmitCall(b.NewCall(callees[i], []ssa.Value{value}))
+	}
+	return value
+}
+
+func (b *builder) VisitFunctionDef(raw pythonparser.IFunction_defContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.Function_defContext)
+	if i == nil {
+		return
+	}
+
+	name := i.Name().GetText()
+	fn := b.NewFunc(name)
+	params := functionParams(i.Params())
+	body := blockStatements(i.Block())
+	b.buildFunction(fn, &functionDefinition{
+		params: params,
+		scope:  newFunctionScope(params, body),
+		body: func() {
+			b.VisitStatements(body)
+		},
+	})
+	b.assignName(name, b.applyDecorators(decoratorsOf(i.Decorators()), fn))
+}
+
+func (b *builder) VisitLambdef(raw pythonparser.ILambdefContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*pythonparser.LambdefContext)
+	if i == nil {
+		return nil
+	}
+
+	fn := b.NewFunc("lambda_" + uuid.NewString()[:8])
+	params := lambdaParams(i.Lambda_params())
+	b.buildFunction(fn, &functionDefinition{
+		params: params,
+		scope:  newFunctionScope(params, nil),
+		body: func() {
+			recoverRange := b.SetRange(i.Expression())
+			defer recoverRange()
+			b.EmitReturn([]ssa.Value{b.VisitExpression(i.Expression())})
+		},
+	})
+	return fn
+}
+
+// VisitClassDef creates a blueprint for the class, `__init__` is the constructor and
+// `@staticmethod` / `@classmethod` functions are static methods.
+func (b *builder) VisitClassDef(raw pythonparser.IClass_defContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.Class_defContext)
+	if i == nil {
+		return
+	}
+
+	className := i.Name().GetText()
+	var bases []string
+	if arguments, ok := i.Arguments().(*pythonparser.ArgumentsContext); ok {
+		for _, raw := range arguments.AllArgument() {
+			argument := raw.(*pythonparser.ArgumentContext)
+			b.VisitArgument(argument)
+			if argument.Named_expression() != nil && argument.Comprehension() == nil {
+				bases = append(bases, referenceName(argument))
+			}
+		}
+	}
+
+	blueprint := b.CreateBlueprint(className)
+	blueprint.SetKind(ssa.BlueprintClass)
+	for _, name := range bases {
+		if name == "" || name == "object" {
+			continue
+		}
+		parent := b.GetBluePrint(name)
+		if parent == nil {
+			parent = b.CreateBlueprint(name)
+		}
+		blueprint.AddParentBlueprint(parent)
+	}
+
+	b.PushBlueprint(blueprint)
+	currentBlueprint := b.MarkedThisClassBlueprint
+	b.MarkedThisClassBlueprint = blueprint
+	defer func() {
+		b.MarkedThisClassBlueprint = currentBlueprint
+		b.PopBlueprint()
+	}()
+
+	// register every method first, a method body may call a method defined after it
+	type method struct {
+		def      *pythonparser.Function_defContext
+		fn       *ssa.Function
+		isStatic bool
+		isCtor   bool
+	}
+	body := blockStatements(i.Block())
+	var methods []*method
+	for _, item := range body {
+		def, ok := item.(*pythonparser.Function_defContext)
+		if !ok {
+			continue
+		}
+		m := &method{def: def}
+		for _, decorator := range decoratorsOf(def.Decorators()) {
+			switch referenceName(decorator) {
+			case "staticmethod", "classmethod":
+				m.isStatic = true
+			}
+		}
+		name := def.Name().GetText()
+		funcName := fmt.Sprintf("%s_%s_%s", className, name, uuid.NewString()[:4])
+		m.fn = b.NewFunc(funcName)
+		m.fn.SetMethodName(name)
+		switch {
+		case name == "__init__" && !m.isStatic:
+			m.isCtor = true
+			blueprint.Constructor = m.fn
+			blueprint.RegisterMagicMethod(ssa.Constructor, m.fn)
+		case m.isStatic:
+			blueprint.RegisterStaticMethod(name, m.fn)
+		default:
+			blueprint.RegisterNormalMethod(name, m.fn)
+		}
+		methods = append(methods, m)
+	}
+
+	for _, item := range body {
+		switch s := item.(type) {
+		case *pythonparser.Function_defContext:
+		case *pythonparser.AssignContext:
+			// class attributes are shared by the class and its instances
+			value := b.VisitAnnotatedRhs(s.Annotated_rhs())
+			for _, target := range s.AllStar_targets() {
+				if name := targetName(target); name != "" {
+					blueprint.RegisterStaticMember(name, value)
+					blueprint.RegisterNormalMember(name, value)
+				} else {
+					b.assignTarget(target, value)
+				}
+			}
+		case *pythonparser.AnnAssignContext:
+			name := targetName(s.Single_target())
+			if name == "" {
+				continue
+			}
+			var value ssa.Value
+			if s.Annotated_rhs() != nil {
+				value = b.VisitAnnotatedRhs(s.Annotated_rhs())
+			} else {
+				value = b.EmitUndefined(name)
+			}
+			blueprint.RegisterStaticMember(name, value)
+			blueprint.RegisterNormalMember(name, value)
+		default:
+			b.VisitStatement(item)
+		}
+	}
+
+	for _, m := range methods {
+		b.buildMethod(blueprint, m.def, m.fn, m.isStatic, m.isCtor)
+	}
+
+	container := blueprint.Container()
+	b.assignName(className, b.applyDecorators(decoratorsOf(i.Decorators()), container))
+}
+
+func (b *builder) buildMethod(blueprint *ssa.Blueprint, def *pythonparser.Function_defContext, fn *ssa.Function, isStatic, isCtor bool) {
+	recoverRange := b.SetRange(def)
+	defer recoverRange()
+
+	// decorators other than staticmethod/classmethod are evaluated for their side effects
+	isClassMethod := false
+	for _, decorator := range decoratorsOf(def.Decorators()) {
+		switch referenceName(decorator) {
+		case "staticmethod":
+		case "classmethod":
+			isClassMethod = true
+		default:
+			b.VisitNamedExpression(decorator)
+		}
+	}
+
+	var container ssa.Value
+	params := functionParams(def.Params())
+	body := blockStatements(def.Block())
+	definition := &functionDefinition{
+		params: params,
+		scope:  newFunctionScope(params, body),
+		body: func() {
+			b.VisitStatements(body)
+		},
+	}
+	switch {
+	case isCtor:
+		definition.enter = func(params []*parameter) []*parameter {
+			// the constructor receives the new object and returns it after `__init__`
+			b.NewParam("$this")
+			object := b.EmitEmptyContainer()
+			object.SetType(blueprint)
+			container = object
+			if len(params) == 0 {
+				return params
+			}
+			b.assignName(params[0].name, object)
+			return params[1:]
+		}
+		definition.exit = func() {
+			b.EmitReturn([]ssa.Value{container})
+		}
+	case !isStatic || isClassMethod:
+		definition.enter = func(params []*parameter) []*parameter {
+			if len(params) == 0 {
+				return params
+			}
+			self := b.NewParam(params[0].name)
+			self.SetType(blueprint)
+			return params[1:]
+		}
+	}
+	b.buildFunction(fn, definition)
+}
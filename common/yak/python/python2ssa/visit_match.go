@@ -0,0 +1,288 @@
+package python2ssa
+
+import (
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *builder) VisitMatchStmt(raw pythonparser.IMatch_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.Match_stmtContext)
+	if i == nil {
+		return
+	}
+
+	subject := b.VisitSubjectExpr(i.Subject_expr())
+	ifBuilder := b.CreateIfBuilder()
+	for _, raw := range i.AllCase_block() {
+		caseBlock := raw.(*pythonparser.Case_blockContext)
+		ifBuilder.AppendItem(
+			func() ssa.Value {
+				condition := b.matchPatterns(caseBlock.Patterns(), subject)
+				if caseBlock.Guard() != nil {
+					guard := b.VisitNamedExpression(caseBlock.Guard().(*pythonparser.GuardContext).Named_expression())
+					if condition == nil {
+						condition = guard
+					} else {
+						condition = b.EmitBinOp(ssa.OpLogicAnd, condition, guard)
+					}
+				}
+				if condition == nil {
+					return b.EmitConstInst(true)
+				}
+				return condition
+			},
+			func() {
+				b.VisitBlock(caseBlock.Block())
+			},
+		)
+	}
+	ifBuilder.Build()
+}
+
+func (b *builder) VisitSubjectExpr(raw pythonparser.ISubject_exprContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*pythonparser.Subject_exprContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.Named_expression() != nil {
+		return b.VisitNamedExpression(i.Named_expression())
+	}
+	// `match a, b:` matches a tuple
+	values := []ssa.Value{b.VisitStarNamedExpression(i.Star_named_expression())}
+	values = append(values, b.visitStarNamedExpressions(i.Star_named_expressions())...)
+	return b.CreateObjectWithSlice(values)
+}
+
+// caseCapture is the name bound by `case pattern as name`.
+func caseCapture(caseBlock *pythonparser.Case_blockContext) string {
+	patterns := caseBlock.Patterns().(*pythonparser.PatternsContext)
+	if len(patterns.AllPattern()) != 1 || len(patterns.AllCOMMA()) != 0 {
+		return ""
+	}
+	pattern := patterns.Pattern(0).(*pythonparser.PatternContext)
+	if pattern.Name() == nil {
+		return ""
+	}
+	return pattern.Name().GetText()
+}
+
+func (b *builder) andCondition(x, y ssa.Value) ssa.Value {
+	if x == nil {
+		return y
+	}
+	if y == nil {
+		return x
+	}
+	return b.EmitBinOp(ssa.OpLogicAnd, x, y)
+}
+
+// matchPatterns matches the patterns of a case, `case a, b:` is a sequence pattern.
+func (b *builder) matchPatterns(raw pythonparser.IPatternsContext, subject ssa.Value) ssa.Value {
+	patterns, _ := raw.(*pythonparser.PatternsContext)
+	if patterns == nil {
+		return nil
+	}
+	if len(patterns.AllPattern()) == 1 && len(patterns.AllCOMMA()) == 0 {
+		return b.matchPattern(patterns.Pattern(0), subject)
+	}
+	return b.matchSequence(patterns.AllPattern(), subject)
+}
+
+// matchSequence matches the items of a sequence by index, a star pattern captures the subject.
+func (b *builder) matchSequence(patterns []pythonparser.IPatternContext, subject ssa.Value) ssa.Value {
+	var condition ssa.Value
+	for i, pattern := range patterns {
+		if isStarPattern(pattern) {
+			condition = b.andCondition(condition, b.matchPattern(pattern, subject))
+			continue
+		}
+		item := b.ReadMemberCallValue(subject, b.EmitConstInst(i))
+		condition = b.andCondition(condition, b.matchPattern(pattern, item))
+	}
+	return condition
+}
+
+func isStarPattern(raw pythonparser.IPatternContext) bool {
+	pattern := raw.(*pythonparser.PatternContext)
+	closed := pattern.Or_pattern().(*pythonparser.Or_patternContext).AllClosed_pattern()
+	if len(closed) != 1 {
+		return false
+	}
+	_, ok := closed[0].(*pythonparser.StarPatternContext)
+	return ok
+}
+
+// matchPattern binds the capture names of a pattern and returns the match condition,
+// nil means the pattern always matches.
+func (b *builder) matchPattern(raw pythonparser.IPatternContext, subject ssa.Value) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	i, _ := raw.(*pythonparser.PatternContext)
+	if i == nil {
+		return nil
+	}
+
+	condition := b.matchOrPattern(i.Or_pattern(), subject)
+	if i.Name() != nil {
+		b.assignName(i.Name().GetText(), subject)
+	}
+	return condition
+}
+
+func (b *builder) matchOrPattern(raw pythonparser.IOr_patternContext, subject ssa.Value) ssa.Value {
+	i, _ := raw.(*pythonparser.Or_patternContext)
+	if i == nil {
+		return nil
+	}
+	closed := i.AllClosed_pattern()
+	if len(closed) == 1 {
+		return b.matchClosedPattern(closed[0], subject)
+	}
+	condition := b.matchOrTrue(closed[0], subject)
+	for _, pattern := range closed[1:] {
+		condition = b.EmitBinOp(ssa.OpLogicOr, condition, b.matchOrTrue(pattern, subject))
+	}
+	return condition
+}
+
+func (b *builder) matchOrTrue(pattern pythonparser.IClosed_patternContext, subject ssa.Value) ssa.Value {
+	if condition := b.matchClosedPattern(pattern, subject); condition != nil {
+		return condition
+	}
+	return b.EmitConstInst(true)
+}
+
+func (b *builder) matchClosedPattern(raw pythonparser.IClosed_patternContext, subject ssa.Value) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	switch i := raw.(type) {
+	case *pythonparser.LiteralPatternContext:
+		return b.EmitBinOp(ssa.OpEq, subject, b.VisitLiteralPattern(i.Literal_pattern()))
+	case *pythonparser.CapturePatternContext:
+		names := i.Name_or_attr().(*pythonparser.Name_or_attrContext).AllName()
+		if len(names) > 1 {
+			// value pattern, `case Color.RED:`
+			return b.EmitBinOp(ssa.OpEq, subject, b.readNameOrAttr(i.Name_or_attr()))
+		}
+		if name := names[0].GetText(); name != "_" {
+			b.assignName(name, subject)
+		}
+		return nil
+	case *pythonparser.StarPatternContext:
+		if name := i.Name().GetText(); name != "_" {
+			b.assignName(name, subject)
+		}
+		return nil
+	case *pythonparser.GroupPatternContext:
+		return b.matchPattern(i.Pattern(), subject)
+	case *pythonparser.TuplePatternContext:
+		if i.Patterns() == nil {
+			return nil
+		}
+		return b.matchSequence(i.Patterns().(*pythonparser.PatternsContext).AllPattern(), subject)
+	case *pythonparser.ListPatternContext:
+		if i.Patterns() == nil {
+			return nil
+		}
+		return b.matchSequence(i.Patterns().(*pythonparser.PatternsContext).AllPattern(), subject)
+	case *pythonparser.MappingPatternContext:
+		var condition ssa.Value
+		for _, raw := range i.AllMapping_item() {
+			item := raw.(*pythonparser.Mapping_itemContext)
+			if item.POWER() != nil {
+				// `**rest` captures the subject
+				b.assignName(item.Name().GetText(), subject)
+				continue
+			}
+			var key ssa.Value
+			if item.Literal_pattern() != nil {
+				key = b.VisitLiteralPattern(item.Literal_pattern())
+			} else {
+				key = b.readNameOrAttr(item.Name_or_attr())
+			}
+			value := b.ReadMemberCallValue(subject, key)
+			condition = b.andCondition(condition, b.matchPattern(item.Pattern(), value))
+		}
+		return condition
+	case *pythonparser.ClassPatternContext:
+		// the type check is not modeled
+		b.readNameOrAttr(i.Name_or_attr())
+		arguments, _ := i.Pattern_arguments().(*pythonparser.Pattern_argumentsContext)
+		if arguments == nil {
+			return nil
+		}
+		var condition ssa.Value
+		index := 0
+		for _, raw := range arguments.AllPattern_argument() {
+			argument := raw.(*pythonparser.Pattern_argumentContext)
+			var value ssa.Value
+			if argument.Name() != nil {
+				value = b.ReadMemberCallValue(subject, b.EmitConstInstPlaceholder(argument.Name().GetText()))
+			} else {
+				value = b.ReadMemberCallValue(subject, b.EmitConstInst(index))
+				index++
+			}
+			condition = b.andCondition(condition, b.matchPattern(argument.Pattern(), value))
+		}
+		return condition
+	}
+	return nil
+}
+
+func (b *builder) readNameOrAttr(raw pythonparser.IName_or_attrContext) ssa.Value {
+	i, _ := raw.(*pythonparser.Name_or_attrContext)
+	if i == nil {
+		return nil
+	}
+	names := i.AllName()
+	value := b.ReadValue(names[0].GetText())
+	for _, name := range names[1:] {
+		value = b.ReadMemberCallMethodOrValue(value, b.EmitConstInstPlaceholder(name.GetText()))
+	}
+	return value
+}
+
+func (b *builder) VisitLiteralPattern(raw pythonparser.ILiteral_patternContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*pythonparser.Literal_patternContext)
+	if i == nil {
+		return nil
+	}
+
+	switch {
+	case i.Strings() != nil:
+		return b.VisitStrings(i.Strings())
+	case i.NONE() != nil:
+		return b.EmitConstInstNil()
+	case i.TRUE() != nil:
+		return b.EmitConstInst(true)
+	case i.FALSE() != nil:
+		return b.EmitConstInst(false)
+	case len(i.AllNUMBER()) > 1:
+		// complex literal, `1 + 2j`
+		return b.EmitConstInst(i.GetText())
+	}
+	value := b.visitNumber(i.NUMBER(0).GetText())
+	if len(i.AllMINUS()) > 0 {
+		return b.EmitUnOp(ssa.OpNeg, value)
+	}
+	return value
+}
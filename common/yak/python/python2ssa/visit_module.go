@@ -0,0 +1,319 @@
+package python2ssa
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// importAlias is a name of an import statement, `*` for `from m import *`.
+type importAlias struct {
+	name   string
+	asName string
+}
+
+// boundName is the local name bound by an import alias,
+// `import a.b.c` binds `a` while `from m import a` and `import x as a` bind `a`.
+func (a *importAlias) boundName() string {
+	if a.asName != "" {
+		return a.asName
+	}
+	name, _, _ := strings.Cut(a.name, ".")
+	return name
+}
+
+func importAliases(stmt *pythonparser.Import_stmtContext) []*importAlias {
+	if stmt.Import_name() != nil {
+		return importNameAliases(stmt.Import_name().(*pythonparser.Import_nameContext))
+	}
+	_, _, aliases := importFrom(stmt.Import_from().(*pythonparser.Import_fromContext))
+	return aliases
+}
+
+func importNameAliases(stmt *pythonparser.Import_nameContext) []*importAlias {
+	var aliases []*importAlias
+	names := stmt.Dotted_as_names().(*pythonparser.Dotted_as_namesContext)
+	for _, raw := range names.AllDotted_as_name() {
+		item := raw.(*pythonparser.Dotted_as_nameContext)
+		alias := &importAlias{name: item.Dotted_name().GetText()}
+		if item.Name() != nil {
+			alias.asName = item.Name().GetText()
+		}
+		aliases = append(aliases, alias)
+	}
+	return aliases
+}
+
+// importFrom returns the relative level, the module and the names of `from ... import`.
+func importFrom(stmt *pythonparser.Import_fromContext) (int, string, []*importAlias) {
+	level := len(stmt.AllDOT()) + 3*len(stmt.AllELLIPSIS())
+	module := ""
+	if stmt.Dotted_name() != nil {
+		module = stmt.Dotted_name().GetText()
+	}
+	targets := stmt.Import_from_targets().(*pythonparser.Import_from_targetsContext)
+	if targets.STAR() != nil {
+		return level, module, []*importAlias{{name: "*"}}
+	}
+	var aliases []*importAlias
+	names := targets.Import_from_as_names().(*pythonparser.Import_from_as_namesContext)
+	for _, raw := range names.AllImport_from_as_name() {
+		item := raw.(*pythonparser.Import_from_as_nameContext)
+		alias := &importAlias{name: item.Name(0).GetText()}
+		if item.AS() != nil {
+			alias.asName = item.Name(1).GetText()
+		}
+		aliases = append(aliases, alias)
+	}
+	return level, module, aliases
+}
+
+func (b *builder) VisitFileInput(raw pythonparser.IFile_inputContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.File_inputContext)
+	if i == nil {
+		return
+	}
+	// python binds functions and classes when the definition is executed,
+	// the pre-handler only needs the module names recorded in PreHandlerProject.
+	if b.PreHandler() {
+		return
+	}
+
+	info := getModuleByPath(b.GetProgram().GetApplication(), b.GetEditor().GetFilename())
+	if info == nil {
+		// single file, build it in the current function
+		recoverRange := b.SetRange(i)
+		defer recoverRange()
+		b.VisitStatements(statements(i.AllStmt()))
+		return
+	}
+	b.buildModule(info, i)
+}
+
+// buildModule builds a project file in the main function of its own library,
+// the top level names of the module are exported by the library.
+func (b *builder) buildModule(info *moduleInfo, module *pythonparser.File_inputContext) {
+	app := b.GetProgram().GetApplication()
+	if lib, _ := app.GetLibrary(info.name); lib != nil {
+		// already built (or being built) when an other module imported it
+		return
+	}
+	lib := app.NewLibrary(info.name, strings.Split(info.name, "."))
+	lib.PushEditor(app.GetCurrentEditor())
+	defer lib.PopEditor(true)
+
+	libBuilder := lib.GetAndCreateFunctionBuilder(info.name, string(ssa.MainFunctionName))
+	if libBuilder == nil {
+		return
+	}
+	libBuilder.SetEditor(app.GetCurrentEditor())
+	libBuilder.SetBuildSupport(b.FunctionBuilder)
+	libBuilder.SupportClosure = true
+	currentBuilder, currentModule := b.FunctionBuilder, b.module
+	b.FunctionBuilder, b.module = libBuilder, info
+	defer func() {
+		b.FunctionBuilder, b.module = currentBuilder, currentModule
+	}()
+
+	recoverRange := b.SetRange(module)
+	defer recoverRange()
+	body := statements(module.AllStmt())
+	b.VisitStatements(body)
+
+	collector := &bindingCollector{seen: make(map[string]struct{})}
+	collector.visitBody(body)
+	for _, name := range collector.names {
+		value := b.PeekValueInThisFunction(name)
+		if value == nil {
+			continue
+		}
+		lib.SetExportValue(name, value)
+		if blueprint, ok := ssa.ToClassBluePrintType(value.GetType()); ok && blueprint.Container() == value {
+			lib.SetExportType(name, blueprint)
+		}
+	}
+}
+
+// loadModule returns the library of a project module, the module is built first when
+// it has not been reached yet, nil means the module is not part of the project.
+func (b *builder) loadModule(name string) *ssa.Program {
+	app := b.GetProgram().GetApplication()
+	info := getModuleByName(app, name)
+	if info == nil {
+		return nil
+	}
+	if lib, _ := app.GetLibrary(info.name); lib != nil {
+		return lib
+	}
+	source, err := app.Loader.GetFilesysFileSystem().ReadFile(info.path)
+	if err != nil {
+		log.Errorf("read file %s error: %v", info.path, err)
+		return nil
+	}
+	app.Build(info.path, memedit.NewMemEditor(string(source)), b.FunctionBuilder)
+	lib, _ := app.GetLibrary(info.name)
+	return lib
+}
+
+// moduleExports returns the names exported by a library in a stable order.
+func moduleExports(lib *ssa.Program) []string {
+	names := lo.Keys(lib.ExportValue)
+	sort.Strings(names)
+	return names
+}
+
+// moduleValue is the value of `import name`: an object holding the exports for a
+// project module, or an undefined value named after a third-party module.
+func (b *builder) moduleValue(name string) ssa.Value {
+	if lib := b.loadModule(name); lib != nil {
+		object := b.EmitEmptyContainer()
+		object.SetName(name)
+		for _, export := range moduleExports(lib) {
+			value := b.importValue(lib, export)
+			if value == nil {
+				continue
+			}
+			member := b.CreateMemberCallVariable(object, b.EmitConstInstPlaceholder(export))
+			b.AssignVariable(member, value)
+		}
+		return object
+	}
+
+	parts := strings.Split(name, ".")
+	var value ssa.Value = b.EmitUndefined(parts[0])
+	// keep the module name searchable without binding it in the current scope
+	b.GetProgram().SetInstructionWithName(parts[0], value)
+	for _, part := range parts[1:] {
+		value = b.ReadMemberCallValue(value, b.EmitConstInstPlaceholder(part))
+	}
+	return value
+}
+
+func (b *builder) importValue(lib *ssa.Program, name string) ssa.Value {
+	prog := b.GetProgram()
+	if err := prog.ImportValueFromLib(lib, name); err != nil {
+		return nil
+	}
+	value, ok := prog.ReadImportValueWithPkg(lib.Name, name)
+	if !ok {
+		return nil
+	}
+	return value
+}
+
+func (b *builder) VisitImportStmt(raw pythonparser.IImport_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*pythonparser.Import_stmtContext)
+	if i == nil {
+		return
+	}
+
+	if i.Import_from() != nil {
+		b.visitImportFrom(importFrom(i.Import_from().(*pythonparser.Import_fromContext)))
+		return
+	}
+	for _, alias := range importAliases(i) {
+		if alias.asName != "" {
+			b.assignName(alias.asName, b.moduleValue(alias.name))
+			continue
+		}
+
+		// `import a.b.c` binds `a`, the sub modules are reachable as members
+		parts := strings.Split(alias.name, ".")
+		top := b.moduleValue(parts[0])
+		current := top
+		for i := 1; i < len(parts); i++ {
+			name := strings.Join(parts[:i+1], ".")
+			if getModuleByName(b.GetProgram().GetApplication(), name) == nil {
+				break
+			}
+			sub := b.moduleValue(name)
+			member := b.CreateMemberCallVariable(current, b.EmitConstInstPlaceholder(parts[i]))
+			b.AssignVariable(member, sub)
+			current = sub
+		}
+		b.assignName(parts[0], top)
+	}
+}
+
+// resolveImportFrom returns the absolute module name of a `from ... import` statement.
+func (b *builder) resolveImportFrom(level int, module string) string {
+	if level == 0 || b.module == nil {
+		return module
+	}
+	base := b.module.packageName()
+	for i := 1; i < level; i++ {
+		if base == "" {
+			b.NewError(ssa.Error, TAG, RelativeImportBeyondTopLevel(module))
+			break
+		}
+		if idx := lastDot(base); idx >= 0 {
+			base = base[:idx]
+		} else {
+			base = ""
+		}
+	}
+	switch {
+	case base == "":
+		return module
+	case module == "":
+		return base
+	default:
+		return base + "." + module
+	}
+}
+
+func (b *builder) visitImportFrom(level int, module string, aliases []*importAlias) {
+	moduleName := b.resolveImportFrom(level, module)
+	lib := b.loadModule(moduleName)
+	for _, alias := range aliases {
+		if alias.name == "*" {
+			if lib == nil {
+				continue
+			}
+			for _, export := range moduleExports(lib) {
+				if strings.HasPrefix(export, "_") {
+					continue
+				}
+				if value := b.importValue(lib, export); value != nil {
+					b.assignName(export, value)
+				}
+			}
+			continue
+		}
+
+		name := alias.boundName()
+		var value ssa.Value
+		subModule := alias.name
+		if moduleName != "" {
+			subModule = moduleName + "." + alias.name
+		}
+		switch {
+		case getModuleByName(b.GetProgram().GetApplication(), subModule) != nil:
+			value = b.moduleValue(subModule)
+		case lib != nil:
+			value = b.importValue(lib, alias.name)
+		}
+		if value == nil {
+			if moduleName == "" {
+				value = b.EmitUndefined(alias.name)
+			} else {
+				// third-party module, keep the full access path so that rules can match `os.system`
+				value = b.ReadMemberCallValue(b.moduleValue(moduleName), b.EmitConstInstPlaceholder(alias.name))
+			}
+		}
+		b.assignName(name, value)
+	}
+}
@@ -0,0 +1,438 @@
+package python2ssa
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *builder) VisitStatements(body []antlr.ParserRuleContext) {
+	for _, stmt := range body {
+		if b.IsStop() {
+			return
+		}
+		b.VisitStatement(stmt)
+	}
+}
+
+func (b *builder) VisitBlock(raw pythonparser.IBlockContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	b.VisitStatements(blockStatements(raw))
+}
+
+// VisitStatement builds a compound statement or one simple statement of a line.
+func (b *builder) VisitStatement(stmt antlr.ParserRuleContext) {
+	if b.IsBlockFinish() {
+		// unreachable after return, raise, break or continue
+		return
+	}
+	if stmt == nil || b.IsStop() {
+		return
+	}
+	recoverRange := b.SetRange(stmt)
+	defer recoverRange()
+
+	switch s := stmt.(type) {
+	case *pythonparser.Star_expressionsContext:
+		b.VisitStarExpressions(s)
+	case *pythonparser.AssignContext:
+		b.VisitAssign(s)
+	case *pythonparser.AugAssignContext:
+		b.VisitAugAssign(s)
+	case *pythonparser.AnnAssignContext:
+		if s.Annotated_rhs() != nil {
+			b.assignTarget(s.Single_target(), b.VisitAnnotatedRhs(s.Annotated_rhs()))
+		}
+	case *pythonparser.Function_defContext:
+		b.VisitFunctionDef(s)
+	case *pythonparser.Class_defContext:
+		b.VisitClassDef(s)
+	case *pythonparser.Return_stmtContext:
+		b.VisitReturnStmt(s)
+	case *pythonparser.If_stmtContext:
+		b.VisitIfStmt(s)
+	case *pythonparser.While_stmtContext:
+		b.VisitWhileStmt(s)
+	case *pythonparser.For_stmtContext:
+		b.VisitForStmt(s)
+	case *pythonparser.Try_stmtContext:
+		b.VisitTryStmt(s)
+	case *pythonparser.With_stmtContext:
+		b.VisitWithStmt(s)
+	case *pythonparser.Match_stmtContext:
+		b.VisitMatchStmt(s)
+	case *pythonparser.Raise_stmtContext:
+		b.VisitRaiseStmt(s)
+	case *pythonparser.Assert_stmtContext:
+		for _, expr := range s.AllExpression() {
+			b.VisitExpression(expr)
+		}
+	case *pythonparser.Import_stmtContext:
+		b.VisitImportStmt(s)
+	case *pythonparser.Yield_stmtContext:
+		b.VisitYieldExpr(s.Yield_expr())
+	case *pythonparser.Break_stmtContext:
+		if !b.Break() {
+			b.NewError(ssa.Error, TAG, UnexpectedBreakStmt())
+		}
+	case *pythonparser.Continue_stmtContext:
+		if !b.Continue() {
+			b.NewError(ssa.Error, TAG, UnexpectedContinueStmt())
+		}
+	case *pythonparser.Del_stmtContext:
+		b.readTarget(s.Del_targets())
+	case *pythonparser.Nonlocal_stmtContext:
+		if b.scope.isModule {
+			b.NewError(ssa.Error, TAG, NonlocalAtModuleLevel())
+		}
+	case *pythonparser.Global_stmtContext, *pythonparser.Pass_stmtContext:
+		// handled by the scope analysis of the enclosing function
+	case *pythonparser.Type_aliasContext:
+		// type aliases are only used by type checkers
+	}
+}
+
+func (b *builder) VisitAssign(raw pythonparser.IAssignmentContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.AssignContext)
+	if i == nil {
+		return
+	}
+
+	value := b.VisitAnnotatedRhs(i.Annotated_rhs())
+	for _, target := range i.AllStar_targets() {
+		b.assignTarget(target, value)
+	}
+}
+
+func (b *builder) VisitAugAssign(raw pythonparser.IAssignmentContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.AugAssignContext)
+	if i == nil {
+		return
+	}
+
+	opText := strings.TrimSuffix(i.Augassign().GetText(), "=")
+	op, ok := binOpTbl[opText]
+	if !ok {
+		b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(opText))
+		return
+	}
+	left := b.readTarget(i.Single_target())
+	right := b.VisitAnnotatedRhs(i.Annotated_rhs())
+	b.assignTarget(i.Single_target(), b.EmitBinOp(op, left, right))
+}
+
+// assignName binds a plain name, the python scope rules are applied by the
+// local variables declared when the function was entered.
+func (b *builder) assignName(name string, value ssa.Value) {
+	if value == nil {
+		value = b.EmitUndefined(name)
+	}
+	variable := b.CreateVariable(name)
+	b.AssignVariable(variable, value)
+}
+
+// assignTarget stores value into an assignment target, tuples and lists are unpacked by index.
+func (b *builder) assignTarget(target antlr.Tree, value ssa.Value) {
+	b.bindTarget(target, value, b.assignName)
+}
+
+// bindTarget stores value into a target, bind is called for the plain names of the target.
+func (b *builder) bindTarget(target antlr.Tree, value ssa.Value, bind func(string, ssa.Value)) {
+	if value == nil {
+		value = b.EmitUndefined("")
+	}
+	switch t := target.(type) {
+	case *pythonparser.Star_targetsContext:
+		items := t.AllStar_target()
+		if len(items) == 1 && len(t.AllCOMMA()) == 0 {
+			b.bindTarget(items[0], value, bind)
+			return
+		}
+		b.unpackTarget(items, value, bind)
+	case *pythonparser.Star_targetContext:
+		b.bindTarget(t.Target_with_star_atom(), value, bind)
+	case *pythonparser.Target_with_star_atomContext:
+		if t.Star_atom() != nil {
+			b.bindTarget(t.Star_atom(), value, bind)
+			return
+		}
+		b.assignMember(t.T_primary(), t.Name(), t.Slices(), value)
+	case *pythonparser.Star_atomContext:
+		switch {
+		case t.Name() != nil:
+			bind(t.Name().GetText(), value)
+		case t.Target_with_star_atom() != nil:
+			b.bindTarget(t.Target_with_star_atom(), value, bind)
+		default:
+			b.unpackTarget(t.AllStar_target(), value, bind)
+		}
+	case *pythonparser.Single_targetContext:
+		switch {
+		case t.T_primary() != nil:
+			b.assignMember(t.T_primary(), t.Name(), t.Slices(), value)
+		case t.Name() != nil:
+			bind(t.Name().GetText(), value)
+		default:
+			b.bindTarget(t.Single_target(), value, bind)
+		}
+	default:
+		b.NewError(ssa.Error, TAG, InvalidAssignTarget())
+	}
+}
+
+func (b *builder) unpackTarget(items []pythonparser.IStar_targetContext, value ssa.Value, bind func(string, ssa.Value)) {
+	for i, raw := range items {
+		item := raw.(*pythonparser.Star_targetContext)
+		if item.STAR() != nil {
+			// `a, *rest = value`, the starred target receives the remaining items
+			b.bindTarget(item.Target_with_star_atom(), value, bind)
+			continue
+		}
+		b.bindTarget(item, b.ReadMemberCallValue(value, b.EmitConstInst(i)), bind)
+	}
+}
+
+// assignMember stores value into `primary.name` or `primary[slices]`.
+func (b *builder) assignMember(primary pythonparser.IT_primaryContext, name pythonparser.INameContext, slices pythonparser.ISlicesContext, value ssa.Value) {
+	obj := b.VisitTPrimary(primary)
+	var key ssa.Value
+	if name != nil {
+		key = b.EmitConstInstPlaceholder(name.GetText())
+	} else {
+		key = b.visitSliceKey(slices)
+	}
+	variable := b.CreateMemberCallVariable(obj, key)
+	b.AssignVariable(variable, value)
+}
+
+// readTarget reads the current value of an augmented assignment or del target.
+func (b *builder) readTarget(target antlr.Tree) ssa.Value {
+	switch t := target.(type) {
+	case *pythonparser.Single_targetContext:
+		switch {
+		case t.T_primary() != nil:
+			return b.readMember(t.T_primary(), t.Name(), t.Slices())
+		case t.Name() != nil:
+			return b.ReadValue(t.Name().GetText())
+		default:
+			return b.readTarget(t.Single_target())
+		}
+	case *pythonparser.Del_targetsContext:
+		// `del a, b` reads every target, there is no single value
+		for _, item := range t.AllDel_target() {
+			b.readTarget(item)
+		}
+	case *pythonparser.Del_targetContext:
+		switch {
+		case t.T_primary() != nil:
+			return b.readMember(t.T_primary(), t.Name(), t.Slices())
+		case t.Name() != nil:
+			return b.ReadValue(t.Name().GetText())
+		case t.Del_targets() != nil:
+			return b.readTarget(t.Del_targets())
+		}
+	}
+	return nil
+}
+
+func (b *builder) readMember(primary pythonparser.IT_primaryContext, name pythonparser.INameContext, slices pythonparser.ISlicesContext) ssa.Value {
+	obj := b.VisitTPrimary(primary)
+	if name != nil {
+		return b.ReadMemberCallMethodOrValue(obj, b.EmitConstInstPlaceholder(name.GetText()))
+	}
+	return b.visitSubscript(obj, slices)
+}
+
+func (b *builder) VisitReturnStmt(raw pythonparser.IReturn_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.Return_stmtContext)
+	if i == nil {
+		return
+	}
+
+	if i.Star_expressions() == nil {
+		b.EmitReturn(nil)
+		return
+	}
+	b.EmitReturn([]ssa.Value{b.VisitStarExpressions(i.Star_expressions())})
+}
+
+func (b *builder) VisitRaiseStmt(raw pythonparser.IRaise_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.Raise_stmtContext)
+	if i == nil {
+		return
+	}
+
+	exprs := i.AllExpression()
+	if len(exprs) == 0 {
+		b.EmitReturn(nil)
+		return
+	}
+	exc := b.VisitExpression(exprs[0])
+	if len(exprs) > 1 {
+		b.VisitExpression(exprs[1])
+	}
+	b.EmitReturn([]ssa.Value{exc})
+}
+
+func (b *builder) VisitIfStmt(raw pythonparser.IIf_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.If_stmtContext)
+	if i == nil {
+		return
+	}
+
+	ifBuilder := b.CreateIfBuilder()
+	ifBuilder.AppendItem(
+		func() ssa.Value {
+			return b.VisitNamedExpression(i.Named_expression())
+		},
+		func() {
+			b.VisitBlock(i.Block())
+		},
+	)
+	for _, raw := range i.AllElif_stmt() {
+		elif := raw.(*pythonparser.Elif_stmtContext)
+		ifBuilder.AppendItem(
+			func() ssa.Value {
+				return b.VisitNamedExpression(elif.Named_expression())
+			},
+			func() {
+				b.VisitBlock(elif.Block())
+			},
+		)
+	}
+	if i.Else_block() != nil {
+		ifBuilder.SetElse(func() {
+			b.VisitStatements(elseStatements(i.Else_block()))
+		})
+	}
+	ifBuilder.Build()
+}
+
+func (b *builder) VisitWhileStmt(raw pythonparser.IWhile_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.While_stmtContext)
+	if i == nil {
+		return
+	}
+
+	loop := b.CreateLoopBuilder()
+	loop.SetCondition(func() ssa.Value {
+		condition := b.VisitNamedExpression(i.Named_expression())
+		if condition == nil {
+			return b.EmitConstInst(true)
+		}
+		return condition
+	})
+	loop.SetBody(func() {
+		b.VisitBlock(i.Block())
+	})
+	loop.Finish()
+	// the else branch runs when the loop was not left by break
+	b.VisitStatements(elseStatements(i.Else_block()))
+}
+
+func (b *builder) VisitForStmt(raw pythonparser.IFor_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.For_stmtContext)
+	if i == nil {
+		return
+	}
+
+	loop := b.CreateLoopBuilder()
+	var iter ssa.Value
+	loop.SetFirst(func() []ssa.Value {
+		iter = b.VisitStarExpressions(i.Star_expressions())
+		return []ssa.Value{iter}
+	})
+	loop.SetCondition(func() ssa.Value {
+		_, value, ok := b.EmitNext(iter, true)
+		if ok == nil {
+			return b.EmitConstInst(false)
+		}
+		b.assignTarget(i.Star_targets(), value)
+		return ok
+	})
+	loop.SetBody(func() {
+		b.VisitBlock(i.Block())
+	})
+	loop.Finish()
+	b.VisitStatements(elseStatements(i.Else_block()))
+}
+
+func (b *builder) VisitTryStmt(raw pythonparser.ITry_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.Try_stmtContext)
+	if i == nil {
+		return
+	}
+
+	tryBuilder := b.BuildTry()
+	tryBuilder.BuildTryBlock(func() {
+		b.VisitBlock(i.Block())
+		b.VisitStatements(elseStatements(i.Else_block()))
+	})
+	for _, raw := range i.AllExcept_block() {
+		handler := raw.(*pythonparser.Except_blockContext)
+		name := ""
+		if handler.Name() != nil {
+			name = handler.Name().GetText()
+		}
+		tryBuilder.BuildErrorCatch(func() string {
+			return name
+		}, func() {
+			b.VisitBlock(handler.Block())
+		})
+	}
+	if i.Finally_block() != nil {
+		tryBuilder.BuildFinally(func() {
+			b.VisitStatements(finallyStatements(i.Finally_block()))
+		})
+	}
+	tryBuilder.Finish()
+}
+
+// VisitWithStmt binds the `as` target to the context expression itself, the value returned by
+// `__enter__` is the context object for almost every context manager (files, connections).
+func (b *builder) VisitWithStmt(raw pythonparser.IWith_stmtContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	i, _ := raw.(*pythonparser.With_stmtContext)
+	if i == nil {
+		return
+	}
+
+	for _, raw := range i.AllWith_item() {
+		item := raw.(*pythonparser.With_itemContext)
+		ctx := b.VisitExpression(item.Expression())
+		if item.Star_target() != nil {
+			b.assignTarget(item.Star_target(), ctx)
+		}
+	}
+	b.VisitBlock(i.Block())
+}
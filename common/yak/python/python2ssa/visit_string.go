@@ -0,0 +1,391 @@
+package python2ssa
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/yak/antlr4util"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// stringPart is a piece of a string literal, the replacement fields of an f-string have
+// their expression parsed.
+type stringPart struct {
+	text string
+	expr pythonparser.IFstring_expressionContext
+}
+
+type stringLiteral struct {
+	isFormat bool
+	parts    []*stringPart
+}
+
+// VisitStrings builds a run of adjacent string literals, which python concatenates at
+// compile time. The result is a constant unless one of the literals is an f-string.
+func (b *builder) VisitStrings(raw pythonparser.IStringsContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*pythonparser.StringsContext)
+	if i == nil {
+		return nil
+	}
+
+	var parts []*stringPart
+	isFormat := false
+	for _, token := range i.AllSTRING() {
+		literal := splitString(token.GetSymbol(), nil)
+		isFormat = isFormat || literal.isFormat
+		parts = append(parts, literal.parts...)
+	}
+
+	var text strings.Builder
+	if !isFormat {
+		for _, part := range parts {
+			text.WriteString(part.text)
+		}
+		return b.EmitConstInst(text.String())
+	}
+
+	var result ssa.Value
+	add := func(value ssa.Value) {
+		if value == nil {
+			return
+		}
+		if result == nil {
+			result = value
+			return
+		}
+		result = b.EmitBinOp(ssa.OpAdd, result, value)
+	}
+	for _, part := range parts {
+		if part.expr == nil {
+			text.WriteString(part.text)
+			continue
+		}
+		if text.Len() > 0 {
+			add(b.EmitConstInst(text.String()))
+			text.Reset()
+		}
+		add(b.VisitFstringExpression(part.expr))
+	}
+	if text.Len() > 0 {
+		add(b.EmitConstInst(text.String()))
+	}
+	if result == nil {
+		return b.EmitConstInst("")
+	}
+	return result
+}
+
+func (b *builder) VisitFstringExpression(raw pythonparser.IFstring_expressionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*pythonparser.Fstring_expressionContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.Yield_expr() != nil {
+		return b.VisitYieldExpr(i.Yield_expr())
+	}
+	return b.VisitStarExpressions(i.Star_expressions())
+}
+
+// splitString decodes a STRING token, the fields of an f-string are parsed with their position
+// in the file and their syntax errors are sent to errListener when it is not nil.
+func splitString(token antlr.Token, errListener *antlr4util.ErrorListener) *stringLiteral {
+	text := token.GetText()
+	quoteIndex := strings.IndexAny(text, `"'`)
+	if quoteIndex < 0 {
+		return &stringLiteral{parts: []*stringPart{{text: text}}}
+	}
+	prefix := strings.ToLower(text[:quoteIndex])
+	rest := text[quoteIndex:]
+	quoteLen := 1
+	if len(rest) >= 6 && (strings.HasPrefix(rest, `"""`) || strings.HasPrefix(rest, `'''`)) {
+		quoteLen = 3
+	}
+	body := rest[quoteLen:]
+	if len(body) >= quoteLen && body[len(body)-quoteLen:] == rest[:quoteLen] {
+		body = body[:len(body)-quoteLen]
+	}
+	raw := strings.Contains(prefix, "r")
+
+	if !strings.Contains(prefix, "f") {
+		value := decodeString(body, raw, strings.Contains(prefix, "b"))
+		return &stringLiteral{parts: []*stringPart{{text: value}}}
+	}
+	scanner := &fstringScanner{
+		runes:       []rune(body),
+		line:        token.GetLine(),
+		column:      token.GetColumn() + utf8.RuneCountInString(text[:quoteIndex]) + quoteLen,
+		raw:         raw,
+		token:       token,
+		errListener: errListener,
+	}
+	return &stringLiteral{isFormat: true, parts: scanner.parts(false)}
+}
+
+// decodeString resolves escape sequences, raw strings are kept as written.
+func decodeString(body string, raw, isBytes bool) string {
+	if raw || !strings.Contains(body, `\`) {
+		return body
+	}
+	var sb strings.Builder
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		if c != '\\' || i+1 >= len(body) {
+			sb.WriteByte(c)
+			continue
+		}
+		i++
+		switch e := body[i]; e {
+		case '\n':
+			// line continuation inside the literal
+		case '\r':
+			if i+1 < len(body) && body[i+1] == '\n' {
+				i++
+			}
+		case '\\', '\'', '"':
+			sb.WriteByte(e)
+		case 'a':
+			sb.WriteByte('\a')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'v':
+			sb.WriteByte('\v')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			j := i
+			for j < len(body) && j < i+3 && body[j] >= '0' && body[j] <= '7' {
+				j++
+			}
+			v, _ := strconv.ParseUint(body[i:j], 8, 32)
+			writeCode(&sb, rune(v), isBytes)
+			i = j - 1
+		case 'x', 'u', 'U':
+			width := map[byte]int{'x': 2, 'u': 4, 'U': 8}[e]
+			if (isBytes && e != 'x') || i+width >= len(body) {
+				sb.WriteByte('\\')
+				sb.WriteByte(e)
+				continue
+			}
+			v, err := strconv.ParseUint(body[i+1:i+1+width], 16, 32)
+			if err != nil {
+				sb.WriteByte('\\')
+				sb.WriteByte(e)
+				continue
+			}
+			writeCode(&sb, rune(v), isBytes)
+			i += width
+		default:
+			// unknown escapes (and `\N{...}`) are kept verbatim like cpython does
+			sb.WriteByte('\\')
+			sb.WriteByte(e)
+		}
+	}
+	return sb.String()
+}
+
+func writeCode(sb *strings.Builder, code rune, isBytes bool) {
+	if isBytes || code < 0x80 {
+		sb.WriteByte(byte(code))
+		return
+	}
+	sb.WriteRune(code)
+}
+
+// fstringScanner splits the body of an f-string into literal text and replacement fields
+// while keeping track of the position in the file.
+type fstringScanner struct {
+	runes  []rune
+	index  int
+	line   int
+	column int
+	raw    bool
+
+	token       antlr.Token
+	errListener *antlr4util.ErrorListener
+}
+
+func (s *fstringScanner) peek(offset int) rune {
+	if i := s.index + offset; i >= 0 && i < len(s.runes) {
+		return s.runes[i]
+	}
+	return 0
+}
+
+func (s *fstringScanner) eof() bool {
+	return s.index >= len(s.runes)
+}
+
+func (s *fstringScanner) advance() rune {
+	r := s.runes[s.index]
+	s.index++
+	if r == '\n' {
+		s.line++
+		s.column = 0
+	} else {
+		s.column++
+	}
+	return r
+}
+
+func (s *fstringScanner) syntaxError(line, column int, msg string) {
+	if s.errListener != nil {
+		s.errListener.SyntaxError(nil, s.token, line, column, msg, nil)
+	}
+}
+
+// parts scans literal text and fields, a format spec ends at the `}` of its field.
+func (s *fstringScanner) parts(inSpec bool) []*stringPart {
+	var parts []*stringPart
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() == 0 {
+			return
+		}
+		parts = append(parts, &stringPart{text: decodeString(literal.String(), s.raw, false)})
+		literal.Reset()
+	}
+
+	for !s.eof() {
+		r := s.peek(0)
+		switch {
+		case r == '{' && s.peek(1) == '{' && !inSpec:
+			s.advance()
+			s.advance()
+			literal.WriteRune('{')
+		case r == '}' && s.peek(1) == '}' && !inSpec:
+			s.advance()
+			s.advance()
+			literal.WriteRune('}')
+		case r == '}' && inSpec:
+			flush()
+			return parts
+		case r == '{':
+			flush()
+			parts = append(parts, s.field()...)
+		case r == '\\' && !s.raw && s.peek(1) != 0:
+			literal.WriteRune(s.advance())
+			literal.WriteRune(s.advance())
+		default:
+			literal.WriteRune(s.advance())
+		}
+	}
+	flush()
+	return parts
+}
+
+// field scans `{expr[=][!conv][:spec]}`, the scanner is at the opening brace.
+func (s *fstringScanner) field() []*stringPart {
+	line, column := s.line, s.column
+	s.advance() // {
+	exprLine, exprColumn := s.line, s.column
+	var exprText strings.Builder
+	depth := 0
+	var quote rune
+	selfDoc := false
+scan:
+	for !s.eof() {
+		r := s.peek(0)
+		if quote != 0 {
+			if r == quote {
+				quote = 0
+			}
+			exprText.WriteRune(s.advance())
+			continue
+		}
+		switch r {
+		case '\'', '"':
+			quote = r
+		case '(', '[', '{':
+			depth++
+		case ')', ']':
+			depth--
+		case '}':
+			if depth == 0 {
+				break scan
+			}
+			depth--
+		case '!':
+			if depth == 0 && s.peek(1) != '=' {
+				break scan
+			}
+		case ':':
+			if depth == 0 {
+				break scan
+			}
+		case '=':
+			if depth == 0 && s.index > 0 && !strings.ContainsRune("=!<>", s.peek(-1)) && s.peek(1) != '=' {
+				next := strings.TrimLeft(string(s.runes[s.index+1:]), " ")
+				if next == "" || strings.ContainsRune("}!:", []rune(next)[0]) {
+					s.advance()
+					for s.peek(0) == ' ' {
+						s.advance()
+					}
+					selfDoc = true
+					break scan
+				}
+			}
+		}
+		exprText.WriteRune(s.advance())
+	}
+
+	var parts []*stringPart
+	if selfDoc {
+		// `{name=}` prints the expression text before its value
+		parts = append(parts, &stringPart{text: exprText.String() + "="})
+	}
+	parts = append(parts, &stringPart{
+		expr: parseFStringExpression(exprText.String(), exprLine, exprColumn, s.errListener),
+	})
+	if s.peek(0) == '!' {
+		s.advance()
+		if !s.eof() {
+			s.advance()
+		}
+	}
+	if s.peek(0) == ':' {
+		// the value of the spec is not modeled, its nested fields are still checked
+		s.advance()
+		s.parts(true)
+	}
+	if s.peek(0) == '}' {
+		s.advance()
+	} else {
+		s.syntaxError(line, column, "f-string: expecting '}'")
+	}
+	return parts
+}
+
+// parseFStringExpression parses the expression of a replacement field, its tokens have the
+// position of the expression in the file.
+func parseFStringExpression(expr string, line, column int, errListener *antlr4util.ErrorListener) pythonparser.IFstring_expressionContext {
+	lexer := pythonparser.NewPythonLexer(antlr.NewInputStream("(" + expr + ")"))
+	lexer.SetStartPosition(line, column-1)
+	lexer.RemoveErrorListeners()
+	parser := pythonparser.NewPythonParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	if errListener != nil {
+		lexer.AddErrorListener(errListener)
+		parser.AddErrorListener(errListener)
+	}
+	return parser.Fstring_expression()
+}
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	test "github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestBasic_Assign(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = 1
+b = a + 2
+println(b)
+`, []string{"3"}, t)
+	})
+
+	t.Run("unpack", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a, b = 1, 2
+println(a)
+println(b)
+`, []string{"1", "2"}, t)
+	})
+
+	t.Run("augmented assign", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = 1
+a += 2
+println(a)
+`, []string{"3"}, t)
+	})
+}
+
+func TestBasic_Control(t *testing.T) {
+	t.Run("if elif else", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = 1
+if c:
+    a = 2
+elif d:
+    a = 3
+else:
+    a = 4
+println(a)
+`, []string{"phi(a)[2,3,4]"}, t)
+	})
+
+	t.Run("for loop", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = 0
+for i in range(10):
+    a = a + i
+println(a)
+`, []string{"phi(a)[0,add(a, Undefined-i(valid))]"}, t)
+	})
+
+	t.Run("while loop", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = 0
+while a < 10:
+    a += 1
+println(a)
+`, []string{"phi(a)[0,add(a, 1)]"}, t)
+	})
+}
+
+func TestBasic_Comprehension(t *testing.T) {
+	t.Run("loop variable does not leak", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+x = 1
+y = [x + 1 for x in [1, 2] if x > 0]
+println(x)
+`, []string{"1"}, t)
+	})
+
+	t.Run("list comprehension", func(t *testing.T) {
+		test.CheckSyntaxFlow(t, `
+import os
+cmds = [c.strip() for c in input().split(",") if c]
+os.popen(cmds[0])
+`, `
+os.popen(* #-> * as $source)
+`, map[string][]string{
+			"source": {"make(any)", "Undefined-input", `","`},
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+
+	t.Run("dict comprehension", func(t *testing.T) {
+		test.CheckSyntaxFlow(t, `
+d = {k: eval(v) for k, v in data.items()}
+`, `
+eval(* #-> * as $source)
+`, map[string][]string{
+			"source": {"Undefined-data"},
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+}
+
+func TestBasic_With(t *testing.T) {
+	t.Run("as target", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+with open("a.txt") as f:
+    println(f)
+    println(f.read())
+`, []string{`Undefined-open("a.txt")`, "Undefined-f.read(valid)()"}, t)
+	})
+
+	t.Run("data flow", func(t *testing.T) {
+		test.CheckSyntaxFlow(t, `
+import os
+with open("a.txt") as f, lock:
+    os.system(f.read())
+`, `
+os.system(* #-> * as $source)
+`, map[string][]string{
+			"source": {"Undefined-open", `"a.txt"`},
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+}
+
+func TestBasic_String(t *testing.T) {
+	t.Run("escapes and concatenation", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = "x\ty" 'z'
+b = b"\x41\101"
+c = r"\d+" "tail"
+println(a)
+println(b)
+println(c)
+`, []string{`"x\tyz"`, `"AA"`, `"\\d+tail"`}, t)
+	})
+
+	t.Run("f-string", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+name = "world"
+println(f"hello {name} {{}}")
+`, []string{`"hello world {}"`}, t)
+	})
+
+	t.Run("f-string field range", func(t *testing.T) {
+		test.Check(t, `
+name = input()
+c = f"hello {name.upper()} {value:>{width}}"
+`, func(prog *ssaapi.Program) error {
+			result, err := prog.SyntaxFlowWithError(`name.upper() as $call`)
+			require.NoError(t, err)
+			call := result.GetValues("call")
+			require.NotEmpty(t, call)
+			require.Equal(t, "name.upper()", call[0].GetRange().GetText())
+			return nil
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+}
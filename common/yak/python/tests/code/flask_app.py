@@ -0,0 +1,67 @@
+# -*- coding: utf-8 -*-
+"""A small flask application with the usual web handlers."""
+import os
+import sqlite3
+import subprocess
+from functools import wraps
+
+from flask import Flask, jsonify, redirect, render_template_string, request, session, url_for
+
+app = Flask(__name__)
+app.secret_key = os.environ.get("SECRET_KEY", "dev")
+
+DATABASE = os.path.join(os.path.dirname(__file__), "app.db")
+
+
+def get_db():
+    conn = sqlite3.connect(DATABASE)
+    conn.row_factory = sqlite3.Row
+    return conn
+
+
+def login_required(view):
+    @wraps(view)
+    def wrapped(*args, **kwargs):
+        if "user" not in session:
+            return redirect(url_for("login", next=request.path))
+        return view(*args, **kwargs)
+
+    return wrapped
+
+
+@app.route("/login", methods=["GET", "POST"])
+def login():
+    if request.method == "POST":
+        username = request.form.get("username", "")
+        password = request.form.get("password", "")
+        row = get_db().execute(
+            "SELECT * FROM users WHERE name = '%s' AND password = '%s'" % (username, password)
+        ).fetchone()
+        if row is not None:
+            session["user"] = row["name"]
+            return redirect(request.args.get("next") or url_for("index"))
+    return render_template_string("<form method=post>{{ error }}</form>", error=None)
+
+
+@app.route("/")
+@login_required
+def index():
+    users = [dict(row) for row in get_db().execute("SELECT name FROM users")]
+    return jsonify(users=users, total=len(users))
+
+
+@app.route("/ping")
+@login_required
+def ping():
+    host = request.args.get("host", "127.0.0.1")
+    output = subprocess.check_output("ping -c 1 " + host, shell=True)
+    return output.decode("utf-8", errors="ignore")
+
+
+@app.errorhandler(404)
+def not_found(error):
+    return jsonify(error=str(error)), 404
+
+
+if __name__ == "__main__":
+    app.run(host="0.0.0.0", port=int(os.environ.get("PORT", 5000)), debug=True)
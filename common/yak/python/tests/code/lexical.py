@@ -0,0 +1,55 @@
+# lexical corner cases: continuation lines, literals, strings and indentation
+import re as _re
+
+numbers = [0, 10, 0o17, 0O17, 0xDEAD_BEEF, 0b1010, 1_000_000, 3.14, .5, 5., 1e10, 1E-3, 2.5j, 1_0.0_1e+1_0]
+strings = ['single', "double", '''triple
+single''', """triple
+double""", r'raw\d', R"raw\w", b'bytes', B"BYTES", rb'\x00', Br'\x01', u'unicode', f'{1 + 1}', F"{'nested'}", fr'{numbers}\n', Rb"\d"]
+concat = ("implicit "
+          'concatenation '  # a comment inside brackets
+          f"with {len(strings):04d} items")
+escaped = "tab\tnewline\nquote\"backslash\\unicodeé\N{BULLET}"
+nested = f"{'a' if concat else 'b'} {strings[0]!r:>10} {f'{numbers[1]}'}"
+longest = max(numbers, key=lambda n: abs(n) if isinstance(n, (int, float)) else 0)
+total = 1 + \
+    2 + \
+    3
+matrix = [
+    [1, 2, 3],
+    [4, 5, 6],
+]
+mapping = {
+    "key": "value",
+    **{"spread": True},
+}
+café = "non-ascii identifier"
+π = 3.14159
+pattern = _re.compile(r"^(?P<name>[a-z_]\w*)\s*=\s*(?P<value>.+)$", _re.M | _re.I)
+empty = ()
+single = (1,)
+sliced = numbers[::-1][1:-1:2]
+ellipsis = ...
+not_equal = 1 != 2 and not 3 == 4 or 5 >= 6 if False else None
+
+
+def spaced(a,
+           b=2,
+           *args,
+           c,
+           **kwargs):
+    if a:
+        if b:
+            return a @ b
+        elif c:
+            pass
+    else:
+        return -a ** -b // c % ~b << 1 >> 2 & 3 | 4 ^ 5
+
+
+class Empty: pass
+
+
+def one_liner(): return 1
+for i in range(3): print(i, end="")
+while False: pass
+if True: x = 1; y = 2
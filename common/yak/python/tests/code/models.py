@@ -0,0 +1,80 @@
+from __future__ import annotations
+
+import enum
+import typing as t
+from dataclasses import dataclass, field
+from typing import ClassVar, Generic, Optional, TypeVar
+
+T = TypeVar("T")
+
+
+class Color(enum.Enum):
+    RED = 1
+    GREEN = 2
+    BLUE = 3
+
+
+@dataclass(frozen=True, slots=True)
+class Point:
+    x: float = 0.0
+    y: float = 0.0
+    tags: list[str] = field(default_factory=list)
+    ORIGIN: ClassVar[Point]
+
+    def __add__(self, other: Point) -> Point:
+        return Point(self.x + other.x, self.y + other.y)
+
+    def __repr__(self) -> str:
+        return f"Point({self.x!r}, {self.y!r})"
+
+    @property
+    def norm(self) -> float:
+        return (self.x ** 2 + self.y ** 2) ** 0.5
+
+    @classmethod
+    def parse(cls, text: str) -> "Point":
+        x, _, y = text.partition(",")
+        return cls(float(x), float(y))
+
+
+class Repository(Generic[T]):
+    _items: dict[int, T]
+
+    def __init__(self) -> None:
+        self._items = {}
+        self._next = 1
+
+    def add(self, item: T, /) -> int:
+        key, self._next = self._next, self._next + 1
+        self._items[key] = item
+        return key
+
+    def get(self, key: int, default: Optional[T] = None) -> Optional[T]:
+        return self._items.get(key, default)
+
+    def find(self, *, where: t.Callable[[T], bool] = lambda _: True) -> list[T]:
+        return [item for item in self._items.values() if where(item)]
+
+    def __iter__(self) -> t.Iterator[T]:
+        yield from self._items.values()
+
+    def __len__(self) -> int:
+        return len(self._items)
+
+
+def describe(value: object) -> str:
+    match value:
+        case Point(x=0, y=0):
+            return "origin"
+        case Point(x=x, y=0) if x > 0:
+            return f"on the positive x axis at {x}"
+        case [first, *rest]:
+            return f"sequence starting with {first!s} and {len(rest)} more"
+        case {"type": kind, **others}:
+            return f"mapping of {kind} with {sorted(others)}"
+        case Color.RED | Color.GREEN:
+            return "warm"
+        case str() | bytes() as raw:
+            return raw if isinstance(raw, str) else raw.decode()
+        case _:
+            return repr(value)
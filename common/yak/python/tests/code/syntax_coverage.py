@@ -0,0 +1,94 @@
+#!/usr/bin/env python3
+import os, sys as system
+from . import views
+from ..db.models import (User, Group as G,)
+from flask import *
+
+GLOBAL: int = 1
+a = b = [1, 2, *rest]
+x, *y = 1, 2, 3
+a += 1; b **= 2
+d = {"k": v, **other}
+s = {1, 2}
+lc = [i * 2 for i in range(10) if i % 2 if i > 1]
+dc = {k: v for k, v in items.items()}
+sc = {x for x in y}
+g = sum(x for x in y)
+cond = a if b else c
+f = lambda x, *args, y=1, **kw: x + y
+sl = arr[1:2, ::3, ...]
+n = (y := 10)
+chained = 1 < a <= 3 is not None not in b
+neg = -2 ** -1
+call(a, *b, key=1, **c)
+big = 0x_ff + 1_000 + 1.5e-3 + 2j
+
+@app.route("/index", methods=["GET"])
+@login_required
+async def handler(a, b: int = 1, /, c=2, *, d, **kwargs) -> str:
+    global GLOBAL
+    async with session() as s, lock:
+        await s.get(a)
+    async for item in stream():
+        yield item
+    return f"{a!r:>{width}} {{raw}} {b=}"
+
+class Foo(Base, metaclass=Meta):
+    """doc"""
+    attr = 1
+
+    def __init__(self, name):
+        super().__init__()
+        self.name = name
+
+    @staticmethod
+    def create(*args): pass
+
+def gen():
+    nonlocal_value = yield from other()
+    del a[0], b.c
+    assert x, "message"
+    raise ValueError("bad") from err
+
+try:
+    pass
+except (TypeError, ValueError) as e:
+    print(e)
+except Exception:
+    pass
+else:
+    pass
+finally:
+    cleanup()
+
+with (open("a") as f1, open("b") as f2):
+    pass
+
+while True:
+    break
+else:
+    continue
+
+if a:
+    pass
+elif b:
+    pass
+else:
+    pass
+
+match command.split():
+    case [action]:
+        pass
+    case [action, obj] if obj:
+        pass
+    case Point(x=0, y=0) | {"k": 1, **rest}:
+        pass
+    case str() as s:
+        pass
+    case _:
+        pass
+
+match = 1
+type Alias = list[int]
+s = r"\d+" "tail" '''multi
+line'''
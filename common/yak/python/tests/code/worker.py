@@ -0,0 +1,93 @@
+#!/usr/bin/env python3
+import asyncio
+import contextlib
+import logging
+import sys
+from collections import defaultdict
+
+log = logging.getLogger(__name__)
+counter = 0
+stats = defaultdict(int)
+
+
+class RetryError(Exception):
+    pass
+
+
+def retry(times=3, *, delay=0.1, exceptions=(OSError, TimeoutError)):
+    def decorator(func):
+        async def wrapper(*args, **kwargs):
+            last = None
+            for attempt in range(1, times + 1):
+                try:
+                    return await func(*args, **kwargs)
+                except exceptions as err:
+                    last = err
+                    log.warning("attempt %d/%d failed: %s", attempt, times, err)
+                    await asyncio.sleep(delay * 2 ** attempt)
+            raise RetryError(f"gave up after {times} attempts") from last
+
+        return wrapper
+
+    return decorator
+
+
+@retry(times=5)
+async def fetch(reader, writer, line: bytes) -> bytes:
+    writer.write(line + b"\r\n")
+    await writer.drain()
+    return await asyncio.wait_for(reader.readline(), timeout=3)
+
+
+async def consume(queue: asyncio.Queue, name: str) -> None:
+    global counter
+    while (item := await queue.get()) is not None:
+        async with asyncio.timeout(10):
+            counter += 1
+            stats[name] += 1
+        queue.task_done()
+
+
+def make_counter():
+    count = 0
+
+    def inc(step=1):
+        nonlocal count
+        count += step
+        return count
+
+    return inc
+
+
+async def main(argv=None):
+    argv = sys.argv[1:] if argv is None else argv
+    queue = asyncio.Queue(maxsize=100)
+    workers = [asyncio.create_task(consume(queue, f"worker-{i}")) for i in range(4)]
+    async for line in read_lines(argv[0] if argv else "-"):
+        await queue.put(line)
+    for _ in workers:
+        await queue.put(None)
+    results = await asyncio.gather(*workers, return_exceptions=True)
+    with contextlib.suppress(KeyError), open("/dev/null", "w") as sink:
+        print(*results, sep="\n", file=sink)
+    total = sum(stats.values()); assert total == counter, (total, counter)
+    return 0
+
+
+async def read_lines(path):
+    stream = sys.stdin if path == "-" else open(path, encoding="utf-8")
+    try:
+        for line in stream:
+            if not (line := line.strip()) or line.startswith("#"):
+                continue
+            yield line
+    finally:
+        if stream is not sys.stdin:
+            stream.close()
+
+
+if __name__ == "__main__":
+    try:
+        sys.exit(asyncio.run(main()))
+    except* (KeyboardInterrupt, SystemExit):
+        pass
@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	test "github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestFunction(t *testing.T) {
+	t.Run("call", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+def add(a, b=2):
+    return a + b
+println(add(1))
+`, []string{"Function-add(1)"}, t)
+	})
+
+	t.Run("local variable", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = 1
+def f():
+    a = 2
+f()
+println(a)
+`, []string{"1"}, t)
+	})
+
+	t.Run("global variable", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = 1
+def f():
+    global a
+    a = 2
+f()
+println(a)
+`, []string{"side-effect(2, a)"}, t)
+	})
+
+	t.Run("closure", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+a = 1
+def f():
+    println(a)
+`, []string{"FreeValue-a"}, t)
+	})
+
+	t.Run("lambda", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+f = lambda x: x + 1
+println(f(1))
+`, []string{"Function-f(1)"}, t)
+	})
+}
+
+func TestClass(t *testing.T) {
+	t.Run("constructor", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+class A:
+    def __init__(self, a):
+        self.a = a
+
+    def get(self):
+        return self.a
+
+obj = A(1)
+println(obj.a)
+`, []string{"side-effect(Parameter-a, self.a)"}, t)
+	})
+
+	t.Run("method", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+class A:
+    def __init__(self, a):
+        self.a = a
+
+    def get(self):
+        return self.a
+
+println(A(1).get())
+`, []string{"Function-A.get(Function-A.A(A,1)) member[side-effect(Parameter-a, self.a)]"}, t)
+	})
+
+	t.Run("method called with class", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+class A:
+    def __init__(self, a):
+        self.a = a
+
+    def get(self):
+        return self.a
+
+obj = A(1)
+println(A.get(obj))
+`, []string{"Function-A.get(Function-A.A(A,1)) member[side-effect(Parameter-a, self.a)]"}, t)
+	})
+
+	t.Run("inherited method", func(t *testing.T) {
+		test.CheckSyntaxFlow(t, `
+class A:
+    def get(self):
+        return self.a
+
+class B(A):
+    def __init__(self, a):
+        self.a = a
+
+    def twice(self):
+        return self.get()
+
+println(B(1).twice())
+`, `println(* #-> * as $value)`, map[string][]string{
+			"value": {"1"},
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+
+	t.Run("class attribute", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+class A:
+    name = "a"
+
+println(A.name)
+`, []string{`"a"`}, t)
+	})
+}
+
+func TestDecorator(t *testing.T) {
+	t.Run("stacked decorators", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+def log(f):
+    return f
+
+def wrap(f):
+    return f
+
+@log
+@wrap
+def add(a):
+    return a
+
+println(add)
+println(add(1))
+`, []string{
+			"Function-log(Function-wrap(Function-add))",
+			"Function-log(Function-wrap(Function-add))(1)",
+		}, t)
+	})
+
+	t.Run("class decorator", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+def register(cls):
+    return cls
+
+@register
+class A:
+    pass
+
+println(A)
+`, []string{"Function-register(A)"}, t)
+	})
+
+	t.Run("decorator with arguments", func(t *testing.T) {
+		test.CheckSyntaxFlow(t, `
+import os
+from flask import Flask, request
+
+app = Flask(__name__)
+
+@app.route("/cmd")
+def cmd():
+    os.system(request.args.get("c"))
+`, `
+app.route(* as $route)
+request.args.get(* as $param)
+`, map[string][]string{
+			"route": {`"/cmd"`},
+			"param": {`"c"`},
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+}
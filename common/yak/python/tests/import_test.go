@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestImport(t *testing.T) {
+	t.Run("third-party module", func(t *testing.T) {
+		ssatest.CheckSyntaxFlow(t, `
+import os
+from subprocess import call as run
+
+os.system("ls")
+run("id")
+`, `
+os.system(* as $system)
+subprocess.call(* as $call)
+`, map[string][]string{
+			"system": {`"ls"`},
+			"call":   {`"id"`},
+		}, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+
+	t.Run("project module", func(t *testing.T) {
+		fs := filesys.NewVirtualFs()
+		fs.AddFile("app/utils/__init__.py", ``)
+		fs.AddFile("app/utils/db.py", `
+import sqlite3
+
+def query(sql):
+    conn = sqlite3.connect("a.db")
+    return conn.execute(sql)
+`)
+		fs.AddFile("app/__init__.py", ``)
+		fs.AddFile("app/main.py", `
+from .utils.db import query
+
+query("select * from users")
+`)
+		ssatest.CheckSyntaxFlowWithFS(t, fs, `
+execute(* as $sql)
+$sql #-> as $source
+`, map[string][]string{
+			"source": {`"select * from users"`},
+		}, true, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+
+	t.Run("package import", func(t *testing.T) {
+		fs := filesys.NewVirtualFs()
+		fs.AddFile("app/__init__.py", ``)
+		fs.AddFile("app/utils/__init__.py", ``)
+		fs.AddFile("app/utils/db.py", `
+import os
+
+def run(cmd):
+    os.system(cmd)
+`)
+		fs.AddFile("main.py", `
+import app.utils.db
+from app.utils import db
+import app.utils.db as database
+
+app.utils.db.run("a")
+db.run("b")
+database.run("c")
+`)
+		ssatest.CheckSyntaxFlowWithFS(t, fs, `
+os.system(* #-> as $cmd)
+`, map[string][]string{
+			"cmd": {`"a"`, `"b"`, `"c"`},
+		}, true, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+
+	t.Run("import module object", func(t *testing.T) {
+		fs := filesys.NewVirtualFs()
+		fs.AddFile("lib.py", `
+def run(cmd):
+    import os
+    os.system(cmd)
+`)
+		fs.AddFile("main.py", `
+import lib
+
+lib.run("whoami")
+`)
+		ssatest.CheckSyntaxFlowWithFS(t, fs, `
+os.system(* #-> as $cmd)
+`, map[string][]string{
+			"cmd": {`"whoami"`},
+		}, true, ssaapi.WithLanguage(ssaapi.PYTHON))
+	})
+}
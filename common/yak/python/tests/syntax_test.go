@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/python/python2ssa"
+)
+
+//go:embed code
+var codeFs embed.FS
+
+func validateSource(t *testing.T, filename string, src string) {
+	t.Run(fmt.Sprintf("syntax file: %v", filename), func(t *testing.T) {
+		_, err := python2ssa.Frontend(src, false)
+		require.Nil(t, err, "parse AST FrontEnd error : %v", err)
+	})
+}
+
+func TestAllSyntaxForPython(t *testing.T) {
+	entry, err := codeFs.ReadDir("code")
+	if err != nil {
+		t.Fatalf("no embed syntax files found: %v", err)
+	}
+	for _, f := range entry {
+		if f.IsDir() {
+			continue
+		}
+		codePath := path.Join("code", f.Name())
+		if !strings.HasSuffix(codePath, ".py") {
+			continue
+		}
+		raw, err := codeFs.ReadFile(codePath)
+		if err != nil {
+			t.Fatalf("cannot found syntax fs: %v", codePath)
+		}
+		validateSource(t, codePath, string(raw))
+	}
+}
+
+func TestBadSyntax(t *testing.T) {
+	for name, src := range map[string]string{
+		"missing colon":         "if a\n    pass\n",
+		"unclosed bracket":      "a = (1,\nb = 2\n",
+		"unexpected indent":     "a = 1\n    b = 2\n",
+		"inconsistent dedent":   "if a:\n        b = 1\n    c = 2\n",
+		"expected indent":       "def f():\nreturn 1\n",
+		"python2 print":         "print 'hello'\n",
+		"python2 not equal":     "a = 1 <> 2\n",
+		"keyword as target":     "class = 1\n",
+		"assign to literal":     "1 = a\n",
+		"incomplete expression": "a = 1 +\n",
+		"unterminated string":   "a = 'abc\n",
+		"unclosed f-string":     "a = f'{name'\n",
+		"else without if":       "else:\n    pass\n",
+		"bad parameter":         "def f(a, :\n    pass\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := python2ssa.Frontend(src, false)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package tests
+
+import (
+	"github.com/yaklang/yaklang/common/yak/python/python2ssa"
+	test "github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func init() {
+	test.SetLanguage("python", python2ssa.Builder)
+}
@@ -0,0 +1,186 @@
+/*
+ * Rust lexer, following the lexical structure of the Rust reference.
+ *
+ * `<` and `>` are always single tokens so that the closing brackets of nested generics
+ * (`Vec<Vec<u8>>`) are not a shift, the parser composes `<<`, `>>`, `<=`, `>=`, `<<=`
+ * and `>>=` from them. Whitespace and comments are SKIP_ tokens dropped by RustLexerBase
+ * (parser/base.go), which also skips the shebang line.
+ */
+
+lexer grammar RustLexer;
+
+options {
+    superClass = RustLexerBase;
+}
+
+// strict keywords
+KW_AS       : 'as';
+KW_ASYNC    : 'async';
+KW_AWAIT    : 'await';
+KW_BREAK    : 'break';
+KW_CONST    : 'const';
+KW_CONTINUE : 'continue';
+KW_CRATE    : 'crate';
+KW_DYN      : 'dyn';
+KW_ELSE     : 'else';
+KW_ENUM     : 'enum';
+KW_EXTERN   : 'extern';
+KW_FALSE    : 'false';
+KW_FN       : 'fn';
+KW_FOR      : 'for';
+KW_IF       : 'if';
+KW_IMPL     : 'impl';
+KW_IN       : 'in';
+KW_LET      : 'let';
+KW_LOOP     : 'loop';
+KW_MATCH    : 'match';
+KW_MOD      : 'mod';
+KW_MOVE     : 'move';
+KW_MUT      : 'mut';
+KW_PUB      : 'pub';
+KW_REF      : 'ref';
+KW_RETURN   : 'return';
+KW_SELFVALUE: 'self';
+KW_SELFTYPE : 'Self';
+KW_STATIC   : 'static';
+KW_STRUCT   : 'struct';
+KW_SUPER    : 'super';
+KW_TRAIT    : 'trait';
+KW_TRUE     : 'true';
+KW_TYPE     : 'type';
+KW_UNSAFE   : 'unsafe';
+KW_USE      : 'use';
+KW_WHERE    : 'where';
+KW_WHILE    : 'while';
+
+// weak keywords, they are identifiers everywhere else
+KW_MACRORULES: 'macro_rules';
+KW_UNION     : 'union';
+KW_RAW       : 'raw';
+KW_SAFE      : 'safe';
+KW_AUTO      : 'auto';
+
+NON_KEYWORD_IDENTIFIER: ID_START ID_CONTINUE* | '_' ID_CONTINUE+;
+
+RAW_IDENTIFIER: 'r#' (ID_START | '_') ID_CONTINUE*;
+
+CHAR_LITERAL: '\'' (~['\\\r\n\t] | ESCAPE) '\'';
+
+STRING_LITERAL: '"' (~["\\] | ESCAPE | STRING_CONTINUE)* '"';
+
+RAW_STRING_LITERAL: 'r' RAW_STRING_CONTENT;
+
+BYTE_LITERAL: 'b\'' (~['\\\r\n\t] | ESCAPE) '\'';
+
+BYTE_STRING_LITERAL: 'b"' (~["\\] | ESCAPE | STRING_CONTINUE)* '"';
+
+RAW_BYTE_STRING_LITERAL: 'br' RAW_STRING_CONTENT;
+
+C_STRING_LITERAL: 'c"' (~["\\] | ESCAPE | STRING_CONTINUE)* '"';
+
+RAW_C_STRING_LITERAL: 'cr' RAW_STRING_CONTENT;
+
+INTEGER_LITERAL: (DEC_LITERAL | BIN_LITERAL | OCT_LITERAL | HEX_LITERAL) INTEGER_SUFFIX?;
+
+// `1.` is a float for the parser, here the dot needs digits after it so that `1..2`,
+// `1.max(2)` and the tuple indexes `t.0` are not floats. `t.0.1` is `t` `.` `0.1`.
+FLOAT_LITERAL
+    : DEC_LITERAL '.' DEC_LITERAL FLOAT_EXPONENT? FLOAT_SUFFIX?
+    | DEC_LITERAL FLOAT_EXPONENT FLOAT_SUFFIX?
+    | DEC_LITERAL FLOAT_SUFFIX
+    ;
+
+LIFETIME_OR_LABEL: '\'' (ID_START | '_') ID_CONTINUE*;
+
+PLUS          : '+';
+MINUS         : '-';
+STAR          : '*';
+SLASH         : '/';
+PERCENT       : '%';
+CARET         : '^';
+NOT           : '!';
+AND           : '&';
+OR            : '|';
+ANDAND        : '&&';
+OROR          : '||';
+PLUSEQ        : '+=';
+MINUSEQ       : '-=';
+STAREQ        : '*=';
+SLASHEQ       : '/=';
+PERCENTEQ     : '%=';
+CARETEQ       : '^=';
+ANDEQ         : '&=';
+OREQ          : '|=';
+EQ            : '=';
+EQEQ          : '==';
+NE            : '!=';
+GT            : '>';
+LT            : '<';
+AT            : '@';
+UNDERSCORE    : '_';
+DOT           : '.';
+DOTDOT        : '..';
+DOTDOTDOT     : '...';
+DOTDOTEQ      : '..=';
+COMMA         : ',';
+SEMI          : ';';
+COLON         : ':';
+PATHSEP       : '::';
+RARROW        : '->';
+FATARROW      : '=>';
+POUND         : '#';
+DOLLAR        : '$';
+QUESTION      : '?';
+TILDE         : '~';
+LCURLYBRACE   : '{';
+RCURLYBRACE   : '}';
+LSQUAREBRACKET: '[';
+RSQUAREBRACKET: ']';
+LPAREN        : '(';
+RPAREN        : ')';
+
+// whitespace and comments, doc comments included
+SKIP_: WHITESPACE | LINE_COMMENT | BLOCK_COMMENT;
+
+UNKNOWN_CHAR: .;
+
+fragment WHITESPACE: [ \t\r\n\f\u000B\u0085\u200E\u200F\u2028\u2029]+;
+
+fragment LINE_COMMENT: '//' ~[\r\n]*;
+
+// block comments nest
+fragment BLOCK_COMMENT: '/*' (BLOCK_COMMENT | .)*? '*/';
+
+fragment ESCAPE
+    : '\\' [nrt\\0'"]
+    | '\\x' HEX_DIGIT HEX_DIGIT
+    | '\\u{' HEX_DIGIT (HEX_DIGIT | '_')* '}'
+    ;
+
+// a backslash at the end of a line skips the newline and the leading whitespace
+fragment STRING_CONTINUE: '\\' '\r'? '\n';
+
+// r"...", r#"..."#, r##"..."##...
+fragment RAW_STRING_CONTENT: '#' RAW_STRING_CONTENT '#' | '"' .*? '"';
+
+fragment INTEGER_SUFFIX: 'u8' | 'u16' | 'u32' | 'u64' | 'u128' | 'usize' | 'i8' | 'i16' | 'i32' | 'i64' | 'i128' | 'isize';
+
+fragment FLOAT_SUFFIX: 'f32' | 'f64';
+
+fragment FLOAT_EXPONENT: [eE] [+-]? '_'* DEC_LITERAL;
+
+fragment DEC_LITERAL: DEC_DIGIT (DEC_DIGIT | '_')*;
+
+fragment BIN_LITERAL: '0b' '_'* [01] [01_]*;
+
+fragment OCT_LITERAL: '0o' '_'* [0-7] [0-7_]*;
+
+fragment HEX_LITERAL: '0x' '_'* HEX_DIGIT (HEX_DIGIT | '_')*;
+
+fragment DEC_DIGIT: [0-9];
+
+fragment HEX_DIGIT: [0-9a-fA-F];
+
+fragment ID_START: [\p{L}\p{Nl}];
+
+fragment ID_CONTINUE: [\p{L}\p{Nl}\p{Mn}\p{Mc}\p{Nd}\p{Pc}];
@@ -0,0 +1,434 @@
+/*
+ * Rust parser, following the syntax chapters of the Rust reference (edition 2021).
+ *
+ * Struct expressions are not excluded from the conditions of if, while, for and match,
+ * the adaptive prediction only takes `S { .. }` as a struct when a block still follows.
+ * `a.f(x)` is a call of the field expression `a.f`, rust2ssa builds it as a method call.
+ * Macro invocations are kept as token trees, rust2ssa parses their arguments with
+ * macroArgument.
+ */
+
+parser grammar RustParser;
+
+options {
+    tokenVocab = RustLexer;
+}
+
+crate: innerAttribute* item* EOF;
+
+// an argument of a macro invocation, e.g. each of `"{}", a` in `println!("{}", a)`
+macroArgument: expression EOF;
+
+// ============================== items ==============================
+
+item: outerAttribute* (visItem | macroItem);
+
+visItem
+    : visibility? (
+        module
+        | externCrate
+        | useDeclaration
+        | function_
+        | typeAlias
+        | struct_
+        | enumeration
+        | union_
+        | constantItem
+        | staticItem
+        | trait_
+        | implementation
+        | externBlock
+    )
+    ;
+
+macroItem: macroInvocationSemi | macroRulesDefinition;
+
+module: KW_UNSAFE? KW_MOD identifier (';' | '{' innerAttribute* item* '}');
+
+externCrate: KW_EXTERN KW_CRATE (identifier | KW_SELFVALUE) (KW_AS (identifier | '_'))? ';';
+
+useDeclaration: KW_USE useTree ';';
+
+useTree
+    : (simplePath? '::')? '*'
+    | (simplePath? '::')? '{' (useTree (',' useTree)* ','?)? '}'
+    | simplePath (KW_AS (identifier | '_'))?
+    ;
+
+function_
+    : functionQualifiers KW_FN identifier genericParams? '(' functionParameters? ')' functionReturnType? whereClause? (
+        blockExpression
+        | ';'
+    )
+    ;
+
+functionQualifiers: KW_CONST? KW_ASYNC? (KW_UNSAFE | KW_SAFE)? (KW_EXTERN abi?)?;
+
+abi: STRING_LITERAL | RAW_STRING_LITERAL;
+
+functionParameters: selfParam ','? | (selfParam ',')? functionParam (',' functionParam)* ','?;
+
+selfParam: outerAttribute* (shorthandSelf | typedSelf);
+
+shorthandSelf: ('&' LIFETIME_OR_LABEL?)? KW_MUT? KW_SELFVALUE;
+
+typedSelf: KW_MUT? KW_SELFVALUE ':' type_;
+
+functionParam: outerAttribute* (pattern ':' (type_ | '...') | '...' | type_);
+
+functionReturnType: '->' type_;
+
+typeAlias: KW_TYPE identifier genericParams? (':' typeParamBounds)? whereClause? ('=' type_ whereClause?)? ';';
+
+struct_
+    : KW_STRUCT identifier genericParams? whereClause? ('{' structFields? '}' | ';')
+    | KW_STRUCT identifier genericParams? '(' tupleFields? ')' whereClause? ';'
+    ;
+
+structFields: structField (',' structField)* ','?;
+
+structField: outerAttribute* visibility? identifier ':' type_;
+
+tupleFields: tupleField (',' tupleField)* ','?;
+
+tupleField: outerAttribute* visibility? type_;
+
+enumeration: KW_ENUM identifier genericParams? whereClause? '{' enumItems? '}';
+
+enumItems: enumItem (',' enumItem)* ','?;
+
+enumItem: outerAttribute* visibility? identifier ('{' structFields? '}' | '(' tupleFields? ')')? ('=' expression)?;
+
+union_: KW_UNION identifier genericParams? whereClause? '{' structFields? '}';
+
+constantItem: KW_CONST (identifier | '_') ':' type_ ('=' expression)? ';';
+
+staticItem: KW_STATIC KW_MUT? identifier ':' type_ ('=' expression)? ';';
+
+trait_
+    : KW_UNSAFE? KW_AUTO? KW_TRAIT identifier genericParams? (':' typeParamBounds?)? whereClause? '{' innerAttribute* associatedItem* '}'
+    ;
+
+implementation
+    : KW_UNSAFE? KW_IMPL genericParams? KW_CONST? ('!'? typePath KW_FOR)? type_ whereClause? '{' innerAttribute* associatedItem* '}'
+    ;
+
+associatedItem: outerAttribute* (macroInvocationSemi | visibility? (typeAlias | constantItem | function_));
+
+externBlock: KW_UNSAFE? KW_EXTERN abi? '{' innerAttribute* externalItem* '}';
+
+externalItem: outerAttribute* (macroInvocationSemi | visibility? ((KW_SAFE | KW_UNSAFE)? staticItem | function_));
+
+visibility: KW_PUB ('(' (KW_CRATE | KW_SELFVALUE | KW_SUPER | KW_IN simplePath) ')')?;
+
+// ============================== generics ==============================
+
+genericParams: '<' (genericParam (',' genericParam)* ','?)? '>';
+
+genericParam: outerAttribute* (lifetimeParam | typeParam | constParam);
+
+lifetimeParam: LIFETIME_OR_LABEL (':' lifetimeBounds)?;
+
+typeParam: identifier (':' typeParamBounds?)? ('=' type_)?;
+
+constParam: KW_CONST identifier ':' type_ ('=' (blockExpression | identifier | '-'? literalExpression))?;
+
+whereClause: KW_WHERE (whereClauseItem (',' whereClauseItem)* ','?)?;
+
+whereClauseItem: LIFETIME_OR_LABEL ':' lifetimeBounds | forLifetimes? type_ ':' typeParamBounds?;
+
+forLifetimes: KW_FOR genericParams;
+
+lifetimeBounds: LIFETIME_OR_LABEL ('+' LIFETIME_OR_LABEL)* '+'?;
+
+typeParamBounds: typeParamBound ('+' typeParamBound)* '+'?;
+
+typeParamBound
+    : LIFETIME_OR_LABEL
+    | ('?' | '~' KW_CONST)? forLifetimes? typePath
+    | '(' ('?' | '~' KW_CONST)? forLifetimes? typePath ')'
+    | KW_USE genericArgs
+    ;
+
+// ============================== attributes and macros ==============================
+
+innerAttribute: '#' '!' '[' attr ']';
+
+outerAttribute: '#' '[' attr ']';
+
+attr: (simplePath | KW_UNSAFE) (delimTokenTree | '=' expression)?;
+
+macroInvocation: simplePath '!' delimTokenTree;
+
+macroInvocationSemi: simplePath '!' delimTokenTree ';'?;
+
+macroRulesDefinition: KW_MACRORULES '!' identifier delimTokenTree ';'?;
+
+delimTokenTree: '(' tokenTree* ')' | '[' tokenTree* ']' | '{' tokenTree* '}';
+
+tokenTree: ~('(' | ')' | '[' | ']' | '{' | '}') | delimTokenTree;
+
+// ============================== statements ==============================
+
+statement
+    : ';'
+    | outerAttribute* visItem
+    | outerAttribute* macroRulesDefinition
+    | letStatement
+    | outerAttribute* expressionWithBlock
+    | outerAttribute* simplePath '!' '{' tokenTree* '}'
+    | outerAttribute* expression ';'
+    ;
+
+letStatement: outerAttribute* KW_LET pattern (':' type_)? ('=' expression (KW_ELSE blockExpression)?)? ';';
+
+// ============================== expressions ==============================
+
+expression
+    : literalExpression                                                 # LiteralExpr
+    | pathExpression                                                    # PathExpr
+    | expression '.' pathExprSegment                                    # FieldExpr
+    | expression '.' tupleIndex                                         # TupleIndexExpr
+    | expression '.' KW_AWAIT                                           # AwaitExpr
+    | expression '(' callParams? ')'                                    # CallExpr
+    | expression '[' expression ']'                                     # IndexExpr
+    | expression '?'                                                    # TryExpr
+    | ('&' | '&&') (KW_MUT | KW_RAW (KW_CONST | KW_MUT))? expression    # BorrowExpr
+    | '*' expression                                                    # DerefExpr
+    | op = ('-' | '!') expression                                       # UnaryExpr
+    | expression KW_AS typeNoBounds                                     # CastExpr
+    | expression op = ('*' | '/' | '%') expression                      # MultiplicativeExpr
+    | expression op = ('+' | '-') expression                            # AdditiveExpr
+    | expression shiftOperator expression                               # ShiftExpr
+    | expression '&' expression                                         # BitAndExpr
+    | expression '^' expression                                         # BitXorExpr
+    | expression '|' expression                                         # BitOrExpr
+    | expression comparisonOperator expression                          # ComparisonExpr
+    | expression '&&' expression                                        # LogicalAndExpr
+    | expression '||' expression                                        # LogicalOrExpr
+    | expression '..' expression                                        # RangeExpr
+    | expression '..'                                                   # RangeExpr
+    | '..' expression                                                   # RangeToExpr
+    | '..'                                                              # RangeToExpr
+    | expression '..=' expression                                       # RangeInclusiveExpr
+    | '..=' expression                                                  # RangeToInclusiveExpr
+    | <assoc = right> expression '=' expression                         # AssignExpr
+    | <assoc = right> expression compoundAssignOperator expression      # CompoundAssignExpr
+    | KW_CONTINUE LIFETIME_OR_LABEL?                                    # ContinueExpr
+    | KW_BREAK LIFETIME_OR_LABEL? expression                            # BreakExpr
+    | KW_BREAK LIFETIME_OR_LABEL?                                       # BreakExpr
+    | KW_RETURN expression                                              # ReturnExpr
+    | KW_RETURN                                                         # ReturnExpr
+    | KW_ASYNC? KW_MOVE? closureParameterList ('->' typeNoBounds)? expression # ClosureExpr
+    | '(' innerAttribute* expression ')'                                # GroupedExpr
+    | '[' innerAttribute* arrayElements? ']'                            # ArrayExpr
+    | '(' innerAttribute* tupleElements? ')'                            # TupleExpr
+    | structExpression                                                  # StructExpr
+    | expressionWithBlock                                               # BlockLikeExpr
+    | macroInvocation                                                   # MacroExpr
+    | '_'                                                               # UnderscoreExpr
+    ;
+
+shiftOperator: '<' '<' | '>' '>';
+
+comparisonOperator: '==' | '!=' | '>' | '<' | '>' '=' | '<' '=';
+
+compoundAssignOperator: '+=' | '-=' | '*=' | '/=' | '%=' | '&=' | '|=' | '^=' | '<' '<' '=' | '>' '>' '=';
+
+expressionWithBlock
+    : loopLabel? blockExpression
+    | KW_UNSAFE blockExpression
+    | KW_ASYNC KW_MOVE? blockExpression
+    | KW_CONST blockExpression
+    | loopLabel? loopExpression
+    | ifExpression
+    | matchExpression
+    ;
+
+literalExpression
+    : CHAR_LITERAL
+    | STRING_LITERAL
+    | RAW_STRING_LITERAL
+    | BYTE_LITERAL
+    | BYTE_STRING_LITERAL
+    | RAW_BYTE_STRING_LITERAL
+    | C_STRING_LITERAL
+    | RAW_C_STRING_LITERAL
+    | INTEGER_LITERAL '.'?
+    | FLOAT_LITERAL
+    | KW_TRUE
+    | KW_FALSE
+    ;
+
+pathExpression: pathInExpression | qualifiedPathInExpression;
+
+blockExpression: '{' innerAttribute* statement* (outerAttribute* expression)? '}';
+
+arrayElements: outerAttribute* expression (',' outerAttribute* expression)* ','? | expression ';' expression;
+
+tupleElements: (outerAttribute* expression ',')+ (outerAttribute* expression)?;
+
+tupleIndex: INTEGER_LITERAL | FLOAT_LITERAL;
+
+structExpression: pathInExpression '{' innerAttribute* (structExprFields | structBase)? '}';
+
+structExprFields: structExprField (',' structExprField)* (',' structBase | ','?);
+
+structExprField: outerAttribute* (identifier | (identifier | tupleIndex) ':' expression);
+
+structBase: '..' expression;
+
+callParams: outerAttribute* expression (',' outerAttribute* expression)* ','?;
+
+closureParameterList: '||' | '|' closureParameters? '|';
+
+closureParameters: closureParam (',' closureParam)* ','?;
+
+closureParam: outerAttribute* patternNoTopAlt (':' type_)?;
+
+loopExpression
+    : KW_LOOP blockExpression                                # InfiniteLoop
+    | KW_WHILE conditions blockExpression                    # WhileLoop
+    | KW_FOR pattern KW_IN expression blockExpression        # ForLoop
+    ;
+
+loopLabel: LIFETIME_OR_LABEL ':';
+
+// the scrutinee of `let` takes the `&&` chain that follows it, rust2ssa splits it again
+conditions: (expression | letCondition) ('&&' letCondition)*;
+
+letCondition: KW_LET pattern '=' expression;
+
+ifExpression: KW_IF conditions blockExpression (KW_ELSE (blockExpression | ifExpression))?;
+
+matchExpression: KW_MATCH expression '{' innerAttribute* matchArm* '}';
+
+matchArm: outerAttribute* pattern (KW_IF expression)? '=>' expression ','?;
+
+// ============================== patterns ==============================
+
+pattern: '|'? patternNoTopAlt ('|' patternNoTopAlt)*;
+
+patternNoTopAlt
+    : rangePatternBound ('..=' | '...') rangePatternBound           # InclusiveRangePattern
+    | rangePatternBound '..' rangePatternBound?                     # ExclusiveRangePattern
+    | '..=' rangePatternBound                                       # ObsoleteRangePattern
+    | KW_REF? KW_MUT? identifier ('@' patternNoTopAlt)?             # IdentifierPattern
+    | '_'                                                           # WildcardPattern
+    | '..'                                                          # RestPattern
+    | ('&' | '&&') KW_MUT? patternNoTopAlt                          # ReferencePattern
+    | '(' (pattern (',' pattern)* ','?)? ')'                        # TuplePattern
+    | '[' (pattern (',' pattern)* ','?)? ']'                        # SlicePattern
+    | pathInExpression '{' structPatternElements? '}'               # StructPattern
+    | pathInExpression '(' (pattern (',' pattern)* ','?)? ')'       # TupleStructPattern
+    | pathPattern                                                   # PathPattern_
+    | literalPattern                                                # LiteralPattern_
+    | macroInvocation                                               # MacroPattern
+    ;
+
+// single names are identifier patterns, rust2ssa takes the capitalized ones as paths
+pathPattern
+    : qualifiedPathInExpression
+    | '::'? pathExprSegment ('::' pathExprSegment)+
+    | '::' pathExprSegment
+    | KW_SELFTYPE
+    | KW_CRATE
+    | KW_SUPER
+    ;
+
+literalPattern
+    : KW_TRUE
+    | KW_FALSE
+    | CHAR_LITERAL
+    | BYTE_LITERAL
+    | STRING_LITERAL
+    | RAW_STRING_LITERAL
+    | BYTE_STRING_LITERAL
+    | RAW_BYTE_STRING_LITERAL
+    | C_STRING_LITERAL
+    | RAW_C_STRING_LITERAL
+    | '-'? INTEGER_LITERAL
+    | '-'? FLOAT_LITERAL
+    ;
+
+rangePatternBound
+    : CHAR_LITERAL
+    | BYTE_LITERAL
+    | '-'? INTEGER_LITERAL
+    | '-'? FLOAT_LITERAL
+    | pathInExpression
+    | qualifiedPathInExpression
+    ;
+
+structPatternElements
+    : structPatternField (',' structPatternField)* (',' structPatternEtCetera?)?
+    | structPatternEtCetera
+    ;
+
+structPatternField
+    : outerAttribute* (tupleIndex ':' pattern | identifier ':' pattern | KW_REF? KW_MUT? identifier)
+    ;
+
+structPatternEtCetera: outerAttribute* '..';
+
+// ============================== types ==============================
+
+type_: typeNoBounds | (KW_IMPL | KW_DYN) typeParamBound ('+' typeParamBound)+;
+
+typeNoBounds
+    : '(' type_ ')'                                                  # ParenthesizedType
+    | '(' (type_ ',' (type_ (',' type_)* ','?)?)? ')'                # TupleType
+    | '!'                                                            # NeverType
+    | '*' (KW_MUT | KW_CONST) typeNoBounds                           # RawPointerType
+    | ('&' | '&&') LIFETIME_OR_LABEL? KW_MUT? typeNoBounds           # ReferenceType
+    | '[' type_ ']'                                                  # SliceType
+    | '[' type_ ';' expression ']'                                   # ArrayType
+    | '_'                                                            # InferredType
+    | (KW_IMPL | KW_DYN) typeParamBound                              # TraitObjectType
+    | forLifetimes? functionQualifiers KW_FN '(' bareFunctionParams? ')' bareFunctionReturnType?   # BareFunctionType
+    | macroInvocation                                                # MacroType
+    | qualifiedPathInType                                            # QualifiedPathType_
+    | typePath                                                       # TypePath_
+    ;
+
+bareFunctionParams: bareFunctionParam (',' bareFunctionParam)* (',' outerAttribute* '...')? ','?;
+
+bareFunctionParam: outerAttribute* ((identifier | '_') ':')? type_;
+
+bareFunctionReturnType: '->' typeNoBounds;
+
+// ============================== paths ==============================
+
+simplePath: '::'? simplePathSegment ('::' simplePathSegment)*;
+
+simplePathSegment: identifier | KW_SUPER | KW_SELFVALUE | KW_CRATE | '$' KW_CRATE;
+
+pathInExpression: '::'? pathExprSegment ('::' pathExprSegment)*;
+
+pathExprSegment: pathIdentSegment ('::' genericArgs)?;
+
+pathIdentSegment: identifier | KW_SUPER | KW_SELFVALUE | KW_SELFTYPE | KW_CRATE | '$' KW_CRATE;
+
+genericArgs: '<' (genericArg (',' genericArg)* ','?)? '>';
+
+genericArg
+    : LIFETIME_OR_LABEL
+    | identifier genericArgs? ('=' type_ | ':' typeParamBounds)
+    | type_
+    | blockExpression
+    | '-'? literalExpression
+    ;
+
+qualifiedPathInExpression: qualifiedPathType ('::' pathExprSegment)+;
+
+qualifiedPathType: '<' type_ (KW_AS typePath)? '>';
+
+qualifiedPathInType: qualifiedPathType ('::' typePathSegment)+;
+
+typePath: '::'? typePathSegment ('::' typePathSegment)*;
+
+typePathSegment: pathIdentSegment ('::'? (genericArgs | typePathFn))?;
+
+typePathFn: '(' (type_ (',' type_)* ','?)? ')' ('->' typeNoBounds)?;
+
+identifier: NON_KEYWORD_IDENTIFIER | RAW_IDENTIFIER | KW_MACRORULES | KW_UNION | KW_RAW | KW_SAFE | KW_AUTO;
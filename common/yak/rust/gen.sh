@@ -0,0 +1,5 @@
+#!/bin/sh
+
+rm ./parser/*.tokens
+rm ./parser/*.interp
+antlr -Dlanguage=Go -package rustparser ./RustLexer.g4 ./RustParser.g4 -o parser -no-listener -visitor
\ No newline at end of file
@@ -0,0 +1,49 @@
+package rustparser
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+)
+
+// RustLexerBase drops the whitespace and comment tokens of the lexer and skips the shebang
+// line of a script. The comments are not on a hidden channel: a lexer action makes every
+// character of a comment a new state of the DFA.
+type RustLexerBase struct {
+	*antlr.BaseLexer
+
+	started bool
+}
+
+func (l *RustLexerBase) NextToken() antlr.Token {
+	if !l.started {
+		l.started = true
+		l.skipShebang()
+	}
+	for {
+		token := l.BaseLexer.NextToken()
+		if token.GetTokenType() != RustLexerSKIP_ {
+			return token
+		}
+	}
+}
+
+// SetStartPosition makes the tokens of a lexer over a part of a file report the line and
+// column of that part inside the file.
+func (l *RustLexerBase) SetStartPosition(line, column int) {
+	if interpreter, ok := l.Interpreter.(*antlr.LexerATNSimulator); ok {
+		interpreter.Line = line
+		interpreter.CharPositionInLine = column
+	}
+	// a part of a file never starts with a shebang
+	l.started = true
+}
+
+// skipShebang skips `#!/usr/bin/env ...` on the first line, `#![attr]` is an inner attribute.
+func (l *RustLexerBase) skipShebang() {
+	input := l.GetInputStream()
+	if input.LA(1) != '#' || input.LA(2) != '!' || input.LA(3) == '[' {
+		return
+	}
+	for c := input.LA(1); c != antlr.TokenEOF && c != '\n'; c = input.LA(1) {
+		l.Interpreter.Consume(input)
+	}
+}
@@ -0,0 +1,314 @@
+package rust2ssa
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/utils"
+	rustparser "github.com/yaklang/yaklang/common/yak/rust/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// unsafeMarker is the name every call inside an `unsafe` block or function is recorded with,
+// rules can find them with `unsafe as $calls`.
+const unsafeMarker = "unsafe"
+
+var binOpTbl = map[string]ssa.BinaryOpcode{
+	"+":  ssa.OpAdd,
+	"-":  ssa.OpSub,
+	"*":  ssa.OpMul,
+	"/":  ssa.OpDiv,
+	"%":  ssa.OpMod,
+	"&":  ssa.OpAnd,
+	"|":  ssa.OpOr,
+	"^":  ssa.OpXor,
+	"<<": ssa.OpShl,
+	">>": ssa.OpShr,
+	"==": ssa.OpEq,
+	"!=": ssa.OpNotEq,
+	"<":  ssa.OpLt,
+	"<=": ssa.OpLtEq,
+	">":  ssa.OpGt,
+	">=": ssa.OpGtEq,
+	"&&": ssa.OpLogicAnd,
+	"||": ssa.OpLogicOr,
+}
+
+var unaryOpTbl = map[string]ssa.UnaryOpcode{
+	"-": ssa.OpNeg,
+	"!": ssa.OpNot,
+}
+
+// transparentWrappers are the enum constructors of the prelude, `Some(x)` is x for the data flow.
+var transparentWrappers = map[string]struct{}{
+	"Some": {},
+	"Ok":   {},
+	"Err":  {},
+}
+
+// emitCall emits a call, calls inside unsafe code are recorded with the unsafe marker.
+func (b *builder) emitCall(callee ssa.Value, args []ssa.Value) ssa.Value {
+	if fn, ok := ssa.ToFunction(callee); ok {
+		// the parameters of the callee are bound at the call site
+		fn.Build()
+	}
+	call := b.EmitCall(b.NewCall(callee, args))
+	if utils.IsNil(call) {
+		return b.EmitUndefined("")
+	}
+	if b.unsafeDepth > 0 {
+		b.GetProgram().Cache.AddVariable(unsafeMarker, call)
+	}
+	if fn, ok := ssa.ToFunction(callee); ok {
+		if blueprint, ok := b.returnTypes[fn]; ok {
+			call.SetType(blueprint)
+		}
+	}
+	return call
+}
+
+// valueOrUndefined keeps the operands of instructions non-nil, unit expressions have no value.
+func (b *builder) valueOrUndefined(value ssa.Value, name string) ssa.Value {
+	if utils.IsNil(value) {
+		return b.EmitUndefined(name)
+	}
+	return value
+}
+
+// assignName binds a name in the current scope, `let` shadows with a local variable.
+func (b *builder) assignName(name string, value ssa.Value) {
+	if name == "" || name == "_" {
+		return
+	}
+	value = b.valueOrUndefined(value, name)
+	b.AssignVariable(b.CreateVariable(name), value)
+}
+
+func (b *builder) declareLocal(name string, value ssa.Value) {
+	if name == "" || name == "_" {
+		return
+	}
+	value = b.valueOrUndefined(value, name)
+	b.AssignVariable(b.CreateLocalVariable(name), value)
+}
+
+// lookupBlueprint finds the blueprint of a struct, enum or trait visible by name.
+func (b *builder) lookupBlueprint(name string) *ssa.Blueprint {
+	if name == "" {
+		return nil
+	}
+	if name == "Self" {
+		return b.selfBlueprint
+	}
+	if value := b.PeekValue(name); value != nil {
+		if blueprint, ok := ssa.ToClassBluePrintType(value.GetType()); ok {
+			return blueprint
+		}
+		return nil
+	}
+	return b.GetBluePrint(name)
+}
+
+// blueprintOfType returns the blueprint named by a type annotation.
+func (b *builder) blueprintOfType(typ rustparser.IType_Context) *ssa.Blueprint {
+	if segments := typeSegments(typ); len(segments) == 1 {
+		return b.lookupBlueprint(segments[0])
+	}
+	return nil
+}
+
+// returnBlueprint records the struct type returned by fn, `Self` is the type of the impl block.
+func (b *builder) returnBlueprint(fn *ssa.Function, raw rustparser.IFunctionReturnTypeContext) {
+	ret, _ := raw.(*rustparser.FunctionReturnTypeContext)
+	if ret == nil {
+		return
+	}
+	if blueprint := b.blueprintOfType(ret.Type_()); blueprint != nil {
+		b.returnTypes[fn] = blueprint
+	}
+}
+
+// blueprintOfValue returns the blueprint when value is the container of a type, or the
+// container captured by a function (a free value named after the type).
+func blueprintOfValue(value ssa.Value) *ssa.Blueprint {
+	if utils.IsNil(value) {
+		return nil
+	}
+	blueprint, ok := ssa.ToClassBluePrintType(value.GetType())
+	if !ok {
+		return nil
+	}
+	if blueprint.Container() == value || value.GetName() == blueprint.Name {
+		return blueprint
+	}
+	return nil
+}
+
+// nameOf is the name of an identifier or a path segment, `r#type` is `type`.
+func nameOf(raw antlr.ParseTree) string {
+	if raw == nil {
+		return ""
+	}
+	return strings.TrimPrefix(raw.GetText(), "r#")
+}
+
+// pathSegments returns the names of a path expression, generic arguments are dropped. A
+// qualified path `<T as Trait>::f` starts with the last segment of the trait (or the type).
+func pathSegments(raw rustparser.IPathExpressionContext) []string {
+	i, _ := raw.(*rustparser.PathExpressionContext)
+	if i == nil {
+		return nil
+	}
+	if path := i.PathInExpression(); path != nil {
+		return pathInExpressionSegments(path)
+	}
+	return qualifiedPathSegments(i.QualifiedPathInExpression())
+}
+
+func pathInExpressionSegments(raw rustparser.IPathInExpressionContext) []string {
+	i, _ := raw.(*rustparser.PathInExpressionContext)
+	if i == nil {
+		return nil
+	}
+	return pathExprSegmentNames(i.AllPathExprSegment())
+}
+
+func pathExprSegmentNames(raws []rustparser.IPathExprSegmentContext) []string {
+	segments := make([]string, 0, len(raws))
+	for _, raw := range raws {
+		segments = append(segments, pathExprSegmentName(raw))
+	}
+	return segments
+}
+
+// pathExprSegmentName is the name of a segment without the generic arguments, `into::<T>` is `into`.
+func pathExprSegmentName(raw rustparser.IPathExprSegmentContext) string {
+	i, _ := raw.(*rustparser.PathExprSegmentContext)
+	if i == nil {
+		return ""
+	}
+	return nameOf(i.PathIdentSegment())
+}
+
+func qualifiedPathSegments(raw rustparser.IQualifiedPathInExpressionContext) []string {
+	i, _ := raw.(*rustparser.QualifiedPathInExpressionContext)
+	if i == nil {
+		return nil
+	}
+	return append(qualifiedTypeSegments(i.QualifiedPathType()), pathExprSegmentNames(i.AllPathExprSegment())...)
+}
+
+// qualifiedTypeSegments is the first segment of a qualified path, the name of the trait or
+// of the type when there is no trait.
+func qualifiedTypeSegments(raw rustparser.IQualifiedPathTypeContext) []string {
+	i, _ := raw.(*rustparser.QualifiedPathTypeContext)
+	if i == nil {
+		return nil
+	}
+	var segments []string
+	if trait := i.TypePath(); trait != nil {
+		segments = typePathSegments(trait)
+	} else {
+		segments = typeSegments(i.Type_())
+	}
+	if len(segments) == 0 {
+		return []string{i.Type_().GetText()}
+	}
+	return segments[len(segments)-1:]
+}
+
+func simplePathSegments(raw rustparser.ISimplePathContext) []string {
+	i, _ := raw.(*rustparser.SimplePathContext)
+	if i == nil {
+		return nil
+	}
+	var segments []string
+	for _, seg := range i.AllSimplePathSegment() {
+		segments = append(segments, nameOf(seg))
+	}
+	return segments
+}
+
+func typePathSegments(raw rustparser.ITypePathContext) []string {
+	i, _ := raw.(*rustparser.TypePathContext)
+	if i == nil {
+		return nil
+	}
+	return typePathSegmentNames(i.AllTypePathSegment())
+}
+
+func typePathSegmentNames(raws []rustparser.ITypePathSegmentContext) []string {
+	segments := make([]string, 0, len(raws))
+	for _, raw := range raws {
+		if seg, _ := raw.(*rustparser.TypePathSegmentContext); seg != nil {
+			segments = append(segments, nameOf(seg.PathIdentSegment()))
+		}
+	}
+	return segments
+}
+
+// typeSegments returns the path of the main type of a type annotation. References,
+// pointers, `dyn` and `impl` are stripped: `&mut dyn io::Write` is `io::Write`.
+func typeSegments(raw rustparser.IType_Context) []string {
+	i, _ := raw.(*rustparser.Type_Context)
+	if i == nil {
+		return nil
+	}
+	if typ := i.TypeNoBounds(); typ != nil {
+		return typeNoBoundsSegments(typ)
+	}
+	return boundSegments(i.AllTypeParamBound())
+}
+
+func typeNoBoundsSegments(raw rustparser.ITypeNoBoundsContext) []string {
+	switch t := raw.(type) {
+	case *rustparser.ParenthesizedTypeContext:
+		return typeSegments(t.Type_())
+	case *rustparser.RawPointerTypeContext:
+		return typeNoBoundsSegments(t.TypeNoBounds())
+	case *rustparser.ReferenceTypeContext:
+		return typeNoBoundsSegments(t.TypeNoBounds())
+	case *rustparser.SliceTypeContext:
+		return typeSegments(t.Type_())
+	case *rustparser.ArrayTypeContext:
+		return typeSegments(t.Type_())
+	case *rustparser.TraitObjectTypeContext:
+		return boundSegments([]rustparser.ITypeParamBoundContext{t.TypeParamBound()})
+	case *rustparser.QualifiedPathType_Context:
+		path, _ := t.QualifiedPathInType().(*rustparser.QualifiedPathInTypeContext)
+		if path == nil {
+			return nil
+		}
+		return append(qualifiedTypeSegments(path.QualifiedPathType()), typePathSegmentNames(path.AllTypePathSegment())...)
+	case *rustparser.TypePath_Context:
+		return typePathSegments(t.TypePath())
+	}
+	return nil
+}
+
+// boundSegments is the first trait of the bounds, lifetimes are skipped.
+func boundSegments(bounds []rustparser.ITypeParamBoundContext) []string {
+	for _, raw := range bounds {
+		if bound, _ := raw.(*rustparser.TypeParamBoundContext); bound != nil && bound.TypePath() != nil {
+			return typePathSegments(bound.TypePath())
+		}
+	}
+	return nil
+}
+
+// identifierPattern returns the name bound by a pattern that is a single name, a capitalized
+// name is a path to a unit struct or an enum variant unless it is declared with ref, mut or @.
+func identifierPattern(raw rustparser.IPatternNoTopAltContext) (*rustparser.IdentifierPatternContext, bool) {
+	ident, ok := raw.(*rustparser.IdentifierPatternContext)
+	if !ok {
+		return nil, false
+	}
+	if ident.KW_REF() != nil || ident.KW_MUT() != nil || ident.AT() != nil {
+		return ident, true
+	}
+	for _, r := range nameOf(ident.Identifier()) {
+		return ident, !unicode.IsUpper(r)
+	}
+	return nil, false
+}
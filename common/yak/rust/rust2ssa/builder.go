@@ -0,0 +1,147 @@
+package rust2ssa
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/antlr4util"
+	rustparser "github.com/yaklang/yaklang/common/yak/rust/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+type SSABuilder struct {
+	*ssa.PreHandlerInit
+}
+
+// moduleInfo is a rust file of the project, the module name is the crate name followed by
+// the module path, e.g. `app::db::query` for `app/src/db/query.rs`. The pre-handler records
+// the module names in the LibraryFile of the application, a module is built once in its own library.
+type moduleInfo struct {
+	name  string
+	crate string
+	path  string
+}
+
+// parent is the module name of `super`, the crate root has no parent.
+func (m *moduleInfo) parent() string {
+	if idx := strings.LastIndex(m.name, "::"); idx >= 0 {
+		return m.name[:idx]
+	}
+	return ""
+}
+
+type builder struct {
+	*ssa.FunctionBuilder
+	module *moduleInfo
+
+	// selfBlueprint is the type of `Self` inside impl and trait blocks
+	selfBlueprint *ssa.Blueprint
+	// unsafeDepth is greater than zero inside unsafe blocks and unsafe functions
+	unsafeDepth int
+	// tupleStructs are the blueprints built by calling the type, `Pair(a, b)`
+	tupleStructs map[*ssa.Blueprint]struct{}
+	// returnTypes are the struct types returned by the functions, calls of them are typed
+	// so that methods can be resolved on the result
+	returnTypes map[*ssa.Function]*ssa.Blueprint
+	// unitStructs are the blueprints used as values, `let s = Unit;`
+	unitStructs map[*ssa.Blueprint]struct{}
+	// moduleAliases are the names bound to project modules in the current file,
+	// paths through them are resolved by module instead of reading members
+	moduleAliases map[string]string
+}
+
+var Builder ssa.Builder = &SSABuilder{}
+
+func (s *SSABuilder) Build(src string, force bool, b *ssa.FunctionBuilder) error {
+	crate, err := Frontend(src, force)
+	if err != nil {
+		return err
+	}
+	b.SupportClosure = true
+	build := &builder{
+		FunctionBuilder: b,
+		tupleStructs:    make(map[*ssa.Blueprint]struct{}),
+		returnTypes:     make(map[*ssa.Function]*ssa.Blueprint),
+		unitStructs:     make(map[*ssa.Blueprint]struct{}),
+		moduleAliases:   make(map[string]string),
+	}
+	build.VisitCrate(crate)
+	return nil
+}
+
+func (*SSABuilder) FilterFile(path string) bool {
+	return filepath.Ext(path) == ".rs"
+}
+
+func (*SSABuilder) GetLanguage() consts.Language {
+	return consts.RUST
+}
+
+func Frontend(src string, force bool) (rustparser.ICrateContext, error) {
+	errListener := antlr4util.NewErrorListener()
+	lexer := rustparser.NewRustLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	tokenStream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := rustparser.NewRustParser(tokenStream)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errListener)
+	parser.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+	ast := parser.Crate()
+	if force || len(errListener.GetErrors()) == 0 {
+		return ast, nil
+	}
+	return nil, utils.Errorf("parse AST FrontEnd error: %v", errListener.GetErrorString())
+}
+
+func newModuleInfo(name, path string) *moduleInfo {
+	crate, _, _ := strings.Cut(name, "::")
+	return &moduleInfo{
+		name:  name,
+		crate: crate,
+		path:  path,
+	}
+}
+
+func registerModule(app *ssa.Program, name, path string) {
+	app.LibraryFile[name] = append(app.LibraryFile[name], path)
+}
+
+func getModuleByName(app *ssa.Program, name string) *moduleInfo {
+	if name == "" {
+		return nil
+	}
+	files, ok := app.LibraryFile[name]
+	if !ok || len(files) == 0 {
+		return nil
+	}
+	return newModuleInfo(name, files[0])
+}
+
+func getModuleByPath(app *ssa.Program, path string) *moduleInfo {
+	if path == "" {
+		return nil
+	}
+	for name, files := range app.LibraryFile {
+		if len(files) > 0 && files[0] == path {
+			return newModuleInfo(name, path)
+		}
+	}
+	return nil
+}
+
+func (b *builder) SwitchFunctionBuilder(s *ssa.StoredFunctionBuilder) func() {
+	t := b.StoreFunctionBuilder()
+	b.LoadBuilder(s)
+	return func() {
+		b.LoadBuilder(t)
+	}
+}
+
+func (b *builder) LoadBuilder(s *ssa.StoredFunctionBuilder) {
+	b.FunctionBuilder = s.Current
+	b.LoadFunctionBuilder(s.Store)
+}
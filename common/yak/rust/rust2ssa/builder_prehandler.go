@@ -0,0 +1,94 @@
+package rust2ssa
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// crateRootName is the crate name of files that are not below a `src` directory.
+const crateRootName = "crate"
+
+func (s *SSABuilder) Create() ssa.Builder {
+	return &SSABuilder{
+		PreHandlerInit: ssa.NewPreHandlerInit().WithLanguageConfigOpts(
+			ssa.WithLanguageConfigBind(true),
+			ssa.WithLanguageConfigSupportClass(true),
+			ssa.WithLanguageConfigIsSupportClassStaticModifier(true),
+			ssa.WithLanguageConfigVirtualImport(true),
+			ssa.WithLanguageBuilder(s),
+			ssa.WithLanguageConfigTryBuildValue(true),
+		),
+	}
+}
+
+func (*SSABuilder) FilterPreHandlerFile(path string) bool {
+	return filepath.Ext(path) == ".rs"
+}
+
+func (*SSABuilder) PreHandlerFile(editor *memedit.MemEditor, builder *ssa.FunctionBuilder) {
+	builder.GetProgram().GetApplication().Build("", editor, builder)
+}
+
+// PreHandlerProject records the module name of every rust file. The crate is the directory
+// holding `src`, `main.rs` and `lib.rs` are the crate root and `mod.rs` is the module of its
+// directory.
+func (s *SSABuilder) PreHandlerProject(fileSystem fi.FileSystem, fb *ssa.FunctionBuilder, path string) error {
+	prog := fb.GetProgram()
+	if prog == nil {
+		log.Errorf("program is nil")
+		return nil
+	}
+	file, err := fileSystem.ReadFile(path)
+	if err != nil {
+		log.Errorf("read file %s error: %v", path, err)
+		return nil
+	}
+
+	registerModule(prog.GetApplication(), moduleNameFromPath(fileSystem, path), path)
+	prog.Build(path, memedit.NewMemEditor(string(file)), fb)
+	return nil
+}
+
+func moduleNameFromPath(fileSystem fi.FileSystem, path string) string {
+	dir, filename := fileSystem.PathSplit(path)
+	stem := strings.TrimSuffix(filename, fileSystem.Ext(filename))
+
+	var dirs []string
+	crate := ""
+	foundSrc := false
+	for dir != "" {
+		parent, name := fileSystem.PathSplit(dir)
+		if name == "" || parent == dir {
+			break
+		}
+		if name == "src" {
+			foundSrc = true
+			_, crate = fileSystem.PathSplit(parent)
+			break
+		}
+		dirs = append([]string{name}, dirs...)
+		dir = parent
+	}
+	if !foundSrc {
+		// a loose file, the directories below the project root are modules
+		crate = ""
+	}
+	crate = strings.ReplaceAll(crate, "-", "_")
+	if crate == "" {
+		crate = crateRootName
+	}
+
+	parts := append([]string{crate}, dirs...)
+	switch {
+	case stem == "mod":
+	case (stem == "main" || stem == "lib") && len(dirs) == 0:
+	default:
+		parts = append(parts, stem)
+	}
+	return strings.Join(parts, "::")
+}
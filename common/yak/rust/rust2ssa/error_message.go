@@ -0,0 +1,37 @@
+package rust2ssa
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+const TAG ssa.ErrorTag = "Rust"
+
+func UnexpectedBinaryOP(op string) string {
+	return fmt.Sprintf("unexpected binary operator: %s", op)
+}
+
+func UnexpectedUnaryOP(op string) string {
+	return fmt.Sprintf("unexpected unary operator: %s", op)
+}
+
+func UnexpectedBreakStmt() string {
+	return "`break` outside of a loop"
+}
+
+func UnexpectedContinueStmt() string {
+	return "`continue` outside of a loop"
+}
+
+func InvalidAssignTarget() string {
+	return "invalid left-hand side of assignment"
+}
+
+func InvalidFunctionCallee() string {
+	return "invalid function callee"
+}
+
+func SuperBeyondCrateRoot(path string) string {
+	return fmt.Sprintf("too many leading `super` keywords: %s", path)
+}
@@ -0,0 +1,420 @@
+package rust2ssa
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/antlr4util"
+	rustparser "github.com/yaklang/yaklang/common/yak/rust/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// MacroKind is the model of a macro invocation, macros are never expanded.
+type MacroKind int
+
+const (
+	// MacroCall is an opaque call of the macro with the parsed arguments, the default model.
+	MacroCall MacroKind = iota
+	// MacroFormat is the string built by `format!`, the arguments flow into the result.
+	MacroFormat
+	// MacroPrint is a call of the macro with the formatted string, e.g. `println!`.
+	MacroPrint
+	// MacroWrite is a call with the destination and the formatted string, e.g. `write!(f, ...)`.
+	MacroWrite
+	// MacroSlice builds a slice from the arguments, e.g. `vec!`.
+	MacroSlice
+	// MacroConcat concatenates the arguments, e.g. `concat!`.
+	MacroConcat
+	// MacroIgnore is an undefined value, the arguments are not evaluated.
+	MacroIgnore
+)
+
+var macroKindNames = map[MacroKind]string{
+	MacroCall:   "call",
+	MacroFormat: "format",
+	MacroPrint:  "print",
+	MacroWrite:  "write",
+	MacroSlice:  "slice",
+	MacroConcat: "concat",
+	MacroIgnore: "ignore",
+}
+
+func (k MacroKind) String() string {
+	if name, ok := macroKindNames[k]; ok {
+		return name
+	}
+	return macroKindNames[MacroCall]
+}
+
+// ParseMacroKind returns the kind of the name returned by MacroKind.String
+func ParseMacroKind(name string) (MacroKind, bool) {
+	for kind, kindName := range macroKindNames {
+		if kindName == name {
+			return kind, true
+		}
+	}
+	return MacroCall, false
+}
+
+// defaultMacroModels are the models of the std and log macros, a compile can add or override
+// them by ssaapi.WithRustMacroModel.
+var defaultMacroModels = map[string]MacroKind{
+	"format":        MacroFormat,
+	"format_args":   MacroFormat,
+	"print":         MacroPrint,
+	"println":       MacroPrint,
+	"eprint":        MacroPrint,
+	"eprintln":      MacroPrint,
+	"panic":         MacroPrint,
+	"unreachable":   MacroPrint,
+	"todo":          MacroPrint,
+	"unimplemented": MacroPrint,
+	"trace":         MacroPrint,
+	"debug":         MacroPrint,
+	"info":          MacroPrint,
+	"warn":          MacroPrint,
+	"error":         MacroPrint,
+	"write":         MacroWrite,
+	"writeln":       MacroWrite,
+	"vec":           MacroSlice,
+	"concat":        MacroConcat,
+	"env":           MacroIgnore,
+	"option_env":    MacroIgnore,
+	"cfg":           MacroIgnore,
+	"file":          MacroIgnore,
+	"line":          MacroIgnore,
+	"column":        MacroIgnore,
+	"module_path":   MacroIgnore,
+	"stringify":     MacroIgnore,
+	"include_str":   MacroIgnore,
+	"include_bytes": MacroIgnore,
+	"include":       MacroIgnore,
+}
+
+// getMacroModel returns the model of a macro, the models of the compile are checked before the
+// default ones, and a full path (`sqlx::query`) is checked before the last segment (`query`).
+func (b *builder) getMacroModel(path []string) MacroKind {
+	var models map[string]string
+	if app := b.GetProgram().GetApplication(); app != nil {
+		models = app.MacroModels
+	}
+	for _, name := range []string{strings.Join(path, "::"), path[len(path)-1]} {
+		if kind, ok := ParseMacroKind(models[name]); ok {
+			return kind
+		}
+		if kind, ok := defaultMacroModels[name]; ok {
+			return kind
+		}
+	}
+	return MacroCall
+}
+
+// macroCall is a macro invocation with the arguments parsed from its token trees.
+type macroCall struct {
+	node antlr.ParserRuleContext
+	path []string
+	args []rustparser.IExpressionContext
+	// repeat is the length of `vec![elem; len]`
+	repeat rustparser.IExpressionContext
+}
+
+func newMacroCall(node antlr.ParserRuleContext, path rustparser.ISimplePathContext, trees []rustparser.ITokenTreeContext) *macroCall {
+	call := &macroCall{
+		node: node,
+		path: simplePathSegments(path),
+	}
+	call.args, call.repeat = parseMacroArgs(trees)
+	return call
+}
+
+// delimTokenTrees are the tokens inside the delimiters of a macro invocation.
+func delimTokenTrees(raw rustparser.IDelimTokenTreeContext) []rustparser.ITokenTreeContext {
+	i, _ := raw.(*rustparser.DelimTokenTreeContext)
+	if i == nil {
+		return nil
+	}
+	return i.AllTokenTree()
+}
+
+// parseMacroArgs parses the tokens of a macro invocation, each argument separated by a top
+// level `,` or `;` is parsed with macroArgument and skipped when it is not an expression.
+func parseMacroArgs(trees []rustparser.ITokenTreeContext) ([]rustparser.IExpressionContext, rustparser.IExpressionContext) {
+	var pieces [][]rustparser.ITokenTreeContext
+	var seps []string
+	var piece []rustparser.ITokenTreeContext
+	for _, tree := range trees {
+		if text := tree.GetText(); tree.GetChildCount() == 1 && (text == "," || text == ";") {
+			pieces, seps, piece = append(pieces, piece), append(seps, text), nil
+			continue
+		}
+		piece = append(piece, tree)
+	}
+	pieces = append(pieces, piece)
+
+	var args []rustparser.IExpressionContext
+	for i, piece := range pieces {
+		arg := parseMacroArg(piece)
+		if arg == nil {
+			continue
+		}
+		args = append(args, arg)
+		if len(args) == 1 && i < len(seps) && seps[i] == ";" {
+			// vec![elem; len]
+			if len(pieces) == i+2 {
+				return args, parseMacroArg(pieces[i+1])
+			}
+			return args, nil
+		}
+	}
+	return args, nil
+}
+
+// parseMacroArg parses the text of some token trees as an expression, the tokens keep
+// their positions in the file.
+func parseMacroArg(trees []rustparser.ITokenTreeContext) rustparser.IExpressionContext {
+	if len(trees) == 0 {
+		return nil
+	}
+	start, stop := trees[0].GetStart(), trees[len(trees)-1].GetStop()
+	if start == nil || stop == nil || stop.GetStop() < start.GetStart() {
+		return nil
+	}
+	text := start.GetInputStream().GetTextFromInterval(antlr.NewInterval(start.GetStart(), stop.GetStop()))
+
+	errListener := antlr4util.NewErrorListener()
+	lexer := rustparser.NewRustLexer(antlr.NewInputStream(text))
+	lexer.SetStartPosition(start.GetLine(), start.GetColumn())
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	parser := rustparser.NewRustParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errListener)
+	arg, _ := parser.MacroArgument().(*rustparser.MacroArgumentContext)
+	if arg == nil || len(errListener.GetErrors()) > 0 {
+		return nil
+	}
+	return arg.Expression()
+}
+
+func (b *builder) VisitMacroInvocation(raw rustparser.IMacroInvocationContext) ssa.Value {
+	i, _ := raw.(*rustparser.MacroInvocationContext)
+	if i == nil {
+		return nil
+	}
+	return b.VisitMacroCall(newMacroCall(i, i.SimplePath(), delimTokenTrees(i.DelimTokenTree())))
+}
+
+func (b *builder) VisitMacroInvocationSemi(raw rustparser.IMacroInvocationSemiContext) ssa.Value {
+	i, _ := raw.(*rustparser.MacroInvocationSemiContext)
+	if i == nil {
+		return nil
+	}
+	return b.VisitMacroCall(newMacroCall(i, i.SimplePath(), delimTokenTrees(i.DelimTokenTree())))
+}
+
+func (b *builder) VisitMacroCall(call *macroCall) ssa.Value {
+	if call == nil || len(call.path) == 0 || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(call.node)
+	defer recoverRange()
+
+	name := strings.Join(call.path, "::")
+	switch b.getMacroModel(call.path) {
+	case MacroIgnore:
+		return b.EmitUndefined(name)
+	case MacroSlice:
+		if call.repeat != nil {
+			b.VisitExpr(call.repeat)
+		}
+		return b.CreateObjectWithSlice(b.visitMacroArgs(call.args))
+	case MacroConcat:
+		return b.concatValues(b.visitMacroArgs(call.args))
+	case MacroFormat:
+		if value, ok := b.formatMacro(call.args); ok {
+			return value
+		}
+	case MacroPrint:
+		if value, ok := b.formatMacro(call.args); ok {
+			return b.emitCall(b.macroCallee(call.path), []ssa.Value{value})
+		}
+	case MacroWrite:
+		if len(call.args) > 0 {
+			dst := b.valueOrUndefined(b.VisitExpr(call.args[0]), "")
+			args := []ssa.Value{dst}
+			if value, ok := b.formatMacro(call.args[1:]); ok {
+				args = append(args, value)
+			}
+			return b.emitCall(b.macroCallee(call.path), args)
+		}
+	}
+	return b.emitCall(b.macroCallee(call.path), b.visitMacroArgs(call.args))
+}
+
+// macroCallee is the value called by a macro, it is named after the macro path like an
+// external function and is not bound in the current scope.
+func (b *builder) macroCallee(path []string) ssa.Value {
+	var value ssa.Value = b.EmitUndefined(path[0])
+	b.GetProgram().SetInstructionWithName(path[0], value)
+	for _, seg := range path[1:] {
+		value = b.ReadMemberCallValue(value, b.EmitConstInstPlaceholder(seg))
+	}
+	return value
+}
+
+// visitMacroArgs evaluates the arguments, `name = value` arguments pass the value.
+func (b *builder) visitMacroArgs(args []rustparser.IExpressionContext) []ssa.Value {
+	values := make([]ssa.Value, 0, len(args))
+	for _, arg := range args {
+		if assign, ok := arg.(*rustparser.AssignExprContext); ok {
+			arg = assign.Expression(1)
+		}
+		values = append(values, b.valueOrUndefined(b.VisitExpr(arg), ""))
+	}
+	return values
+}
+
+func (b *builder) concatValues(values []ssa.Value) ssa.Value {
+	var result ssa.Value
+	for _, value := range values {
+		if utils.IsNil(value) {
+			continue
+		}
+		if result == nil {
+			result = value
+			continue
+		}
+		result = b.EmitBinOp(ssa.OpAdd, result, value)
+	}
+	if result == nil {
+		return b.EmitConstInst("")
+	}
+	return result
+}
+
+// formatPiece is a literal part of a format string or a `{...}` placeholder.
+type formatPiece struct {
+	text  string
+	isArg bool
+}
+
+// parseFormatString splits a format string, `{{` and `}}` are escaped braces and the
+// format spec after `:` is dropped from the placeholders.
+func parseFormatString(s string) []formatPiece {
+	var pieces []formatPiece
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			pieces = append(pieces, formatPiece{text: text.String()})
+			text.Reset()
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '{' && i+1 < len(s) && s[i+1] == '{':
+			text.WriteByte('{')
+			i++
+		case c == '}' && i+1 < len(s) && s[i+1] == '}':
+			text.WriteByte('}')
+			i++
+		case c == '{':
+			end := strings.IndexByte(s[i:], '}')
+			if end < 0 {
+				text.WriteString(s[i:])
+				i = len(s)
+				continue
+			}
+			arg, _, _ := strings.Cut(s[i+1:i+end], ":")
+			flush()
+			pieces = append(pieces, formatPiece{text: strings.TrimSpace(arg), isArg: true})
+			i += end
+		default:
+			text.WriteByte(c)
+		}
+	}
+	flush()
+	return pieces
+}
+
+// formatMacro builds the string of a format macro like an interpolated string: the literal
+// pieces and the arguments are concatenated. `{}` takes the next positional argument, `{0}`
+// an argument by index and `{name}` a named argument or the variable captured from the scope.
+func (b *builder) formatMacro(args []rustparser.IExpressionContext) (ssa.Value, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+	format, ok := stringLiteral(args[0])
+	if !ok {
+		return nil, false
+	}
+
+	var positional []ssa.Value
+	named := make(map[string]ssa.Value)
+	for _, arg := range args[1:] {
+		if assign, ok := arg.(*rustparser.AssignExprContext); ok {
+			value := b.valueOrUndefined(b.VisitExpr(assign.Expression(1)), "")
+			if target, ok := assign.Expression(0).(*rustparser.PathExprContext); ok {
+				named[strings.Join(pathSegments(target.PathExpression()), "::")] = value
+			}
+			positional = append(positional, value)
+			continue
+		}
+		positional = append(positional, b.valueOrUndefined(b.VisitExpr(arg), ""))
+	}
+
+	var values []ssa.Value
+	next := 0
+	for _, piece := range parseFormatString(format) {
+		if !piece.isArg {
+			values = append(values, b.EmitConstInst(piece.text))
+			continue
+		}
+		var value ssa.Value
+		switch name := piece.text; {
+		case name == "":
+			if next < len(positional) {
+				value = positional[next]
+			}
+			next++
+		case isDigits(name):
+			if index, err := strconv.Atoi(name); err == nil && index < len(positional) {
+				value = positional[index]
+			}
+		default:
+			if v, ok := named[name]; ok {
+				value = v
+			} else {
+				value = b.ReadValue(name)
+			}
+		}
+		values = append(values, b.valueOrUndefined(value, piece.text))
+	}
+	return b.concatValues(values), true
+}
+
+// stringLiteral returns the content of a string literal expression.
+func stringLiteral(raw rustparser.IExpressionContext) (string, bool) {
+	expr, ok := raw.(*rustparser.LiteralExprContext)
+	if !ok {
+		return "", false
+	}
+	token := expr.LiteralExpression().GetStart()
+	switch token.GetTokenType() {
+	case rustparser.RustLexerSTRING_LITERAL, rustparser.RustLexerC_STRING_LITERAL:
+		return unquote(token.GetText()), true
+	case rustparser.RustLexerRAW_STRING_LITERAL, rustparser.RustLexerRAW_C_STRING_LITERAL:
+		return rawString(token.GetText()), true
+	}
+	return "", false
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,544 @@
+package rust2ssa
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/utils"
+	rustparser "github.com/yaklang/yaklang/common/yak/rust/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// VisitExpr builds an expression, nil is returned for the expressions of unit type
+// (loops, assignments, break...).
+func (b *builder) VisitExpr(raw rustparser.IExpressionContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	switch e := raw.(type) {
+	case *rustparser.LiteralExprContext:
+		return b.VisitLiteral(e.LiteralExpression())
+	case *rustparser.PathExprContext:
+		value := b.resolvePath(pathSegments(e.PathExpression()))
+		if blueprint := blueprintOfValue(value); blueprint != nil {
+			if _, ok := b.unitStructs[blueprint]; ok {
+				// a unit struct is the only value of its type
+				object := b.EmitEmptyContainer()
+				object.SetType(blueprint)
+				return object
+			}
+		}
+		return value
+	case *rustparser.CallExprContext:
+		return b.VisitCall(e)
+	case *rustparser.FieldExprContext:
+		obj := b.valueOrUndefined(b.VisitExpr(e.Expression()), "")
+		return b.ReadMemberCallValue(obj, b.fieldKey(pathExprSegmentName(e.PathExprSegment())))
+	case *rustparser.TupleIndexExprContext:
+		value := b.valueOrUndefined(b.VisitExpr(e.Expression()), "")
+		// `t.0.1` is `t` `.` `0.1`, the float literal is two indexes
+		for _, index := range strings.Split(e.TupleIndex().GetText(), ".") {
+			value = b.ReadMemberCallValue(value, b.fieldKey(index))
+		}
+		return value
+	case *rustparser.IndexExprContext:
+		return b.VisitIndex(e)
+	case *rustparser.AwaitExprContext:
+		return b.VisitExpr(e.Expression())
+	case *rustparser.TryExprContext:
+		return b.VisitExpr(e.Expression())
+	case *rustparser.BorrowExprContext:
+		return b.VisitExpr(e.Expression())
+	case *rustparser.DerefExprContext:
+		// references are not modeled, a dereference is the value itself
+		return b.valueOrUndefined(b.VisitExpr(e.Expression()), "")
+	case *rustparser.UnaryExprContext:
+		value := b.valueOrUndefined(b.VisitExpr(e.Expression()), "")
+		op, ok := unaryOpTbl[e.GetOp().GetText()]
+		if !ok {
+			b.NewError(ssa.Error, TAG, UnexpectedUnaryOP(e.GetOp().GetText()))
+			return b.EmitUndefined("")
+		}
+		return b.EmitUnOp(op, value)
+	case *rustparser.CastExprContext:
+		return b.VisitExpr(e.Expression())
+	case *rustparser.MultiplicativeExprContext:
+		return b.visitBinary(e.GetOp().GetText(), e.Expression(0), e.Expression(1))
+	case *rustparser.AdditiveExprContext:
+		return b.visitBinary(e.GetOp().GetText(), e.Expression(0), e.Expression(1))
+	case *rustparser.ShiftExprContext:
+		return b.visitBinary(e.ShiftOperator().GetText(), e.Expression(0), e.Expression(1))
+	case *rustparser.BitAndExprContext:
+		return b.visitBinary("&", e.Expression(0), e.Expression(1))
+	case *rustparser.BitXorExprContext:
+		return b.visitBinary("^", e.Expression(0), e.Expression(1))
+	case *rustparser.BitOrExprContext:
+		return b.visitBinary("|", e.Expression(0), e.Expression(1))
+	case *rustparser.ComparisonExprContext:
+		return b.visitBinary(e.ComparisonOperator().GetText(), e.Expression(0), e.Expression(1))
+	case *rustparser.LogicalAndExprContext:
+		return b.visitBinary("&&", e.Expression(0), e.Expression(1))
+	case *rustparser.LogicalOrExprContext:
+		return b.visitBinary("||", e.Expression(0), e.Expression(1))
+	case *rustparser.RangeExprContext, *rustparser.RangeToExprContext,
+		*rustparser.RangeInclusiveExprContext, *rustparser.RangeToInclusiveExprContext:
+		start, end, _ := rangeBounds(e)
+		var values []ssa.Value
+		if start != nil {
+			values = append(values, b.valueOrUndefined(b.VisitExpr(start), ""))
+		}
+		if end != nil {
+			values = append(values, b.valueOrUndefined(b.VisitExpr(end), ""))
+		}
+		return b.CreateObjectWithSlice(values)
+	case *rustparser.AssignExprContext:
+		b.assignTarget(e.Expression(0), b.VisitExpr(e.Expression(1)))
+		return nil
+	case *rustparser.CompoundAssignExprContext:
+		text := strings.TrimSuffix(e.CompoundAssignOperator().GetText(), "=")
+		op, ok := binOpTbl[text]
+		if !ok {
+			b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(text))
+			return nil
+		}
+		left := b.valueOrUndefined(b.VisitExpr(e.Expression(0)), "")
+		right := b.valueOrUndefined(b.VisitExpr(e.Expression(1)), "")
+		b.assignTarget(e.Expression(0), b.EmitBinOp(op, left, right))
+		return nil
+	case *rustparser.ContinueExprContext:
+		b.VisitContinue(e)
+		return nil
+	case *rustparser.BreakExprContext:
+		b.VisitBreak(e)
+		return nil
+	case *rustparser.ReturnExprContext:
+		b.VisitReturn(e)
+		return nil
+	case *rustparser.ClosureExprContext:
+		return b.VisitClosure(e)
+	case *rustparser.GroupedExprContext:
+		return b.VisitExpr(e.Expression())
+	case *rustparser.ArrayExprContext:
+		elems, _ := e.ArrayElements().(*rustparser.ArrayElementsContext)
+		if elems == nil {
+			return b.CreateObjectWithSlice(nil)
+		}
+		if elems.SEMI() != nil {
+			// `[elem; len]`
+			elem := b.valueOrUndefined(b.VisitExpr(elems.Expression(0)), "")
+			b.VisitExpr(elems.Expression(1))
+			return b.CreateObjectWithSlice([]ssa.Value{elem})
+		}
+		return b.CreateObjectWithSlice(b.visitArgs(elems.AllExpression()))
+	case *rustparser.TupleExprContext:
+		if e.TupleElements() == nil {
+			// the unit value
+			return nil
+		}
+		return b.CreateObjectWithSlice(b.visitArgs(tupleElements(e.TupleElements())))
+	case *rustparser.StructExprContext:
+		return b.VisitStructExpression(e.StructExpression())
+	case *rustparser.BlockLikeExprContext:
+		return b.VisitExpressionWithBlock(e.ExpressionWithBlock())
+	case *rustparser.MacroExprContext:
+		return b.VisitMacroInvocation(e.MacroInvocation())
+	case *rustparser.UnderscoreExprContext:
+		return b.EmitUndefined("_")
+	default:
+		return b.EmitUndefined("")
+	}
+}
+
+func (b *builder) visitBinary(opText string, x, y rustparser.IExpressionContext) ssa.Value {
+	op, ok := binOpTbl[opText]
+	if !ok {
+		b.NewError(ssa.Error, TAG, UnexpectedBinaryOP(opText))
+		return b.EmitUndefined("")
+	}
+	left := b.valueOrUndefined(b.VisitExpr(x), "")
+	right := b.valueOrUndefined(b.VisitExpr(y), "")
+	return b.EmitBinOp(op, left, right)
+}
+
+// rangeBounds returns the operands of a range expression, ok is false for other expressions.
+func rangeBounds(raw rustparser.IExpressionContext) (start, end rustparser.IExpressionContext, ok bool) {
+	switch e := raw.(type) {
+	case *rustparser.RangeExprContext:
+		return e.Expression(0), e.Expression(1), true
+	case *rustparser.RangeToExprContext:
+		return nil, e.Expression(), true
+	case *rustparser.RangeInclusiveExprContext:
+		return e.Expression(0), e.Expression(1), true
+	case *rustparser.RangeToInclusiveExprContext:
+		return nil, e.Expression(), true
+	}
+	return nil, nil, false
+}
+
+// VisitExpressionWithBlock builds blocks, loops, if and match, loops have no value.
+func (b *builder) VisitExpressionWithBlock(raw rustparser.IExpressionWithBlockContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	e, _ := raw.(*rustparser.ExpressionWithBlockContext)
+	if e == nil {
+		return nil
+	}
+	switch {
+	case e.LoopExpression() != nil:
+		b.VisitLoopExpression(e.LoopExpression(), e.LoopLabel())
+		return nil
+	case e.IfExpression() != nil:
+		return b.VisitIf(e.IfExpression())
+	case e.MatchExpression() != nil:
+		return b.VisitMatch(e.MatchExpression())
+	case e.KW_UNSAFE() != nil:
+		b.unsafeDepth++
+		defer func() { b.unsafeDepth-- }()
+	}
+	// async and const blocks are built like blocks
+	return b.VisitBlock(e.BlockExpression(), e.LoopLabel())
+}
+
+// VisitLiteral builds a literal, `1.` is a float.
+func (b *builder) VisitLiteral(raw rustparser.ILiteralExpressionContext) ssa.Value {
+	lit, _ := raw.(*rustparser.LiteralExpressionContext)
+	if lit == nil {
+		return b.EmitUndefined("")
+	}
+	if lit.DOT() != nil {
+		return b.visitFloat(lit.INTEGER_LITERAL().GetText())
+	}
+	return b.visitLiteralToken(lit.GetStart())
+}
+
+func (b *builder) visitLiteralToken(token antlr.Token) ssa.Value {
+	text := token.GetText()
+	switch token.GetTokenType() {
+	case rustparser.RustLexerSTRING_LITERAL, rustparser.RustLexerBYTE_STRING_LITERAL, rustparser.RustLexerC_STRING_LITERAL,
+		rustparser.RustLexerCHAR_LITERAL, rustparser.RustLexerBYTE_LITERAL:
+		return b.EmitConstInst(unquote(text))
+	case rustparser.RustLexerRAW_STRING_LITERAL, rustparser.RustLexerRAW_BYTE_STRING_LITERAL, rustparser.RustLexerRAW_C_STRING_LITERAL:
+		return b.EmitConstInst(rawString(text))
+	case rustparser.RustLexerINTEGER_LITERAL:
+		return b.visitInteger(text)
+	case rustparser.RustLexerFLOAT_LITERAL:
+		return b.visitFloat(text)
+	case rustparser.RustLexerKW_TRUE, rustparser.RustLexerKW_FALSE:
+		return b.EmitConstInst(text == "true")
+	default:
+		return b.EmitUndefined(text)
+	}
+}
+
+var integerSuffixes = []string{"u128", "usize", "u16", "u32", "u64", "u8", "i128", "isize", "i16", "i32", "i64", "i8"}
+
+func (b *builder) visitInteger(literal string) ssa.Value {
+	text := strings.ToLower(strings.ReplaceAll(literal, "_", ""))
+	for _, suffix := range integerSuffixes {
+		if strings.HasSuffix(text, suffix) {
+			text = strings.TrimSuffix(text, suffix)
+			break
+		}
+	}
+	base := 10
+	for prefix, n := range map[string]int{"0x": 16, "0o": 8, "0b": 2} {
+		if strings.HasPrefix(text, prefix) {
+			text, base = text[2:], n
+		}
+	}
+	if v, err := strconv.ParseInt(text, base, 64); err == nil {
+		return b.EmitConstInst(v)
+	}
+	if v, ok := new(big.Int).SetString(text, base); ok {
+		// out of int64 range, keep the decimal text
+		return b.EmitConstInst(v.String())
+	}
+	return b.EmitConstInst(literal)
+}
+
+func (b *builder) visitFloat(literal string) ssa.Value {
+	text := strings.ReplaceAll(literal, "_", "")
+	text = strings.TrimSuffix(strings.TrimSuffix(text, "f32"), "f64")
+	if v, err := strconv.ParseFloat(text, 64); err == nil {
+		return b.EmitConstInst(v)
+	}
+	return b.EmitConstInst(literal)
+}
+
+// unquote returns the content of a string, char, byte or byte string literal with the
+// escapes replaced, a backslash at the end of a line skips the leading whitespace of the next.
+func unquote(literal string) string {
+	start := strings.IndexAny(literal, `"'`)
+	if start < 0 || len(literal)-start < 2 {
+		return literal
+	}
+	content := literal[start+1 : len(literal)-1]
+	var buf strings.Builder
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if c != '\\' || i+1 >= len(content) {
+			buf.WriteByte(c)
+			continue
+		}
+		i++
+		switch c = content[i]; c {
+		case 'n':
+			buf.WriteByte('\n')
+		case 'r':
+			buf.WriteByte('\r')
+		case 't':
+			buf.WriteByte('\t')
+		case '0':
+			buf.WriteByte(0)
+		case 'x':
+			if i+2 < len(content) {
+				if v, err := strconv.ParseUint(content[i+1:i+3], 16, 8); err == nil {
+					buf.WriteByte(byte(v))
+				}
+				i += 2
+			}
+		case 'u':
+			end := strings.IndexByte(content[i:], '}')
+			if end < 0 {
+				continue
+			}
+			hex := strings.ReplaceAll(strings.TrimPrefix(content[i+1:i+end], "{"), "_", "")
+			if v, err := strconv.ParseUint(hex, 16, 32); err == nil {
+				buf.WriteRune(rune(v))
+			}
+			i += end
+		case '\r', '\n':
+			for i+1 < len(content) && strings.ContainsRune(" \t\r\n", rune(content[i+1])) {
+				i++
+			}
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	return buf.String()
+}
+
+// rawString returns the content of r"..", r#".."#, br".." or cr"..", it is not unescaped.
+func rawString(literal string) string {
+	start := strings.IndexByte(literal, '"')
+	end := strings.LastIndexByte(literal, '"')
+	if start < 0 || end <= start {
+		return literal
+	}
+	return literal[start+1 : end]
+}
+
+// fieldKey is the member key of a field, tuple fields are indexes.
+func (b *builder) fieldKey(name string) ssa.Value {
+	if index, err := strconv.Atoi(name); err == nil {
+		return b.EmitConstInst(index)
+	}
+	return b.EmitConstInstPlaceholder(name)
+}
+
+func (b *builder) visitArgs(args []rustparser.IExpressionContext) []ssa.Value {
+	values := make([]ssa.Value, 0, len(args))
+	for _, arg := range args {
+		values = append(values, b.valueOrUndefined(b.VisitExpr(arg), ""))
+	}
+	return values
+}
+
+func callArgs(raw rustparser.ICallParamsContext) []rustparser.IExpressionContext {
+	i, _ := raw.(*rustparser.CallParamsContext)
+	if i == nil {
+		return nil
+	}
+	return i.AllExpression()
+}
+
+func tupleElements(raw rustparser.ITupleElementsContext) []rustparser.IExpressionContext {
+	i, _ := raw.(*rustparser.TupleElementsContext)
+	if i == nil {
+		return nil
+	}
+	return i.AllExpression()
+}
+
+func arrayElements(raw rustparser.IArrayElementsContext) []rustparser.IExpressionContext {
+	i, _ := raw.(*rustparser.ArrayElementsContext)
+	if i == nil {
+		return nil
+	}
+	return i.AllExpression()
+}
+
+// VisitCall builds a call. `a.f(x)` is a method call, the enum constructors of the prelude
+// pass their argument and calling a tuple struct builds an object of its type.
+func (b *builder) VisitCall(e *rustparser.CallExprContext) ssa.Value {
+	args := callArgs(e.CallParams())
+	if field, ok := e.Expression().(*rustparser.FieldExprContext); ok {
+		receiver := b.valueOrUndefined(b.VisitExpr(field.Expression()), "")
+		method := pathExprSegmentName(field.PathExprSegment())
+		callee := b.ReadMemberCallMethod(receiver, b.EmitConstInstPlaceholder(method))
+		return b.emitCall(callee, b.visitArgs(args))
+	}
+	if path, ok := e.Expression().(*rustparser.PathExprContext); ok && len(args) == 1 {
+		if segments := pathSegments(path.PathExpression()); len(segments) == 1 {
+			if _, ok := transparentWrappers[segments[0]]; ok && b.PeekValue(segments[0]) == nil {
+				return b.VisitExpr(args[0])
+			}
+		}
+	}
+
+	callee := b.VisitExpr(e.Expression())
+	if utils.IsNil(callee) {
+		b.NewError(ssa.Error, TAG, InvalidFunctionCallee())
+		return b.EmitUndefined("")
+	}
+	values := b.visitArgs(args)
+
+	if blueprint := blueprintOfValue(callee); blueprint != nil {
+		if _, ok := b.tupleStructs[blueprint]; ok {
+			object := b.EmitEmptyContainer()
+			object.SetType(blueprint)
+			for i, arg := range values {
+				member := b.CreateMemberCallVariable(object, b.EmitConstInst(i))
+				b.AssignVariable(member, arg)
+			}
+			return object
+		}
+	}
+	return b.emitCall(callee, values)
+}
+
+// VisitIndex reads an element, indexing with a range is a slice of the object.
+func (b *builder) VisitIndex(e *rustparser.IndexExprContext) ssa.Value {
+	obj := b.valueOrUndefined(b.VisitExpr(e.Expression(0)), "")
+	if start, end, ok := rangeBounds(e.Expression(1)); ok {
+		var low, high ssa.Value
+		if start != nil {
+			low = b.VisitExpr(start)
+		}
+		if end != nil {
+			high = b.VisitExpr(end)
+		}
+		return b.EmitMakeSlice(obj, low, high, nil)
+	}
+	key := b.valueOrUndefined(b.VisitExpr(e.Expression(1)), "")
+	return b.ReadMemberCallValue(obj, key)
+}
+
+// VisitStructExpression builds an object of the struct type, the fields are members.
+func (b *builder) VisitStructExpression(raw rustparser.IStructExpressionContext) ssa.Value {
+	i, _ := raw.(*rustparser.StructExpressionContext)
+	if i == nil {
+		return nil
+	}
+	segments := pathInExpressionSegments(i.PathInExpression())
+	var blueprint *ssa.Blueprint
+	if len(segments) == 1 {
+		blueprint = b.lookupBlueprint(segments[0])
+	} else {
+		blueprint = blueprintOfValue(b.resolvePath(segments))
+	}
+
+	object := b.EmitEmptyContainer()
+	if blueprint != nil {
+		object.SetType(blueprint)
+	}
+	base := i.StructBase()
+	fields, _ := i.StructExprFields().(*rustparser.StructExprFieldsContext)
+	if fields != nil && base == nil {
+		base = fields.StructBase()
+	}
+	if base, _ := base.(*rustparser.StructBaseContext); base != nil {
+		b.VisitExpr(base.Expression())
+	}
+	if fields == nil {
+		return object
+	}
+	for _, raw := range fields.AllStructExprField() {
+		field, _ := raw.(*rustparser.StructExprFieldContext)
+		if field == nil {
+			continue
+		}
+		var name string
+		if field.TupleIndex() != nil {
+			name = field.TupleIndex().GetText()
+		} else {
+			name = nameOf(field.Identifier())
+		}
+		var value ssa.Value
+		if field.Expression() != nil {
+			value = b.VisitExpr(field.Expression())
+		} else {
+			// the shorthand `S { name }`
+			value = b.ReadValue(name)
+		}
+		member := b.CreateMemberCallVariable(object, b.fieldKey(name))
+		b.AssignVariable(member, b.valueOrUndefined(value, name))
+	}
+	return object
+}
+
+// assignTarget stores value into the place of an assignment, tuples are unpacked by index.
+func (b *builder) assignTarget(target rustparser.IExpressionContext, value ssa.Value) {
+	value = b.valueOrUndefined(value, "")
+	switch t := target.(type) {
+	case *rustparser.PathExprContext:
+		segments := pathSegments(t.PathExpression())
+		if len(segments) != 1 {
+			// a static of an other module
+			b.resolvePath(segments)
+			return
+		}
+		b.assignName(segments[0], value)
+	case *rustparser.FieldExprContext:
+		obj := b.valueOrUndefined(b.VisitExpr(t.Expression()), "")
+		member := b.CreateMemberCallVariable(obj, b.fieldKey(pathExprSegmentName(t.PathExprSegment())))
+		b.AssignVariable(member, value)
+	case *rustparser.TupleIndexExprContext:
+		obj := b.valueOrUndefined(b.VisitExpr(t.Expression()), "")
+		indexes := strings.Split(t.TupleIndex().GetText(), ".")
+		for _, index := range indexes[:len(indexes)-1] {
+			obj = b.ReadMemberCallValue(obj, b.fieldKey(index))
+		}
+		member := b.CreateMemberCallVariable(obj, b.fieldKey(indexes[len(indexes)-1]))
+		b.AssignVariable(member, value)
+	case *rustparser.IndexExprContext:
+		obj := b.valueOrUndefined(b.VisitExpr(t.Expression(0)), "")
+		key := b.valueOrUndefined(b.VisitExpr(t.Expression(1)), "")
+		member := b.CreateMemberCallVariable(obj, key)
+		b.AssignVariable(member, value)
+	case *rustparser.DerefExprContext:
+		b.assignTarget(t.Expression(), value)
+	case *rustparser.GroupedExprContext:
+		b.assignTarget(t.Expression(), value)
+	case *rustparser.TupleExprContext:
+		b.unpackTarget(tupleElements(t.TupleElements()), value)
+	case *rustparser.ArrayExprContext:
+		b.unpackTarget(arrayElements(t.ArrayElements()), value)
+	default:
+		b.NewError(ssa.Error, TAG, InvalidAssignTarget())
+	}
+}
+
+func (b *builder) unpackTarget(elems []rustparser.IExpressionContext, value ssa.Value) {
+	for i, elem := range elems {
+		switch e := elem.(type) {
+		case *rustparser.UnderscoreExprContext:
+			continue
+		case *rustparser.RangeToExprContext:
+			if e.Expression() == nil {
+				// the rest `..`
+				continue
+			}
+		}
+		b.assignTarget(elem, b.ReadMemberCallValue(value, b.EmitConstInst(i)))
+	}
+}
@@ -0,0 +1,554 @@
+package rust2ssa
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/google/uuid"
+	rustparser "github.com/yaklang/yaklang/common/yak/rust/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// itemSet is the items of a module or a block. Rust items are visible in the whole
+// scope, so the types and functions are declared before anything is built and the
+// function bodies are built last.
+type itemSet struct {
+	items  []antlr.ParserRuleContext
+	names  []string
+	bodies []func()
+}
+
+func (s *itemSet) bind(name string) {
+	if name == "" || name == "_" {
+		return
+	}
+	s.names = append(s.names, name)
+}
+
+// itemOf returns the context of the declared item, e.g. a *rustparser.Function_Context,
+// or the *rustparser.MacroItemContext of a macro at item level.
+func itemOf(raw rustparser.IItemContext) antlr.ParserRuleContext {
+	i, _ := raw.(*rustparser.ItemContext)
+	if i == nil {
+		return nil
+	}
+	if item := i.VisItem(); item != nil {
+		return visItemOf(item)
+	}
+	if item, ok := i.MacroItem().(*rustparser.MacroItemContext); ok {
+		return item
+	}
+	return nil
+}
+
+// visItemOf skips the visibility of an item.
+func visItemOf(raw rustparser.IVisItemContext) antlr.ParserRuleContext {
+	if raw == nil || raw.GetChildCount() == 0 {
+		return nil
+	}
+	item, _ := raw.GetChild(raw.GetChildCount() - 1).(antlr.ParserRuleContext)
+	return item
+}
+
+func itemsOf(raws []rustparser.IItemContext) []antlr.ParserRuleContext {
+	items := make([]antlr.ParserRuleContext, 0, len(raws))
+	for _, raw := range raws {
+		if item := itemOf(raw); item != nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// VisitItems builds the items of a file or of an inline module and returns the bound names.
+func (b *builder) VisitItems(items []antlr.ParserRuleContext) []string {
+	set := b.declareItems(items)
+	b.defineItems(set)
+	return set.names
+}
+
+// declareItems binds the types and the functions of the scope.
+func (b *builder) declareItems(items []antlr.ParserRuleContext) *itemSet {
+	set := &itemSet{items: items}
+	if b.IsStop() {
+		return set
+	}
+
+	for _, item := range items {
+		switch it := item.(type) {
+		case *rustparser.Struct_Context:
+			set.bind(b.declareStruct(it))
+		case *rustparser.Union_Context:
+			set.bind(b.declareUnion(it))
+		case *rustparser.EnumerationContext:
+			set.bind(b.declareEnum(it))
+		case *rustparser.Trait_Context:
+			set.bind(b.declareTrait(it))
+		}
+	}
+
+	for _, item := range items {
+		switch it := item.(type) {
+		case *rustparser.TypeAliasContext:
+			if blueprint := b.blueprintOfType(it.Type_()); blueprint != nil {
+				name := nameOf(it.Identifier())
+				b.assignName(name, blueprint.Container())
+				set.bind(name)
+			}
+		case *rustparser.Function_Context:
+			recoverRange := b.SetRange(it)
+			name := nameOf(it.Identifier())
+			fn := b.NewFunc(name)
+			b.returnBlueprint(fn, it.FunctionReturnType())
+			b.assignName(name, fn)
+			recoverRange()
+			set.bind(name)
+			set.bodies = append(set.bodies, b.lazyFunction(fn, it, nil))
+		case *rustparser.ExternBlockContext:
+			for _, raw := range it.AllExternalItem() {
+				foreign, _ := raw.(*rustparser.ExternalItemContext)
+				if foreign == nil {
+					continue
+				}
+				name := ""
+				if fn, _ := foreign.Function_().(*rustparser.Function_Context); fn != nil {
+					name = nameOf(fn.Identifier())
+				} else if static, _ := foreign.StaticItem().(*rustparser.StaticItemContext); static != nil {
+					name = nameOf(static.Identifier())
+				}
+				if name == "" {
+					continue
+				}
+				recoverRange := b.SetRange(foreign)
+				b.assignName(name, b.externalPath([]string{"extern", name}))
+				recoverRange()
+				set.bind(name)
+			}
+		}
+	}
+	return set
+}
+
+// defineItems builds the modules, imports, constants and impl blocks of the scope,
+// then the bodies of the functions.
+func (b *builder) defineItems(set *itemSet) {
+	if b.IsStop() {
+		return
+	}
+	for _, item := range set.items {
+		switch it := item.(type) {
+		case *rustparser.UseDeclarationContext:
+			for _, tree := range b.VisitUse(it) {
+				if !tree.glob {
+					set.bind(tree.boundName())
+				}
+			}
+		case *rustparser.ModuleContext:
+			if b.visitMod(it) {
+				set.bind(nameOf(it.Identifier()))
+			}
+		case *rustparser.ExternCrateContext:
+			recoverRange := b.SetRange(it)
+			ids := it.AllIdentifier()
+			crate := "self"
+			if it.KW_SELFVALUE() == nil {
+				crate, ids = nameOf(ids[0]), ids[1:]
+			}
+			name := crate
+			if it.KW_AS() != nil {
+				// `as _` binds no name
+				name = "_"
+				if len(ids) > 0 {
+					name = nameOf(ids[0])
+				}
+			}
+			if getModuleByName(b.GetProgram().GetApplication(), crate) != nil {
+				b.assignName(name, b.moduleValue(crate))
+			} else {
+				b.assignName(name, b.externalPath([]string{crate}))
+			}
+			recoverRange()
+			set.bind(name)
+		}
+	}
+
+	for _, item := range set.items {
+		var name string
+		var value rustparser.IExpressionContext
+		switch it := item.(type) {
+		case *rustparser.ConstantItemContext:
+			name, value = "_", it.Expression()
+			if it.Identifier() != nil {
+				name = nameOf(it.Identifier())
+			}
+		case *rustparser.StaticItemContext:
+			name, value = nameOf(it.Identifier()), it.Expression()
+		default:
+			continue
+		}
+		recoverRange := b.SetRange(item)
+		b.assignName(name, b.VisitExpr(value))
+		recoverRange()
+		set.bind(name)
+	}
+
+	// the methods of the traits are registered first, the impl blocks inherit them
+	for _, item := range set.items {
+		if it, ok := item.(*rustparser.Trait_Context); ok {
+			set.bodies = append(set.bodies, b.visitTraitMethods(it)...)
+		}
+	}
+	for _, item := range set.items {
+		if it, ok := item.(*rustparser.ImplementationContext); ok {
+			set.bodies = append(set.bodies, b.visitImpl(it)...)
+		}
+	}
+
+	for _, item := range set.items {
+		if it, ok := item.(*rustparser.MacroItemContext); ok && it.MacroInvocationSemi() != nil {
+			b.VisitMacroInvocationSemi(it.MacroInvocationSemi())
+		}
+	}
+
+	for _, body := range set.bodies {
+		if b.IsStop() {
+			return
+		}
+		body()
+	}
+}
+
+func (b *builder) declareStruct(it *rustparser.Struct_Context) string {
+	recoverRange := b.SetRange(it)
+	defer recoverRange()
+	name := nameOf(it.Identifier())
+	blueprint := b.CreateBlueprint(name)
+	switch {
+	case it.LPAREN() != nil:
+		b.tupleStructs[blueprint] = struct{}{}
+	case it.LCURLYBRACE() == nil:
+		b.unitStructs[blueprint] = struct{}{}
+	}
+	return name
+}
+
+func (b *builder) declareUnion(it *rustparser.Union_Context) string {
+	recoverRange := b.SetRange(it)
+	defer recoverRange()
+	name := nameOf(it.Identifier())
+	b.CreateBlueprint(name)
+	return name
+}
+
+// declareEnum creates a blueprint for the enum, the variants are static members.
+func (b *builder) declareEnum(it *rustparser.EnumerationContext) string {
+	recoverRange := b.SetRange(it)
+	defer recoverRange()
+	name := nameOf(it.Identifier())
+	blueprint := b.CreateBlueprint(name)
+	variants, _ := it.EnumItems().(*rustparser.EnumItemsContext)
+	if variants == nil {
+		return name
+	}
+	for _, raw := range variants.AllEnumItem() {
+		variant, _ := raw.(*rustparser.EnumItemContext)
+		if variant == nil {
+			continue
+		}
+		variantName := nameOf(variant.Identifier())
+		var value ssa.Value
+		if variant.Expression() != nil {
+			value = b.VisitExpr(variant.Expression())
+		} else {
+			value = b.EmitUndefined(variantName)
+		}
+		blueprint.RegisterStaticMember(variantName, value)
+	}
+	return name
+}
+
+// declareTrait creates an interface blueprint, the supertraits are its parents.
+func (b *builder) declareTrait(it *rustparser.Trait_Context) string {
+	recoverRange := b.SetRange(it)
+	defer recoverRange()
+	name := nameOf(it.Identifier())
+	blueprint := b.CreateInterface(name)
+	bounds, _ := it.TypeParamBounds().(*rustparser.TypeParamBoundsContext)
+	if bounds == nil {
+		return name
+	}
+	for _, super := range bounds.AllTypeParamBound() {
+		segments := boundSegments([]rustparser.ITypeParamBoundContext{super})
+		if len(segments) != 1 {
+			continue
+		}
+		if parent := b.lookupBlueprint(segments[0]); parent != nil && parent != blueprint {
+			blueprint.AddParentBlueprint(parent)
+		}
+	}
+	return name
+}
+
+// visitMod binds a module declaration, an inline module is built in a nested scope and
+// `mod name;` is the value of the project module.
+func (b *builder) visitMod(it *rustparser.ModuleContext) bool {
+	recoverRange := b.SetRange(it)
+	defer recoverRange()
+
+	name := nameOf(it.Identifier())
+	if it.LCURLYBRACE() != nil {
+		object := b.EmitEmptyContainer()
+		object.SetName(name)
+		b.BuildSyntaxBlock(func() {
+			for _, name := range b.VisitItems(itemsOf(it.AllItem())) {
+				value := b.PeekValue(name)
+				if value == nil {
+					continue
+				}
+				member := b.CreateMemberCallVariable(object, b.EmitConstInstPlaceholder(name))
+				b.AssignVariable(member, value)
+			}
+		})
+		b.assignName(name, object)
+		return true
+	}
+	if b.module == nil {
+		return false
+	}
+	child := b.module.name + "::" + name
+	if getModuleByName(b.GetProgram().GetApplication(), child) == nil {
+		return false
+	}
+	b.moduleAliases[name] = child
+	b.assignName(name, b.moduleValue(child))
+	return true
+}
+
+// implBlueprint returns the blueprint of the type of an impl block. Methods of types
+// declared elsewhere (other crates, primitive types) get a blueprint of their own.
+func (b *builder) implBlueprint(typ rustparser.IType_Context) *ssa.Blueprint {
+	segments := typeSegments(typ)
+	if len(segments) == 0 {
+		return nil
+	}
+	name := segments[len(segments)-1]
+	if len(segments) > 1 {
+		if blueprint := blueprintOfValue(b.resolvePath(segments)); blueprint != nil {
+			return blueprint
+		}
+	} else if blueprint := b.lookupBlueprint(name); blueprint != nil {
+		return blueprint
+	}
+	if blueprint := b.GetBluePrint(name); blueprint != nil {
+		return blueprint
+	}
+	return b.CreateBlueprint(name)
+}
+
+// visitImpl registers the methods of an impl block, functions with a self parameter are
+// normal methods and the others are static methods. The bodies are returned to be built
+// after every item of the scope has been declared.
+func (b *builder) visitImpl(it *rustparser.ImplementationContext) []func() {
+	recoverRange := b.SetRange(it)
+	defer recoverRange()
+
+	blueprint := b.implBlueprint(it.Type_())
+	if blueprint == nil {
+		return nil
+	}
+	// the methods of the impl are registered before the trait, so that they override
+	// the declarations and default methods of the trait
+	bodies := b.registerMethods(blueprint, it.AllAssociatedItem())
+	if it.TypePath() != nil && it.NOT() == nil {
+		if segments := typePathSegments(it.TypePath()); len(segments) == 1 {
+			if trait := b.lookupBlueprint(segments[0]); trait != nil && trait != blueprint {
+				blueprint.AddInterfaceBlueprint(trait)
+			}
+		}
+	}
+	return bodies
+}
+
+func (b *builder) visitTraitMethods(it *rustparser.Trait_Context) []func() {
+	blueprint := b.lookupBlueprint(nameOf(it.Identifier()))
+	if blueprint == nil {
+		return nil
+	}
+	return b.registerMethods(blueprint, it.AllAssociatedItem())
+}
+
+func (b *builder) registerMethods(blueprint *ssa.Blueprint, items []rustparser.IAssociatedItemContext) []func() {
+	var bodies []func()
+	currentSelf := b.selfBlueprint
+	b.selfBlueprint = blueprint
+	defer func() {
+		b.selfBlueprint = currentSelf
+	}()
+
+	for _, raw := range items {
+		item, _ := raw.(*rustparser.AssociatedItemContext)
+		if item == nil {
+			continue
+		}
+		if it, _ := item.Function_().(*rustparser.Function_Context); it != nil {
+			recoverRange := b.SetRange(it)
+			name := nameOf(it.Identifier())
+			funcName := fmt.Sprintf("%s_%s_%s", blueprint.Name, name, uuid.NewString()[:4])
+			fn := b.NewFunc(funcName)
+			fn.SetMethodName(name)
+			b.returnBlueprint(fn, it.FunctionReturnType())
+			if hasSelfParam(it) {
+				blueprint.RegisterNormalMethod(name, fn)
+			} else {
+				blueprint.RegisterStaticMethod(name, fn)
+			}
+			recoverRange()
+			if it.BlockExpression() == nil {
+				// a required method of a trait
+				continue
+			}
+			bodies = append(bodies, b.lazyFunction(fn, it, blueprint))
+		} else if it, _ := item.ConstantItem().(*rustparser.ConstantItemContext); it != nil {
+			recoverRange := b.SetRange(it)
+			name := "_"
+			if it.Identifier() != nil {
+				name = nameOf(it.Identifier())
+			}
+			var value ssa.Value
+			if it.Expression() != nil {
+				value = b.VisitExpr(it.Expression())
+			} else {
+				value = b.EmitUndefined(name)
+			}
+			blueprint.RegisterStaticMember(name, value)
+			recoverRange()
+		} else if item.MacroInvocationSemi() != nil {
+			b.VisitMacroInvocationSemi(item.MacroInvocationSemi())
+		}
+	}
+	return bodies
+}
+
+// lazyFunction defers the body of a function until it is first used: a call or a method
+// lookup builds it, so the parameter members are known at the call sites. The returned
+// function builds the body if it was never used.
+func (b *builder) lazyFunction(fn *ssa.Function, def *rustparser.Function_Context, blueprint *ssa.Blueprint) func() {
+	store := b.StoreFunctionBuilder()
+	module, aliases := b.module, b.moduleAliases
+	fn.AddLazyBuilder(func() {
+		switchHandler := b.SwitchFunctionBuilder(store)
+		currentModule, currentAliases := b.module, b.moduleAliases
+		b.module, b.moduleAliases = module, aliases
+		defer func() {
+			b.module, b.moduleAliases = currentModule, currentAliases
+			switchHandler()
+		}()
+		b.buildFunction(fn, def, blueprint)
+	})
+	return fn.Build
+}
+
+// buildFunction builds the body of a function, blueprint is the type of `Self` for methods.
+func (b *builder) buildFunction(fn *ssa.Function, def *rustparser.Function_Context, blueprint *ssa.Blueprint) {
+	recoverRange := b.SetRange(def)
+	defer recoverRange()
+
+	currentSelf, currentUnsafe := b.selfBlueprint, b.unsafeDepth
+	currentThis := b.MarkedThisClassBlueprint
+	b.FunctionBuilder = b.PushFunction(fn)
+	if blueprint != nil {
+		b.selfBlueprint = blueprint
+		b.MarkedThisClassBlueprint = blueprint
+	}
+	b.unsafeDepth = 0
+	if qualifiers, _ := def.FunctionQualifiers().(*rustparser.FunctionQualifiersContext); qualifiers != nil && qualifiers.KW_UNSAFE() != nil {
+		b.unsafeDepth = 1
+	}
+	{
+		if hasSelfParam(def) {
+			self := b.NewParam("self")
+			if blueprint != nil {
+				self.SetType(blueprint)
+			}
+		}
+		if params, _ := def.FunctionParameters().(*rustparser.FunctionParametersContext); params != nil {
+			for i, raw := range params.AllFunctionParam() {
+				param, _ := raw.(*rustparser.FunctionParamContext)
+				if param == nil {
+					continue
+				}
+				var pat antlr.ParserRuleContext
+				if param.Pattern() != nil {
+					pat = param.Pattern()
+				}
+				b.declareParam(i, param, pat, param.Type_())
+			}
+		}
+		if body := def.BlockExpression(); body != nil {
+			if value := b.visitStatements(body); value != nil {
+				b.EmitReturn([]ssa.Value{value})
+			}
+		}
+		b.Finish()
+	}
+	b.FunctionBuilder = b.PopFunction()
+	b.selfBlueprint, b.unsafeDepth = currentSelf, currentUnsafe
+	b.MarkedThisClassBlueprint = currentThis
+}
+
+func hasSelfParam(fn *rustparser.Function_Context) bool {
+	params, _ := fn.FunctionParameters().(*rustparser.FunctionParametersContext)
+	return params != nil && params.SelfParam() != nil
+}
+
+// declareParam creates a parameter, a pattern parameter is destructured from `$param_i`.
+func (b *builder) declareParam(index int, raw antlr.ParserRuleContext, pat antlr.ParserRuleContext, typ rustparser.IType_Context) {
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	var param *ssa.Parameter
+	if name := bindingName(pat); name != "" {
+		param = b.NewParam(name)
+	} else {
+		param = b.NewParam(fmt.Sprintf("$param_%d", index))
+		b.bindPattern(pat, param)
+	}
+	if blueprint := b.blueprintOfType(typ); blueprint != nil {
+		param.SetType(blueprint)
+	} else if segments := typeSegments(typ); len(segments) > 0 {
+		// a type outside of the project keeps its path, e.g. the extractors `web::Query<T>`
+		t := ssa.NewBasicType(ssa.AnyTypeKind, segments[len(segments)-1])
+		t.SetFullTypeNames([]string{strings.Join(segments, ".")})
+		param.SetType(t)
+	}
+}
+
+// VisitClosure builds a closure, the variables of the enclosing function are captured.
+func (b *builder) VisitClosure(raw *rustparser.ClosureExprContext) ssa.Value {
+	fn := b.NewFunc("closure_" + uuid.NewString()[:8])
+	b.FunctionBuilder = b.PushFunction(fn)
+	{
+		if list, _ := raw.ClosureParameterList().(*rustparser.ClosureParameterListContext); list != nil {
+			if params, _ := list.ClosureParameters().(*rustparser.ClosureParametersContext); params != nil {
+				for i, raw := range params.AllClosureParam() {
+					if param, _ := raw.(*rustparser.ClosureParamContext); param != nil {
+						b.declareParam(i, param, param.PatternNoTopAlt(), param.Type_())
+					}
+				}
+			}
+		}
+		var value ssa.Value
+		if block := plainBlock(raw.Expression()); block != nil {
+			value = b.visitStatements(block)
+		} else {
+			value = b.VisitExpr(raw.Expression())
+		}
+		if value != nil {
+			b.EmitReturn([]ssa.Value{value})
+		}
+		b.Finish()
+	}
+	b.FunctionBuilder = b.PopFunction()
+	return fn
+}
@@ -0,0 +1,400 @@
+package rust2ssa
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	rustparser "github.com/yaklang/yaklang/common/yak/rust/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *builder) VisitCrate(raw rustparser.ICrateContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	// items are hoisted inside their module, the pre-handler only records the module names
+	if b.PreHandler() {
+		return
+	}
+	crate, _ := raw.(*rustparser.CrateContext)
+	if crate == nil {
+		return
+	}
+
+	info := getModuleByPath(b.GetProgram().GetApplication(), b.GetEditor().GetFilename())
+	if info == nil {
+		// single file, build it in the current function
+		recoverRange := b.SetRange(crate)
+		defer recoverRange()
+		b.VisitItems(itemsOf(crate.AllItem()))
+		return
+	}
+	b.buildModule(info, crate)
+}
+
+// buildModule builds a project file in the main function of its own library, the items of
+// the module are exported by the library. The names are exported once they are declared so
+// that a module importing this one back while the bodies are built can see them.
+func (b *builder) buildModule(info *moduleInfo, crate *rustparser.CrateContext) {
+	app := b.GetProgram().GetApplication()
+	if lib, _ := app.GetLibrary(info.name); lib != nil {
+		// already built (or being built) when an other module used it
+		return
+	}
+	lib := app.NewLibrary(info.name, strings.Split(info.name, "::"))
+	lib.PushEditor(app.GetCurrentEditor())
+	defer lib.PopEditor(true)
+
+	libBuilder := lib.GetAndCreateFunctionBuilder(info.name, string(ssa.MainFunctionName))
+	if libBuilder == nil {
+		return
+	}
+	libBuilder.SetEditor(app.GetCurrentEditor())
+	libBuilder.SetBuildSupport(b.FunctionBuilder)
+	libBuilder.SupportClosure = true
+	currentBuilder, currentModule, currentAliases := b.FunctionBuilder, b.module, b.moduleAliases
+	b.FunctionBuilder, b.module, b.moduleAliases = libBuilder, info, make(map[string]string)
+	defer func() {
+		b.FunctionBuilder, b.module, b.moduleAliases = currentBuilder, currentModule, currentAliases
+	}()
+
+	recoverRange := b.SetRange(crate)
+	defer recoverRange()
+
+	export := func(names []string) {
+		for _, name := range names {
+			value := b.PeekValueInThisFunction(name)
+			if value == nil {
+				continue
+			}
+			lib.SetExportValue(name, value)
+			if blueprint := blueprintOfValue(value); blueprint != nil {
+				lib.SetExportType(name, blueprint)
+			}
+		}
+	}
+	items := b.declareItems(itemsOf(crate.AllItem()))
+	export(items.names)
+	b.defineItems(items)
+	export(items.names)
+}
+
+// loadModule returns the library of a project module, the module is built first when
+// it has not been reached yet, nil means the module is not part of the project.
+func (b *builder) loadModule(name string) *ssa.Program {
+	app := b.GetProgram().GetApplication()
+	info := getModuleByName(app, name)
+	if info == nil {
+		return nil
+	}
+	if lib, _ := app.GetLibrary(info.name); lib != nil {
+		return lib
+	}
+	source, err := app.Loader.GetFilesysFileSystem().ReadFile(info.path)
+	if err != nil {
+		log.Errorf("read file %s error: %v", info.path, err)
+		return nil
+	}
+	app.Build(info.path, memedit.NewMemEditor(string(source)), b.FunctionBuilder)
+	lib, _ := app.GetLibrary(info.name)
+	return lib
+}
+
+// moduleExports returns the names exported by a library in a stable order.
+func moduleExports(lib *ssa.Program) []string {
+	names := lo.Keys(lib.ExportValue)
+	sort.Strings(names)
+	return names
+}
+
+// moduleValue is the value of a module path: an object holding the exports for a
+// project module, or an undefined value named after an external crate.
+func (b *builder) moduleValue(name string) ssa.Value {
+	if lib := b.loadModule(name); lib != nil {
+		object := b.EmitEmptyContainer()
+		object.SetName(name)
+		for _, export := range moduleExports(lib) {
+			value := b.importValue(lib, export)
+			if value == nil {
+				continue
+			}
+			member := b.CreateMemberCallVariable(object, b.EmitConstInstPlaceholder(export))
+			b.AssignVariable(member, value)
+		}
+		return object
+	}
+	return b.externalPath(strings.Split(name, "::"))
+}
+
+func (b *builder) importValue(lib *ssa.Program, name string) ssa.Value {
+	prog := b.GetProgram()
+	if err := prog.ImportValueFromLib(lib, name); err != nil {
+		return nil
+	}
+	value, ok := prog.ReadImportValueWithPkg(lib.Name, name)
+	if !ok {
+		return nil
+	}
+	return value
+}
+
+// externalPath is the value of a path outside of the project, e.g. `std::process::Command`.
+// The first segment keeps the path searchable without binding it in the current scope.
+func (b *builder) externalPath(segments []string) ssa.Value {
+	var value ssa.Value = b.EmitUndefined(segments[0])
+	b.GetProgram().SetInstructionWithName(segments[0], value)
+	for _, seg := range segments[1:] {
+		value = b.ReadMemberCallValue(value, b.EmitConstInstPlaceholder(seg))
+	}
+	return value
+}
+
+func (b *builder) memberChain(value ssa.Value, segments []string) ssa.Value {
+	for _, seg := range segments {
+		value = b.ReadMemberCallMethodOrValue(value, b.EmitConstInstPlaceholder(seg))
+	}
+	return value
+}
+
+// moduleChain resolves the rest of a path starting at a project module: the longest
+// sub module, then an item exported by it, then the members of the item.
+func (b *builder) moduleChain(module string, rest []string) ssa.Value {
+	app := b.GetProgram().GetApplication()
+	i := 0
+	for i < len(rest) && getModuleByName(app, module+"::"+rest[i]) != nil {
+		module += "::" + rest[i]
+		i++
+	}
+	if i == len(rest) {
+		return b.moduleValue(module)
+	}
+	var value ssa.Value
+	if lib := b.loadModule(module); lib != nil {
+		value = b.importValue(lib, rest[i])
+	}
+	if value == nil {
+		// a private item or a module being built, keep the full path
+		segments := append(strings.Split(module, "::"), rest[i])
+		value = b.externalPath(segments)
+	}
+	return b.memberChain(value, rest[i+1:])
+}
+
+// relativeModule resolves the leading `crate`, `self` and `super` of a path in a project file,
+// it returns the module and the remaining segments.
+func (b *builder) relativeModule(segments []string) (string, []string, bool) {
+	switch segments[0] {
+	case "crate":
+		return b.module.crate, segments[1:], true
+	case "self":
+		return b.module.name, segments[1:], true
+	case "super":
+		module := b.module.name
+		for len(segments) > 0 && segments[0] == "super" {
+			idx := strings.LastIndex(module, "::")
+			if idx < 0 {
+				b.NewError(ssa.Error, TAG, SuperBeyondCrateRoot(strings.Join(segments, "::")))
+				return module, segments[1:], true
+			}
+			module = module[:idx]
+			segments = segments[1:]
+		}
+		return module, segments, true
+	}
+	return "", segments, false
+}
+
+// resolvePath returns the value of a path expression. Names in scope are read first, then
+// the modules of the project and finally the path is an external item.
+func (b *builder) resolvePath(segments []string) ssa.Value {
+	if len(segments) == 0 {
+		return b.EmitUndefined("")
+	}
+	first := segments[0]
+	switch first {
+	case "Self":
+		if b.selfBlueprint == nil {
+			return b.externalPath(segments)
+		}
+		return b.memberChain(b.selfBlueprint.Container(), segments[1:])
+	case "crate", "self", "super":
+		if first == "self" && len(segments) == 1 {
+			return b.ReadValue("self")
+		}
+		if b.module == nil {
+			// single file, the items of the parent modules are visible lexically
+			rest := lo.DropWhile(segments, func(s string) bool {
+				return s == "crate" || s == "self" || s == "super"
+			})
+			if len(rest) == 0 {
+				return b.EmitUndefined(first)
+			}
+			return b.memberChain(b.ReadValue(rest[0]), rest[1:])
+		}
+		module, rest, _ := b.relativeModule(segments)
+		if len(rest) == 0 {
+			return b.moduleValue(module)
+		}
+		return b.moduleChain(module, rest)
+	}
+
+	if len(segments) == 1 {
+		return b.ReadValue(first)
+	}
+	if module, ok := b.moduleAliases[first]; ok {
+		return b.moduleChain(module, segments[1:])
+	}
+	if value := b.PeekValue(first); value != nil {
+		return b.memberChain(value, segments[1:])
+	}
+	if b.module != nil {
+		app := b.GetProgram().GetApplication()
+		if child := b.module.name + "::" + first; getModuleByName(app, child) != nil {
+			return b.moduleChain(child, segments[1:])
+		}
+		if getModuleByName(app, first) != nil {
+			// an other crate of the workspace
+			return b.moduleChain(first, segments[1:])
+		}
+	}
+	return b.externalPath(segments)
+}
+
+// useTree is a leaf of a use declaration, `use a::{b, c as d, e::*}` has three trees:
+// a::b, a::c (alias d) and a::e (glob).
+type useTree struct {
+	node  antlr.ParserRuleContext
+	path  []string
+	alias string
+	glob  bool
+}
+
+// boundName is the name bound by the tree, `self` binds the parent module name.
+func (t *useTree) boundName() string {
+	if t.alias != "" {
+		return t.alias
+	}
+	if len(t.path) == 0 {
+		return ""
+	}
+	name := t.path[len(t.path)-1]
+	if name == "self" && len(t.path) > 1 {
+		return t.path[len(t.path)-2]
+	}
+	return name
+}
+
+// useTrees flattens the nested trees of a use declaration.
+func useTrees(prefix []string, raw rustparser.IUseTreeContext) []*useTree {
+	i, _ := raw.(*rustparser.UseTreeContext)
+	if i == nil {
+		return nil
+	}
+	path := append(append([]string{}, prefix...), simplePathSegments(i.SimplePath())...)
+	switch {
+	case i.STAR() != nil:
+		return []*useTree{{node: i, path: path, glob: true}}
+	case i.LCURLYBRACE() != nil:
+		var trees []*useTree
+		for _, child := range i.AllUseTree() {
+			trees = append(trees, useTrees(path, child)...)
+		}
+		return trees
+	}
+	tree := &useTree{node: i, path: path}
+	if i.KW_AS() != nil {
+		tree.alias = "_"
+		if i.Identifier() != nil {
+			tree.alias = nameOf(i.Identifier())
+		}
+	}
+	return []*useTree{tree}
+}
+
+// VisitUse binds the names of a use declaration, a glob imports every item exported by a
+// project module and is ignored for external crates. The trees of the declaration are returned.
+func (b *builder) VisitUse(raw rustparser.IUseDeclarationContext) []*useTree {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	item, _ := raw.(*rustparser.UseDeclarationContext)
+	if item == nil {
+		return nil
+	}
+	trees := useTrees(nil, item.UseTree())
+	for _, tree := range trees {
+		segments := tree.path
+		if len(segments) > 1 && segments[len(segments)-1] == "self" {
+			segments = segments[:len(segments)-1]
+		}
+		if len(segments) == 0 {
+			continue
+		}
+		if tree.glob {
+			b.useGlob(segments)
+			continue
+		}
+		recoverRange := b.SetRange(tree.node)
+		name := tree.boundName()
+		value := b.resolvePath(segments)
+		recoverRange()
+		if name == "_" {
+			continue
+		}
+		if module, ok := b.projectModule(segments); ok {
+			b.moduleAliases[name] = module
+		}
+		b.assignName(name, value)
+	}
+	return trees
+}
+
+// projectModule returns the project module named by a path, nil for items and external crates.
+func (b *builder) projectModule(segments []string) (string, bool) {
+	if b.module == nil {
+		return "", false
+	}
+	module, rest, ok := b.relativeModule(segments)
+	if !ok {
+		app := b.GetProgram().GetApplication()
+		if alias, ok := b.moduleAliases[segments[0]]; ok {
+			module, rest = alias, segments[1:]
+		} else if child := b.module.name + "::" + segments[0]; getModuleByName(app, child) != nil {
+			module, rest = child, segments[1:]
+		} else if getModuleByName(app, segments[0]) != nil {
+			module, rest = segments[0], segments[1:]
+		} else {
+			return "", false
+		}
+	}
+	for _, seg := range rest {
+		module += "::" + seg
+	}
+	if getModuleByName(b.GetProgram().GetApplication(), module) == nil {
+		return "", false
+	}
+	return module, true
+}
+
+func (b *builder) useGlob(segments []string) {
+	module, ok := b.projectModule(segments)
+	if !ok {
+		return
+	}
+	lib := b.loadModule(module)
+	if lib == nil {
+		return
+	}
+	for _, export := range moduleExports(lib) {
+		if value := b.importValue(lib, export); value != nil {
+			b.assignName(export, value)
+		}
+	}
+}
@@ -0,0 +1,657 @@
+package rust2ssa
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/google/uuid"
+	rustparser "github.com/yaklang/yaklang/common/yak/rust/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// visitStatements builds the statements of a block in the current scope and returns the
+// value of the tail expression, nil when the block has no value.
+func (b *builder) visitStatements(raw rustparser.IBlockExpressionContext) ssa.Value {
+	block, _ := raw.(*rustparser.BlockExpressionContext)
+	if block == nil {
+		return nil
+	}
+
+	stmts := block.AllStatement()
+	var items []antlr.ParserRuleContext
+	for _, raw := range stmts {
+		if stmt, _ := raw.(*rustparser.StatementContext); stmt != nil {
+			if item := visItemOf(stmt.VisItem()); item != nil {
+				items = append(items, item)
+			}
+		}
+	}
+	if len(items) > 0 {
+		b.VisitItems(items)
+	}
+
+	// without a tail expression, a last statement like `if` or `match` is the value of the block
+	tail := block.Expression()
+	var tailStmt rustparser.IStatementContext
+	if tail == nil && len(stmts) > 0 && isTailStatement(stmts[len(stmts)-1]) {
+		tailStmt, stmts = stmts[len(stmts)-1], stmts[:len(stmts)-1]
+	}
+	for _, stmt := range stmts {
+		if b.IsStop() {
+			return nil
+		}
+		b.VisitStmt(stmt)
+	}
+	switch {
+	case b.IsStop():
+		return nil
+	case tail != nil:
+		return b.VisitExpr(tail)
+	case tailStmt != nil:
+		return b.visitStmtValue(tailStmt)
+	}
+	return nil
+}
+
+// isTailStatement is a block-like expression or a macro with braces ending a block
+// without `;`.
+func isTailStatement(raw rustparser.IStatementContext) bool {
+	stmt, _ := raw.(*rustparser.StatementContext)
+	return stmt != nil && (stmt.ExpressionWithBlock() != nil || stmt.SimplePath() != nil)
+}
+
+// plainBlock returns the block of `{ ... }` without a label, other expressions give nil.
+func plainBlock(raw rustparser.IExpressionContext) rustparser.IBlockExpressionContext {
+	expr, ok := raw.(*rustparser.BlockLikeExprContext)
+	if !ok {
+		return nil
+	}
+	block, _ := expr.ExpressionWithBlock().(*rustparser.ExpressionWithBlockContext)
+	if block == nil || block.GetChildCount() != 1 {
+		return nil
+	}
+	return block.BlockExpression()
+}
+
+// VisitBlock builds a block expression in a nested scope.
+func (b *builder) VisitBlock(raw rustparser.IBlockExpressionContext, label rustparser.ILoopLabelContext) ssa.Value {
+	var value ssa.Value
+	if label != nil {
+		// a labeled block can be left by `break 'label value`, it is a loop running once
+		id := b.newTempVariable()
+		loop := b.CreateLoopBuilderWithLabelName(labelName(label))
+		loop.SetCondition(func() ssa.Value {
+			return b.EmitConstInst(true)
+		})
+		loop.SetBody(func() {
+			b.assignTemp(id, b.visitStatements(raw))
+			b.Break()
+		})
+		loop.Finish()
+		return b.ReadValue(id)
+	}
+	b.BuildSyntaxBlock(func() {
+		value = b.visitStatements(raw)
+	})
+	return value
+}
+
+// labelName is the name of a loop label without the trailing `:`.
+func labelName(label rustparser.ILoopLabelContext) string {
+	i, _ := label.(*rustparser.LoopLabelContext)
+	if i == nil {
+		return ""
+	}
+	return i.LIFETIME_OR_LABEL().GetText()
+}
+
+func (b *builder) VisitStmt(raw rustparser.IStatementContext) {
+	b.visitStmtValue(raw)
+}
+
+// visitStmtValue builds a statement and returns the value of a statement without `;`.
+func (b *builder) visitStmtValue(raw rustparser.IStatementContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	stmt, _ := raw.(*rustparser.StatementContext)
+	if stmt == nil {
+		return nil
+	}
+	switch {
+	case stmt.LetStatement() != nil:
+		b.VisitLet(stmt.LetStatement())
+	case stmt.ExpressionWithBlock() != nil:
+		return b.VisitExpressionWithBlock(stmt.ExpressionWithBlock())
+	case stmt.SimplePath() != nil:
+		return b.VisitMacroCall(newMacroCall(stmt, stmt.SimplePath(), stmt.AllTokenTree()))
+	case stmt.Expression() != nil:
+		b.VisitExpr(stmt.Expression())
+	}
+	// items are declared when the block is entered
+	return nil
+}
+
+// VisitLet binds the pattern of a let statement, the else block of `let ... else`
+// runs when the pattern does not match.
+func (b *builder) VisitLet(raw rustparser.ILetStatementContext) {
+	stmt, _ := raw.(*rustparser.LetStatementContext)
+	if stmt == nil {
+		return
+	}
+	var value ssa.Value
+	if stmt.Expression() != nil {
+		value = b.VisitExpr(stmt.Expression())
+	}
+	if value == nil {
+		value = b.EmitValueOnlyDeclare(bindingName(stmt.Pattern()))
+	}
+	if blueprint := b.blueprintOfType(stmt.Type_()); blueprint != nil && value.GetType() == nil {
+		value.SetType(blueprint)
+	}
+	if stmt.BlockExpression() == nil {
+		b.bindPattern(stmt.Pattern(), value)
+		return
+	}
+	condition := b.matchPattern(stmt.Pattern(), value)
+	if condition == nil {
+		return
+	}
+	b.CreateIfBuilder().AppendItem(
+		func() ssa.Value {
+			return b.EmitUnOp(ssa.OpNot, condition)
+		},
+		func() {
+			b.visitStatements(stmt.BlockExpression())
+		},
+	).Build()
+}
+
+// bindingName returns the name of a pattern binding a single name, `x` or `mut x`.
+func bindingName(raw antlr.ParserRuleContext) string {
+	if pattern, ok := raw.(*rustparser.PatternContext); ok {
+		if len(pattern.AllPatternNoTopAlt()) != 1 {
+			return ""
+		}
+		raw = pattern.PatternNoTopAlt(0)
+	}
+	noTopAlt, ok := raw.(rustparser.IPatternNoTopAltContext)
+	if !ok {
+		return ""
+	}
+	if ident, ok := identifierPattern(noTopAlt); ok && ident.AT() == nil {
+		return nameOf(ident.Identifier())
+	}
+	return ""
+}
+
+// bindPattern declares the names of an irrefutable pattern.
+func (b *builder) bindPattern(pat antlr.ParserRuleContext, value ssa.Value) {
+	b.matchPattern(pat, value)
+}
+
+// matchPattern declares the names bound by a pattern (a pattern or a patternNoTopAlt) and
+// returns the match condition, nil means the pattern always matches. Enum variants are not
+// modeled: `Some(x)` binds the value itself and `Message::Write(text)` binds the first field.
+func (b *builder) matchPattern(raw antlr.ParserRuleContext, subject ssa.Value) ssa.Value {
+	and := func(x, y ssa.Value) ssa.Value {
+		if x == nil {
+			return y
+		}
+		if y == nil {
+			return x
+		}
+		return b.EmitBinOp(ssa.OpLogicAnd, x, y)
+	}
+	elements := func(elems []rustparser.IPatternContext) ssa.Value {
+		var condition ssa.Value
+		index := 0
+		for _, elem := range elems {
+			if isRestPattern(elem) {
+				continue
+			}
+			item := b.ReadMemberCallValue(subject, b.EmitConstInst(index))
+			condition = and(condition, b.matchPattern(elem, item))
+			index++
+		}
+		return condition
+	}
+
+	if ident, ok := raw.(rustparser.IPatternNoTopAltContext); ok {
+		if ident, ok := identifierPattern(ident); ok {
+			b.declareLocal(nameOf(ident.Identifier()), subject)
+			if ident.PatternNoTopAlt() != nil {
+				return b.matchPattern(ident.PatternNoTopAlt(), subject)
+			}
+			return nil
+		}
+	}
+
+	switch p := raw.(type) {
+	case nil:
+		return nil
+	case *rustparser.PatternContext:
+		alts := p.AllPatternNoTopAlt()
+		if len(alts) == 1 {
+			return b.matchPattern(alts[0], subject)
+		}
+		var condition ssa.Value
+		for i, alt := range alts {
+			var c ssa.Value
+			if i == 0 {
+				c = b.matchPattern(alt, subject)
+			} else {
+				// the alternatives bind the same names, the first one declares them
+				c = b.patternCondition(alt, subject)
+			}
+			if c == nil {
+				c = b.EmitConstInst(true)
+			}
+			if condition == nil {
+				condition = c
+			} else {
+				condition = b.EmitBinOp(ssa.OpLogicOr, condition, c)
+			}
+		}
+		return condition
+	case *rustparser.IdentifierPatternContext:
+		// a capitalized name, a unit struct or an enum variant
+		return b.EmitBinOp(ssa.OpEq, subject, b.resolvePath([]string{nameOf(p.Identifier())}))
+	case *rustparser.ReferencePatternContext:
+		return b.matchPattern(p.PatternNoTopAlt(), subject)
+	case *rustparser.TuplePatternContext:
+		if elems := p.AllPattern(); len(elems) == 1 && len(p.AllCOMMA()) == 0 {
+			// a parenthesized pattern
+			return b.matchPattern(elems[0], subject)
+		}
+		return elements(p.AllPattern())
+	case *rustparser.SlicePatternContext:
+		return elements(p.AllPattern())
+	case *rustparser.TupleStructPatternContext:
+		segments := pathInExpressionSegments(p.PathInExpression())
+		if elems := p.AllPattern(); len(elems) == 1 && len(segments) > 0 {
+			if _, ok := transparentWrappers[segments[len(segments)-1]]; ok {
+				return b.matchPattern(elems[0], subject)
+			}
+		}
+		return elements(p.AllPattern())
+	case *rustparser.StructPatternContext:
+		elems, _ := p.StructPatternElements().(*rustparser.StructPatternElementsContext)
+		if elems == nil {
+			return nil
+		}
+		var condition ssa.Value
+		for _, raw := range elems.AllStructPatternField() {
+			field, _ := raw.(*rustparser.StructPatternFieldContext)
+			if field == nil {
+				continue
+			}
+			var name string
+			if field.TupleIndex() != nil {
+				name = field.TupleIndex().GetText()
+			} else {
+				name = nameOf(field.Identifier())
+			}
+			item := b.ReadMemberCallValue(subject, b.fieldKey(name))
+			if field.Pattern() == nil {
+				b.declareLocal(name, item)
+				continue
+			}
+			condition = and(condition, b.matchPattern(field.Pattern(), item))
+		}
+		return condition
+	case *rustparser.PathPattern_Context:
+		return b.EmitBinOp(ssa.OpEq, subject, b.resolvePath(pathPatternSegments(p.PathPattern())))
+	case *rustparser.LiteralPattern_Context:
+		return b.EmitBinOp(ssa.OpEq, subject, b.visitLiteralPattern(p.LiteralPattern()))
+	case *rustparser.InclusiveRangePatternContext:
+		low := b.visitRangeBound(p.RangePatternBound(0))
+		high := b.visitRangeBound(p.RangePatternBound(1))
+		return and(b.EmitBinOp(ssa.OpGtEq, subject, low), b.EmitBinOp(ssa.OpLtEq, subject, high))
+	case *rustparser.ExclusiveRangePatternContext:
+		condition := b.EmitBinOp(ssa.OpGtEq, subject, b.visitRangeBound(p.RangePatternBound(0)))
+		if p.RangePatternBound(1) != nil {
+			condition = and(condition, b.EmitBinOp(ssa.OpLt, subject, b.visitRangeBound(p.RangePatternBound(1))))
+		}
+		return condition
+	case *rustparser.ObsoleteRangePatternContext:
+		return b.EmitBinOp(ssa.OpLtEq, subject, b.visitRangeBound(p.RangePatternBound()))
+	}
+	// `_`, `..` and macros
+	return nil
+}
+
+// patternAlternatives are the patterns of `A | B`.
+func patternAlternatives(raw rustparser.IPatternContext) []rustparser.IPatternNoTopAltContext {
+	i, _ := raw.(*rustparser.PatternContext)
+	if i == nil {
+		return nil
+	}
+	return i.AllPatternNoTopAlt()
+}
+
+func isRestPattern(raw rustparser.IPatternContext) bool {
+	alts := patternAlternatives(raw)
+	if len(alts) != 1 {
+		return false
+	}
+	_, ok := alts[0].(*rustparser.RestPatternContext)
+	return ok
+}
+
+// patternCondition is the condition of a pattern without binding its names.
+func (b *builder) patternCondition(raw rustparser.IPatternNoTopAltContext, subject ssa.Value) ssa.Value {
+	switch p := raw.(type) {
+	case *rustparser.IdentifierPatternContext:
+		if _, ok := identifierPattern(p); !ok {
+			return b.matchPattern(p, subject)
+		}
+	case *rustparser.PathPattern_Context, *rustparser.LiteralPattern_Context,
+		*rustparser.InclusiveRangePatternContext, *rustparser.ExclusiveRangePatternContext,
+		*rustparser.ObsoleteRangePatternContext:
+		return b.matchPattern(p, subject)
+	}
+	return nil
+}
+
+func pathPatternSegments(raw rustparser.IPathPatternContext) []string {
+	i, _ := raw.(*rustparser.PathPatternContext)
+	if i == nil {
+		return nil
+	}
+	if path := i.QualifiedPathInExpression(); path != nil {
+		return qualifiedPathSegments(path)
+	}
+	if segments := i.AllPathExprSegment(); len(segments) > 0 {
+		return pathExprSegmentNames(segments)
+	}
+	// `Self`, `crate` or `super`
+	return []string{i.GetText()}
+}
+
+func (b *builder) visitRangeBound(raw rustparser.IRangePatternBoundContext) ssa.Value {
+	bound, _ := raw.(*rustparser.RangePatternBoundContext)
+	if bound == nil {
+		return b.EmitUndefined("")
+	}
+	recoverRange := b.SetRange(bound)
+	defer recoverRange()
+	switch {
+	case bound.PathInExpression() != nil:
+		return b.resolvePath(pathInExpressionSegments(bound.PathInExpression()))
+	case bound.QualifiedPathInExpression() != nil:
+		return b.resolvePath(qualifiedPathSegments(bound.QualifiedPathInExpression()))
+	}
+	value := b.visitLiteralToken(bound.GetStop())
+	if bound.MINUS() != nil {
+		return b.EmitUnOp(ssa.OpNeg, value)
+	}
+	return value
+}
+
+func (b *builder) visitLiteralPattern(raw rustparser.ILiteralPatternContext) ssa.Value {
+	pattern, _ := raw.(*rustparser.LiteralPatternContext)
+	if pattern == nil {
+		return b.EmitUndefined("")
+	}
+	recoverRange := b.SetRange(pattern)
+	defer recoverRange()
+	value := b.visitLiteralToken(pattern.GetStop())
+	if pattern.MINUS() != nil {
+		return b.EmitUnOp(ssa.OpNeg, value)
+	}
+	return value
+}
+
+func (b *builder) newTempVariable() string {
+	id := "if_" + uuid.NewString()
+	b.AssignVariable(b.CreateLocalVariable(id), b.EmitValueOnlyDeclare(id))
+	return id
+}
+
+// VisitIf builds an if expression, the values of the branches are merged into a phi.
+func (b *builder) VisitIf(raw rustparser.IIfExpressionContext) ssa.Value {
+	id := b.newTempVariable()
+	ifBuilder := b.CreateIfBuilder()
+	current, _ := raw.(*rustparser.IfExpressionContext)
+	for current != nil {
+		item := current
+		ifBuilder.AppendItem(
+			func() ssa.Value {
+				return b.condition(item.Conditions())
+			},
+			func() {
+				b.assignTemp(id, b.visitStatements(item.BlockExpression(0)))
+			},
+		)
+		if elseIf, _ := item.IfExpression().(*rustparser.IfExpressionContext); elseIf != nil {
+			current = elseIf
+			continue
+		}
+		if block := item.BlockExpression(1); block != nil {
+			ifBuilder.SetElse(func() {
+				b.assignTemp(id, b.visitStatements(block))
+			})
+		}
+		break
+	}
+	ifBuilder.Build()
+	return b.ReadValue(id)
+}
+
+func (b *builder) assignTemp(id string, value ssa.Value) {
+	if value == nil {
+		return
+	}
+	b.AssignVariable(b.CreateVariable(id), value)
+}
+
+// condition builds the condition of if and while, `let` conditions bind their names. The
+// expression of a `let` takes the `&&` chain following it, the chain is split again: the
+// leftmost operand is matched and the others are conditions.
+func (b *builder) condition(raw rustparser.IConditionsContext) ssa.Value {
+	var condition ssa.Value
+	and := func(value ssa.Value) {
+		value = b.valueOrUndefined(value, "")
+		if condition == nil {
+			condition = value
+		} else {
+			condition = b.EmitBinOp(ssa.OpLogicAnd, condition, value)
+		}
+	}
+	conditions, _ := raw.(*rustparser.ConditionsContext)
+	if conditions == nil {
+		return b.EmitConstInst(true)
+	}
+	if conditions.Expression() != nil {
+		and(b.VisitExpr(conditions.Expression()))
+	}
+	for _, raw := range conditions.AllLetCondition() {
+		let, _ := raw.(*rustparser.LetConditionContext)
+		if let == nil {
+			continue
+		}
+		operands := logicalAndOperands(let.Expression())
+		and(b.VisitLetCondition(let, operands[0]))
+		for _, operand := range operands[1:] {
+			and(b.VisitExpr(operand))
+		}
+	}
+	if condition == nil {
+		return b.EmitConstInst(true)
+	}
+	return condition
+}
+
+// logicalAndOperands flattens `a && b && c` to its operands.
+func logicalAndOperands(raw rustparser.IExpressionContext) []rustparser.IExpressionContext {
+	expr, ok := raw.(*rustparser.LogicalAndExprContext)
+	if !ok {
+		return []rustparser.IExpressionContext{raw}
+	}
+	return append(logicalAndOperands(expr.Expression(0)), expr.Expression(1))
+}
+
+// VisitLetCondition matches the pattern of `if let` and `while let`.
+func (b *builder) VisitLetCondition(raw *rustparser.LetConditionContext, scrutinee rustparser.IExpressionContext) ssa.Value {
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+	subject := b.valueOrUndefined(b.VisitExpr(scrutinee), "")
+	if condition := b.matchPattern(raw.Pattern(), subject); condition != nil {
+		return condition
+	}
+	return b.EmitConstInst(true)
+}
+
+func (b *builder) VisitMatch(raw rustparser.IMatchExpressionContext) ssa.Value {
+	i, _ := raw.(*rustparser.MatchExpressionContext)
+	if i == nil {
+		return nil
+	}
+	subject := b.valueOrUndefined(b.VisitExpr(i.Expression()), "")
+	id := b.newTempVariable()
+	ifBuilder := b.CreateIfBuilder()
+	armBody := func(arm *rustparser.MatchArmContext) {
+		body := arm.Expression(len(arm.AllExpression()) - 1)
+		if block := plainBlock(body); block != nil {
+			b.assignTemp(id, b.visitStatements(block))
+			return
+		}
+		b.assignTemp(id, b.VisitExpr(body))
+	}
+	arms := i.AllMatchArm()
+	for i, raw := range arms {
+		arm, _ := raw.(*rustparser.MatchArmContext)
+		if arm == nil {
+			continue
+		}
+		guard := arm.KW_IF() != nil
+		if i > 0 && i == len(arms)-1 && !guard && isCatchAll(arm.Pattern()) {
+			// the last `_` or binding arm is the else branch
+			ifBuilder.SetElse(func() {
+				b.matchPattern(arm.Pattern(), subject)
+				armBody(arm)
+			})
+			break
+		}
+		ifBuilder.AppendItem(
+			func() ssa.Value {
+				recoverRange := b.SetRange(arm)
+				defer recoverRange()
+				condition := b.matchPattern(arm.Pattern(), subject)
+				if guard {
+					guard := b.valueOrUndefined(b.VisitExpr(arm.Expression(0)), "")
+					if condition == nil {
+						condition = guard
+					} else {
+						condition = b.EmitBinOp(ssa.OpLogicAnd, condition, guard)
+					}
+				}
+				if condition == nil {
+					return b.EmitConstInst(true)
+				}
+				return condition
+			},
+			func() {
+				armBody(arm)
+			},
+		)
+	}
+	ifBuilder.Build()
+	return b.ReadValue(id)
+}
+
+func isCatchAll(raw rustparser.IPatternContext) bool {
+	alts := patternAlternatives(raw)
+	if len(alts) != 1 {
+		return false
+	}
+	switch p := alts[0].(type) {
+	case *rustparser.WildcardPatternContext:
+		return true
+	case *rustparser.IdentifierPatternContext:
+		_, ok := identifierPattern(p)
+		return ok && p.AT() == nil
+	}
+	return false
+}
+
+func (b *builder) createLoop(label rustparser.ILoopLabelContext) *ssa.LoopBuilder {
+	if label != nil {
+		return b.CreateLoopBuilderWithLabelName(labelName(label))
+	}
+	return b.CreateLoopBuilder()
+}
+
+// VisitLoopExpression builds `loop`, `while` and `for`, loops have no value.
+func (b *builder) VisitLoopExpression(raw rustparser.ILoopExpressionContext, label rustparser.ILoopLabelContext) {
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	loop := b.createLoop(label)
+	switch e := raw.(type) {
+	case *rustparser.InfiniteLoopContext:
+		loop.SetCondition(func() ssa.Value {
+			return b.EmitConstInst(true)
+		})
+		loop.SetBody(func() {
+			b.visitStatements(e.BlockExpression())
+		})
+	case *rustparser.WhileLoopContext:
+		loop.SetCondition(func() ssa.Value {
+			return b.condition(e.Conditions())
+		})
+		loop.SetBody(func() {
+			b.visitStatements(e.BlockExpression())
+		})
+	case *rustparser.ForLoopContext:
+		var iter ssa.Value
+		loop.SetFirst(func() []ssa.Value {
+			iter = b.valueOrUndefined(b.VisitExpr(e.Expression()), "")
+			return []ssa.Value{iter}
+		})
+		loop.SetCondition(func() ssa.Value {
+			_, value, ok := b.EmitNext(iter, true)
+			if ok == nil {
+				return b.EmitConstInst(false)
+			}
+			b.bindPattern(e.Pattern(), value)
+			return ok
+		})
+		loop.SetBody(func() {
+			b.visitStatements(e.BlockExpression())
+		})
+	default:
+		return
+	}
+	loop.Finish()
+}
+
+// VisitBreak leaves the innermost loop, the exits of labeled loops are not named so
+// `break 'label` leaves the innermost loop too.
+func (b *builder) VisitBreak(raw *rustparser.BreakExprContext) {
+	if raw.Expression() != nil {
+		b.VisitExpr(raw.Expression())
+	}
+	if !b.Break() {
+		b.NewError(ssa.Error, TAG, UnexpectedBreakStmt())
+	}
+}
+
+func (b *builder) VisitContinue(raw *rustparser.ContinueExprContext) {
+	if label := raw.LIFETIME_OR_LABEL(); label != nil && b.ContinueWithLabelName(label.GetText()) {
+		return
+	}
+	if !b.Continue() {
+		b.NewError(ssa.Error, TAG, UnexpectedContinueStmt())
+	}
+}
+
+func (b *builder) VisitReturn(raw *rustparser.ReturnExprContext) {
+	if raw.Expression() == nil {
+		b.EmitReturn(nil)
+		return
+	}
+	b.EmitReturn([]ssa.Value{b.valueOrUndefined(b.VisitExpr(raw.Expression()), "")})
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"testing"
+
+	test "github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestBasic_Assign(t *testing.T) {
+	t.Run("let", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let a = 1;
+    let b = a + 2;
+    println!("{}", b);
+}
+`, []string{"3"}, t)
+	})
+
+	t.Run("tuple pattern", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let (a, b) = (1, 2);
+    println!("{}", a);
+    println!("{}", b);
+}
+`, []string{"1", "2"}, t)
+	})
+
+	t.Run("shadow and compound assign", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let a = 1;
+    let mut a = a + 1;
+    a += 2;
+    println!("{}", a);
+}
+`, []string{"4"}, t)
+	})
+}
+
+func TestBasic_Control(t *testing.T) {
+	t.Run("if expression", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let a = if c { 2 } else if d { 3 } else { 4 };
+    println!("{}", a);
+}
+`, []string{"phi(a)[2,3,4]"}, t)
+	})
+
+	t.Run("match expression", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let x = 5;
+    let a = match x {
+        1 => 10,
+        Some(v) => v,
+        _ => 30,
+    };
+    println!("{}", a);
+}
+`, []string{"phi(a)[10,5,30]"}, t)
+	})
+
+	t.Run("while loop", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let mut a = 0;
+    while a < 10 {
+        a += 1;
+    }
+    println!("{}", a);
+}
+`, []string{"phi(a)[0,add(a, 1)]"}, t)
+	})
+}
+
+func TestBasic_Macro(t *testing.T) {
+	t.Run("format arguments", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let name = "a";
+    let id = 1;
+    println!("{name}-{}", id);
+}
+`, []string{`add("a-", 1)`}, t)
+	})
+
+	t.Run("format macro", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let s = format!("{0}{0}", 1);
+    println!("{}", s);
+}
+`, []string{`2`}, t)
+	})
+}
+
+func TestBasic_Function(t *testing.T) {
+	t.Run("call before declaration", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    println!("{}", add(1, 2));
+}
+
+fn add(a: i32, b: i32) -> i32 {
+    a + b
+}
+`, []string{"FreeValue-add(1,2)"}, t)
+	})
+
+	t.Run("closure", func(t *testing.T) {
+		test.CheckPrintlnValue(`
+fn main() {
+    let x = 1;
+    let f = |y| x + y;
+    println!("{}", f(2));
+}
+`, []string{"Function-f(2) binding[1]"}, t)
+	})
+}
@@ -0,0 +1,29 @@
+use a::b::{c, d as e, f::*};
+
+pub struct S { a: i32 }
+
+impl Show for S {
+    fn show(&self) {}
+    fn create() -> Self { S { a: 1 } }
+}
+
+extern "C" { fn system(cmd: *const i8) -> i32; }
+
+fn macros() {
+    println!("{} {}", a, b.c());
+    let v = vec![0; n];
+    let q = format!("select * from t where id = {id}", id = input);
+    sqlx::query!("select 1");
+}
+
+fn generic_split() {
+    let a: Vec<Vec<u8>>= Vec::new();
+    let b = x >> 1;
+    let c = Vec::<Vec<u8>>::new();
+}
+
+fn no_struct_condition() {
+    if x == Foo { }
+    for i in items { }
+    match s { S { a, .. } => a, }
+}
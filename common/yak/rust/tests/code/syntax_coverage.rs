@@ -0,0 +1,129 @@
+#![allow(dead_code)]
+//! crate doc
+use std::collections::{HashMap, hash_map::Entry as E, self};
+use std::process::Command;
+use super::*;
+extern crate libc as c;
+
+/// doc comment
+#[derive(Debug, Clone)]
+pub struct Config<'a, T: Clone + 'a = String> where T: Default {
+    pub(crate) name: &'a str,
+    values: Vec<Vec<T>>,
+    callback: Box<dyn Fn(i32) -> i32 + Send>,
+}
+
+struct Unit;
+struct Pair(pub i32, String);
+union U { a: u32, b: f32 }
+
+enum Message {
+    Quit,
+    Move { x: i32, y: i32 },
+    Write(String),
+    Code = 3,
+}
+
+pub trait Shape: Debug + Clone {
+    const SIDES: usize;
+    type Output;
+    fn area(&self) -> f64;
+    fn name(&self) -> String { "shape".to_string() }
+}
+
+impl<T> Shape for Config<'_, T> where T: Clone + Default {
+    const SIDES: usize = 0;
+    type Output = ();
+    fn area(&self) -> f64 { 0.0 }
+}
+
+impl Pair {
+    pub const fn new(a: i32, b: String) -> Self { Self(a, b) }
+    pub async fn run(&mut self, cmd: &str) -> Result<(), Box<dyn std::error::Error>> {
+        let out = Command::new("sh").arg("-c").arg(cmd).output().await?;
+        Ok(())
+    }
+}
+
+mod inner {
+    pub fn helper<F: FnOnce() -> u8>(f: F) -> u8 { f() }
+}
+
+extern "C" {
+    fn system(cmd: *const c_char) -> c_int;
+    fn printf(fmt: *const c_char, ...) -> c_int;
+    static errno: c_int;
+}
+
+static mut COUNTER: u32 = 0;
+const MAX: usize = 1 << 10;
+type Res<T> = std::result::Result<T, String>;
+
+macro_rules! square {
+    ($x:expr) => { $x * $x };
+}
+
+lazy_static! {
+    static ref MAP: HashMap<u32, u32> = HashMap::new();
+}
+
+#[tokio::main]
+async fn main() -> std::io::Result<()> {
+    let mut v: Vec<i32> = vec![1, 2, 3];
+    let zeros = vec![0u8; 16];
+    let (a, mut b) = (1, 2);
+    let Pair(x, ref y) = pair else { return Ok(()) };
+    let s = format!("{} {name}", a, name = b);
+    let t = x.0.1 + arr[i] * -n as i64;
+    let closure = move |x: i32, y| -> i32 { x + y };
+    let r = 0..=10;
+    let full = ..;
+    let lit = Config { name: "n", values: vec![], ..Default::default() };
+    let short = Point { x, y };
+    let parsed = "5".parse::<i32>().unwrap_or_default();
+    let collected: Vec<_> = v.iter().map(|x| x * 2).filter(|&x| x > 2).collect::<Vec<_>>();
+    let nested: HashMap<String, Vec<Vec<u8>>> = HashMap::new();
+    let cmp = a < b && b >= c || !d;
+    let shift = a >> 2 << 1;
+    let r#type = 1;
+    let ch = 'a';
+    let byte = b'x';
+    let raw = r#"raw "string""#;
+    let lifetime_str: &'static str = "s";
+    v.push(1);
+    unsafe {
+        COUNTER += 1;
+        system(cmd.as_ptr());
+    }
+    if let Some(x) = opt {
+        println!("{x}");
+    } else if a == b {
+    } else {
+    }
+    while let Some(top) = stack.pop() {
+        continue;
+    }
+    'outer: for i in 0..10 {
+        loop {
+            break 'outer;
+        }
+    }
+    match msg {
+        Message::Quit => {}
+        Message::Move { x, y: 0, .. } => println!("{}", x),
+        Message::Write(ref text) if text.is_empty() => (),
+        Message::Code | Message::Other(_) => return Ok(()),
+        1..=9 | -1 => {},
+        n @ 10..=20 => {}
+        [first, .., last] => {}
+        &(a, b) => {}
+        _ => unreachable!(),
+    }
+    let value = match x { Some(v) => v, None => 0 };
+    let block_value = { 1 };
+    let fut = async move { 1 };
+    let ptr = &raw const value;
+    let deref = **ptr;
+    let qualified = <Vec<u8> as Default>::default();
+    Ok(())
+}
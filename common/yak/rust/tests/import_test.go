@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestImport(t *testing.T) {
+	t.Run("external crate", func(t *testing.T) {
+		ssatest.CheckSyntaxFlow(t, `
+use std::process::Command;
+use std::process as p;
+
+fn main() {
+    Command::new("ls").arg("-l").output();
+    p::Command::new("id");
+}
+`, `
+Command.new(* as $cmd)
+`, map[string][]string{
+			"cmd": {`"id"`, `"ls"`},
+		}, ssaapi.WithLanguage(ssaapi.RUST))
+	})
+
+	t.Run("project module", func(t *testing.T) {
+		fs := filesys.NewVirtualFs()
+		fs.AddFile("app/src/db/mod.rs", `
+pub mod query;
+`)
+		fs.AddFile("app/src/db/query.rs", `
+use rusqlite::Connection;
+
+pub fn run(sql: &str) {
+    let conn = Connection::open("a.db").unwrap();
+    conn.execute(sql, []).unwrap();
+}
+`)
+		fs.AddFile("app/src/main.rs", `
+mod db;
+use crate::db::query::run;
+
+fn main() {
+    run("select * from users");
+    db::query::run("delete from users");
+}
+`)
+		ssatest.CheckSyntaxFlowWithFS(t, fs, `
+execute(* as $sql)
+$sql #-> as $source
+`, map[string][]string{
+			"source": {`"delete from users"`, `"select * from users"`},
+		}, true, ssaapi.WithLanguage(ssaapi.RUST))
+	})
+
+	t.Run("super path", func(t *testing.T) {
+		fs := filesys.NewVirtualFs()
+		fs.AddFile("app/src/lib.rs", `
+pub mod shell;
+
+pub fn exec(cmd: String) {
+    std::process::Command::new("sh").arg("-c").arg(cmd).spawn();
+}
+`)
+		fs.AddFile("app/src/shell.rs", `
+pub fn run(input: String) {
+    super::exec(format!("echo {}", input));
+}
+
+pub fn entry() {
+    run("whoami".to_string());
+}
+`)
+		ssatest.CheckSyntaxFlowWithFS(t, fs, `
+Command.new(* as $program)
+.arg(* as $arg)
+`, map[string][]string{
+			"program": {`"sh"`},
+			"arg":     {`"-c"`, `Parameter-cmd`},
+		}, true, ssaapi.WithLanguage(ssaapi.RUST))
+	})
+}
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/rust/rust2ssa"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestItem_Impl(t *testing.T) {
+	t.Run("method of struct", func(t *testing.T) {
+		ssatest.CheckSyntaxFlowContain(t, `
+struct Runner {
+    shell: String,
+}
+
+impl Runner {
+    fn new(shell: &str) -> Self {
+        Runner { shell: shell.to_string() }
+    }
+
+    fn run(&self, cmd: &str) {
+        std::process::Command::new(&self.shell).arg(cmd).spawn();
+    }
+}
+
+fn main() {
+    let r = Runner::new("sh");
+    r.run("id");
+}
+`, `
+Command.new(* #-> as $shell)
+.arg(* #-> as $cmd)
+`, map[string][]string{
+			"shell": {`"sh"`},
+			"cmd":   {`"id"`},
+		}, ssaapi.WithLanguage(ssaapi.RUST))
+	})
+
+	t.Run("trait method", func(t *testing.T) {
+		ssatest.CheckSyntaxFlow(t, `
+trait Exec {
+    fn exec(&self, cmd: String);
+}
+
+struct Local;
+
+impl Exec for Local {
+    fn exec(&self, cmd: String) {
+        std::process::Command::new(cmd);
+    }
+}
+
+fn main() {
+    let l = Local;
+    l.exec("whoami".to_string());
+}
+`, `
+Command.new(* #-> as $cmd)
+`, map[string][]string{
+			"cmd": {`"whoami"`},
+		}, ssaapi.WithLanguage(ssaapi.RUST))
+	})
+
+	t.Run("tuple struct", func(t *testing.T) {
+		ssatest.CheckPrintlnValue(`
+struct Pair(i32, i32);
+
+fn main() {
+    let p = Pair(1, 2);
+    println!("{}", p.1);
+}
+`, []string{"2"}, t)
+	})
+}
+
+func TestItem_Unsafe(t *testing.T) {
+	t.Run("ffi call in unsafe block", func(t *testing.T) {
+		ssatest.CheckSyntaxFlowContain(t, `
+extern "C" {
+    fn system(cmd: *const c_char) -> c_int;
+}
+
+fn main() {
+    let cmd = CString::new("ls").unwrap();
+    unsafe {
+        system(cmd.as_ptr());
+    }
+    safe_call(1);
+}
+`, `
+unsafe as $unsafe
+system(* #-> as $arg)
+`, map[string][]string{
+			"unsafe": {`FreeValue-system(Undefined-cmd.as_ptr(Undefined-.unwrap(Undefined-CString.new(valid)("ls"))))`},
+			"arg":    {`"ls"`},
+		}, ssaapi.WithLanguage(ssaapi.RUST))
+	})
+}
+
+func TestItem_MacroModel(t *testing.T) {
+	code := `
+fn main() {
+    let q = sql!("select {}", 1);
+    println!("{}", q);
+}
+`
+	t.Run("model of the compile", func(t *testing.T) {
+		ssatest.CheckSyntaxFlow(t, code, `println(* as $q)`, map[string][]string{
+			"q": {`add("select ", 1)`},
+		}, ssaapi.WithLanguage(ssaapi.RUST), ssaapi.WithRustMacroModel("sql", rust2ssa.MacroFormat))
+	})
+
+	t.Run("not shared with other compiles", func(t *testing.T) {
+		ssatest.CheckSyntaxFlow(t, code, `println(* as $q)`, map[string][]string{
+			"q": {`Undefined-sql("select {}",1)`},
+		}, ssaapi.WithLanguage(ssaapi.RUST))
+	})
+}
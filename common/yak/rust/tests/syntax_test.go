@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/rust/rust2ssa"
+)
+
+//go:embed code
+var codeFs embed.FS
+
+func validateSource(t *testing.T, filename string, src string) {
+	t.Run(fmt.Sprintf("syntax file: %v", filename), func(t *testing.T) {
+		_, err := rust2ssa.Frontend(src, false)
+		require.Nil(t, err, "parse AST FrontEnd error : %v", err)
+	})
+}
+
+func TestAllSyntaxForRust(t *testing.T) {
+	entry, err := codeFs.ReadDir("code")
+	if err != nil {
+		t.Fatalf("no embed syntax files found: %v", err)
+	}
+	for _, f := range entry {
+		if f.IsDir() {
+			continue
+		}
+		codePath := path.Join("code", f.Name())
+		if !strings.HasSuffix(codePath, ".rs") {
+			continue
+		}
+		raw, err := codeFs.ReadFile(codePath)
+		if err != nil {
+			t.Fatalf("cannot found syntax fs: %v", codePath)
+		}
+		validateSource(t, codePath, string(raw))
+	}
+}
+
+func TestBadSyntax(t *testing.T) {
+	for name, src := range map[string]string{
+		"missing semicolon":     "fn f() { let a = 1 let b = 2; }",
+		"unclosed brace":        "fn f() { let a = 1;",
+		"unclosed paren":        "fn f() { g(1, 2; }",
+		"let without pattern":   "fn f() { let = 1; }",
+		"incomplete expression": "fn f() { let a = 1 +; }",
+		"bad parameter":         "fn f(a: ) {}",
+		"keyword as name":       "fn fn() {}",
+		"unterminated string":   "fn f() { let s = \"abc; }",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := rust2ssa.Frontend(src, false)
+			require.Error(t, err)
+		})
+	}
+}
@@ -22,6 +22,10 @@ func (b *FunctionBuilder) WithExternLib(lib map[string]map[string]any) {
 	b.GetProgram().ExternLib = lib
 }
 
+func (b *FunctionBuilder) WithMacroModels(models map[string]string) {
+	b.GetProgram().MacroModels = models
+}
+
 func (b *FunctionBuilder) WithExternBuildValueHandler(m map[string]func(b *FunctionBuilder, id string, v any) (value Value)) {
 	b.GetProgram().externBuildValueHandler = m
 }
@@ -291,6 +291,9 @@ type Program struct {
 	ExternInstance          map[string]any
 	ExternLib               map[string]map[string]any
 
+	// MacroModels is the model of the macro invocations by macro name, for the languages with macros
+	MacroModels map[string]string
+
 	PkgName           string
 	fixImportCallback []func()
 
@@ -2,6 +2,7 @@ package ssadb
 
 import (
 	"github.com/jinzhu/gorm"
+	"github.com/samber/lo"
 	"github.com/yaklang/yaklang/common/consts"
 	"github.com/yaklang/yaklang/common/schema"
 )
@@ -53,6 +54,28 @@ func DeleteProgramIrCode(db *gorm.DB, program string) {
 	deleteProgramAuditResult(db, program) // because audit result depends on ir code
 }
 
+// DeleteProgramIrCodeBySourceHash delete the ir code, index, offset and source of the given files (ir source hash),
+// the ir code of other files in this program will be kept, this is used by incremental compile.
+func DeleteProgramIrCodeBySourceHash(db *gorm.DB, program string, hashes []string) {
+	if len(hashes) == 0 {
+		return
+	}
+	for _, chunk := range lo.Chunk(hashes, 500) {
+		var ids []int64
+		db.Model(&IrCode{}).Where("program_name = ?", program).Where("source_code_hash IN (?)", chunk).Pluck("id", &ids)
+		for _, idChunk := range lo.Chunk(ids, 500) {
+			db.Model(&IrIndex{}).Where("program_name = ?", program).Where("value_id IN (?)", idChunk).Unscoped().Delete(&IrIndex{})
+		}
+		db.Model(&IrCode{}).Where("program_name = ?", program).Where("source_code_hash IN (?)", chunk).Unscoped().Delete(&IrCode{})
+		db.Model(&IrOffset{}).Where("program_name = ?", program).Where("file_hash IN (?)", chunk).Unscoped().Delete(&IrOffset{})
+		db.Model(&IrSource{}).Where("program_name = ?", program).Where("source_code_hash IN (?)", chunk).Unscoped().Delete(&IrSource{})
+	}
+	// folder will be saved again when compile
+	db.Model(&IrSource{}).Where("program_name = ? AND quoted_code = ?", program, "").Unscoped().Delete(&IrSource{})
+	db.Model(&IrSource{}).Where("folder_path = ? AND file_name = ?", "/", program).Unscoped().Delete(&IrSource{})
+	deleteProgramAuditResult(db, program) // because audit result depends on ir code
+}
+
 func deleteProgramCodeOnly(db *gorm.DB, program string) {
 	// delete the program
 	// code
@@ -45,6 +45,20 @@ func GetIrSourceByPathAndName(path, name string) (*IrSource, error) {
 	return &source, nil
 }
 
+// GetProgramSourceHash return the ir source hash of every file in program, the key is file path without program name
+func GetProgramSourceHash(program string) map[string]string {
+	var sources []*IrSource
+	if err := GetDB().Where("program_name = ?", program).Where("quoted_code != ?", "").Find(&sources).Error; err != nil {
+		return nil
+	}
+	ret := make(map[string]string, len(sources))
+	for _, source := range sources {
+		_, filePath := splitProjectPath(irSourceJoin(source.FolderPath, source.FileName))
+		ret[filePath] = source.SourceCodeHash
+	}
+	return ret
+}
+
 func GetEditorByFileName(fileName string) (*memedit.MemEditor, error) {
 	dir, name := pathSplit(fileName)
 	source, err := GetIrSourceByPathAndName(dir, name)
@@ -0,0 +1,122 @@
+package ssadb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils/bizhelper"
+)
+
+// SourceDependency 记录程序中源文件之间的依赖关系，key 均为 ir source hash
+type SourceDependency struct {
+	// Dependents 依赖某个文件的其他文件，Dependents[b] 包含 a 表示 a 引用了 b 中的 IR 或名称
+	Dependents map[string]map[string]struct{}
+	// Declares 文件中声明的名称，例如类、函数
+	Declares map[string]map[string]struct{}
+	// Undefined 文件中使用但未在当前文件中找到定义的名称
+	Undefined map[string]map[string]struct{}
+}
+
+func newSourceDependency() *SourceDependency {
+	return &SourceDependency{
+		Dependents: make(map[string]map[string]struct{}),
+		Declares:   make(map[string]map[string]struct{}),
+		Undefined:  make(map[string]map[string]struct{}),
+	}
+}
+
+func addDependencyItem(m map[string]map[string]struct{}, key, value string) {
+	if key == "" || value == "" {
+		return
+	}
+	if _, ok := m[key]; !ok {
+		m[key] = make(map[string]struct{})
+	}
+	m[key][value] = struct{}{}
+}
+
+// AddDependency 添加 a 依赖 b 的关系
+func (d *SourceDependency) AddDependency(a, b string) {
+	if a == b {
+		return
+	}
+	addDependencyItem(d.Dependents, b, a)
+}
+
+// dependencyName 去掉 Java 构造/析构函数等名称后缀，例如 B-constructor => B
+func dependencyName(name string) string {
+	if name == "" || strings.HasPrefix(name, "#") {
+		return ""
+	}
+	name, _, _ = strings.Cut(name, "-")
+	return name
+}
+
+// GetProgramSourceDependency 根据数据库中的 IR 计算源文件之间的依赖关系：
+// 1. IR 之间的引用（Users / Pointer / 所属函数 / 对象成员等）跨越了不同的文件
+// 2. 一个文件中未定义的名称（Undefined）在另一个文件中被声明
+func GetProgramSourceDependency(ctx context.Context, program string) *SourceDependency {
+	type codeItem struct {
+		hash  string
+		users []int64 // users 依赖当前 IR
+		refs  []int64 // 当前 IR 依赖 refs
+	}
+	ret := newSourceDependency()
+	id2hash := make(map[int64]string)
+	var codes []codeItem
+
+	db := GetDB().Model(&IrCode{}).Where("program_name = ?", program).Select(
+		"id, source_code_hash, opcode_name, name, current_function, users, pointer, point, object_parent, object_key, object_members",
+	)
+	for code := range bizhelper.YieldModel[*IrCode](ctx, db) {
+		hash := code.SourceCodeHash
+		if hash == "" {
+			continue
+		}
+		id2hash[int64(code.ID)] = hash
+
+		switch code.OpcodeName {
+		case "Undefined":
+			addDependencyItem(ret.Undefined, hash, dependencyName(code.Name))
+		case "Make", "Function":
+			addDependencyItem(ret.Declares, hash, dependencyName(code.Name))
+		}
+
+		refs := make([]int64, 0, len(code.Pointer)+4)
+		refs = append(refs, code.Pointer...)
+		refs = append(refs, code.CurrentFunction, code.Point, code.ObjectParent, code.ObjectKey)
+		code.ObjectMembers.ForEach(func(key, value int64) {
+			refs = append(refs, key, value)
+		})
+		codes = append(codes, codeItem{hash: hash, users: code.Users, refs: refs})
+	}
+
+	for _, code := range codes {
+		for _, user := range code.users {
+			if hash, ok := id2hash[user]; ok && user > 0 {
+				ret.AddDependency(hash, code.hash)
+			}
+		}
+		for _, ref := range code.refs {
+			if hash, ok := id2hash[ref]; ok && ref > 0 {
+				ret.AddDependency(code.hash, hash)
+			}
+		}
+	}
+
+	// name in other file
+	declare2hash := make(map[string][]string)
+	for hash, names := range ret.Declares {
+		for name := range names {
+			declare2hash[name] = append(declare2hash[name], hash)
+		}
+	}
+	for hash, names := range ret.Undefined {
+		for name := range names {
+			for _, other := range declare2hash[name] {
+				ret.AddDependency(hash, other)
+			}
+		}
+	}
+	return ret
+}
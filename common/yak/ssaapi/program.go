@@ -29,6 +29,8 @@ type Program struct {
 	comeFromDatabase bool
 	//value cache
 	nodeId2ValueCache *utils.CacheWithKey[uint, *Value]
+	// incremental compile result
+	incrementalInfo *IncrementalCompileInfo
 }
 
 type Programs []*Program
@@ -52,6 +54,11 @@ func (p *Program) GetLanguage() string {
 	return p.Program.Language
 }
 
+// GetIncrementalCompileInfo 获取增量编译的文件变化信息，非增量编译时返回 nil
+func (p *Program) GetIncrementalCompileInfo() *IncrementalCompileInfo {
+	return p.incrementalInfo
+}
+
 func (p *Program) GetType(name string) *Type {
 	typ := p.Program.GetType(name)
 	if utils.IsNil(typ) {
@@ -0,0 +1,319 @@
+package ssaapi
+
+import (
+	"context"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+// IncrementalCompileInfo 记录一次增量编译中发生变化以及重新编译的文件
+type IncrementalCompileInfo struct {
+	ChangedFiles []string
+	AddedFiles   []string
+	RemovedFiles []string
+	// RebuildFiles 重新编译的文件，包含变化的文件以及直接或间接依赖它们的文件
+	RebuildFiles []string
+	// FullCompile 没有旧的编译结果或者所有文件都需要重新编译时，会退化为完整编译
+	FullCompile bool
+}
+
+// HasChanged 项目文件是否发生了变化
+func (i *IncrementalCompileInfo) HasChanged() bool {
+	if i == nil {
+		return false
+	}
+	return i.FullCompile || len(i.ChangedFiles)+len(i.AddedFiles)+len(i.RemovedFiles) > 0
+}
+
+type incrementalFile struct {
+	path   string
+	hash   string // ir source hash
+	source bool   // can be compiled by language builder
+}
+
+func normalizeIncrementalPath(path string) string {
+	return strings.TrimLeft(path, "/")
+}
+
+// collectIncrementalFiles 按照编译时相同的规则遍历文件系统，计算每个文件的 ir source hash
+func (c *config) collectIncrementalFiles() (map[string]*incrementalFile, error) {
+	files := make(map[string]*incrementalFile)
+	err := filesys.Recursive(c.programPath,
+		filesys.WithFileSystem(c.fs),
+		filesys.WithContext(c.ctx),
+		filesys.WithDirStat(func(s string, fi fs.FileInfo) error {
+			_, name := c.fs.PathSplit(s)
+			if name == "test" || name == ".git" {
+				return filesys.SkipDir
+			}
+			return nil
+		}),
+		filesys.WithFileStat(func(path string, fi fs.FileInfo) error {
+			if fi.Size() == 0 || c.excludeFile(path, fi.Name()) {
+				return nil
+			}
+			source := c.checkLanguage(path) == nil
+			if !source && c.checkLanguagePreHandler(path) != nil {
+				return nil
+			}
+			raw, err := c.fs.ReadFile(path)
+			if err != nil {
+				return nil
+			}
+			files[normalizeIncrementalPath(path)] = &incrementalFile{
+				path:   path,
+				hash:   memedit.NewMemEditorWithFileUrl(string(raw), path).GetIrSourceHash(c.ProgramName),
+				source: source,
+			}
+			return nil
+		}),
+	)
+	return files, err
+}
+
+// incrementalCompile 只重新编译发生变化的文件以及与其存在依赖关系的文件，其他文件的 IR 保留在数据库中。
+// 返回 false 表示无法增量编译（没有旧的编译结果或者需要重新编译所有文件），需要进行完整编译。
+func (c *config) incrementalCompile() (*Program, bool, error) {
+	info := &IncrementalCompileInfo{FullCompile: true}
+	c.incrementalInfo = info
+
+	if c.peepholeSize != 0 || !c.enableDatabase {
+		return nil, false, nil
+	}
+	irProg, err := ssadb.GetProgram(c.ProgramName, ssa.Application)
+	if err != nil || len(irProg.FileList) == 0 {
+		return nil, false, nil
+	}
+	if c.language == "" && irProg.Language != "" {
+		if err := WithRawLanguage(irProg.Language)(c); err != nil {
+			return nil, false, err
+		}
+	}
+
+	c.Processf(0, "incremental compile, check changed files...")
+	files, err := c.collectIncrementalFiles()
+	if err != nil {
+		return nil, false, err
+	}
+	oldHash := make(map[string]string)
+	for path, hash := range ssadb.GetProgramSourceHash(c.ProgramName) {
+		oldHash[normalizeIncrementalPath(path)] = hash
+	}
+	oldSource := make(map[string]string) // compiled file path => old file list key
+	for path := range irProg.FileList {
+		oldSource[normalizeIncrementalPath(path)] = path
+	}
+
+	seeds := make(map[string]struct{})
+	for key, file := range files {
+		hash, existed := oldHash[key]
+		switch {
+		case !file.source:
+			// project config / template etc. will affect the whole program
+			if existed && hash != file.hash {
+				log.Infof("incremental compile: extra file %s changed, compile whole project", file.path)
+				return nil, false, nil
+			}
+		case !existed:
+			info.AddedFiles = append(info.AddedFiles, file.path)
+		case hash != file.hash:
+			info.ChangedFiles = append(info.ChangedFiles, file.path)
+			seeds[hash] = struct{}{}
+		}
+	}
+	for key, path := range oldSource {
+		if _, ok := files[key]; ok {
+			continue
+		}
+		info.RemovedFiles = append(info.RemovedFiles, path)
+		if hash, ok := oldHash[key]; ok {
+			seeds[hash] = struct{}{}
+		}
+	}
+	sort.Strings(info.ChangedFiles)
+	sort.Strings(info.AddedFiles)
+	sort.Strings(info.RemovedFiles)
+
+	info.FullCompile = false
+	if !info.HasChanged() {
+		c.Processf(1, "incremental compile, no file changed")
+		prog, err := c.fromDatabase()
+		if err != nil {
+			return nil, false, err
+		}
+		prog.incrementalInfo = info
+		return prog, true, nil
+	}
+
+	// the ir of a kept file must not point to deleted ir, so every file depending on a changed file,
+	// directly or through other files, is rebuilt
+	dependency := ssadb.GetProgramSourceDependency(c.ctx, c.ProgramName)
+	rebuildHash := make(map[string]struct{}, len(seeds))
+	queue := make([]string, 0, len(seeds))
+	for hash := range seeds {
+		rebuildHash[hash] = struct{}{}
+		queue = append(queue, hash)
+	}
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		for dependent := range dependency.Dependents[hash] {
+			if _, ok := rebuildHash[dependent]; ok {
+				continue
+			}
+			rebuildHash[dependent] = struct{}{}
+			queue = append(queue, dependent)
+		}
+	}
+
+	rebuild := make(map[string]struct{})
+	sourceCount := 0
+	for key, file := range files {
+		if !file.source {
+			continue
+		}
+		sourceCount++
+		if _, ok := rebuildHash[oldHash[key]]; ok || oldHash[key] == "" {
+			rebuild[key] = struct{}{}
+			info.RebuildFiles = append(info.RebuildFiles, file.path)
+		}
+	}
+	sort.Strings(info.RebuildFiles)
+	if len(rebuild) >= sourceCount {
+		log.Infof("incremental compile: all files need to rebuild, compile whole project")
+		info.FullCompile = true
+		return nil, false, nil
+	}
+	c.Processf(0, "incremental compile, changed(%d) added(%d) removed(%d), rebuild %d/%d files",
+		len(info.ChangedFiles), len(info.AddedFiles), len(info.RemovedFiles), len(rebuild), sourceCount)
+
+	// delete old ir of rebuild files, extra files will be saved again in pre-handler
+	var deleteHash []string
+	for hash := range rebuildHash {
+		deleteHash = append(deleteHash, hash)
+	}
+	for key, file := range files {
+		if hash, ok := oldHash[key]; ok && !file.source {
+			deleteHash = append(deleteHash, hash)
+		}
+	}
+	ssadb.DeleteProgramIrCodeBySourceHash(ssadb.GetDB(), c.ProgramName, deleteHash)
+
+	// only compile the rebuild files
+	excludeFile := c.excludeFile
+	c.excludeFile = func(path, filename string) bool {
+		if excludeFile(path, filename) {
+			return true
+		}
+		if !strings.HasSuffix(path, filename) {
+			path = path + filename
+		}
+		key := normalizeIncrementalPath(path)
+		if file, ok := files[key]; ok && file.source {
+			_, ok := rebuild[key]
+			return !ok
+		}
+		return false
+	}
+	defer func() {
+		c.excludeFile = excludeFile
+	}()
+	prog, err := c.parseProjectWithFS(c.fs, func(f float64, s string, a ...any) {
+		c.Processf(f*0.99, s, a...)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	// a rebuilt file may declare a name that a kept file could not resolve before, e.g. the kept file
+	// used a class of a file added now and got a stub of it. The kept file has to be compiled again,
+	// so compile the whole project
+	declares := make(map[string]struct{})
+	dependency = ssadb.GetProgramSourceDependency(c.ctx, c.ProgramName)
+	for key := range rebuild {
+		for name := range dependency.Declares[files[key].hash] {
+			declares[name] = struct{}{}
+		}
+	}
+	for key, hash := range oldHash {
+		if _, ok := rebuild[key]; ok {
+			continue
+		}
+		if file, ok := files[key]; !ok || !file.source {
+			continue
+		}
+		if hasCommonName(dependency.Undefined[hash], declares) || hasCommonName(dependency.Declares[hash], declares) {
+			log.Infof("incremental compile: %s uses the names declared in rebuilt files, compile whole project", key)
+			info.FullCompile = true
+			return nil, false, nil
+		}
+	}
+
+	// merge file list with the files which are not rebuild
+	fileList := make(map[string]string)
+	for key, path := range oldSource {
+		if _, ok := rebuild[key]; ok {
+			continue
+		}
+		if _, ok := files[key]; !ok {
+			continue
+		}
+		fileList[path] = irProg.FileList[path]
+	}
+	for path, hash := range prog.Program.FileList {
+		fileList[path] = hash
+	}
+	extraFile := make(map[string]string)
+	for path, hash := range irProg.ExtraFile {
+		extraFile[path] = hash
+	}
+	for path, hash := range prog.Program.ExtraFile {
+		extraFile[path] = hash
+	}
+	if irProg, err = ssadb.GetProgram(c.ProgramName, ssa.Application); err != nil {
+		return nil, false, utils.Wrap(err, "get program after incremental compile failed")
+	}
+	irProg.FileList = fileList
+	irProg.ExtraFile = extraFile
+	ssadb.UpdateProgram(irProg)
+	c.SaveConfig()
+
+	ret, err := c.fromDatabase()
+	if err != nil {
+		return nil, false, err
+	}
+	ret.incrementalInfo = info
+	c.Processf(1, "program %s incremental compile finish", c.ProgramName)
+	return ret, true, nil
+}
+
+func hasCommonName(names, other map[string]struct{}) bool {
+	for name := range names {
+		if _, ok := other[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffRisk 比较同一程序两次扫描（例如增量编译前后两个版本）的风险，
+// 结果中 Add 表示只存在于 baseRuntimeID 的扫描中，Del 表示只存在于 compareRuntimeID 的扫描中
+func (p *Program) DiffRisk(ctx context.Context, baseRuntimeID, compareRuntimeID string) (<-chan *yakit.ComparisonResult[*schema.SSARisk], error) {
+	if baseRuntimeID == "" || compareRuntimeID == "" {
+		return nil, utils.Error("base and compare runtime id are required")
+	}
+	return yakit.DoRiskDiff(ctx,
+		&ypb.SSARiskDiffItem{ProgramName: p.GetProgramName(), RiskRuntimeId: baseRuntimeID},
+		&ypb.SSARiskDiffItem{ProgramName: p.GetProgramName(), RiskRuntimeId: compareRuntimeID},
+	)
+}
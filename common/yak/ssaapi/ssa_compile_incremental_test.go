@@ -0,0 +1,389 @@
+package ssaapi_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+const (
+	incrementalFileA = "src/main/java/com/a/A.java"
+	incrementalFileB = "src/main/java/com/b/B.java"
+	incrementalFileC = "src/main/java/com/c/C.java"
+)
+
+func newIncrementalProject() *filesys.VirtualFS {
+	vf := filesys.NewVirtualFs()
+	vf.AddFile(incrementalFileA, `package com.a;
+import com.b.B;
+public class A {
+	public void run(String x) {
+		B b = new B();
+		b.exec(x);
+	}
+}`)
+	vf.AddFile(incrementalFileB, `package com.b;
+public class B {
+	public void exec(String cmd) {
+		Runtime.getRuntime().exec(cmd);
+	}
+}`)
+	vf.AddFile(incrementalFileC, `package com.c;
+public class C {
+	public int add(int a, int b) {
+		return a + b;
+	}
+}`)
+	return vf
+}
+
+func updateIncrementalFile(vf *filesys.VirtualFS, path, content string) {
+	vf.RemoveFileOrDir(path)
+	vf.AddFile(path, content)
+}
+
+func countIrCodeBySource(t *testing.T, progName, path string) (int, string) {
+	hash := ssadb.GetProgramSourceHash(progName)[path]
+	require.NotEmpty(t, hash, "source hash of %s not found", path)
+	var count int
+	ssadb.GetDB().Model(&ssadb.IrCode{}).Where("program_name = ? AND source_code_hash = ?", progName, hash).Count(&count)
+	return count, hash
+}
+
+func TestIncrementalCompile(t *testing.T) {
+	vf := newIncrementalProject()
+	progName := uuid.NewString()
+	t.Cleanup(func() {
+		ssadb.DeleteProgram(ssadb.GetDB(), progName)
+	})
+	progs, err := ssaapi.ParseProjectWithFS(vf, ssaapi.WithLanguage(ssaapi.JAVA), ssaapi.WithProgramName(progName))
+	require.NoError(t, err)
+	require.Len(t, progs, 1)
+
+	compile := func() (*ssaapi.Program, *ssaapi.IncrementalCompileInfo) {
+		progs, err := ssaapi.ParseProjectWithFS(vf,
+			ssaapi.WithLanguage(ssaapi.JAVA),
+			ssaapi.WithProgramName(progName),
+			ssaapi.WithIncrementalCompile(true),
+		)
+		require.NoError(t, err)
+		require.Len(t, progs, 1)
+		info := progs[0].GetIncrementalCompileInfo()
+		require.NotNil(t, info)
+		require.False(t, info.FullCompile)
+		return progs[0], info
+	}
+	query := func(prog *ssaapi.Program, rule string) ssaapi.Values {
+		res, err := prog.SyntaxFlowWithError(rule + " as $target")
+		require.NoError(t, err)
+		return res.GetValues("target")
+	}
+	checkExec := func(prog *ssaapi.Program, want ...string) {
+		res, err := prog.SyntaxFlowWithError(`Runtime.getRuntime().exec(* #-> as $source)`)
+		require.NoError(t, err)
+		var got []string
+		for _, v := range res.GetValues("source") {
+			got = append(got, v.String())
+		}
+		for _, w := range want {
+			require.Contains(t, got, w)
+		}
+	}
+
+	t.Run("no file changed", func(t *testing.T) {
+		countA, _ := countIrCodeBySource(t, progName, incrementalFileA)
+		prog, info := compile()
+		require.False(t, info.HasChanged())
+		require.Empty(t, info.RebuildFiles)
+		after, _ := countIrCodeBySource(t, progName, incrementalFileA)
+		require.Equal(t, countA, after)
+		checkExec(prog, "Parameter-x")
+	})
+
+	t.Run("independent file changed", func(t *testing.T) {
+		countA, hashA := countIrCodeBySource(t, progName, incrementalFileA)
+		countB, hashB := countIrCodeBySource(t, progName, incrementalFileB)
+		updateIncrementalFile(vf, incrementalFileC, `package com.c;
+public class C {
+	public int sub(int a, int b) {
+		return a - b;
+	}
+}`)
+		prog, info := compile()
+		require.Equal(t, []string{incrementalFileC}, info.ChangedFiles)
+		require.Equal(t, []string{incrementalFileC}, info.RebuildFiles)
+
+		// ir of other files are kept
+		afterA, afterHashA := countIrCodeBySource(t, progName, incrementalFileA)
+		afterB, afterHashB := countIrCodeBySource(t, progName, incrementalFileB)
+		require.Equal(t, hashA, afterHashA)
+		require.Equal(t, hashB, afterHashB)
+		require.Equal(t, countA, afterA)
+		require.Equal(t, countB, afterB)
+
+		require.NotEmpty(t, query(prog, "C.sub"))
+		require.Empty(t, query(prog, "C.add"))
+		checkExec(prog, "Parameter-x")
+
+		irProg, err := ssadb.GetProgram(progName, ssa.Application)
+		require.NoError(t, err)
+		require.Len(t, irProg.FileList, 3)
+	})
+
+	t.Run("depended file changed", func(t *testing.T) {
+		countC, _ := countIrCodeBySource(t, progName, incrementalFileC)
+		updateIncrementalFile(vf, incrementalFileB, `package com.b;
+public class B {
+	public void exec(String command) {
+		Runtime.getRuntime().exec("sh -c " + command);
+	}
+}`)
+		prog, info := compile()
+		require.Equal(t, []string{incrementalFileB}, info.ChangedFiles)
+		require.Equal(t, []string{incrementalFileA, incrementalFileB}, info.RebuildFiles)
+		afterC, _ := countIrCodeBySource(t, progName, incrementalFileC)
+		require.Equal(t, countC, afterC)
+		checkExec(prog, "Parameter-x")
+	})
+
+	t.Run("file added and removed", func(t *testing.T) {
+		vf.RemoveFileOrDir(incrementalFileC)
+		vf.AddFile("src/main/java/com/d/D.java", `package com.d;
+public class D {
+	public void hello(String name) {
+		System.out.println(name);
+	}
+}`)
+		prog, info := compile()
+		require.Equal(t, []string{incrementalFileC}, info.RemovedFiles)
+		require.Equal(t, []string{"src/main/java/com/d/D.java"}, info.AddedFiles)
+		require.Equal(t, []string{"src/main/java/com/d/D.java"}, info.RebuildFiles)
+		require.NotEmpty(t, query(prog, "D.hello"))
+		require.Empty(t, query(prog, "C.sub"))
+
+		irProg, err := ssadb.GetProgram(progName, ssa.Application)
+		require.NoError(t, err)
+		require.Contains(t, irProg.FileList, "src/main/java/com/d/D.java")
+		require.NotContains(t, irProg.FileList, incrementalFileC)
+		_, ok := ssadb.GetProgramSourceHash(progName)[incrementalFileC]
+		require.False(t, ok)
+	})
+}
+
+func TestIncrementalCompile_TransitiveDependents(t *testing.T) {
+	const (
+		fileA = "src/main/java/com/a/A.java"
+		fileB = "src/main/java/com/b/B.java"
+		fileC = "src/main/java/com/c/C.java"
+		fileD = "src/main/java/com/d/D.java"
+		fileE = "src/main/java/com/e/E.java"
+	)
+	// A -> B -> C, D -> B, E is independent
+	vf := filesys.NewVirtualFs()
+	vf.AddFile(fileA, `package com.a;
+import com.b.B;
+public class A {
+	public void run(String x) {
+		B b = new B();
+		b.exec(x);
+	}
+}`)
+	vf.AddFile(fileB, `package com.b;
+import com.c.C;
+public class B {
+	public void exec(String cmd) {
+		C c = new C();
+		Runtime.getRuntime().exec(c.wrap(cmd));
+	}
+}`)
+	vf.AddFile(fileC, `package com.c;
+public class C {
+	public String wrap(String s) {
+		return s;
+	}
+}`)
+	vf.AddFile(fileD, `package com.d;
+import com.b.B;
+public class D {
+	public void handle(String y) {
+		B b = new B();
+		b.exec(y);
+	}
+}`)
+	vf.AddFile(fileE, `package com.e;
+public class E {
+	public int add(int a, int b) {
+		return a + b;
+	}
+}`)
+	progName := uuid.NewString()
+	fullName := uuid.NewString()
+	t.Cleanup(func() {
+		ssadb.DeleteProgram(ssadb.GetDB(), progName)
+		ssadb.DeleteProgram(ssadb.GetDB(), fullName)
+	})
+	_, err := ssaapi.ParseProjectWithFS(vf, ssaapi.WithLanguage(ssaapi.JAVA), ssaapi.WithProgramName(progName))
+	require.NoError(t, err)
+
+	countE, hashE := countIrCodeBySource(t, progName, fileE)
+	updateIncrementalFile(vf, fileC, `package com.c;
+public class C {
+	public String wrap(String s) {
+		return "sh -c " + s;
+	}
+}`)
+	progs, err := ssaapi.ParseProjectWithFS(vf,
+		ssaapi.WithLanguage(ssaapi.JAVA),
+		ssaapi.WithProgramName(progName),
+		ssaapi.WithIncrementalCompile(true),
+	)
+	require.NoError(t, err)
+	require.Len(t, progs, 1)
+	info := progs[0].GetIncrementalCompileInfo()
+	require.False(t, info.FullCompile)
+	require.Equal(t, []string{fileC}, info.ChangedFiles)
+	// A and D depend on C through B
+	require.Equal(t, []string{fileA, fileB, fileC, fileD}, info.RebuildFiles)
+
+	afterE, afterHashE := countIrCodeBySource(t, progName, fileE)
+	require.Equal(t, hashE, afterHashE)
+	require.Equal(t, countE, afterE)
+
+	// the data flow is the same as a full compile of the changed project
+	full, err := ssaapi.ParseProjectWithFS(vf, ssaapi.WithLanguage(ssaapi.JAVA), ssaapi.WithProgramName(fullName))
+	require.NoError(t, err)
+	require.Len(t, full, 1)
+	source := func(prog *ssaapi.Program) []string {
+		res, err := prog.SyntaxFlowWithError(`Runtime.getRuntime().exec(* #-> as $source)`)
+		require.NoError(t, err)
+		var got []string
+		for _, v := range res.GetValues("source") {
+			got = append(got, v.String())
+		}
+		sort.Strings(got)
+		return got
+	}
+	want := source(full[0])
+	require.Contains(t, want, "Parameter-y")
+	require.Equal(t, want, source(progs[0]))
+}
+
+func TestIncrementalCompile_NewDeclaration(t *testing.T) {
+	const (
+		fileA      = "src/main/java/com/a/A.java"
+		fileE      = "src/main/java/com/e/E.java"
+		fileHelper = "src/main/java/com/b/Helper.java"
+	)
+	vf := filesys.NewVirtualFs()
+	vf.AddFile(fileA, `package com.a;
+import com.b.Helper;
+public class A {
+	public void run(String x) {
+		Helper.exec(x);
+	}
+}`)
+	vf.AddFile(fileE, `package com.e;
+public class E {
+	public int add(int a, int b) {
+		return a + b;
+	}
+}`)
+	progName := uuid.NewString()
+	t.Cleanup(func() {
+		ssadb.DeleteProgram(ssadb.GetDB(), progName)
+	})
+	_, err := ssaapi.ParseProjectWithFS(vf, ssaapi.WithLanguage(ssaapi.JAVA), ssaapi.WithProgramName(progName))
+	require.NoError(t, err)
+
+	// A used Helper without a definition, the added file declares it
+	vf.AddFile(fileHelper, `package com.b;
+public class Helper {
+	public static void exec(String cmd) {
+		Runtime.getRuntime().exec(cmd);
+	}
+}`)
+	progs, err := ssaapi.ParseProjectWithFS(vf,
+		ssaapi.WithLanguage(ssaapi.JAVA),
+		ssaapi.WithProgramName(progName),
+		ssaapi.WithIncrementalCompile(true),
+	)
+	require.NoError(t, err)
+	require.Len(t, progs, 1)
+	info := progs[0].GetIncrementalCompileInfo()
+	require.Equal(t, []string{fileHelper}, info.AddedFiles)
+	require.True(t, info.FullCompile)
+
+	res, err := progs[0].SyntaxFlowWithError(`Runtime.getRuntime().exec(* #-> as $source)`)
+	require.NoError(t, err)
+	var got []string
+	for _, v := range res.GetValues("source") {
+		got = append(got, v.String())
+	}
+	require.Contains(t, got, "Parameter-x")
+}
+
+func TestIncrementalCompile_RiskDiff(t *testing.T) {
+	vf := newIncrementalProject()
+	progName := uuid.NewString()
+	t.Cleanup(func() {
+		ssadb.DeleteProgram(ssadb.GetDB(), progName)
+	})
+	progs, err := ssaapi.ParseProjectWithFS(vf, ssaapi.WithLanguage(ssaapi.JAVA), ssaapi.WithProgramName(progName))
+	require.NoError(t, err)
+
+	rule := `
+desc(title: "command exec")
+Runtime.getRuntime().exec(* as $sink)
+alert $sink for {
+	"title": "command exec",
+	"level": "high",
+}
+`
+	scan := func(prog *ssaapi.Program) string {
+		res, err := prog.SyntaxFlowWithError(rule)
+		require.NoError(t, err)
+		taskID := uuid.NewString()
+		_, err = res.Save(schema.SFResultKindDebug, taskID)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			yakit.DeleteSSARisks(ssadb.GetDB(), &ypb.SSARisksFilter{RuntimeID: []string{taskID}})
+		})
+		return taskID
+	}
+	oldTask := scan(progs[0])
+
+	updateIncrementalFile(vf, incrementalFileC, `package com.c;
+public class C {
+	public void shell(String c) {
+		Runtime.getRuntime().exec(c);
+	}
+}`)
+	prog, err := progs[0].IncrementalRecompile()
+	require.NoError(t, err)
+	require.Equal(t, []string{incrementalFileC}, prog.GetIncrementalCompileInfo().RebuildFiles)
+	newTask := scan(prog)
+
+	res, err := prog.DiffRisk(context.Background(), newTask, oldTask)
+	require.NoError(t, err)
+	status := make(map[yakit.CompareStatus]int)
+	for r := range res {
+		status[r.Status]++
+		if r.Status == yakit.Add {
+			require.Contains(t, r.NewValue.CodeSourceUrl, "C.java")
+		}
+	}
+	require.Equal(t, 1, status[yakit.Add])
+	require.Equal(t, 1, status[yakit.Equal])
+	require.Equal(t, 0, status[yakit.Del])
+}
@@ -109,6 +109,7 @@ func (c *config) init(filesystem filesys_interface.FileSystem) (*ssa.Program, *s
 	builder.WithExternMethod(c.externMethod)
 	builder.WithExternBuildValueHandler(c.externBuildValueHandler)
 	builder.WithDefineFunction(c.defineFunc)
+	builder.WithMacroModels(c.macroModels)
 	builder.SetContext(c.ctx)
 	return application, builder, nil
 }
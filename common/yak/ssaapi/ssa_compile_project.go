@@ -36,6 +36,16 @@ func ParseProject(opts ...Option) (Programs, error) {
 }
 
 func (c *config) parseProject() (Programs, error) {
+	if c.incremental {
+		if prog, ok, err := c.incrementalCompile(); err != nil {
+			return nil, err
+		} else if ok {
+			return Programs{prog}, nil
+		}
+		// can not compile incrementally, rebuild the whole program
+		c.reCompile = true
+	}
+
 	if c.reCompile {
 		c.Processf(0, "recompile project, delete old data...")
 		ssadb.DeleteProgramIrCode(ssadb.GetDB(), c.ProgramName)
@@ -60,6 +70,7 @@ func (c *config) parseProject() (Programs, error) {
 			return nil, err
 		} else {
 			c.SaveConfig()
+			prog.incrementalInfo = c.incrementalInfo
 			c.Processf(1, "program %s finish", prog.GetProgramName())
 			return Programs{prog}, nil
 		}
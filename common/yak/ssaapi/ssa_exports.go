@@ -21,6 +21,7 @@ import (
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/filesys"
 	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/yak/rust/rust2ssa"
 	"github.com/yaklang/yaklang/common/yak/ssa"
 )
 
@@ -41,6 +42,7 @@ type config struct {
 	feedCode        bool
 	ignoreSyntaxErr bool
 	reCompile       bool
+	incremental     bool
 	strictMode      bool
 
 	// input, code or project path
@@ -63,6 +65,7 @@ type config struct {
 	defineFunc              map[string]any
 	externMethod            ssa.MethodBuilder
 	externBuildValueHandler map[string]func(b *ssa.FunctionBuilder, id string, v any) (value ssa.Value)
+	macroModels             map[string]string
 
 	// peephole
 	peepholeSize int
@@ -78,6 +81,9 @@ type config struct {
 	excludeFile func(path, filename string) bool
 
 	logLevel string
+
+	// incremental compile result
+	incrementalInfo *IncrementalCompileInfo
 }
 
 func defaultConfig(opts ...Option) (*config, error) {
@@ -92,6 +98,7 @@ func defaultConfig(opts ...Option) (*config, error) {
 		externLib:                  make(map[string]map[string]any),
 		externValue:                make(map[string]any),
 		defineFunc:                 make(map[string]any),
+		macroModels:                make(map[string]string),
 		DatabaseProgramCacheHitter: func(any) {},
 		ctx:                        context.Background(),
 		excludeFile: func(path, filename string) bool {
@@ -183,6 +190,14 @@ func WithReCompile(b bool) Option {
 	}
 }
 
+// WithIncrementalCompile 增量编译，只重新编译数据库中已有程序发生变化的文件以及与其存在依赖关系的文件
+func WithIncrementalCompile(b bool) Option {
+	return func(c *config) error {
+		c.incremental = b
+		return nil
+	}
+}
+
 func WithStrictMode(b bool) Option {
 	return func(c *config) error {
 		c.strictMode = b
@@ -323,6 +338,15 @@ func WithExternBuildValueHandler(id string, callback func(b *ssa.FunctionBuilder
 	}
 }
 
+// WithRustMacroModel sets how the invocations of a rust macro are built in this compile, the name is
+// the macro path without `!`, e.g. WithRustMacroModel("sqlx::query", rust2ssa.MacroFormat)
+func WithRustMacroModel(name string, kind rust2ssa.MacroKind) Option {
+	return func(c *config) error {
+		c.macroModels[name] = kind.String()
+		return nil
+	}
+}
+
 func WithPeepholeSize(size int) Option {
 	return func(c *config) error {
 		c.peepholeSize = size
@@ -522,6 +546,7 @@ var Exports = map[string]any{
 	"withProcess":            WithProcess,
 	"withEntryFile":          WithFileSystemEntry,
 	"withReCompile":          WithReCompile,
+	"withIncrementalCompile": WithIncrementalCompile,
 	"withStrictMode":         WithStrictMode,
 	"withContext":            WithContext,
 	"withPeepholeSize":       WithPeepholeSize,
@@ -56,3 +56,27 @@ func (prog *Program) Recompile(opts ...Option) error {
 
 	return err
 }
+
+// IncrementalRecompile 增量重新编译，只重新编译发生变化的文件以及与其存在依赖关系的文件，
+// 没有文件发生变化时不会编译，返回的程序可以通过 GetIncrementalCompileInfo 获取文件的变化
+func (prog *Program) IncrementalRecompile(opts ...Option) (*Program, error) {
+	opts = append(opts, WithIncrementalCompile(true))
+	if prog.irProgram != nil && prog.irProgram.ConfigInput != "" {
+		opts = append(opts, WithConfigInfoRaw(prog.irProgram.ConfigInput))
+	} else if prog.config == nil || prog.config.fs == nil {
+		return nil, utils.Errorf("该项目编译时引擎版本过旧，无法重新编译。")
+	} else {
+		opts = append([]Option{WithFileSystem(prog.config.fs)}, opts...)
+	}
+	opts = append(opts, WithProgramName(prog.Program.Name))
+	opts = append(opts, WithRawLanguage(prog.GetLanguage()))
+
+	progs, err := ParseProject(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(progs) == 0 {
+		return nil, utils.Errorf("program %s recompile failed", prog.Program.Name)
+	}
+	return progs[0], nil
+}
@@ -599,6 +599,10 @@ var FuzzExports = map[string]interface{}{
 	"UrlsToHTTPRequests": mutate.UrlsToHTTPRequests,
 	"UrlToHTTPRequest":   _urlToFuzzRequest,
 
+	// graphql
+	"ParseGraphQLSchema":        mutate.ParseGraphQLSchema,
+	"GraphQLIntrospectionQuery": mutate.GraphQLIntrospectionQuery,
+
 	// protobuf fuzz
 	"ProtobufHex":   _protobufRecordsFromHex,
 	"ProtobufBytes": _protobufRecordsFromBytes,
@@ -35,6 +35,7 @@ var MitmExports = map[string]interface{}{
 	"gmtls":                mitmConfigGMTLS,
 	"gmtlsPrefer":          mitmConfigGMTLSPrefer,
 	"gmtlsOnly":            mitmConfigGMTLSOnly,
+	"tlsKeyLogFile":        mitmConfigTLSKeyLogFile,
 }
 
 // Start 启动一个 MITM (中间人)代理服务器，它的第一个参数是端口，接下来可以接收零个到多个选项函数，用于影响中间人代理服务器的行为
@@ -63,6 +64,7 @@ type mitmConfig struct {
 	gmtls                  bool
 	gmtlsPrefer            bool
 	gmtlsOnly              bool
+	tlsKeyLogFile          string
 	dialer                 func(timeout time.Duration, target string) (net.Conn, error)
 	tunMode                bool
 
@@ -124,6 +126,18 @@ func mitmConfigGMTLSOnly(b bool) MitmConfigOpt {
 	}
 }
 
+// tlsKeyLogFile 是一个选项参数，用于指定中间人代理服务器把劫持的 TLS 连接的密钥以 NSS Key Log 格式（SSLKEYLOGFILE）追加写入文件
+// 同时抓取的流量可以使用该文件解密，例如 pcapx 中的 pcap_tlsKeyLogFile
+// Example:
+// ```
+// mitm.Start(8080, mitm.tlsKeyLogFile("/tmp/sslkeylog.txt"))
+// ```
+func mitmConfigTLSKeyLogFile(filename string) MitmConfigOpt {
+	return func(config *mitmConfig) {
+		config.tlsKeyLogFile = filename
+	}
+}
+
 var MitmConfigContext = mitmConfigContext
 
 // context 是一个选项函数，用于指定中间人代理服务器的上下文
@@ -387,7 +401,13 @@ func initMitmServer(downstreamProxy []string, config *mitmConfig) (*crep.MITMSer
 		config.ctx = context.Background()
 	}
 
+	keyLogOpt := crep.MITM_MergeOptions()
+	if config.tlsKeyLogFile != "" {
+		keyLogOpt = crep.MITM_SetTLSKeyLogFile(config.tlsKeyLogFile)
+	}
+
 	return crep.NewMITMServer(
+		keyLogOpt,
 		crep.MITM_SetDialer(config.dialer),
 		crep.MITM_SetTunMode(config.tunMode),
 		crep.MITM_SetGM(config.gmtls),
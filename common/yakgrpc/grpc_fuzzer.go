@@ -716,6 +716,13 @@ func (s *Server) HTTPFuzzer(req *ypb.FuzzerRequest, stream ypb.Yak_HTTPFuzzerSer
 		maxBodySize = uint64(req.MaxBodySize)
 	}
 
+	// 任务级别的 host 策略优先，未匹配的 host 使用全局策略
+	s.syncHostPolicy()
+	fuzzerCtx, err := withTaskHostPolicy(stream.Context(), req.GetHostPolicy())
+	if err != nil {
+		return err
+	}
+
 	fuzzerRequestSwg := utils.NewSizedWaitGroup(int(concurrent))
 	executeBatchRequestsWithParams := func(mergedParams map[string]any) (retErr error) {
 		defer func() {
@@ -740,7 +747,7 @@ func (s *Server) HTTPFuzzer(req *ypb.FuzzerRequest, stream ypb.Yak_HTTPFuzzerSer
 			mutate.WithPoolOpt_Https(req.GetIsHTTPS()),
 			mutate.WithPoolOpt_GmTLS(req.GetIsGmTLS()),
 			mutate.WithPoolOpt_RandomJA3(req.GetRandomJA3()),
-			mutate.WithPoolOpt_Context(stream.Context()),
+			mutate.WithPoolOpt_Context(fuzzerCtx),
 			mutate.WithPoolOpt_FollowJSRedirect(req.GetFollowJSRedirect()),
 			mutate.WithPoolOpt_RedirectTimes(int(req.GetRedirectTimes())),
 			mutate.WithPoolOpt_NoFollowRedirect(req.GetNoFollowRedirect()),
@@ -0,0 +1,77 @@
+package yakgrpc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+const (
+	// YAK_ENGINE_HOST_POLICY is the json of the default host policies, a web fuzzer or hybrid scan task can
+	// override them by the HostPolicy of its request, e.g.
+	// {"*": {"max_concurrent": 10, "adaptive_backoff": true, "breaker_threshold": 20}, "*.example.com": {"rps": 5}}
+	YAK_ENGINE_HOST_POLICY = "YAK_ENGINE_HOST_POLICY"
+)
+
+var (
+	hostPolicyConfigMu sync.Mutex
+	// hostPolicyConfigRaw is the last loaded json, hostPolicyConfigPatterns are the patterns it registered
+	hostPolicyConfigRaw      string
+	hostPolicyConfigPatterns []string
+)
+
+// syncHostPolicy loads YAK_ENGINE_HOST_POLICY into lowhttp.DefaultHostPolicyRegistry, the default policies of
+// the hosts not matched by the policy of a task
+func (s *Server) syncHostPolicy() {
+	syncHostPolicyFromDatabase(s.GetProfileDatabase())
+}
+
+// syncHostPolicyFromDatabase only rebuilds the policies when the stored json changes, the state of the policies
+// (in-flight requests, back-off and circuit breaker) is kept otherwise. Patterns set by scripts are not touched.
+func syncHostPolicyFromDatabase(db *gorm.DB) {
+	raw := yakit.GetKey(db, YAK_ENGINE_HOST_POLICY)
+
+	hostPolicyConfigMu.Lock()
+	defer hostPolicyConfigMu.Unlock()
+	if raw == hostPolicyConfigRaw {
+		return
+	}
+	hostPolicyConfigRaw = raw
+
+	var policies map[string]*lowhttp.HostPolicy
+	if raw != "" {
+		registry, err := lowhttp.NewHostPolicyRegistryFromJSON([]byte(raw))
+		if err != nil {
+			log.Warnf("load %v failed: %v", YAK_ENGINE_HOST_POLICY, err)
+			return
+		}
+		policies = registry.Policies()
+	}
+
+	for _, pattern := range hostPolicyConfigPatterns {
+		lowhttp.RemoveHostPolicy(pattern)
+	}
+	hostPolicyConfigPatterns = hostPolicyConfigPatterns[:0]
+	for pattern, policy := range policies {
+		lowhttp.SetHostPolicy(pattern, policy)
+		hostPolicyConfigPatterns = append(hostPolicyConfigPatterns, pattern)
+	}
+}
+
+// withTaskHostPolicy binds the host policy of a fuzzer or hybrid scan request to the task context, raw is in the
+// format of YAK_ENGINE_HOST_POLICY. The policies are only shared by the requests of the task.
+func withTaskHostPolicy(ctx context.Context, raw string) (context.Context, error) {
+	if raw == "" {
+		return ctx, nil
+	}
+	registry, err := lowhttp.NewHostPolicyRegistryFromJSON([]byte(raw))
+	if err != nil {
+		return ctx, utils.Wrap(err, "load task host policy failed")
+	}
+	return lowhttp.ContextWithHostPolicyRegistry(ctx, registry), nil
+}
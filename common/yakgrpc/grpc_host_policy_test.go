@@ -0,0 +1,99 @@
+package yakgrpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+func TestSyncHostPolicy(t *testing.T) {
+	db := consts.GetGormProfileDatabase()
+	origin := yakit.GetKey(db, YAK_ENGINE_HOST_POLICY)
+	scriptPolicy := &lowhttp.HostPolicy{MaxConcurrent: 1}
+	lowhttp.SetHostPolicy("script.host-policy.test", scriptPolicy)
+	t.Cleanup(func() {
+		lowhttp.RemoveHostPolicy("script.host-policy.test")
+		yakit.SetKey(db, YAK_ENGINE_HOST_POLICY, origin)
+		syncHostPolicyFromDatabase(db)
+	})
+
+	require.NoError(t, yakit.SetKey(db, YAK_ENGINE_HOST_POLICY, `{"*.host-policy.test": {"max_concurrent": 2}}`))
+	syncHostPolicyFromDatabase(db)
+	policy := lowhttp.DefaultHostPolicyRegistry.Match("a.host-policy.test", 80)
+	require.NotNil(t, policy)
+	require.Equal(t, 2, policy.MaxConcurrent)
+
+	// the registry is kept when the json is not changed, so the state is shared by all tasks
+	syncHostPolicyFromDatabase(db)
+	require.Same(t, policy, lowhttp.DefaultHostPolicyRegistry.Match("a.host-policy.test", 80))
+
+	require.NoError(t, yakit.SetKey(db, YAK_ENGINE_HOST_POLICY, `{"b.host-policy.test": {"rps": 5}}`))
+	syncHostPolicyFromDatabase(db)
+	require.Nil(t, lowhttp.DefaultHostPolicyRegistry.Match("a.host-policy.test", 80))
+	require.Equal(t, float64(5), lowhttp.DefaultHostPolicyRegistry.Match("b.host-policy.test", 80).RPS)
+	// patterns set by scripts are not removed
+	require.Same(t, scriptPolicy, lowhttp.DefaultHostPolicyRegistry.Match("script.host-policy.test", 80))
+}
+
+func TestWithTaskHostPolicy(t *testing.T) {
+	ctx, err := withTaskHostPolicy(context.Background(), "")
+	require.NoError(t, err)
+	require.Nil(t, lowhttp.HostPolicyRegistryFromContext(ctx))
+
+	ctx, err = withTaskHostPolicy(context.Background(), `{"a.host-policy.test": {"rps": 3}}`)
+	require.NoError(t, err)
+	require.Equal(t, float64(3), lowhttp.HostPolicyRegistryFromContext(ctx).Match("a.host-policy.test", 80).RPS)
+	// other tasks are not affected
+	require.Nil(t, lowhttp.DefaultHostPolicyRegistry.Match("a.host-policy.test", 80))
+
+	_, err = withTaskHostPolicy(context.Background(), `[]`)
+	require.Error(t, err)
+}
+
+func TestGRPCMUSTPASS_HTTPFuzzer_TaskHostPolicy(t *testing.T) {
+	var count int64
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt64(&count, 1)
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c, err := NewLocalClient()
+	require.NoError(t, err)
+
+	fuzz := func(hostPolicy string) int {
+		stream, err := c.HTTPFuzzer(context.Background(), &ypb.FuzzerRequest{
+			Request:                  fmt.Sprintf("GET /{{int(1-5)}} HTTP/1.1\r\nHost: %v\r\n\r\n", utils.HostPort(host, port)),
+			Concurrent:               1,
+			ForceFuzz:                true,
+			PerRequestTimeoutSeconds: 5,
+			HostPolicy:               hostPolicy,
+		})
+		require.NoError(t, err)
+		responses := 0
+		for {
+			_, err := stream.Recv()
+			if err != nil {
+				break
+			}
+			responses++
+		}
+		return responses
+	}
+
+	// the circuit breaker of the task opens after the first 503
+	policy := fmt.Sprintf(`{%q: {"adaptive_backoff": true, "min_backoff_seconds": 0.001, "breaker_threshold": 1, "breaker_cooldown_seconds": 60}}`, host)
+	require.Equal(t, 5, fuzz(policy))
+	require.Equal(t, int64(1), atomic.LoadInt64(&count))
+
+	// the breaker belongs to the previous task
+	require.Equal(t, 5, fuzz(""))
+	require.Equal(t, int64(6), atomic.LoadInt64(&count))
+}
@@ -921,6 +921,13 @@ func (s *Server) ExportHTTPFlowStream(req *ypb.ExportHTTPFlowStreamRequest, stre
 					count++
 					sendPercent()
 				} else {
+					if flow.IsWebsocket && flow.WebsocketHash != "" {
+						wsFlows, err := yakit.QueryAllWebsocketFlowByWebsocketHash(s.GetProjectDatabase(), flow.WebsocketHash)
+						if err != nil {
+							log.Errorf("query websocket flows of %v failed: %s", flow.Url, err)
+						}
+						entry.WebSocketMessages = har.WebsocketFlows2HarMessages(wsFlows)
+					}
 					entryCh <- entry
 				}
 			}
@@ -985,6 +992,11 @@ func (s *Server) ImportHTTPFlowStream(req *ypb.ImportHTTPFlowStreamRequest, stre
 			if err != nil {
 				return err
 			}
+			for _, wsFlow := range har.HarEntry2WebsocketFlows(e, flow.WebsocketHash) {
+				if err := yakit.CreateOrUpdateWebsocketFlow(tx, wsFlow.Hash, wsFlow); err != nil {
+					return err
+				}
+			}
 			count++
 			percent := 0.0
 			if total == 0 {
@@ -989,6 +989,89 @@ func TestGRPCMUSTPASS_Export_And_ImportHAR(t *testing.T) {
 	require.Equal(t, wantCount, len(flows))
 }
 
+func TestGRPCMUSTPASS_Export_And_ImportHAR_Websocket(t *testing.T) {
+	client, err := NewLocalClient()
+	require.NoError(t, err)
+	ctx := utils.TimeoutContextSeconds(10)
+
+	db := consts.GetGormProjectDatabase()
+	token := utils.RandStringBytes(16)
+	wsHash := utils.CalcSha1(token)
+	flow, err := yakit.CreateHTTPFlow(
+		yakit.CreateHTTPFlowWithURL("ws://example.com/"+token),
+		yakit.CreateHTTPFlowWithRequestRaw([]byte("GET /"+token+" HTTP/1.1\r\nHost: example.com\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")),
+		yakit.CreateHTTPFlowWithResponseRaw([]byte("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")),
+	)
+	require.NoError(t, err)
+	flow.IsWebsocket = true
+	flow.WebsocketHash = wsHash
+	require.NoError(t, yakit.InsertHTTPFlow(db, flow))
+	for i, data := range []string{"ping", "pong"} {
+		wsFlow := yakit.BuildWebsocketFlow(i == 1, wsHash, i+1, []byte(data))
+		wsFlow.Hash = wsFlow.CalcHash()
+		require.NoError(t, yakit.CreateOrUpdateWebsocketFlow(db, wsFlow.Hash, wsFlow))
+	}
+	t.Cleanup(func() {
+		yakit.DeleteHTTPFlow(db, &ypb.DeleteHTTPFlowRequest{Id: []int64{int64(flow.ID)}})
+		yakit.DeleteWebsocketFlowByWebsocketHash(db, wsHash)
+	})
+
+	// export
+	fn := filepath.Join(t.TempDir(), "test.har")
+	stream, err := client.ExportHTTPFlowStream(ctx, &ypb.ExportHTTPFlowStreamRequest{
+		Filter:     &ypb.QueryHTTPFlowRequest{Keyword: token},
+		ExportType: "har",
+		TargetPath: fn,
+	})
+	require.NoError(t, err)
+	for {
+		if _, err := stream.Recv(); err != nil {
+			break
+		}
+	}
+	fh, err := os.Open(fn)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fh.Close()
+	})
+	count := 0
+	err = har.ImportHTTPArchiveStream(fh, func(h *har.HAREntry) error {
+		count++
+		require.Len(t, h.WebSocketMessages, 2)
+		require.Equal(t, "send", h.WebSocketMessages[0].Type)
+		require.Equal(t, "ping", h.WebSocketMessages[0].Data)
+		require.Equal(t, "receive", h.WebSocketMessages[1].Type)
+		require.Equal(t, "pong", h.WebSocketMessages[1].Data)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// delete before import
+	require.NoError(t, yakit.DeleteHTTPFlow(db, &ypb.DeleteHTTPFlowRequest{Id: []int64{int64(flow.ID)}}))
+	require.NoError(t, yakit.DeleteWebsocketFlowByWebsocketHash(db, wsHash))
+
+	// import
+	importStream, err := client.ImportHTTPFlowStream(ctx, &ypb.ImportHTTPFlowStreamRequest{InputPath: fn})
+	require.NoError(t, err)
+	for {
+		if _, err := importStream.Recv(); err != nil {
+			break
+		}
+	}
+	_, flows, err := yakit.QueryHTTPFlow(db, &ypb.QueryHTTPFlowRequest{Keyword: token})
+	require.NoError(t, err)
+	require.Len(t, flows, 1)
+	require.True(t, flows[0].IsWebsocket)
+	require.Equal(t, wsHash, flows[0].WebsocketHash)
+	wsFlows, err := yakit.QueryAllWebsocketFlowByWebsocketHash(db, wsHash)
+	require.NoError(t, err)
+	require.Len(t, wsFlows, 2)
+	t.Cleanup(func() {
+		yakit.DeleteHTTPFlow(db, &ypb.DeleteHTTPFlowRequest{Id: []int64{int64(flows[0].ID)}})
+	})
+}
+
 func TestGRPCMUSTPASS_Export_CSV(t *testing.T) {
 	client, err := NewLocalClient()
 	require.NoError(t, err)
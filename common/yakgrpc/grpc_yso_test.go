@@ -2,6 +2,7 @@ package yakgrpc
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"github.com/yaklang/yaklang/common/yak"
 	"math/rand"
@@ -11,6 +12,7 @@ import (
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
 	"github.com/yaklang/yaklang/common/yso"
+	"github.com/yaklang/yaklang/common/yso/dotnet"
 )
 
 func TestGRPCMUSTPASS_GeneratePayload(t *testing.T) {
@@ -24,8 +26,11 @@ func TestGRPCMUSTPASS_GeneratePayload(t *testing.T) {
 		t.Fatal(err)
 	}
 	//测试获取所有的yso选项(ysoVerboses, t)
-	assert.Equal(t, len(ysoVerboses.GetOptions()), len(yso.AllGadgets)-1)
+	assert.Equal(t, len(ysoVerboses.GetOptions()), len(yso.AllGadgets)-1+len(dotnet.GetAllGadgets()))
 	for _, option := range ysoVerboses.GetOptions() {
+		if dotnet.IsGadget(option.GetName()) {
+			continue
+		}
 		assert.Equal(t, yso.AllGadgets[yso.GadgetType(option.GetName())].Name, option.GetName())
 		classOptions, err := client.GetAllYsoClassOptions(ctx, &ypb.YsoOptionsRequerstWithVerbose{Gadget: option.GetName()})
 		if err != nil {
@@ -87,8 +92,11 @@ func TestGRPCMUSTPASS_GenerateYakCode(t *testing.T) {
 		t.Fatal(err)
 	}
 	//测试获取所有的yso选项(ysoVerboses, t)
-	assert.Equal(t, len(ysoVerboses.GetOptions()), len(yso.AllGadgets)-1)
+	assert.Equal(t, len(ysoVerboses.GetOptions()), len(yso.AllGadgets)-1+len(dotnet.GetAllGadgets()))
 	for _, option := range ysoVerboses.GetOptions() {
+		if dotnet.IsGadget(option.GetName()) {
+			continue
+		}
 		assert.Equal(t, yso.AllGadgets[yso.GadgetType(option.GetName())].Name, option.GetName())
 		classOptions, err := client.GetAllYsoClassOptions(ctx, &ypb.YsoOptionsRequerstWithVerbose{Gadget: option.GetName()})
 		if err != nil {
@@ -125,3 +133,57 @@ func TestGRPCMUSTPASS_GenerateYakCode(t *testing.T) {
 		}
 	}
 }
+
+func TestGRPCMUSTPASS_GenerateDotNetPayload(t *testing.T) {
+	client, err := NewLocalClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	validationKey := "B4A1C6D6E8F0A2B4C6D8E0F2A4B6C8D0E2F4A6B8C0D2E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2B4C6D8E0F2A4B6C8D0E2F4A6B8C0D2E4F6A8B0C2D4E6F8A0B2C4"
+	decryptionKey := "0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF"
+	for _, gadget := range dotnet.GetAllGadgets() {
+		classOptions, err := client.GetAllYsoClassOptions(ctx, &ypb.YsoOptionsRequerstWithVerbose{Gadget: string(gadget.Name)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, classOption := range classOptions.GetOptions() {
+			req := &ypb.YsoOptionsRequerstWithVerbose{Gadget: string(gadget.Name), Class: classOption.GetName()}
+			paramsOption, err := client.GetAllYsoClassGeneraterOptions(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, option := range paramsOption.GetOptions() {
+				switch option.Key {
+				case "validationKey":
+					option.Value = validationKey
+				case "decryptionKey":
+					option.Value = decryptionKey
+				}
+			}
+			req.Options = paramsOption.GetOptions()
+			rsp, err := client.GenerateYsoBytes(ctx, req)
+			if err != nil {
+				t.Fatalf("gadget: %s, class: %s, err: %v", req.Gadget, req.Class, err)
+			}
+			assert.NotEmpty(t, rsp.Bytes)
+			if req.Class == "ViewState" {
+				_, err := base64.StdEncoding.DecodeString(string(rsp.Bytes))
+				assert.NoError(t, err)
+			}
+
+			codeRsp, err := client.GenerateYsoCode(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e, err := yak.Execute(codeRsp.Code)
+			if err != nil {
+				t.Fatalf("code: %s, err: %v", codeRsp.Code, err)
+			}
+			v, ok := e.GetVar("payload")
+			if !ok || v == nil {
+				t.Fatalf("payload is nil, gadget: %s, class: %s, code: %s", req.Gadget, req.Class, codeRsp.Code)
+			}
+		}
+	}
+}
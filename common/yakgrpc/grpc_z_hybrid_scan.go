@@ -90,6 +90,8 @@ func (s *Server) HybridScan(stream ypb.Yak_HybridScanServer) error {
 		}()
 	}
 
+	s.syncHostPolicy()
+
 	var taskStream = newWrapperHybridScanStream(taskCtx, stream)
 	taskStream.RequestHandler = func(request *ypb.HybridScanRequest) bool {
 		//if request.Control {
@@ -72,6 +72,7 @@ func (s *Server) hybridScanNewTask(manager *HybridScanTaskManager, stream Hybrid
 	concurrent := 20 // 默认值
 	var totalTimeout float32 = 72000
 	var proxy string
+	var hostPolicy string
 	log.Infof("waiting for recv input and plugin config: %v", taskId)
 	for plugin == nil || target == nil {
 		rsp, err = stream.Recv()
@@ -94,14 +95,23 @@ func (s *Server) hybridScanNewTask(manager *HybridScanTaskManager, stream Hybrid
 		if rsp.GetProxy() != "" {
 			proxy = rsp.GetProxy()
 		}
+		if rsp.GetHostPolicy() != "" {
+			hostPolicy = rsp.GetHostPolicy()
+		}
 	}
 	taskRecorder.ScanConfig, _ = json.Marshal(rsp)
 	quickSave()
 
 	// 设置并发
 	swg := utils.NewSizedWaitGroup(concurrent)
+	// 任务级别的 host 策略
+	taskCtx, err := withTaskHostPolicy(manager.Context(), hostPolicy)
+	if err != nil {
+		taskRecorder.Reason = err.Error()
+		return err
+	}
 	// 设置总超时
-	manager.ctx, manager.cancel = context.WithTimeout(manager.Context(), time.Duration(totalTimeout)*time.Second)
+	manager.ctx, manager.cancel = context.WithTimeout(taskCtx, time.Duration(totalTimeout)*time.Second)
 
 	// targetChan 的大小如何估算？目标数量（百万为单位） * 目标大小字节数为 M 数
 	// 即，100w 个目标，每个目标占用大小为 100 字节，那么都在内存中，开销大约为 100M
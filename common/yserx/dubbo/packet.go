@@ -0,0 +1,110 @@
+package dubbo
+
+import (
+	"encoding/binary"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// https://github.com/apache/dubbo/blob/dubbo-2.7.23/dubbo-remoting/dubbo-remoting-api/src/main/java/org/apache/dubbo/remoting/exchange/codec/ExchangeCodec.java
+
+const (
+	Magic      = 0xdabb
+	HeaderSize = 16
+
+	flagRequest = 0x80
+	flagTwoWay  = 0x40
+	flagEvent   = 0x20
+	// serializationMask 标记字节的低 5 位为序列化方式
+	serializationMask = 0x1f
+
+	// DefaultDubboVersion 请求中的 Dubbo 协议版本
+	DefaultDubboVersion = "2.0.2"
+)
+
+// 序列化方式 id，与 Serialization.getContentTypeId 相同
+const (
+	SerializationHessian2      byte = 2
+	SerializationJava          byte = 3
+	SerializationCompactedJava byte = 4
+	SerializationFastjson      byte = 6
+	SerializationNativeJava    byte = 7
+	SerializationKryo          byte = 8
+	SerializationFst           byte = 9
+)
+
+// 响应状态，与 Response 中的常量相同
+const (
+	StatusOK                byte = 20
+	StatusClientTimeout     byte = 30
+	StatusServerTimeout     byte = 31
+	StatusBadRequest        byte = 40
+	StatusBadResponse       byte = 50
+	StatusServiceNotFound   byte = 60
+	StatusServiceError      byte = 70
+	StatusServerError       byte = 80
+	StatusClientError       byte = 90
+	StatusThreadPoolExhaust byte = 100
+)
+
+// Packet Dubbo 协议的一个数据包，16 字节的头部之后是按照 Serialization 序列化的数据
+type Packet struct {
+	Request       bool
+	TwoWay        bool
+	Event         bool
+	Serialization byte
+	// Status 响应状态，请求中为 0
+	Status byte
+	ID     int64
+	Body   []byte
+}
+
+func (p *Packet) Marshal() []byte {
+	header := make([]byte, HeaderSize)
+	binary.BigEndian.PutUint16(header, Magic)
+	flag := p.Serialization & serializationMask
+	if p.Request {
+		flag |= flagRequest
+	}
+	if p.TwoWay {
+		flag |= flagTwoWay
+	}
+	if p.Event {
+		flag |= flagEvent
+	}
+	header[2] = flag
+	header[3] = p.Status
+	binary.BigEndian.PutUint64(header[4:], uint64(p.ID))
+	binary.BigEndian.PutUint32(header[12:], uint32(len(p.Body)))
+	return append(header, p.Body...)
+}
+
+// Size 数据包的总长度，用于从数据流中读取下一个数据包
+func (p *Packet) Size() int {
+	return HeaderSize + len(p.Body)
+}
+
+// ParsePacket 解析数据开头的一个 Dubbo 数据包，多余的数据会被忽略
+func ParsePacket(raw any) (*Packet, error) {
+	data := utils.InterfaceToBytes(raw)
+	if len(data) < HeaderSize {
+		return nil, utils.Errorf("dubbo packet too short: %d bytes", len(data))
+	}
+	if magic := binary.BigEndian.Uint16(data); magic != Magic {
+		return nil, utils.Errorf("bad dubbo magic: 0x%04x", magic)
+	}
+	length := binary.BigEndian.Uint32(data[12:])
+	if uint64(length) > uint64(len(data)-HeaderSize) {
+		return nil, utils.Errorf("dubbo body length %d exceeds available %d bytes", length, len(data)-HeaderSize)
+	}
+	flag := data[2]
+	return &Packet{
+		Request:       flag&flagRequest != 0,
+		TwoWay:        flag&flagTwoWay != 0,
+		Event:         flag&flagEvent != 0,
+		Serialization: flag & serializationMask,
+		Status:        data[3],
+		ID:            int64(binary.BigEndian.Uint64(data[4:])),
+		Body:          data[HeaderSize : HeaderSize+int(length)],
+	}, nil
+}
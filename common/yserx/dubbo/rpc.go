@@ -0,0 +1,271 @@
+package dubbo
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yserx/hessian"
+	"github.com/yaklang/yaklang/common/yserx/kryo"
+)
+
+// https://github.com/apache/dubbo/blob/dubbo-2.7.23/dubbo-rpc/dubbo-rpc-dubbo/src/main/java/org/apache/dubbo/rpc/protocol/dubbo/DubboCodec.java
+
+// 响应结果标记，与 DubboCodec 中的常量相同
+const (
+	ResponseWithException                byte = 0
+	ResponseValue                        byte = 1
+	ResponseNullValue                    byte = 2
+	ResponseWithExceptionWithAttachments byte = 3
+	ResponseValueWithAttachments         byte = 4
+	ResponseNullValueWithAttachments     byte = 5
+)
+
+var requestID int64
+
+// Request Dubbo 调用请求。Args 中的值为 *hessian.Value / *kryo.Value，或者可以转换的 Go 值
+type Request struct {
+	ID            int64
+	TwoWay        bool
+	Event         bool
+	Serialization byte
+	DubboVersion  string
+	// Path 服务接口名，例如 org.apache.dubbo.demo.DemoService
+	Path    string
+	Version string
+	Method  string
+	// ParameterTypes 方法签名中的参数类型，例如 Ljava/lang/String;，为空时根据参数推断
+	ParameterTypes string
+	Args           []any
+	Attachments    map[string]string
+}
+
+// NewRequest 使用 Hessian2 序列化的双向调用请求
+func NewRequest(path, method string, args ...any) *Request {
+	return &Request{
+		ID:            atomic.AddInt64(&requestID, 1),
+		TwoWay:        true,
+		Serialization: SerializationHessian2,
+		DubboVersion:  DefaultDubboVersion,
+		Path:          path,
+		Version:       "0.0.0",
+		Method:        method,
+		Args:          args,
+	}
+}
+
+// Marshal 生成完整的 Dubbo 数据包，使用 Kryo 序列化时 opts 需要与服务端的注册表一致
+func (r *Request) Marshal(opts ...kryo.Option) ([]byte, error) {
+	out, err := newObjectOutput(r.Serialization, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if r.Event {
+		// 心跳等事件的数据为一个对象
+		var data any
+		if len(r.Args) > 0 {
+			data = r.Args[0]
+		}
+		out.writeObject(data)
+	} else {
+		args := make([]any, len(r.Args))
+		for i, arg := range r.Args {
+			if r.Serialization == SerializationKryo {
+				args[i] = kryo.ToValue(arg)
+			} else {
+				args[i] = hessian.ToValue(arg)
+			}
+		}
+		desc := r.ParameterTypes
+		if desc == "" {
+			var types []string
+			for _, arg := range args {
+				types = append(types, argDesc(arg))
+			}
+			desc = strings.Join(types, "")
+		}
+		out.writeUTF(r.DubboVersion)
+		out.writeUTF(r.Path)
+		out.writeUTF(r.Version)
+		out.writeUTF(r.Method)
+		out.writeUTF(desc)
+		for _, arg := range args {
+			out.writeObject(arg)
+		}
+		attachments := map[string]string{"path": r.Path, "interface": r.Path}
+		if r.Version != "" {
+			attachments["version"] = r.Version
+		}
+		for k, v := range r.Attachments {
+			attachments[k] = v
+		}
+		out.writeAttachments(attachments)
+	}
+	p := &Packet{
+		Request:       true,
+		TwoWay:        r.TwoWay,
+		Event:         r.Event,
+		Serialization: r.Serialization,
+		ID:            r.ID,
+		Body:          out.bytes(),
+	}
+	return p.Marshal(), nil
+}
+
+// ParseRequest 解析 Dubbo 请求数据包，参数按照 ParameterTypes 中的数量读取
+func ParseRequest(raw any, opts ...kryo.Option) (*Request, error) {
+	p, err := ParsePacket(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Request {
+		return nil, utils.Error("dubbo packet is not a request")
+	}
+	r := &Request{ID: p.ID, TwoWay: p.TwoWay, Event: p.Event, Serialization: p.Serialization}
+	in, err := newObjectInput(p.Serialization, p.Body, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if p.Event {
+		if in.more() {
+			data, err := in.readObject()
+			if err != nil {
+				return r, err
+			}
+			r.Args = []any{data}
+		}
+		return r, nil
+	}
+	for _, field := range []*string{&r.DubboVersion, &r.Path, &r.Version, &r.Method, &r.ParameterTypes} {
+		if *field, err = in.readUTF(); err != nil {
+			return r, utils.Errorf("read dubbo request header failed: %v", err)
+		}
+	}
+	types, err := splitDesc(r.ParameterTypes)
+	if err != nil {
+		return r, err
+	}
+	for range types {
+		arg, err := in.readObject()
+		if err != nil {
+			return r, utils.Errorf("read dubbo request argument failed: %v", err)
+		}
+		r.Args = append(r.Args, arg)
+	}
+	if in.more() {
+		if r.Attachments, err = in.readAttachments(); err != nil {
+			return r, utils.Errorf("read dubbo request attachments failed: %v", err)
+		}
+	}
+	return r, nil
+}
+
+// Response Dubbo 调用结果。Status 不为 OK 时 ErrorMessage 为服务端返回的错误信息
+type Response struct {
+	ID            int64
+	Event         bool
+	Serialization byte
+	Status        byte
+	// Flag 结果标记，见 ResponseValue 等常量
+	Flag         byte
+	Value        any
+	Exception    any
+	ErrorMessage string
+	Attachments  map[string]string
+}
+
+// Marshal 生成响应数据包，Flag 根据 Exception、Value 与 Attachments 计算
+func (r *Response) Marshal(opts ...kryo.Option) ([]byte, error) {
+	out, err := newObjectOutput(r.Serialization, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case r.Status != StatusOK:
+		out.writeUTF(r.ErrorMessage)
+	case r.Event:
+		out.writeObject(r.Value)
+	default:
+		flag := ResponseValue
+		if r.Exception != nil {
+			flag = ResponseWithException
+		} else if r.Value == nil {
+			flag = ResponseNullValue
+		}
+		if r.Attachments != nil {
+			flag += 3
+		}
+		out.writeByte(flag)
+		switch flag {
+		case ResponseWithException, ResponseWithExceptionWithAttachments:
+			out.writeObject(r.Exception)
+		case ResponseValue, ResponseValueWithAttachments:
+			out.writeObject(r.Value)
+		}
+		if r.Attachments != nil {
+			out.writeAttachments(r.Attachments)
+		}
+	}
+	p := &Packet{Event: r.Event, Serialization: r.Serialization, Status: r.Status, ID: r.ID, Body: out.bytes()}
+	return p.Marshal(), nil
+}
+
+// ParseResponse 解析 Dubbo 响应数据包
+func ParseResponse(raw any, opts ...kryo.Option) (*Response, error) {
+	p, err := ParsePacket(raw)
+	if err != nil {
+		return nil, err
+	}
+	if p.Request {
+		return nil, utils.Error("dubbo packet is not a response")
+	}
+	r := &Response{ID: p.ID, Event: p.Event, Serialization: p.Serialization, Status: p.Status}
+	in, err := newObjectInput(p.Serialization, p.Body, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != StatusOK {
+		if r.ErrorMessage, err = in.readUTF(); err != nil {
+			return r, utils.Errorf("read dubbo error message failed: %v", err)
+		}
+		return r, nil
+	}
+	if p.Event {
+		if in.more() {
+			r.Value, err = in.readObject()
+		}
+		return r, err
+	}
+	if r.Flag, err = in.readByte(); err != nil {
+		return r, utils.Errorf("read dubbo response flag failed: %v", err)
+	}
+	switch r.Flag {
+	case ResponseWithException, ResponseWithExceptionWithAttachments:
+		r.Exception, err = in.readObject()
+	case ResponseValue, ResponseValueWithAttachments:
+		r.Value, err = in.readObject()
+	case ResponseNullValue, ResponseNullValueWithAttachments:
+	default:
+		return r, utils.Errorf("unknown dubbo response flag: %d", r.Flag)
+	}
+	if err != nil {
+		return r, utils.Errorf("read dubbo response result failed: %v", err)
+	}
+	if r.Flag >= ResponseWithExceptionWithAttachments {
+		if r.Attachments, err = in.readAttachments(); err != nil {
+			return r, utils.Errorf("read dubbo response attachments failed: %v", err)
+		}
+	}
+	return r, nil
+}
+
+// GenerateGadgetRequest 生成参数为 Hessian 利用链的 Dubbo 请求，服务端在解码参数时触发利用链，服务与方法不需要存在
+func GenerateGadgetRequest(path, method, gadget string, args ...string) ([]byte, error) {
+	v, err := hessian.GenerateGadget(gadget, args...)
+	if err != nil {
+		return nil, err
+	}
+	r := NewRequest(path, method, v)
+	r.ParameterTypes = "Ljava/lang/Object;"
+	return r.Marshal()
+}
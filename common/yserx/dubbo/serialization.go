@@ -0,0 +1,344 @@
+package dubbo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yserx/hessian"
+	"github.com/yaklang/yaklang/common/yserx/kryo"
+)
+
+// objectOutput 与 Dubbo 的 ObjectOutput 相同，屏蔽不同序列化方式的差异
+type objectOutput interface {
+	writeUTF(s string)
+	writeByte(b byte)
+	writeObject(v any)
+	writeAttachments(m map[string]string)
+	bytes() []byte
+}
+
+type objectInput interface {
+	more() bool
+	readUTF() (string, error)
+	readByte() (byte, error)
+	readObject() (any, error)
+	readAttachments() (map[string]string, error)
+}
+
+func newObjectOutput(serialization byte, opts ...kryo.Option) (objectOutput, error) {
+	switch serialization {
+	case SerializationHessian2:
+		return &hessian2Output{e: hessian.NewHessian2Encoder()}, nil
+	case SerializationKryo:
+		return &kryoOutput{e: kryo.NewEncoder(opts...)}, nil
+	}
+	return nil, utils.Errorf("unsupported dubbo serialization id: %d", serialization)
+}
+
+func newObjectInput(serialization byte, raw []byte, opts ...kryo.Option) (objectInput, error) {
+	switch serialization {
+	case SerializationHessian2:
+		return &hessian2Input{d: hessian.NewHessian2Decoder(raw)}, nil
+	case SerializationKryo:
+		return &kryoInput{d: kryo.NewDecoder(raw, opts...)}, nil
+	}
+	return nil, utils.Errorf("unsupported dubbo serialization id: %d", serialization)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type hessian2Output struct {
+	e *hessian.Hessian2Encoder
+}
+
+func (o *hessian2Output) writeUTF(s string) {
+	o.e.Write(hessian.NewString(s))
+}
+
+// writeByte Hessian2ObjectOutput 把 byte 写为 int
+func (o *hessian2Output) writeByte(b byte) {
+	o.e.Write(hessian.NewInt(int64(int8(b))))
+}
+
+func (o *hessian2Output) writeObject(v any) {
+	o.e.Write(hessian.ToValue(v))
+}
+
+func (o *hessian2Output) writeAttachments(m map[string]string) {
+	attachments := hessian.NewMap("")
+	for _, k := range sortedKeys(m) {
+		attachments.Put(k, m[k])
+	}
+	o.e.Write(attachments)
+}
+
+func (o *hessian2Output) bytes() []byte {
+	return o.e.Bytes()
+}
+
+type hessian2Input struct {
+	d *hessian.Hessian2Decoder
+}
+
+func (i *hessian2Input) more() bool {
+	return i.d.More()
+}
+
+func (i *hessian2Input) readUTF() (string, error) {
+	v, err := i.d.Read()
+	if err != nil {
+		return "", err
+	}
+	switch v.Type {
+	case hessian.TypeString:
+		return v.String, nil
+	case hessian.TypeNull:
+		return "", nil
+	}
+	return "", utils.Errorf("expect hessian string but got %s", v.Type)
+}
+
+func (i *hessian2Input) readByte() (byte, error) {
+	v, err := i.d.Read()
+	if err != nil {
+		return 0, err
+	}
+	if v.Type != hessian.TypeInt {
+		return 0, utils.Errorf("expect hessian int but got %s", v.Type)
+	}
+	return byte(v.Int), nil
+}
+
+func (i *hessian2Input) readObject() (any, error) {
+	return i.d.Read()
+}
+
+func (i *hessian2Input) readAttachments() (map[string]string, error) {
+	v, err := i.d.Read()
+	if err != nil {
+		return nil, err
+	}
+	if v.Type == hessian.TypeNull {
+		return nil, nil
+	}
+	if v.Type != hessian.TypeMap {
+		return nil, utils.Errorf("expect hessian map but got %s", v.Type)
+	}
+	m := make(map[string]string)
+	for _, e := range v.Entries {
+		m[hessianText(e.Key)] = hessianText(e.Value)
+	}
+	return m, nil
+}
+
+func hessianText(v *hessian.Value) string {
+	switch v.Type {
+	case hessian.TypeString:
+		return v.String
+	case hessian.TypeNull:
+		return ""
+	case hessian.TypeInt, hessian.TypeLong, hessian.TypeDate:
+		return fmt.Sprint(v.Int)
+	case hessian.TypeBool:
+		return fmt.Sprint(v.Bool)
+	case hessian.TypeDouble:
+		return fmt.Sprint(v.Double)
+	}
+	return fmt.Sprintf("<%s %s>", v.Type, v.ClassName)
+}
+
+type kryoOutput struct {
+	e *kryo.Encoder
+}
+
+func (o *kryoOutput) writeUTF(s string) {
+	o.e.WriteString(s)
+}
+
+func (o *kryoOutput) writeByte(b byte) {
+	o.e.WriteRawByte(b)
+}
+
+func (o *kryoOutput) writeObject(v any) {
+	o.e.Write(kryo.ToValue(v))
+}
+
+func (o *kryoOutput) writeAttachments(m map[string]string) {
+	attachments := kryo.NewMap("")
+	for _, k := range sortedKeys(m) {
+		attachments.Put(k, m[k])
+	}
+	o.e.Write(attachments)
+}
+
+func (o *kryoOutput) bytes() []byte {
+	return o.e.Bytes()
+}
+
+type kryoInput struct {
+	d *kryo.Decoder
+}
+
+func (i *kryoInput) more() bool {
+	return i.d.More()
+}
+
+func (i *kryoInput) readUTF() (string, error) {
+	s, _, err := i.d.ReadString()
+	return s, err
+}
+
+func (i *kryoInput) readByte() (byte, error) {
+	return i.d.ReadRawByte()
+}
+
+func (i *kryoInput) readObject() (any, error) {
+	return i.d.Read()
+}
+
+func (i *kryoInput) readAttachments() (map[string]string, error) {
+	v, err := i.d.Read()
+	if err != nil {
+		return nil, err
+	}
+	if v.Type == kryo.TypeNull {
+		return nil, nil
+	}
+	if v.Type != kryo.TypeMap {
+		return nil, utils.Errorf("expect kryo map but got %s", v.Type)
+	}
+	m := make(map[string]string)
+	for _, e := range v.Entries {
+		m[kryoText(e.Key)] = kryoText(e.Value)
+	}
+	return m, nil
+}
+
+func kryoText(v *kryo.Value) string {
+	switch v.Type {
+	case kryo.TypeString:
+		return v.String
+	case kryo.TypeNull:
+		return ""
+	case kryo.TypeInt, kryo.TypeLong, kryo.TypeByte, kryo.TypeShort:
+		return fmt.Sprint(v.Int)
+	case kryo.TypeChar:
+		return string(rune(v.Int))
+	case kryo.TypeBool:
+		return fmt.Sprint(v.Bool)
+	case kryo.TypeFloat, kryo.TypeDouble:
+		return fmt.Sprint(v.Float)
+	}
+	return fmt.Sprintf("<%s %s>", v.Type, v.ClassName)
+}
+
+// classDesc 把 Java 类名转换为类型描述符，例如 java.lang.String 转换为 Ljava/lang/String;
+func classDesc(className string) string {
+	if className == "" {
+		return "Ljava/lang/Object;"
+	}
+	name := strings.ReplaceAll(className, ".", "/")
+	if strings.HasPrefix(name, "[") {
+		return name
+	}
+	return "L" + name + ";"
+}
+
+// argDesc 根据参数的值推断方法签名中的类型
+func argDesc(v any) string {
+	switch v := v.(type) {
+	case *hessian.Value:
+		switch v.Type {
+		case hessian.TypeBool:
+			return "Z"
+		case hessian.TypeInt:
+			return "I"
+		case hessian.TypeLong:
+			return "J"
+		case hessian.TypeDouble:
+			return "D"
+		case hessian.TypeString:
+			return "Ljava/lang/String;"
+		case hessian.TypeBinary:
+			return "[B"
+		case hessian.TypeDate:
+			return "Ljava/util/Date;"
+		case hessian.TypeList:
+			if strings.HasPrefix(v.ClassName, "[") {
+				return classDesc(v.ClassName)
+			}
+			return "Ljava/util/List;"
+		case hessian.TypeMap:
+			return "Ljava/util/Map;"
+		case hessian.TypeObject:
+			return classDesc(v.ClassName)
+		}
+	case *kryo.Value:
+		switch v.Type {
+		case kryo.TypeBool:
+			return "Z"
+		case kryo.TypeInt:
+			return "I"
+		case kryo.TypeLong:
+			return "J"
+		case kryo.TypeFloat:
+			return "F"
+		case kryo.TypeDouble:
+			return "D"
+		case kryo.TypeByte:
+			return "B"
+		case kryo.TypeChar:
+			return "C"
+		case kryo.TypeShort:
+			return "S"
+		case kryo.TypeString:
+			return "Ljava/lang/String;"
+		case kryo.TypeBytes:
+			return "[B"
+		case kryo.TypeCollection:
+			return "Ljava/util/List;"
+		case kryo.TypeMap:
+			return "Ljava/util/Map;"
+		case kryo.TypeArray, kryo.TypeObject:
+			return classDesc(v.ClassName)
+		}
+	}
+	return "Ljava/lang/Object;"
+}
+
+// splitDesc 拆分方法签名中的参数类型，例如 ILjava/lang/String;[B 拆分为 I、Ljava/lang/String;、[B
+func splitDesc(desc string) ([]string, error) {
+	var types []string
+	for i := 0; i < len(desc); {
+		start := i
+		for i < len(desc) && desc[i] == '[' {
+			i++
+		}
+		if i >= len(desc) {
+			return nil, utils.Errorf("bad parameter desc: %s", desc)
+		}
+		switch desc[i] {
+		case 'L':
+			end := strings.IndexByte(desc[i:], ';')
+			if end < 0 {
+				return nil, utils.Errorf("bad parameter desc: %s", desc)
+			}
+			i += end + 1
+		case 'Z', 'B', 'C', 'S', 'I', 'J', 'F', 'D':
+			i++
+		default:
+			return nil, utils.Errorf("bad parameter desc: %s", desc)
+		}
+		types = append(types, desc[start:i])
+	}
+	return types, nil
+}
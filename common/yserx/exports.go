@@ -1,6 +1,11 @@
 package yserx
 
-import "github.com/yaklang/yaklang/common/javaclassparser/jarwar"
+import (
+	"github.com/yaklang/yaklang/common/javaclassparser/jarwar"
+	"github.com/yaklang/yaklang/common/yserx/dubbo"
+	"github.com/yaklang/yaklang/common/yserx/hessian"
+	"github.com/yaklang/yaklang/common/yserx/kryo"
+)
 
 var Exports = map[string]interface{}{
 	"ToJson":                   ToJson,
@@ -37,4 +42,62 @@ var Exports = map[string]interface{}{
 	"MarshalJavaObjects":      MarshalJavaObjects,
 
 	"Decompile": jarwar.AutoDecompile,
+
+	// Hessian 1.0 / 2.0
+	"ParseHessian2":      hessian.UnmarshalHessian2,
+	"ParseHessian1":      hessian.UnmarshalHessian1,
+	"MarshalHessian2":    hessian.MarshalHessian2,
+	"MarshalHessian1":    hessian.MarshalHessian1,
+	"HessianToJson":      hessian.ToJson,
+	"HessianFromJson":    hessian.FromJson,
+	"ParseHessianCall":   hessian.ParseCall,
+	"MarshalHessianCall": hessian.MarshalCall,
+	"NewHessianValue":    hessian.ToValue,
+	"NewHessianNull":     hessian.NewNull,
+	"NewHessianBool":     hessian.NewBool,
+	"NewHessianInt":      hessian.NewInt,
+	"NewHessianLong":     hessian.NewLong,
+	"NewHessianDouble":   hessian.NewDouble,
+	"NewHessianDate":     hessian.NewDate,
+	"NewHessianString":   hessian.NewString,
+	"NewHessianBinary":   hessian.NewBinary,
+	"NewHessianList":     hessian.NewList,
+	"NewHessianMap":      hessian.NewMap,
+	"NewHessianObject":   hessian.NewObject,
+	"NewHessianClass":    hessian.NewClass,
+	"NewHessianRef":      hessian.NewRef,
+
+	// Kryo 4
+	"ParseKryo":         kryo.Unmarshal,
+	"MarshalKryo":       kryo.Marshal,
+	"KryoToJson":        kryo.ToJson,
+	"KryoFromJson":      kryo.FromJson,
+	"kryoRegister":      kryo.WithRegister,
+	"kryoReferences":    kryo.WithReferences,
+	"kryoSchema":        kryo.WithClassSchema,
+	"NewKryoValue":      kryo.ToValue,
+	"NewKryoNull":       kryo.NewNull,
+	"NewKryoInt":        kryo.NewInt,
+	"NewKryoLong":       kryo.NewLong,
+	"NewKryoFloat":      kryo.NewFloat,
+	"NewKryoDouble":     kryo.NewDouble,
+	"NewKryoBool":       kryo.NewBool,
+	"NewKryoByte":       kryo.NewByte,
+	"NewKryoChar":       kryo.NewChar,
+	"NewKryoShort":      kryo.NewShort,
+	"NewKryoString":     kryo.NewString,
+	"NewKryoBytes":      kryo.NewBytes,
+	"NewKryoArray":      kryo.NewArray,
+	"NewKryoCollection": kryo.NewCollection,
+	"NewKryoMap":        kryo.NewMap,
+	"NewKryoObject":     kryo.NewObject,
+
+	// Dubbo 协议
+	"NewDubboRequest":              dubbo.NewRequest,
+	"ParseDubboPacket":             dubbo.ParsePacket,
+	"ParseDubboRequest":            dubbo.ParseRequest,
+	"ParseDubboResponse":           dubbo.ParseResponse,
+	"GenerateDubboGadgetRequest":   dubbo.GenerateGadgetRequest,
+	"DUBBO_SERIALIZATION_HESSIAN2": dubbo.SerializationHessian2,
+	"DUBBO_SERIALIZATION_KRYO":     dubbo.SerializationKryo,
 }
@@ -0,0 +1,132 @@
+package hessian
+
+import (
+	"bytes"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Call Hessian RPC 调用（例如 HessianServlet 接收的请求），Version 为 1 或 2
+type Call struct {
+	Version int      `json:"version"`
+	Method  string   `json:"method"`
+	Args    []*Value `json:"args"`
+}
+
+// MarshalCall 生成 Hessian RPC 调用，version 为 1 时使用 c 1 0 格式，否则使用 H 2 0 C 格式
+func MarshalCall(version int, method string, args ...any) []byte {
+	c := &Call{Version: version, Method: method}
+	for _, arg := range args {
+		c.Args = append(c.Args, ToValue(arg))
+	}
+	return c.Marshal()
+}
+
+func (c *Call) Marshal() []byte {
+	if c.Version == 1 {
+		e := NewHessian1Encoder()
+		e.buf.Write([]byte{'c', 0x01, 0x00, 'm'})
+		units := toJavaChars(c.Method)
+		writeUint16(&e.buf, len(units))
+		writeChars(&e.buf, units)
+		for _, arg := range c.Args {
+			e.Write(arg)
+		}
+		e.buf.WriteByte('z')
+		return e.Bytes()
+	}
+	e := NewHessian2Encoder()
+	e.buf.Write([]byte{'H', 0x02, 0x00, 'C'})
+	e.writeString(c.Method)
+	e.writeInt(int32(len(c.Args)))
+	for _, arg := range c.Args {
+		e.Write(arg)
+	}
+	return e.Bytes()
+}
+
+// ParseCall 解析 Hessian RPC 调用
+func ParseCall(raw any) (*Call, error) {
+	data := utils.InterfaceToBytes(raw)
+	switch {
+	case bytes.HasPrefix(data, []byte{'H', 0x02, 0x00, 'C'}):
+		return parseHessian2Call(data[4:])
+	case bytes.HasPrefix(data, []byte{'C'}):
+		return parseHessian2Call(data[1:])
+	case bytes.HasPrefix(data, []byte{'c', 0x01, 0x00}):
+		return parseHessian1Call(data[3:])
+	}
+	return nil, utils.Error("not a hessian call")
+}
+
+func parseHessian2Call(data []byte) (*Call, error) {
+	d := NewHessian2Decoder(data)
+	method, err := d.readString()
+	if err != nil {
+		return nil, utils.Errorf("read hessian call method failed: %v", err)
+	}
+	count, err := d.readInt()
+	if err != nil {
+		return nil, utils.Errorf("read hessian call argument count failed: %v", err)
+	}
+	c := &Call{Version: 2, Method: method}
+	for i := 0; i < count; i++ {
+		arg, err := d.Read()
+		if err != nil {
+			return nil, utils.Errorf("read hessian call argument %d failed: %v", i, err)
+		}
+		c.Args = append(c.Args, arg)
+	}
+	return c, nil
+}
+
+func parseHessian1Call(data []byte) (*Call, error) {
+	d := NewHessian1Decoder(data)
+	// 跳过 header：H 名称 值
+	for {
+		b, err := d.r.peek()
+		if err != nil {
+			return nil, err
+		}
+		if b != 'H' {
+			break
+		}
+		d.r.pos++
+		n, err := d.r.readUint16()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := d.r.readChars(n, nil); err != nil {
+			return nil, err
+		}
+		if _, err := d.Read(); err != nil {
+			return nil, err
+		}
+	}
+	if b, err := d.r.readByte(); err != nil || b != 'm' {
+		return nil, d.r.errorf("expect method")
+	}
+	n, err := d.r.readUint16()
+	if err != nil {
+		return nil, err
+	}
+	units, err := d.r.readChars(n, nil)
+	if err != nil {
+		return nil, err
+	}
+	c := &Call{Version: 1, Method: fromJavaChars(units)}
+	for {
+		end, err := d.readEnd()
+		if err != nil {
+			return nil, err
+		}
+		if end {
+			return c, nil
+		}
+		arg, err := d.Read()
+		if err != nil {
+			return nil, err
+		}
+		c.Args = append(c.Args, arg)
+	}
+}
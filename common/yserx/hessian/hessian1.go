@@ -0,0 +1,367 @@
+package hessian
+
+import (
+	"bytes"
+	"math"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Hessian1Encoder Hessian 1.0 序列化，对象序列化为带类型的 map
+type Hessian1Encoder struct {
+	buf      bytes.Buffer
+	refs     map[*Value]int
+	refCount int
+}
+
+func NewHessian1Encoder() *Hessian1Encoder {
+	return &Hessian1Encoder{refs: make(map[*Value]int)}
+}
+
+func (e *Hessian1Encoder) Bytes() []byte {
+	return e.buf.Bytes()
+}
+
+// MarshalHessian1 把多个值序列化为 Hessian 1.0 数据流
+func MarshalHessian1(values ...*Value) []byte {
+	e := NewHessian1Encoder()
+	for _, v := range values {
+		e.Write(v)
+	}
+	return e.Bytes()
+}
+
+func (e *Hessian1Encoder) Write(v *Value) {
+	if v == nil {
+		e.buf.WriteByte('N')
+		return
+	}
+	switch v.Type {
+	case TypeBool:
+		if v.Bool {
+			e.buf.WriteByte('T')
+		} else {
+			e.buf.WriteByte('F')
+		}
+	case TypeInt:
+		e.buf.WriteByte('I')
+		writeInt32(&e.buf, int32(v.Int))
+	case TypeLong:
+		e.buf.WriteByte('L')
+		writeInt64(&e.buf, v.Int)
+	case TypeDouble:
+		e.buf.WriteByte('D')
+		writeInt64(&e.buf, int64(math.Float64bits(v.Double)))
+	case TypeDate:
+		e.buf.WriteByte('d')
+		writeInt64(&e.buf, v.Int)
+	case TypeString:
+		chunks := splitJavaChars(toJavaChars(v.String))
+		for i, chunk := range chunks {
+			if i < len(chunks)-1 {
+				e.buf.WriteByte('s')
+			} else {
+				e.buf.WriteByte('S')
+			}
+			writeUint16(&e.buf, len(chunk))
+			writeChars(&e.buf, chunk)
+		}
+	case TypeBinary:
+		b := v.Binary
+		for len(b) > 0x8000 {
+			e.buf.WriteByte('b')
+			writeUint16(&e.buf, 0x8000)
+			e.buf.Write(b[:0x8000])
+			b = b[0x8000:]
+		}
+		e.buf.WriteByte('B')
+		writeUint16(&e.buf, len(b))
+		e.buf.Write(b)
+	case TypeRef:
+		e.buf.WriteByte('R')
+		writeInt32(&e.buf, int32(v.Ref))
+	case TypeList, TypeMap, TypeObject:
+		if ref, ok := e.refs[v]; ok {
+			e.buf.WriteByte('R')
+			writeInt32(&e.buf, int32(ref))
+			return
+		}
+		e.refs[v] = e.refCount
+		e.refCount++
+		e.writeContainer(v)
+	default:
+		e.buf.WriteByte('N')
+	}
+}
+
+func (e *Hessian1Encoder) writeType(t string) {
+	units := toJavaChars(t)
+	e.buf.WriteByte('t')
+	writeUint16(&e.buf, len(units))
+	writeChars(&e.buf, units)
+}
+
+func (e *Hessian1Encoder) writeContainer(v *Value) {
+	switch v.Type {
+	case TypeList:
+		e.buf.WriteByte('V')
+		if v.ClassName != "" {
+			e.writeType(v.ClassName)
+		}
+		e.buf.WriteByte('l')
+		writeInt32(&e.buf, int32(len(v.Items)))
+		for _, item := range v.Items {
+			e.Write(item)
+		}
+	case TypeMap:
+		e.buf.WriteByte('M')
+		e.writeType(v.ClassName)
+		for _, entry := range v.Entries {
+			e.Write(entry.Key)
+			e.Write(entry.Value)
+		}
+	default:
+		e.buf.WriteByte('M')
+		e.writeType(v.ClassName)
+		for _, f := range v.Fields {
+			e.Write(NewString(f.Name))
+			e.Write(f.Value)
+		}
+	}
+	e.buf.WriteByte('z')
+}
+
+// Hessian1Decoder Hessian 1.0 反序列化，带类型且键都为字符串的 map（java.util 中的类型除外）解析为对象
+type Hessian1Decoder struct {
+	r        reader
+	refCount int
+}
+
+func NewHessian1Decoder(raw []byte) *Hessian1Decoder {
+	return &Hessian1Decoder{r: reader{data: raw}}
+}
+
+func (d *Hessian1Decoder) More() bool {
+	return d.r.more()
+}
+
+func (d *Hessian1Decoder) Offset() int {
+	return d.r.pos
+}
+
+// UnmarshalHessian1 解析 Hessian 1.0 数据流中的所有值，出错时返回已经解析的值
+func UnmarshalHessian1(raw any) ([]*Value, error) {
+	d := NewHessian1Decoder(utils.InterfaceToBytes(raw))
+	var values []*Value
+	for d.More() {
+		v, err := d.Read()
+		if err != nil {
+			return values, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func (d *Hessian1Decoder) Read() (*Value, error) {
+	b, err := d.r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	switch b {
+	case 'N':
+		return NewNull(), nil
+	case 'T', 'F':
+		return NewBool(b == 'T'), nil
+	case 'I':
+		i, err := d.r.readInt32()
+		if err != nil {
+			return nil, err
+		}
+		return NewInt(int64(i)), nil
+	case 'L', 'D', 'd':
+		i, err := d.r.readInt64()
+		if err != nil {
+			return nil, err
+		}
+		switch b {
+		case 'L':
+			return NewLong(i), nil
+		case 'D':
+			return NewDouble(math.Float64frombits(uint64(i))), nil
+		}
+		return &Value{Type: TypeDate, Int: i}, nil
+	case 'S', 's', 'X', 'x':
+		d.r.pos--
+		s, err := d.readString()
+		if err != nil {
+			return nil, err
+		}
+		return NewString(s), nil
+	case 'B', 'b':
+		d.r.pos--
+		var buf bytes.Buffer
+		for final := false; !final; {
+			tag, err := d.r.readByte()
+			if err != nil {
+				return nil, err
+			}
+			if tag != 'B' && tag != 'b' {
+				return nil, d.r.errorf("expect binary chunk but got tag 0x%02x", tag)
+			}
+			final = tag == 'B'
+			n, err := d.r.readUint16()
+			if err != nil {
+				return nil, err
+			}
+			chunk, err := d.r.readN(n)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(chunk)
+		}
+		return NewBinary(buf.Bytes()), nil
+	case 'V':
+		return d.readList()
+	case 'M':
+		return d.readMap()
+	case 'R':
+		i, err := d.r.readInt32()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= d.refCount {
+			return nil, d.r.errorf("ref %d out of range", i)
+		}
+		return NewRef(int(i)), nil
+	}
+	return nil, d.r.errorf("unknown tag 0x%02x", b)
+}
+
+// readString 读取 S / s 分块的字符串，XML（X / x）与字符串格式相同
+func (d *Hessian1Decoder) readString() (string, error) {
+	var units []uint16
+	for {
+		tag, err := d.r.readByte()
+		if err != nil {
+			return "", err
+		}
+		if tag != 'S' && tag != 's' && tag != 'X' && tag != 'x' {
+			return "", d.r.errorf("expect string chunk but got tag 0x%02x", tag)
+		}
+		n, err := d.r.readUint16()
+		if err != nil {
+			return "", err
+		}
+		if units, err = d.r.readChars(n, units); err != nil {
+			return "", err
+		}
+		if tag == 'S' || tag == 'X' {
+			return fromJavaChars(units), nil
+		}
+	}
+}
+
+// readType 读取可选的 t 类型
+func (d *Hessian1Decoder) readType() (string, error) {
+	if b, err := d.r.peek(); err != nil || b != 't' {
+		return "", err
+	}
+	d.r.pos++
+	n, err := d.r.readUint16()
+	if err != nil {
+		return "", err
+	}
+	units, err := d.r.readChars(n, nil)
+	if err != nil {
+		return "", err
+	}
+	return fromJavaChars(units), nil
+}
+
+// readEnd 遇到 z 时返回 true
+func (d *Hessian1Decoder) readEnd() (bool, error) {
+	b, err := d.r.peek()
+	if err != nil {
+		return false, err
+	}
+	if b == 'z' {
+		d.r.pos++
+		return true, nil
+	}
+	return false, nil
+}
+
+func (d *Hessian1Decoder) readList() (*Value, error) {
+	t, err := d.readType()
+	if err != nil {
+		return nil, err
+	}
+	// 长度可选，元素总是以 z 结尾
+	if b, err := d.r.peek(); err != nil {
+		return nil, err
+	} else if b == 'l' {
+		d.r.pos++
+		if _, err := d.r.readInt32(); err != nil {
+			return nil, err
+		}
+	}
+	l := NewList(t)
+	d.refCount++
+	for {
+		end, err := d.readEnd()
+		if err != nil {
+			return nil, err
+		}
+		if end {
+			return l, nil
+		}
+		item, err := d.Read()
+		if err != nil {
+			return nil, err
+		}
+		l.Items = append(l.Items, item)
+	}
+}
+
+func (d *Hessian1Decoder) readMap() (*Value, error) {
+	t, err := d.readType()
+	if err != nil {
+		return nil, err
+	}
+	m := NewMap(t)
+	d.refCount++
+	for {
+		end, err := d.readEnd()
+		if err != nil {
+			return nil, err
+		}
+		if end {
+			break
+		}
+		k, err := d.Read()
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.Read()
+		if err != nil {
+			return nil, err
+		}
+		m.Entries = append(m.Entries, &Entry{Key: k, Value: v})
+	}
+	if t == "" || strings.HasPrefix(t, "java.util.") || strings.HasSuffix(t, "Map") {
+		return m, nil
+	}
+	for _, e := range m.Entries {
+		if e.Key.Type != TypeString {
+			return m, nil
+		}
+	}
+	// 与 Hessian 1.0 中 writeObjectBegin 写入的数据相同，转换为对象
+	obj := NewObject(t)
+	for _, e := range m.Entries {
+		obj.Fields = append(obj.Fields, &Field{Name: e.Key.String, Value: e.Value})
+	}
+	return obj, nil
+}
@@ -0,0 +1,691 @@
+package hessian
+
+import (
+	"bytes"
+	"math"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Hessian2Encoder Hessian 2.0 序列化，多次 Write 的值共享引用、类定义与类型表，
+// 与 Hessian2Output（以及 Dubbo 中连续写入的请求字段）相同
+type Hessian2Encoder struct {
+	buf        bytes.Buffer
+	refs       map[*Value]int
+	refCount   int
+	classDefs  map[string]int
+	classCount int
+	types      map[string]int
+}
+
+func NewHessian2Encoder() *Hessian2Encoder {
+	return &Hessian2Encoder{
+		refs:      make(map[*Value]int),
+		classDefs: make(map[string]int),
+		types:     make(map[string]int),
+	}
+}
+
+func (e *Hessian2Encoder) Bytes() []byte {
+	return e.buf.Bytes()
+}
+
+// MarshalHessian2 把多个值序列化为 Hessian 2.0 数据流
+func MarshalHessian2(values ...*Value) []byte {
+	e := NewHessian2Encoder()
+	for _, v := range values {
+		e.Write(v)
+	}
+	return e.Bytes()
+}
+
+// Write 写入一个值，同一个 list / map / object 指针再次出现时写入引用
+func (e *Hessian2Encoder) Write(v *Value) {
+	if v == nil {
+		e.buf.WriteByte('N')
+		return
+	}
+	switch v.Type {
+	case TypeBool:
+		if v.Bool {
+			e.buf.WriteByte('T')
+		} else {
+			e.buf.WriteByte('F')
+		}
+	case TypeInt:
+		e.writeInt(int32(v.Int))
+	case TypeLong:
+		e.writeLong(v.Int)
+	case TypeDouble:
+		e.writeDouble(v.Double)
+	case TypeDate:
+		if minutes := v.Int / 60000; v.Int%60000 == 0 && minutes >= math.MinInt32 && minutes <= math.MaxInt32 {
+			e.buf.WriteByte(0x4b)
+			writeInt32(&e.buf, int32(minutes))
+		} else {
+			e.buf.WriteByte(0x4a)
+			writeInt64(&e.buf, v.Int)
+		}
+	case TypeString:
+		e.writeString(v.String)
+	case TypeBinary:
+		e.writeBinary(v.Binary)
+	case TypeRef:
+		e.buf.WriteByte('Q')
+		e.writeInt(int32(v.Ref))
+	case TypeList, TypeMap, TypeObject:
+		if ref, ok := e.refs[v]; ok {
+			e.buf.WriteByte('Q')
+			e.writeInt(int32(ref))
+			return
+		}
+		e.refs[v] = e.refCount
+		e.refCount++
+		switch v.Type {
+		case TypeList:
+			e.writeList(v)
+		case TypeMap:
+			e.writeMap(v)
+		default:
+			e.writeObject(v)
+		}
+	default:
+		e.buf.WriteByte('N')
+	}
+}
+
+func (e *Hessian2Encoder) writeInt(i int32) {
+	switch {
+	case i >= -0x10 && i <= 0x2f:
+		e.buf.WriteByte(byte(i + 0x90))
+	case i >= -0x800 && i <= 0x7ff:
+		e.buf.WriteByte(byte(0xc8 + i>>8))
+		e.buf.WriteByte(byte(i))
+	case i >= -0x40000 && i <= 0x3ffff:
+		e.buf.WriteByte(byte(0xd4 + i>>16))
+		e.buf.WriteByte(byte(i >> 8))
+		e.buf.WriteByte(byte(i))
+	default:
+		e.buf.WriteByte('I')
+		writeInt32(&e.buf, i)
+	}
+}
+
+func (e *Hessian2Encoder) writeLong(i int64) {
+	switch {
+	case i >= -0x08 && i <= 0x0f:
+		e.buf.WriteByte(byte(i + 0xe0))
+	case i >= -0x800 && i <= 0x7ff:
+		e.buf.WriteByte(byte(0xf8 + i>>8))
+		e.buf.WriteByte(byte(i))
+	case i >= -0x40000 && i <= 0x3ffff:
+		e.buf.WriteByte(byte(0x3c + i>>16))
+		e.buf.WriteByte(byte(i >> 8))
+		e.buf.WriteByte(byte(i))
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		e.buf.WriteByte(0x59)
+		writeInt32(&e.buf, int32(i))
+	default:
+		e.buf.WriteByte('L')
+		writeInt64(&e.buf, i)
+	}
+}
+
+// writeDouble 与 Hessian2Output.writeDouble 相同，整数与千分位小数使用紧凑格式
+func (e *Hessian2Encoder) writeDouble(f float64) {
+	if f == math.Trunc(f) && f >= math.MinInt32 && f <= math.MaxInt32 {
+		switch i := int32(f); {
+		case i == 0:
+			e.buf.WriteByte(0x5b)
+			return
+		case i == 1:
+			e.buf.WriteByte(0x5c)
+			return
+		case i >= -0x80 && i < 0x80:
+			e.buf.WriteByte(0x5d)
+			e.buf.WriteByte(byte(i))
+			return
+		case i >= -0x8000 && i < 0x8000:
+			e.buf.WriteByte(0x5e)
+			writeUint16(&e.buf, int(i))
+			return
+		}
+	}
+	if mills := f * 1000; mills >= math.MinInt32 && mills <= math.MaxInt32 {
+		if m := int32(mills); 0.001*float64(m) == f {
+			e.buf.WriteByte(0x5f)
+			writeInt32(&e.buf, m)
+			return
+		}
+	}
+	e.buf.WriteByte('D')
+	writeInt64(&e.buf, int64(math.Float64bits(f)))
+}
+
+func (e *Hessian2Encoder) writeString(s string) {
+	chunks := splitJavaChars(toJavaChars(s))
+	for i, chunk := range chunks {
+		n := len(chunk)
+		switch {
+		case i < len(chunks)-1:
+			e.buf.WriteByte('R')
+			writeUint16(&e.buf, n)
+		case n <= 0x1f:
+			e.buf.WriteByte(byte(n))
+		case n <= 0x3ff:
+			e.buf.WriteByte(byte(0x30 + n>>8))
+			e.buf.WriteByte(byte(n))
+		default:
+			e.buf.WriteByte('S')
+			writeUint16(&e.buf, n)
+		}
+		writeChars(&e.buf, chunk)
+	}
+}
+
+func (e *Hessian2Encoder) writeBinary(b []byte) {
+	for len(b) > 0x8000 {
+		e.buf.WriteByte('A')
+		writeUint16(&e.buf, 0x8000)
+		e.buf.Write(b[:0x8000])
+		b = b[0x8000:]
+	}
+	switch n := len(b); {
+	case n <= 0x0f:
+		e.buf.WriteByte(byte(0x20 + n))
+	case n <= 0x3ff:
+		e.buf.WriteByte(byte(0x34 + n>>8))
+		e.buf.WriteByte(byte(n))
+	default:
+		e.buf.WriteByte('B')
+		writeUint16(&e.buf, n)
+	}
+	e.buf.Write(b)
+}
+
+// writeType 第一次出现的类型写入字符串，之后写入类型表中的序号
+func (e *Hessian2Encoder) writeType(t string) {
+	if idx, ok := e.types[t]; ok {
+		e.writeInt(int32(idx))
+		return
+	}
+	e.types[t] = len(e.types)
+	e.writeString(t)
+}
+
+func (e *Hessian2Encoder) writeList(v *Value) {
+	n := len(v.Items)
+	if v.ClassName != "" {
+		if n <= 7 {
+			e.buf.WriteByte(byte(0x70 + n))
+			e.writeType(v.ClassName)
+		} else {
+			e.buf.WriteByte('V')
+			e.writeType(v.ClassName)
+			e.writeInt(int32(n))
+		}
+	} else if n <= 7 {
+		e.buf.WriteByte(byte(0x78 + n))
+	} else {
+		e.buf.WriteByte('X')
+		e.writeInt(int32(n))
+	}
+	for _, item := range v.Items {
+		e.Write(item)
+	}
+}
+
+func (e *Hessian2Encoder) writeMap(v *Value) {
+	if v.ClassName != "" {
+		e.buf.WriteByte('M')
+		e.writeType(v.ClassName)
+	} else {
+		e.buf.WriteByte('H')
+	}
+	for _, entry := range v.Entries {
+		e.Write(entry.Key)
+		e.Write(entry.Value)
+	}
+	e.buf.WriteByte('Z')
+}
+
+func (e *Hessian2Encoder) writeObject(v *Value) {
+	names := make([]string, len(v.Fields))
+	for i, f := range v.Fields {
+		names[i] = f.Name
+	}
+	// 同名但字段不同的对象使用新的类定义
+	key := v.ClassName + "\x00" + strings.Join(names, "\x00")
+	idx, ok := e.classDefs[key]
+	if !ok {
+		idx = e.classCount
+		e.classCount++
+		e.classDefs[key] = idx
+		e.buf.WriteByte('C')
+		e.writeString(v.ClassName)
+		e.writeInt(int32(len(names)))
+		for _, name := range names {
+			e.writeString(name)
+		}
+	}
+	if idx <= 0x0f {
+		e.buf.WriteByte(byte(0x60 + idx))
+	} else {
+		e.buf.WriteByte('O')
+		e.writeInt(int32(idx))
+	}
+	for _, f := range v.Fields {
+		e.Write(f.Value)
+	}
+}
+
+type classDef struct {
+	name   string
+	fields []string
+}
+
+// Hessian2Decoder Hessian 2.0 反序列化，引用保持为 TypeRef，不展开
+type Hessian2Decoder struct {
+	r         reader
+	classDefs []*classDef
+	types     []string
+	refCount  int
+}
+
+func NewHessian2Decoder(raw []byte) *Hessian2Decoder {
+	return &Hessian2Decoder{r: reader{data: raw}}
+}
+
+// More 是否还有未读取的数据
+func (d *Hessian2Decoder) More() bool {
+	return d.r.more()
+}
+
+// Offset 已经读取的字节数
+func (d *Hessian2Decoder) Offset() int {
+	return d.r.pos
+}
+
+// UnmarshalHessian2 解析 Hessian 2.0 数据流中的所有值，出错时返回已经解析的值
+func UnmarshalHessian2(raw any) ([]*Value, error) {
+	d := NewHessian2Decoder(utils.InterfaceToBytes(raw))
+	var values []*Value
+	for d.More() {
+		v, err := d.Read()
+		if err != nil {
+			return values, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func (d *Hessian2Decoder) Read() (*Value, error) {
+	b, err := d.r.readByte()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case b == 'N':
+		return NewNull(), nil
+	case b == 'T' || b == 'F':
+		return NewBool(b == 'T'), nil
+	case b >= 0x80 && b <= 0xbf:
+		return NewInt(int64(b) - 0x90), nil
+	case b >= 0xc0 && b <= 0xcf:
+		rest, err := d.r.readN(1)
+		if err != nil {
+			return nil, err
+		}
+		return NewInt((int64(b)-0xc8)<<8 + int64(rest[0])), nil
+	case b >= 0xd0 && b <= 0xd7:
+		rest, err := d.r.readN(2)
+		if err != nil {
+			return nil, err
+		}
+		return NewInt((int64(b)-0xd4)<<16 + int64(rest[0])<<8 + int64(rest[1])), nil
+	case b == 'I':
+		i, err := d.r.readInt32()
+		if err != nil {
+			return nil, err
+		}
+		return NewInt(int64(i)), nil
+	case b >= 0xd8 && b <= 0xef:
+		return NewLong(int64(b) - 0xe0), nil
+	case b >= 0xf0:
+		rest, err := d.r.readN(1)
+		if err != nil {
+			return nil, err
+		}
+		return NewLong((int64(b)-0xf8)<<8 + int64(rest[0])), nil
+	case b >= 0x38 && b <= 0x3f:
+		rest, err := d.r.readN(2)
+		if err != nil {
+			return nil, err
+		}
+		return NewLong((int64(b)-0x3c)<<16 + int64(rest[0])<<8 + int64(rest[1])), nil
+	case b == 0x59:
+		i, err := d.r.readInt32()
+		if err != nil {
+			return nil, err
+		}
+		return NewLong(int64(i)), nil
+	case b == 'L':
+		i, err := d.r.readInt64()
+		if err != nil {
+			return nil, err
+		}
+		return NewLong(i), nil
+	case b == 0x5b:
+		return NewDouble(0), nil
+	case b == 0x5c:
+		return NewDouble(1), nil
+	case b == 0x5d:
+		rest, err := d.r.readN(1)
+		if err != nil {
+			return nil, err
+		}
+		return NewDouble(float64(int8(rest[0]))), nil
+	case b == 0x5e:
+		i, err := d.r.readUint16()
+		if err != nil {
+			return nil, err
+		}
+		return NewDouble(float64(int16(i))), nil
+	case b == 0x5f:
+		i, err := d.r.readInt32()
+		if err != nil {
+			return nil, err
+		}
+		return NewDouble(0.001 * float64(i)), nil
+	case b == 'D':
+		i, err := d.r.readInt64()
+		if err != nil {
+			return nil, err
+		}
+		return NewDouble(math.Float64frombits(uint64(i))), nil
+	case b == 0x4a:
+		i, err := d.r.readInt64()
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Type: TypeDate, Int: i}, nil
+	case b == 0x4b:
+		i, err := d.r.readInt32()
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Type: TypeDate, Int: int64(i) * 60000}, nil
+	case isHessian2StringTag(b):
+		d.r.pos--
+		s, err := d.readString()
+		if err != nil {
+			return nil, err
+		}
+		return NewString(s), nil
+	case b <= 0x2f || (b >= 0x34 && b <= 0x37) || b == 'B' || b == 'A':
+		d.r.pos--
+		bin, err := d.readBinary()
+		if err != nil {
+			return nil, err
+		}
+		return NewBinary(bin), nil
+	case b == 'U' || b == 'V' || (b >= 0x70 && b <= 0x77):
+		t, err := d.readType()
+		if err != nil {
+			return nil, err
+		}
+		length := -1
+		if b == 'V' {
+			if length, err = d.readInt(); err != nil {
+				return nil, err
+			}
+		} else if b >= 0x70 {
+			length = int(b - 0x70)
+		}
+		return d.readList(t, length)
+	case b == 'W':
+		return d.readList("", -1)
+	case b == 'X':
+		length, err := d.readInt()
+		if err != nil {
+			return nil, err
+		}
+		return d.readList("", length)
+	case b >= 0x78 && b <= 0x7f:
+		return d.readList("", int(b-0x78))
+	case b == 'H':
+		return d.readMap("")
+	case b == 'M':
+		t, err := d.readType()
+		if err != nil {
+			return nil, err
+		}
+		return d.readMap(t)
+	case b == 'C':
+		if err := d.readClassDef(); err != nil {
+			return nil, err
+		}
+		return d.Read()
+	case b == 'O':
+		idx, err := d.readInt()
+		if err != nil {
+			return nil, err
+		}
+		return d.readObject(idx)
+	case b >= 0x60 && b <= 0x6f:
+		return d.readObject(int(b - 0x60))
+	case b == 'Q':
+		idx, err := d.readInt()
+		if err != nil {
+			return nil, err
+		}
+		if idx < 0 || idx >= d.refCount {
+			return nil, d.r.errorf("ref %d out of range", idx)
+		}
+		return NewRef(idx), nil
+	}
+	return nil, d.r.errorf("unknown tag 0x%02x", b)
+}
+
+func isHessian2StringTag(b byte) bool {
+	return b <= 0x1f || (b >= 0x30 && b <= 0x33) || b == 'S' || b == 'R'
+}
+
+// readInt 读取长度、序号等整数
+func (d *Hessian2Decoder) readInt() (int, error) {
+	v, err := d.Read()
+	if err != nil {
+		return 0, err
+	}
+	if v.Type != TypeInt && v.Type != TypeLong {
+		return 0, d.r.errorf("expect int but got %s", v.Type)
+	}
+	return int(v.Int), nil
+}
+
+func (d *Hessian2Decoder) readString() (string, error) {
+	var units []uint16
+	for {
+		b, err := d.r.readByte()
+		if err != nil {
+			return "", err
+		}
+		var n int
+		final := true
+		switch {
+		case b <= 0x1f:
+			n = int(b)
+		case b >= 0x30 && b <= 0x33:
+			rest, err := d.r.readN(1)
+			if err != nil {
+				return "", err
+			}
+			n = int(b-0x30)<<8 + int(rest[0])
+		case b == 'S' || b == 'R':
+			if n, err = d.r.readUint16(); err != nil {
+				return "", err
+			}
+			final = b == 'S'
+		default:
+			return "", d.r.errorf("expect string but got tag 0x%02x", b)
+		}
+		if units, err = d.r.readChars(n, units); err != nil {
+			return "", err
+		}
+		if final {
+			return fromJavaChars(units), nil
+		}
+	}
+}
+
+func (d *Hessian2Decoder) readBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	for {
+		b, err := d.r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		var n int
+		final := true
+		switch {
+		case b >= 0x20 && b <= 0x2f:
+			n = int(b - 0x20)
+		case b >= 0x34 && b <= 0x37:
+			rest, err := d.r.readN(1)
+			if err != nil {
+				return nil, err
+			}
+			n = int(b-0x34)<<8 + int(rest[0])
+		case b == 'B' || b == 'A':
+			if n, err = d.r.readUint16(); err != nil {
+				return nil, err
+			}
+			final = b == 'B'
+		default:
+			return nil, d.r.errorf("expect binary but got tag 0x%02x", b)
+		}
+		chunk, err := d.r.readN(n)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(chunk)
+		if final {
+			return buf.Bytes(), nil
+		}
+	}
+}
+
+func (d *Hessian2Decoder) readType() (string, error) {
+	b, err := d.r.peek()
+	if err != nil {
+		return "", err
+	}
+	if isHessian2StringTag(b) {
+		t, err := d.readString()
+		if err != nil {
+			return "", err
+		}
+		d.types = append(d.types, t)
+		return t, nil
+	}
+	idx, err := d.readInt()
+	if err != nil {
+		return "", err
+	}
+	if idx < 0 || idx >= len(d.types) {
+		return "", d.r.errorf("type ref %d out of range", idx)
+	}
+	return d.types[idx], nil
+}
+
+// readList length 为 -1 时读取到 Z 为止
+func (d *Hessian2Decoder) readList(className string, length int) (*Value, error) {
+	if length > len(d.r.data)-d.r.pos {
+		return nil, d.r.errorf("list length %d out of range", length)
+	}
+	l := &Value{Type: TypeList, ClassName: className}
+	d.refCount++
+	for i := 0; length < 0 || i < length; i++ {
+		if length < 0 {
+			if b, err := d.r.peek(); err != nil {
+				return nil, err
+			} else if b == 'Z' {
+				d.r.pos++
+				break
+			}
+		}
+		item, err := d.Read()
+		if err != nil {
+			return nil, err
+		}
+		l.Items = append(l.Items, item)
+	}
+	return l, nil
+}
+
+func (d *Hessian2Decoder) readMap(className string) (*Value, error) {
+	m := &Value{Type: TypeMap, ClassName: className}
+	d.refCount++
+	for {
+		b, err := d.r.peek()
+		if err != nil {
+			return nil, err
+		}
+		if b == 'Z' {
+			d.r.pos++
+			return m, nil
+		}
+		k, err := d.Read()
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.Read()
+		if err != nil {
+			return nil, err
+		}
+		m.Entries = append(m.Entries, &Entry{Key: k, Value: v})
+	}
+}
+
+func (d *Hessian2Decoder) readClassDef() error {
+	name, err := d.readString()
+	if err != nil {
+		return err
+	}
+	count, err := d.readInt()
+	if err != nil {
+		return err
+	}
+	if count < 0 || count > len(d.r.data)-d.r.pos {
+		return d.r.errorf("field count %d out of range", count)
+	}
+	def := &classDef{name: name}
+	for i := 0; i < count; i++ {
+		field, err := d.readString()
+		if err != nil {
+			return err
+		}
+		def.fields = append(def.fields, field)
+	}
+	d.classDefs = append(d.classDefs, def)
+	return nil
+}
+
+func (d *Hessian2Decoder) readObject(idx int) (*Value, error) {
+	if idx < 0 || idx >= len(d.classDefs) {
+		return nil, d.r.errorf("class definition %d not found", idx)
+	}
+	def := d.classDefs[idx]
+	obj := NewObject(def.name)
+	d.refCount++
+	for _, name := range def.fields {
+		v, err := d.Read()
+		if err != nil {
+			return nil, err
+		}
+		obj.Fields = append(obj.Fields, &Field{Name: name, Value: v})
+	}
+	return obj, nil
+}
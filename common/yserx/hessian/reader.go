@@ -0,0 +1,152 @@
+package hessian
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+type reader struct {
+	data []byte
+	pos  int
+}
+
+func (r *reader) errorf(format string, args ...any) error {
+	return utils.Errorf("parse hessian data failed at offset %d: %s", r.pos, fmt.Sprintf(format, args...))
+}
+
+func (r *reader) more() bool {
+	return r.pos < len(r.data)
+}
+
+func (r *reader) peek() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, r.errorf("unexpected end of data")
+	}
+	return r.data[r.pos], nil
+}
+
+func (r *reader) readByte() (byte, error) {
+	b, err := r.peek()
+	if err != nil {
+		return 0, err
+	}
+	r.pos++
+	return b, nil
+}
+
+func (r *reader) readN(n int) ([]byte, error) {
+	if n < 0 || r.pos+n > len(r.data) {
+		return nil, r.errorf("need %d bytes but only %d left", n, len(r.data)-r.pos)
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *reader) readUint16() (int, error) {
+	b, err := r.readN(2)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint16(b)), nil
+}
+
+func (r *reader) readInt32() (int32, error) {
+	b, err := r.readN(4)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.BigEndian.Uint32(b)), nil
+}
+
+func (r *reader) readInt64() (int64, error) {
+	b, err := r.readN(8)
+	if err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(b)), nil
+}
+
+// readChars 读取 n 个 Java char（UTF-16 code unit），每个 char 使用 1-3 字节的 UTF-8 编码，代理对分开编码
+func (r *reader) readChars(n int, units []uint16) ([]uint16, error) {
+	for i := 0; i < n; i++ {
+		b, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case b < 0x80:
+			units = append(units, uint16(b))
+		case b&0xe0 == 0xc0:
+			b1, err := r.readByte()
+			if err != nil {
+				return nil, err
+			}
+			units = append(units, uint16(b&0x1f)<<6|uint16(b1&0x3f))
+		case b&0xf0 == 0xe0:
+			rest, err := r.readN(2)
+			if err != nil {
+				return nil, err
+			}
+			units = append(units, uint16(b&0x0f)<<12|uint16(rest[0]&0x3f)<<6|uint16(rest[1]&0x3f))
+		default:
+			return nil, r.errorf("bad utf-8 encoding 0x%02x", b)
+		}
+	}
+	return units, nil
+}
+
+func toJavaChars(s string) []uint16 {
+	return utf16.Encode([]rune(s))
+}
+
+func fromJavaChars(units []uint16) string {
+	return string(utf16.Decode(units))
+}
+
+func writeChars(buf *bytes.Buffer, units []uint16) {
+	for _, c := range units {
+		switch {
+		case c < 0x80:
+			buf.WriteByte(byte(c))
+		case c < 0x800:
+			buf.WriteByte(byte(0xc0 | c>>6&0x1f))
+			buf.WriteByte(byte(0x80 | c&0x3f))
+		default:
+			buf.WriteByte(byte(0xe0 | c>>12&0x0f))
+			buf.WriteByte(byte(0x80 | c>>6&0x3f))
+			buf.WriteByte(byte(0x80 | c&0x3f))
+		}
+	}
+}
+
+// splitJavaChars 按照 Hessian 的分块大小拆分字符串，分块不能以高位代理结尾
+func splitJavaChars(units []uint16) [][]uint16 {
+	var chunks [][]uint16
+	for len(units) > 0x8000 {
+		n := 0x8000
+		if tail := units[n-1]; tail >= 0xd800 && tail <= 0xdbff {
+			n--
+		}
+		chunks = append(chunks, units[:n])
+		units = units[n:]
+	}
+	return append(chunks, units)
+}
+
+func writeUint16(buf *bytes.Buffer, i int) {
+	buf.WriteByte(byte(i >> 8))
+	buf.WriteByte(byte(i))
+}
+
+func writeInt32(buf *bytes.Buffer, i int32) {
+	binary.Write(buf, binary.BigEndian, i)
+}
+
+func writeInt64(buf *bytes.Buffer, i int64) {
+	binary.Write(buf, binary.BigEndian, i)
+}
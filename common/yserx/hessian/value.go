@@ -0,0 +1,246 @@
+package hessian
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// http://hessian.caucho.com/doc/hessian-serialization.html
+// http://hessian.caucho.com/doc/hessian-1.0-spec.xtp
+
+// Hessian 1/2 共用的值类型
+const (
+	TypeNull   = "null"
+	TypeBool   = "bool"
+	TypeInt    = "int"
+	TypeLong   = "long"
+	TypeDouble = "double"
+	// TypeDate Int 为 UTC 毫秒时间戳
+	TypeDate   = "date"
+	TypeString = "string"
+	TypeBinary = "binary"
+	TypeList   = "list"
+	TypeMap    = "map"
+	TypeObject = "object"
+	// TypeRef 引用之前出现过的 list / map / object，Ref 为其序号（从 0 开始）
+	TypeRef = "ref"
+)
+
+// Value Hessian 序列化数据中的一个值，list / map / object 的 ClassName 为 Java 类型，list / map 可以没有类型
+type Value struct {
+	Type      string   `json:"type"`
+	Bool      bool     `json:"bool,omitempty"`
+	Int       int64    `json:"int,omitempty"`
+	Double    float64  `json:"double,omitempty"`
+	String    string   `json:"string,omitempty"`
+	Binary    []byte   `json:"binary,omitempty"`
+	ClassName string   `json:"class_name,omitempty"`
+	Items     []*Value `json:"items,omitempty"`
+	Entries   []*Entry `json:"entries,omitempty"`
+	Fields    []*Field `json:"fields,omitempty"`
+	Ref       int      `json:"ref,omitempty"`
+}
+
+// Entry map 中的键值对
+type Entry struct {
+	Key   *Value `json:"key"`
+	Value *Value `json:"value"`
+}
+
+// Field 对象的字段，按照序列化时的顺序保存
+type Field struct {
+	Name  string `json:"name"`
+	Value *Value `json:"value"`
+}
+
+func NewNull() *Value {
+	return &Value{Type: TypeNull}
+}
+
+func NewBool(b bool) *Value {
+	return &Value{Type: TypeBool, Bool: b}
+}
+
+// NewInt 32 位整数，超出范围时使用 NewLong
+func NewInt(i int64) *Value {
+	return &Value{Type: TypeInt, Int: int64(int32(i))}
+}
+
+func NewLong(i int64) *Value {
+	return &Value{Type: TypeLong, Int: i}
+}
+
+func NewDouble(f float64) *Value {
+	return &Value{Type: TypeDouble, Double: f}
+}
+
+func NewDate(t time.Time) *Value {
+	return &Value{Type: TypeDate, Int: t.UnixMilli()}
+}
+
+func NewString(s string) *Value {
+	return &Value{Type: TypeString, String: s}
+}
+
+func NewBinary(b []byte) *Value {
+	return &Value{Type: TypeBinary, Binary: b}
+}
+
+// NewList 创建列表，className 为空时为无类型列表（反序列化为 ArrayList），也可以是 java.util.Vector、[java.lang.String 等
+func NewList(className string, items ...any) *Value {
+	l := &Value{Type: TypeList, ClassName: className}
+	for _, item := range items {
+		l.Items = append(l.Items, ToValue(item))
+	}
+	return l
+}
+
+// NewMap 创建 map，className 为空时为无类型 map（反序列化为 HashMap）
+func NewMap(className string) *Value {
+	return &Value{Type: TypeMap, ClassName: className}
+}
+
+func NewObject(className string) *Value {
+	return &Value{Type: TypeObject, ClassName: className}
+}
+
+// NewClass java.lang.Class 对象，与 Hessian 的 ClassSerializer 相同，序列化为只有 name 字段的对象
+func NewClass(name string) *Value {
+	return NewObject("java.lang.Class").Set("name", name)
+}
+
+func NewRef(index int) *Value {
+	return &Value{Type: TypeRef, Ref: index}
+}
+
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// ToValue 把 Go 的值转换为 Hessian 的值，超出 32 位的整数转换为 long，slice 转为无类型列表，map 转为无类型 map
+func ToValue(i any) *Value {
+	switch v := i.(type) {
+	case nil:
+		return NewNull()
+	case *Value:
+		if v == nil {
+			return NewNull()
+		}
+		return v
+	case Value:
+		return &v
+	case bool:
+		return NewBool(v)
+	case string:
+		return NewString(v)
+	case []byte:
+		return NewBinary(v)
+	case float32:
+		return NewDouble(float64(v))
+	case float64:
+		return NewDouble(v)
+	case time.Time:
+		return NewDate(v)
+	}
+	rv := reflect.ValueOf(i)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := rv.Int(); n >= math.MinInt32 && n <= math.MaxInt32 {
+			return NewInt(n)
+		}
+		return NewLong(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n := rv.Uint(); n <= math.MaxInt32 {
+			return NewInt(int64(n))
+		}
+		return NewLong(int64(rv.Uint()))
+	case reflect.Slice, reflect.Array:
+		l := NewList("")
+		for j := 0; j < rv.Len(); j++ {
+			l.Items = append(l.Items, ToValue(rv.Index(j).Interface()))
+		}
+		return l
+	case reflect.Map:
+		m := NewMap("")
+		for _, k := range sortedMapKeys(rv) {
+			m.Put(k.Interface(), rv.MapIndex(k).Interface())
+		}
+		return m
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return NewNull()
+		}
+		return ToValue(rv.Elem().Interface())
+	}
+	return NewString(utils.InterfaceToString(i))
+}
+
+// Add 向列表末尾添加元素
+func (v *Value) Add(items ...any) *Value {
+	for _, item := range items {
+		v.Items = append(v.Items, ToValue(item))
+	}
+	return v
+}
+
+// Put 向 map 添加键值对，与 HashMap 序列化后的数据相同，不检查重复的键
+func (v *Value) Put(key, value any) *Value {
+	v.Entries = append(v.Entries, &Entry{Key: ToValue(key), Value: ToValue(value)})
+	return v
+}
+
+// Set 设置对象字段，已经存在的字段会被覆盖
+func (v *Value) Set(name string, value any) *Value {
+	val := ToValue(value)
+	for _, f := range v.Fields {
+		if f.Name == name {
+			f.Value = val
+			return v
+		}
+	}
+	v.Fields = append(v.Fields, &Field{Name: name, Value: val})
+	return v
+}
+
+// Get 获取对象字段或者 map 中字符串键对应的值
+func (v *Value) Get(name string) (*Value, bool) {
+	for _, f := range v.Fields {
+		if f.Name == name {
+			return f.Value, true
+		}
+	}
+	for _, e := range v.Entries {
+		if e.Key != nil && e.Key.Type == TypeString && e.Key.String == name {
+			return e.Value, true
+		}
+	}
+	return nil, false
+}
+
+// ToJson 把 Hessian 值转换为 JSON，便于查看与修改
+func ToJson(values []*Value) (string, error) {
+	raw, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return "", utils.Errorf("marshal hessian values to json failed: %v", err)
+	}
+	return string(raw), nil
+}
+
+// FromJson 从 ToJson 的结果恢复 Hessian 值
+func FromJson(raw any) ([]*Value, error) {
+	var values []*Value
+	if err := json.Unmarshal(utils.InterfaceToBytes(raw), &values); err != nil {
+		return nil, utils.Errorf("unmarshal hessian values from json failed: %v", err)
+	}
+	return values, nil
+}
@@ -0,0 +1,711 @@
+package kryo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"unicode/utf16"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Kryo 中的 NULL / NOT_NULL 标记，类型 id 与引用序号写入时加 2，按类名写入的类型 id 为 NAME（-1）
+const (
+	kryoNull    = 0
+	kryoNotNull = 1
+	kryoName    = -1
+)
+
+// Encoder 与 Kryo 的 Output 相同，多次写入共享类名表与引用表
+type Encoder struct {
+	buf      bytes.Buffer
+	config   *Config
+	names    map[string]int
+	refs     map[*Value]int
+	refCount int
+}
+
+func NewEncoder(opts ...Option) *Encoder {
+	return &Encoder{
+		config: NewConfig(opts...),
+		names:  make(map[string]int),
+		refs:   make(map[*Value]int),
+	}
+}
+
+func (e *Encoder) Bytes() []byte {
+	return e.buf.Bytes()
+}
+
+// Marshal 与 kryo.writeClassAndObject 相同，依次写入多个值
+func Marshal(values []*Value, opts ...Option) []byte {
+	e := NewEncoder(opts...)
+	for _, v := range values {
+		e.Write(v)
+	}
+	return e.Bytes()
+}
+
+func (e *Encoder) writeVarInt(i int32, optimizePositive bool) {
+	if !optimizePositive {
+		i = i<<1 ^ i>>31
+	}
+	u := uint32(i)
+	for u >= 0x80 {
+		e.buf.WriteByte(byte(u) | 0x80)
+		u >>= 7
+	}
+	e.buf.WriteByte(byte(u))
+}
+
+// writeVarLong Kryo 4 的 varlong 最多 9 个字节，第 9 个字节保存剩余的 8 位
+func (e *Encoder) writeVarLong(i int64, optimizePositive bool) {
+	if !optimizePositive {
+		i = i<<1 ^ i>>63
+	}
+	u := uint64(i)
+	for n := 0; n < 8; n++ {
+		if u < 0x80 {
+			e.buf.WriteByte(byte(u))
+			return
+		}
+		e.buf.WriteByte(byte(u) | 0x80)
+		u >>= 7
+	}
+	e.buf.WriteByte(byte(u))
+}
+
+// WriteString 与 Output.writeString 相同，不写入类型，例如 Dubbo 请求中的服务名与方法名
+func (e *Encoder) WriteString(s string) {
+	units := utf16.Encode([]rune(s))
+	switch {
+	case len(units) == 0:
+		e.buf.WriteByte(0x81)
+		return
+	case len(units) > 1 && len(units) < 64 && isASCII(units):
+		// 最后一个字节的最高位表示结束
+		for i, c := range units {
+			if i == len(units)-1 {
+				c |= 0x80
+			}
+			e.buf.WriteByte(byte(c))
+		}
+		return
+	}
+	e.writeUtf8Length(len(units) + 1)
+	for _, c := range units {
+		switch {
+		case c <= 0x7f:
+			e.buf.WriteByte(byte(c))
+		case c > 0x7ff:
+			e.buf.WriteByte(byte(0xe0 | c>>12&0x0f))
+			e.buf.WriteByte(byte(0x80 | c>>6&0x3f))
+			e.buf.WriteByte(byte(0x80 | c&0x3f))
+		default:
+			e.buf.WriteByte(byte(0xc0 | c>>6&0x1f))
+			e.buf.WriteByte(byte(0x80 | c&0x3f))
+		}
+	}
+}
+
+// WriteRawByte 与 Output.writeByte 相同，例如 Dubbo 响应中的结果标记
+func (e *Encoder) WriteRawByte(b byte) {
+	e.buf.WriteByte(b)
+}
+
+func (e *Encoder) writeNullString() {
+	e.buf.WriteByte(0x80)
+}
+
+func isASCII(units []uint16) bool {
+	for _, c := range units {
+		if c > 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// writeUtf8Length 第一个字节的最高位表示 UTF-8 字符串，第 7 位表示长度还有后续字节
+func (e *Encoder) writeUtf8Length(n int) {
+	u := uint32(n)
+	if u>>6 == 0 {
+		e.buf.WriteByte(byte(u | 0x80))
+		return
+	}
+	e.buf.WriteByte(byte(u&0x3f | 0x40 | 0x80))
+	u >>= 6
+	for u >= 0x80 {
+		e.buf.WriteByte(byte(u) | 0x80)
+		u >>= 7
+	}
+	e.buf.WriteByte(byte(u))
+}
+
+func (e *Encoder) writeClass(className string) {
+	if id, ok := e.config.registry[className]; ok {
+		e.writeVarInt(int32(id+2), true)
+		return
+	}
+	e.writeVarInt(kryoName+2, true)
+	if id, ok := e.names[className]; ok {
+		e.writeVarInt(int32(id), true)
+		return
+	}
+	id := len(e.names)
+	e.names[className] = id
+	e.writeVarInt(int32(id), true)
+	e.WriteString(className)
+}
+
+// writeReferenceOrNull 与 Kryo.writeReferenceOrNull 相同，返回 true 表示已经写入 null 或者引用
+func (e *Encoder) writeReferenceOrNull(v *Value, className string, mayBeNull bool) bool {
+	if v == nil || v.Type == TypeNull {
+		e.writeVarInt(kryoNull, true)
+		return true
+	}
+	if v.Type == TypeRef {
+		e.writeVarInt(int32(v.Ref+2), true)
+		return true
+	}
+	if !useReferences(className) {
+		if mayBeNull {
+			e.writeVarInt(kryoNotNull, true)
+		}
+		return false
+	}
+	if id, ok := e.refs[v]; ok {
+		e.writeVarInt(int32(id+2), true)
+		return true
+	}
+	e.refs[v] = e.refCount
+	e.refCount++
+	e.writeVarInt(kryoNotNull, true)
+	return false
+}
+
+// Write 与 kryo.writeClassAndObject 相同，TypeRaw 的数据原样写入
+func (e *Encoder) Write(v *Value) {
+	if v == nil || v.Type == TypeNull {
+		e.writeVarInt(kryoNull, true)
+		return
+	}
+	if v.Type == TypeRaw {
+		e.buf.Write(v.Bytes)
+		return
+	}
+	className := classOf(v)
+	e.writeClass(className)
+	if e.config.references && e.writeReferenceOrNull(v, className, false) {
+		return
+	}
+	e.writeData(v)
+}
+
+// writeObjectOrNull 与 kryo.writeObjectOrNull 相同，用于类型确定（final）的字段与数组元素
+func (e *Encoder) writeObjectOrNull(v *Value, className string) {
+	if e.config.references {
+		if !e.writeReferenceOrNull(v, className, true) {
+			e.writeData(v)
+		}
+		return
+	}
+	isNull := v == nil || v.Type == TypeNull
+	// StringSerializer 可以序列化 null，其他序列化器需要写入标记
+	if className == stringClass || (isNull && v != nil && v.ClassName == stringClass) {
+		if isNull {
+			e.writeNullString()
+		} else {
+			e.writeData(v)
+		}
+		return
+	}
+	if isNull {
+		e.buf.WriteByte(kryoNull)
+		return
+	}
+	e.buf.WriteByte(kryoNotNull)
+	e.writeData(v)
+}
+
+func (e *Encoder) writeData(v *Value) {
+	switch v.Type {
+	case TypeInt:
+		e.writeVarInt(int32(v.Int), false)
+	case TypeLong:
+		e.writeVarLong(v.Int, false)
+	case TypeFloat:
+		binary.Write(&e.buf, binary.BigEndian, math.Float32bits(float32(v.Float)))
+	case TypeDouble:
+		binary.Write(&e.buf, binary.BigEndian, math.Float64bits(v.Float))
+	case TypeBool:
+		if v.Bool {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+	case TypeByte:
+		e.buf.WriteByte(byte(v.Int))
+	case TypeChar, TypeShort:
+		binary.Write(&e.buf, binary.BigEndian, uint16(v.Int))
+	case TypeString:
+		e.WriteString(v.String)
+	case TypeBytes:
+		e.writeVarInt(int32(len(v.Bytes)+1), true)
+		e.buf.Write(v.Bytes)
+	case TypeArray:
+		e.writeVarInt(int32(len(v.Items)+1), true)
+		elem, final := isFinalArrayElement(v.ClassName)
+		for _, item := range v.Items {
+			if final {
+				e.writeObjectOrNull(item, elem)
+			} else {
+				e.Write(item)
+			}
+		}
+	case TypeCollection:
+		e.writeVarInt(int32(len(v.Items)), true)
+		for _, item := range v.Items {
+			e.Write(item)
+		}
+	case TypeMap:
+		e.writeVarInt(int32(len(v.Entries)), true)
+		for _, entry := range v.Entries {
+			e.Write(entry.Key)
+			e.Write(entry.Value)
+		}
+	case TypeObject:
+		for _, f := range v.Fields {
+			switch f.Kind {
+			case FieldPrimitive:
+				e.writeData(ToValue(f.Value))
+			case FieldFinal:
+				className := ""
+				if f.Value != nil {
+					className = classOf(f.Value)
+				}
+				e.writeObjectOrNull(f.Value, className)
+			default:
+				e.Write(f.Value)
+			}
+		}
+	}
+}
+
+// Decoder 与 Kryo 的 Input 相同，多次读取共享类名表与引用表
+type Decoder struct {
+	data     []byte
+	pos      int
+	config   *Config
+	names    map[int]string
+	refCount int
+}
+
+func NewDecoder(raw []byte, opts ...Option) *Decoder {
+	return &Decoder{data: raw, config: NewConfig(opts...), names: make(map[int]string)}
+}
+
+func (d *Decoder) More() bool {
+	return d.pos < len(d.data)
+}
+
+func (d *Decoder) Offset() int {
+	return d.pos
+}
+
+// Unmarshal 与 kryo.readClassAndObject 相同，读取所有的值。无法解析的数据（例如没有通过 WithClassSchema 提供结构的对象）
+// 保存为 TypeRaw，与已经解析的值一起返回，重新序列化后数据不变
+func Unmarshal(raw any, opts ...Option) ([]*Value, error) {
+	data := utils.InterfaceToBytes(raw)
+	d := NewDecoder(data, opts...)
+	var values []*Value
+	for d.More() {
+		start := d.pos
+		v, err := d.Read()
+		if err != nil {
+			values = append(values, &Value{Type: TypeRaw, Bytes: data[start:], String: err.Error()})
+			return values, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func (d *Decoder) errorf(format string, args ...any) error {
+	return utils.Errorf("parse kryo data failed at offset %d: %s", d.pos, fmt.Sprintf(format, args...))
+}
+
+func (d *Decoder) readN(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, d.errorf("need %d bytes but only %d left", n, len(d.data)-d.pos)
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *Decoder) readByte() (byte, error) {
+	b, err := d.readN(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// ReadRawByte 与 Input.readByte 相同
+func (d *Decoder) ReadRawByte() (byte, error) {
+	return d.readByte()
+}
+
+func (d *Decoder) readVarInt(optimizePositive bool) (int32, error) {
+	var u uint32
+	for shift := 0; shift < 35; shift += 7 {
+		b, err := d.readByte()
+		if err != nil {
+			return 0, err
+		}
+		u |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	if !optimizePositive {
+		return int32(u>>1) ^ -int32(u&1), nil
+	}
+	return int32(u), nil
+}
+
+func (d *Decoder) readVarLong(optimizePositive bool) (int64, error) {
+	var u uint64
+	for n := 0; n < 9; n++ {
+		b, err := d.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if n == 8 {
+			u |= uint64(b) << 56
+			break
+		}
+		u |= uint64(b&0x7f) << (7 * n)
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	if !optimizePositive {
+		return int64(u>>1) ^ -int64(u&1), nil
+	}
+	return int64(u), nil
+}
+
+// readLength 读取长度并检查是否超出剩余数据
+func (d *Decoder) readLength() (int, error) {
+	n, err := d.readVarInt(true)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 || int(n) > len(d.data)-d.pos+1 {
+		return 0, d.errorf("length %d out of range", n)
+	}
+	return int(n), nil
+}
+
+// ReadString 与 Input.readString 相同，null 返回 ok 为 false
+func (d *Decoder) ReadString() (string, bool, error) {
+	b, err := d.readByte()
+	if err != nil {
+		return "", false, err
+	}
+	if b&0x80 == 0 {
+		// ASCII 字符串，读取到最高位为 1 的字节为止
+		start := d.pos - 1
+		for b&0x80 == 0 {
+			if b, err = d.readByte(); err != nil {
+				return "", false, err
+			}
+		}
+		s := []byte(string(d.data[start:d.pos]))
+		s[len(s)-1] &= 0x7f
+		return string(s), true, nil
+	}
+	n := int(b & 0x3f)
+	if b&0x40 != 0 {
+		for shift := 6; shift < 34; shift += 7 {
+			if b, err = d.readByte(); err != nil {
+				return "", false, err
+			}
+			n |= int(b&0x7f) << shift
+			if b&0x80 == 0 {
+				break
+			}
+		}
+	}
+	switch n {
+	case 0:
+		return "", false, nil
+	case 1:
+		return "", true, nil
+	}
+	if n-1 > len(d.data)-d.pos {
+		return "", false, d.errorf("string length %d out of range", n-1)
+	}
+	units := make([]uint16, 0, n-1)
+	for i := 0; i < n-1; i++ {
+		b, err := d.readByte()
+		if err != nil {
+			return "", false, err
+		}
+		switch b >> 4 {
+		case 0, 1, 2, 3, 4, 5, 6, 7:
+			units = append(units, uint16(b))
+		case 12, 13:
+			b1, err := d.readByte()
+			if err != nil {
+				return "", false, err
+			}
+			units = append(units, uint16(b&0x1f)<<6|uint16(b1&0x3f))
+		case 14:
+			rest, err := d.readN(2)
+			if err != nil {
+				return "", false, err
+			}
+			units = append(units, uint16(b&0x0f)<<12|uint16(rest[0]&0x3f)<<6|uint16(rest[1]&0x3f))
+		default:
+			return "", false, d.errorf("bad utf-8 encoding 0x%02x", b)
+		}
+	}
+	return string(utf16.Decode(units)), true, nil
+}
+
+func (d *Decoder) readClass() (string, bool, error) {
+	id, err := d.readVarInt(true)
+	if err != nil {
+		return "", false, err
+	}
+	switch id {
+	case kryoNull:
+		return "", false, nil
+	case kryoName + 2:
+		nameID, err := d.readVarInt(true)
+		if err != nil {
+			return "", false, err
+		}
+		if name, ok := d.names[int(nameID)]; ok {
+			return name, true, nil
+		}
+		name, ok, err := d.ReadString()
+		if err != nil {
+			return "", false, err
+		}
+		if !ok {
+			return "", false, d.errorf("class name is null")
+		}
+		d.names[int(nameID)] = name
+		return name, true, nil
+	}
+	name, ok := d.config.ids[int(id-2)]
+	if !ok {
+		return "", false, d.errorf("unregistered class id %d", id-2)
+	}
+	return name, true, nil
+}
+
+// readReference 读取引用标记，返回非 nil 的值表示 null 或者引用
+func (d *Decoder) readReference(className string, mayBeNull bool) (*Value, error) {
+	if !useReferences(className) && !mayBeNull {
+		return nil, nil
+	}
+	marker, err := d.readVarInt(true)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case marker == kryoNull:
+		if !mayBeNull {
+			return nil, d.errorf("unexpected null reference")
+		}
+		return &Value{Type: TypeNull, ClassName: className}, nil
+	case marker == kryoNotNull:
+		if useReferences(className) {
+			d.refCount++
+		}
+		return nil, nil
+	case !useReferences(className):
+		return nil, d.errorf("unexpected reference marker %d for %s", marker, className)
+	case int(marker-2) >= d.refCount:
+		return nil, d.errorf("reference %d out of range", marker-2)
+	}
+	return &Value{Type: TypeRef, ClassName: className, Ref: int(marker - 2)}, nil
+}
+
+// Read 与 kryo.readClassAndObject 相同
+func (d *Decoder) Read() (*Value, error) {
+	className, ok, err := d.readClass()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return NewNull(), nil
+	}
+	if d.config.references {
+		if v, err := d.readReference(className, false); err != nil || v != nil {
+			return v, err
+		}
+	}
+	return d.readData(className)
+}
+
+func (d *Decoder) readObjectOrNull(className string) (*Value, error) {
+	if d.config.references {
+		if v, err := d.readReference(className, true); err != nil || v != nil {
+			return v, err
+		}
+		return d.readData(className)
+	}
+	if className == stringClass {
+		s, ok, err := d.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return &Value{Type: TypeNull, ClassName: stringClass}, nil
+		}
+		return NewString(s), nil
+	}
+	b, err := d.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if b == kryoNull {
+		return &Value{Type: TypeNull, ClassName: className}, nil
+	}
+	return d.readData(className)
+}
+
+func (d *Decoder) readData(className string) (*Value, error) {
+	switch typ := typeOfClass(className); typ {
+	case TypeInt:
+		i, err := d.readVarInt(false)
+		return NewInt(int64(i)), err
+	case TypeLong:
+		i, err := d.readVarLong(false)
+		return NewLong(i), err
+	case TypeFloat:
+		b, err := d.readN(4)
+		if err != nil {
+			return nil, err
+		}
+		return NewFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(b)))), nil
+	case TypeDouble:
+		b, err := d.readN(8)
+		if err != nil {
+			return nil, err
+		}
+		return NewDouble(math.Float64frombits(binary.BigEndian.Uint64(b))), nil
+	case TypeBool:
+		b, err := d.readByte()
+		return NewBool(b == 1), err
+	case TypeByte:
+		b, err := d.readByte()
+		return NewByte(int64(int8(b))), err
+	case TypeChar, TypeShort:
+		b, err := d.readN(2)
+		if err != nil {
+			return nil, err
+		}
+		if typ == TypeChar {
+			return NewChar(int64(binary.BigEndian.Uint16(b))), nil
+		}
+		return NewShort(int64(int16(binary.BigEndian.Uint16(b)))), nil
+	case TypeString:
+		s, ok, err := d.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return &Value{Type: TypeNull, ClassName: stringClass}, nil
+		}
+		return NewString(s), nil
+	case TypeBytes:
+		n, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return NewNull(), nil
+		}
+		b, err := d.readN(n - 1)
+		if err != nil {
+			return nil, err
+		}
+		return NewBytes(append([]byte{}, b...)), nil
+	case TypeArray, TypeCollection:
+		n, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		v := &Value{Type: typ, ClassName: className}
+		elem, final := isFinalArrayElement(className)
+		if typ == TypeArray {
+			if n == 0 {
+				return NewNull(), nil
+			}
+			n--
+		} else {
+			final = false
+		}
+		for i := 0; i < n; i++ {
+			var item *Value
+			if final {
+				item, err = d.readObjectOrNull(elem)
+			} else {
+				item, err = d.Read()
+			}
+			if err != nil {
+				return nil, err
+			}
+			v.Items = append(v.Items, item)
+		}
+		return v, nil
+	case TypeMap:
+		n, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		m := &Value{Type: TypeMap, ClassName: className}
+		for i := 0; i < n; i++ {
+			k, err := d.Read()
+			if err != nil {
+				return nil, err
+			}
+			v, err := d.Read()
+			if err != nil {
+				return nil, err
+			}
+			m.Entries = append(m.Entries, &Entry{Key: k, Value: v})
+		}
+		return m, nil
+	}
+	schema, ok := d.config.schemas[className]
+	if !ok {
+		return nil, d.errorf("unknown layout of class %s, provide it with WithClassSchema", className)
+	}
+	obj := NewObject(className)
+	for _, f := range schema {
+		var v *Value
+		var err error
+		switch f.kind {
+		case FieldPrimitive:
+			v, err = d.readData(f.class)
+		case FieldFinal:
+			v, err = d.readObjectOrNull(f.class)
+		default:
+			v, err = d.Read()
+		}
+		if err != nil {
+			return nil, err
+		}
+		obj.Fields = append(obj.Fields, &Field{Name: f.name, Kind: f.kind, Value: v})
+	}
+	return obj, nil
+}
@@ -0,0 +1,193 @@
+package kryo
+
+import (
+	"strings"
+)
+
+// https://github.com/EsotericSoftware/kryo/tree/kryo-parent-4.0.2
+
+// defaultRegistrations Kryo 默认注册的类，序号即注册 id
+var defaultRegistrations = []string{"int", "java.lang.String", "float", "boolean", "byte", "char", "short", "long", "double", "void"}
+
+// 包装类与基本类型共用同一个注册 id
+var wrapperClasses = map[string]string{
+	"java.lang.Integer":   "int",
+	"java.lang.Float":     "float",
+	"java.lang.Boolean":   "boolean",
+	"java.lang.Byte":      "byte",
+	"java.lang.Character": "char",
+	"java.lang.Short":     "short",
+	"java.lang.Long":      "long",
+	"java.lang.Double":    "double",
+}
+
+var primitiveTypes = map[string]string{
+	"int":     TypeInt,
+	"float":   TypeFloat,
+	"boolean": TypeBool,
+	"byte":    TypeByte,
+	"char":    TypeChar,
+	"short":   TypeShort,
+	"long":    TypeLong,
+	"double":  TypeDouble,
+}
+
+var collectionClasses = map[string]bool{
+	"java.util.ArrayList":                       true,
+	"java.util.LinkedList":                      true,
+	"java.util.HashSet":                         true,
+	"java.util.LinkedHashSet":                   true,
+	"java.util.Vector":                          true,
+	"java.util.ArrayDeque":                      true,
+	"java.util.concurrent.CopyOnWriteArrayList": true,
+}
+
+var mapClasses = map[string]bool{
+	"java.util.HashMap":                      true,
+	"java.util.LinkedHashMap":                true,
+	"java.util.Hashtable":                    true,
+	"java.util.IdentityHashMap":              true,
+	"java.util.concurrent.ConcurrentHashMap": true,
+}
+
+const stringClass = "java.lang.String"
+
+type fieldSchema struct {
+	name  string
+	kind  string
+	class string
+}
+
+// Config Kryo 实例的配置，读写双方的注册表、引用设置与类结构需要一致
+type Config struct {
+	registry   map[string]int
+	ids        map[int]string
+	references bool
+	schemas    map[string][]*fieldSchema
+}
+
+type Option func(*Config)
+
+// WithRegister 注册类，与 kryo.register(Class, id) 相同，例如 Dubbo 中注册的 java.util.HashMap
+func WithRegister(id int, className string) Option {
+	return func(c *Config) {
+		c.register(id, className)
+	}
+}
+
+// WithReferences 是否开启引用，Kryo 4 默认开启（Dubbo 使用 Kryo 4），Kryo 5 默认关闭
+func WithReferences(b bool) Option {
+	return func(c *Config) {
+		c.references = b
+	}
+}
+
+// WithClassSchema 设置 FieldSerializer 序列化的类的字段，Kryo 数据中不包含字段信息，解析对象时需要提供。
+// 字段格式为 name（非 final 类型，写入类型与对象）或者 name:type（基本类型或者 final 类，例如 id:int、name:java.lang.String）
+func WithClassSchema(className string, fields ...string) Option {
+	return func(c *Config) {
+		var schema []*fieldSchema
+		for _, f := range fields {
+			name, class, _ := strings.Cut(f, ":")
+			field := &fieldSchema{name: strings.TrimSpace(name), kind: FieldObject}
+			if class = strings.TrimSpace(class); class != "" {
+				// int 为基本类型字段，java.lang.Integer 为 final 类字段
+				if _, ok := primitiveTypes[class]; ok {
+					field.kind = FieldPrimitive
+				} else {
+					field.kind = FieldFinal
+				}
+				field.class = canonicalClass(class)
+			}
+			schema = append(schema, field)
+		}
+		c.schemas[className] = schema
+	}
+}
+
+func NewConfig(opts ...Option) *Config {
+	c := &Config{
+		registry:   make(map[string]int),
+		ids:        make(map[int]string),
+		references: true,
+		schemas:    make(map[string][]*fieldSchema),
+	}
+	for id, name := range defaultRegistrations {
+		c.register(id, name)
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Config) register(id int, className string) {
+	className = canonicalClass(className)
+	if old, ok := c.ids[id]; ok {
+		delete(c.registry, old)
+	}
+	c.registry[className] = id
+	c.ids[id] = className
+}
+
+func canonicalClass(name string) string {
+	if primitive, ok := wrapperClasses[name]; ok {
+		return primitive
+	}
+	if strings.EqualFold(name, "string") {
+		return stringClass
+	}
+	return name
+}
+
+// useReferences 与 MapReferenceResolver.useReferences 相同，基本类型的包装类不使用引用
+func useReferences(className string) bool {
+	_, ok := primitiveTypes[className]
+	return !ok
+}
+
+// classOf 写入数据时使用的类型
+func classOf(v *Value) string {
+	switch v.Type {
+	case TypeInt, TypeLong, TypeFloat, TypeDouble, TypeBool, TypeByte, TypeChar, TypeShort:
+		return v.Type
+	case TypeString:
+		return stringClass
+	case TypeBytes:
+		return "[B"
+	}
+	return v.ClassName
+}
+
+// typeOfClass 根据类型选择序列化器
+func typeOfClass(className string) string {
+	if t, ok := primitiveTypes[className]; ok {
+		return t
+	}
+	switch {
+	case className == stringClass:
+		return TypeString
+	case className == "[B":
+		return TypeBytes
+	case strings.HasPrefix(className, "[L") || strings.HasPrefix(className, "[["):
+		return TypeArray
+	case collectionClasses[className]:
+		return TypeCollection
+	case mapClasses[className]:
+		return TypeMap
+	}
+	return TypeObject
+}
+
+// isFinalArrayElement 数组元素为 final 类时，元素不写入类型
+func isFinalArrayElement(arrayClass string) (string, bool) {
+	if !strings.HasPrefix(arrayClass, "[L") || !strings.HasSuffix(arrayClass, ";") {
+		return "", false
+	}
+	elem := canonicalClass(arrayClass[2 : len(arrayClass)-1])
+	if elem == stringClass {
+		return elem, true
+	}
+	_, ok := primitiveTypes[elem]
+	return elem, ok
+}
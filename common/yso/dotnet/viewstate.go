@@ -0,0 +1,484 @@
+package dotnet
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// ViewState 使用的 Purpose，.NET 4.5 之后通过 SP800-108 从 machineKey 派生密钥
+const viewStatePurpose = "WebForms.HiddenFieldPageStatePersister.ClientState"
+
+type ViewStateConfig struct {
+	// ValidationKey / DecryptionKey 为 web.config 中 machineKey 的十六进制密钥
+	ValidationKey string
+	ValidationAlg string
+	DecryptionKey string
+	DecryptionAlg string
+	// Generator 页面中的 __VIEWSTATEGENERATOR，旧版本签名时使用
+	Generator string
+	// Path 目标页面路径，例如 /app/default.aspx，AppPath 为 IIS 应用的虚拟目录
+	Path             string
+	AppPath          string
+	ViewStateUserKey string
+	// Legacy 模拟 .NET 4.5 之前（或者 compatibilityMode 为 Framework20SP1/SP2）的签名方式
+	Legacy bool
+	// LegacyEncrypt 旧版本中同时加密 ViewState（ViewStateEncryptionMode 为 Always 或者 validation 为 AES/3DES）
+	LegacyEncrypt bool
+}
+
+type ViewStateOption func(*ViewStateConfig)
+
+func WithValidationKey(key string) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.ValidationKey = key
+	}
+}
+
+// WithValidationAlg machineKey 的 validation 算法: SHA1 / MD5 / HMACSHA256 / HMACSHA384 / HMACSHA512 / AES / 3DES
+func WithValidationAlg(alg string) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.ValidationAlg = alg
+	}
+}
+
+func WithDecryptionKey(key string) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.DecryptionKey = key
+	}
+}
+
+// WithDecryptionAlg machineKey 的 decryption 算法: AES / DES / 3DES
+func WithDecryptionAlg(alg string) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.DecryptionAlg = alg
+	}
+}
+
+func WithGenerator(generator string) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.Generator = generator
+	}
+}
+
+func WithTargetPath(path string) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.Path = path
+	}
+}
+
+func WithAppPath(path string) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.AppPath = path
+	}
+}
+
+func WithViewStateUserKey(key string) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.ViewStateUserKey = key
+	}
+}
+
+func WithLegacy(b bool) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.Legacy = b
+	}
+}
+
+func WithLegacyEncrypt(b bool) ViewStateOption {
+	return func(c *ViewStateConfig) {
+		c.LegacyEncrypt = b
+	}
+}
+
+func newViewStateConfig(opts ...ViewStateOption) *ViewStateConfig {
+	c := &ViewStateConfig{
+		ValidationAlg: "HMACSHA256",
+		DecryptionAlg: "AES",
+		AppPath:       "/",
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func normalizeVirtualPath(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path
+}
+
+// templateSourceDirectory 页面所在的虚拟目录，即 Page.TemplateSourceDirectory
+func templateSourceDirectory(path string) string {
+	path = normalizeVirtualPath(path)
+	if strings.LastIndex(path, ".") > strings.LastIndex(path, "/") {
+		path = path[:strings.LastIndex(path, "/")]
+	}
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+// pageTypeName ASP.NET 编译页面生成的类名，例如 /app/admin/login.aspx 为 admin_login_aspx
+func pageTypeName(path, appPath string) string {
+	path = normalizeVirtualPath(path)
+	if !strings.HasSuffix(strings.ToLower(path), ".aspx") {
+		path = strings.TrimSuffix(path, "/") + "/default.aspx"
+	}
+	appPath = strings.ToLower(normalizeVirtualPath(appPath))
+	if !strings.HasSuffix(appPath, "/") {
+		appPath += "/"
+	}
+	if idx := strings.Index(strings.ToLower(path), appPath); idx >= 0 {
+		path = path[idx+len(appPath):]
+	}
+	path = strings.TrimPrefix(path, "/")
+	return strings.NewReplacer(".", "_", "/", "_").Replace(path)
+}
+
+func (c *ViewStateConfig) keys() (validationKey, decryptionKey []byte, err error) {
+	validationKey, err = hex.DecodeString(strings.TrimPrefix(c.ValidationKey, "0x"))
+	if err != nil || len(validationKey) == 0 {
+		return nil, nil, utils.Errorf("invalid validation key: %s", c.ValidationKey)
+	}
+	if c.DecryptionKey != "" {
+		decryptionKey, err = hex.DecodeString(strings.TrimPrefix(c.DecryptionKey, "0x"))
+		if err != nil {
+			return nil, nil, utils.Errorf("invalid decryption key: %s", c.DecryptionKey)
+		}
+	}
+	return validationKey, decryptionKey, nil
+}
+
+// modifier 旧版本签名时附加在数据后的页面标识以及 ViewStateUserKey
+func (c *ViewStateConfig) modifier() ([]byte, error) {
+	// .NET Framework 使用区域性相关的 GetHashCode 计算页面哈希，无法可靠模拟，需要从页面中获取
+	generator := c.Generator
+	if generator == "" {
+		return nil, utils.Error("__VIEWSTATEGENERATOR is required in legacy mode")
+	}
+	pageHash, err := strconv.ParseUint(strings.TrimPrefix(generator, "0x"), 16, 32)
+	if err != nil {
+		return nil, utils.Errorf("invalid generator: %s", generator)
+	}
+	modifier := binary.LittleEndian.AppendUint32(nil, uint32(pageHash))
+	if c.ViewStateUserKey != "" {
+		for _, r := range utf16.Encode([]rune(c.ViewStateUserKey)) {
+			modifier = binary.LittleEndian.AppendUint16(modifier, r)
+		}
+	}
+	return modifier, nil
+}
+
+// specificPurposes .NET 4.5 之后派生密钥使用的页面信息
+func (c *ViewStateConfig) specificPurposes() ([]string, error) {
+	if c.Path == "" {
+		return nil, utils.Error("target page path is required in .NET 4.5+ mode")
+	}
+	purposes := []string{
+		"TemplateSourceDirectory: " + strings.ToUpper(templateSourceDirectory(c.Path)),
+		"Type: " + strings.ToUpper(pageTypeName(c.Path, c.AppPath)),
+	}
+	if c.ViewStateUserKey != "" {
+		purposes = append(purposes, "ViewStateUserKey: "+c.ViewStateUserKey)
+	}
+	return purposes, nil
+}
+
+func (c *ViewStateConfig) hmac(legacy bool) (func(key []byte) hash.Hash, error) {
+	switch strings.ToUpper(c.ValidationAlg) {
+	case "SHA1", "AES", "3DES", "TRIPLEDES":
+		return func(key []byte) hash.Hash { return hmac.New(sha1.New, key) }, nil
+	case "MD5":
+		if legacy {
+			return nil, nil
+		}
+		return func(key []byte) hash.Hash { return hmac.New(md5.New, key) }, nil
+	case "HMACSHA256":
+		return func(key []byte) hash.Hash { return hmac.New(sha256.New, key) }, nil
+	case "HMACSHA384":
+		return func(key []byte) hash.Hash { return hmac.New(sha512.New384, key) }, nil
+	case "HMACSHA512":
+		return func(key []byte) hash.Hash { return hmac.New(sha512.New, key) }, nil
+	default:
+		return nil, utils.Errorf("not support validation algorithm: %s", c.ValidationAlg)
+	}
+}
+
+func (c *ViewStateConfig) block(key []byte) (cipher.Block, error) {
+	switch strings.ToUpper(c.DecryptionAlg) {
+	case "AES", "AUTO", "":
+		return aes.NewCipher(key)
+	case "DES":
+		return des.NewCipher(key)
+	case "3DES", "TRIPLEDES":
+		return des.NewTripleDESCipher(key)
+	default:
+		return nil, utils.Errorf("not support decryption algorithm: %s", c.DecryptionAlg)
+	}
+}
+
+// legacySign 旧版本 MachineKeySection.HashData，MD5 为 md5(data + modifier + key)，其余为 HMAC
+func (c *ViewStateConfig) legacySign(key, data, modifier []byte) ([]byte, error) {
+	newHash, err := c.hmac(true)
+	if err != nil {
+		return nil, err
+	}
+	if newHash == nil {
+		sum := md5.Sum(bytes.Join([][]byte{data, modifier, key}, nil))
+		return sum[:], nil
+	}
+	h := newHash(key)
+	h.Write(data)
+	h.Write(modifier)
+	return h.Sum(nil), nil
+}
+
+func (c *ViewStateConfig) isLegacyEncrypt() bool {
+	switch strings.ToUpper(c.ValidationAlg) {
+	case "AES", "3DES", "TRIPLEDES":
+		return true
+	}
+	return c.LegacyEncrypt
+}
+
+// deriveKey SP800-108 (HMACSHA512, counter mode)，与 System.Web.Security.Cryptography.SP800_108 相同
+func deriveKey(key []byte, label string, context []string) []byte {
+	var ctx bytes.Buffer
+	for _, s := range context {
+		ctx.Write(write7BitEncodedInt(len(s)))
+		ctx.WriteString(s)
+	}
+	var input bytes.Buffer
+	input.Write(make([]byte, 4))
+	input.WriteString(label)
+	input.WriteByte(0)
+	input.Write(ctx.Bytes())
+	input.Write(binary.BigEndian.AppendUint32(nil, uint32(len(key)*8)))
+	buf := input.Bytes()
+
+	var output []byte
+	for i := uint32(1); len(output) < len(key); i++ {
+		binary.BigEndian.PutUint32(buf, i)
+		h := hmac.New(sha512.New, key)
+		h.Write(buf)
+		output = append(output, h.Sum(nil)...)
+	}
+	return output[:len(key)]
+}
+
+func pkcs7Pad(data []byte, blockSize int) []byte {
+	n := blockSize - len(data)%blockSize
+	return append(append([]byte{}, data...), bytes.Repeat([]byte{byte(n)}, n)...)
+}
+
+func pkcs7Unpad(data []byte, blockSize int) ([]byte, error) {
+	if len(data) == 0 || len(data)%blockSize != 0 {
+		return nil, utils.Error("invalid padding")
+	}
+	n := int(data[len(data)-1])
+	if n == 0 || n > blockSize || n > len(data) {
+		return nil, utils.Error("invalid padding")
+	}
+	for _, b := range data[len(data)-n:] {
+		if int(b) != n {
+			return nil, utils.Error("invalid padding")
+		}
+	}
+	return data[:len(data)-n], nil
+}
+
+func cbcEncrypt(block cipher.Block, iv, data []byte) []byte {
+	data = pkcs7Pad(data, block.BlockSize())
+	out := make([]byte, len(data))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, data)
+	return out
+}
+
+func cbcDecrypt(block cipher.Block, iv, data []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%block.BlockSize() != 0 {
+		return nil, utils.Error("invalid cipher text length")
+	}
+	out := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(out, data)
+	return pkcs7Unpad(out, block.BlockSize())
+}
+
+func viewStatePayload(payload []byte) []byte {
+	// LosFormatter 输出的 base64 文本
+	if bytes.HasPrefix(payload, []byte("/wE")) {
+		if raw, err := base64.StdEncoding.DecodeString(string(payload)); err == nil {
+			return raw
+		}
+	}
+	return payload
+}
+
+// GenerateViewState 使用 machineKey 对 ObjectStateFormatter 序列化的 payload 签名（以及加密），返回可以直接作为 __VIEWSTATE 的 base64 字符串
+func GenerateViewState(payload []byte, opts ...ViewStateOption) (string, error) {
+	c := newViewStateConfig(opts...)
+	payload = viewStatePayload(payload)
+	validationKey, decryptionKey, err := c.keys()
+	if err != nil {
+		return "", err
+	}
+
+	if c.Legacy {
+		modifier, err := c.modifier()
+		if err != nil {
+			return "", err
+		}
+		if !c.isLegacyEncrypt() {
+			mac, err := c.legacySign(validationKey, payload, modifier)
+			if err != nil {
+				return "", err
+			}
+			return base64.StdEncoding.EncodeToString(append(append([]byte{}, payload...), mac...)), nil
+		}
+		// 旧版本加密: 零 IV，明文前附加一个随机块，后面附加 modifier，对密文签名
+		block, err := c.block(decryptionKey)
+		if err != nil {
+			return "", utils.Errorf("invalid decryption key: %v", err)
+		}
+		random := make([]byte, block.BlockSize())
+		rand.Read(random)
+		plain := bytes.Join([][]byte{random, payload, modifier}, nil)
+		encrypted := cbcEncrypt(block, make([]byte, block.BlockSize()), plain)
+		mac, err := c.legacySign(validationKey, encrypted, nil)
+		if err != nil {
+			return "", err
+		}
+		return base64.StdEncoding.EncodeToString(append(encrypted, mac...)), nil
+	}
+
+	if len(decryptionKey) == 0 {
+		return "", utils.Error("decryption key is required in .NET 4.5+ mode")
+	}
+	purposes, err := c.specificPurposes()
+	if err != nil {
+		return "", err
+	}
+	newHash, err := c.hmac(false)
+	if err != nil {
+		return "", err
+	}
+	block, err := c.block(deriveKey(decryptionKey, viewStatePurpose, purposes))
+	if err != nil {
+		return "", utils.Errorf("invalid decryption key: %v", err)
+	}
+	iv := make([]byte, block.BlockSize())
+	rand.Read(iv)
+	data := append(iv, cbcEncrypt(block, iv, payload)...)
+	h := newHash(deriveKey(validationKey, viewStatePurpose, purposes))
+	h.Write(data)
+	return base64.StdEncoding.EncodeToString(h.Sum(data)), nil
+}
+
+// DecodeViewState 使用 machineKey 校验并解密 __VIEWSTATE，返回 ObjectStateFormatter 序列化的数据，可用于验证泄露的 machineKey 是否正确
+func DecodeViewState(viewState string, opts ...ViewStateOption) ([]byte, error) {
+	c := newViewStateConfig(opts...)
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(viewState))
+	if err != nil {
+		return nil, utils.Errorf("invalid viewstate: %v", err)
+	}
+	validationKey, decryptionKey, err := c.keys()
+	if err != nil {
+		return nil, err
+	}
+	var hashSize int
+	if newHash, err := c.hmac(c.Legacy); err != nil {
+		return nil, err
+	} else if newHash == nil {
+		hashSize = md5.Size
+	} else {
+		hashSize = newHash(nil).Size()
+	}
+	if len(data) < hashSize {
+		return nil, utils.Error("viewstate is too short")
+	}
+	body, mac := data[:len(data)-hashSize], data[len(data)-hashSize:]
+
+	if c.Legacy {
+		modifier, err := c.modifier()
+		if err != nil {
+			return nil, err
+		}
+		if !c.isLegacyEncrypt() {
+			expected, err := c.legacySign(validationKey, body, modifier)
+			if err != nil {
+				return nil, err
+			}
+			if !hmac.Equal(expected, mac) {
+				return nil, utils.Error("viewstate mac validation failed")
+			}
+			return body, nil
+		}
+		expected, err := c.legacySign(validationKey, body, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !hmac.Equal(expected, mac) {
+			return nil, utils.Error("viewstate mac validation failed")
+		}
+		block, err := c.block(decryptionKey)
+		if err != nil {
+			return nil, utils.Errorf("invalid decryption key: %v", err)
+		}
+		plain, err := cbcDecrypt(block, make([]byte, block.BlockSize()), body)
+		if err != nil {
+			return nil, utils.Errorf("decrypt viewstate failed: %v", err)
+		}
+		if len(plain) < block.BlockSize()+len(modifier) || !bytes.HasSuffix(plain, modifier) {
+			return nil, utils.Error("viewstate modifier mismatch")
+		}
+		return plain[block.BlockSize() : len(plain)-len(modifier)], nil
+	}
+
+	if len(decryptionKey) == 0 {
+		return nil, utils.Error("decryption key is required in .NET 4.5+ mode")
+	}
+	purposes, err := c.specificPurposes()
+	if err != nil {
+		return nil, err
+	}
+	newHash, _ := c.hmac(false)
+	h := newHash(deriveKey(validationKey, viewStatePurpose, purposes))
+	h.Write(body)
+	if !hmac.Equal(h.Sum(nil), mac) {
+		return nil, utils.Error("viewstate mac validation failed")
+	}
+	block, err := c.block(deriveKey(decryptionKey, viewStatePurpose, purposes))
+	if err != nil {
+		return nil, utils.Errorf("invalid decryption key: %v", err)
+	}
+	if len(body) < block.BlockSize() {
+		return nil, utils.Error("viewstate is too short")
+	}
+	plain, err := cbcDecrypt(block, body[:block.BlockSize()], body[block.BlockSize():])
+	if err != nil {
+		return nil, utils.Errorf("decrypt viewstate failed: %v", err)
+	}
+	return plain, nil
+}
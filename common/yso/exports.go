@@ -1,5 +1,12 @@
 package yso
 
+import (
+	"github.com/yaklang/yaklang/common/yserx/dubbo"
+	"github.com/yaklang/yaklang/common/yserx/hessian"
+	"github.com/yaklang/yaklang/common/yso/dotnet"
+	"github.com/yaklang/yaklang/common/yso/php"
+)
+
 var Exports = map[string]interface{}{
 	// 生成链
 	"ToBytes": ToBytes,
@@ -126,4 +133,56 @@ var Exports = map[string]interface{}{
 	"useConstructorExecutor":       SetConstruct, // 使用构造器执行
 	"evilClassName":                SetClassName, // className
 	"obfuscationClassConstantPool": SetObfuscation,
+
+	// .NET 反序列化
+	"GetAllDotNetGadgets":    dotnet.GetAllGadgets,
+	"GenerateDotNetGadget":   dotnet.GenerateGadget,
+	"dotNetRawCommand":       dotnet.WithRawCommand,
+	"GenerateViewState":      dotnet.GenerateViewState,
+	"DecodeViewState":        dotnet.DecodeViewState,
+	"viewStateValidationKey": dotnet.WithValidationKey,
+	"viewStateValidationAlg": dotnet.WithValidationAlg,
+	"viewStateDecryptionKey": dotnet.WithDecryptionKey,
+	"viewStateDecryptionAlg": dotnet.WithDecryptionAlg,
+	"viewStateGenerator":     dotnet.WithGenerator,
+	"viewStatePath":          dotnet.WithTargetPath,
+	"viewStateAppPath":       dotnet.WithAppPath,
+	"viewStateUserKey":       dotnet.WithViewStateUserKey,
+	"viewStateLegacy":        dotnet.WithLegacy,
+	"viewStateLegacyEncrypt": dotnet.WithLegacyEncrypt,
+
+	// PHP 反序列化
+	"GetAllPHPGadgets":   php.GetAllGadgets,
+	"GetPHPGadget":       php.GenerateGadget,
+	"GeneratePHPGadget":  php.GenerateGadgetBytes,
+	"GeneratePhar":       php.GeneratePhar,
+	"GeneratePharGadget": php.GeneratePharGadget,
+	"ParsePhar":          php.ParsePhar,
+	"pharStub":           php.WithPharStub,
+	"pharPrefix":         php.WithPharPrefix,
+	"pharAlias":          php.WithPharAlias,
+	"pharFile":           php.WithPharFile,
+	"pharSignature":      php.WithPharSignature,
+	"NewPHPNull":         php.NewNull,
+	"NewPHPBool":         php.NewBool,
+	"NewPHPInt":          php.NewInt,
+	"NewPHPFloat":        php.NewFloat,
+	"NewPHPString":       php.NewString,
+	"NewPHPArray":        php.NewArray,
+	"NewPHPObject":       php.NewObject,
+	"NewPHPCustomObject": php.NewCustomObject,
+	"NewPHPEnum":         php.NewEnum,
+	"NewPHPRef":          php.NewRef,
+	"NewPHPRefPointer":   php.NewRefPointer,
+	"PHPSerialize":       php.Marshal,
+	"PHPUnserialize":     php.Unmarshal,
+	"PHPDump":            php.Dump,
+	"PHPToJson":          php.ToJson,
+	"PHPFromJson":        php.FromJson,
+
+	// Hessian 反序列化，用于 Dubbo 等使用 Hessian 的服务
+	"GetAllHessianGadgets":  hessian.GetAllGadgets,
+	"GetHessianGadget":      hessian.GenerateGadget,
+	"GenerateHessianGadget": hessian.GenerateGadgetBytes,
+	"GenerateDubboGadget":   dubbo.GenerateGadgetRequest,
 }
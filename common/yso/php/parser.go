@@ -0,0 +1,323 @@
+package php
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+type parser struct {
+	data []byte
+	pos  int
+}
+
+// Unmarshal 解析 PHP serialize 格式的数据，引用（r/R）保持为引用值，不展开
+func Unmarshal(raw any) (*Value, error) {
+	p := &parser{data: utils.InterfaceToBytes(raw)}
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.data) {
+		return v, utils.Errorf("unexpected trailing data at offset %d", p.pos)
+	}
+	return v, nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return utils.Errorf("parse php serialized data failed at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(s string) error {
+	if !bytes.HasPrefix(p.data[p.pos:], []byte(s)) {
+		return p.errorf("expect %q", s)
+	}
+	p.pos += len(s)
+	return nil
+}
+
+// readUntil 读取到 end 为止（不包含 end），并跳过 end
+func (p *parser) readUntil(end byte) (string, error) {
+	idx := bytes.IndexByte(p.data[p.pos:], end)
+	if idx < 0 {
+		return "", p.errorf("expect %q", end)
+	}
+	s := string(p.data[p.pos : p.pos+idx])
+	p.pos += idx + 1
+	return s, nil
+}
+
+func (p *parser) readInt(end byte) (int64, error) {
+	s, err := p.readUntil(end)
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.ParseInt(strings.TrimPrefix(s, "+"), 10, 64)
+	if err != nil {
+		return 0, p.errorf("invalid integer %q", s)
+	}
+	return i, nil
+}
+
+// readString 读取 len:"..." 格式的字符串，escaped 为 S: 格式（\xx 十六进制转义）
+func (p *parser) readString(escaped bool) (string, error) {
+	length, err := p.readInt(':')
+	if err != nil {
+		return "", err
+	}
+	if length < 0 {
+		return "", p.errorf("invalid string length %d", length)
+	}
+	if err := p.expect(`"`); err != nil {
+		return "", err
+	}
+	var s string
+	if escaped {
+		var buf bytes.Buffer
+		for int64(buf.Len()) < length {
+			if p.pos >= len(p.data) {
+				return "", p.errorf("unexpected end of string")
+			}
+			c := p.data[p.pos]
+			if c == '\\' && p.pos+2 < len(p.data) {
+				b, err := hex.DecodeString(string(p.data[p.pos+1 : p.pos+3]))
+				if err != nil {
+					return "", p.errorf("invalid escape sequence")
+				}
+				buf.Write(b)
+				p.pos += 3
+				continue
+			}
+			buf.WriteByte(c)
+			p.pos++
+		}
+		s = buf.String()
+	} else {
+		if p.pos+int(length) > len(p.data) {
+			return "", p.errorf("string length %d out of range", length)
+		}
+		s = string(p.data[p.pos : p.pos+int(length)])
+		p.pos += int(length)
+	}
+	if err := p.expect(`"`); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+func (p *parser) parseKey() (*Value, error) {
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	if v.Type != TypeInt && v.Type != TypeString {
+		return nil, p.errorf("invalid key type %s", v.Type)
+	}
+	return v, nil
+}
+
+func (p *parser) parseMembers() ([]*Member, error) {
+	count, err := p.readInt(':')
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var members []*Member
+	for i := int64(0); i < count; i++ {
+		k, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, &Member{Key: k, Value: v})
+	}
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (p *parser) parseValue() (*Value, error) {
+	if p.pos+2 > len(p.data) {
+		return nil, p.errorf("unexpected end of data")
+	}
+	typ := p.data[p.pos]
+	if typ == 'N' {
+		if err := p.expect("N;"); err != nil {
+			return nil, err
+		}
+		return NewNull(), nil
+	}
+	if p.data[p.pos+1] != ':' {
+		return nil, p.errorf("invalid type %q", typ)
+	}
+	p.pos += 2
+	switch typ {
+	case 'b':
+		i, err := p.readInt(';')
+		if err != nil {
+			return nil, err
+		}
+		return NewBool(i != 0), nil
+	case 'i':
+		i, err := p.readInt(';')
+		if err != nil {
+			return nil, err
+		}
+		return NewInt(i), nil
+	case 'd':
+		s, err := p.readUntil(';')
+		if err != nil {
+			return nil, err
+		}
+		switch s {
+		case "NAN":
+			return NewFloat(math.NaN()), nil
+		case "INF":
+			return NewFloat(math.Inf(1)), nil
+		case "-INF":
+			return NewFloat(math.Inf(-1)), nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, p.errorf("invalid float %q", s)
+		}
+		return NewFloat(f), nil
+	case 's', 'S':
+		s, err := p.readString(typ == 'S')
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		return NewString(s), nil
+	case 'E':
+		s, err := p.readString(false)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		return &Value{Type: TypeEnum, String: s}, nil
+	case 'r', 'R':
+		i, err := p.readInt(';')
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Type: string(typ), Int: i}, nil
+	case 'a':
+		members, err := p.parseMembers()
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Type: TypeArray, Members: members}, nil
+	case 'O', 'C':
+		className, err := p.readString(false)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if typ == 'O' {
+			members, err := p.parseMembers()
+			if err != nil {
+				return nil, err
+			}
+			return &Value{Type: TypeObject, ClassName: className, Members: members}, nil
+		}
+		length, err := p.readInt(':')
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("{"); err != nil {
+			return nil, err
+		}
+		if length < 0 || p.pos+int(length) > len(p.data) {
+			return nil, p.errorf("custom data length %d out of range", length)
+		}
+		data := string(p.data[p.pos : p.pos+int(length)])
+		p.pos += int(length)
+		if err := p.expect("}"); err != nil {
+			return nil, err
+		}
+		return NewCustomObject(className, data), nil
+	}
+	return nil, p.errorf("invalid type %q", typ)
+}
+
+// Dump 以类似 var_dump 的格式展示 PHP 序列化数据
+func Dump(v *Value) string {
+	var buf strings.Builder
+	dumpValue(&buf, v, 0)
+	return buf.String()
+}
+
+func dumpKey(k *Value, isObject bool) string {
+	if k.Type == TypeInt {
+		return fmt.Sprintf("[%d]", k.Int)
+	}
+	if !isObject {
+		return fmt.Sprintf("[%q]", k.String)
+	}
+	visibility, name := SplitPropertyName(k.String)
+	switch visibility {
+	case "public":
+		return fmt.Sprintf("[%q]", name)
+	case "protected":
+		return fmt.Sprintf("[%q:protected]", name)
+	default:
+		return fmt.Sprintf("[%q:%q:private]", name, visibility)
+	}
+}
+
+func dumpValue(buf *strings.Builder, v *Value, level int) {
+	indent := strings.Repeat("  ", level)
+	if v == nil {
+		buf.WriteString(indent + "NULL\n")
+		return
+	}
+	switch v.Type {
+	case TypeBool:
+		fmt.Fprintf(buf, "%sbool(%v)\n", indent, v.Bool)
+	case TypeInt:
+		fmt.Fprintf(buf, "%sint(%d)\n", indent, v.Int)
+	case TypeFloat:
+		fmt.Fprintf(buf, "%sfloat(%s)\n", indent, formatFloat(v.Float))
+	case TypeString:
+		fmt.Fprintf(buf, "%sstring(%d) %q\n", indent, len(v.String), v.String)
+	case TypeEnum:
+		fmt.Fprintf(buf, "%senum(%s)\n", indent, v.String)
+	case TypeRef:
+		fmt.Fprintf(buf, "%s*REFERENCE* r:%d\n", indent, v.Int)
+	case TypeRefPointer:
+		fmt.Fprintf(buf, "%s*REFERENCE* &R:%d\n", indent, v.Int)
+	case TypeCustom:
+		fmt.Fprintf(buf, "%sserializable(%s) (%d) %q\n", indent, v.ClassName, len(v.Data), v.Data)
+	case TypeArray, TypeObject:
+		if v.Type == TypeArray {
+			fmt.Fprintf(buf, "%sarray(%d) {\n", indent, len(v.Members))
+		} else {
+			fmt.Fprintf(buf, "%sobject(%s) (%d) {\n", indent, v.ClassName, len(v.Members))
+		}
+		for _, m := range v.Members {
+			fmt.Fprintf(buf, "%s  %s=>\n", indent, dumpKey(m.Key, v.Type == TypeObject))
+			dumpValue(buf, m.Value, level+1)
+		}
+		buf.WriteString(indent + "}\n")
+	default:
+		buf.WriteString(indent + "NULL\n")
+	}
+}
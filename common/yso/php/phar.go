@@ -0,0 +1,292 @@
+package php
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// https://www.php.net/manual/en/phar.fileformat.phar.php
+
+const (
+	pharAPIVersion    = 0x1110
+	pharHasSignature  = 0x00010000
+	pharFilePerm      = 0x000001b6
+	pharSignatureMD5  = 0x0001
+	pharSignatureSHA1 = 0x0002
+	pharSignature256  = 0x0003
+	pharSignature512  = 0x0004
+	pharSignatureFlag = "GBMB"
+	pharHaltCompiler  = "__HALT_COMPILER();"
+	defaultPharStub   = "<?php __HALT_COMPILER(); ?>\r\n"
+)
+
+type PharFile struct {
+	Name     string
+	Content  []byte
+	Metadata []byte
+}
+
+type pharConfig struct {
+	stub      string
+	prefix    []byte
+	alias     string
+	files     []*PharFile
+	signature string
+	timestamp uint32
+}
+
+type PharOption func(*pharConfig)
+
+// WithPharStub 自定义 stub，必须包含 __HALT_COMPILER();
+func WithPharStub(stub string) PharOption {
+	return func(c *pharConfig) {
+		c.stub = stub
+	}
+}
+
+// WithPharPrefix 在 stub 前添加数据，例如 GIF89a 用于绕过文件类型检查
+func WithPharPrefix(prefix []byte) PharOption {
+	return func(c *pharConfig) {
+		c.prefix = prefix
+	}
+}
+
+func WithPharAlias(alias string) PharOption {
+	return func(c *pharConfig) {
+		c.alias = alias
+	}
+}
+
+// WithPharFile 添加归档中的文件，默认包含一个 test.txt
+func WithPharFile(name string, content []byte) PharOption {
+	return func(c *pharConfig) {
+		c.files = append(c.files, &PharFile{Name: name, Content: content})
+	}
+}
+
+// WithPharSignature 签名算法: md5 / sha1 / sha256 / sha512
+func WithPharSignature(alg string) PharOption {
+	return func(c *pharConfig) {
+		c.signature = alg
+	}
+}
+
+// GeneratePhar 生成 metadata 为序列化数据的 phar 文件，phar:// 协议读取文件时会反序列化 metadata
+func GeneratePhar(metadata any, opts ...PharOption) ([]byte, error) {
+	c := &pharConfig{
+		stub:      defaultPharStub,
+		signature: "sha1",
+		timestamp: uint32(time.Now().Unix()),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if !strings.Contains(strings.ToUpper(c.stub), strings.ToUpper(pharHaltCompiler)) {
+		return nil, utils.Errorf("phar stub must contain %s", pharHaltCompiler)
+	}
+	if len(c.files) == 0 {
+		c.files = append(c.files, &PharFile{Name: "test.txt", Content: []byte("test")})
+	}
+	var meta []byte
+	switch v := metadata.(type) {
+	case *Value:
+		meta = v.Marshal()
+	case []byte:
+		meta = v
+	case string:
+		meta = []byte(v)
+	case nil:
+	default:
+		meta = Marshal(v)
+	}
+
+	var signFlag uint32
+	var newHash func() hash.Hash
+	switch strings.ToLower(c.signature) {
+	case "md5":
+		signFlag, newHash = pharSignatureMD5, md5.New
+	case "sha1", "":
+		signFlag, newHash = pharSignatureSHA1, sha1.New
+	case "sha256":
+		signFlag, newHash = pharSignature256, sha256.New
+	case "sha512":
+		signFlag, newHash = pharSignature512, sha512.New
+	default:
+		return nil, utils.Errorf("not support phar signature: %s", c.signature)
+	}
+
+	var manifest bytes.Buffer
+	writeUint32 := func(buf *bytes.Buffer, i uint32) {
+		binary.Write(buf, binary.LittleEndian, i)
+	}
+	writeUint32(&manifest, uint32(len(c.files)))
+	manifest.Write([]byte{pharAPIVersion >> 8, pharAPIVersion & 0xf0})
+	writeUint32(&manifest, pharHasSignature)
+	writeUint32(&manifest, uint32(len(c.alias)))
+	manifest.WriteString(c.alias)
+	writeUint32(&manifest, uint32(len(meta)))
+	manifest.Write(meta)
+	for _, f := range c.files {
+		writeUint32(&manifest, uint32(len(f.Name)))
+		manifest.WriteString(f.Name)
+		writeUint32(&manifest, uint32(len(f.Content)))
+		writeUint32(&manifest, c.timestamp)
+		writeUint32(&manifest, uint32(len(f.Content)))
+		writeUint32(&manifest, crc32.ChecksumIEEE(f.Content))
+		writeUint32(&manifest, pharFilePerm)
+		writeUint32(&manifest, uint32(len(f.Metadata)))
+		manifest.Write(f.Metadata)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(c.prefix)
+	buf.WriteString(c.stub)
+	writeUint32(&buf, uint32(manifest.Len()))
+	buf.Write(manifest.Bytes())
+	for _, f := range c.files {
+		buf.Write(f.Content)
+	}
+	h := newHash()
+	h.Write(buf.Bytes())
+	buf.Write(h.Sum(nil))
+	writeUint32(&buf, signFlag)
+	buf.WriteString(pharSignatureFlag)
+	return buf.Bytes(), nil
+}
+
+// GeneratePharGadget 生成 metadata 为指定利用链的 phar 文件
+func GeneratePharGadget(gadget string, args []string, opts ...PharOption) ([]byte, error) {
+	v, err := GenerateGadget(gadget, args...)
+	if err != nil {
+		return nil, err
+	}
+	return GeneratePhar(v, opts...)
+}
+
+// PharArchive 解析后的 phar 文件
+type PharArchive struct {
+	Stub     string
+	Alias    string
+	Metadata []byte
+	Files    []*PharFile
+}
+
+// ParsePhar 解析 phar 格式的文件并校验签名
+func ParsePhar(raw []byte) (*PharArchive, error) {
+	idx := bytes.Index(bytes.ToUpper(raw), []byte(strings.ToUpper(pharHaltCompiler)))
+	if idx < 0 {
+		return nil, utils.Errorf("%s not found", pharHaltCompiler)
+	}
+	pos := idx + len(pharHaltCompiler)
+	// 与 PHP 相同，stub 可以以 " ?>" 加可选的换行结尾
+	for _, suffix := range []string{" ?>\r\n", " ?>\n", " ?>", "?>\r\n", "?>\n", "?>"} {
+		if bytes.HasPrefix(raw[pos:], []byte(suffix)) {
+			pos += len(suffix)
+			break
+		}
+	}
+	archive := &PharArchive{Stub: string(raw[:pos])}
+	r := bytes.NewReader(raw[pos:])
+	readUint32 := func() (uint32, error) {
+		var i uint32
+		err := binary.Read(r, binary.LittleEndian, &i)
+		return i, err
+	}
+	readBytes := func() ([]byte, error) {
+		n, err := readUint32()
+		if err != nil {
+			return nil, err
+		}
+		if int(n) > r.Len() {
+			return nil, utils.Error("phar manifest is truncated")
+		}
+		b := make([]byte, n)
+		_, err = r.Read(b)
+		return b, err
+	}
+
+	if _, err := readUint32(); err != nil {
+		return nil, utils.Errorf("read phar manifest failed: %v", err)
+	}
+	count, err := readUint32()
+	if err != nil {
+		return nil, utils.Errorf("read phar manifest failed: %v", err)
+	}
+	var header [6]byte
+	if _, err := r.Read(header[:]); err != nil {
+		return nil, utils.Errorf("read phar manifest failed: %v", err)
+	}
+	flags := binary.LittleEndian.Uint32(header[2:])
+	alias, err := readBytes()
+	if err != nil {
+		return nil, utils.Errorf("read phar alias failed: %v", err)
+	}
+	archive.Alias = string(alias)
+	if archive.Metadata, err = readBytes(); err != nil {
+		return nil, utils.Errorf("read phar metadata failed: %v", err)
+	}
+	var sizes []uint32
+	for i := uint32(0); i < count; i++ {
+		name, err := readBytes()
+		if err != nil {
+			return nil, utils.Errorf("read phar entry failed: %v", err)
+		}
+		var entry [20]byte
+		if _, err := r.Read(entry[:]); err != nil {
+			return nil, utils.Errorf("read phar entry failed: %v", err)
+		}
+		meta, err := readBytes()
+		if err != nil {
+			return nil, utils.Errorf("read phar entry failed: %v", err)
+		}
+		archive.Files = append(archive.Files, &PharFile{Name: string(name), Metadata: meta})
+		sizes = append(sizes, binary.LittleEndian.Uint32(entry[8:]))
+	}
+	for i, f := range archive.Files {
+		if int(sizes[i]) > r.Len() {
+			return nil, utils.Errorf("phar entry %s is truncated", f.Name)
+		}
+		f.Content = make([]byte, sizes[i])
+		r.Read(f.Content)
+	}
+
+	if flags&pharHasSignature == 0 {
+		return archive, nil
+	}
+	if len(raw) < 8 || string(raw[len(raw)-4:]) != pharSignatureFlag {
+		return nil, utils.Error("phar signature not found")
+	}
+	var newHash func() hash.Hash
+	switch binary.LittleEndian.Uint32(raw[len(raw)-8:]) {
+	case pharSignatureMD5:
+		newHash = md5.New
+	case pharSignatureSHA1:
+		newHash = sha1.New
+	case pharSignature256:
+		newHash = sha256.New
+	case pharSignature512:
+		newHash = sha512.New
+	default:
+		return nil, utils.Error("not support phar signature type")
+	}
+	h := newHash()
+	signed := len(raw) - 8 - h.Size()
+	if signed < 0 {
+		return nil, utils.Error("phar signature is truncated")
+	}
+	h.Write(raw[:signed])
+	if !bytes.Equal(h.Sum(nil), raw[signed:len(raw)-8]) {
+		return nil, utils.Error("phar signature mismatch")
+	}
+	return archive, nil
+}
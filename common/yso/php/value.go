@@ -0,0 +1,437 @@
+package php
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// https://www.php.net/manual/en/function.serialize.php
+// https://github.com/php/php-src/blob/master/ext/standard/var_unserializer.re
+
+// PHP serialize 格式中的类型标识
+const (
+	TypeNull   = "N"
+	TypeBool   = "b"
+	TypeInt    = "i"
+	TypeFloat  = "d"
+	TypeString = "s"
+	TypeArray  = "a"
+	TypeObject = "O"
+	// TypeCustom 实现了 Serializable 接口的对象，Data 为 unserialize 方法接收的原始数据
+	TypeCustom = "C"
+	// TypeEnum PHP 8.1 的枚举，String 为 "Class:Case"
+	TypeEnum = "E"
+	// TypeRef 对象引用 r:N，TypeRefPointer 变量引用（&）R:N，Int 为被引用值的序号（从 1 开始）
+	TypeRef        = "r"
+	TypeRefPointer = "R"
+)
+
+// Value PHP 序列化数据中的一个值
+type Value struct {
+	Type      string    `json:"type"`
+	Bool      bool      `json:"bool,omitempty"`
+	Int       int64     `json:"int,omitempty"`
+	Float     float64   `json:"float,omitempty"`
+	String    string    `json:"string,omitempty"`
+	ClassName string    `json:"class_name,omitempty"`
+	Members   []*Member `json:"members,omitempty"`
+	Data      string    `json:"data,omitempty"`
+}
+
+// Member 数组的元素或者对象的属性，Key 只能为 int 或者 string
+type Member struct {
+	Key   *Value `json:"key"`
+	Value *Value `json:"value"`
+}
+
+func NewNull() *Value {
+	return &Value{Type: TypeNull}
+}
+
+func NewBool(b bool) *Value {
+	return &Value{Type: TypeBool, Bool: b}
+}
+
+func NewInt(i int64) *Value {
+	return &Value{Type: TypeInt, Int: i}
+}
+
+func NewFloat(f float64) *Value {
+	return &Value{Type: TypeFloat, Float: f}
+}
+
+func NewString(s string) *Value {
+	return &Value{Type: TypeString, String: s}
+}
+
+// NewArray 创建数组，values 按顺序追加，map 类型的参数会展开为键值对
+func NewArray(values ...any) *Value {
+	arr := &Value{Type: TypeArray}
+	for _, v := range values {
+		if m := reflect.ValueOf(v); m.Kind() == reflect.Map {
+			for _, k := range sortedMapKeys(m) {
+				arr.Set(k.Interface(), m.MapIndex(k).Interface())
+			}
+			continue
+		}
+		arr.Append(v)
+	}
+	return arr
+}
+
+func NewObject(className string) *Value {
+	return &Value{Type: TypeObject, ClassName: className}
+}
+
+// NewCustomObject 创建实现了 Serializable 接口的对象（C:），data 为其 serialize 方法的返回值
+func NewCustomObject(className string, data string) *Value {
+	return &Value{Type: TypeCustom, ClassName: className, Data: data}
+}
+
+func NewEnum(className, caseName string) *Value {
+	return &Value{Type: TypeEnum, String: className + ":" + caseName}
+}
+
+func NewRef(index int) *Value {
+	return &Value{Type: TypeRef, Int: int64(index)}
+}
+
+func NewRefPointer(index int) *Value {
+	return &Value{Type: TypeRefPointer, Int: int64(index)}
+}
+
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// ToValue 把 Go 的值转换为 PHP 的值，slice 转为列表，map 转为关联数组
+func ToValue(i any) *Value {
+	switch v := i.(type) {
+	case nil:
+		return NewNull()
+	case *Value:
+		if v == nil {
+			return NewNull()
+		}
+		return v
+	case Value:
+		return &v
+	case bool:
+		return NewBool(v)
+	case string:
+		return NewString(v)
+	case []byte:
+		return NewString(string(v))
+	case float32:
+		return NewFloat(float64(v))
+	case float64:
+		return NewFloat(v)
+	}
+	rv := reflect.ValueOf(i)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return NewInt(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return NewInt(int64(rv.Uint()))
+	case reflect.Slice, reflect.Array:
+		arr := &Value{Type: TypeArray}
+		for j := 0; j < rv.Len(); j++ {
+			arr.Append(rv.Index(j).Interface())
+		}
+		return arr
+	case reflect.Map:
+		return NewArray(i)
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return NewNull()
+		}
+		return ToValue(rv.Elem().Interface())
+	}
+	return NewString(utils.InterfaceToString(i))
+}
+
+func toKey(k any) *Value {
+	switch v := k.(type) {
+	case *Value:
+		return v
+	case string:
+		// 与 PHP 相同，十进制整数形式的字符串作为整数键
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil && strconv.FormatInt(i, 10) == v {
+			return NewInt(i)
+		}
+		return NewString(v)
+	case bool:
+		if v {
+			return NewInt(1)
+		}
+		return NewInt(0)
+	}
+	key := ToValue(k)
+	if key.Type == TypeFloat {
+		return NewInt(int64(key.Float))
+	}
+	if key.Type != TypeInt {
+		return NewString(utils.InterfaceToString(k))
+	}
+	return key
+}
+
+// Set 设置数组元素或者对象属性，已经存在的键会被覆盖
+func (v *Value) Set(key any, value any) *Value {
+	k := toKey(key)
+	val := ToValue(value)
+	for _, m := range v.Members {
+		if m.Key.Type == k.Type && m.Key.Int == k.Int && m.Key.String == k.String {
+			m.Value = val
+			return v
+		}
+	}
+	v.Members = append(v.Members, &Member{Key: k, Value: val})
+	return v
+}
+
+// Append 以下一个整数索引追加数组元素
+func (v *Value) Append(value any) *Value {
+	var next int64
+	for _, m := range v.Members {
+		if m.Key.Type == TypeInt && m.Key.Int >= next {
+			next = m.Key.Int + 1
+		}
+	}
+	v.Members = append(v.Members, &Member{Key: NewInt(next), Value: ToValue(value)})
+	return v
+}
+
+// Get 获取数组元素或者对象属性，对象属性可以不带可见性前缀
+func (v *Value) Get(key any) (*Value, bool) {
+	k := toKey(key)
+	for _, m := range v.Members {
+		if m.Key.Type != k.Type || m.Key.Int != k.Int {
+			continue
+		}
+		if m.Key.String == k.String {
+			return m.Value, true
+		}
+		if v.Type == TypeObject && k.Type == TypeString {
+			if _, name := SplitPropertyName(m.Key.String); name == k.String {
+				return m.Value, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (v *Value) SetPublic(name string, value any) *Value {
+	return v.Set(NewString(name), value)
+}
+
+// SetProtected 设置 protected 属性，属性名为 \0*\0name
+func (v *Value) SetProtected(name string, value any) *Value {
+	return v.Set(NewString("\x00*\x00"+name), value)
+}
+
+// SetPrivate 设置当前类的 private 属性，属性名为 \0Class\0name
+func (v *Value) SetPrivate(name string, value any) *Value {
+	return v.SetPrivateOf(v.ClassName, name, value)
+}
+
+// SetPrivateOf 设置父类（或者 trait 所在类）中声明的 private 属性
+func (v *Value) SetPrivateOf(className, name string, value any) *Value {
+	return v.Set(NewString("\x00"+className+"\x00"+name), value)
+}
+
+// SplitPropertyName 拆分对象属性名，返回可见性（public/protected/声明的类名）和属性名
+func SplitPropertyName(key string) (string, string) {
+	if len(key) > 0 && key[0] == 0 {
+		if idx := bytes.IndexByte([]byte(key[1:]), 0); idx >= 0 {
+			class := key[1 : idx+1]
+			if class == "*" {
+				return "protected", key[idx+2:]
+			}
+			return class, key[idx+2:]
+		}
+	}
+	return "public", key
+}
+
+// Marshal 序列化为 PHP serialize 格式，同一个对象指针多次出现时写入 r:N 引用
+func (v *Value) Marshal() []byte {
+	m := &marshaler{objects: make(map[*Value]int)}
+	m.write(v)
+	return m.buf.Bytes()
+}
+
+func Marshal(v any) []byte {
+	return ToValue(v).Marshal()
+}
+
+type marshaler struct {
+	buf     bytes.Buffer
+	n       int
+	objects map[*Value]int
+}
+
+func (m *marshaler) writeString(prefix string, s string) {
+	fmt.Fprintf(&m.buf, "%s:%d:\"%s\"", prefix, len(s), s)
+}
+
+func (m *marshaler) writeKey(k *Value) {
+	if k != nil && k.Type == TypeInt {
+		fmt.Fprintf(&m.buf, "i:%d;", k.Int)
+		return
+	}
+	var s string
+	if k != nil {
+		s = k.String
+	}
+	m.writeString("s", s)
+	m.buf.WriteByte(';')
+}
+
+func (m *marshaler) writeMembers(members []*Member) {
+	fmt.Fprintf(&m.buf, "%d:{", len(members))
+	for _, member := range members {
+		m.writeKey(member.Key)
+		m.write(member.Value)
+	}
+	m.buf.WriteByte('}')
+}
+
+func (m *marshaler) write(v *Value) {
+	// 与 php_add_var_hash 相同，除了 R 以外每个值（不包括键）都占用一个序号
+	m.n++
+	if v == nil {
+		m.buf.WriteString("N;")
+		return
+	}
+	if v.Type == TypeObject || v.Type == TypeCustom {
+		if idx, ok := m.objects[v]; ok {
+			fmt.Fprintf(&m.buf, "r:%d;", idx)
+			return
+		}
+		m.objects[v] = m.n
+	}
+	switch v.Type {
+	case TypeBool:
+		if v.Bool {
+			m.buf.WriteString("b:1;")
+		} else {
+			m.buf.WriteString("b:0;")
+		}
+	case TypeInt:
+		fmt.Fprintf(&m.buf, "i:%d;", v.Int)
+	case TypeFloat:
+		fmt.Fprintf(&m.buf, "d:%s;", formatFloat(v.Float))
+	case TypeString:
+		m.writeString("s", v.String)
+		m.buf.WriteByte(';')
+	case TypeEnum:
+		m.writeString("E", v.String)
+		m.buf.WriteByte(';')
+	case TypeArray:
+		m.buf.WriteString("a:")
+		m.writeMembers(v.Members)
+	case TypeObject:
+		m.writeString("O", v.ClassName)
+		m.buf.WriteByte(':')
+		m.writeMembers(v.Members)
+	case TypeCustom:
+		m.writeString("C", v.ClassName)
+		fmt.Fprintf(&m.buf, ":%d:{%s}", len(v.Data), v.Data)
+	case TypeRef:
+		fmt.Fprintf(&m.buf, "r:%d;", v.Int)
+	case TypeRefPointer:
+		m.n--
+		fmt.Fprintf(&m.buf, "R:%d;", v.Int)
+	default:
+		m.buf.WriteString("N;")
+	}
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NAN"
+	case math.IsInf(f, 1):
+		return "INF"
+	case math.IsInf(f, -1):
+		return "-INF"
+	}
+	return strconv.FormatFloat(f, 'G', -1, 64)
+}
+
+// MarshalJSON 二进制字符串（例如 private 属性名以外的非 UTF-8 数据）使用 base64 保存
+func (v *Value) MarshalJSON() ([]byte, error) {
+	type alias Value
+	c := *v
+	out := struct {
+		*alias
+		StringBase64 string `json:"string_base64,omitempty"`
+		DataBase64   string `json:"data_base64,omitempty"`
+	}{alias: (*alias)(&c)}
+	if !utf8.ValidString(c.String) {
+		out.StringBase64, c.String = base64.StdEncoding.EncodeToString([]byte(c.String)), ""
+	}
+	if !utf8.ValidString(c.Data) {
+		out.DataBase64, c.Data = base64.StdEncoding.EncodeToString([]byte(c.Data)), ""
+	}
+	return json.Marshal(out)
+}
+
+func (v *Value) UnmarshalJSON(raw []byte) error {
+	type alias Value
+	in := struct {
+		*alias
+		StringBase64 string `json:"string_base64,omitempty"`
+		DataBase64   string `json:"data_base64,omitempty"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(raw, &in); err != nil {
+		return err
+	}
+	if in.StringBase64 != "" {
+		s, err := base64.StdEncoding.DecodeString(in.StringBase64)
+		if err != nil {
+			return err
+		}
+		v.String = string(s)
+	}
+	if in.DataBase64 != "" {
+		s, err := base64.StdEncoding.DecodeString(in.DataBase64)
+		if err != nil {
+			return err
+		}
+		v.Data = string(s)
+	}
+	return nil
+}
+
+// ToJson 把 PHP 序列化数据转换为 JSON，便于查看与修改
+func ToJson(v *Value) (string, error) {
+	raw, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", utils.Errorf("marshal php value to json failed: %v", err)
+	}
+	return string(raw), nil
+}
+
+// FromJson 从 ToJson 的结果恢复 PHP 值
+func FromJson(raw any) (*Value, error) {
+	v := &Value{}
+	if err := json.Unmarshal(utils.InterfaceToBytes(raw), v); err != nil {
+		return nil, utils.Errorf("unmarshal php value from json failed: %v", err)
+	}
+	return v, nil
+}
@@ -111,6 +111,7 @@ require (
 	github.com/tidwall/gjson v1.14.4
 	github.com/tidwall/sjson v1.2.5
 	github.com/twmb/murmur3 v1.1.6
+	github.com/ulikunitz/xz v0.5.12
 	github.com/urfave/cli v1.22.15
 	github.com/valyala/bytebufferpool v1.0.0
 	github.com/vjeantet/grok v1.0.0